@@ -154,6 +154,7 @@ func makeConfigTemplate(destination string, mode os.FileMode, confParams ConfigF
 		"ExcludeKafka":                   false,
 		"ExcludeLogstash":                false,
 		"ExcludeRedis":                   false,
+		"ExcludeSyslog":                  false,
 		"UseObserverProcessor":           false,
 		"UseDockerMetadataProcessor":     true,
 		"UseKubernetesMetadataProcessor": false,
@@ -24,6 +24,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/registrar"
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/processors"
 	"github.com/elastic/beats/v7/libbeat/publisher/pipetool"
 )
 
@@ -178,3 +179,17 @@ func (c *combinedEventer) DroppedOnPublish(event beat.Event) {
 	c.a.DroppedOnPublish(event)
 	c.b.DroppedOnPublish(event)
 }
+
+// withPipelineProcessor adds processor after the processors of all the clients
+// connecting to the pipeline.
+func withPipelineProcessor(pipeline beat.PipelineConnector, processor processors.Processor) beat.PipelineConnector {
+	return pipetool.WithClientConfigEdit(pipeline, func(config beat.ClientConfig) (beat.ClientConfig, error) {
+		procs := processors.NewList(nil)
+		if lst := config.Processing.Processor; lst != nil {
+			procs.AddProcessor(lst)
+		}
+		procs.AddProcessor(processor)
+		config.Processing.Processor = procs
+		return config, nil
+	})
+}
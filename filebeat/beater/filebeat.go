@@ -29,6 +29,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/fileset"
 	_ "github.com/elastic/beats/v7/filebeat/include"
 	"github.com/elastic/beats/v7/filebeat/input"
+	"github.com/elastic/beats/v7/filebeat/logmetrics"
 	"github.com/elastic/beats/v7/filebeat/registrar"
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -240,6 +241,17 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 	fb.pipeline = pipetool.WithDefaultGuarantees(b.Publisher, beat.GuaranteedSend)
 	fb.pipeline = withPipelineEventCounter(fb.pipeline, wgEvents)
 
+	// Metric events are published without going through the aggregator
+	metricsPipeline := fb.pipeline
+	var logsToMetrics *logmetrics.Aggregator
+	if config.LogsToMetrics.Enabled() {
+		logsToMetrics, err = logmetrics.New(config.LogsToMetrics)
+		if err != nil {
+			return err
+		}
+		fb.pipeline = withPipelineProcessor(fb.pipeline, logsToMetrics)
+	}
+
 	outDone := make(chan struct{}) // outDone closes down all active pipeline connections
 	pipelineConnector := channel.NewOutletFactory(outDone).Create
 
@@ -285,6 +297,14 @@ func (fb *Filebeat) Run(b *beat.Beat) error {
 	// Wait for all events to be processed or timeout
 	defer waitEvents.Wait()
 
+	if logsToMetrics != nil {
+		if err := logsToMetrics.Start(metricsPipeline); err != nil {
+			return err
+		}
+		// Stopping the aggregator publishes the metrics of the last period
+		defer logsToMetrics.Stop()
+	}
+
 	if config.OverwritePipelines {
 		logp.Debug("modules", "Existing Ingest pipelines will be updated")
 	}
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package channel
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Pauser blocks the events published through the outlets of a pausable
+// connector while paused.
+type Pauser struct {
+	mutex  sync.Mutex
+	resume chan struct{} // closed on resume, nil if not paused
+}
+
+type pausableConnector struct {
+	connector Connector
+	pauser    *Pauser
+}
+
+type pausableOutlet struct {
+	Outleter
+	pauser *Pauser
+}
+
+// NewPauser creates a Pauser, initially not paused.
+func NewPauser() *Pauser {
+	return &Pauser{}
+}
+
+// Pause blocks the events published from now on.
+func (p *Pauser) Pause() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.resume == nil {
+		p.resume = make(chan struct{})
+	}
+}
+
+// Resume unblocks the events.
+func (p *Pauser) Resume() {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if p.resume != nil {
+		close(p.resume)
+		p.resume = nil
+	}
+}
+
+// Paused returns true if the events are blocked.
+func (p *Pauser) Paused() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return p.resume != nil
+}
+
+// Wait blocks while paused. It returns false if done is closed before being
+// resumed.
+func (p *Pauser) Wait(done <-chan struct{}) bool {
+	p.mutex.Lock()
+	resume := p.resume
+	p.mutex.Unlock()
+
+	if resume == nil {
+		return true
+	}
+	select {
+	case <-resume:
+		return true
+	case <-done:
+		return false
+	}
+}
+
+// PausableConnector wraps a connector, so the events published through its
+// outlets are blocked while the pauser is paused. Blocked events are not
+// reported as published, so inputs don't update their state for them.
+func PausableConnector(connector Connector, pauser *Pauser) Connector {
+	return &pausableConnector{connector: connector, pauser: pauser}
+}
+
+func (c *pausableConnector) Connect(cfg *common.Config) (Outleter, error) {
+	return c.wrap(c.connector.Connect(cfg))
+}
+
+func (c *pausableConnector) ConnectWith(cfg *common.Config, clientCfg beat.ClientConfig) (Outleter, error) {
+	return c.wrap(c.connector.ConnectWith(cfg, clientCfg))
+}
+
+func (c *pausableConnector) wrap(outlet Outleter, err error) (Outleter, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &pausableOutlet{Outleter: outlet, pauser: c.pauser}, nil
+}
+
+func (o *pausableOutlet) OnEvent(event beat.Event) bool {
+	if !o.pauser.Wait(o.Done()) {
+		return false
+	}
+	return o.Outleter.OnEvent(event)
+}
@@ -0,0 +1,63 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package channel
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestPausableConnector(t *testing.T) {
+	pauser := NewPauser()
+	o := &dummyOutletter{c: make(chan struct{})}
+	connector := PausableConnector(ConnectorFunc(func(*common.Config, beat.ClientConfig) (Outleter, error) {
+		return o, nil
+	}), pauser)
+	outlet, err := connector.Connect(common.NewConfig())
+	require.NoError(t, err)
+
+	assert.False(t, pauser.Paused())
+	assert.True(t, outlet.OnEvent(beat.Event{}))
+
+	pauser.Pause()
+	assert.True(t, pauser.Paused())
+
+	published := make(chan bool)
+	go func() { published <- outlet.OnEvent(beat.Event{}) }()
+	select {
+	case <-published:
+		t.Fatal("event published while paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	pauser.Resume()
+	assert.False(t, pauser.Paused())
+	assert.True(t, <-published)
+
+	// Events blocked when the outlet is closed are not published.
+	pauser.Pause()
+	go func() { published <- outlet.OnEvent(beat.Event{}) }()
+	outlet.Close()
+	assert.False(t, <-published)
+}
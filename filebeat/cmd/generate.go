@@ -20,6 +20,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,6 +28,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/generator/fileset"
 	"github.com/elastic/beats/v7/filebeat/generator/module"
 	"github.com/elastic/beats/v7/libbeat/common/cli"
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
 	"github.com/elastic/beats/v7/libbeat/paths"
 )
 
@@ -85,12 +87,34 @@ func genGenerateFilesetCmd() *cobra.Command {
 			moduleName := args[0]
 			filesetName := args[1]
 
+			if testPipeline, _ := cmd.Flags().GetBool("test-pipeline"); testPipeline {
+				esURL, _ := cmd.Flags().GetString("es-url")
+				updateGolden, _ := cmd.Flags().GetBool("update-golden")
+
+				esClient, err := eslegclient.NewConnection(eslegclient.ConnectionSettings{
+					URL:     esURL,
+					Timeout: 90 * time.Second,
+				})
+				if err != nil {
+					return err
+				}
+				if err := esClient.Connect(); err != nil {
+					return err
+				}
+				defer esClient.Close()
+
+				return fileset.TestPipeline(moduleName, filesetName, modulesPath, esClient, updateGolden)
+			}
+
 			return fileset.Generate(moduleName, filesetName, modulesPath, esBeatsPath)
 		}),
 	}
 
 	genFilesetCmd.Flags().String("modules-path", defaultHomePath, "Path to modules directory")
 	genFilesetCmd.Flags().String("es-beats", defaultHomePath, "Path to Elastic Beats")
+	genFilesetCmd.Flags().Bool("test-pipeline", false, "Run the sample logs of an existing fileset through its ingest pipelines and compare them with the golden files")
+	genFilesetCmd.Flags().Bool("update-golden", false, "Update the golden files with the documents of --test-pipeline")
+	genFilesetCmd.Flags().String("es-url", "http://localhost:9200", "Elasticsearch URL used by --test-pipeline")
 
 	return genFilesetCmd
 }
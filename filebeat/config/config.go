@@ -48,6 +48,7 @@ type Config struct {
 	ConfigModules      *common.Config       `config:"config.modules"`
 	Autodiscover       *autodiscover.Config `config:"autodiscover"`
 	OverwritePipelines bool                 `config:"overwrite_pipelines"`
+	LogsToMetrics      *common.Config       `config:"logs_to_metrics"`
 }
 
 type Registry struct {
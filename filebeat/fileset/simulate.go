@@ -0,0 +1,78 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileset
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// SimulatePipelines loads the ingest pipelines of the fileset and runs the
+// documents through them with the simulate API of Elasticsearch. It returns
+// the resulting documents, the documents that failed only have an `error` key.
+func (fs *Fileset) SimulatePipelines(esClient PipelineLoader, docs []common.MapStr) ([]common.MapStr, error) {
+	pipelines, err := fs.GetPipelines(esClient.GetVersion())
+	if err != nil {
+		return nil, fmt.Errorf("Error getting pipeline for fileset %s: %v", fs, err)
+	}
+	if len(pipelines) == 0 {
+		return nil, fmt.Errorf("fileset %s has no ingest pipeline", fs)
+	}
+
+	// Pipelines are loaded so the entry point can call the other ones
+	for _, pipeline := range pipelines {
+		err = loadPipeline(esClient, pipeline.id, pipeline.contents, true)
+		if err != nil {
+			return nil, fmt.Errorf("Error loading pipeline for fileset %s: %v", fs, err)
+		}
+	}
+
+	sources := make([]common.MapStr, len(docs))
+	for i, doc := range docs {
+		sources[i] = common.MapStr{"_source": doc}
+	}
+	path := makeIngestPipelinePath(pipelines[0].id) + "/_simulate"
+	_, body, err := esClient.Request("POST", path, "", nil, common.MapStr{"docs": sources})
+	if err != nil {
+		return nil, fmt.Errorf("Error simulating pipeline for fileset %s: %v. Response body: %s", fs, err, body)
+	}
+
+	var response struct {
+		Docs []struct {
+			Doc struct {
+				Source common.MapStr `json:"_source"`
+			} `json:"doc"`
+			Error common.MapStr `json:"error"`
+		} `json:"docs"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("Error decoding the simulate response: %v", err)
+	}
+
+	results := make([]common.MapStr, len(response.Docs))
+	for i, doc := range response.Docs {
+		if doc.Error != nil {
+			results[i] = common.MapStr{"error": doc.Error}
+			continue
+		}
+		results[i] = doc.Doc.Source
+	}
+	return results, nil
+}
@@ -43,7 +43,12 @@ func Generate(module, fileset, modulesPath, beatsPath string) error {
 
 	replace := map[string]string{"module": module, "fileset": fileset}
 	templatesPath := path.Join(beatsPath, "scripts", "fileset")
-	filesToCopy := []string{path.Join("config", "config.yml"), path.Join("ingest", "pipeline.json"), "manifest.yml"}
+	filesToCopy := []string{
+		path.Join("config", "config.yml"),
+		path.Join("ingest", "pipeline.json"),
+		path.Join("test", "test.log"),
+		"manifest.yml",
+	}
 	err = generator.CopyTemplates(templatesPath, filesetPath, filesToCopy, replace)
 	if err != nil {
 		return err
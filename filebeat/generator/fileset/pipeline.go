@@ -0,0 +1,228 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package fileset
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/elastic/beats/v7/filebeat/generator"
+	fs "github.com/elastic/beats/v7/filebeat/fileset"
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/version"
+)
+
+// volatileKeys are removed from the documents before comparing them with the
+// golden files, as the system tests of the modules do.
+var volatileKeys = []string{"event.created", "ecs.version", "agent.version", "log.file.path"}
+
+// TestPipeline runs the sample logs of a fileset, the `.log` files in its test
+// directory, through its ingest pipelines in Elasticsearch, and compares the
+// resulting documents with the `-expected.json` golden files next to them.
+// If update is set, the golden files are written instead.
+func TestPipeline(module, fileset, modulesPath string, esClient fs.PipelineLoader, update bool) error {
+	filesetPath := path.Join(modulesPath, "module", module, fileset)
+	if !generator.DirExists(filesetPath) {
+		return fmt.Errorf("fileset does not exist: %s", fileset)
+	}
+
+	samples, err := filepath.Glob(path.Join(filesetPath, "test", "*.log"))
+	if err != nil {
+		return err
+	}
+	if len(samples) == 0 {
+		return fmt.Errorf("no sample logs found in %s", path.Join(filesetPath, "test"))
+	}
+
+	f, err := fs.New(path.Join(modulesPath, "module"), fileset, &fs.ModuleConfig{Module: module}, &fs.FilesetConfig{})
+	if err != nil {
+		return err
+	}
+	err = f.Read(beat.Info{Beat: "filebeat", IndexPrefix: "filebeat", Version: version.GetDefaultVersion()})
+	if err != nil {
+		return fmt.Errorf("error reading fileset %s/%s: %v", module, fileset, err)
+	}
+
+	var failed []string
+	for _, sample := range samples {
+		docs, err := readSample(sample, module, fileset)
+		if err != nil {
+			return fmt.Errorf("error reading sample %s: %v", sample, err)
+		}
+
+		results, err := f.SimulatePipelines(esClient, docs)
+		if err != nil {
+			return err
+		}
+		for i := range results {
+			results[i] = goldenDoc(results[i])
+		}
+
+		golden := sample + "-expected.json"
+		if update {
+			if err := writeGolden(golden, results); err != nil {
+				return err
+			}
+			fmt.Printf("Updated %s\n", golden)
+			continue
+		}
+
+		diffs, err := compareGolden(golden, results)
+		if err != nil {
+			return err
+		}
+		for _, diff := range diffs {
+			fmt.Printf("%s: %s\n", filepath.Base(sample), diff)
+		}
+		if len(diffs) > 0 {
+			failed = append(failed, filepath.Base(sample))
+			continue
+		}
+		fmt.Printf("%s: %d documents match\n", filepath.Base(sample), len(results))
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("documents don't match the golden files for %v", failed)
+	}
+	return nil
+}
+
+// readSample reads the lines of a sample log as the documents Filebeat would
+// send for the fileset.
+func readSample(sample, module, fileset string) ([]common.MapStr, error) {
+	f, err := os.Open(sample)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var docs []common.MapStr
+	var offset int64
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			docs = append(docs, common.MapStr{
+				"message": string(bytes.TrimRight(line, "\r\n")),
+				"event": common.MapStr{
+					"module":  module,
+					"dataset": module + "." + fileset,
+				},
+				"fileset": common.MapStr{"name": fileset},
+				"input":   common.MapStr{"type": "log"},
+				"log":     common.MapStr{"offset": offset},
+				"service": common.MapStr{"type": module},
+			})
+			offset += int64(len(line))
+		}
+		if err != nil {
+			break
+		}
+	}
+	return docs, nil
+}
+
+// goldenDoc flattens a document and removes its volatile keys, the golden
+// files use the same format as the ones of the system tests.
+func goldenDoc(doc common.MapStr) common.MapStr {
+	flat := doc.Flatten()
+	for _, key := range volatileKeys {
+		delete(flat, key)
+	}
+	return flat
+}
+
+func writeGolden(golden string, docs []common.MapStr) error {
+	if docs == nil {
+		docs = []common.MapStr{}
+	}
+	d, err := json.MarshalIndent(docs, "", "    ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(golden, append(d, '\n'), 0644)
+}
+
+// compareGolden returns the differences between the documents and the ones in
+// the golden file.
+func compareGolden(golden string, docs []common.MapStr) ([]string, error) {
+	d, err := ioutil.ReadFile(golden)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read golden file, it can be created with --update-golden: %v", err)
+	}
+	var expected []map[string]interface{}
+	if err := json.Unmarshal(d, &expected); err != nil {
+		return nil, fmt.Errorf("cannot decode golden file %s: %v", golden, err)
+	}
+
+	// Round trip the documents so their values have the same types as the expected ones
+	d, err = json.Marshal(docs)
+	if err != nil {
+		return nil, err
+	}
+	var actual []map[string]interface{}
+	if err := json.Unmarshal(d, &actual); err != nil {
+		return nil, err
+	}
+
+	if len(expected) != len(actual) {
+		return []string{fmt.Sprintf("expected %d documents, got %d", len(expected), len(actual))}, nil
+	}
+
+	var diffs []string
+	for i := range expected {
+		for _, key := range volatileKeys {
+			delete(expected[i], key)
+		}
+		keys := map[string]struct{}{}
+		for k := range expected[i] {
+			keys[k] = struct{}{}
+		}
+		for k := range actual[i] {
+			keys[k] = struct{}{}
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			sorted = append(sorted, k)
+		}
+		sort.Strings(sorted)
+
+		for _, k := range sorted {
+			e, eok := expected[i][k]
+			a, aok := actual[i][k]
+			switch {
+			case !aok:
+				diffs = append(diffs, fmt.Sprintf("document %d: missing %s, expected %v", i, k, e))
+			case !eok:
+				diffs = append(diffs, fmt.Sprintf("document %d: unexpected %s: %v", i, k, a))
+			case !reflect.DeepEqual(e, a):
+				diffs = append(diffs, fmt.Sprintf("document %d: %s is %v, expected %v", i, k, a, e))
+			}
+		}
+	}
+	return diffs, nil
+}
@@ -0,0 +1,125 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package fileset
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
+)
+
+func TestTestPipeline(t *testing.T) {
+	modulesPath, err := ioutil.TempDir("", "modules")
+	require.NoError(t, err)
+	defer os.RemoveAll(modulesPath)
+
+	filesetPath := filepath.Join(modulesPath, "module", "mod", "fls")
+	require.NoError(t, os.MkdirAll(filepath.Join(filesetPath, "test"), 0750))
+	require.NoError(t, os.MkdirAll(filepath.Join(filesetPath, "ingest"), 0750))
+	writeFile(t, filepath.Join(filesetPath, "manifest.yml"), "module_version: 1.0\ningest_pipeline: ingest/pipeline.json\n")
+	writeFile(t, filepath.Join(filesetPath, "ingest", "pipeline.json"), `{"processors": []}`)
+	writeFile(t, filepath.Join(filesetPath, "test", "test.log"), "first line\nsecond line\n")
+
+	// The fake simulate API upper cases the messages
+	var upper bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.Path, "/_simulate") {
+			w.Write([]byte(`{"version":{"number":"7.9.0"}}`))
+			return
+		}
+
+		var request struct {
+			Docs []map[string]map[string]interface{} `json:"docs"`
+		}
+		json.NewDecoder(r.Body).Decode(&request)
+		var docs []interface{}
+		for _, doc := range request.Docs {
+			source := doc["_source"]
+			if upper {
+				source["message"] = strings.ToUpper(source["message"].(string))
+			}
+			docs = append(docs, map[string]interface{}{"doc": map[string]interface{}{"_source": source}})
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"docs": docs})
+	}))
+	defer server.Close()
+
+	esClient, err := eslegclient.NewConnection(eslegclient.ConnectionSettings{
+		URL:     server.URL,
+		Timeout: 10 * time.Second,
+	})
+	require.NoError(t, err)
+	require.NoError(t, esClient.Connect())
+
+	golden := filepath.Join(filesetPath, "test", "test.log-expected.json")
+	err = TestPipeline("mod", "fls", modulesPath, esClient, false)
+	assert.Error(t, err, "golden file doesn't exist")
+
+	err = TestPipeline("mod", "fls", modulesPath, esClient, true)
+	require.NoError(t, err)
+
+	var expected []map[string]interface{}
+	d, err := ioutil.ReadFile(golden)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(d, &expected))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"message":       "first line",
+			"event.module":  "mod",
+			"event.dataset": "mod.fls",
+			"fileset.name":  "fls",
+			"input.type":    "log",
+			"log.offset":    float64(0),
+			"service.type":  "mod",
+		},
+		{
+			"message":       "second line",
+			"event.module":  "mod",
+			"event.dataset": "mod.fls",
+			"fileset.name":  "fls",
+			"input.type":    "log",
+			"log.offset":    float64(11),
+			"service.type":  "mod",
+		},
+	}, expected)
+
+	err = TestPipeline("mod", "fls", modulesPath, esClient, false)
+	assert.NoError(t, err)
+
+	upper = true
+	err = TestPipeline("mod", "fls", modulesPath, esClient, false)
+	assert.Error(t, err)
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+}
@@ -32,6 +32,9 @@ var (
 )
 
 type inputConfig struct {
+	// ID identifies the input, inputs with an ID can be paused and resumed
+	// through the control API.
+	ID            string        `config:"id"`
 	ScanFrequency time.Duration `config:"scan_frequency" validate:"min=0,nonzero"`
 	Type          string        `config:"type"`
 	InputType     string        `config:"input_type"`
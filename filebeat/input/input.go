@@ -25,6 +25,7 @@ import (
 	"github.com/elastic/beats/v7/filebeat/channel"
 	"github.com/elastic/beats/v7/filebeat/input/file"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/control"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
@@ -52,6 +53,9 @@ type Runner struct {
 	wg       *sync.WaitGroup
 	Once     bool
 	beatDone chan struct{}
+
+	// pauser blocks the events of inputs with an ID while paused
+	pauser *channel.Pauser
 }
 
 // New instantiates a new Runner
@@ -75,6 +79,11 @@ func New(
 		return nil, err
 	}
 
+	if input.config.ID != "" {
+		input.pauser = channel.NewPauser()
+		connector = channel.PausableConnector(connector, input.pauser)
+	}
+
 	var f Factory
 	f, err = GetFactory(input.config.Type)
 	if err != nil {
@@ -110,6 +119,9 @@ func (p *Runner) Start() {
 
 	onceWg.Add(1)
 	inputList.Add(p.config.Type)
+	if p.pauser != nil {
+		control.Pausables.Register(p.config.ID, p)
+	}
 	// Add waitgroup to make sure input is finished
 	go func() {
 		defer func() {
@@ -125,7 +137,9 @@ func (p *Runner) Start() {
 // Run starts scanning through all the file paths and fetch the related files. Start a harvester for each file
 func (p *Runner) Run() {
 	// Initial input run
-	p.input.Run()
+	if !p.Paused() {
+		p.input.Run()
+	}
 
 	// Shuts down after the first complete run of all input
 	if p.Once {
@@ -138,6 +152,10 @@ func (p *Runner) Run() {
 			logp.Info("input ticker stopped")
 			return
 		case <-time.After(p.config.ScanFrequency):
+			if p.Paused() {
+				logp.Debug("input", "Input %s paused, skipping run", p.config.ID)
+				continue
+			}
 			logp.Debug("input", "Run input")
 			p.input.Run()
 		}
@@ -150,6 +168,25 @@ func (p *Runner) Stop() {
 	close(p.done)
 	p.wg.Wait()
 	inputList.Remove(p.config.Type)
+	if p.pauser != nil {
+		control.Pausables.Unregister(p.config.ID, p)
+	}
+}
+
+// Pause stops the input from scanning and blocks the events of its
+// harvesters, which keep their state until the input is resumed.
+func (p *Runner) Pause() {
+	p.pauser.Pause()
+}
+
+// Resume resumes a paused input.
+func (p *Runner) Resume() {
+	p.pauser.Resume()
+}
+
+// Paused returns true if the input is paused.
+func (p *Runner) Paused() bool {
+	return p.pauser != nil && p.pauser.Paused()
 }
 
 func (p *Runner) stop() {
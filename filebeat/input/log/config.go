@@ -59,6 +59,7 @@ var (
 		BufferSize:     16 * humanize.KiByte,
 		MaxBytes:       10 * humanize.MiByte,
 		LineTerminator: readfile.AutoLineTerminator,
+		Sampling:       readfile.DefaultSampleConfig(),
 		LogConfig: LogConfig{
 			Backoff:       1 * time.Second,
 			BackoffFactor: 2,
@@ -98,12 +99,14 @@ type config struct {
 	ScanOrder  string `config:"scan.order"`
 	ScanSort   string `config:"scan.sort"`
 
-	LineTerminator readfile.LineTerminator `config:"line_terminator"`
-	ExcludeLines   []match.Matcher         `config:"exclude_lines"`
-	IncludeLines   []match.Matcher         `config:"include_lines"`
-	MaxBytes       int                     `config:"max_bytes" validate:"min=0,nonzero"`
-	Multiline      *multiline.Config       `config:"multiline"`
-	JSON           *readjson.Config        `config:"json"`
+	LineTerminator readfile.LineTerminator  `config:"line_terminator"`
+	ExcludeLines   []match.Matcher          `config:"exclude_lines"`
+	IncludeLines   []match.Matcher          `config:"include_lines"`
+	MaxBytes       int                      `config:"max_bytes" validate:"min=0,nonzero"`
+	Multiline      *multiline.Config        `config:"multiline"`
+	JSON           *readjson.Config         `config:"json"`
+	Original       *readfile.OriginalConfig `config:"preserve_original"`
+	Sampling       readfile.SampleConfig    `config:"sampling"`
 
 	// Hidden on purpose, used by the docker input:
 	DockerJSON *struct {
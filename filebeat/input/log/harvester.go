@@ -65,6 +65,10 @@ var (
 	harvesterRunning   = monitoring.NewInt(harvesterMetrics, "running")
 	harvesterOpenFiles = monitoring.NewInt(harvesterMetrics, "open_files")
 
+	// Lines dropped by sampling, per severity level.
+	sampledOutMetrics = harvesterMetrics.NewRegistry("sampled_out")
+	sampledOut        = map[string]*monitoring.Int{}
+
 	ErrFileTruncate = errors.New("detected file being truncated")
 	ErrRenamed      = errors.New("file was renamed")
 	ErrRemoved      = errors.New("file was removed")
@@ -72,6 +76,12 @@ var (
 	ErrClosed       = errors.New("reader closed")
 )
 
+func init() {
+	for _, level := range readfile.SampleLevels {
+		sampledOut[level] = monitoring.NewInt(sampledOutMetrics, level)
+	}
+}
+
 // OutletFactory provides an outlet for the harvester
 type OutletFactory func() channel.Outleter
 
@@ -653,6 +663,12 @@ func (h *Harvester) newLogFileReader() (reader.Reader, error) {
 		return nil, err
 	}
 
+	// Without multiline, originals are preserved before lines are decoded
+	preserveOriginal := h.config.Original != nil && h.config.Original.Enabled
+	if preserveOriginal && h.config.Multiline == nil {
+		r = readfile.NewOriginalReader(r, *h.config.Original)
+	}
+
 	if h.config.DockerJSON != nil {
 		// Docker json-file format, add custom parsing to the pipeline
 		r = readjson.New(r, h.config.DockerJSON.Stream, h.config.DockerJSON.Partial, h.config.DockerJSON.Format, h.config.DockerJSON.CRIFlags)
@@ -669,6 +685,19 @@ func (h *Harvester) newLogFileReader() (reader.Reader, error) {
 		if err != nil {
 			return nil, err
 		}
+
+		// With multiline, the original is the complete message
+		if preserveOriginal {
+			r = readfile.NewOriginalReader(r, *h.config.Original)
+		}
+	}
+
+	// Lines are sampled once complete, so multiline events are kept or
+	// dropped as a whole.
+	if h.config.Sampling.Enabled {
+		r = readfile.NewSampleReader(r, h.config.Sampling, func(level string) {
+			sampledOut[level].Inc()
+		})
 	}
 
 	return readfile.NewLimitReader(r, h.config.MaxBytes), nil
@@ -0,0 +1,57 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logmetrics
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/conditions"
+)
+
+type config struct {
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+	Rules  []ruleConfig  `config:"rules" validate:"required"`
+}
+
+type ruleConfig struct {
+	Name      string             `config:"name" validate:"required"`
+	When      *conditions.Config `config:"when"`
+	Labels    []string           `config:"labels"`
+	Histogram *histogramConfig   `config:"histogram"`
+	Drop      bool               `config:"drop"`
+}
+
+type histogramConfig struct {
+	Field   string    `config:"field" validate:"required"`
+	Buckets []float64 `config:"buckets" validate:"required"`
+}
+
+func defaultConfig() config {
+	return config{
+		Period: 1 * time.Minute,
+	}
+}
+
+func (c *histogramConfig) Validate() error {
+	if !sort.Float64sAreSorted(c.Buckets) {
+		return errors.New("histogram buckets must be sorted in increasing order")
+	}
+	return nil
+}
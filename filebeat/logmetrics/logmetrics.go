@@ -0,0 +1,282 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package logmetrics aggregates log events into metrics. Events are matched
+// against a set of rules, and the number of matching events, and optionally
+// an histogram of one of their fields, are periodically published as metric
+// events, grouped by the values of the labels of each rule.
+package logmetrics
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/conditions"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+var (
+	metricsRegistry = monitoring.Default.NewRegistry("filebeat.logs_to_metrics")
+	matchedEvents   = monitoring.NewInt(metricsRegistry, "events.matched")
+	droppedEvents   = monitoring.NewInt(metricsRegistry, "events.dropped")
+	publishedEvents = monitoring.NewInt(metricsRegistry, "metrics.published")
+)
+
+// Aggregator is a processor counting the events matching its rules. Metric
+// events with the aggregated values are published every period once the
+// aggregator is started.
+type Aggregator struct {
+	log    *logp.Logger
+	period time.Duration
+	rules  []*rule
+
+	mu     sync.Mutex
+	series map[string]*series
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+type rule struct {
+	name      string
+	condition conditions.Condition
+	labels    []string
+	histogram *histogramConfig
+	drop      bool
+}
+
+type series struct {
+	rule   *rule
+	labels common.MapStr
+	count  int64
+	sum    float64
+	counts []int64
+}
+
+// New creates a new aggregator from its configuration.
+func New(cfg *common.Config) (*Aggregator, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("failed to unpack logs_to_metrics config: %v", err)
+	}
+
+	rules := make([]*rule, 0, len(config.Rules))
+	for _, rc := range config.Rules {
+		r := &rule{
+			name:      rc.Name,
+			labels:    rc.Labels,
+			histogram: rc.Histogram,
+			drop:      rc.Drop,
+		}
+		if rc.When != nil {
+			condition, err := conditions.NewCondition(rc.When)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create condition of rule %s: %v", rc.Name, err)
+			}
+			r.condition = condition
+		}
+		rules = append(rules, r)
+	}
+
+	return &Aggregator{
+		log:    logp.NewLogger("logs_to_metrics"),
+		period: config.Period,
+		rules:  rules,
+		series: map[string]*series{},
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Run records the event in the series of all the rules it matches. The event
+// is dropped if any of the matching rules is configured to drop events.
+func (a *Aggregator) Run(event *beat.Event) (*beat.Event, error) {
+	drop := false
+	for _, r := range a.rules {
+		if r.condition != nil && !r.condition.Check(event) {
+			continue
+		}
+		a.record(r, event)
+		matchedEvents.Inc()
+		drop = drop || r.drop
+	}
+
+	if drop {
+		droppedEvents.Inc()
+		return nil, nil
+	}
+	return event, nil
+}
+
+func (a *Aggregator) record(r *rule, event *beat.Event) {
+	labels := common.MapStr{}
+	values := make([]string, len(r.labels))
+	for i, field := range r.labels {
+		v, err := event.GetValue(field)
+		if err != nil {
+			continue
+		}
+		labels.Put(field, v)
+		values[i] = fmt.Sprint(v)
+	}
+	key := r.name + "\x00" + strings.Join(values, "\x00")
+
+	var value float64
+	var hasValue bool
+	if r.histogram != nil {
+		if v, err := event.GetValue(r.histogram.Field); err == nil {
+			value, hasValue = toFloat(v)
+		}
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	s, found := a.series[key]
+	if !found {
+		s = &series{rule: r, labels: labels}
+		if r.histogram != nil {
+			s.counts = make([]int64, len(r.histogram.Buckets))
+		}
+		a.series[key] = s
+	}
+
+	s.count++
+	if hasValue {
+		s.sum += value
+		s.counts[bucket(r.histogram.Buckets, value)]++
+	}
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	if f, ok := common.TryToFloat64(v); ok {
+		return f, true
+	}
+	if i, ok := common.TryToInt(v); ok {
+		return float64(i), true
+	}
+	return 0, false
+}
+
+// bucket returns the index of the first bucket whose bound is greater or
+// equal than the value. Values greater than all bounds go to the last bucket.
+func bucket(buckets []float64, value float64) int {
+	for i, bound := range buckets {
+		if value <= bound {
+			return i
+		}
+	}
+	return len(buckets) - 1
+}
+
+// Start starts publishing the aggregated metrics every period.
+func (a *Aggregator) Start(pipeline beat.PipelineConnector) error {
+	client, err := pipeline.Connect()
+	if err != nil {
+		return fmt.Errorf("failed to connect logs_to_metrics to the pipeline: %v", err)
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+		defer client.Close()
+
+		ticker := time.NewTicker(a.period)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-a.done:
+				a.publish(client)
+				return
+			case <-ticker.C:
+				a.publish(client)
+			}
+		}
+	}()
+	return nil
+}
+
+// Stop publishes the pending metrics and stops the aggregator.
+func (a *Aggregator) Stop() {
+	close(a.done)
+	a.wg.Wait()
+}
+
+func (a *Aggregator) publish(client beat.Client) {
+	events := a.collect(time.Now())
+	if len(events) == 0 {
+		return
+	}
+
+	a.log.Debugf("Publishing %d metric events", len(events))
+	client.PublishAll(events)
+	publishedEvents.Add(int64(len(events)))
+}
+
+// collect returns the metric events for the current period and resets all
+// series.
+func (a *Aggregator) collect(now time.Time) []beat.Event {
+	a.mu.Lock()
+	current := a.series
+	a.series = map[string]*series{}
+	a.mu.Unlock()
+
+	events := make([]beat.Event, 0, len(current))
+	for _, s := range current {
+		metrics := common.MapStr{
+			"rule":   s.rule.name,
+			"count":  s.count,
+			"period": a.period.Milliseconds(),
+		}
+		if len(s.labels) != 0 {
+			metrics["labels"] = s.labels
+		}
+		if s.rule.histogram != nil {
+			metrics["histogram"] = common.MapStr{
+				"field":  s.rule.histogram.Field,
+				"sum":    s.sum,
+				"values": s.rule.histogram.Buckets,
+				"counts": s.counts,
+			}
+		}
+
+		events = append(events, beat.Event{
+			Timestamp: now,
+			Fields: common.MapStr{
+				"event": common.MapStr{
+					"kind":    "metric",
+					"dataset": "logs_to_metrics",
+				},
+				"logs_to_metrics": metrics,
+			},
+		})
+	}
+	return events
+}
+
+func (a *Aggregator) String() string {
+	names := make([]string, len(a.rules))
+	for i, r := range a.rules {
+		names[i] = r.name
+	}
+	return fmt.Sprintf("logs_to_metrics=[period=%v, rules=%v]", a.period, strings.Join(names, ","))
+}
@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logmetrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestAggregator(t *testing.T) {
+	a, err := New(common.MustNewConfigFrom(common.MapStr{
+		"rules": []common.MapStr{
+			{
+				"name":   "http_5xx",
+				"when":   common.MapStr{"range.http.response.status_code.gte": 500},
+				"labels": []string{"service.name"},
+				"drop":   true,
+			},
+			{
+				"name": "response_bytes",
+				"histogram": common.MapStr{
+					"field":   "http.response.body.bytes",
+					"buckets": []float64{100, 1000},
+				},
+			},
+		},
+	}))
+	require.NoError(t, err)
+
+	events := []common.MapStr{
+		{"service.name": "api", "http.response.status_code": 200, "http.response.body.bytes": 50},
+		{"service.name": "api", "http.response.status_code": 503, "http.response.body.bytes": 500},
+		{"service.name": "api", "http.response.status_code": 500, "http.response.body.bytes": 2000},
+		{"service.name": "web", "http.response.status_code": 502},
+	}
+
+	var published int
+	for _, fields := range events {
+		event := &beat.Event{Fields: common.MapStr{}}
+		for k, v := range fields {
+			event.PutValue(k, v)
+		}
+
+		out, err := a.Run(event)
+		require.NoError(t, err)
+		if out != nil {
+			published++
+		}
+	}
+	assert.Equal(t, 1, published)
+
+	metrics := map[string]common.MapStr{}
+	for _, event := range a.collect(time.Now()) {
+		m, err := event.GetValue("logs_to_metrics")
+		require.NoError(t, err)
+		mapstr := m.(common.MapStr)
+		name := mapstr["rule"].(string)
+		if labels, ok := mapstr["labels"]; ok {
+			service, _ := labels.(common.MapStr).GetValue("service.name")
+			name += "/" + service.(string)
+		}
+		metrics[name] = mapstr
+	}
+
+	require.Len(t, metrics, 3)
+	assert.Equal(t, int64(2), metrics["http_5xx/api"]["count"])
+	assert.Equal(t, int64(1), metrics["http_5xx/web"]["count"])
+
+	histogram := metrics["response_bytes"]
+	assert.Equal(t, int64(4), histogram["count"])
+	assert.Equal(t, common.MapStr{
+		"field":  "http.response.body.bytes",
+		"sum":    float64(2550),
+		"values": []float64{100, 1000},
+		"counts": []int64{1, 2},
+	}, histogram["histogram"])
+
+	// Series are reset after being collected
+	assert.Len(t, a.collect(time.Now()), 0)
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of fields.yml.
 func AssetFieldsYml() string {
-	return "eJzsvXtTHLmSOPr/fApdNuKHOdsUD4ONuXcjfgwwM8TamDH4zJ5Zb9DqKnW3DlVSjaQC92zsd7+hTEmlegCNTfkxy5zdGbq7SkqlUql857+Q3w7enZ6c/vz/kCNJhDSEZdwQM+eaTHnOSMYVS02+GBFuyA3VZMYEU9SwjEwWxMwZOT48J6WS/2SpGf3wL2RCNcuIFPD9NVOaS0G2kt1kM/nhX8hZzqhm5JprbsjcmFLvb2zMuJlXkySVxQbLqTY83WCpJkYSXc1mTBuSzqmYMfjKDjvlLM908sMP6+SKLfYJS/UPhBhucrZvH/iBkIzpVPHScCngK/KTe4e4t/d/IGSdCFqwfbL6fw0vmDa0KFd/IISQnF2zfJ+kUjH4rNgfFVcs2ydGVfiVWZRsn2TU4MfGfKtH1LANOya5mTMBaGLXTBgiFZ9xYdGX/ADvEXJhcc01PJSF99hHo2hq0TxVsqhHGNmJeUrzfEEUKxXTTBguZjCRG7GernfDtKxUysL8J9PoBfyNzKkmQnpocxLQM0LSuKZ5xQDoAEwpyyq307hh3WRTrrSB91tgKZYyfl1DVfKS5VzUcL1zOMf9IlOpCM1zHEEnuE/sIy1Ku+mr25tbL9Y3d9e3n19s7u1v7u4/30n2dp//vhptc04nLNe9G4y7KSeWiuEL/PMSv79iixupsp6NPqy0kYV9YANxUlKudFjDIRVkwkhlj4SRhGYZKZihhIupVAW1g9jv3ZrI+VxWeQbHMJXCUC6IYNpuHYID5Gv/Ochz3ANNqGJEG2kRRbWHNABw7BE0zmR6xdSYUJGR8dWeHjt0dDD53yu0LHOeAnQr+2RlKuX6hKqVEVlh4tp+UyqZVSn8/j8xggumNZ2xOzBs2EfTg8afpCK5nDlEAD24sdzuO3TgT/ZJ9/OIyNLwgv8Z6M7SyTVnN/ZMcEEoPG2/YCpgxU6njapSU1m85XKmyQ03c1kZQkVN9g0YRkSaOVOOfZAUtzaVIqWGiYjyjbRAFISSeVVQsa4YzegkZ0RXRUHVgsjoxMXHsKhyw8s8rF0T9pFre+TnbFFPWEy4YBnhwkgiRXi6vZG/sDyX5Dep8izaIkNnd52AmNL5TEjFLulEXrN9srW5vdPduddcG7se954OpG7ojDCazv0qmzT2nzEJIV1tr/xXTEp0xgRSimPrB+GLmZJVuU+2e+joYs7wzbBL7hg55koJndhNRjY4NTf29FgGauwFN3VbQcXC4pzaU5jn9tyNSMYM/iEVkRPN1LXdHiRXaclsLu1OSUUMvWKaFIzqSrHCPuCGDY+1T6cmXKR5lTHyI6OWD8BaNSnogtBcS6IqYd928yqdwI0GC03+5pbqhtRzyyQnrObHQNkWfspz7WkPkaQqIew5kYggC1u0PuWGvJkzFXPvOS1LZinQLhZOalgqcHaLAOGocSqlEdLYPfeL3ScnOF1qJQE5xUXDubUHcVTDl1hSIE4SmTBqkuj8Hpy9AZnE3ZzNBbkdp2W5YZfCU5aQmjZi7ptJ5lEHbBcEDcKnSC1cE3u/EjNXsprNyR8Vq+z4eqENKzTJ+RUj/06nV3RE3rGMI32USqZMay5mflPc47pK55ZLv5YzbaieE1wHOQd0O5ThQQQiRxQGcaU+Haycs4Ipml9yz3XceWYfDRNZzYs6p/rWc90+S8d+DsIze0SmnCkkH64dIp/xKXAgYFN6LdC1F2rsVaYKEA+8BEdTJbW9/bWhyp6nSWXIGLebZ2PYD7sTDhkR09ijO9Pdzc1pAxHt5Qd29llLfy/4H1a+efi6w31rSRQJG967gYt9wgiQMc9uXV7WWJ799xALdGILnK+YI3R2UBOKTyE7xCtoxq8ZyC1UuNfwaffznOXltMrtIbKH2q0wDGxuJPnJHWjChTZUpE6OafEjbScGpmSJxF2npL5OWUkVnOIwNtdEMJahAnIz5+m8O1U42aks7GRWvo7WfTK1kq/nPLBUZEn+Kzk1TJCcTQ1hRWkW3a2cStnYRbtRQ+zixaK8Y/s8t7MTEG3oQhOa39j/BNxaWVDPPWnitjpxHN+1t3lSo0YEnh2wWj+LJO6mmLD6EbjC+LSx8fWOtQmgsfkFTedWJ+iiOB7H49lpmwOg+u9Oj20iuwXTi2Qz2VxX6XYsxuiGDFMZKWQhK03O4Uq4R545EITWr+AtQp4dnK/hwXTSiQMslUIw0BhPhGFKMEPOlDQylbmD9NnJ2RpRsgJ9sVRsyj8yTSqRMbzIrbCkZG4Hs9xNKlJIxYhg5kaqKyJLq0dKZQUer+SxOc2n9gVK7H2XM0KzgguujT2Z1164smNlskBJjBri9FZcRFFIMSJpzqjKFwH7UxByA7Qy5+kCBMs5s6IvLDBZ+sIUVTEJAs1dV2Uuw63d2Ap3JeA4VhGVKQhXDqLONjl5I3wdCN7tohvo2cH56RqpYPB8Ud84GoXngHo8EyeNdUekt7W79eJVY8FSzajgfwJ7TLrXyOeICaCmXMZYjlid1+9IV+UjIGOpQu+TKc11fSNkbEqr3OCQzR8be/A2WhPM18HDz1JaGnz9+jA6g2nOW7rEYf3NHcrEgXvTHjZPj1Q7AuSG27OApO+3yR1BC95UempzSoJiM6oyEB6tbCiFHkXPo+A44Whu49Jqn9Nc3hDFUqtXNVTXi8MzNyreTDWYHdjsF/bxCDI4gJqJoDLYZ87/cUpKml4x80yvJTALarulYyGdqdCsZEW7xqRe11FgM2PawuGkcY8lo6jQFIBJyLksWJCPK416hmGqICveVibVSq1ZKzb13MqBIloL1Hj03M9OD8SdnbCgB4EeGCHAHUsLlpj5ba6niOFHjdYRkZ/A3l6VrixC3Ki1AsaFBe+flcANAH0MNSxvyewZrMavkKYzpBWscL/W4UR7E1IwPOF4G36eYCqEw4OiGs0yollBheEp8H720Tipjn1EeX2EQpTnCDrIdkaSa26Xy/9ktXJtF8oUKNyam4q67TiZkoWsVJhjSvPcE5+/ESw3nUm1GNlHvVCiDc9zwoRVLx3don3SCi4Z08aSh0WpRdiU53lgaLQslSwVp4bliwcoVjTLFNN6KJ0KqB21aEdbbkIn/wQ2U0z4rJKVzhdIzfBOYJg3Fi1aFgzssiTnGuxWJ2cjQv09KxWh9mL5SLS0dJIQ8o8as05MA8Nhza/njCh642HydD9O3BdjRFlTyhRWCa+FyKxC2yFejeOEl2MLyjhBsMYjkrGSicyJ+SijS1EDASq927Faikr+113gVCdPd3gE1WRhmL5HtI/2Hi08zdcagPxof0DrTvCwuDPpSAJZZ3er9nYagCFhD6B0OB6O4yeNOWdMJik3i8uBDASHVmbv3Z03VkdgNO+CI4XhggkzFEynkbEiTNaB71QqMycHBVM8pT1AVsKoxSXX8jKV2SCowynIyflbYqfoQHh4cCtYQ+2mA6l3Qw+poFkXU8Ae71emZ0xelpKHu6npHJBixk2V4X2dUwMfOhCs/jdZycHVtP7yefJia2fv+eaIrOTUrOyTnd1kd3P31dYe+Z/VDpCPyxNbNkDN1Lq/j6OfUOL36BkRZwNBKUxOyUxRUeVUcbOIL9YFSe0FD2JndIEe+nszWJiQwrlCiSpl9sZwwvc0l1K5i2cEFpU5r0Xb+oZC8HJSzhea2z+8hyP1x1pHIJxKE7lxwX/D0e5QwAU5Y9KvtmuHmUhtpFjP0s7eKDbjUgx50t7BDHcdtPVfD2+Da6Cj5mDqPWm/VmzCmoji5T0whAeaxHlyFoQ0zxHhsogpC42x3pDjXYsnZ9c79ouTs+sXtfDZkrcKmg6AmzcHh7dBTRo2b5O08dJ7rG/BzYVVL1FLOjmzEzmdAQNTTg8uggJOnrFkljhrEs1jQwFBbdMbmhqujXBWIp3TKrVgfhQzkkuakQnNqUjh6E65YjdW5QEdX8nKnugWxu2iS6nMwwRcL+Roo3i/1Btjw47/veADddsHyHuNVZ/h258k3W034ejsyTJC5+37ceb24Dbit9xJG6ZYdtknVz7e9WaVmzmfzZk20aQeRzj3CBZSlizzIOtq4sXRsP8/1T4evKai4ZwuOpUKwkiSGcj2SSqLFcI1WYk+t11PGE7jXEoZM0wVcBWXiqVcW10L7CgUtV9wxEIYUTXJeUp0NZ3yj2FEeObZ3Jhyf2MDH8EnrI61lpALtbCUaiQaDj5ye/Xh9TpZEM2LMl8QQ6/qXUVtOafagF8DY2lQMRfSEFD6bliew9ovXh/Vzt+VVCbV1Ur3Lq2R0SAJI8tL2P4vQBFsOrUH+JrZWZ1M4/bwGbt4fbQ2Qm/OlZA3wlvJGmARh/qRN0cCikpak70bD67ILvG05w3DWjzWGALq+b7JBkjmNoqpN2I52oHvG2RTaaaSYSkm1sjQcC0VmoPt5OijKhiYSeT0No5BBXl9dHAGoRC44qMwVEwqq93VsYLyfKDFWfGfwAReZkm6AEyrPO+RJL9Lw4xd8KomdkkwHSgY9JrynE7yrjB7kE+YMuSYC22YI7EGbsDO+tUIEGYfngJxkYPF4HTjUKYu5grX513lYJHcKHNqrATSQ6gI54DqcrwTOFkXiDnV88G0dcQU8B07j+XJqVSKWdG3EfA1RcM4MChBqJBiEYePohAXkcp7zVwwyxhWwTM0aMMHu7pxCDJMpZjiXtG8MScVmb2SakcO8VHBfUQ1SExTh5SCDgZzdqF4PAX5q7G087mVttGqAsGFXHQXHfE0Cjyt4TmWFS4vOI79F7f7jTHRgCDpBf8CDEXAGTpVNAQf12GV6ADCmCSvTkBkErk1jHJK3jCjeIrhTToOn6KCHB9uY/CUpb4pM+mcaTAqRaMTbrSLXK2BtJTbDLhuRM5yHcJymiC4cVUlXEisYoU0IYiHyMponrFopjZkCBMlLmbTL8gTmKhfdQaxZmw4DloPBMGpbnKv8tlhua5BdQh7iIswBXPtcFx/9aJGEM4FQbmx44RnIdDanegFyfh0ylSssIPZj0N4sb0H7TFcN0xQYQgT11xJUTRtRjVtHfx2Hibn2cg7ZYD+ydt3P5OTDEOhIUigajOXroD64sWLly9f7u3tvXrV8nOhiMFzbhaXf9aewMfG6kE0D7HzWKyg+xFoGo5KfYg6zKHS64xqs77VsuC5+LXhyOHExy2eHHnuBbD6Q9gGlK9vbT/f2X3xcu/VJp2kGZtu9kM8oDgQYI4jTLtQR/ZG+LIbKPloEL3xfCCKmbwTjWY7KVjGq6YyXip5zbOlHNGf7eOCs+YnTPzhjPN+6I0eEfpnpdiIzNJyFA6yVCTjM25oLlNGRfemu9GNZaFRfKBFOZv4Jx63+DqWGbvUfCaovTob97LMGDlv/HL7BX0xZ5q1E0Qa4hrcdBMuqFrApCRMqpcPOcTg8HtEqImUOaOiD20/4k8gydIShAWOcZYOFos+F9XT9akZVbHVMOwt8pIHVRtqqsGCXg6yjLuQti6WgdKZstdGakV1BKUnDr1COdyliczstZ2qRWnkTNFyzlPClJIK87g6o17TnGexR86qUarSxs9HXjN6zUgloqgtPIb+1foVfz7r8cOwN1STSqRzll6xnhj/43fv3r67fH968e79+cXx0eW7t28vlt6jCjMSB3JcnePwDYYdSD/wuzoMgKdKajk15FCqUjbC8O9dCqCRLXNf3nE8Vs+NVAzl03gre7aHpPOmyfrvdk8pRPrVr9/2HqRhYeKdD20ageRq+VitNYIo6uKgpMgXzRysyYIYKXONUWwUzAyQFcPSK5RNkQ47JPOwgwzE+pl47ec7aGKBK6XJga6ZsiJfRujMCuGRNjdnNQ8Vpilp9h432kD+PWdpGcTUFwcweUfG4c6Iv7wjDjg82Iz1dFGYnXzeKMOwZKldjQMyQIFE4Ozjzhsnp/EgUXJ4dFfNWV5GVg1QdNCLF4bWToUSC3uzGh7MVsvcWEMaHurF86wp/PGCzgYVRmOhCiYLIUQIkCW0ScVzY/XAHtAMnQ0EWU1ZDi46a5mZo5T1u6ePUtfvSF5vi+kwq8sDb8w74HbUi66jJIIcijQ7lCCKo5OCCjpD5s91TQgdIQpT5iM+EoUcx5zkqPX1HbwkevTu0HRkuNHTEHaEbvGNZuZ4z5hRNPp9cejIflwc+rcYKN2I814qWjrcMq7axCNFS4dhIWr6KVr6KVr6f3e0dHwwfVCNKy3T3q8vFTIds8KnuOmnuOnHAekpbnp5nD3FTT/FTX9PcdPRJfa9BU83QCfDRFDz0s4W3/T3hA2zRrxwqfg1NYwcvfl9rS9iGE4N6CHfVNA0ROlGxhm3UjDZ1LgxkkwWgIkjBiWGHn+FQ4RBP0Bs+3Kx0LfS8tcOiM46EuVTVPRTVPRTVPRTVPRTVPRTVHSb4J6iop+iop+iop+ior9llvbZUdFZjteL9369fg0f7y7Lu0zEFcSb5HyiqOJMk2whaIFqlEe5pJmvfOyKrIJJxv38hoqFq1IXF2l1JaMkWdFzCkmOjXlWXIFcHz6Lhh4fSzepQjV8CPBgBseDWvQ0zz3qpjLP5Q0Xs30Pzd/IES5gPefiys23IM/GSZbn4zVX+M6riFKQ37jI5I2u3z9HcN9iZM6zcaJl33vvBf+4DjJbZ+0dWBpgLHI+6RuwoOnb8+Vdgc2wvOQ7intrQf4UBvfth8G1t+yvExXXWtlTkNxQQXItRD/FzN2CJysxJkW2OxBDfHO0i1M8CB49p1sDAXT+y8HWp0G0vftiOJi2d198GlS7zn47CFS7W9sPg2ogDt3Qdp1w074261KaBS21N3rHPB1aHUlBMq6vusfmiinB8ufbiZd8l1huSc1Qat1PVZ4jxHaSztpbwB/uf3CC5QesOf18+8MnLQgsjCUVi4GWdRLKzuA0nQ0a+WSYjEBrjqLkOVuHGNdHvYhLlkSADb3alov8ExZ7RuM4gvsXZ4e/7K2V/viru24WTn/gyl4kz5NXLzY3k62XO1u7D1ii7+BzCWsdNNHNLfRziPX87ODk9CI5/o/jByzRNdAZel1ums9Z30o4jR8+Hhx7NRf+fhsUVuRNK3cjIFggRKOs/tHp+X0WiJ8asbZ2wqPTc/JHxcDSYAVVKvQNi1p32d9dYrYTWBmHZNdQSrmuee/HWpBScQm2hhkzWEkah3WDPhtnQkOa4z48P15zTXQWfpJ4dLA6+1LMaC6r2xm5EXHaEDqs0VlCdWybcDCgWH3DFKv3Di2nXOM4XSjx1fHaQyKDGyt+9Jj11QNBqFJ04ZGBWHbvo5uIpnMHBtGu6rliplIiMmj6ZniuDFgkMTAC1u0rtnAoq+N1/d7gFmjm+7I1wpEnC3J8eF63zXiHJdxxrLmV4aGtQmwEKOrl4I9+ckFu7FvHh+du+HYEkt1mS34Q9YR+fOxaAr80Q8rtc57MyYEhBRe8qIqR+7K2CrhFFVbjiztoje0sYwscpP53lsF17RsZWWErDEntaCkIK9z4No5Uk1JqzSfob8igIrm9+WltKnFGQx933A8o1STFjjaNOPYWRSZpTgeLWMecfYrROWFDfG5BhhTDofERxpRgYf8Oszw57QU9qtswiIsboI24I0YstDpFusPBKBZN8HF0+GrJRKa97wWyrIFheZTEA/q1dwTtrc3E/18vFoaMW7xoOuEtxUXpyi3QSYll7nWzcRB1xhA5JYenB2+O7YGYMIss+35+zbJRzJxWVzUZo7OkZjEmyl+QwjdekkoxXUqL4mDZiwaBc5mQk8CrhDTe094e0zc3HEN7Bh8sP7Y3D4PGpJ1tubm5SW4Jw/A7Y8wyLufbApUs7iEzB2LIrsFCajk3rBcQ0LsJ3uZE03nM2NkU+FIjz4LrlKqMZQn5nSnpc+gLsNnMXSgqstAaf5MaaThFT1x7P50OWMfgYl7XMPhEFgOk2bQYMJoxdTnNfXPIIczfcGfLKdkmOTOGKeCSODOBmRuFSEpsZVQXO9gnBwcjcnE4Iu+ORuTdwYgcHI3I4dGIHL3tkKz7uE7eHdV/NuPHB3NP2x2yS8PYvdhNTTWYjeuWt0rOFC2QAkOb3oAE+wiIZZhcEw0EWWslr/NxkDnoHg1qe2trq7FuWfbEFT/64p0nSgo0l6MYhemwzhx9xQUE0KEA25BpSWhpGkcvQS9G43FXN4fBwHIcBmVkwAw4CeMxb8XRr++P3/2jgaPAGb+YxODa/LjbAvWSe4WDBgMf8l6EC7EFWnzvBXNaqyCTkGK9VFwY6NeXzim0tFaaPJuwXN6Q59uQeGchIFvbL9ZGEe1L3Xij5uVBQ8J2TEyntLRnimpGtjbhCpnBHB+Ojo7WajH8R5peEZ1TPXca3x+VhKSmMLIbKiEXdKJHJKVKcTpjTnfQKKPmPEq/mzKWxSOkUlwz5YKDP5gR+aDwrQ8C6I85n8aD7tiwzV89FvYp/vWbiX8NRBGQPyQxhElAxastC26BdQvBDol2GYUbaA4qoUusAKCBEYaZRjVqdDXZtuvcShxWgDRGDZzXEDacjF57rcdYGSGJCEmMojyH7oJMcdkv+PYj/Sn6GNnfU/Txg6KPa/r5MgqC05PuFioODg6akrHXVS8/J4fooGOiy3NycmZlOAa1wMaxaWPcsjH4H8fe1Odoh0+nPK1ysCBVmo3IhKW00sEyfU0VZ2bhlaOYUAtqtFUK7VAOrIQcfzTKt/wD+KIKAx5Qg+3PJQGraISccS2uQst3boI5C3slZOyjfbuwVBIPjSIBvgS/M6o5hKiFEevmeiipWOF2Krt1FYN20zadNL/bam8wSMJfQhHwc/WnGp6+hVigBnQDno3V+HAEA78P2chGDtFWJgX6a15e0MOwLtcTOQgglGXGr5mG7oWRa6HRzhAeSxWLQ6UyocMoU4St7SNYFooaAG/wd+6ABhCt+aGNOWChZMqt/5ks0fqaL+wQWspwrzhtDU/HWkIORAb1WlMpasXVYbV59m93VHh7vtXjHE/o8NJg+A3V9dKGC+j48D4X0Btm6HpsrPbVmZw1evnCfve1mVbsj4orlkGhs0eIcDg+PA9+VLjHAn7tYjQxMiFjlurEPTTGCH8PRs0EQTAC1lNpg/UJIdo777QPJeS3ORO4Z7CB2LU/yGtcZDxlmqyvOyOpc2BYgCw+dc5nc5P3FaWNVgPvR8G1ObMs2upvyrUppdk/Lag+TTGds4K28E8873dL6BqVk81kM6YcpWSjENhx+GLpEGZoQ++dQS7iEsh3AXaNgMf32NC2QPkBn3NuoLJkUNAlZ1gC2aLZMwIIwk+pvYVu8PYJdgzce240y6e1ok0Fjv4AN91AyeWATDT6tNwJCOCdNrhhYvpDekgPBM7QdA8YUfB9z2K9saoxsDY0vbq00sVfIQ3qAoMvU2jenLLg+wGMWmItc/ARso+tfkZfSNANuzvCk+ZK5ZpgYovDF9jHlJV1pnHEKv5Jr2mSUzFLTqs8P5Pgjjj2j8c85LrVUfz4eomG4qGRb28hQd8duT84PJdeXcGag4qnDV4QWM6BfbTVstyyh/ad7G9iaAhWMDPHcxp4U60pvJaBM8HFwUWaV66OO3htqAmuMtC0xKweI9QUtxPVi3Dj+aGoT+ewVKaML2LvStPXDdadTR0VmpDW7sb0/m/Q/eLE7RGW9+rp0j5h5saK+TS0Y3byjLoObmaczDU4Z1DDP82ltms78DtxP7qxlIQ/x1JBbS0otpOTglFdKVZgFwAImu7DbPQYBPoaesUCDcdojsmjxnHBCgkRKkxDP203XFZj2rXVvuaBZxlWgCG/Uiwh5wz3fIzl5+xFN8Zlc+MKPANT0HUL/MiTH45wHJHgILXzamP19MYlvlw1/iWq7XyyroCjBwXBOx+a9feclSPUk8FCk3FYhIjeIidQ+hNIoBZB51R4vPpO6OPadB021zKMMSBknWbZeETG7tysw7lh8NWU52wdxfxsjL4j70Fp3AYg30dBK1gfs8yBwvpq+FeaqfWSam2RuY5hSU2ZwoE+zHZgAgwcpCmZWjXIypKHOKcvkoaBXqhhg5RKDe5IbQsDZcUZtNzW2IE88GTOmaIqncdxxO29qcU/3O6VCZ+RSQX1NlYsfNGInOmmUS2SyHPDlON2rSn23c6OycJdFkFMx94izsrlHgtjQtoENwvnO0PJmmvkWfki7kviZrSbMnad/l2KkWVj9YhEVxMPVpvqw/hejXPzgg2N5rm8sRBa3TJtbpS7d9ySIlMcNVYOga0J+kaEya5qWJm5FfWiulu3y7iPZ0o4cfJlGrk5QzQdLApyxUG/hoy4CHNRdUsfslVpFi6NjOlGZw8nYGpSiajU5YgoNqMqy+PdB+4PTxMrx1T2D6mIXR7ocaBP4UUjr5mCW8Zq8UFk8pIdj7eE+aBNlHPIyVF3G3Ze7Ow1kY8c6B5ekNXGiCZ+3WnAQTrtaNgG3I83VksNvBVuxSlXUUKNYhR4m6XOGeyJVPYzWFFKXrIcej/cQtMZtzJE6orn/F+oH2poUSLboCb+ysRtUE1sJQ+3OUNro5X3fDGeEI3TvlJOBCnslay5qVAZHrmQQ3MjSZjWHbQJ61G5kfX7j2kczSJ8pjVmLOUpJBS5Sjw5hNWgYBRbm1yEgou3RBKvmUQstsC2wKuAdNyTkLGbEW4cl2hBUkjBjazj++ohVldBLfY7Zj/6Xi5GkivGSlKV6EaAl+LD1cSqVasR0iYe7dWKJy6l+Sje2dq9G+Wmx1lV25tbL9Y3d9e3n19s7u1v7u4/30n2dl/+3oxCzKihmt1XQenzKz7gNK3ANNHACLpWwBFeYClbKjDYzOlTVoWQyl83WN+Lpo17JpezkdP/cjlbG8WTh1vESCfjLOratdF5TWURld/Ddlc12LDpiqWyKIBnQy62kCZYtmB4K/c05gZVLwTJFTKr8pr0sYYHJmuj1ENJJrH9legM03PZlDSdsyTCRdjeSi1T+LGnQlbrTS7Kylz6HwUV0kXCef2vMvEDVL/hec57n0EHG9DIVi/hHLmpGzY0Ap7AMG2TkpBPIdbtmcfPzKpNijkfpKmdfo24xj5e5BkNzC4yrwrYPeWd6iJMLBO0dduVUoPauU3aFwnSm704/fderAqA27sGfIZyAupiq6r9gGU9fqF6Tp6VTM1pqe3h08Z+M+VixhSE26yB84/euJvMSLsBFP1Ske2nkEIbZZcPJgMwvFrJsU30dT+pvr8Ofjw8+mJWvZMju5pQMj1Sxlow79Gd6e7mZtaETMxYN6l6eZnkItwJQBeBq1Kl+LWPwGRQfFTR3AWUGqk6EgbIFr7eBAgD4/rCiWXxFl16cSFfEJmmlVIsSxynrG/iXMvO6A1pKp6gYBR7ovu8ZUzwsfd1VImfBAGKaHrTqwOfCKdU2tOFSr9Vw7SuCisxCEns2kDbGQVJwd293jU1V1LIXM4aRT/sVSOvfFgA1/sNXJH/r724+hu/3eOl7uzdZGtz6/els6OveJsZfWN6rg/g+iRFF4076FG0A637Udq2SUhP8WJD/LPp1OH3XBcDcKDFFtrxIkecL1IdHKK13aRXg3bxwV5rQX6HYvus4npOaM6U8YIMnIWGdawVd4CXVnO0loyKayRzeePkcYsqgKCRLRZdcGRORZZDXOGcLcBVdmNVZWGiY6qYXTMYK+svUcwAhCiZ16vmBkaBkw5NYSAASxtLDDdzBmlqIaIdW4qCo8+AW3BW5VSFUPtadVRWuOoReXLm6n4Gp0ksUw0myOIsUY4JRD3DWtqSovOKO/UBFBTkVVVZSuVMNKkUKSsh5AmHRo0ir2YgCXQtKbVbnsJJEF56Rnn4AERBuH/XRv7c4MjjVvhZQxWsXRFgBrTP3yZnNrDuef8QeH9nmTr7aILxwJKzMFyF0/fekf8dUsMtSrSV2CEWhqF0l8n0MuphmHFtJZMMDKNYDgzUWWY5E8tqorfSv4vfgShgozi79rr0+BL3pofVn7OSbL0im3v72y/2tzbR0n14/NP+5v/5l63tnf/3nKWVXQB+ImZu7xFoEcMUfreVuEe3Nt0ftRRoeYGu4JxOK3svayPLkmX+BfyvVum/bW0m9n9bJNPm37aTrWQ72dal+bet7efNOruyMlYx+qYvF6s+ferd4tY39sF4GRMQiB1zLrwxIiMr9VgGX06tM1KeW6klGFRKpnyYdbg/oIo7GmwwnZllvSLMqTQuVQHFO5/eCzWfnSsgMvRnDRMlcgvM72pdfJZX+6ItEXev764WYkbQehctdngn8tomEi0wAv3AXgUiwO8FUYqhcXAJlLLy+hp5FtaGn12SGd7PYdA6PBdFMrdG0PXrimh1cmyoSxO0b7xP7ejRfahDxBUyZnkN1TniDV5qW6/jsBK3sXHI1k+VAnqq0SJcwqzj7GA6g4RcK91qLVPn4cN9uEXkMA3uVtcWsYPXKJi23LSWMvysZh6b3vetRDFu9G6lYhFEFlBCOeQMesBIJhny1YJe1bujmdA9V4lDa4PFDNzGdvU8xKf1nTM0IsOpwuvZh9KeL7SzPHVtzq/lLLKxFigsNS7WOijOK2b+TulpFEG0nJobqthd2VfusMB1f77QhZXO5saU2Ro2v56ib8T1OHIDt4vwhRGfYdmVUV2dZN0tcd3fQesHlVWdxGzttio0jW2ESoSROeXR9/Gdn4C8f/ea5Fxc+djqu4vZeRdIWyjwo2D1RPD58jT2ITscRiOQg0iCH4XrqJHIHykt+yCuWhaqGPK9QgrwrgAzDB4a7M3VQbLdXb2/seG6Wl0zkUmVpLLAnmsb/7K5CaaPZbVExfXVpY4u79uu82kuaW+M0TuurwiMAOKq4lJxjHBuU6h2RES0zCvQv6Psp/eaOWM+rAzM6c71gEx6zlS7GV+A/dJq9kvQ2K2LWD0F0wD/k2Uw7D0LGmFMgk4peKTCIjYt2WxtbvaYUwrKXQlLV5d2ISvY9qaB2x1VLDAH6Zg6Akg3/Rl2iBtnHtHMkpOol4FYc4GRcH1hyc2WyVKzP6olT+jDelScu4F9a7VbeC1EbrUehfBQhN87AsAUrjtuyRF4ZehVM4WcfaSpIVJlzncdVN/IPxl7J8OpDuazYJjuYOuaRR2AHqXNBGYwYrBNmKB5fhri1l3+o99CrniQ4sKIcU55lK+AT3kzt3f30ihc2jMnnTifR1V6U0gUjhF2AoJ33KzcKVGpFJprEwtEjjJjywdce/YK7K3r4C7fsJ4Js2iGvobjXM4SDb8n/vcklRkbJ573+q/rpIjYuFgHy2LNFTdFW8xtOqmQq/k2KfXRPDk6X0t8NlnjjSAXObIm3OrvNyLMiJHwVh6vQ9zDuKksMQjm9uVGURNhwd1L5GWTpg1dqkXN3W4L9Inc67hwYUCx6yKiCHRh1G7yW3wX9pz+WXeZHCAL427tobEkeyBqxmF3OCwILQsuGNHB3BRHcsVotnCU5C5rT+i1/Tm6JvEAeuIg0ioQN1w3VK00ZSVmNIdJfX4R1Cmg9vhLATL5yZGbfOW4UrJkGweFNkxltFiJsp3pZKLYNSof/vHzi5U11AXIL7/sF0XNTDjN/VPrm7v7m5sray022o26/cbMB2bO1SeGYEG0UtMy0IosWtHVZB1jsVbgph8hSWFcU3R3kFpR7cR3IXkiTx8RJux+6yhgy/HVDPydMrJI4KIg97BUdktB5nTatk/ravcb+4KhVE7hX5SdxmWVGqptyGpbexAwNhSY8xKZdM0pK3uEr5k2fOZX11S9l1AsBJxbPzSmUHCxnrHSzDuj45XUbNVO0L0GQlOIdXe5YgICb0mZ05Tdqp3copXUJ/6ztJNi4fSTYuGyrK2GAnNs7G6/3MpYNlmf7k4213e2t/bW915ON9d3aLqz93KTPt+bsru1F08PU+6M/C7G/Sf/+Y4Q9wMsTNqKh4bCHR3/EISaazKxclEzWMyFbNtfIXbOBynbsd3K/f7/BJVbXR0wJ3ZFphw44GDx9Vvko8D9ZyqyDanqxZJG1MvIVaIIdsPJAqc88XZv8qb2OvznTydv/suXTNR1vLe9ZHnK9FqCL7vwf2eF6Wn8TSHVmGWIzdZ6/HGMvMLO1PSguGmMxfoMwWT1NXVeYhJq6FrRwg/da1n1Jrh6KzWGbxlF0yswqaAVsCf8gxqj+KTqdDYeoEgR4j3MF1//4UtsFIHs+ZqqhaWN0G2G/MIUhqlBFRT2cU4rDeZLSGCXU3e3NLm1ZQvM1z7y8fTueNr7kF+zEdhyIZE4G9X9fewdBY0AYpcJ+8jSyrARmfMsY2IE4ZD4bynyxchxyBG5Udz0mA5X/3PFP7syIiv49Mp/fWql9afOEE+dIZ46Qzx1hnjqDGG+784QvaH9D5MdQA6CcUAYhLrRS4oLEFGHxNZ4vykspFH42mNJN7VA4GQuihE2kAnVL+/gb6GALQzjNhAlh6oEO864sFONncrH7VlhmoxhFeNIX8Vgf8zjwNrbwapnHx1ZTTMNw3lt0sMdV/Bu4auR9/fYVxw2SHa+ad3y1gWA2kSpW/31g7AzFJShwWHIug/qDLRyd1Eqjk3FebCZ4tdRdAQUuHRmh8gU0FnhxlwWbIPmHvNhpXa4SxzmcxfbS9xHCkRRLMR5x2qbhglgzIrl7JpGlua6dVlvNF2UPlGWTFlFFy+AhvkOrs+8r1X+4bJcCVAzYFMDYFlhks5els6uFJrmD1Zh9Ezxwl4E2O7y5Ig8+/nkaO3Oo7S6tbm51TzwtX44NITt3gE9LQbbB+CL9h76Sg2GvmIXoa/YKqiOxR8uOfPEjl3biL2gitxNhL+9Kal9VrZ3Xzzfe948LQUv2OWA1SzenLw5xjhqf7v47E+AFpTCZrciRbRRjELcyWRhIlNCpaEEgzMW3tzcJJwKmkg120CfNySAbhQs43QdLMHx38nHuSny/zw5OD2oWfx0ylNOc7Qb/9fIXRm+3FmC5YJ6csms/FGC3D9x1QTDmJjeGGK/o6X7TLtlGX8xHCW9sYQUo50LIlMrtgfqor2lRFY3X+xstkjoMyXSHoE0SJIUQolBdWgeswFLA5+2G2jhZR7q/fibso73N3FH6g7KfHHP9kUqb8RgkWpoPrYTrIIFRUHa3/330+O29/pqdX2glRh0EYv0k1FrI2FvsTRoR/ht6KdZJFQ+TPjduG3vn7qOPXUde+o69tR17Gt2HYtCefifDwzk6zF62UGsGAEyW6Qxv42Va+SeUMrHRTxwTVbsx55Cw1svnu/tNAA1VM2YufyL3FIXsBq8pyCYYlGAr/+LlZqDfQMJ9RlSYcYVeKgdJGsd6gvu5BBcMWi/ESu5gCHgPRgCVB0LHJVBfHbeshKg4HO7rSBYChhmjbs4gJ/dxzvCAH5mMq6VmVKlFpjEh04tWgv+YGrCDm2hMFGwpTdjPVwzVxleib1lobw4pmJjwCNL55A3XqcYWMhOzryLVCqnbKh1XVk9JdjGlyqhyc1iKP/Sod28XmH0jRRW72tmAmDsDBOD+btOG34uN1m3nrNUZk4OsLBdC8BKGLW45Fr2lJ1+HJThFOTk/G1/tenDg16QhtpBB07vJh5SQVvWbU/V94AyY/KylLHsFauIUsy4gYqKIiM5NfChe8L/m6zkUqzsk/WXz5MXWzt7zzdHZCWnZmWf7Owmu5u7r7b2yP+sfilVcvW9PYI+ZKglnNKAmpH3d2CQnZySmaKiyqmKXdfQTjOFCCvLbKIr9jAuRhLJFly5VGmItMZKS2SaS6lcyPwInXZxlb8wKIKXk3K+0JglB/mGI2APGCPS6tlYpzFBSCIXhFZGFsD9IvbWvegnUhsp1rO0sS+KzbgUQ56sdzDDXQdr/dfDPpgGOloOnt6T9WvFJiz9oc/O7e+v8MXtN5i9VNF4HZVq7Qlnh2d0HbzTco7EYe3LFxgftqdIo1hU8HiZsGDIDimYSyq5raUPFeT10cGZvUEPMC2z9p7F3USaLGQwIej2os+4KNeXEi2+GyFK60vxtxjnAFDyQ0+pIEefv/jP95QSnmPVHyDPmiLrnBP4neYzqbiZF6GyLFcu9CyKoWR55qLZsBIxhKXOsVUWhpq/OdodgQNjDei8VMxx64QcZJkHYxpCHjEC1w0xWUDCuEqp9kalJnDIjC2AaLvGehaQI6ZZSRU1MnQUproRXf1MC3qF8bMjgnlwc/r8cndr+yFNi7+0q+nLe5m+joPpS/qWwnmSulGb+xf/+c64ZQgSbsctu+xusDRUBsuoaENFlDx1fHgO7yZ/84fg1oz4bpwvTCpFXeQ51ntCEW1QNUGhua8YNKwVnTQtC+2cquyGKjYi11yZiuakoOmcC6ZH5EimV0yFTqLKpW78ezVhSjCIdJUZe1BVZpXOuWGpqe5NfP2UjX/bSrFuzNeRCD7uvbh8sfO1bli8C+U02jtPav6ave2OrQMrUPZMY/HVDrK6qm+7fcOIUpFTZn48eXve7fL1movqY8/YNdDRTGFEuPd9BYGeeI23pxdvz98GzNxjU5sxmXxDijSA860r0wjkN6dQx2B9I0q1BembV6wtkE/K9bepXNu9+RYV7Aiur6lkN6WugSBZ/cWNHd9IjUrBdT+DkCF941P1xx6yMSg29vy6hr5eK4T72IlD9yisj7Mep62iHBDHDR/ogEdfOo3mN3ShSQWvjCBX0FUaCEaHglHBxQwKX7i620xccyUh0KfRVt3tH/SerhSoiZUv+DaeMGqAEY3bWCjvwUJ/E0gQRnlZNz5s9V6i6QDI/cVt5m2zDkWjp3fSZ9R1EikzosqIGt8L/tEXEnGMEorK/VHRHIJ7wpiRLOfb20BlB9djPTT0qDRTiasCAl16M5byDKqtWXEUSKlm7tBVs7X5UidTWvB8qAiMt+cExyfPvJNGsQzStjM24VSMyFQxNtHZiNygONz1t+GTHbir/BFTmr+a/7Oj7uCuN6N0QsyD677WL/LS1OL7jfwnvWZtbEUFpgbY5fYacLYANqjbit64Qi4dyHeSnWRzfWtrex10cp62oX9cAepb2+s4gs6h7LbN/Y82Zry180vtrJ/PnWcr90k9ItWkEqa66wxTdcM7Z3jYkKEO8MvS49ZmsrWTNPvqDlZ2w5VXbl0rVoM/zGWVBWXc2wnqindOqsHgBSihPTbbScEyXhVjKKJzXbRKGzYsAcEm1Gish9XvwMIbu+BrOSSM2CePtKpOlEuGxd4WVXOObQpqSS4UFUAze3Pbnm/vNqe39+PXcrhA2MaQ/hZYHSsoH4qtW9WSwARe3kq6ANhr+JHD4b4af7YLXtUglvlreEroNeU5nfRkthzkE6YMOeZCG9ZiboAb9Ab9dT1+0SK/aedfBOeX9gO2gBiwc4hXPIHvgAcOyu4oDL1q8HJo3ugYlCBUSLEo+J9xN2lAYfj4PhReHMMqeDa2lIIfvPaN+k8qxRT3ql3wQGSuAngYttl0qYGnL9M8OCTEw5xdKB5PnfxqLO18LpUPtYXaEbXpv150Ixtigh0BgunHmEaAxS8XF2fw+XaH20/ebR1i/uxLUfNC1zmbjCuV+2pcmmEpThNh2AKpcg+vYn9UTD8g1MK/MJHZIomzqB5YqDN+tYncONq3BSaBWdvo3dt7eTuILuHnL3CRXjjjBm78nRj5heW5JDdSubYaHcwMsG8XEmsz3LF7zyywwLTmjFrpu6vSbO0879/Mgpm5HOo+XG2gFKdqpWZH5e2wqfOExcVtjQwBG1iV7I+KqYXVg0IX4EymVeHT38LYvvfvyomvXGp1q+PD856w9RkzI1JCh+eyMr1oggLXarDsr3du+LrwWoy5zm76jMpJLmeJz1hKZbHRgl2XUmj2xXkKTrssU4mB/Otylbtwcjtb8bj50nzFQftpjMUBjZVwehxVn19zuolTVy+o11+1s9mMtxjWiANw3WYV2wIjTZ11bpia0rRR2PCk8eXdQaFhgE4Pf4gLTaXKCBczqwljf0T8szkvaYi9kOqjWCmVK3VEhS/Mq9pFkImSFWRX5pJmZEJzKlKm1sKowWjDPoZ08TAW9KGC7kg9vfATaOFm6q4hbszQKSQMU6MAgfNjaSa0VK50e0kFsStaw6IhMRyJw08PKnpCp5aX5WjO6VA12gKJ4CzopKh3rFYvRz0OaL97gZuFst7Y2RdNaxaVXGiesRGRlXF/KJIVf4YWHzXqBS36zJLuxR/u4ZqDx+PW+Do5aiOrQd41ts5P35x1zgkhJ0c93G9z2QUOnYTp94LdThHdPHczvwf+OiVkFvOp1+7jHXGMR50Qw1BE2xcFLFg6p4LrgkSVAkMzlijZCjrL1GGN0Csl7Na9oY2d6dy4oes01BDz5VfD/FG8fNP8hPXYw0RYnd6PCZ7NuGz738aNhfi34laDnTr/rRUKaWARLIvH/1so4jupDFHUGcF9sd+/gdXDKtDww/HhuUPfA4IngVCbRPs4foS3vuOHRWSI8nGb1W3oOe2p04X4cv4GDeE5YSgFclwFnYh8uf1GkT9X+Qt7QFNDZpLV7QVgEHRJxE3HM8m0WF01oY+0FFEvJl/Nv6xMvJ+Bmizdh24DULIkNPOJex2sdXrzI9Uh0Y9vqBLjERkzpex/OPyrvrVo3tMDAIptNrfV0pIaYF8vWp2NcCJ3l0D5N6zAgrd8XS60AjKPS7LEo6Q51T5KALrzeNUwzAC3ky+5TNJKG1n0u52lmiUsp9rwFPv6JRMpjTaKlsmP/q8GsjCVHooGJDlfqhUBdCIMCO5gyI7S6pUSSqhQLrwb3ZEduNBdy3I8Ne3eUNGRaa12Z/vWpQx4HbWp4JEWF5UyNI5yLGM0XZrrL+0Vtjf5J72mvYipRDpgyYsOXtx0roLjXGYdVNyzv/Y09CxkmM6c/rgC44z5t+/USdv9zEH9jZ4IGzthU0ioKXNuMJfBkKpsNAcoqWr0xD3BqCUFlYcwl23shvVGWUReHN+E1f0VhSLWdsRmCX8WA9doJdhYhl/sqLMg39UtjIkt/FyvD+iEgLWQUideU8zsRv83E6mEoBmpiGA3wBes6FbI6/gQSJJC3daqbIP8uY1OiZauj6m91iYMbGtxaNfEx3mAde6z+51CAC04xt8sgkQZ8nPgIlzi6GGJffcVfrjsI+vO2XNXbSiW2uzzxWOxAvJY7NVdcBNzpGtO3TAJOcuZVU81Y+TdT4ea7O5s79itfL71YifpWVoypSnPfQOfx7aIrEYr9C2m/IQd2artKg7rO4jbINWrsjRkl+XOSLuaJhX+ygvdpTbDkPbd7edd4th+fieOBr6ffOcd9tGsT6hVBJZGVmsdQNQv+9biG8o9+la3tvmWxnWfvsWsHpJrskf+ViPnX4OkmjR5T93QzaobyN9D/wDXUgVYsqOeQCgw89arrZ5iMs93+9Da6IP1MNzee2LaTdnuPzF9zb9czy+L45phxKpKnRnbnrjmNIClts3t5Oh8bRRrJVat6ADvTuZM9jYJuxP00LfMKznU9bBPTat1mb0N7mpd1m7itlS/sl6eEDZ8yMyUb4EYmg38wqhLEQGYWW+hgEip/YqbH0HR7bbgdNRgLENDbmxyOo2+uicd3ZuBmzm0aI8uiko4cQzLOMlrFvoa1wm7BIWyqEGPy4HVDWuOe+KTMm796D7SwA3bbhkUOgg/IOe11rKHOi4HqMnM+DUTro9WNKuzw5RKGpnK3Kn6XkFXE24UVTwiHCwG65pVG3tYNMrIBZROc02LRiCQ0lxLmGyBikD9sL5alJFJhqd/jOzNxSZSXo2IubGynPKtzOL6rlbz0NxUTkqvq5Bj190wIpSzAljqIk/2FspCUae6uyUcqY2MaUNOzrC+lR6BI0KPSDTmDVe+qu436BmnvGiQVo8jcpmeqLc6IVfRC4neR5C4wQ8OOzKR9txAZJ/dliafHbvOofDmGISIsUW21Zu5FOF7xciVkDdiRMb+sLqfUFSJ+tnrqui5kV7sNRDgOIhZXA7msVg9wIg4aKaH5mAB2ZJ+ceTkDF16jpqoJjcszx2TC+vxx69OP2zyv9oCR6GnyTqdCamNvfkMFRlVQGO++nMYdpo36+u/ZlS5isvUhMiEGTfzagIxCZZAcj6bm42AvHWerdtLpkfo25+//Vd9uvPLv775effNPzb25ifqP87+SHd+//XPzX9rbEUgjQGsHStHfnB/+3t2bRSdTnmafBDvmF0P7Dmptev9D4J8CMj5QP5GuJjISmQfBCF/I7Iy0SfuykziJ9+JED9VAgj3g/ggfpszEY9Z0LKMWj8C08HLyykzRd0JzrlgR+FCiuwc8ZiBc0GSvSaQgAzdwTi7SRCGWyb2qJGKlEzxghmmEJAG0MvBVAPSgMD+F0QeN1k8cpg0WelayADbDbqZSnVDVcayy8/JJjw583HmdZtYd1yjn5y9rFTyYzfsY+vVdrKVbCVNKy2ngl6iOjUQgzk5OD0gZ547nKLm9uzeKu2en6wjcN0vsF571MP23PERuK98tzn/lnb8h+bQ+xw4GEg8p8z8lMsb4HAa/nLBmWHcXM68Q6By0Zl9a+rW020iWixXzfuTDE5OXE1gkthxSbPMcWPXa80yWX81XedUuIdjA6DPRkejJQwJNev//vrgFKnvj3Uu1v/ALwxFf2fUgo4c5FZWiGKmESDf9ITYiROO1kL4G0tznAD0EVQtz2SlozEBEM1E5ty4lk3ijgar7t7mdrL1B2EipaW2Jx/kLSs/tmI3WsrP74xdjchvXDE9p+oqWQsovy+swC4gcasb6DgB0rvBBY1Ak87RXzpuIFrBgPrvW6fM4WJuCyO4dTkPDPYYOq8B1ZLJgkhIqpMKaMzJvbquBuGPXXs5P0O46m98yhtglzS9Yve2jbzd3gSirhvkk4Rd926PuFv/0iPw+h9rzciJvv0i73YzYs7z6wGkrNXXLz2jrKVV5DzsYwKy5IjkwMv/SVOrw4XgjKBbfns6U0hCCHGmHuohUHjuzqrf7Eh8QH0ZEr6or2dnl/jvOE98DIkXc2sM53RhxYIqK0fEpOWI8PL6xTpPi3JEmEmTtW8P8yZtIX6gNFgXnvj2/ATasuQovt7E6aqerF9bLCYWdzuIwcg+UWqWjkjJC0Dot4dOC3QDn9/zPfpXuEGDm9+NAk87++jb+Lu76gtGMY+d5uglg95KjpeMQvF2LOzRMStip8YQSJcxw1Iz8uNjVA4G19074npTxncKpr3nsKG4btZeD6nhIdzHlxXEQSn0y1fQ8B2W2mryLsWUzypV77skqhLLI4BoOTV2usSXsmmXOfT2ej0iN2wCGiBn0JjfqAoS+xFdXIqNUsF6YVxfcsXLw7Xa/IM/wVZAdsPGIEUzgn87lxo0gM7QFqsHZ28canTyQ812An1GFm2KnT5vMWi7e8PHHPMpoWLhmRxgHdepA11oH2qJtKFr4f8OfMMqvA4WusyTNy725I+KVTgwOb54DVUypQAS8savUsmUaR1ZL8IwoZ6rYuD+SCUErFnJzOMDogOPD88fYIVncWj5o+uX/rgnLqx/LlGfqyPYwSQehWmjmg/tLmkRmcktY0Sa+FOKZuqtkQSj7/h04fMHvP2LkHOMxqeqaFic6qvG2cTbul0rLt/7TDA83+rzt4TnYywMNWwmFf+TBUiWvQFwAUlASfIUpv9gza2Dw7983H5nxd9nIH9nQd+zLBcv4TsX6TqLskx4KNuIY8PA5+U0+CKCse6O1REjw4GKeTCkNNSeKaoYBNa5y8KP7Oqh+65aI3LsXB31NXT05vcR+eXdiLxmM/uEVTHbGD2rJjlPL3EYtnTPt6fCvk+FfR8OUu+GPhX2fSrs+1TY969X2Ldd17d5qde+mC+j0/m07eGVOj/T96vVudGe1DryOdnXHST+5fW67pK/d8XOr+h71uwaa/jLqHZ+VV9Qt+MilUUciPFpul2dj05x1KZel3h21dHrQJ8Lo96j1x29+X1pVH5ayFYdklVXuem/44epBf/m4PB2ABrzDymlH9aZ0V0khM2qo0LhQbDhu3DnON47vNmI7p6zvJxWeVyjt77upnUkUHBWBAcCxWxJlteFbDCFU6oZFfxPlKkbcRFCxsnekPnIWMYypwBgKifClbOpIawozaIn5vQS4vPOf25sxFO1effDt1aB/Kna/FO1+adq848M/OdUmy+VzKr0EYv2ddJ13Qy33FwtEPX25mYDPs0Up/mwMdVed3eTOc28KVoMVpV/7srqt8usgXWeGkogYgLEwamSRTNmTrkGP1En1RCrXY+0KJlO+krS+Gh6Na7FvbG/3aE+TabhPyX8B25a+EPmOYMqNmg/sH/VQQk9OYIN7bku5xclaD0mUv8OAy9HcOeLggrTMlb1nt/H6TnpNyViiHUBkFpWgnd9dFD7+3tSKONxfCQIE4qncyQoCAFpVMwOeY2pLEoqvNRkxUCwpzaIsZXkGOdU6lDP0IqSkG1KlaJiBvE8U54b5qy9UH3ZC4lQ7gJCfgU86AXNAEa9nodUwPoKleKb4i4ZTDX4eld9TFteXKtvvgbZhmvqHK6pe0j3AoIyPf34kgP9ZCpbN+Dy1R2/S63gSSVo4eh2leA71gf+KhzikZWB71gT+ObVgDg5xtf4ctz7LPrqTqZd3/m382y447WhORauwuhbP6uH78TUpbt8x/Seofxro+DNQgKLGIfmf8ajQtGBMLQDBMd0gbD1WIb7/hVpdIkvVbjh1mblj7bjbk8e3Kd8UvE8uxyWGlcPXEpk767ZUw9Q1Ns0dfmQjiwCnwlUEb6JCriGlNFUFgU35PyXA4xSEBiFziCD2g/RUxBgujN9yfZeZdmLrcnmq729ydY2Y5ubm5NXe69evNh78fLl1mZaO3jvMWinc5Ze6Woo3nTohu8gy68Q5M5rpkKVum7W7N7k+farjL7ae/WcPd/ZfPUqfZnt0Ww3nbxKX+00de1o8oFWdNSMLoH06iYXCJC/LZkIdXiUnClagBKcUzGr7NqNdCSlwRW7oVjO6SRnG2w65SmvQ85JHfDf1A8QnZc6lW3d/hGdhxlsjZiRubyJFwx16sKOuiC7SjO1DiEtIzLL5YTmHbzg130LYcvoOxk1/S0PLOODLOBe+JqYy3nKhB7M1fEah3cFkzFXvI05f9ibzaMIJTr0IXI4hZglN2KssilZkPOzo/8gfrrXXBusH1MzI6k1n+SszrDXZfYRsuvdkHpjrctnDkqazlkYeDvZHFDS670ioilqypFNwYqaoTqEnVEzjyrx+H3jHYKKoNuotNoA0t84ZHlO1cZMbmwlW9vJq3ZnFCi5lQ6Fwl9kYUFGm0WYjLx/9zq4u7wEA50SuK5FEl6XKL296mAosyItL7PEtOx9YwWbJVb9oIqEnmIazUS698j29vP72pQ+YkE3ZxDtygLgrnThSV7ejEkM6hXbmUe+qrqZ0+YjBRW0rvBMXM6yzwTbJ6osRiQrr2YjMlHsZkSE/WLGihERFXz9T6q6Z16VxbLbOKwk5je0OUvcyWQ7eRUL/025/5j8Au1iPkXy/w2VI3ImlbGkT44/srTCP5+dHa+F+q3Li9VNi+QgsT1WZHXTNGzGlpZGvtpfRqiBp3jO1q2W0NVeodyZnBpyKFUpVTPZ8h6SGF70CkvNujLYA1d6RuMw6HtWZsceWPcIS2spFw9c1ovkefLqxeZmsvVyZ2t32fX5CtOXsNCh49DsKj+HRs/PDk5OL5Lj/zhedn3DOgjDovq8hA9c3Eo4gR8+Hhx7ZgR/t23RK3evPlp76qNdPX+MvrrbD7OUYcRP0e9FSamoPSl1h1WX+dps/wT1Jv1whGcbESm6Wl+N6udgcB/76UvotDo1VucydKF9EyicinCjWT4lVITdtasqOeaO2wdRLfFlwMB6i+DWwfTLWVFmQ4X/rh4oRReuihUgiaoZVFnQI7toBfQBeLQLohMt88owrDQaRdlB6dVwr0WyyRu6IBPm3FyImVJJw6ACq9Acuh1He9aRIdzHdZSFJ1xs6NDEd52s5+FPqyaGD1ubif3f1osOIi8h2+ZhAmNLE2NiZuZBVXfEYscGx96iv4q9C9uqsJlvXOHClZmzKLCfJlV6xQyhguYLzTWRwmrJYcjC3shhk8iN1ScCN4AWrlTFZ4i8gUKG4YUCNySq8c+dOo53hK50yVMuK123jO3IdTvLMspUZuxS85mgYJdjH7m+t97QRMqcUdGH+x/xJ4ywL+2QkJ9PwgxxjbA20KtGVWz1EyHHlnyDncL77IQpUwYNWr47YE98Y0RbvkVUqhalkTNFyzlPsXOOro9zPOo1zXkWZy1B66hKGz8fec3oNSOVqOsmuBYD/tX6FZ+nV48fhr2hmlQCjISh+XRcOPndu7fvLt+fXrx7f35xfHT57u3bi0/dsgrTVAbKsDnH4RuXM3jnoPKvelRJuLUyQPJSlq07ztLquZGKaVckqd7ons0j6ZzyOFT173bHUXaoX7/tPc9yrJwC5S9Yhpk8jQ5Wrg81arGQY9Mo0TFZQElXjdG7wJlYvkBjM9ofkEo7BPVZpx4o+zPR3M+zIHiEzzi2LI24F1qurWQ3o1xo07hiJ1xQtSCuqWyzZm33bNLGXtxz8B6Kp6KgIrtcsoHU1/HPNvfhpyrPPdzYsgpICe5L15jI3Zlt97uXesJcTvppST1I1DTP69u23fyscw1/ulzUkIfIOhRFVi25Z5kkfYhlGrD28+1xQW0pH6XvZgoZMhW83lyHwTrdA4OmwBuCleF0HM1XX2RTcgMh/40K6WCIhZxcDwgGIMDhef/+5Ghk1aJCCq/dkJ/fnxzpUXw/0qiudWGPn11qvgglprE0cKjcA0657qoPpdBGVanB/rGoNOQLN1yMOchhsCQsBSmVZYIpuHwKbvgsvmTPTo6IYpVmjVLade1rXxprCt1WcHnQN8DqkCNC7VWl2yFnxGdPWuxJbXqYbbqd7uzuZq+mr149f7m7tMuwPkPfLC9ZPtbjoKUjxbTe0JHuOM8t7HDzCU2nuzGQdiAUUZq6S51MjqXTmVVEoipVvSUpo25JEytuu0stBN/Wk/nzjl0nsP5tbESw/wAX7nEabble3EsQkT2KSZHtDsTI3hzt4hTdSfWcbg006/kvB1t3TLu9+2K4ibd3X9wx9e7W9nBT725t90z9FwkGW/UXCobxNSQEy381SV1AA3r4nYahiOYFz/vcLG2OUVJlj+3XsRsNYvx5uM1nGStujaYnq9CXtAo5xH+/xqH+BTzZiL59G9EtO/fXMRX1L/DJYjSUxagf30+Go/vQ9WQ/+kvYj9x+PpmRnsxIX92M5Gnx27cmDWMwegiKnkxKy2Pri1qWHgjWl7M9PRywL2idejhwX9B+tTxw37SF6wsZsZbHVjlbSt54UOT3SX1NOo4GsVmRpYvpBoOeMDu+vRYfutllG/plGs/eEbMeoty6ObbbO9sPBa4D3WNE1UNXcIe5VVL2g7r1QFCB0S8B661ZPlYf5QVrbKsT67t2ou3NrRfrm7vr288vNvf2N3f3n+8ke7vPf3+oBmTmitFsubKGD8LyBQxMTo4egwwclANG8Dpwe1Pacfb1pYsteqC5+V5kv8BGAeaWVGRpEb4foWKAfDXUlqM6UCumaxxSgXm9E1Y34d8PQ0YV7AglEyVvNJT3MaAxcOOA8BIoNPmhM0bSStmBcug+KCITwLL7UZUW8s8QNc9ZKkXW5Luh9VFVdpO5n28vHaruYLyR6oqL2SV2LJTqEZMrhqQfSyYOdBJAbzshOorDXBZsg+Y8XbrgZ8mS/yVJJyVL/rp5JyVL/uqpJyVL/vLZJyz535iAEiHgWxT8A3BfXqwPU39toT3k5H5DInm4ar+iwN2C4VsQpwNI37Sw/AlRNd+fJO3x8/XkZA/B9yMFL08YjyAi11UWZlwbhxWX+/gu/u725MefMHnRNYW1lOHzwv0AvoAfNEsnS6YGQt44VCcYiJ+svnXCFNZAIDeKG8NcauWEavZihzCRygyKaoXN+UmqsEDVXWBdW+qcmb/TvGLHH8H7+Y7Nfq2YWrjvRk2PP6RP6hJpXNbOO2hBhQ69cV5e2u/GSQh5kb41wqQyXm6px5wwY5giiqXymik64Tk3C4CldkfUznF78t8d/3z548npwbt/4MqZa2vd48j6/dcfq4PDzYO///rjxcHBwQF8xn/+bVlhB7YYb5/7gqM+rYY+xgRgnRu7vVA9DeZzVXLrbT0LiKCaWB4JUYB9b8K+uD3yBJAAWWjoxxOGdM8HIoEpyTOL5PPfR4Ds4/84Ozg9ujz/fQ3pIXYUBRh4KNxCoGSqq/OGU7I/KiZSbFTgJgQCtqO/ef/64gTmgrH9cNAjOIx4TRXUUSI5hPnhsKKCPnOw1pqi7ZhHv719d4QEffzz5a/2UwP0iPrabYixAWHKC5oTxVy4GnrOnrFkRsYrWyvjHrfW6n+uHO5/UIZ+UCy7NKb8MOHiQ7GgZZmwj2zlv5a22gDBDVTa+dxQkVGVNfcbL1THRXyQim6vEEli2VXM+fUQCziYTBS7xkq/oBV5V6Sdr3ON/PLvr98sC/AVWwwA7y/8mmErcn7tPMxyakfq3nnnb3+6+O3g3fGHWmPzLPz04sMhyi5/R5X+w0lhBZqfeKhnYgkUm9DoDzdcWEAt3S2t0nUKLz3K8iFox44dx+TYrRrZ4eCEAu/u27gPn42QcMx7EPPhiE2qWV1z5/4CORGcQzXWhDn8Hd/tarMUxLWwVPe/D7JS/dWddSJCfLRmxl7hBaPC2OtkSlN7QVPDSMmvJca6KOj5SknJWWqX4uGDmjruA4RPwQMa+/7UEbQuBltbIRliD8WClDlNoQO+vWGOD89d1AK5iEFwQ2sGtSfFzPOCYoSlvOvbSU4hrgumQFnB3Y1cRUJNrV/i4rkgY4fFZBxWcmAZZKqYCTFKFkNxP6CRKw/ng8uhYtxcahM61quRD3iqKcK3vB2RNOdMmBHxj0I3PmzHlPjq+NklLxNyMsV65mXJXOjayZnn20bW0PNyPMJ6HVh3SjikAcao68JzckaM4tec5vliRIQkBQXRLK4+xw1MRhXLRlbcC9Hy0VT7W6+2k81kO9naHT+gysac6qFKvx3kOd4RVM+ZRjKQwiJEecJykhWGDHryh7Y/NRepNKqXENBf48+NGuqicEE0N5VrwYcV5xayWlWWFHSlGMSx1fqWA4zQfCYVN/PC0tMzDLdlik0lvGEJyrJMuPQCAGvLtzUsl0Buf68riz7HoE7OetHXVKP1YE0x/EZCrKSd7XZo7uePVd4oMvbOf76DM9pnfB2c0FQqig8Gi4aLyMNAQbGoe16EvhJ0ZgV+C4CLjvYhi4TmTBlNpCISCsUJiYXKYGG1JuALw9kpovBJN9oNSOderkUVIAIcL2K273mKByoruAZ3gRUAlcxD1Wk9Cq05JTIycnJ0vnFydl7/ENpvjcgNm/ghSwwfx54P4YFK5S5wVo8IExmojyRjhqWYUiGsfGpZsmbk2fHRuzVXTTqEbTKTPqR+T2Xm7Z4ej9cnD4p6xj0WoLlmqVmVSbEIdXIRCAg3hb8sZ5AkVYyaqNBw2CtPWYEygCs16LuTpHVuqFp/HfeCva+KAPbmG8qneFA3/0MaQPHGDYVLdDHArqUHcliPhIAVy2Vr8vCxxL3IIAfGsKK06sFJJGO8ZvRqaf1rcPfjBTa5b3seYePdhns89C/yx1ymV0RZtVobkGVK6GRPjk7PMQL4l4uLs3OyQS5en0Ngukxlrpe+K4YKIz/ANZ4cIaPi2kdHW9XbVfeCysfIO5FRRlJTbWHwDLKXcB5EMFubSwc8DVtiOFYE8luqDd/OGwJqMCbXCu00Y3dUfHX1gH0d4CWWP6jbpNF/HdcJxiqfYbPcuXj99vDfL49Ozy/tIbi8eH2+7NqGLuC7+q5RtNdIqy7cnU8Y73XY3d77IPxq0WiHT6FpNkedDbtbiEyq1VVNMplWdV5GczZQKOzJXF2t6UlIU1PRyIq/aeSdoSTn4grWQwoZ9ilHhwuiYOKl6vqac7V0Qdzp2tJ8MWImkht+xUuWcQr1re2njU/aXitrsaH89actytXMjEgpc54uRiiboEyArlx/61pFAU72g25/DOgvWN0NLjYhOfPe5Zlj+Zc/oZy1LJ6q6hvh/WB5kCoEAQQcwZWg6ztBj1qXAWd6qeugyTC718LW5ib+/9IGokGDei6iPkQbRLFrrtuiw4TZVQPtgF7vctW7S0vuWVPU59B3E3ZK0nn9zR1q0oF7zm6y7wBItfNFgKnF/iailv+pFMJtzzSI6qj0EMVmVIHhUDNQUPQoeh73f8LRtYj8dJrLG/AoqazWmX6SilwcnrlRsaOvDmAibCnj13UAChfccJqT83+cQqFuZp7pNfejG9QOWMOCbgmkxSB0tWdyDDJfdPDxQ80FPF6MokJTNzjY0JwmRGhqKswvc91HDFMFWQnjrVj+AbdaNKyHQrQA1wnQl/vZ6YmOeTPfkKa+LLzhDVv8UJfypltTxOtwVpbzxgSoQcMq3IhRFiyoof+sBBIFuGbQLube7husRq2QpjPkFFiw3cZ1OJxtpfoQh9/wS2h6f9DAQ7OMaFZQYXiKjpKPxrWvZh/TORUzNmowda5DB2sjyTW3y/W90LF5oYBkX9qwGnnLngpzTK3q7McUvoc2XiRo2nNOOW14nhOGhibMkHUt10UWmxkBYVMedeigZalkqTg1LF88RL1Gu+dQghO2CIWrz21M3ffcriEwmGLCZ5WsdL5AaoZ3ApcHj6IO2THQkJQKcnI2IpRksrAbAMbQSvCPREtLJwkh/6gxS/MbutBoWm5e2fTGw+Tpfpy4L8aIsqaMJqwUVTtRs8pn2YPRNuHl2IIyThCs8YhkrGRgnybSyQyk7vwPVlmuW8EsVCdL96e9LZ7FJf3iOITm0ICqLq9MKyOFLGSlfctDwHv9dQDQd13DgZ4dnJ+uddJs7b3NaDqvbU2ISgyGZD039O7Wi1ftNTeaXX7T6VzLR9D09rdsoOJnKWc5I69fHzbw0ROYskwwZPxas8ILhKBAaihU7474vSMJZNHdrdprNv9Cwr4Hsk/ybyM0OH7TLD1jMkm5WQxVZOSQm0X/7ryRwijW6o8E4EhhuGBisMInp42CJ26yDnynUpk5OYBgCtoDZCWMWlxyLXtSlh8HdTgFOTl/C/nFHQgPD24Fa6jddCD1bughFTTrYsr357sHnBmTl6Cc9837WooZN1WG93VODXzoxtz+N1nJpVjZJ+svnycvtnb2nm+OyEpOzco+2dlNdjd3X23tkf9Z7QA5oBFn9b1mat3fxy0DJw3tC0eEoskBpTA5JTNFRZVTFZc2MnO2IClUdrBiZ6PQgrs3TdNoxF0b55QJdC1AtHwuMVJowlSdFO9F2/qGQvByUs4Xmts/0LA4Iqk/1nEc1qk0Fk/2QZTAsWt0ZWQBF+SMydCssWPdmEhtpFjP0s7eKDbjUgx50t7BDHcdtPVfD2+Da6Cj5mDqPWm/VmzS6oPedmR2YOh3Yq7WHvrQMst1X68pCx32rY7f5OTsesd+cXJ2/aIWPlvyVkHTAXDz5uDwNqhJwzJrks9w8K5eWDXTKV6QchErChPoX3l6cBH0b1fxgTvJrD6zkpSKX1PDyNGb39cimbd5VkCbyyXNyITmVKRwWiMHoVREycoe4haS7TpLuVRqw4NSCGIE2PG/YRSgBvsAqa7Th4uZT5PhWrkunW34zDwbh/bbSBwDFpli2WWf9PiIfd4gmHA2Z9pEk3oc4dwjWEhZsiyAXE280Bm2POoRO4oCcWE4p3FOpSIrUymTGUjwSSqLFcI1WYk+t6sIohfVBRdlDGu7QKUHlnJtNSrXdwd03JxfuTQe9BDqajrlH8OI8Aw0ktzf2MBH8AmrSa0l5ALDe4xE88BHXgRz9GSBXU4XxNCreldRJ86pNsTcSJLTCcs1qt9CGkgFwFpGdu0Xr490iNxdSWVSXa10b8waGQ2SMLK8hO3/AhTBplMGJezsrE5ycXv4jF28PloboUvkSsgb4W1hDbCIQ/3ImxsBRSWtyd6NhykwHeJpzxuGtXisMQTU832TDZDMbRRTb8RytAPfN8im0kwlw1JMrHfVOS8hcily4RA5vY1jUEFeHx2c2avgAFd8FIaKSWW1uzpWUJ4PtDgr5BOYwEsm3fCvZFrl+SNn/n4184td8KomdkkwHagRd/jV8wlThhxzoQ1rNd8H3IA19asRIDrUBqdAXORgzsTbyxE6h6HzJ4LdccMHsvUQKsI5oFIc7wRO1gViwNBXX7gR+A6EmRoZde2LIw8wFhgZlCBUSLEo+J9RcBqiMHx8j6WM+ZSMYRXQrU+5D3Z149BkMJViinvVjnYQUIO7dtcQX9mxj6juzex+FFIKmhbM2YXi8dTgr8bSzkM/coKFqLnoLjriaRR4Wssz7MuXRK5h/9XdTSj92x1Ho4l/w2BJ0FHq+KeMGuqAu6GapDLPWWqijuuNVpWhTeWUiwxpLVB+LmfakXyooennhrQU9LU/wA/GyjkrmKL5gGVYj/0cMevz8W0e/Gd8CjYMLOi+1qlCngHxgC6KLkvtS4UqBkn+Guuwjt2AcLIzybQVx7oS1h7dme5ubk4byBjkqPZUoQ3xD0JghABCjIFMNTVBa9CiVFxH/ExOMdlEyIw5c2FjybWHLmSqA8GAXJqxbnn3kLPaKSEbA+MyYwt6xTThpu7nH3PmWtK2dGoJ0jdYhYMhWIdqmykb9sBY3YKnVU4VwBuGZAU3vmRyO4LsVBrnNuaYWyKY62DAWP2CxnPZAAPiwmUD7XW8ZuSgxshvvKGpIWP7nrsu7O0BHy32QX6iPQWvs+cv2S6bTNkmZS/SnVcvt7MJezXd3Hq5Q7dePH85mext77ycvmhZjgaxXTYELU9s6NePuBNgqxWmJ3pehDKr7mTCPQyJOY5eaJ7LG9z+jGuj+KSKI8fdGC4FQFWQFBFMmFDot3n1o0HCR1toQyFBFyxd9QkRwcgegX+C36ZUwwqOrdLGU5cR0zhFXgpod8ZP80qbTrt7K3v+yKjRfYOg5uguOKifXIYqAuFRu5HjWl7BLK6pPRiA7rj6dJeuWLyOdXfcmkQkMzaoA8VTEw0kAVO2+ExECeZGIi8KpGRH8C97ruilYfsbHNMooDSusAFpteDEx7SjUbQJfumBLdb+j4mvmR0GdddJgMynmPnRlqOlFkuOQOhSVAsA+yzueRRd2CRUR4OJBcFO71O1GidZMi1WV2upa06vmfempqw0uLgwG0IMKPbClQPS5StFDWeipA8JJ5qLWcX1POxafSjhSNv7glRl46p395zUFlQSS9GuzoLDi2DaW6wDS6iHb3GhJtXUDMZTzxpZR64QcOwWVVCBIWma9YgJfr71TfdPqzm0jlI6H9WTi3nCOH5rrU3pfqCcexB5fcTzg+8JeDGiGggLBh23R55tyAnhho4Ec7+SaJJjv0EnUxxEqjAGVawFXfuE3sJ6b7zkNG5w1fE9XLexHb3xtI+zI39vFsbzGxKC8hq6RXdXah5sJMmlvCLUXkmYiccMNkNp6RZRLb7A3bvYeJ5sJzuxngWxew01q/7mDi0Ln7o/ktMHB2JPA3AObTRFwuZIUcjmPcGasfvMRWx+kyGFLjjyKaTwKaTwKaTwGwkpxDPpK0zVjOQrxhUiSE9xhU9xhY8D0lNc4fI4e4orfIor/K7iCuGy+O7iCh3UZMi4Qne13xNPR3MXhFafWhlC7Xpj6qJUNmIUBWVLzL75GMNb0ZF8Jj6+wRjD5YW6Lxho2EPzXz3QMBY1nwINnwINnwINnwINnwINnwIN2wT3FGj4FGj4FGj4FGj4LbO0zw40hJ4pCIxzgF3U39zhAHP9HiwN5lRrPl34yCVs8g5lNmmaSqwsA/WrcC5i6EcpZOFNRv7itzC/4UYxcnBx8X8O/51MFS0YFOXtDT6E+hpSwTqbgLjZQTWiobYqV6GKJ+h+bsyTo/MROf35p99GUPVyzQc0hA7iHlz0lOAaEgNdxZO/ARS+erMbMS5WavUPJ+yFslRufxw2UA9d4UVJU7Oy1pyFpXMg6uRvXv2q1x5qRvv5XA1bLkCXAXGNpnMoBBUqQYINzYDb1dM5TDWCHUpTWZQ51xhlNJM09+BFVUSFPfpWt0Yf68raA/yOYUu/AI92+A1TBu/+tFJQQSgUz0SbrSefhhiL+wy/h80IMZHMqs4Q5we7RX4KU7mxeMOuTLzMHnqLQcAVlM0Ss1CClTAr4GMTCkO4mFn9FRvOS0UUM0rqEiXnPAKWzma4PF91p3Xy35xcvDt2R6upfCEpD3bDW3rmqF4jMhvU6HH3D1c821dbijlBWOQbahT/SC5wnGbx01HctSghz9jHJNS5o8bQ9Cop7JhQ5w4h0RsXB5ubO5sbYYK1NtbwgT58fSFJI8S1LI+7Gl0xN/3yuEOW1oe7oYtBXsDp9PUgK5V/pxh80Ai1vOEvjS9xpANTbOIV97n/VIf1PjpePTB642Jr59Wru861/f0WtP1FtN1GEPR3uk23ix237N3X4SxLY7chWwzEXJbH7oPGCLh2ZfK8tuBqxD6kMxz9/9n79ua2cWTf//dToJw/Jt4r0ZJs+ZFbuVuOZG98Nw/fyNk5dbamZIiEJIwpggFAK566H/4UGg+CD0mUbSWZ1Li2ZiOJxKO70ehuNH4NqNk+rGPBsJ+yMBPW8c8xaC3gI6JSkHgKNhmFSkoAShk/IHzPKODvtyOSyrkD6MwNNj2Er0G/c2aNdcKlNtR05dctatOFNJ3vrBLDSFfxokkERqRBW9VdajGLMu6+Nim4HkkrCu/daHwxGL69GH8anY9/vbp5Oz6/GI27vdPx4M1gPHp73usf/22DhnEz1wgWHu12RIXri/dtW4NOSJxEbRyzhBS4xiC53iHdm7FBqNyJPvhAOqtykWlczzb5GsaZoPegIG+rUxqHc0yTWyRoEpqIt1+iCOljAn0HzEFGxlRU83TeX10FQeNCIqtGsiMSn9sCPj6tvc4r2fEF6ueuzRyyMVfz4lE8yBOeLRewNOcfxctjU8qFLIiFvQkzdwllNRUdCpxpP45RcyzmwSLq74g/g4KCSmaEp1ztiDkE8/thH0UU3EQ2RcOLT46NxQxvuJDXYOVc6lsVggpJktCcJmnQXYg76gJPLW8vc4dSOVN0ZDCvpJilKeFwCwXoVV4incuT48HJZW/Q77+5HJ4MTy9O35xeHr25fHPZGZxdDB7DEzHH3e/GlNHb8+6fnitnF4dnh8Ozw+7h6enp6bB3eto7Ph70hmfdfq97NOwOu4PBxZve+SO5k+8434U/vf5xPYccDb07BU/nUN6q5tTzrJvj05PL4+Pj807/6OKye3LeOb3oXfa6x72L8zdHgzeDzrB33L/oDk9OT/pvLk6O3lweDk66vcH5WW94ftm4NIWZIxUi25nJM8zvaNnik8rezya/k9AdresR2E9gydXuRwZausKlMgEHH16/fxjqI7BPjEk0OG+hj59fXyVTjoXkWQix1RuCFy00HLxePNjEkeHgtc1jaE7A3/HhrvZxcygEV4vz9Hzdr7l3qozqOVvqHM2UcCVsSshGo3cHuaGN0BwnkZjju+qZaHRE+pPuaXQ86ffDk27vpHd6dtjrdcOz4wnuHW0rTwmTYzyVjURqVS39IZbk4IYuiG8sQ8leg2desAoEShjkMxGzWCO1lP21WVP//5dep9dtd9T/bjqdV/C/oNPp/HfjmrPefCdw9fMbTtjYRo0n2z076TzHZDWi2zMnD5TK1QmGQhzHSl0maPThymhVSeK4AJevz0bmTMjE1PerVgYx1KMCYV3jyhxcGa8qQL8qGntaWz1ZKNxSKn48I4rsKTWXhPycPHNNqEL85XIZmBt7Qci2JbhWld9TPVcUcq6IHVk2KuTFg63Q+fHz62Ghns5z6WGRpfrwZqxd6l1dhXPelemm3nYo+PL6mzmJY7bSb1nhzff6x+N/Dt4rb/7w9Kjm6YvBsMHzvwRB0HyxZ7xciHrXQRDVY16GBY4q4fa7pnFL60JTG7EusUeQMO31j3njyjNESDyJQfAbzHTCWExwUjehN/onNI1xYVp0aoNdKCEzJqmW9iWGvLiQCDHNYoQT7047x4mA+lYmppYgkoT8ASrzySxJSNzYkU3IVzm24bVvykoX09OldfS4SRSga6IZa4oJe0mScL/w/MN5XmH9pY1jKuVJcaJLWWEh6CxRmkMcyFi0YSbKmldzaOt2V/4QfJ3LRfwCx2nStmNs00jsl/wrU2s/N99jtoSTZVGVOjXKg42lgfw8aZEtdipwVJQCsSBwpl9In8hjXYmOdKl3S1LaWMwM6uwPGTU0Y9s2alid0veKGq4aya73tR1EDX1ePIoHP3TU0Az3p4kaWm79maOGPk9+jqjh9+TKc0cNS9z5SaKGDTnkO+t/uqihmeNOo4ajreKDlbhgvlV4mPjfIT5ouv8dH+7MFa0PEJoqn88VIDw8Ozo66uLJcf+kf0R6vc7JpEu6k6P+yeTw+KgbbUmP5wgQ3tCFcuAWaSVeZoJDP0KA0JvvkwOE2074mwcIzWR3G68aNY5MlVRyjQpQnqVd2UHIFjtRAbutb/shA5yQwj1Fu1OlmAuLP6a+Z5zOaIJj49/WSEDQa8xs08muAwwfANiT/kEi7YTD7ufiCxCu9Ke5aYpyUzV/lw/FcWgvP9qcKO+r1XlRwxxk1DZSj1kLaUx/EKuPsXZpOMtmc5bZ1YPRgoacOYRlHs6pJFoycRwrx0a5wPeULHPPKk/4N4vAGzjyrk4gTr5kRHms7VxIbPXeJZnY3637NOUskW2SRCVsvLaazpeMcLXxQPl8M48cs2GCwzv/zS3ysdTod5j0uhocWXec36c619/o4Yp8buaCjL6RmxceNr7yhKhdB0k2I8r6A8vQNZnf5NP3uizB1UYca+Z5wJOS8LaJ6hCPkpUrtUeT6Vlvetg/OZkcHkX4GB+G5Kx3FnVIhxydHB6XyetKJX8fIrvuS6S239v72PbSv8OpgTsZC4JFxg1sA1zwccDOIvOOgpQF7egL2YpmX6iQr9OZdo5PMO5M8FmnNznxtELGY18jfP70boM2+Pzpnc1/tNCi5owCgtywTokkpsw9LLzPn96JFqRBmietxlI0mHACl7JRxJaJEgmGRDgnC9JyyAcplnPzPkM2jtdkoe32xqsxtu0tNh638rvhxeOxvSLOrWALYpBmMdBzgR90sq4JkF9dq9keKBIquurrtPFDCySCZdKhCrpW9Q3+K3Pqp9rWV/g9TBqNxDljFnnj1hztGRDBitDUnPC5YwYbid4VaW/mJsnW3ucUJgymlJPtvMYMMKvBkSXjcQlFtdQEFRqjUxDAOafSRDxbiosJk0oV8gfIn57Deiu+X2o8JhguEaaEUxahRSYkNDJRui6Ms4hENTAL2keGhycE7aXJbC+Pc6jX9wL1XZVDqdkBvUtrs0UODvPsXLlmXHpgqYoo4PJocXpx68m/ZOleiTi3L26101KEoLCDLt2+nWbxMxpg3+1uw9VU3+JXKhAuQ9KFWtLmQiQUds8EyRfsgxcrATDQ3MehCbpV8qzau4WzQ4i9wII3AOcCcaK8IzD1lZPMre9gDZ4ibqmPelOTbl/UAK+Ojg4PNDrvP768LqD1vpAsLXDPLsifgIO/fE4WLAKk+FzPgOgLJAhJCpStIn55ZRQShz66YAmVTJnzWgOwCezckdsMJkSpGiM4LY1HjoUvChgOWwGnWbehXoUbBJIk6PcMoIRyxxF0l9pHyxgtTnLcLV33mmsWg6W/xMINtFXY52uLgTxKiFRrK34uyFeKhfCk5tnP5UzzJa8iKI1B7gpC4RrLealvT7caAu2VhrMDpDIfIasyjqOjw4rmODo6LAxKuVAPuzQSoAMjxA5zEcarfzHn3nVz8O3ovZKwVfauf8DeBed5kR+A8HsBDH5t0DmrJWHqXVih3kU1Hbvzxm7L1HCdqwX9TTLpnmp5nenJajPFtaiBlBJEFqnMxwND10/emrdLAPKFig9oQuSSkGIKg1wybauWNujvjY6mVPBf0Gg/DjSadtp2JQQjaH21ToTdZq+07+pbkLevau1OPd4V+1YxnvAX6Bv6C/TtUaBvO0wp/myar7FR/BEUgjv284aqfBC4K1eMKGAouaoR8Kg2b+HmLLnHzr8wcYZiFQlzyVbJB5TQgfJ0AITtA+KqbygRZke1SFJowQCtBusQMY2sm2wDUThBGPJ9jMENu7Xw4sOLLSBgflq8vu8J1fcXSl8tSt/PDtD3J8Dm+96wfH8h8m1E5PvuYHx/4fBpo2KMZzaM6JkWKP+2gYGh27BmRl6Hli2IAcRDE86W3hmij673YAJdYs6WSCmvBI537akylC8L2UIZh85XN6fqmRuq9ZO3sAmIK0T5DbSE6a3MEno9twWaVgvmTgaUk64yqBGeYk4Lg/rhg8AlPeDJx7ggH+W5vmd/0DjGB/2gg15qbvxvNLj+bDiDPo5QtzfuaufmPQ7VF/+1j87TNCa/ksm/qDw47vSDbtDtu+G9/Nfbm/fvWvqdf5Lwju0jU5zuoNsLOug9m9CYHHT7F92jU0Pug+POkbmn4Ygugile0HhXUbePI6TbRy+tT8RJNMeyhSIyoThpoSknZCKiFlrSJGJLsV+9nAtPVsb9cxz5fEwJxx5QorUNwRux+bku9ZZDmZQVZZ206Lxnv+N7UqbWHeEJ2ZUZX5mD7s0NW6ce4OWqFXIUHAWddrfba89IQjgNy6P/SVyAFby2x/Qep1cx97/KlLHW6bfirO3PrOeQJJKJFsomWSKzdWsY8yWtrOHdpgZWBt9UHrudoFvWlLsdaqmw6JqdU2l3z766j41mNJbVv9+df2hiU6nnisU5dYTfFZ4/7fSC7hck8eyl2PfrfNooChY6/IUFoskMckaUaU70P6F9LAQL9W06Xc45sUeC4C+AQ6Fm7SCGvbqnujNTCdmhf5nnPuiT0UDNvm4WnISMR6o5msxiM1uJZwA1C0eoGSQiwOVByzyvnPSXNk3aXxBJQpyKTI9StIy7UzcyVDjtdKW4TNM+MC52x7qCJIJxg0T834TctdCvlBMxx/xuH84sAQrX4PHaysocT6c0rFCCJgnhK7mqm0D6ITO5nMECvbShNNOq+a04//0Vk1w/vQIo9bazXDO9AiYBJOXYcyrliUYRNZJlx1OQFSiDFOl0aUMOiWcz0AWmyY8Te8vDE24rvYEv5eYub4382cdNk062fXcW8tfdqjCplNYJjqgIOQGnu7zCTJswAq+9VXzxyjeZ2k0t7dH5VZ62cG12FpyBCV0NtaVogKhNHrujflVf/23DRvwNPJ+PqQZs1DMAl3mbObBMChqR9RNxWj+LE8LxhMa2RKFV/5UfVu8DahsoNNQgiI9rukaViL69uH/vNrBGuJMGSH5H/CmUUzcGgdLnfkY5TERW6ILhdMdhj1vAfpN6Y02itlvfL6d+DHQI7ovqa/R5dLGv/gFmLo7hQddo/gKWeAI7EUeXZt3uF87ecmyALxmOH8QswzwK9L+DkC0OvizJZE7i9GDKxpBBFh/cJWwZk2hGVNMHhQmOLS4rEcFcLv7z/6AhN7AiMfJnf9uvzQ6yqYn2eKV6+vXLf/bsvPZ+2wJ+pwZ8fhdAuMWO3KWSAhVEyHhuWRaYkzvpflITXEYCBIfwXoiDCmjt4N+jUVNKeCP+Yb2iClVL9VerJIXFZ/Ys4bZwHMNu6PdW9/aK5RHeEw//F3TYwRR/ATGPX4T3ZAyniWNvcGIccoIlif4zgEIZrltft1Ki9+KLrykTSnMM/n3hz/C3Cn+vErTA4ccR0tfgUC/o9oLjlp/GUySHSRT8dD3Y4hY+SbIFOD07XSBWi3onKB5sDRVrWFNdHHUsqlkdF01JsGN0eD1joxpeXg33beKEqSif5lnP9Zsl0gfYAbryz5xNDfpyB6ZRez5VpWt592gq+ss5lmMqxmoJ0GjfyHpZxl3rFVm/Gv5Ww6N2r9M9a3c6nc4WcDC7RTY/R5zYGqKrFEzBfjbaRt8gWVBJZ9r9cbSwzHDSH5X4UiZMPUfCGW1PaKK+hXBeOKP/UP947eh43O1uQUYleOOdCr/xIhlHIsRJvahWJq9m0u10T4NthEK1nxAe3JMkYru6YX9TLNdd2eBhCEgPoYo7ThI8iTeY6/6EGCeBsrwaTGYaM1xbjP2XkWpGp8NwnMzM0Vcn6CiLu9sJOjqYCP+02FNzghZMSCTIPeF+rvkbZWIK0yJT3qey2IQgQizgrA20dhozKi1RFkRyGgr0UkPro3s4ys+vn+g0769QqDzl9J7GZEbMZS5zSiwJ17fa9lumkkreqn/mq9pw7arXZhyahTJcOmsCxrRvrnqFLCUrjIAa88ua6iC67chg8e1XLNV+0N+OxSS5p5wBPlejo6xvxOsLf1ibmI6TB+QuMYCUGA610GM4BAeylBPALPsBWCTJImX8R+LOjRnRJsbA2c8Cy0wTWpE0MpB6MItWYb+2vAqfb100pPBuY+XgyH/ANtpS0NrOdX754d/D/XyzV64xlVjSex8Z5Z5wkE+c3NFkBiHqvXdsuddCe+9JRLPFnpbmvbd0Nt8DFig3Dd33FFOd+nQtgiSIcgBSQzC4viR0lbd1GHRMZu4DxBAjMqVJ8SKXaiF/uMAjT4rgCSoQWyaAGxuhBU7wTMeeLq8+jW6Cj3zWQldJGKCX8IVSnujzqK1BUhIGqIBT6rlafIYTV65lOWdKGVBhL0NKhuYkTkHvQ0RdkBCEU1m2oCeU9ZWyxC8RQ/BCIBxyJrThvGQ8jlaIaHIfBQkVMpixe4hZtI0qAnGtKgN9ONJMVA1LdmhdOK7XWhiQ1KqoB4rCboK2/AvPUyGQ2ksZp9IwAnEyw7r+pKcCHkfBihGvugld17VUbCuCvEITXU4TJ+Gccf2xHVqX2cQj3+hnCpT5P9D2wN55MeUoJ1DU0Bxd2KxIWEpxbG7LKWZAEK4ueqhPyywScoF9OKbuNlyK5dw+7D1YM0D1NyQpJyEU12nDzcv8RYgdwidaPDhTanuW5zDb8U2g8CVdkD9sNs7q4enAaenhBZ1px/4VkjwjxdY1RQrNMh+ERn8Y14nziqk7/oDdBnvJLOPAFN1Z3fwakF5xyH9u7bSg0cfydG3LirgCADsCmgiJcwd0I40Aoly/i+y7iEZ2WYQxy6J8BQzUR7sRcbXMcYQlrl8U782v2poIC6+Cx5ofJOAoGsMDY9ukejIkQmhvxa6RwqzhhSDlTElDnmCbXxHXv7S/rpcNP8nLvKJW6j/huoeesXaYajqnCzwjNV3jBW3jSRh1e4e1+jTv/Uq1gK6GzhHXdLKsMHL5Ap0rEYGHWBz5K8QOSBEucCQBIm+QsdqH18qZ14cdYO6kr+/GTcg9v3VPDZZNqa+m68frbYHDOU0IKJdGnZkXAu+Fpn35fsW4gSZd/1bTXo2MN2VcZX017YeTWW42r++j8Ght+1YfRSy8A1k1CmloP9csL/0bEhLDIXQca6Qd0Eb6N7WuxZxxOdZbQm5ZWTtA99d2ymjFfu2GhWqOB4uvFJSI3pb8Wuv1xPIIVv9KLdFWdKU0zva9gabzFtSWvZbebNbp47szlz3RC3TzcfjxFXrLlsquWWCAORbkH5WxFCwMtN7KQKv1OXI6XQ8hsJKrNv5cbt/qTzWNXCVT5kur2RbU68jqGk9A1fe14mn2jYvByM+hoTZrJCChCB4WBn/+hTkExqYiunKe8jdLlzWYA5lZLemrWVO4UVEPjr6JvNOcInDUlLO92i8TwSSjcbXLKkfd7r3XPR12O2d7zYbzcYSgBz/wXj+QkEWkdh2sG4uQnMhw3nwwthd9JSt5cBJ4l00IT4iEkxAjh//yv6tpN//dGXtFyy1vFPlSuF6r5i9t1KyFQa+XuTLFUxbVq52tFrNHgZTpkipV5qqushod/tierlmEPl8Nqx2p/4oUh883qbzFamcsqqj8J3Zm872rnRl1+fcnK2bv5/ECpylNZubZvb83XEXeiM1GssBpdchwb0ufp/1w4/bGVj94TqD0iiDyeVmct7uC0RFJY/awKPm3T+84b3dFx8oQJNMsfvYpew2v6HqDHfTYjl2zG7utN/qe3q9u12wwRpfnu8u1+6KmXfNjvq84p7ZuH8jbRlttAuRrU7PT9BCQryTMpHceimpMTzPj31nM7ihu40yyiAo46sin/3/1r2hofnlA/nPI87w3Rk9qmvJ3YTMO1+SquKJ5LtDhpeLJxhbhNJvgbxI62NQNwEvzr++TrgtGr+juAodzc2tRAxG69BJTMs4gbhAKqHAuU9gU7BISc5mlNsajG9KQNwud2eICgtIALeMFkWpi3Jx2Ad+IBJNcAzPAF+pjy6RPwNAgRo5jgBwROmx+dd2yoSUQdxq14B4yHH8VhgTBcimAMvUkNNm2KWdRFsrtCQn5gG7tmmaUmejmtq7bR4tLodtfhLu58tLreX9D117qxJY963ctqfPpe7IgEM+SRJe+qh+HhYrduvfPn94ZsH7lqkB3RlphJOuIHma8eQ2pvNdfHTiind8SCyfixqXEmZyTRLqsUA1k56K+pYOPPZNQNSeYSzjbMCh+eyXdtULtmKdXKu+VUXvo1bxdjNSv1vheIG4Vv9b0aflmO9WLsdYOf7ZOCtwpxzxqEllL8/WzSf3h1PxgUMr5K40PVGMyPNWJKUwL4EF+ZxNzBQLSKicPuRgF33GiUVbILEW1glmZ7A2TOPYwLJEkQta1tW4imaidhgdPWNv30G5QNLE48iFLIlFj6fp4ZGiD3ZPxOKi8ULZ3VgypyPtzg7ac8dhCjBWOcW9lmN620K2Mhfq/uZTqo9r24N/itmahedGmJhMp4XY9ciJvbQlaNnWwCtoQMJxXVsBAq3FIIEhmEG2xz9Iig91LSvivrmtmSdPKHOlKGSxFw67XjvLKH1VxJPYoslVoD/CMaXpbh0HNiWDxPYkQTR20tju3yjgHC415aBVF56sg9yaPKqrw5TEBV339jXHFBKu5Q8AShuooGhiNOEpIBlc6criiquc0J+HduKwKHjG0cyTZHUmsyaqh6qlSdjghLBPxA6LJPbsjkcXrmerOhb6Lmt/kBCDcHCbs6lpHdOFhu6vbK6LDDyOTmF2dGhwWp7iq+BSZxpD101DV0wUx+WJg3aQ6h8NcEQOrG2xnaSpRCA39PDdj1oVT1VPKiCZJ5D0MX1uTLSFfJeiTKItJpF8O/mZtFZEtFhjuWVlj5b0RAPNLQxslbwdttlH2rovQ0oCpp/M6yIJCjS/jfGAzXo3m5mRTc9jDfItSRhODvW5y8DXXqZyj2wWLQO3Ft8HeBvOnRmAhnZHw5ht47te5gel8c6hzS/w6avkJ2LIqUc/X8RTTmESO6UYReUxXKhvFjN1laUOG5200YHg+VK+jwvHIao78sFvYc+9D+ZaQJeWyzyu2BS6rpFlrgDkjyO4f+oYysNKUj4CAid3cgu9lk1n1xMI70fcEdfRx8K9RXznfXxurJttGPY1WMMXvSKMTkKhEglUSuzVXSiv53QjF+IFwxEESJKep3naacsNgEdSypDyQDYNBbqfyBMbVCtfBH4t7cE+xJZt6yKigSnOuCl+e2uc1IlmB9EHp9bppo3WCiNYJY2XyqwXSSqQummXFUfHK1CSHzGvFtoZimVffaiyTnmQUBHKTCvUrJidMjsGqK9bgQwU7piCoNu3xFToJTl1ObZV0eX4kTdAU3+uokXJXbBzqa79z5pX/uw3QBeYxhSJESsawNBFJE2oyMvGLKJZdVnZboWzfppn61RU3zWkFER45Uej5NkDvsHzGWX53BeNqY+5MxUxpovSLGqrrzNMcMSc4evA0iEH2qDTsA8YUf/lemqTcTwEWZDURSyIowaJDX8sFH72c0nUTtZ2r9xvzsJ5i7jg3hkR1Se91rmGVfKv8P/23MpV+BQ3eUV2e2FTY9PvXp/Lo5ej8w36gM1QhXxzdY/6gfPe6Ou35H87knEGuPlyd8agLl50nmTQhXgCd1Ogsuf1GRAscyHoaIPRSNbqkcRRiHglzZa2Ae1xch/ovx0v4u1cT5ZcaEq2EHyszrFCyuMylOv5vXjOaLONSELbY8ir+N5KAFTJg7g2Axf5y8AEwqtT0lMHu889xtkpgj8RDOqMDwiWUwkZv6WyOzoXIOCR3jzQ4z+C8dmwbiY/WxmrL9KwJzRaJueaBSgx3VScRFZImswxKZH473g39bg3rhk9l3eD151ELfXztWHiVhFDIdrlcBhGdUdWkrm47+PB6HaNrqfpE5ueZQbJik/hkjur0UvXeQdGQ2FzguWxOVKjX6/Q67c5Ju3uMOoevuv1Xh2f/C0ofP0XNVMo972C25erODWbaPWt3TmGm3VdHnVe9/tNnqqtMjO/IwxjHMyWs88WON8Fz24+DG9NwQrJQGuOO1KwaR4tPo6o4P27WYcbvV3H5uWZ8o4Pl98Sdh0GliDhWD4Tmp3zeyHFClwSuU10if8hdqFxDr4QKmfZ73WciGvmasoTU+MlrbM0CRS5MA3llJsKhDExRAPKKyNtM9rjfPzx5ppkK+scq6dg8S7hDSf/I7xrlLIb8S2VnT6hcZzr1OkenT5mKIJzieKyjrDsWc4Mtr7u0gV2w2JzM1++OcGQImlBIkoQPrTqJnxpoHii4AyKRznGiq6e3EJVeKUmdlC1NTWEGtm/MEn05NUtTXYW8ppNwjjkOJeHrWNLvX755czY4GV68ueycnXbOht3eYHD+JIUk6CzBMlPU/kZa+KpYPcJnjBuMr4g+EWWwEgDCoLWupHZgdCQYbtOhdziZoQF/SCVDMZ1w5cm8HBHiEGVmVM6zCSC+zViMk9nBjB1MYjY5mLFu0D06EDw8gEgVO1BuH/wnmLEX7w4PT9rvDvuH+2sYpSyk/nH7iXuF8dV+Np9DOKfDTHCtjcp7gZhjTqJgFrMJjoMpFjJ+CBJSZ8r/5VM8j09R1pg2bKBWbiOnYnTzeoBjOmU8obiF3r0e4QRdKl+BipApp+MS+KhBFcC/eHZOlwCov81W5OFRQ7hJ7whrdMVhMxXhrnZJmfpHkTc311smyJkW0FYHkaqb7cLoeRYjanAQ6dUkQI89hhyZo8eMx87cNaSpiQqX0TVW6dV1AcEJix6eGBD0w8Ri/oh1vyFWrP7eYuHK3EKanJ08TMBlFzmnCHJD7DNCpwVolIYQQ332eTnollNVV7yqP+Csn8SGCdgdxDWNpiyO2VKPFXOw3gEBwyFeJjJA77CQiAJUrUmboPoKny22rGwPcJErPSqi2IbUG2LOlkn9fOcER8pm+3vtfCu3chBgGOJJTFaorE2Bf8jUSFiiGaE7t5zN863MatX8e/YDEWj9aSciNlGq8dopDOf/V+jijg9MYV0T4pg8SPCADD2guJFJTFhyKiWBmliV1vLVAY+aZHqtT8zIGQ+8TksHLZUGKwcvdcj8+Z8uqUqneWe2rqpqXg0JJJRxjd6OUcoJFCKV9S67+jM+UcSIbkmpgYdSCXIJ+aZw6pYUE29KbGIwEHgHFMiWim5np851nVlRG2+lq5vKG/OZVMwSwRpJqpATWLPp6D9Nxr8kAe1QEtSSJ2OjBh4lCWvlQJisQn1ObYr2+5qnRmNUNcWqo9of8Gh2hQGgJXysN6fnIXPxvNvqeJ/gLofT/1IRv0a5621ArU1vl4A8rsK+abS/4kfOuerC3XjI/mfhnLWcHpmcVslJ4ERySu5J5K5CmRxWGAoyYwnqBwMK6Nm1tT88e0XOCgqSHCdCA6wGaKTkSVu+VX8REowplNe8GVwXatlISRapDNBFEhm7GU6ucv1ddfaoyTIubBA/8l7wo0ixcYhpuPAd4qvB++uGjrB5E23jCF9d69TzZj6wUTaiYm5vlaz8wcSzp0hNDl2Ec/bJNAz67jlSXV3L6JOnID+RVMlD0cpvaOM/d5KrzSgMfW6r9bdVGmG4NcdVF1aVPyadMGV8m4zs0uNPCoQA6LhFF39uN9CR/kdJvK1V837ybUlZb+G25flKP4ry24FbvYaiuZujPglJ0px65KsOI5fI+6MQ6n8CAAD//+Z4xbw="
+	return "eNrsvWlX21jWKPy9foVeeq1L6McWNjO5b697HSAVVieEClRVd3V6Ydk6tlWRJZckQ1zPev773cMZJRlMgsjQVA+FbekM++yz5+Ev3q+9d2enZz/+f95x6iVp4YkwKrxiEuXeKIqFF0aZGBbxouXB1zdB7o1FIrKgEKE3WMBzwjs5uvBmWfo7PNb64S/eIMjhtzSh769Flkfwd9ff9Ts+/HoeC/jdu45yGG5SFLP8+ebmOCom84E/TKebIg7yIhpuimHuFamXz8djkRfecBIk8Ad+hcOOIhGHuf/DD23vg1g89+DpHzyviIpYPMcH4EMo8mEWzQqYnb7yXsp3PPn2c/ir7SXBFF5Z/79FNIV5gulsHb72vFhci/i5N0wzQZ8z8cccABE+94pszl8Vixm8GQIk6KMz3/oxfL2JY3o3E5EQmGDEpPDSLBpHCYIPVu/RP5cIa/gvPhTq98THIguGCOZRlk7NCC2cOBoGcbyAVc0ykcOXUTKmieSIZrraA8vTeTYUev7TkfUC/+ZN4L0kVauNPQ2eFqPGdRDPBS1aL2aWzuYxTiOHlZONogzOj7bkLgvQSkTXZlWzaCbiKDHreidhzufljdLMg4l4hNzncxIfYU146Otbne5eu7Pb3tq+7Bw87+w+397xD3a3f1u3jjkOBiLOaw+YTzMdIBbTF/znFX8PSHaTZmHNQR/N8wKOBx7YZJjMAtiw3sNRkHgD4c3xSgDuBmHoTUUReFEC25kGOAh+L/fkXUzSOWwVr+EwTYogSrwE4I73iZZD6Iv/9AAQNF/uBRmcaJEioACqcqV6AScKQP0wHX4QWd8LktDrfzjI+xIcFUj+91owm8Vwqri6tefe2ihN24MgW2t5ayK5xm/guofzIf3+PzaAAUnyYCxugXABeF0DxpdwuHE6loAgfJBjydOX4OCf8En5c8tLYYxp9KfGO8ST60jc4J0A+AX0NH4hMg0VnC6Hmzws5gg3eCL3boAIpfMC4GPQ3lkDTAWTZ5J8eEM+WlgYQEokFubDgeLpwtST+TRI2pkIwmAAtDSfT6dBtvBS68bZ13A6j4sIDkHNm8OpRDle+YlYmAmnA7gmIWwOJkoT/XT5IF+JOE69X9MsDq0jKoLxbTfAxvRonMCPV8EgvYZfup2tnerJvYb14X7ke7lGdZjHE8Fwonbp4ti/bBRivNpa+7eNSrChhDFFkvWe/mKcpfPZc2+rBo8uAaz0pj4leY0kcQ082M28kGRwVNzg7UECWiCDG8mjCJIFwjzAWxjHeO9aME/BfwDqpINcZNd4PIyuKaLZJMWTgl+L4AP8NAU+B8g1xQfksPqx8u0E8p8M43kovBciQDpAe4UxggWQvDz1snmCb8t5gb4QR6ON+n+VW5VD5hMkkoAnmh4TZuP6gyjOFe4xkGDcBO9JygDCtVn7y+SQwFkym3pPgD4IxEDcLN1UvVWi7AiARGIj0I4CyBmeudrsc++UpxuiJADroU3TvcWL2DLr8xEVPCmJDOAp37q/vfM3JJNIzuluSJ44LHQTtxIBu/MMbtjUN0yFAh2RXRI0ABUYW2Bw5K8wGODceOL9MRdzHD9fAFWe5l4cfRDe34PRh6AF/CqMGD8At4dwJ+FBdSjy8XwOFwIg9Br2WQT5xON9eBcEbgkyvoiE5AxCLa6Y2yFmE4B3FsRXkaI68j4DfRVJaGhR5VYvvdflu3Si5vCiEK8IrCNj9AGoMCCfAZyQAhGZyjc0XiuhBlkZABrFAyXBBcMszZH7AwAyvE8DuI59Pu4o7NN54ElIYFhE4yDYGe12OiMHEOXta3L2WVv/OYn+QPnm/vvW/BZRlBGb3rshxg7XktA4CpduL3S2h//fxAal2EL3y6YIlROEHfNTTA6ZBY1BbiO5BT7ya/y0/Hki4tloHuMlwkstd6gHLm5SEMb5QsNVBDxIhlKOKdGjHCcmooRIItmpZ9ipmAUZ3WI9NiwiESJkBeRmEsF1q0ylbzZwUpwM5Wtr38CHQfJVlIe2yiRJfQVsA3YfixHoStNZsageJRA95xTxoJo4xUt4dfnxKWqHE4C0EywAxvEN/kvDFmXBfKJQk49ViuP8LnJz34Am0TRbQ9U8yygup4Dh9CPEwgAZ7IM3J1ZGAOfwpyBBoE5QBbE9joKz1DYbAPUvUo91gV1a0x6ouJ12NtyyxZjckWHmRZqk03SeexfEEu6QZ3pwv8wrzEW8Z72LDb6YUjqRCwNRJxGkMZ4mhcgSUXjnWVqk8JRc6bPT8w0PJiN9EVTHUfQR4D4HdsGMHIWlLI1xMKRucHenABu4UXBy2QeQtFGPTDMUeJSSJ0DcGOELgYf8Dm5lEMKtArKIN/NaCVc4VphOWRIDlJB6K29iOk3hig1jEWTxQkN/REKuXm0KGsmCBEtYaCQ36K/MMJP5dKAFmttYZZxqru0chWQJPA4qoumQhCu5osoxSXlDf60RXp6iHAgO82wDjgAHBy6pOU7OwrMGPd+JU2ffFup1d7t7h86G02wcJNGfRB79Khv5HDGB1JQrG8oWqVP6XY3KxzJWNgUJcAQ0wXAEOOwAxuQh3R+dM3hr7Ynmq8DhxzRFHHz9+si6g8M4KukSR+abW5SJnnwTL5vCxyCXCBgVEd4FRn11TPIK4vKA86nFsZKQiXGQhSQ8omyYJiD7mOdZcBxEbG6DL0DsGsXpDZpJUK9yVNfLo3M5KnMms8zK2vALfNxaGV1AuH1aZcBnLv555s2C4QdRPAN5hmZhbXcmSUhlKjYroWjnTKp0nYxsZgItE0oaV1AC0pDkAS3G9y5SIPNKPgZ2Q08Clk+9NWUrS7M1o1kD1VLUSi4lKW0w56snf5Z6IJ8scCWlB5EeaAFAXktcFhyRPGYzhb1+1mglEqkJkHvN8zkCRI5qFDB4H5b3+zzhAyB9jDUsZcmsGczAF6ThypAoWPF5telGKxOSNjzxeJtqHm0qpMvDohpao3IBIlURDYn2w0WVUp34yPJ6i4WoH7R0pWQ7eOw6wu1GfwqjXONGRUYKdx4V80AeB4hUi3Se6TnggscK+RRHQGo6TjNQvOFRJZTkRYQWvwTVS4m3bJ9EwQWOtED0QJAiwEAkiDVBA80vS2cZoCSQ1XsoVgATgFPelE5F2M5atMQtOaGUfzSZmQ6i8RzYBiyesJne0QTzBsGSw1hklwUtNCe71el5C4iR5LNoLkXG8hEeRDzxPe+fBrJSTCPDoaHXMFEW3Kg1Kbzv+/KLPoPMlTITVMKNEBnO2XbIrLHvR7M+LqXv87L6aEmZATSlmM8yOkgbRiBE6iJPzEhR/n8cA4c9P/Fwa1WDRSHyO0R76+zZwuO+5izkBf7A1h3tYZF3UqIEk87qUR3sOAtjxG5A6ZA0nMf3nTnHIvWHIFdfNWQgOEKZvfZ03qCOIIK4upwU/VCw4KbWdGYZK/RklfWdpRlw195UZECFahY5h+UvrqI8vRqmYSOg4ym804u3Hk5RWeFRb+mymjpNuaTaAz0KkiCsQorI493KNDx6NUsjzZtc5wBcRxADQubXILjQh8oK1v/bW4vJ1dTe3/b3ujsH250WfBUU8NXOrr/b2T3sHnj/s15Z5MPSxJINEK5/W/Fj6yeW+BV4gOGyDYSlMPhtDNItCGkZ3CCbsaL/Bhg8iZ0WAz1SfFNbmBjDo4wlqqFAjiGFb1AIgJUy42mRRWUSGdHWcCheXuzNJoscvbPawzFU1zq3lnCWFpYbl/w3EdsdpsQgAdBqt1U7zCAFESJph8PK2YC+A280edPe0Qy3XbT2T0fL1tXQVZNrqr1pP83FQLiAimZ3rEE/4CLn6bkW0hRFJGZhYxYbY5UhR7kWT8+vd/AL+PeeET5L8tY0GDYAmze9o2Wrdm3ehV+GS+21XgKbS1QvWUsCMMFEUmfgwJSz3qVWwL1nwh/70poE18QyFEhtUxmaHNeGviuWzolKLZkfQayN0wCudBCjWROv7gh0+htUeUjHR4sWuvDWK5ueAeu6n4CrhJy8yKJ6qdeGBo7/rcCDddt7yHvOrs/57U+S7rbcdVTOZBWhc/l5nMszWIb8SJ1AvchEeFUnVz4ce0PlZhKNJxhdZSZVMOK5W7SR2QzdKbzkfD5Q4qg+/5fGx8NsyhpO6qJorcAwEn9Msj1Geq2hNWHN+lx2PXE4jXQpofc9mxIrnmViGOWoa5EdJWDtlxyxFEY0H4D+CQsdjaKPekR65hnGmz3f3ORH+AnUsTZA1csWiKlo/EDDwccIWR+z18HCyyPY3AL92uZUWVvGaDXya3AsDSvm6Ecmpe9GwCfc++XrY+P8XRum/vzDWpWXGmC43qN0dkXH/wgYIUYjvMDXAmeVMo08w2cCdrHRYm/OhyS9SZSVzFmWJ0HfUuZIAtEsMGgvxyMWWUWe8rx6WISjgRBhz7eNNoQyyzDGHMRquEPfO2gDglzmN4sxtkbGhus0Y3MwTs4+qqkgM0k6WkYx4KReH/fOKRSCd3ysh7JRZb26OwG/xg1tDsV/jyZQMotfXcBoHsdX34lhBje8nnu4JZqOFIzgGgCAzvYKn+zFcKyFd4L+WyFRzIEN2Vm/GALS7M1jIG+ysRicahzKSMZc8f6Uq5wskpszEPNQAvGXrbNBddk+CZ6suohJkE8a09YZUkR3cB6kySCpZQJFXyfga8SGcSJQwMKSNFnY4aMsxFmoAvdCBrP0aRcYpIQGbfqAu+vrIEP494jPCoOmrDnR/AEsyThyPBUVXIdUjcQ0VVBJ62A0Z3UVD6cgfzGSdjFBaZutKhRcGCXVTVs0LSCa5niO03noOo7VF8v9xpxo4DHqaf8CDeWRM3SUBTr42IRVsgOIY5KUOkGRScvDKEfeGwEC+pDDm3I7fCrA/IstDp5C7BuJYgiyPhmVrNG9COblyFWzSMRcN+DaiZyNch2W4y5BjgurkCGxmZjCmtXTHryeA/5ZM5VXxmsKPBmzqTZkuwrlq9Ig5saG86BmIApOlZMrlQ+HjXKzVAmw+7gIh2SubY7qr18aAPFcFJRrO06iUAdayxsNrCoCmTGzFXYy+0UUXox8EK9hG0PVYUCRXEdZmkxdm5HBrd6vF3ryCKAtnTKE/97bdz96pyGHQlOQwLxMXKoC6t7e3v7+/sHBweFhyc/FIkYUozvjT+MJfGio9qx5PJwHocLuR8JpuirmElWIwzxvC7i37W7Jgifj15pDh1MVt3h6rKgXrVVdwvJCo3Z3a3tnd2//4LATDIZALDv1K25QHNBrtiNMq6u27I30ZTVQ8sFW9EbRAStm8lYwFlv+VITR3FXGQcu7BjTPHkGMYgqgJvTV5bTzfoKbHMTXP4GPtLzxcNbSFxnD7aJxVARxOhRBUuV0N3nF7JQ2JaZLm/gnXjebHaehuMph0gBZp8OX4RfvwvllOYMG8pqLcoKII64RpxtECSbr4KSenjRfPeSQg8PvEKEGaRrDAdU6qPknkmSDGQkLEcdZyrUg+GRUT9WnhnmK6z/cIS+ppcJlLeaNBb30wjCSIW1VKBOmg9QFbAPjMeRSauLQ5yyHyzSRMbLtYbaYFek4C2aAV57IMoxNJfNOeVS4M1Foe+RQjcrmgIJyPu+1CEDxmydW1BZfQ/WqeUXdTzO+CcwJMJ4W5JnhB1ET43/y7t3bd1c/n12++/ni8uT46t3bt5crn9GcMxIbclxd8PAOwdaor+mdCQOIMI8jHRVw9bJZ6oTh37kVAqNYhV/ecj3WLzB2ieVT+yhrjgeTTxyT9S94pgFF+pnXl71HaViceKdCm1okuSIdM1ojiaIyDipN4oWbg4VR9bCXnKPYAjIzUFYMYArLpoyH6593kQlZPxOu9XSHTSzEUlwKdC0yFPlAVh2jEF44hk5NQ5PClTRrr1vgAP+Ou7QKYAzjICIv0VjzDPvLW+KA9YNurKeMwqzk81oZhjMxxN3IRepVMBJI+7j0xgH2WYNYyeEWr8LgS8uqQYoOe/H00LlUoZIFclYMD7yHZtOk4cFsPgpd4S+aYvLqI9mmaDIdQsQLQkQbzKO4QD2wZmlFMG5oZQaz5LqCccnMbKWs3z69lbp+S/J6WUynWWUe+B2JZw1s2kRJaDmUcbYpQZRHB4KeBGMm/lFuEKEiRHHKvEVHrJBjm5Icl76+hZZYj94ems4E13qawo7YLb7pZo7XjGlFo98Vh87kR8ahf42B0k6c90rR0sYAwtUmHiha2ng4UfJ4ipZ+ipb+z46Wti+mCqqRpWW+VMi0TQqf4qaf4qaf4qaf4qaf4qaf4qaXx01bTOxbC552lt5QBHU0w9lsTn9H2LBw4oVnWXSNporjN79t1EUM060hPeSrCpqmKF3LOCN3SiYbAxvY32BBkDgWVGLo4XfYRBj0PcS2x4uFXorLXzogOqxIlE9R0U9R0U9R0U9R0U9R0U9R0U9R0U9R0U9R0U9R0f9RUdFhHDver9evVyjLu0rEFcWbxNEgCzJ0CYQLmI/VKAVyUKFU5WNZZJVMMvLnN+j25ip1dpFWWTIq9dbySUBJjs48a7JArgqfZUOPiqUbzHU1fArwEAWPR7XosdSuBN0ojeMUi04/V6v5q3fMG2jHUfJBzrfwnvV9AGB/Qxa+UyoiAOHXKAnTm9y8f8HLfcuROfBinta9B0j8sU0yW2XvlbU4y1jAh7oBp8Hw7cXqrkA3LM//huLeSit/CoP7+sPgykf2/UTFlXb2FCTXVJBcCdBPMXNL4IQSoz8Nd5uKyD/e5SnutR5g4d2GFnTxqtf9tBVt7e41tyYY/NNWtSvtt42sCga/36qaKmVu61hSuCmzTVNKcxrMcmX0tmk6tTpCC2+Uf6hemw/o14i3t3wl+a6w3VlQNKXWvURjBK0YJ6nsvezNe/5eCpbvueb09tb7T9oQWRhnIGI3ljOkys7wNJUDaqlkmNCj1hzTGXzZphjXB2XEsFNrYU3vtuQi/4TNngd2HMHdm8Phr2prpT/87q7dwun33Nmev+0f7nU6fnd/p7t7jy2qDj5XtNdGE93kRj8HWS/Oe6dnl/7JP07usUXZQKfpfclpPmd/a/o2vv/YO1FqLv39ViusTJvWVovnDxOnrP7x2cVdFoiXTqwtTggvYTsXsjSgoBok+Y2wWnfh7zIxWwqsIqJkV11K2dS8V2Mt0OGdkq1hLAquJM3Dqgr8fVg6pTk+p+dB+2bT5UJNYo9OVmdVipnNZaadkRyRp9Whwzk7S4Lctk3INbBYfYPNfPTZseUUeCSNU10lv9rfuE9ksLPjB49ZX8emCFkWLBQwGMryfXYTYSwpL8PLZdXzTIDwnVgGTdUMT5YBcxpnkHUb1iBBZuJ11dnwEWArDO7L5oQjw8gnRxembcY7LuHOY01Qhqe2CrYRYGq2wz+qyTHmF97Cdk88fDkCCY8Z0Y+intiPz11L6Bc3pByfU2ju9QoPGzVM59OW/NJYBeSmpqjx2R20+jhLHxdHqf+VbaBnQflGWihsmdAqHG1IwkpUqDaO8OUszfNowP6GkCqSI+cPjKlEGg1V3HH9QmGgIXe0ceLYSxjpD+OgsYh1ztkPODpHH4jKLQgZYyJqfMQxJVzYv0IsT89ql27VbWjExU2rtagjRyyUOkXKywGKLsWjqzg6fhXD1HPle6EsayJYCiT2gGrvFUG7C5xe/rcWCk3GLV66TnjEOCtdubR0YMBU5j53GwcF0hgC+z066705wQsxEAgsfD++RqOIRZzW13Ovz84SQ2IKK38hTVTjJXTa5LMUQawte9YgdC/hTmpahb5z6Wkvj6maG/apPYMKlu8j5xHUmLRyLDc3N/6SMAx1MkURf0a2AcKeMnMohuyaLKRIuWm/BIDaQ1A2J4CoTdjFiOiSk2cR5cMgg+X43m8iS1UO/ZRsNhMZisok1MBvYIDGU9TEtdfjaYN1DC4npobBJ5IYQk3XYiCCUGRXo1g1h2zC/E08G1a9BWMXcMxEJXlmj2Z2CpHMuJWRKXbw3Ov1Wt7lUct7dwz/g7978O8j+N/x2wrKan/au2PzZ+/WZMcHPSHcGsfu2W5qIIVoNjYtbzO02k8ZA3WbXscYSGIZJ9dYA1HW2iwy+ThMHPIaDWqr2+26jYFmNXHFD7556YlKEzaXsxjF6bDSHP0B1ABEBxZgHZnW0y1N7egl6sVYKNiZ5jAcWM7DsIxMkCEnoT3mUhj99PPJu386MNKU8dEkBtnmR3IL1kvuFA4cAt4kXySGWFqazfe0Oa1UkClJkzZoRPAJ+/UBb6SW1qCJPBsIbG60vUWJd7gCr7u1t9GycD/NnTcMLdcaErdjgsUGGIuJccmwC2IhY5rj/fHx8YYRw1/AffRyAPhEanx/zFNKatIjy6EA64IBdmcCNSPCBFnWHXKWUbFHtXFoCBHaI8DOQZuQwcHvi5b3PuO33ieEf0L6NO7FY/Uxf/FY2Kf4168m/lUjhQZ+k8igJyEVz1gW5AZNC8EKilYJhRxoQiqhTKygRRMh1DO1DGjguy3cZ9eXUCHUaDkwNyt0nIxKezVjrLUYRZIUY/mjmLoLAk1L6wXfeqA/RR8/RR9/QvSxwZ/HURCknnS7UNGDf0opF6yrXn1ODlGvYqIDKJ6eowwnqBZY3zZt9Es2BvVjX5n6JO5EcNBDIBp4vPMc0HMghsE815bpa4zoKhZKOXKiF7EXc8Q9jOWysKZakamWf7Q+q8KAWmjB7c9Tj6yiFnD6Rlyllu8wuDJnca+EUHzEt6eIJfbQLBLwS/S7CPKIQtT0iKa5HksqKNzCJpYrOmXTiftdt3zAJAk/hiKg5qpPNTx7S7FAd3RLflh6qi6HNvCrkI2wJQGNMinhn8u8qIehKddjOQgolAWNrjl1L7RcC047Q3psmAk7VApwSo8y4rWVfQSrrsIsQBn8pTvAWURpfmpjTlAAJij3/yydsfUVlgtD5Gmq+YrU1vh2ALXtodVWmmpKZTdKd3+5o0LZ81GPkzShQku14VdX1xs6LqCTo7tcQG9g5rZtrFbVmaQ1evXCfne1mcbGpxGgExU6e4AIBzSVKz8q8TENX9wM2sZ9rw/w8OVDfY7wV8soRV8R6UHDPsVpUrR3XGkf6nm/Yq0SOjM6QHTgWfIa0LYIHQ3ttjSSSgcGLgjhmcegPRRxXVFaazf0vhVcG2OyIulvmWxTGoS/41JVmuIQiGVQgr+naL/cQtWojG25bczBAEkHd/QXK4cwUxt65QySEZeEvguya2g4/swNbacsP/Bz0g0EChQVdAFoUglkBLMiBBSEj63W4ZYw99F2DD77qACxaWQUbWzZiqP794vabSAeh4DJRp+SO4EX+GgFx2rSQ2pWIA1NdyzDCr6v2awyVpVLag4/XKF08T2kQV1y8OWQmjcPhfb9EEQRWWcx+QhhQv+xmLnNy/XptvimyVK5hTax2eEL4uNQzEymsUUqfg+uAz8OkrF/No/j85TcESfqcZuGXJc6ip9cr9BQXDfyrS0kqLoj1weHx+nYtNaeUs11hxZoktPDR0sty5E8lHmy4sTUEAwGnPA91bTJaAqvU02ZiHFEyTCeyzru5LXBqALpKiNNCwbSY+ia4jiR2YQcTw0VqHQOxLKsUEXsZWl602Bd2tRZodFp7arnsxKLUPezE7dbXN6rpkv7AL5CMT/Q7ZilPINBASoDhCaTDc4F1fAfxilmKQGs5UncDW4uJaHuMTqokjkX24nRDYUdrqfcBYCCpusgaz1Ggb6gxAqNwzaYbfQwMJ6KaUoRKgBmjEOWw4UG0rKtNtYyULdTTMmQj3HS3oXgM+9z+TlkdH3edlTIAs9EFFTIBeWUaU++vsJ2RIJcKc6Lsd0lJr5aNf4Vqu18sq7Ao2sFQTkf3Pp70sqh68lwoUk7LCKx3kInLbZQQBQwIihGeki4qk7ofb9Sl58IRp8A0gbI9lteX96bNt0bQV9heFabxfywz74j5UFxuAHJ91bQCtfHhHmmVDynKklhelh7BuQUgdnmsCRXppBLb+Y4OAGGLtIIkAvUIJQlj3hOVSSNA71YwyYpNSj4RIwtjJQVadCSR4MDqcV7E5AZgmw4seOIy2djxD8+7rVBNPYGc6q3sYbrs0YENdA1qlkSeQyAltSuNMVzebJ9byGZhRbTubeItHLJx0ykDZqoomIhfWcsWUc50yw4cKsviZwRDwXwhgkNpxghGbPKIuXzgVpWGev1+EqNk/OSDQ3rBt7gClG3HLoHJfmO3JJligOaN8K9YiiU0jcsSFZVwzkcAIh6Vt2t5TLuw5kSTqV8ObTcnDqajjZFueKkX1NGnAU5q7qlCtlCI5ZiGqHInc4eUsBEO7tV6rKFxvYgC2P79In609MeyjFz/AMWhdsjPY70KWY0sL2MuAxq8VpkUpJdlDvWURm0yXKOd3pcPYadvZ0DF/hMge6gBaExRrjwlbeBB6m0oxGbxB9vUEvVtJW44ijKrIQaeJ1oG2LnmM4EoAGfyYoyi2Yipt4PS3A6jFCGGMriOf+X6ocWsF8mG4Co1leF3Qa1sK3kmpsLtjaivKeK8ehonDJLOcVAQMzXioo5K8MtGXKIjiU9rbxoA1GjcjPpVx+HdjRLojKtOWMpHlJCkazEE1NYDQtGtrVJRijIeEtGcUMkbLGFjoVeJaDzmeiMXQBtIalEaSXTFESJ1MT3mSEwyik1J4YfVS8XeO+DEDNvPmM3Ar1kXy4XqqhW80pdOCJr5RsH8GjZJ2vcu1Zuup1VtdXp7rU7u+2t7cvOwfPO7vPtHf9gd/83NwoRDdK5KJrOgZHTlALTEgci7FohR/iUS9liAK3VHQVViDRT7IbrewVDh8/AIy2p/8GfGy17cs1F0BREMs7C1K617usQ6KCVHUjtrsyy6dDRhTGdEs2mXGx0yyjLFg2Pco8zN6l6Okhumobz2KA+1/DgZG2WerAEMLe/SirD1DCbGUaC+RYs9PHOs1UKP9ZUyCq9GSWzOWjk8sckSFIZCaf0v3lhPxDkb4ACRLXPsIONcKRbizjHcmrHhuaRJ1BP62IS0ymGOt55/ixQbcKwZfJBFsbp58Q11tEiRWho9iRUqgCeaVSpLiKSVYK2lrEUs9QKNykzEsY3ZJzqeyVWOTnO5DNMB6QulqraN1jW4xVW8ngGItUEs9ng8gG84BuQ48cio3CbDXL+BTeSk2GhOiyhSX4py/YD5DYvMOaMTQZkeEXJsVoHTvWTqvur9+Lo+NGseqfHuBtdMt1SxkprPgh2RrudTlhqfQVS1GfIJJeaJxBeaKqKgULXKgJTUPHRDFO7KKAUM7BrEvlNvQkSBvqG4diyeAkvlbgAbD4dDudZhlYIppSGE2MwQHl0R5qyJ8AQ2MLOW+YEH+TXViV+TwtQXg5oVacDA2tlpRJvFyv9qIbl+Rw7GlK4RYDGBPjQ0pKC5L3KNTXJ0iTFiiR20Q9kNekHFRYQ5c8dWHn/f3lz5ht13P2VePau3+10f1s5OxrNH1+1nqsCuD5J0WXjDnsUcaC2GqVsm6T0FCU22D8XlTr8iupyAA612GI7nuWIU0WqtUPU2E1qNWgZH6y0FqZ3LLaP5yDhwG3AglxSkKG74FjHSnEHzLTc0UoyKu/Rm6Q3Uh5HUNEKnGwxu6QRSFegpYUkjy/IVXaDqjL2NtDXNBO4ZzJWmi9ZzCCAZGncsqv+4yh006kpDAVgYcu9ECkGpanpiHZuKUqOvoLcgmMss6xD7e1aICBc1Yg8sZB1P7XTxJapGhNkeRYrx4SinmkvZUlResWl+kAKCtOq+QyLluYKrdCQTyoyDc0aRTwfkyRQtaQYt3xANyFR0jPLwz0SBYn/gvQr7w2P3C+FnzmqoHFFkBkQn18mZ7qB25L2NwH3d0jU0fmgjAeIzoCOmb59P0v0v0VqWKJEo8ROsTCCpbswHV5ZPQzhsqJkEpJhlMuBkTorkDKJ0CA9Sv8yfoeigOEWi2ulS/ev+GxqSP0F6IbdQw+o/Nbe826HLd1HJy+fd/7XX7pbO//7QgAjhQ3wJzgW5CPUIkZk/F3Xl492O/IPIwUiLcjndE8xXXOB/B5jY9UL/O88G/6t20FHtN/1wrz425bf9bf8rXxW/A0kKbfOLlBGVIy+auaC6tOn8ha5v74KxgOlmwKxbcrFHMMysgYKyuTLMTpjEMUotWiDCkgYKsxa8w+q4s4GG05nFmGtCHOWFjJVgcU7ld5LNZ+lK8Ay9IeOiZKpBed3lRgf0mpVtMWi7oZ3lQDTota7bLFjnhgZm4i1QWvpPWQFiV6/EkQDDo0jJjBL50pf857pvfFnmWTG/NmEtenwXBbJ5B5J1zcV0UxyrK5Lo7Vv5qc4usUPcx1xxYQZDZFo8LUOeKVjvbbDSuTB2iFbL+cZ4ZMBSyITZiVlJ9MZJeSidJvn6VB6+PgclogchUPdTG0RHNyAYFRy0yJmqFkBOJbp/TlKFH2ndyvGkSiRhZTQiHIG1cKAhAqmqxhDqE8HLkpew0okWB+zje36hY5Pq7tnbESmW8XsWYXSXixyaXmq2pzRC21srFMWltwQcD2pUswUT6lpFOFhPYKbIBO3ZV/Jy0LsHtY2RekMI4/DDW5+PWLfiOxxJAcuF+HTIz7jsistU52kLbfYVjyo3Zuj6pSMN5ZVoSkFcrrmlAc/x3dqAu/nd68x9eWDiq2+vZidcoGUhQI1CldPJJ8vXBDLhyxhaI0ApM1I8C3NjpxEfktpeU7iKpJQ7BoiaxuSd4WIofbQcG+uCpDxdPPnm5uyqxUMG6YZBrdzz7XNv3Q6ZPpYVUvMovzDVW4x72XsfBSnQW2M0TsYwaMRSFzF+hIRRziXMTSXSASoHc9J/7aynzASjY35tDMyp0vXAxNpjDPzl6z9CjX7FXBs6SbWz8g0gBVladg7NtTimIQc7jreV72JDqINCGE15hTMS+ASlrIuLYa84rG7Bm55VbnAHKVj5taCctefgUPcSPNILhCdErMNhpoMjCT2xSU3SybLXPwxX/GG3q9HxYUcWLVWW0JrKXKr9CiFh/L6lSOATOF5xS3ZIq9M8MFNIYfbMkRbVCh911r1tfyTtndS32ptPtOG6Qq0sDResXjINhOcwcjBNnoC9/444tZt/qNfda64luL0iHZOuZWvwE8pM7dy9wZWuLQiTrkvfR7zmTKFWOEY+iQoeEfOGkklCkS3HPOuLIFIYqZt+SC2hyywtq6DZL56P5iimYypr2EffvBz+t1Xv/vop+77ivaqr01ShG1cNMGyXHNFTlEWc10nFVM11SbFXM3T44sNX2WTOW9ouUiiNcbGeeiRUDNyJDzK4ybE3bhF0xkHwSzfrhU1oTdcZSL75UjQlVrU3O62YJ/InY4LGQZkuy4qkSHGTb7Ed4H39E/TZbKBLIzbtQdnS3ghDOHAE7aCmKxgRLlmVxyJ0f+/kJgkmbVCdGN/ttgkX0CFHF6KCsRNlDuq1hDNSOxNUZOq/CKqUxDg9U8TkslPj+XkaydzDIPZ7E0xPTIMpmtWtnMwGGTimpUP9fjF5doG6wLeq1fPp1NDTLCOunyq3dl93umsbZTIaDXq9iszH4AIln1iCBZFK7mWgVJkEeZ6tjkWa404fYtRiuOaLN7hGUW1Et/F6Mk0HVS3BM87twK2JF0Nyd+ZWhYJ3hTlHoJsg1cUZU6pbau0rnK/sUcMpZIKP0CxLKrMs7ixRgQl7SGhsanAnJLIUtmcEuMUk2sM3h2r3bmq9wqKRUL3Vg3NKRRR0g7h3k4qo9e1avfYvUZCk451l7liCQXeYsT7UCzVTpZoJebGf5Z2Ml1I/WS6kFnWqKHQHJu7W/tdOLNBe7Q76LR3troH7YP9EfwVDHcO9jvB9sFIrFZlD+NI7Rj3l+rzLSHuPS5MWoqHpsIdFf8QhZpjyQu4m26wmAzZxl8pdk4FKePYcufq/F9S5VZZB0yKXZYphy44WXzVEakocPUZiNQmWpyiWLix6URiW7IShbYbwrnSlKfK7o2dXZXX4V8vT9/8W5VMzE28NzJZzJcCsYVeluH/0gpT0/g7oFRjNCPh46X9/FA2UGhT073ipjkW6zMEk/XXgfQSmxq6KFqooWstq8oEZ44y5/AtDI37QCYVtgLWhH8EBWxyMK90Nm6gSBHDXc9ns3/9JTeKYPJ8jSW7ATd0txnvFRAdClOjKiji4ySY52S+pAR2mIJ5i0utkSwIVftIxdPL64n8EN5vkS2XEonDlunvgzyKGgHYLhPxUQxhpS3gp2EokhaFQ/L/Yy5qS1JI4I+AyTWmw/V/raln11rAV7lG578/tdL6U2eIp84QT50hnjpDPHWG+MY7Q9SG9t9PdiA5iMYhYZDqRq8oLlBEHSOb874rLAyt8LWHkm6MQCBlroAjbCgTql7e4d90AVsaRh4gSw7zGdlx+lOcqi9VPrT7oW2vT7voW/oqB/tzHgfX3tZWPXy0hZrmUA+ntEm1bruCdwleTt7fQ7M4bpAsfdN5yVunF1TxXIaBu0Ql7DS1St3gUGfda3WGWrnLKBVJpuw82BADQC0bABa4lGYHyxRQ2eHmBPR2UNwU5PVOcbgrHuZzN1sf+J2RKMqFOG/ZrWuYIMKcCVhKYFmaTeuy2mg6K31iNhMZKrrMABzzHbHPWDsE7HKlq1IlAk2DTQ2IZOlJKmc5k3YltZxxYxVGz7NoioyA212iifHH0+ONW6/SerfT6boX3uiHTa+w3DugpsVg+QI8au+hL9Rg6At2EfqCrYJMLH5zyZmnOLaxEStBlalbov9WpqRKbPju3vbBtntbpsBOrxqsZvHm9M0Jx1Er7qKyP7m7JCqFbreiDH2eIqC4k8GisEwJ85xKMEhjIVYWjYIkwPp4m+zzpgTQzakIo6BNlmD7b//jpJjG/zrtnfWstFIQI9HvQE/8uyVZhip35nO5oJpcMpQ/ZiT3D2Q1QRNdRumNOvbb2rrKtFuV8E+bw6Q3iEg22LG6+xDFdo1dQW0pkfXO3k6nhEKfKZHWCKRakgwolJhUB/+OtluNdDeWsEFmruv9KE5p4v2djtQVkKninmVGmt4kjUWqsfkYJ1gnC0pGaX9386eHbe/1xer6UCsx6iJm6Set0kHS2XJp0Irw6+inoSVU3k/43Vx29k9dx566jj11HXvqOvYlu45ZoTzRn+Iz2pyw0QsHQTGCZDZLY35rK9dMPamUj4x4wOLK+LGm0HAXxNUdNwAkyMaiuPpOuNQl7Yb5FAVTLKbk63+0UnN0biShPmMsBCGGPNRyJRsV7NPuZB1c0Wi/EZRcyBDwMxkCMhMLbJVBfHZRshKw4LPcVqAtBSK14wB+lB9vCQOAR+xamdhJYcFJfOzUCozgT6Ym7tCmCxNpW7ob6yGbuab6FdtbpsuLcyo2BzyK4YTyxk2KAa7s9LxlaumxspG1MVcwjrRtfKUSmgDgpvxLR3h4tcLoG8wGFUFc8lygmgvAamo9Z46fS05WreecZnBHe1zYrrTAOSx7cRXl6VVDpUePeArv9OJtfbXpo17tkpo6Qbmc2kM8AoW8ZN1WWH3HUgD/r2apLXvZKiJwnqigiopY4gzGww/VG/7f3howqbXnXnt/29/r7hxsd1rwVVDAVzu7/m5n97B74P3P+mOpkus/4xVUIUMl4TTQoGkpfwcH2cFv4yxIMJ3Zdl1TO80hRVghsbFY7JFdjMSSLaJMpkpTpDVXWsJkBkyJppD5Fjvt7Cp/Vnk0XB7ILJNFzllylG/YIvLAMSKlno0mjYlCEjEze16kU6J+FnmrMvpBmhdp0g6HpSygMTzd5M16RzPcdrHaPx3VramhqyXXU3uzfpqLgRj+UGfnVvxLf7GcgyFTZeO1Vaq1JpydnslN8E7JOWKHta9eYLzZniJOsSjt8Sr0hik7ZCpkUsmylj7AbV8f986Rg/Y4LdN4z+xuIuuP03RtedFn3pTsS8kW300dpfUlTGW0IP+HmlJBEj9fqc93lBKecNUfQk+DkSbnhH4PYgwULiZTXVkWiB2HnlkxlOjf42g2rkRMYakTbpXFoeZvjndb5MDYIDyH2SS19r1eGKpljHTII0fgyiEGC0oYR9+fMiq5i2NijAtk2zXXs6AcsVzMggz7vCmKG+ROdPWzPMFwXHYrch7cJNi+2u1u3adp8WO7mh7fy/RlHEyP6VvS9ynNndrcr9TnW+OWKUi4HLcss7vJ0jAvuIwKllmxkqcwbwHf9f+qLsHSjPhqnC9NmiamyLNT5V8V0SZVkxSau4pB017ZSVOy0E6CLMR055Z3HWXFHKtOB9h0ALMbjtPhB5HpTqKZTN34+3yAKccU6Yo1Se8TXYyxqqB9YVhTA/z/bSnF2pmvIhF8PNi72tv5UhyWeSF8MmenUE2x2WU81gRWsOw5tMVXHATji5dwXzuQ80wUL07fXlS7fL2OkvnHmrHNoq2ZTLQZ8n1VQaAmXuPt2eXbi7er2tRA4PW/IkWalvO1K9O8yK9OobaX9ZUo1bikr16xxkU+Kddfp3KNZ/M1KtjWur6kku1KXQ2tZP2VHNvmSE6lYNPPQGdI36hU/b5aWZ8UG7y/sqGv0gqJH0tx6A6F9WH2I7VVlgPsuOFeruGoSqcF8U2wwNhtfKVFuYKy0oA2OqBdAoQhKnwh626LBMS8lAJ9nLbq8vyo9zSWDwXpca4KvvUHIiiIEPXLUJjdAYX6JpAkjEYz0/iw1HspGDYA3FfyMJfN2hSOnt2Kn1bXScZMCystbATE+KgKiUhCSUXl/sCsYQzuMb4zI8up9jZU2UH2WNcNPdC34csqINSlN4QHQqq2huIooZIh7tRVs3T4ae6PgmkUNxWBAYIpjw+qvHTSZCKktO1QDKIAGNMoE2KQhxhIROJw1d/GT1bWDcD7DvyfFXWHT92N0tExD7L7Wr3IC/cC4P0m/T24FmVoWQWmGjjl8h54Nr1sUrexZDUXcqmsfMff8TvtbnerTTp5NCyv/mEFqK/trO0IOgmyZYf7jzJklLXzsU5WzSfvM8p9KVCz+QBE9/ltdzjIbqLKHW42ZKiy+FXxEbvq7vjdxym7Icsrl9gKavBHcToPtTKu7ASm4p2Uajh4gUpo94stH6N959M+FdG5npZKGzqWAG0TchrrcfU7svDaLngjh5giQDXySKnqxGzFsNhlUTUX3KbASHK6qACb2d1j297adadH/vilHC4UttGkv4V2J+DXpsg6qpYeTaDkLb+6AGTDV98JfcYNr+cklik2DEr0NQAAI9yrzdXjAdYHOUGzsSgRN4INe4O+X4+ftcmv2vlnrfOx/YClRTTYOUQpnkR3yANHZXcyDr1yaDk1b5QECjtdpMliinUPTcY2gdCuLykLL/ZpF1HYR0zhD0r7Zv0H+0TzWZULHiShrABuV8+3mi45cHqc5sE6IZ7mrK7i4dTJL0bSLiZppkJtqXaEMf2bTTvZEAPuCKBNP0XhBFi8urw8v8Ph9lK5rXXMH75kNS+UnbMBgbJYVePCKkLU8smCMC4yi9V6sTOUyO8RaqFeGKThwrezqO5ZqNN+1QWuHe1bWqZHs1Y61xzsL1+iTPj5TlpKExelg78VIq9EHKdYaj8O6yHTwLldplyb4ZbTe4aLJaI1EQFK31WVpruzXX+Y2HA5bYofrjsg5alKqdlWeTtu6gyE1ipuC7dMBWxwVTIYKlugHqS7AIfpcD5V6W92c1WuwXeqKpeibnVydFETtj4WRQtTCPH/50UtmKjAddZY9tc7ObwpvGZDzl9Wfm2AhUFVxhJWXiutPZ+lWIn9sWkKT7sqUbEX+f1SldtgspysKNg8Nl2Rq/00wiIXzZVwrlZsfXuvSsouTGW9oFp/1U7Hjbdo1ohD61pmFeuSkcZknRciGwVDp7DhqfPl7UGhegA7MFT1hsJmlRn6HMeoCXN/RP7TnddzxF5K9ckEtkIQqlmtLMyblYsge7A4yq6MU2xsG8QYi5Rt6FFNA62POl1cj0V9qKg7kq6CCI8mTLJ9auFmdQ35QXd/5tqlehgDAl6cGgv7T6BdiEq3z2ATuKMNLhpir8OX8KkBRU3o1OqyHPCuIG8yM432TLOwk8KcmFEvWzUOaHV6xiqmmCN39mXTGoIySnJQPVrY6EP+kXnh9E/d4sOAHrZcZ5aUL64afNOYSm7gdXpcBpaD3gZaF2dvziv3BKt911C/zqobbDoJU52FWI4R1Tz3YtJZrQIrHLtNp17Lj7fEMR5XQgx1EW1VFHAqsCZVlE89q1KgbsZiJVtRZxkT1ki9UvRp3RnaWJnuB13tnrtOUw0xVX5Vz7+s45/H9dj1RFydXo1Jnk27bPtf+85G1Ft2q8FKnf/SDtH5jpuA5Vrj/1UX8cV+ZFkgjeCq2O9fyeqBCjT9gMGhDL57BE8SojbhR3irOn4gIHWUjzysakPPUU2dLoaX9DfkFJ6jh8pIjptTJyJVbt8p8icrf3EPaGy6kwrTXoAGYZeE3XQcC74n6+uF7iMNb7fsBuHcXQvOwjpPjU2I97rbAJUs0c187F4HG9a2L6iSNmMdI33/JsiSPrb4yzL8V0T/Z7hWENf0AKBim6X8LsClrIFzvSx1NuKJJC+h8m9cgYW5vCkXOic0t0uyOMUs4yBXUQLUnUephnoG4k6q5LI3BC0ynda7ndMM9CVslhwNua8fqB1pgV0EZ/4L9VdNs0MqGuBjw/cVaDh1ItQArkAIRyn1StElVIIoUW50iXbkQpcty/nWlHtDWVemLPxuLd1Kg+yojAUPtDmrlGEhMQcJY1HFufrSXvp4/d+D66AWMPNk2GDJiwpc5HSyguMkDSuguON88TY8WmdOdV2JcNr0W3XqDMr9zEn9tZ6wbOkjSqgBYlBwLkOBrWbs5gCzIHN64p5y1FJGlYc4l60vh1VGWQaeHd/E1f2zgIpY44huCX9hL85pJehsQ222VdmQ6upmOh1TCz/Z64M6IXAtpKEUrwPO7Gb/t0gA/altUQaayw3RBRTdpnAOoR0JNaS6rdiLx13y5zY6hRXKPqbI1oB2om3NDu0aqDgPss59dr9TCqAlx/ibhZYodX4OMcIVrh6X2FfJXVxauQ6tK3dPslpdLNXt8xXZYgXlsSDrngKCWgC5jgI5jO+dw6mg8UEI793Lo9zb3dnawaPc7u7t+DVb80FCj2LVwOfBu3BZO1QtptSEFdmq7Cq2O8qYNkhmV4hDuC15R8rVNINEsTzdXarjtC/b2q4ix9b2rTBqmD+pzjswaHsQoCKwMrBK+yCk3q/bi2oo9/AN19xjXtK47tOPWJgh4bQPvL8a4PyXllR9l/aYhm6objB91/0DZEsVIskSezSi0Mzdw25NMZnt3TqwOn2wPqcGTs2NKTdlu/vG1DX/kj2/EMaGYNiqismMLU/stpOv2Nyw4VjL1kpQragsXt7McVrbJOzWpeu+ZUrJCWQP+2FRal2G3OC21mXlJm4r9SvbvvXAm8xM+RqQwW3gZ7q1roIEZGZdggGWUvsFD99aRbXbgtRRtbGMDbm2yenM+uqOdHRlBnZzaNkePZ3OEymOcRkn7Pms+hqbhF2PhTKrQY/Mgc0da4584pMybtXoKtJAJ5W7LYN0B+F75LwaLbuxRiGsyYyja5HIPlrWrNIOA0hepMM0lqq+UtCzQQSyVRZZiMPFYGWz6gIvS84y8pRKp8mmRS0SSANsMI6TLVgRMA/nH2BDxiQTDf9oIecSoOJ/AL52g7JcplqZ2fVdUfPIo2IupXRThZy77toZrHItpsgTcqFQF3Uy3S3pSm2G6Pc+Pef6VnmLHBF5y7PGvIkyVVX3K/SMB9HUQa0aR+QqPVGXOiHX2QvJ3keSuMkPTicySPHeUGQfHotLZ/uycyi92Schoo/ARr0ZrUvqewDuhwSg3/L66rLKn1hUsfrZ5/NpDUfaOyiVaiIKUiyuGvNYYAkAjIijZnpsDk4oW1JtDhCKXXoSmwDvbgR25nNbG6rrZ9IPXfpnLHAB9TRpB7A8tIyhQzUJg4xwTFV/NuF2sVtf/7UIMllxGfuoyciEMdCu+YBiEhBB4mg8KTY18NpR2EYmUyP0PZ+8/a/8bOfVf735cffNPzcPJqfZP87/GO789tOfnb/VNGG4O532U85g7VgNrri/IteApFiC2n+fvBO4Hzpzz2jXz98n3nsNnPcgPEcJ0PwkhO/hA1B/61Mky0zyJ9WJkD/NE0Lc9/AfrGltjzkF8me1fiSiw8xLKjNT0wlOumBbmiFZdg57TE25KMk+9ygBmbqDReLG5zUsmViBBptoA78HBVtkvBBn0autySzEWQH+m0QeOZk9sp7UX6tayAjaDt4AUboB5Bbh1edkEwJRl3Hmpk2svK7WT9JeBlfxYzXso3u45XfhP66VFiukX7E61ZS/sHfW884VdThjze3ZnVXaFT1p8+KqX3C9dquH7YWkI8SvVLc59VYu6Q+wM+x9ThSMJB6Q9F5ii1GkcDn9JYMzTVeTdKwcAnMZnVm3p2o93VLd/9WqeX+SwUmKqz5NYjsuATMkNZa91pDIKtZ0HQeJfNg2AKpsdDZa0pBUs/6X170zxr4/2lHS/oO/KAL2d1ot6LweNm21Y6Z5QarpiYcT+xFbC+lvWWWbVm+tquSZnOfWmLQQzO2Ublwkk3yi2qp70AGM/wMtn8Esx5tP8hbKj6XYjZLy85sQIMH9CjQ5nwQAp42VwwpwA77cXUPXiYBeDS5wAk0+PW7A2kGD+u9b1WeaNrMsjGDpdu4Z7NF0XgOrJQPsrS64YhdFa0tB0lSDUNeuvJ0fKVz112gUlUrtYyfne4i/daKuHOSThF35bo24a36pEXjVjyX3NwCgVuTdciPmFL1uwqf0el8RSiOtMuURH32SJVteTLT8d9hDywrO0Lrl16cz6SQEHWeqVt0ECC/kXVWHbYkPrC9Twleg6tnhFv/O89jX0FNiroFwHCxQLJiHcAbFEP4vml3vtaPhFP4UxRBo8FcHeVjmo6TByvDEtxen1JYlZvH1xk5XVWj9GqHoI+x2GIKWfWIGewMZOJoSQL8+cOKi3eDZb5iPfg8cVLv55Si2ffSt/d1t9QWtmMdKc3S0+2IzB0beli7ezoU9KmZF7tSoA+lCgYXvWmp8jsrh4Lo7R2y7Mr5UMJHPcUPx3K29rlPDdbiPKivIg2IwMhcjkVstNXnHxL/xPDPnnnrZPFkdAB62c8DpfFXKplzmUNnr8xaoNgPSACM0YYJOm80psZ/BBX+BIkX7pXFVyRUlDxu1WbdwRwFZDmsvyZqR/NtxmpMGUBkaodo7fyNBk/uWQVvjp2XRDrjT5xKDtuQbKuYYPSPJovWD1Uqc95lrvMhVqCXjRm6E/1vgTbswpnnZZd57I2NPgPzNeWDv5PI1VclME0IhZfyCA8Bu7pb1Qg+j67lmgtwfgB14F1EyU/Cg6ECgkvewwgs7tPzB9Ut13X0Z1j9JWZ8zEexkErfCtFnNp3aXmC1uPI0xFrbB7//EeEh7CLgVHH2HziA5kbJ/gXbP0fhBNnUsTpY9lW3iwe1x+cpnwuH5qM8vCc/nWBjAGCzV+6fQK1m5KycN5muQ+E9h+vfW3Cow/O7j9is7/jYD+Ssb+pZlOXsL341RRG4KiXBTthFJhonOA99WvghtrLtld0B7jZvfosGU0mA8U8BCKbBOMgs1sqyHrrpqtUBokn/pQY/f/NbyXr1rea/FGJ9AFbPS6Q1jKYZXPIwongr7PhX2fSrs+1TY96mw71Nh3weo1WrnZrhM3fhiHkenU2nbzSt1aqZvV6uToz2pdZ+VfZ38x+l11S1/64qd2tG37e3+HlU7tatH1O2iZJhO7UCMT9PtTD56wKO6ep2vyFVFryN9To96h14Hz64Myk8L2TIhWabKTT2Pb6YW/Jve0fIFPFpvyyOTGV0FgpvPQ1Gh9CDZ8GW4sx3vrd90orsnIp5h+UWrRq9hdyMTCaSdFdqBEHC2JOgFupANp3Cm2ThIoj9ZpnbiIpLUTvamzEchQmxTVWgXqlxXLEaFJ6azYlETc3pF8XkXPz5Vm3+qNv9Ubf6p2vxTtfl7VZsH6hnOh0WD6bpyBm+5im4tMd/qdNw8Qrg3QdxsTLXS3eVkUjP3H6cq/0SW1S+XWSPrPBrGKGKCxEGMrndj5jLZ4MfqpKpjtc1I2MjRrytJo6Lps74R9/qKu1N9mjCnf83oX8Rp6Y80jgVVsWH7Af5lghJqcgQd7dmU87MStB4SqL/QwKsh3MViGoAEPLy70ODD9JxUh2IRRFMAxMhKTnRQ+fs7UijtcVQkiEgyjLgnhKIQEKdits5rxNiLIFmYWA22pzrIWEpytHMqc13PEEVJyjYNsixIxhTPM4riQkhrL1VfVkIilbugkN+EHlSCpl6G2c99KmB9gUrxrrjbnGrw5Vi9jVtKXDOcz0FbzaYuiE2tUBbzrapzpksO1KNpWuKAq1d3/Ca1gieVYGWV4BvWB56UgVpl4BvWBL56NcBOjlE1viT1Pre+upVoG56/nGYTj88LEACpcBVH36pZ1fpOC1O6S3VMrxlKvdbS3ixGMItwYF1sa1QqOqCHlgvhMWUgrBkLu0hxRdOhxcQ/r1n5g524PJN79ykfzKM4vGoWG9d7MiWy9tTw1tMqzDGNZD6kRAuT+a6woq5/kE4ZxeztqPAuXvU4SiHhKHRBGdRqiJqCAKOd0b44OAzDve6gc3hwMOhuCdHpdAaHB4d7ewd7+/vdzjBc1aA9nIjhh3zeFG06ksNXgKV2SHInlmlRVeqqWbMHg+2twzCA7W2L7Z3O4eFwPzwIwt3h4HB4uOPq2tbkDe3o2I0uofRqlwrolQN5S3QdniwdZ8GUlOAY1Ik57r1IJUrl5IrdxEIFWNNnU6B3IzIh554J+C95dgicV/kwnTXnPAzpaGDhk/TG3jDVqdMnKoPssFNOm0JaWt44TgdBXIELf123EbGKvgPaV33LAyR8lAVcuz4XcnEEbDFvzNXxmoeXBZM5V7wMOXXZ3eZRGMWg+xBJmFLMkhzRVtmwVMHF+fE/PDXdazScUP0YQ4zSPI8Ap0yGfT4LP1J2vRwy39yo0pkerHQi9MBbfuex3ESKRVhTGMxJSxmxxaSxVRQTqxKPOreoglDW6jbnebZJqL95JIA/Z5vjdLPrd7f8w3JnFCq5NWwKhK/QTjYL2GahJ/N+fvdau7uUBEOdEjBVX4kkkSlRurzqoC6zkiItQ2Rald+gYPPQFQkVxjjNRKp8ZGtr+642pQ9Y0E0aRKuyALkrZXiSkjdtFKN6xThzS1VVLyaB+8g0SAJT4dmTOcsqEwzwazYFfX32YdzyBpm4aXkJfjHGsKBkTl//HmTVOw+vrZzO2Kgkpg7UncXuZAJXyhb+Xbn/xHtF7WI+RfL/lZUj7xxIMKI+QFUM5/zns/OTDV2/dXWx2rVINhLbgyKrnKZS+7qlqv2FGP6KT8GXbdQSqtorlTsD0uAdpdkszdxkyztQonnRS281rMpg99zpeWCHQd+xMxy7Yd1Db62kXNxzW3v+tn+41wHteH+nu7vq/lSF6SvaaNNxaLjLz8HRi/Pe6dmlf/KPk1X316yDUG+qzkt4z82t6Rv4/mPvRBEj+rtsi15bLVdb5p069NH66nY/zEqGETVFvRcF41+0J8V0WJWZr277J6o3qYYDQXfTQkVZ68upfk4G976afkadVkcF6lxFsMhVEyieyouKXMSYHaxPF3c1izh3nPrQklqiyoCR9ZaXa4LpV7OijPPGLBRZFixkFSsCEkxGVRbQ/lMEGeEHwRE3FAzyNJ4XgiuNWlF2VHpV8zVLNnkDow+EdHMxZLDSiaAKrEkeUbdj68wqMoQ205IsPIiSzVw38W177Vj/iWqi/tDt+Pif7l4FkFeUbfM5ZZZfi2RcTLSqLpEFxybH3qK+ir0M25pzM1+7woUsM4cgwE+DORa3AbQK4kUOrwMeg5ash5wiR9aH5N2gPqGpAbVwxbYA5g55b6iQoX5hygdi1fiPpDrOPCKf57NoGKXz3LSMrch1Oysbg9JQXGHFtYDscuIjqJN31RsapCn2B6iD/Qv+iSPsZzgk5ed7ega7Rlgl0ajI5mL9E1fOLfm+mJ1wKLKCDVqqO2BNfKOFW6pF1DBbzAq0E80mQLGoc05urrM96nUQR6GdtUSto7BQi5wP62Jeo/3B1E2QLQbUq+YVladnxjdF1QJYaEJGQt182i6c/O7d23dXP59dvvv54vLk+Ord27eXn3pkc05TaSjD5oKHdzv7oHeOKv9mDyoJl3ZGQF7JsnXLXVq/wHCDXBZJMgddc3jecAKs2sK4X/DEWXYwry97T5EclFOo/AVa9jCTx+lgJftQsxZLOTZOiQ6M8Ia95By9S5RJwDOER2x/YCxdf9BbT5j9mWCup1kUPAJCMrcstagXW65Rshuja6ZwWCywvyBbeLKprFuztno3A+cs7rh494XTFBhReLViA6kv4591z+El9rqR6+aWVYRKxC9lYyLJM8vudyX1WO4Ykn5KUg8jNRaX0dy23PyswoY/XS5y5CGQg0j+Kck9qyTpUyxTg7Wfl8cFlaV8lr7dFDImKszeZIdBk+7BQVPkDeHKcLkdzWcXgruhkH+nQjoZYiknVy2EAxDo8vz88+lxC9WiKSxBajfej/Bl3rL5Y2DVtZ7i9cOtAlVSJaa5NLCu3ENOuequj1K45hnoedw/lpUGzLKrQI5yGBCFsdY/doHCYlWAOVNAl7HNZM9Pj71MoF/QLqVtal+r0lgj6rbC26O+AahDAh4jq8rLIWeeyp5E6KUgx1Vxcrg13NndDQ9Hh4fb+7sruwzNHfoOYj16JR3JxnVHR7rlPpegExVXD9EbBgdiEcXVXUwyOZdOF6iIWFWqaktSWt2SBihuS6amg2/NZOq+c9cJrn9rGxHIGYlUeKOuZc7+qkiEV9GfhrsNEbI3x7s8RXXSfBJ0G5r14lWve8u0W7t7zU0Mg98y9W53q7mpYfCaqb+TYLB1xVA4jM+REJD+oomLAxrYwy81DAzhmUZxnZulTDFmAbbf8b+M3agR48/9bT6rWHENmJ6sQo9pFZKA/3aNQ/UbeLIRff02oiUn9/2Yiuo3+GQxaspiVA/vJ8PRXeB6sh99F/YjeZ5PZqQnM9IXNyMpXPz6rUnNGIzuA6Ink9Lq0HpUy9I9l/V4tqf7L+wRrVP3X9wj2q9WX9xXbeF6JCPW6tCajVeSN+4V+X1q2KSkaBSbZVm6RO4Q6IHA8ZEt3vewZ+XVr9J49paYdR3lVs2x3drZuu/iZg8P23MaWkFu3ZvVL7V7z6USof+cLB/UR6OpcI5VivVVO9FWp7vX7uy2t7YvOwfPO7vPt3f8g93t3+6rARWTTASh//BQvqSBvdPjh0ADucoGI3jlcmtT2nn2due+i8ao1G+MjNKaS1IR4iJ932LFgOmqri0X5BpbOV3jCJuWY14v9vGORpSkUzw39hIrZTTwBll6k1N5n4I0hqiQi1ASKDX5wZyJ4TzDgWLqPphYJoBVz2M+w5V/hqh5IYZpErp0V7c+ms+qydzbW7v3XCPWmgQwXHHHwjRbfBv4g2gil+7ppZedEBXFYQLYtBlgst7KUBL+f0jSCez0+807gc1976knsMXvPvtE+P+JCSgWAL5GwV8v7vHFej31lxbadU7uVySSa1b7BQXu0hq+BnFaL+mrFpY/Iarm25OkFXy+nJysVvDtSMGrI8YDiMimysI4AjqwsHMf39nfLU9+fMnJi7IpLGKGygtXA6gCftQsfdXUQMobp+oETTne3kphimsgeDdZVGBCJMWHDIJc7O14IhmmIRXVcsoTqQ1m1Q2a2lIXovgFe0KffCTvJwDjJwyAkt+1XI8/pU/mM8bx1DjvqAUVO/T68ewKv+v7OuQlVa0R0Lcn5RYz5gBurcCaFkP0XAWDKMYoFVyLcUcY5zje/HcnP169OD3rvfsn71zIttY1jqzffnox7x11er/89OKyB//QZ/7nbytnweMRM/fJmyi2e8QxAVznBo+XqqfRfLJKrjnWcw0IrKeWcGRb7Zt0LvKMFAL4hBY59eOx3DT0vEYSmtJ7hkC++K1FwD75x3nv7Bg+bjA+2I4ivYZIF27xqGSqrPPGU4o/5livhHynckJCYBz9zc+vL09pLhpbDUc9gk37oSCjOkoAegzz42GTOfWZo70ajMYxj399++6YERo+/YSfnKVb2FduQ8wNCIfRFBA2EzJcjT1n6Ofy+mvdtX6NW2v9X2tHz99nRfA+E+FVUczeD6Lk/XQRzGboEV379/q9EK6h0s4XBQAlyEL3vJmhSiqiglTy8g4ZJVYOB4+um9hAbzDIxDVX+iWtSLkicb4KG3n199dvVl0wrKaJPmCwLG5FjgEh5GGGOwAjVXnexduXl7/23p28NxqbIuFnl++PWHb5hVX696dTFGheRrqeCSIoN6HJ399ECS4U8W51M1y58NKDbJ+CdnBsOyYHj6qFw9ENJdpdd3DvPxsg+prXAOb9sRjMx6bmzt0Fcqx1NtVYk+ZQPL7a1WalFRthiaiaKyuZr26tE6Hjo0GmRhY+FQFwKGAno2CIDBrD0mbRdcqxLhn1fAUxIBJD3IpaH9XU+cEKn6IHcu77YyJoZQx2jkIyxR4mCyx4ik9yKe6TowsZteBd2kvQ/eup9iTWomdaMG1xKW/DnTBkBxCRpmBZQfLGKLOEGqNf8uZh9r6Eot/XO+khgRxmotAxSgghux9QS5aHU8HlVDEOQ210x/qspQKeDEaolrctbxhjocCWpx6lbnzcjslX1fHDq2jmY8saqmc+A3WGQ9dOzxXdhg3q1UezfovrdXDdqUQCjSAWyC48sAVgzUBb43jRwngPOJ+Cat2Z6nNRQZMFGUaEgrino+WtqZ53D7f8jr/ld3f796iygf76hoToHgCGeARMAUdPaACIBwDJFGJJyYpDBhX6U9sfQ0XmOauXFNBv4CdH1XVRAG3yqJjLFnxccQ6mWscmREmO0aIYx+aEh1L8WRBjn6xiMkV8esbhtvDuKKU3EKGQZHILJbWADf9T+1zVAre+1xWCTxIoQK868LlqdN5YUwx1kBQrSZ1Ul67mbvo4j50iY+/U51soIz6j6uDoplJWfDBZNGREHgcKAi3TPS90XwngVEBOcQEyOlqFLAIOiAxDDOFhKhSXpFyojDZmNAFVGA6nsMIn5Wg3JJ0ruZZVAGvhzIgxn4tpilpUOI1ychegAJilsa46DQRNteZMmZB5p8cXm6fnF+YH3X6rheYWNeSMw8e554N+YJ7FMnAWPgBikPqIZY/FkFMqEpRPkSTnwnt2cvxuQ1aT1mGb2PPtHvV75sUkbQolz6iop91jgZprznIxD9Nkoevk8iIo3JT+QsoAiJOJoLAKDeuzUpilMYOokoPflSQtEO6z9mu7F+xdVQS4N19TPsWeaf7HOMDijRyKtyhjgGVLD6awCgiOvc+gh4olrgWG1wOeNZ2henBqyRivRfDh6yl7d8lN7sueRzp4eeAKDvWbfBGnww9wR4D95gXJMjPqZO8dn11wBPCry8vzC2/Tu3x9QYHp6TCN85V5RdgYRtAeT4+ZUGE+FEdHo+otq3tR5WOmnUwoLanJjtpmAlmLOPdCmG5n5YCnZksM24pAvKTa8HLaoEHDMbkotAfY+n1pxVdZD1jVAV5h+426TZz+67xPMlapDJvV7sXrt0d/v4JLcIWX4AqQf9W9NV3Ad/2dU7QXO17elU9on7U+3Vp+4PhacPghNc2OWGfj7hbYfWp9PffCdDg3eRnubKRQ4M2EBy3jf2GwqIXi79DyzgSYivOB9uNNU31OMTtcGAQDJVU7PgZWykDcqdrSVDFi0IFvog/RTIRRQPWt8dPmJx0vylqieISbK2dqAfzhEoMiRbIJywTsylVcFxUFutn34v4c0D8VphucbUKS5r2rc0nyr16ynLUqnObzr4T2k+UBYKaCAEwJ6zEXR1Q8IW+VmEEk8pXYgUswq2yh2+nw/76OCsqXVh+iTQ9toHlZdBgI3DXhDun1Mlfdr6m2vJqKZHUTlkrShfnmFjWpJ5/DQ1YdAINc+iLI1IK/JdgiQ6oPoHIk8nhGWlRnpQdN1YDS5NsQpKDAkZvn+fwHEbsWmZ6O4vSGPEpZaHQm9BhcHp3LUbmjb66XyWsbiujaBKBECWATDHfxzzMq1C2KZ/mG/FFVRoUBzVrYLcG4qIWu8kySQMaLCjx+MFRAwaXIgiQP5OBkQ5OaECbUzjm/THYfwRwfb02Pt4b0g7iaNaxaRVJaeO4TfsmfpZ4oibdQDWkMs1CGN27xE8iUt7w0hb0PaWW5cCZgDZp28YNqbaCzYEkN/X2eDE0lWbaLybfrBjOgBaZVGXJEJBiPsU2Xs6xUH/Hwm2oLrveHDTzAtOFn7M4IwjY5Sj4Wsn21+DicYFfBlkPUo1x3sIbH4NLCdlUvdG5emFCyb+BYjZRlL9NzjFB1VmMmqoc2MxI27UmnXF6g60ywoYkzZGXLddCqLTMjAQy0cNOhA7T1LJ1l6FuJF/dRr9nu2ZTgxC1CifXJgzF9z3EPmsBMB9F4ns5zWDxhM71jahogWHKdHUMNSQM0eraADIXpFA+AjKHAlT7Cg4gnvuf900A2iG+wCDGZll2WHdyoNSm87/vyiz6DzJXREpSijBM1nKssezLaorUWl9L3eVl97NSHBl6qSiJlBvSxmlohyE5LwSxB7q/cn3ZZPItM+uVx0Lyc6lVKk0aapFMsSiJbHhLczdfGySy7rvFAz3oXZxuVNFvk2wJUEmNrYlByMKSo4dC73b3D8p6dZpffc39LBxQ/pukYBIDXr4/cHlvVwJRVgiHt19wKLxSCQqmhVL3bovcSJZhEV4/qwG3+xYjdhH+bV8Pju2bpsUj9ISbJN1Rk5AjtEPWdIdGeKkr9kWg58EOESeVNrenMKXgiJ6us7yzNgLv2KJgiqFnkHJa/uIrytCZl+WFAx1N4pxdvKb+4ssKj3tJlNXWackm1B3oEonVYhZTqz3fHcuDRK1LO6+Z9DdcRxICQ+TV6pPBDNeb2v701uLlrz732/ra/19052O604KuggK92dv3dzu5h98D7n/XKIhs04qz/jJ3CFD8uGTgD3b6whTHrZOQiKQx+G4OUC0JaZpc2ggcWIOGgFxfFTqfQguSbhWs0imQbZ+yOSXohRcvHKUcKDdCTqpLilWhrOBQvL/ZmE1CZ8A82LIKmoa61HYd1lhYIJ3yQJXDuGg2Mb0oMEgCtmzVWrBuDFESIpB0OK2eDQTlp0uRNe0cz3HbR2j8dLVtXQ1dNrqn2pv00F4NSH/SyI7Oyhnon5rrx0OuWWbL7usEsdtiXOn6DjHi9g1/Av/eM8FmSt6bBsAHYvOkdLVu1a5kt/M9w8K5fopopFS9KubAVhQH1rzzrXWr9W1Z8iKRkZu4sFsGJrtFgdfzmtw1L5nXvCmlzcRrALQ7iIBnSbbUchNjjDO48fF0CMu5zlmbFQ6cQ2ADA8b9iELAGew+prtKHC97+JBmulOtSOYbPzLORYF+G4hywiLWWruqkxwfs80bBhOMJHL81qYIRz92ijcxm8L1a8nyghM66HrEtKxCXhpMaJ9ok1kZp6o9JgsckzzW0GaxZn8tVBNmLKoOL0KyJtV2o0oMYRjlqVLLvDum4cfRBpvGwhzCfj0bRRz0iPUONJJ9vbvIj/ARqUhug0HF4D5o40DzwMZpqc/RgwV1OF14RfDCnyjpxHMC4oMfBHwMR56x+oyuBVDuqZYR7v3x9nOvI3bVh6s8/rFU5pgGGmzqTzq7o+B8BI8RoJKiEHc4qJRd5hs8E7GKjxS6RD0l6kyhbmLMsT4K+pcyNBKJZYNBejscpMBXkKc/reMcNhAh7vm20IZRZhjHmIFbDHfq+Ep/kN4sxtt5lcl505JLlwoGnllEMOKnXx71zZAU93vGxHspGlfXq7gT8Gje0ORTyPZpASSbV8C9/NI/jq+/E/IIbXs893JJs4YslGpf71WM41sI7wRqQQqKYAxuypn4xBGSHWuMYyJtszJm4vByhdBhKfyLZHTdVIJu/bJ0NKsX2SfBk1UU0GPqqCjcS3aEwUwxX1F377MgDjgVmAoVBeBi9Fv1pBacxCO3W1ujWgMvQp11Qt75MfsDd9XWTQfj3iM+qHO2QUA1u467xVGXHOqS6M7P7QVBJa1o0Z3UVD6cGfzGSdqH7kXtciDpKqpu2aFpANK3kGVblSyzXsPrq9iaU6u2Ko7Gwf+NgSdJRTPwTGnh+MEWqh2kcAz2yOq47rSp1m8pRhOHxiGsa82HTuUR5XUNTzU1pKexrv4cfTMwmYor+xwbLsJ6oOWzSp+Lb1PKfwZVEGwYXdN+oVCEPCXlIF2WXZa5KhQI3xyT/nOuw9uWAdLPDVGARyJponYNgZ7Tb6YxuDSF8mFzJKtnX8Q9JwhECvGIOZDLYRK1Bp6CZ5xY9g3cp2SRJQyHNhc6WjYdOZ6oTwpBcGoq8BrDylUoJWXsxMjN2GnzADBdQAQDy0YDT1TV+Gkkb8RQRUjVYpYuRiArWuikbeGFQt4iGaFil9ZqAlynmF4V2HJ1t2ZRu44hzSxIhOxgIYV7I+V46y6C48NQBu4nXtBzUHPnNHBqm6eN7kl0g96CPCH2Sn4Kagtfh9r7YFYOR6ARib7hzuL8VDsThqNPd3wm6e9v7g8HB1s7+aO+O2MWHwUibvStkY7++RZ0IWqUwvaTmRSqzKm8m8WFKzJH4gu7XGz7+EFM3I7iiFjLLMWQKANwHhLA2YVKhX5f1s0FCRVvAvacEXbJ0WYm82shuLf+Uvx3Co7iDE1TaADM5I8a5RUoKwHNXsgCbmLAcfrndPcqeL0RQ5HWDsOYoGRzVT57pKgL6UTzIfqlAPeAxXAwCt119uopXwt5HW143F4nQZN6kA0VhU6BRgqYs0RkLE9DCQrRIoxKOoF5WVFFJw/gbXVMroNSusEFpteTE57SjlnUIauuaLBr/x0DVzDbeFGYnemUqxUyNthoulUiytYQqRpUWgM/ymVvRhS6iShz0cQk4vUrVcm4yML1kfd1IXROs1i+9qUMxK3hzejZeMYFYCVdykTJfyWo4E9o2Y7zRcFLzCAR0dWrmUtKVRn7hgWpjs3rJ59Icl+rZUrSssyDhkqD/gC3WmiSY4UtUyMUaQ2AU9mzAfSCqoGEsNwXMlEPSclEjJqj52h35T6k5dG6ldD6oJ5fzhHn80l5d6b6hnHsSeVXE8735BL1oYQ2FBZOOWyPPOnKC5tCWYK52Yk1yog4IUIkGQdeXHAPdr+7qyjd0Cem9UZJT36Gq/Tuo7t3xtA9zIr+4hfHUgeigPEe3qJ6KocFwGHGafkCPdiAz8TBuGZuhlHQLqxafpu5VaGz7W/6OrWdR7J6jZplvbtGy+Km7IzlVcCD3NCDn0KYrErojWSGbdwRr2u4zGbH5VYYUyuDIp5DCp5DCp5DCrySkkO+kqjBlCMkXjCvkJT3FFT7FFT7FFT7FFT7FFT7FFd4SV0jM4puLK5SrbjSuULL2O+LpsAw9BaHZJhIValcbU2elsmHOHClbINp+7TGGS8HhfyY8vsIYw9WFukcMNKzB+S8eaGiLmk+Bhk+Bhk+Bhk+Bhk+Bhk+Bhk+Bhk+Bhk+Bhk+Bhv9RgYbUM6WwHWCX5ptbHGCy3wPiILD6HEOwZOQSN3mnMpvBEEvEKPlBzgXSwkd0NiiTkWL8uOY3UZEJr3d5+b+O/g6qDayQivLWBh9SfQ3YNO7TXYicnVSjQNdWjTJdxZN0Pznm6fFFyzv78eWvLap6uaECGnQHcbVc9pTwHvyCuor7f6VVqOrNckS7WCnqH1LY02Wp5PlIaLAeugaCFAy5tuHOIoYTQmr/r3bh5NyZVc8na9hiKCba7VBcQ98MFoLSlSDJhlaQ21WXrcGpWnRCQ4zhizFGgohcCndcLs+qIprg1Ufdmn2saxv38DvqI30EGi3hq6fU3v3RPKMKQrp4JttsFfo4YiyfM/2uD0PHRApUnSnOj07Le6mnkmNFjl3ZUzK77i1GAVdUNgtDK2UJVrhHIOBzE4oCaOwY9VduOI8GBVFkKTq9kYvH1mKD8Zi3p6rulJ0Zp5fvTuTVcpUvRuXGODzic8TqNQPTwUYFu3/K4tmq2pJNCfQm3wSgrH/0Lnkct/hpy+5ahOadj76ucxcUMPUHf4pjUp07Xkm+ednrdHY6m3qCjTLU+IE6eD2SpKHjWlaHnQGXTU0fH3ZM0upg13QxyEu6naoeJJZD/jYheK8RjLyhmMZjXGlNFF248jnX32q93weHq1oMAKa7c3h4273G35eA7TvRdp0g6G/0mJaLHUvO7stQlpWh68gWDRGX1aF7rzE0rGWZPKUtyBqx9+kMF1DVbLusoyPYj9LhPFeKv6lBqwo+Yv9BEY9IJouokxIVpYxB7r9OI6q/3w7FDC2gskCnEdh4CR/93c6hEtZBVWBBjTu/3qM33TCaTRrrxHDBXbxAmCchUlZb5SkZzcJ5pr+WIbgWSCsE7/XF1cnR8auTq3cXvatfTy9fXfVOLq66WwdXRy+Ori5e9bZ291aua88VLCzYNQSF85M3bdWDDqObw3YQo5fXPrWUgut1pXu5NjKVm2i7XEdVTudc17MtPmKEOtrCAD361S1dDScYyYf93IbS4m23KPLYTcA5YLpkJJrTa0TvU9/3Px24vJKmygarBj42rK3JK9HxDvSNajOhaMzlZ/FJZ2ACntUpwFLY/+Emj42iDLQkGy1UJsxEB5TVdHRwTqb9aQeFtjh/Gu42dD5HDoECbTCbZcgRTQnmN8e7XhiRmghQPD55p4/RjfCmhLwVbs5LzqrI0cOZDKU3iYvukt2RGzy1LF6mnVLmUNgyaDopzmczkVEWCsGrfEU6L/f3jvZfbh3t7r54ebx/fHBy8OLg5c6Lly9edo4OT44+5UzySdD9YocCBLX7zZ/K4cn24fbx4XZ3+wD+Od46ONja2zvaOj7s7m51d467x92jo5MXW71PPB3Dcb7I+cD09SdkumKanILPPyGr1yad1MPcm72D/Zd7e3u9zu7Oycvufq9zcLL1cqu7t3XSe7EDnL1zvLW3e9I93j/Y331xsg83avtov7t11DvcOu697Nzz5KI8nzcm8hybHC3VfBLl/fngd5BpdM1wWoH6RJJcLT+SpaUrp1QJnDv725vFMbvA3qVp4R31Wt7bn/92moyyIC+y+ZBsq5cimLa846O/TRcqcAQ+qDiG1QH4e7DdWPl/dgpRarEJz+d5Zd4pCtWT9IZjNAGvENkQyS4uXm8aQRuz8JIQ7ueHqk803BG7g+5BuDfY3R0CHu1vHRxub211h4d7g2Br5774lKTFVTAqVkKp0Byvizbw/eZlhOmnRlimlr2ynrkjFWDSJMUzCXlZQ7zK9t2MwmrU7lZnq9vu4H8vO53n9F+/0+n8tv4J+x1Q6ucjbljKRitvtnu433mIzXJFt6sGDaU9lMAxIA/JJaDx2amkqoWIY6dcPvtGMLtS9ferdgaR0EOPOve4ko4rqVX53q8IY4tq45NO45ZS8+OxQLDPIpkkZMfkyTShCvBvbm58mbHnD9P7ApxJ5ZckzxWCbAix8QvfRZCnC9WhEwjxsdNP56HocA58lZw3V6xS501rV3KaetnB0eX5mwlgcLpUb1mizYNEc/Xj0RvU5rcPdmqehv9f4fl1UIJWv+zz7Fo8rhEEZzRtWMhVSdnvDOMW00LZG7EusCcXwxlsPVu58wxWbRnEhPgr7HSQprEIktpsLP7JG8WBs61opIxdXiLGKRYKoETPgOLihiLPMUADuLIhWBjsTP2tpE0twQbj2YI68xVzoFjxyopsAtu4Uua1Rz1KbdPj1jq8biyRcC4y07A596wgScov7J31TIf1Z8qOicQzChJuZYUO2HGClCPfLOK8TTtBaR730OZxl/7gf5wU0/gvQTxL2mqN7SjMN0r6FUdBW+J7nN6QZzmvYh2ucvPO1kB2nHQOhK9JhIMNuIZYQjg5L4VPGFtXwpYufLeEpSujmaw6+1VaDeXa7ms1rG7pS1kNl63kG7Qa2mfxSWfwVVsN5XK/G6uhOq1v2Wpon8n3YTX8kqfy0FbD0ul8J1bDFU/om7Yayj02ajW8uJd9sGIXdOq71QTMPpZ9UE7/e7CdP66BUHb5fCgD4fbhzs5ONxjs7e7v7oitrc7+oCu6g53d/cH23k43vCc8HsJAiKYyEAOns4q9TBqHvgYDobXfzzYQ3nfDj24glJtt1l51sbJlqkSSa0gAapbqZmNuXiMkoNn+tmdzqhPi5CkqTgXf5ar+GH6fZtE4wqxo1m9rMMDfWr/ntpo2MJxRYU9Mc2ElnLifti+QudLe5l1bLOJ8tR6+RRYMVfKjiomyvloeF3VsioyqQepr1lIY059C0eOAVRoYdwz8X92ewJtGWBRSVVjOhpMII8sRMzEHAtUsUIGvI3FjNCsT8C8vgbVwz0qd8DKBwWCgsbYNkqjuvTdioH5X6hM8kxQgF4Wl2nht3A48mSHjmQah3oep2TAIhh/sN+8Rj4WrbzDodXlxZJ7Y5FP1+Btebm72JhNkOCPXNB6WuvJAINcBCI0FSn8kGZqSwjqTj/O6FMCREcd8eFbhSeCXbWnVERYkKym1O4PR4dZoe3cfmPNOGOwF20NxuHUYdkRH7Oxv75XBq1slfxkg6+lLoFbfq3xslfSv69RQTsZUBNizNzQJPrqwM6YgGQMNZlQq+FK0ouQLFfB1OqPO3n4QdAbBYWdrsG9RhXkW2xTh53ev76AG8ISKf1SlRaWPgozcdE9FIWSbe7p48EreojBI+WRudaIeZIKSsr0Q09gBJVIvH2Jt85aufDALiol8P/WUHW+Vi9ZsxqsUtlUWWxa3TG646x5bc+vcYqVAWWk2IHhOgwUH60oDOVaSScJNBCHCldNp40WLMAILNgbljHzO4D+VXj8cm1P4rZo0XIlznKrKG33p2pNFBNdX8PBpN4OyRDdZ/4CLNst8zlyawZA4qclrxAB5GzRY4FBKVVRLQ2DMbcKFatHUDAyNLZ4tPEUsBQA7yxYUPz2h++a+Xxo8FgElEQIDjNIQCB2W/02RYgJiD+N5iB6DSpkF1pHpYXhwbZaM11pWZH3hrfn4XfWEZpIDWklr46kpDvPgp4IFU6xiqQgUUnkYnf7St/C/SGdrJeDAA6y0uCUo1KJL2beY+/4d5DacjjiLH0kgJUNGU7zSMiGSGrtjLoO+sAvLVkLFQI2OA6Smj/iM4/XJd0i2F7rwssA5+kRQOyJRH5XkTOkOSuBx65baVW9qwu1dCvB8Z2d7k6vz/p8//uZU6/0LHLdzeupCfgcnCIx+moZUKd7QGUJ9NElguVULstWKX1YbhURXH52mIJKmKM4zBUgHxLlDzQzg9AONOC2uRx7kNioE5GylOs08Br5KGQQAY+/3OZUSMooj0S7ko+UaLRpzdJaufk0PG5Ckjy43tdCWw+drm4F8EhLhaEt+LtWlynMLax7cLyeHL2kVfmkNxaSx+dG06sxt0VYJoDX/jupYn1+pzK6QVVkHnGSFcsB3zqJQhVo0KSTQBBKJdc1FWi//Iv3edXuw5ei1ErJVeNf/Id5F/rzQNkDYs1ANfhbotNSSpPgu3VArUY1td9baVZuajGO1aD5svKOealmT8WZZTLEqUFMakYfRYGY9tHR+si/fLhWQdzo+wA/FDZA0N7H8JmVZtcSgv3R1NCTBT6XRvp7SaKy0NVaonUZfThOJ26yV+C5nQfaf18qdvN4lfOup6NtT0beHKPrWYEjxz3L4GhnFXoFj3FGf7+jKR4a7cscIp4aS7hpBj7J4S5mz4jrQ+oW0M7hdJGSSLeIHtdCh9nRUCNsuiIvfRMBymKOqSlIgN1O1moBNxFHY+sHtcQM/BBTvIwVu4ta5ZR+e3qMEzHdbr+9Llup7qtJXW6Xvey/Q9w3U5vvSZfmeKvLdWZHvixfje6rDx0LFVTBWZkRLtPDMtysIGDyGEjNMH1r0jXBBPG+QpTeWD9GurreQhq4cg4CQeCXk3lVeZWpfBkOhcKh1delVn+ulKj35HjKB0I0oH4FKyNkq5arOJ6pB03LEbGRBBnSVRV0EoyCLvi0jcIkOWPhx5eBHtd3On6A0BJu7fsd7xqfxv72j85/lyXhvL7zu1lWXlZs3wRC/+MeG15vB27+Kwd+jYnOvs+t3/e6uXt6zv7+6fPO6xe/8KIYf0g1PNqfb7G7BRG/SQRSLze7uSXfnQIIbhtmReRoa6Lk/CqZR3JTVDbbC43vPlE6UiXCChRlDMYgCrAGUCTHIQ/RWJiHc4I1qci49WVn39+HyeTsTWWAVSlSyIWkjKj5Xh95m1CZlSVsnRp036e/BtShD6wP2fGhKjK/sgWfTy+bQg+Bm2Q3Z8Xf8Trvb3WrDPcJorvLqv5fuafVnrdz01kkvO9x/lCGjpNPHOlk1n7zP2NsozVvefDBPivltdzjIbqLKHW42NLCy+FXxsdvxu2VK2exSS41Fb+GcSN0t+eo6lpRRSla/vO6drSJT4XNuc0628OvG8wedLb/7B9ZffZZv2H0+lRUFgEjmL3T3JWOKGUHRXPCfNH6Q5+mQs+m4nXOiXIKkL5BCgbvWJYatvqc8meyErKt/yefO2DPq4+7rdoF+7SzE4WBpsdwtbIVKzZILdU6BCJQ8aEUXqXbSf7SjpP0HZp4Gs3zOq8xbUt2pW5nneDt1Ky7X4EThbIF26+YiyWEQrkT8mxAfWt6voBznkyD7sEE+SyqFK+vxqs7KWTAC8FQgESXYKnrZqfIQHj8kN2cOOPeeKVOaHFX+5u5/Y8kmb9+eU5T6vru8ZXtOTQIKylF+KtREwzCSmKXW4+AKtUEKOVxaggMLDRMtkEO+HagsDwu5Ffb6NpbLXN4a/FOPq4rgCrdtdZbi1/WtkKGUSgkOI7i3gpTu8g2TY9IKrPGWnYvVvkn2bmqxRmd3ebqHatOYcYY2dHrMkqIsRC3j2DX0q/R65ZTw5jSftzMu2Mg7IJX5PnuAQ8E0hdWCs6/nMdyHAER81aJQkf/KD8v5ALIBZ6AVjPhBzdRexaKvEvevNQNbqe6kLCTfpMNMlZ2WAgHSczuinDZSVOASkHdH1x5XBftl6I0Sidr6fj8b2TbQY1JfcK6Lny9ONvAPEnOxCv2oLhb6OCiCAXGizHsp7+2G43sztQH+mGMt0PE8yEKf/0Z32+YfN2IwEfFsc4S9PbMiiDfRBxiLcCxw6E1ng1eqLqvI/Ukx/ddPNJBJl3GAYZ7990ZtdJAKTVTular3a/1fa2pfa/++R/mdmuLzTRTCdSfSSSUOFPJhmhnJ0jkco6TbQU2UjEQVHIbXeb5ZKVp79MvFxaqQsFb8bVhLLrl1n91/tQpSunySZ+WahWMPQ+CG9mx1by+5HsNrYdX/JRq2OQr+IDSP/wK/XpE38cpaXH41xOLpIvzXETXK0NPatBUTPZAXn3ycpRh9D8d3Yu/w35XzPU2wJSeocJwG54GAteXvtewwHhccMlDw3fnRPbLwRYLpUE1fEEVFLQ+KVbYGvfpLj6Z6OeqOqOZ2nKwKgoarw/OOJWl4dnq8oQInZEf5mYl6rmeWHjuwfe/U9jnLHvTlCeSgyj9VhWuZe6yK+jdw2a4iwHW4AlG4IXG9jONWnZQSrp8e/7vmjNpbne5huwP/3KMcTLOVzbGgjuohuozAOPKzpDacQQIAjMas/jiRLYWFC1jEwDmXZbGt7okMx1F7ECX4LZnz4PP/wT/+puG41+3eA4yIeFeNIr/UImH7OQZk1KJqZfO4k26ne+DfBylwfACoD6AJ06zBLdkhMWUGT0vweAnVuuNwaINYrL4hWJCPktcKmxmBVlYsiWuDYTgcJguSsXR9dfwOStxd+DcbE+lPVXsKdjZNQSLLMTfFjjV/gSJmLkdMUftEiQ1bSedT8rUR1Z7FaVQooExFkUXD3HvGpfUBOOjK/3/tHdly20byPV+BYh5iZ0VYhxnbqvJuyZS81kaWVaay+0iBIEQhAgEWAOpI7cfv9DEHgAEIkJStbCUPVkgC0zPdPT09fer0EwrzfsBG5Ys0vAujYBZwMhd7icXVk7LaXu5wJxU9qunzhTGMCHahc6Y4LLbhoqgJnNNLTvXyk0VQowRY1C+pqiPr9qdci+9lRVMduINuJA7iuzBNsD5XK1fWN6L1iTmtVUT3YkFpmcSAXMIU2nHWoRA6ZIW6jzXLngGJoAZmkj4n6lzyjFYRBn0/4pxaEqIBpVMuqYer2Cmc15JW/vb2RUsMP62tHC/y5560thSktro6vzj/t1DN1GEPV+MQam3emZVRxDyRP70YQj7RRN07S+57O07vs8Dsct4jbu59Cmc3PSQBXNOcO/CwTpT4VCMiJ2RlAySVYFCwcgSlxzoQY1Fk7iPaEAWOIQLWzN6BEfTDBRoZXIRPQE7PfYx1Y4X24sXejGxPH0+/ji7dL+lsR9xEfNd5gV+A8HR+G/WpSEqcYFXA69C4aqUzL1btWu5vEhAGYSaTIcXqwMqAch8t6lngI3OCZotyArSvhdCejBYxgTeHFP00yUhxFgwQTWtYNL6bujFUkZsld2iz6LMoQnatCgNyjrQs1kEkeULtQlHdqmFgUCtgDwWFPARl+5dUh0KAAyFMxEBMCMhF8Kj/pCEC1sNgRYkHML4CbcViHxByKKQaykYv9m+SlD72fXllZnvkB3qmgJm/49hDmfPC7Sgn2NSQXRcyKhK3UhRxthwQA41wNushectkJeQC+cTWV9lwkIYlHzYetEwQ/juG5DYfm+v0MfNSv4i2Q/wUFh1nILZnOoZZzm+CjS/DefCHjMapnx4ZTksPz8MZXeyhZMEyKI5OGCkMm5hFaOjD2MbONUtX9EG9Dc+S2TJFohAw2/paoB4oZD7XuCwcdF2aNo4MyM2wYIcLEbaevoCuxBGWKKd3HfkuWB1K4eD3cYZ7aupiUnhdWl0N+kfgYYxxmhIW+4Zug0UOVb4jzoQIF0EkDgxMuFRQyY+mxfgyF/IZi5BAtibc4l3nCzjNIABJSCfKW0fbDZsc6EsASMJLy29jSgAT8yyg0opbj4HsFhOuOuLg3D4TJ8NS58Y0eHjsO2piAMtkFjI5xOrvwmRpzFp6KVio+VGynGr5NYSPUo1IQUh7U6Fi2EXaZ/6VdEG/8CraG7QbSHwY4wNjOSQ8CTm6dNeUEq5kABcvuOI52Ms6PFon+NMv/YcVaDXuvPwKyNl/YrIOrZiuuxbg4VxsMwtobx72vYk/3ds/eN0M/RRGAAefNKMQnuRGYib60TmCDY4PJdHUlG9yQoA4V6EEkbxCQlgfbpQSBgw5QW1iaQajFqSe7wyphdArwWor/Qxoc8+/EeIDj4ZWwPgF13ihLSzzVjhucQ42v9UWKvN4W8JV9ldbOJAhqmRuM4zCo9bxpTwSV7Bb5FUWSMfys2V70W9QGhpDCKKI6iShNKLfYF9nENI9pgNd68VSiyN4fSWMfqjLduJp2Jy7xVcKQoSUCoz8Vz/akGUgzP6KFWk1oEDidIeGks7YUB2hlt5sB3R9cJyqKwTn5ZfjL4fOJ6h2mYgbIBapzoJ/VOZS0A9X6IgN8lzLdJqCOklBbdN8+4k+WQY5ja8Tk1v5WIDXHSlrDAaF763syefGyXBkRkCFMubHDfzMfZxz94Af2YXvcT97uPrqN0upNokqEVTP6fWkKeTD2Evbr0LvtcYIOgo12atwk8ydLMOoCrJKUXV69/beHu/tvuu1mw74MAGC6TaxTwTsVdZ90DSXLE+D3L9pPxkJhRLq4kfFgbfLCcQh5+jHYj781fzOMq7+XSl7Rc1ND+qYXNgsVfVLKyVrYdLdpOsimbot0d2AUQMDYkCKmLKCWobTrUG6EJB+Oz2uAoJ/s4Xnb29ResQqMMFJ28VgLKP1q8BYXP68sWA2fh4Leb8QNx9+tvdzr/OM+SARA1WnjFl35A19dvM25maffBpg45wsyLdLYj1uDaGn4oHkcV6yTmwOWI9bAxgUQci33PqSjYFrQK/Qg9YFrIZdCdau9G0Ol8blA4ZluT5dLtQXlnH5R32uqEut7RzQY3c7BIKHtmonQ3DFG/4yN7zZNtWTV/x7EiW3odf3lnkCwcnJnXk5+Rf9CiWA8ZdHx3zOMW7eK60nlqHMU5jnoYasswrzcy4ZB4t+qQ7GUJmeoW1jcgJGkoYdZjjtDu7Eg1Q5zCmkMpIqOIgb/nG9lCDEmn4qzpvbrYntmeaQx056Iw1EBYvmFJekzLk5l8kWEwf3jdC9yVeJdAtyVMmprAZ+AR93OPgFp4YeDi/CgjEZOT1OL3akaQnZPRRvQBY5Oi8LU0JXh7iqAmbsKORYaTHUdOnn3RGJ0Zxq7/IwoCaqtTWBXZtdCmB/ylTe0QsD8ssVoI3Al46Q6V2Jar18gxfEqbWMY2pcZp+HLPTbGTrUM6NWC3BVQXDMrTiTJqT7y7R9BzAN9T+qtKVcH5ilJYvzlVLw7w04aGRML5UhVFbfktuqx+FwN4Hga/RMcQ3GXkl21YgdfrpWeNf6XBAqv130s9RLfMMQt+ZJd4mRCQSUNqNVD98akAJ1yjYPSxhyQzBybUiyjIPAGvPiCobVnSwqw6aXmMKysLjL78mEE1gwKFacWIqN3O+40OmyEBdsZ8yqsyuBgCFdgVTAznLbWE0LWWbWZRgeICvsY3lAibOLuwAIvhEaU1XTNavJrdJ7oCpu5YWyvtOK9kdcKxuKC3KBuIIT/ir3F1c74k+UwR9wyV9RRAn+f3Zl2WiGtanNQkpV19ZcyCfZQBhSY7goBikCTHnQAoYkxjH8A0rRhvrZsEhg9RIw/+mFZZXhorLGcNHSGnbROMtTc1bFmUhH8k5hPKxGHS6ubBXEIXQ4gkq74UIVRld+q2WaooaWGLVGipevAt9zFNy0Qpd1DK6UvChmIoggJbePlaCxtw2VtQsUJvIEE3J0sanqzekm8G/HZVGwxtSOBLDbIJYqKzUaCEHYeXGQLLMIYoPuxCNTWW3pmoBnlEms83CxjLEu8nZ6QRZdfFie6jLB9/h8xGH11aWhq3/hVQUfoGmMMVstRT24nCnaD7WbBUXgcIIfat2oO+fcRySjwt03PGdqewtPgRItlDTjYfxaqmzQtxblyXQJPTDwZbEu1lWy5XzuYTyBVFY+MwPwLy11FD1OCx2ld1EsDI4VESkqJ5iH2KGNLx8ez5dq8SneJAobFfumiySMuXI+Z1AQ1SEY6mouLgsg9qIrt7dC/bEwLAajBmn7A9yIeZATo2wB7FIcmF3wzPCGJwR8LbaqgCqJzoLIIDqIbHGCJrfLRUuC6zE6KaUGoIJ7pJ4iz/YI2/Y5pI+EZVxu2l1zLKR53k0BU0qQPD8ovxxJyc0/0GAiDzf3e+lkUjwlYqMPDEYdfRn+OhrA5fuhtWiSY3RiVBMQ1ZYIpiUU1HFsZ6qUdvLZSEjER6g9hpwA9aXp2GlLDa4kYSVJeSIrJqNPKoNhVKd3Mv7IqhV3oSfRBg+xCKoMp3oo6sBMYxDxpol6t/S6bdmNjNjIjB0YUnIktTyT7Ai04o7yGDcPZGvJlrp3WmueNDijwJCrRKjZ7xp6J6JWV+ygaO2XSFORQauHzhv3rYqIrqJOR7eCddC7I6sRXFekHephsPvOaN545TonXhqF2EIKeMzL2SLJpibmiZ+yYtNs0NsKTRdXrdTsjblqTTVIWHOhCFms88zLt7jK7y5gVGfTJxMx12EM8gWmqoAZkiNKA2/6aEgQrstSGdgs9/M8JEnlamcWdWl7jAc5anTOQ7ldpxFT2oaQ8H5rGtoxpty5EaYZQFIMxhpW0Vd3/1uRCFGDg7OQmktzf1QTPnnlnRejo/OXLkWoYrS/2E/pI9zdDYxZ6AjW3QQzLTDxycAupqpDbwwy8WLJUKqto/U3yFCEC6QdB47zAga9D6Op76XTjBMOC1Wri/uwXO3iZ6OjzU8WFNUWj6toq2bD6Tb0X71nCC3jkhG2Hf1bcUAND3DWB2rsL4bnWGEMlgcKu0k/RVnXMrJC8XE4C6HLOTYydyBbyzmCKHcMzR9RaaXhkXVuK5HfbKttYbNdabutteHWAZkKJApELTGA/dvR7tgEy6Q73pR0w/e/jXacL+8VCU9jH9sQQ3biVHwJQ1Jv4uH5+yZCW7G6IfF1ZFBe0UkatI+6rJGiIrG6PXdZnahgb393f7e/+6a/94uze3C4Nzg8ePc3bFz9w4aLraiaW15tuTd3i5VCZYm3uNK9w9e7h/uDzVdKPULGYpOMvQiKGuU38yc+BI8kHFUsjopB5YXGJgJaAy6+jo62tGp/md4FT7ziSzKW3wXKH4Z9PqIIHvD5J71uR1GCGjrbRFemH1LpsA34gvzHxWB/b0tICx4WSRxY7skNumYBIyc8gO6rFaTYxKfIALqfdZfF/jIYHLzZ0kqz8I9g7VViBqwYQOUaaRJj/CXo2ZMwzxo3/eu3mywF8sO8aExW1idmc+4MQCClYRc1NsXz9tMRXYYoCbM8iP3HHRvHX3NhJWyXhCyxELcsKPYUiuMyzI1GoBSUnXNH6AR13wjCIrEKx2JBPeQtQPwbD2pzQCHIepIMBh8/fHg3fHN88uHj7ru3u++O9/aHw6ONBFImcA1VDYJvJoVPi70/TMKoyZiC6GsACmuAZUxC61WSLjBkCcZsOufMEwrREAxN4mQPJyncZF6MgkDVA5qJsZcTrNc3SyLxtPjzahIlE/F3z917/SpL/VdoqUpewbUP/3FnyY9nBwdv+mcHg4OXDYQCDWnwS3/Ds4Lvav9vd45MXTp4gY06arrvQr3aYOrOBHW8yL0W17no0Y2D/K87xZPdKcoSU5oNYOe2ulSMLt8PhVYpNngcejvO2fuRFzsf4a4AQaVw6fiIdKSSGHi/2DqlS+XDv81RZFQTR3MTnQgNsuKgnYhQqV1CgJmuyMvLi44BcjxCN0ckgOlmRtdRjG0ckUZHibXdkCN2PUJIkFR3GTUWq3C5NkqdXG0yCE6S6eOGBkFrW6ku+36FrRipB42kZBN3CJOTi8cFqOgidSnC2BD5TEZhAVRjwxfqFcyzbHTTWKV+ZXYHp30RKxYgTxA1tNhSUZTc01y9FLV3rF+i6pXGOfgQIGMPCw1z2ERIKXyyVTboHnhFrrrcBFLkQPAGdC6K7eu9CTwoMFDIyGnIysGNj8XrakTWKsM/RmrEgqeREARcUlbHW/FuJfpt3SGCo2/mEZGBUus5RP5bwYtyH3BbZDZxTB5zvAExPrA1FQcm3KcQOYMdzSqj6d2Bj3IwPckTnrmQKAbQkqOlMmDF8WLrq6D/o4a44tqhgMmuuDA8TAk5FBxpYFSHTIwA28jm9is7qsh0J5omAY0EYuCx1EA+x3hT9LrFxcCbEpkSnAi+gwLkuXidm1ht3ElWt+W3xCRSMUrEozpghZhAy6GjRc5fnPC0nABbPhizGFiLExr5IOOoQvJTg24niG5KHovEqEqKOlftM3TN1igAxOFjOpy2g+aiv1vKeBPhKobT/BKQbxHudAzA3jROCYzjKpybLP2BHppyDeE5f3bKSc1pzeC0SkxCCqXuAgjglqlQHMOKU3F4Lq59MiiAti6tzenJFDnJKND3Js6oPK7rjICfSPOt3hcxwDjE5qiXw4tCJ6IcCpyKnX4ilsbd08FzpeV39bIXcpRx4YB4zmfBc+FivhCH/ty8EJ8OP1+0vAjzm50uwqcXFHre7g7MwibbLFiZa7EJ0sPinBP/JvnKA6O820aoqxrZ+WoIyK/BAvihqOV/pyBXGVHom9SG/dcpjNDvTHEAIUX5OuGEkKLQwRBSenwjQwiWjJe14bceFze8eF6Bt1YxbwbfloR1h2ubjlf6U9x1to5Rfc2BT1keLDT2ggcyI5fQ+1wQ9T+ho1Z/"
 }
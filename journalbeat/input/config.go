@@ -45,6 +45,10 @@ type Config struct {
 	Matches []string `config:"include_matches"`
 	// SaveRemoteHostname defines if the original source of the entry needs to be saved.
 	SaveRemoteHostname bool `config:"save_remote_hostname"`
+	// Namespaces lists the journal namespaces to read, "*" reads all of them.
+	Namespaces []string `config:"namespaces"`
+	// Machines enables reading the journals of local systemd-nspawn containers.
+	Machines bool `config:"machines"`
 
 	// Fields and tags to add to events.
 	common.EventMetadata `config:",inline"`
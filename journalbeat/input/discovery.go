@@ -0,0 +1,160 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+var (
+	// journalDir is where persistent journals, including namespaced ones, are stored.
+	journalDir = "/var/log/journal"
+	// machinesDir is where machined keeps the root directories of local containers.
+	machinesDir = "/var/lib/machines"
+)
+
+// journalSource is a journal to read, and the fields identifying its origin.
+type journalSource struct {
+	path   string
+	fields common.MapStr
+}
+
+// discoverJournals returns the journals of the namespaces and local containers
+// enabled in the configuration.
+func discoverJournals(config Config, logger *logp.Logger) ([]journalSource, error) {
+	var sources []journalSource
+
+	if len(config.Namespaces) != 0 {
+		namespaces, err := discoverNamespaces(journalDir, config.Namespaces)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, namespaces...)
+	}
+
+	if config.Machines {
+		machines, err := discoverMachines(machinesDir)
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, machines...)
+	}
+
+	for _, s := range sources {
+		logger.Debugf("Discovered journal in %s with fields %v", s.path, s.fields)
+	}
+	return sources, nil
+}
+
+// discoverNamespaces looks for the journals of the given namespaces. Namespaced
+// journals are stored in directories named `<machine-id>.<namespace>`.
+func discoverNamespaces(dir string, namespaces []string) ([]journalSource, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sources []journalSource
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		parts := strings.SplitN(entry.Name(), ".", 2)
+		if len(parts) != 2 || !isMachineID(parts[0]) || !namespaceSelected(parts[1], namespaces) {
+			continue
+		}
+
+		sources = append(sources, journalSource{
+			path: filepath.Join(dir, entry.Name()),
+			fields: common.MapStr{
+				"journald": common.MapStr{
+					"namespace": parts[1],
+				},
+			},
+		})
+	}
+	return sources, nil
+}
+
+// discoverMachines looks for the persistent journals of local systemd-nspawn
+// containers. Containers whose journal is linked into the host journal are
+// already read from the local journal.
+func discoverMachines(dir string) ([]journalSource, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var sources []journalSource
+	for _, entry := range entries {
+		if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name(), "var", "log", "journal")
+		if info, err := os.Stat(path); err != nil || !info.IsDir() {
+			continue
+		}
+
+		sources = append(sources, journalSource{
+			path: path,
+			fields: common.MapStr{
+				"container": common.MapStr{
+					"name":    entry.Name(),
+					"runtime": "systemd-nspawn",
+				},
+			},
+		})
+	}
+	return sources, nil
+}
+
+func namespaceSelected(namespace string, namespaces []string) bool {
+	for _, ns := range namespaces {
+		if ns == "*" || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// isMachineID checks if the string is formatted as a machine ID, 32 hexadecimal characters.
+func isMachineID(s string) bool {
+	if len(s) != 32 {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
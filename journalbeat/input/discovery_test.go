@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package input
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const testMachineID = "0123456789abcdef0123456789abcdef"
+
+func TestDiscoverNamespaces(t *testing.T) {
+	dir, err := ioutil.TempDir("", "journal")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, d := range []string{testMachineID, testMachineID + ".audit", testMachineID + ".apps", "notamachineid.apps"} {
+		require.NoError(t, os.Mkdir(filepath.Join(dir, d), 0755))
+	}
+
+	sources, err := discoverNamespaces(dir, []string{"audit"})
+	require.NoError(t, err)
+	assert.Equal(t, []journalSource{
+		{
+			path:   filepath.Join(dir, testMachineID+".audit"),
+			fields: common.MapStr{"journald": common.MapStr{"namespace": "audit"}},
+		},
+	}, sources)
+
+	sources, err = discoverNamespaces(dir, []string{"*"})
+	require.NoError(t, err)
+	assert.Len(t, sources, 2)
+
+	sources, err = discoverNamespaces(filepath.Join(dir, "missing"), []string{"*"})
+	require.NoError(t, err)
+	assert.Empty(t, sources)
+}
+
+func TestDiscoverMachines(t *testing.T) {
+	dir, err := ioutil.TempDir("", "machines")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "web", "var", "log", "journal"), 0755))
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "volatile", "var", "log"), 0755))
+
+	sources, err := discoverMachines(dir)
+	require.NoError(t, err)
+	assert.Equal(t, []journalSource{
+		{
+			path: filepath.Join(dir, "web", "var", "log", "journal"),
+			fields: common.MapStr{
+				"container": common.MapStr{
+					"name":    "web",
+					"runtime": "systemd-nspawn",
+				},
+			},
+		},
+	}, sources)
+}
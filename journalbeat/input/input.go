@@ -84,16 +84,28 @@ func New(
 		readers = append(readers, r)
 	}
 
+	sources := make([]journalSource, 0, len(config.Paths))
 	for _, p := range config.Paths {
+		sources = append(sources, journalSource{path: p})
+	}
+
+	discovered, err := discoverJournals(config, logger)
+	if err != nil {
+		return nil, err
+	}
+	sources = append(sources, discovered...)
+
+	for _, source := range sources {
 		cfg := reader.Config{
-			Path:               p,
+			Path:               source.path,
 			Backoff:            config.Backoff,
 			MaxBackoff:         config.MaxBackoff,
 			Seek:               config.Seek,
 			CursorSeekFallback: config.CursorSeekFallback,
 			Matches:            config.Matches,
 			SaveRemoteHostname: config.SaveRemoteHostname,
-			CheckpointID:       checkpointID(config.ID, p),
+			CheckpointID:       checkpointID(config.ID, source.path),
+			Fields:             source.fields,
 		}
 
 		state := states[cfg.CheckpointID]
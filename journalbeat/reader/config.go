@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/elastic/beats/v7/journalbeat/config"
+	"github.com/elastic/beats/v7/libbeat/common"
 )
 
 // Config stores the options of a reder.
@@ -43,6 +44,8 @@ type Config struct {
 	SaveRemoteHostname bool
 	// CheckpointID is the identifier to use when persisting state.
 	CheckpointID string
+	// Fields are added to every entry read, they identify the source of the journal.
+	Fields common.MapStr
 }
 
 const (
@@ -257,6 +257,10 @@ func (r *Reader) toEvent(entry *sdjournal.JournalEntry) *beat.Event {
 		fields.Put("journald.custom", custom)
 	}
 
+	if len(r.config.Fields) != 0 {
+		fields.DeepUpdate(r.config.Fields.Clone())
+	}
+
 	// if entry is coming from a remote journal, add_host_metadata overwrites the source hostname, so it
 	// has to be copied to a different field
 	if r.config.SaveRemoteHostname {
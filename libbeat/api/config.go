@@ -17,15 +17,36 @@
 
 package api
 
-import "os"
+import (
+	"os"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
 
 // Config is the configuration for the API endpoint.
 type Config struct {
-	Enabled            bool   `config:"enabled"`
-	Host               string `config:"host"`
-	Port               int    `config:"port"`
-	User               string `config:"named_pipe.user"`
-	SecurityDescriptor string `config:"named_pipe.security_descriptor"`
+	Enabled            bool          `config:"enabled"`
+	Host               string        `config:"host"`
+	Port               int           `config:"port"`
+	User               string        `config:"named_pipe.user"`
+	SecurityDescriptor string        `config:"named_pipe.security_descriptor"`
+	Inject             InjectConfig  `config:"debug.inject"`
+	Logging            LoggingConfig `config:"debug.logging"`
+}
+
+// InjectConfig is the configuration for the event injection endpoint.
+type InjectConfig struct {
+	Enabled bool `config:"enabled"`
+	// Token required in the Authorization header of the requests. It is
+	// mandatory when the endpoint is not bound to localhost or a socket.
+	Token       string           `config:"token"`
+	MaxBodySize cfgtype.ByteSize `config:"max_body_size" validate:"min=1"`
+}
+
+// LoggingConfig is the configuration for the endpoint changing the log level
+// of selectors at runtime.
+type LoggingConfig struct {
+	Enabled bool `config:"enabled"`
 }
 
 var (
@@ -34,6 +55,9 @@ var (
 		Enabled: false,
 		Host:    "localhost",
 		Port:    5066,
+		Inject: InjectConfig{
+			MaxBodySize: 10 * 1024 * 1024,
+		},
 	}
 )
 
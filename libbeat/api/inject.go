@@ -0,0 +1,208 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/publisher/processing"
+)
+
+const (
+	injectModeDryRun = "dry_run"
+	injectModeLive   = "live"
+)
+
+// injectRequest is the body accepted by the inject endpoint.
+type injectRequest struct {
+	Mode   string          `json:"mode"`
+	Events []common.MapStr `json:"events"`
+}
+
+// injectResponse is the body returned by the inject endpoint. Events dropped
+// by the processors are returned as null documents.
+type injectResponse struct {
+	Mode      string          `json:"mode"`
+	Documents []common.MapStr `json:"documents"`
+	Dropped   int             `json:"dropped"`
+	Published int             `json:"published"`
+}
+
+// MakeInjectHandler returns a handler that runs the events sent in the body of
+// the request through the processors of the publisher pipeline and returns the
+// resulting documents. In live mode the events are also published to the outputs.
+// When a token is configured, requests must include it as a bearer token in the
+// Authorization header.
+func MakeInjectHandler(log *logp.Logger, config InjectConfig, support processing.Supporter, pipeline beat.PipelineConnector) http.Handler {
+	log = log.Named("inject")
+	maxBodySize := int64(config.MaxBodySize)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST requests are accepted", http.StatusMethodNotAllowed)
+			return
+		}
+
+		if config.Token != "" && !validInjectToken(r, config.Token) {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "a valid token is required", http.StatusUnauthorized)
+			return
+		}
+
+		if maxBodySize > 0 {
+			if r.ContentLength > maxBodySize {
+				http.Error(w, fmt.Sprintf("request body larger than %d bytes", maxBodySize), http.StatusRequestEntityTooLarge)
+				return
+			}
+			r.Body = http.MaxBytesReader(w, r.Body, maxBodySize)
+		}
+
+		var req injectRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, fmt.Sprintf("failed to decode request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if req.Mode == "" {
+			req.Mode = injectModeDryRun
+		}
+		if req.Mode != injectModeDryRun && req.Mode != injectModeLive {
+			http.Error(w, fmt.Sprintf("unknown mode '%s'", req.Mode), http.StatusBadRequest)
+			return
+		}
+
+		events := make([]beat.Event, 0, len(req.Events))
+		for _, fields := range req.Events {
+			event, err := makeInjectEvent(fields)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			events = append(events, event)
+		}
+
+		processor, err := support.Create(beat.ProcessingConfig{}, false)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to create processors: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		resp := injectResponse{Mode: req.Mode, Documents: make([]common.MapStr, 0, len(events))}
+		for _, event := range events {
+			// Processors can modify the event in place, keep the original one for publishing
+			processed, err := processor.Run(copyEvent(event))
+			if err != nil {
+				log.Debugf("Failed to process injected event: %v", err)
+			}
+			if processed == nil {
+				resp.Dropped++
+				resp.Documents = append(resp.Documents, nil)
+				continue
+			}
+			resp.Documents = append(resp.Documents, eventToDocument(processed))
+		}
+
+		if req.Mode == injectModeLive && len(events) != 0 {
+			client, err := pipeline.ConnectWith(beat.ClientConfig{})
+			if err != nil {
+				http.Error(w, fmt.Sprintf("failed to connect to the pipeline: %v", err), http.StatusInternalServerError)
+				return
+			}
+			client.PublishAll(events)
+			client.Close()
+			resp.Published = len(events)
+			log.Infof("Published %d injected events", len(events))
+		}
+
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(resp)
+	})
+}
+
+func validInjectToken(r *http.Request, token string) bool {
+	const prefix = "Bearer "
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, prefix) {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(auth[len(prefix):]), []byte(token)) == 1
+}
+
+func makeInjectEvent(fields common.MapStr) (beat.Event, error) {
+	event := beat.Event{Timestamp: time.Now(), Fields: fields.Clone()}
+
+	if ts, ok := event.Fields["@timestamp"]; ok {
+		str, _ := ts.(string)
+		t, err := time.Parse(time.RFC3339Nano, str)
+		if err != nil {
+			return event, fmt.Errorf("invalid @timestamp '%v' in event: %v", ts, err)
+		}
+		event.Timestamp = t
+		delete(event.Fields, "@timestamp")
+	}
+
+	if meta, ok := event.Fields["@metadata"]; ok {
+		m, ok := tryToMapStr(meta)
+		if !ok {
+			return event, fmt.Errorf("invalid @metadata in event, it must be an object")
+		}
+		event.Meta = m
+		delete(event.Fields, "@metadata")
+	}
+
+	return event, nil
+}
+
+func copyEvent(event beat.Event) *beat.Event {
+	return &beat.Event{
+		Timestamp: event.Timestamp,
+		Meta:      event.Meta.Clone(),
+		Fields:    event.Fields.Clone(),
+	}
+}
+
+func eventToDocument(event *beat.Event) common.MapStr {
+	doc := event.Fields.Clone()
+	if doc == nil {
+		doc = common.MapStr{}
+	}
+	doc["@timestamp"] = common.Time(event.Timestamp)
+	if len(event.Meta) != 0 {
+		doc["@metadata"] = event.Meta
+	}
+	return doc
+}
+
+func tryToMapStr(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return common.MapStr(m), true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,169 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+type injectSupport struct{}
+
+func (injectSupport) Create(_ beat.ProcessingConfig, _ bool) (beat.Processor, error) {
+	return injectProcessor{}, nil
+}
+
+// injectProcessor drops events with a drop field and adds a field to the rest
+type injectProcessor struct{}
+
+func (injectProcessor) Run(event *beat.Event) (*beat.Event, error) {
+	if _, err := event.GetValue("drop"); err == nil {
+		return nil, nil
+	}
+	event.PutValue("processed", true)
+	return event, nil
+}
+
+func (injectProcessor) String() string { return "inject_test" }
+
+type injectPipeline struct {
+	published []beat.Event
+}
+
+func (p *injectPipeline) ConnectWith(beat.ClientConfig) (beat.Client, error) { return p, nil }
+func (p *injectPipeline) Connect() (beat.Client, error)                      { return p, nil }
+func (p *injectPipeline) Publish(e beat.Event)                               { p.published = append(p.published, e) }
+func (p *injectPipeline) PublishAll(es []beat.Event)                         { p.published = append(p.published, es...) }
+func (p *injectPipeline) Close() error                                       { return nil }
+
+func TestInjectHandler(t *testing.T) {
+	body := `{"mode": "%s", "events": [
+		{"@timestamp": "2020-06-01T10:00:00Z", "message": "hello"},
+		{"message": "bye", "drop": true}
+	]}`
+
+	t.Run("dry run does not publish", func(t *testing.T) {
+		pipeline := &injectPipeline{}
+		handler := MakeInjectHandler(logp.NewLogger(""), DefaultConfig.Inject, injectSupport{}, pipeline)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "", 1))))
+		require.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]interface{}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		assert.Equal(t, "dry_run", resp["mode"])
+		assert.Equal(t, float64(1), resp["dropped"])
+		assert.Equal(t, float64(0), resp["published"])
+
+		docs := resp["documents"].([]interface{})
+		require.Len(t, docs, 2)
+		assert.Equal(t, map[string]interface{}{
+			"@timestamp": "2020-06-01T10:00:00.000Z",
+			"message":    "hello",
+			"processed":  true,
+		}, docs[0])
+		assert.Nil(t, docs[1])
+		assert.Empty(t, pipeline.published)
+	})
+
+	t.Run("live mode publishes the events", func(t *testing.T) {
+		pipeline := &injectPipeline{}
+		handler := MakeInjectHandler(logp.NewLogger(""), DefaultConfig.Inject, injectSupport{}, pipeline)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "live", 1))))
+		require.Equal(t, http.StatusOK, w.Code)
+		require.Len(t, pipeline.published, 2)
+
+		// Published events are not modified by the dry run processing
+		_, err := pipeline.published[0].GetValue("processed")
+		assert.Error(t, err)
+	})
+
+	t.Run("only POST is accepted", func(t *testing.T) {
+		handler := MakeInjectHandler(logp.NewLogger(""), DefaultConfig.Inject, injectSupport{}, &injectPipeline{})
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/inject", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+
+	t.Run("unknown mode is rejected", func(t *testing.T) {
+		handler := MakeInjectHandler(logp.NewLogger(""), DefaultConfig.Inject, injectSupport{}, &injectPipeline{})
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "other", 1))))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+
+	t.Run("requests without a valid token are rejected", func(t *testing.T) {
+		config := DefaultConfig.Inject
+		config.Token = "secret"
+		pipeline := &injectPipeline{}
+		handler := MakeInjectHandler(logp.NewLogger(""), config, injectSupport{}, pipeline)
+
+		for _, auth := range []string{"", "Bearer other", "secret"} {
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "live", 1)))
+			if auth != "" {
+				req.Header.Set("Authorization", auth)
+			}
+			handler.ServeHTTP(w, req)
+			assert.Equal(t, http.StatusUnauthorized, w.Code, auth)
+		}
+		assert.Empty(t, pipeline.published)
+
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "live", 1)))
+		req.Header.Set("Authorization", "Bearer secret")
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Len(t, pipeline.published, 2)
+	})
+
+	t.Run("oversized bodies are rejected", func(t *testing.T) {
+		config := DefaultConfig.Inject
+		config.MaxBodySize = 64
+		pipeline := &injectPipeline{}
+		handler := MakeInjectHandler(logp.NewLogger(""), config, injectSupport{}, pipeline)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/debug/inject", strings.NewReader(strings.Replace(body, "%s", "live", 1))))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+
+		// Bodies of unknown length are limited while they are read
+		w = httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/debug/inject", ioutil.NopCloser(strings.NewReader(strings.Replace(body, "%s", "live", 1))))
+		req.ContentLength = -1
+		handler.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "too large")
+		assert.Empty(t, pipeline.published)
+	})
+}
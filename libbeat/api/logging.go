@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// loggingRequest is the body accepted by the logging endpoint to set the
+// level of a selector.
+type loggingRequest struct {
+	Selector string `json:"selector"`
+	Level    string `json:"level"`
+}
+
+// loggingResponse is the body returned by the logging endpoint, with the
+// levels set at runtime for each selector.
+type loggingResponse struct {
+	Levels map[string]string `json:"levels"`
+}
+
+// MakeLoggingHandler returns a handler to change the log level of selectors at
+// runtime. GET requests return the levels currently set, POST requests set the
+// level of a selector, and DELETE requests with a selector query parameter
+// restore the configured level for the selector.
+func MakeLoggingHandler(log *logp.Logger) http.Handler {
+	log = log.Named("logging")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			var req loggingRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, fmt.Sprintf("failed to decode request: %v", err), http.StatusBadRequest)
+				return
+			}
+			req.Selector = strings.TrimSpace(req.Selector)
+			if req.Selector == "" {
+				http.Error(w, "selector is required", http.StatusBadRequest)
+				return
+			}
+			var level logp.Level
+			if err := level.Unpack(req.Level); err != nil {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			logp.SetSelectorLevel(req.Selector, level)
+			log.Infof("Log level of selector '%s' set to %s", req.Selector, level)
+		case http.MethodDelete:
+			selector := strings.TrimSpace(r.URL.Query().Get("selector"))
+			if selector == "" {
+				http.Error(w, "selector query parameter is required", http.StatusBadRequest)
+				return
+			}
+			logp.ResetSelectorLevel(selector)
+			log.Infof("Log level of selector '%s' reset to the configured level", selector)
+		default:
+			w.Header().Set("Allow", strings.Join([]string{http.MethodGet, http.MethodPost, http.MethodDelete}, ", "))
+			http.Error(w, "only GET, POST and DELETE requests are accepted", http.StatusMethodNotAllowed)
+			return
+		}
+
+		resp := loggingResponse{Levels: map[string]string{}}
+		for selector, level := range logp.SelectorLevels() {
+			resp.Levels[selector] = level.String()
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		json.NewEncoder(w).Encode(resp)
+	})
+}
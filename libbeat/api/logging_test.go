@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestLoggingHandler(t *testing.T) {
+	handler := MakeLoggingHandler(logp.NewLogger(""))
+	defer logp.ResetSelectorLevel("hints.builder")
+
+	do := func(method, target, body string) (int, loggingResponse) {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(method, target, strings.NewReader(body)))
+		var resp loggingResponse
+		if w.Code == http.StatusOK {
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		}
+		return w.Code, resp
+	}
+
+	code, resp := do(http.MethodPost, "/debug/logging", `{"selector": "hints.builder", "level": "debug"}`)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"hints.builder": "debug"}, resp.Levels)
+	assert.Equal(t, logp.DebugLevel, logp.SelectorLevels()["hints.builder"])
+
+	code, resp = do(http.MethodGet, "/debug/logging", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, map[string]string{"hints.builder": "debug"}, resp.Levels)
+
+	code, _ = do(http.MethodPost, "/debug/logging", `{"selector": "hints.builder", "level": "verbose"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, _ = do(http.MethodPost, "/debug/logging", `{"level": "debug"}`)
+	assert.Equal(t, http.StatusBadRequest, code)
+
+	code, resp = do(http.MethodDelete, "/debug/logging?selector=hints.builder", "")
+	assert.Equal(t, http.StatusOK, code)
+	assert.Empty(t, resp.Levels)
+	assert.Empty(t, logp.SelectorLevels())
+
+	code, _ = do(http.MethodPut, "/debug/logging", "")
+	assert.Equal(t, http.StatusMethodNotAllowed, code)
+}
@@ -38,7 +38,16 @@ func NewWithDefaultRoutes(log *logp.Logger, config *common.Config, ns lookupFunc
 	mux.HandleFunc("/state", makeAPIHandler(ns("state")))
 	mux.HandleFunc("/stats", makeAPIHandler(ns("stats")))
 	mux.HandleFunc("/dataset", makeAPIHandler(ns("dataset")))
-	return New(log, mux, config)
+	mux.HandleFunc("/goroutines", makeAPIHandler(ns("goroutines")))
+
+	s, err := New(log, mux, config)
+	if err != nil {
+		return nil, err
+	}
+	if s.config.Logging.Enabled {
+		mux.Handle("/debug/logging", MakeLoggingHandler(s.log))
+	}
+	return s, nil
 }
 
 func makeRootAPIHandler(handler handlerFunc) handlerFunc {
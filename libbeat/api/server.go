@@ -24,8 +24,10 @@ import (
 	"net/url"
 	"strconv"
 
+	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/publisher/processing"
 )
 
 // Server takes cares of correctly starting the HTTP component of the API
@@ -54,6 +56,14 @@ func New(log *logp.Logger, mux *http.ServeMux, config *common.Config) (*Server,
 		return nil, err
 	}
 
+	// Injected events are published to the outputs, don't let remote clients
+	// inject them without authentication.
+	if cfg.Inject.Enabled && cfg.Inject.Token == "" && !isLocalListener(l) {
+		l.Close()
+		return nil, fmt.Errorf("the event injection endpoint requires a token when listening on %s, "+
+			"bind the HTTP endpoint to localhost or a socket, or set http.debug.inject.token", l.Addr())
+	}
+
 	return &Server{mux: mux, l: l, config: cfg, log: log.Named("api")}, nil
 }
 
@@ -67,11 +77,31 @@ func (s *Server) Start() {
 	}(s.l)
 }
 
+// AttachInjectHandler registers the event injection endpoint if it is enabled
+// in the configuration. Events injected through it are run through the given
+// processing and pipeline.
+func (s *Server) AttachInjectHandler(support processing.Supporter, pipeline beat.PipelineConnector) {
+	if !s.config.Inject.Enabled {
+		return
+	}
+	s.log.Warn("Event injection endpoint enabled on /debug/inject, do not use it in production")
+	s.mux.Handle("/debug/inject", MakeInjectHandler(s.log, s.config.Inject, support, pipeline))
+}
+
 // Stop stops the API server and free any resource associated with the process like unix sockets.
 func (s *Server) Stop() error {
 	return s.l.Close()
 }
 
+// isLocalListener returns true if the listener only accepts connections from
+// the local host, like unix sockets, named pipes or loopback addresses.
+func isLocalListener(l net.Listener) bool {
+	if addr, ok := l.Addr().(*net.TCPAddr); ok {
+		return addr.IP.IsLoopback()
+	}
+	return true
+}
+
 func parse(host string, port int) (string, string, error) {
 	url, err := url.Parse(host)
 	if err != nil {
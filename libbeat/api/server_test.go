@@ -177,6 +177,33 @@ func TestHTTP(t *testing.T) {
 	assert.Equal(t, "ehlo!", string(body))
 }
 
+func TestInjectRequiresTokenOnRemoteHosts(t *testing.T) {
+	for _, c := range []struct {
+		host  string
+		token string
+		ok    bool
+	}{
+		{host: "localhost", ok: true},
+		{host: "0.0.0.0", ok: false},
+		{host: "0.0.0.0", token: "secret", ok: true},
+	} {
+		cfg := common.MustNewConfigFrom(map[string]interface{}{
+			"host":                 c.host,
+			"port":                 0,
+			"debug.inject.enabled": true,
+			"debug.inject.token":   c.token,
+		})
+
+		s, err := New(nil, simpleMux(), cfg)
+		if !c.ok {
+			assert.Error(t, err, "host: %s, token: %s", c.host, c.token)
+			continue
+		}
+		require.NoError(t, err, "host: %s, token: %s", c.host, c.token)
+		s.Stop()
+	}
+}
+
 func simpleMux() *http.ServeMux {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/echo-hello", func(w http.ResponseWriter, r *http.Request) {
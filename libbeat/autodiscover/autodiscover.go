@@ -19,9 +19,13 @@ package autodiscover
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover/meta"
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -31,11 +35,15 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/keystore"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
 )
 
 const (
 	// If a config reload fails after a new event, a new reload will be run after this period
 	retryPeriod = 10 * time.Second
+
+	// Restored configs not emitted again by a provider are stopped after this period
+	defaultStaleTimeout = 5 * time.Minute
 )
 
 // EventConfigurer is used to configure the creation of configuration objects
@@ -63,7 +71,30 @@ type Autodiscover struct {
 	runners         *cfgfile.RunnerList
 	meta            *meta.Map
 	listener        bus.Listener
+	debounce        time.Duration
 	logger          *logp.Logger
+
+	// state saves the running configs if persistence is enabled, restored
+	// holds the IDs of the restored configs not emitted again yet.
+	state        *stateStore
+	restored     map[string]bool
+	staleTimeout time.Duration
+
+	// providerKeys maps the IDs of the providers, generated on each run, to
+	// keys stable across restarts, used to identify the persisted configs.
+	// providerTypes maps these keys to the types of the providers, running
+	// maps the hashes of the configs with a running runner to the type of
+	// the provider that emitted them.
+	providerKeys  map[string]string
+	providerTypes map[string]string
+	running       map[uint64]string
+
+	// limiter limits the runners started and stopped if rate limiting is
+	// enabled, applied holds the configs the runners were last reloaded
+	// with, and pending the number of starts and stops deferred.
+	limiter *rate.Limiter
+	applied map[uint64]*reload.ConfigWithMeta
+	pending int
 }
 
 // NewAutodiscover instantiates and returns a new Autodiscover manager
@@ -82,13 +113,50 @@ func NewAutodiscover(
 
 	// Init providers
 	var providers []Provider
-	for _, providerCfg := range config.Providers {
-		provider, err := Registry.BuildProvider(bus, providerCfg, keystore)
+	providerKeys := map[string]string{}
+	providerTypes := map[string]string{}
+	for i, providerCfg := range config.Providers {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return nil, err
+		}
+		provider, err := Registry.buildProvider(bus, id, providerCfg, keystore)
 		if err != nil {
 			return nil, errors.Wrap(err, "error in autodiscover provider settings")
 		}
 		logger.Debugf("Configured autodiscover provider: %s", provider)
 		providers = append(providers, provider)
+
+		var providerConfig ProviderConfig
+		if err := providerCfg.Unpack(&providerConfig); err != nil {
+			return nil, errors.Wrap(err, "error in autodiscover provider settings")
+		}
+		key, err := providerKey(providerConfig, providerCfg)
+		if err != nil {
+			return nil, err
+		}
+		if _, exists := providerTypes[key]; exists {
+			if providerConfig.ID != "" {
+				return nil, fmt.Errorf("duplicated autodiscover provider id '%s'", providerConfig.ID)
+			}
+			// Providers with the same settings are told apart by their position
+			key = fmt.Sprintf("%s-%d", key, i)
+		}
+		providerKeys[id.String()] = key
+		providerTypes[key] = strings.ToLower(providerConfig.Type)
+	}
+
+	var state *stateStore
+	staleTimeout := config.Persist.StaleTimeout
+	if config.Persist.Enabled {
+		path := config.Persist.Path
+		if path == "" {
+			path = filepath.Join("autodiscover", name+".json")
+		}
+		state = &stateStore{path: paths.Resolve(paths.Data, path)}
+		if staleTimeout == 0 {
+			staleTimeout = defaultStaleTimeout
+		}
 	}
 
 	return &Autodiscover{
@@ -100,7 +168,16 @@ func NewAutodiscover(
 		runners:         cfgfile.NewRunnerList("autodiscover", factory, pipeline),
 		providers:       providers,
 		meta:            meta.NewMap(),
+		debounce:        config.Debounce,
 		logger:          logger,
+		state:           state,
+		restored:        map[string]bool{},
+		staleTimeout:    staleTimeout,
+		providerKeys:    providerKeys,
+		providerTypes:   providerTypes,
+		running:         map[uint64]string{},
+		limiter:         newRateLimiter(config.RateLimit),
+		applied:         map[uint64]*reload.ConfigWithMeta{},
 	}, nil
 }
 
@@ -113,6 +190,10 @@ func (a *Autodiscover) Start() {
 	a.logger.Info("Starting autodiscover manager")
 	a.listener = a.bus.Subscribe(a.configurer.EventFilter()...)
 
+	if a.state != nil {
+		a.restore()
+	}
+
 	// It is important to start the worker first before starting the producer.
 	// In hosts that have large number of workloads, it is easy to have an initial
 	// sync of workloads to have a count that is greater than 100 (which is the size
@@ -128,42 +209,94 @@ func (a *Autodiscover) Start() {
 func (a *Autodiscover) worker() {
 	var updated, retry bool
 
+	// With a debounce window, the runners are reloaded once the window
+	// started by the first update expires, so events flapping during the
+	// window, as the stop and start of the same configs, are collapsed.
+	var window <-chan time.Time
+
+	// Restored configs not emitted again by the providers in time belong to
+	// instances that are gone.
+	var stale <-chan time.Time
+	if len(a.restored) > 0 {
+		stale = time.After(a.staleTimeout)
+	}
+
+	// With a rate limit, the starts and stops deferred are applied as the
+	// limiter allows them.
+	var throttle <-chan time.Time
+	if a.pending > 0 {
+		throttle = time.After(a.throttleDelay())
+	}
+
 	for {
+		var expired bool
+
 		select {
 		case event := <-a.listener.Events():
 			// This will happen on Stop:
 			if event == nil {
+				// All runners are stopped by Stop.
+				a.updateMetrics(nil)
 				return
 			}
 
+			eventsReceived.Inc()
+			if providerType, ok := a.providerTypes[a.providerKeys[fmt.Sprint(event["provider"])]]; ok {
+				getProviderMetrics(providerType).events.Inc()
+			}
+
 			if _, ok := event["start"]; ok {
-				updated = a.handleStart(event)
+				updated = a.handleStart(event) || updated
 			}
 			if _, ok := event["stop"]; ok {
-				updated = a.handleStop(event)
+				updated = a.handleStop(event) || updated
 			}
 
+		case <-window:
+			window = nil
+			expired = true
+
+		case <-stale:
+			stale = nil
+			updated = a.dropRestored() || updated
+
+		case <-throttle:
+			throttle = nil
+			updated = true
+
 		case <-time.After(retryPeriod):
 		}
 
+		if a.debounce > 0 && !expired && (updated || window != nil) {
+			if window == nil {
+				a.logger.Debugf("Collapsing autodiscover events for %v", a.debounce)
+				window = time.After(a.debounce)
+			}
+			continue
+		}
+
 		if updated || retry {
 			if retry {
 				a.logger.Debug("Reloading existing autodiscover configs after error")
 			}
 
-			configs := []*reload.ConfigWithMeta{}
-			for _, list := range a.configs {
-				for _, c := range list {
-					configs = append(configs, c)
-				}
-			}
-
-			err := a.runners.Reload(configs)
+			err := a.reload()
 
 			// On error, make sure the next run also updates because some runners were not properly loaded
 			retry = err != nil
 			// reset updated status
 			updated = false
+
+			if a.pending > 0 && throttle == nil {
+				a.logger.Debugf("Deferring %d autodiscover config starts and stops by the rate limit", a.pending)
+				throttle = time.After(a.throttleDelay())
+			}
+
+			if a.state != nil {
+				if err := a.state.save(a.configs); err != nil {
+					a.logger.Errorf("Failed to save autodiscover state: %v", err)
+				}
+			}
 		}
 	}
 }
@@ -173,12 +306,20 @@ func (a *Autodiscover) handleStart(event bus.Event) bool {
 
 	a.logger.Debugf("Got a start event: %v", event)
 
-	eventID := getID(event)
+	eventID := a.getID(event)
 	if eventID == "" {
 		a.logger.Errorf("Event didn't provide instance id: %+v, ignoring it", event)
 		return false
 	}
 
+	// Configs restored from the state file are replaced by the ones
+	// generated from the current event.
+	if a.restored[eventID] {
+		delete(a.restored, eventID)
+		delete(a.configs, eventID)
+		updated = true
+	}
+
 	// Ensure configs list exists for this instance
 	if _, ok := a.configs[eventID]; !ok {
 		a.configs[eventID] = map[uint64]*reload.ConfigWithMeta{}
@@ -187,7 +328,7 @@ func (a *Autodiscover) handleStart(event bus.Event) bool {
 	configs, err := a.configurer.CreateConfig(event)
 	if err != nil {
 		a.logger.Debugf("Could not generate config from event %v: %v", event, err)
-		return false
+		return updated
 	}
 
 	if a.logger.IsDebug() {
@@ -207,6 +348,7 @@ func (a *Autodiscover) handleStart(event bus.Event) bool {
 		err = a.factory.CheckConfig(config)
 		if err != nil {
 			a.logger.Error(errors.Wrap(err, fmt.Sprintf("Auto discover config check failed for config '%s', won't start runner", common.DebugString(config, true))))
+			configsFailed.Inc()
 			continue
 		}
 
@@ -232,7 +374,7 @@ func (a *Autodiscover) handleStop(event bus.Event) bool {
 	var updated bool
 
 	a.logger.Debugf("Got a stop event: %v", event)
-	eventID := getID(event)
+	eventID := a.getID(event)
 	if eventID == "" {
 		a.logger.Errorf("Event didn't provide instance id: %+v, ignoring it", event)
 		return false
@@ -244,10 +386,147 @@ func (a *Autodiscover) handleStop(event bus.Event) bool {
 	}
 
 	delete(a.configs, eventID)
+	delete(a.restored, eventID)
 
 	return updated
 }
 
+// restore starts the runners of the configs saved by a previous run.
+func (a *Autodiscover) restore() {
+	saved, err := a.state.load()
+	if err != nil {
+		a.logger.Errorf("Failed to load autodiscover state, configs won't be restored: %v", err)
+		return
+	}
+
+	var configs []*reload.ConfigWithMeta
+	for _, c := range saved {
+		config, err := common.NewConfigFrom(c.Config)
+		if err != nil {
+			a.logger.Errorf("Failed to restore autodiscover config: %v", err)
+			continue
+		}
+
+		hash, err := cfgfile.HashConfig(config)
+		if err != nil {
+			a.logger.Debugf("Could not hash config %v: %v", common.DebugString(config, true), err)
+			continue
+		}
+
+		if err := a.factory.CheckConfig(config); err != nil {
+			a.logger.Error(errors.Wrap(err, fmt.Sprintf("Auto discover config check failed for restored config '%s', won't start runner", common.DebugString(config, true))))
+			configsFailed.Inc()
+			continue
+		}
+
+		if _, ok := a.configs[c.ID]; !ok {
+			a.configs[c.ID] = map[uint64]*reload.ConfigWithMeta{}
+		}
+		dynFields := a.meta.Store(hash, c.Meta)
+		a.configs[c.ID][hash] = &reload.ConfigWithMeta{
+			Config: config,
+			Meta:   &dynFields,
+		}
+		a.restored[c.ID] = true
+		configs = append(configs, a.configs[c.ID][hash])
+	}
+
+	if len(configs) == 0 {
+		return
+	}
+
+	a.logger.Infof("Restoring %d autodiscover configs saved by a previous run", len(configs))
+	if err := a.reload(); err != nil {
+		a.logger.Errorf("Failed to start restored autodiscover configs: %v", err)
+	}
+}
+
+// reload reloads the runners with the current configs. With a rate limit,
+// only the starts and stops allowed by the limiter are applied.
+func (a *Autodiscover) reload() error {
+	configs := []*reload.ConfigWithMeta{}
+	for _, list := range a.configs {
+		for _, c := range list {
+			configs = append(configs, c)
+		}
+	}
+
+	a.pending = 0
+	if a.limiter != nil {
+		configs, a.pending = a.limitChanges(configs)
+	}
+	configsPending.Set(int64(a.pending))
+
+	err := a.runners.Reload(configs)
+	a.updateMetrics(a.runningConfigs())
+	return err
+}
+
+// dropRestored removes the restored configs that no provider emitted again.
+func (a *Autodiscover) dropRestored() bool {
+	if len(a.restored) == 0 {
+		return false
+	}
+
+	a.logger.Infof("Stopping %d restored autodiscover configs not found by the providers", len(a.restored))
+	for id := range a.restored {
+		delete(a.configs, id)
+	}
+	a.restored = map[string]bool{}
+	return true
+}
+
+// runningConfigs returns the hashes of the configs with a running runner,
+// mapped to the type of the provider that emitted them. Configs whose
+// runner could not be created are counted as failed, unless their start
+// was deferred by the rate limit.
+func (a *Autodiscover) runningConfigs() map[uint64]string {
+	running := map[uint64]string{}
+	failed := map[uint64]bool{}
+	for id, list := range a.configs {
+		providerType := a.providerTypes[strings.SplitN(id, ":", 2)[0]]
+		for hash := range list {
+			if !a.runners.Has(hash) {
+				if _, ok := a.applied[hash]; ok || a.limiter == nil {
+					failed[hash] = true
+				}
+			} else if running[hash] == "" {
+				running[hash] = providerType
+			}
+		}
+	}
+	configsFailed.Add(int64(len(failed)))
+	return running
+}
+
+// updateMetrics updates the metrics of the started, stopped and running
+// configs from the configs running before and after a reload.
+func (a *Autodiscover) updateMetrics(running map[uint64]string) {
+	deltas := map[string]int64{}
+	for hash, providerType := range running {
+		if _, ok := a.running[hash]; !ok {
+			configsStarted.Inc()
+		}
+		deltas[providerType]++
+	}
+	for hash, providerType := range a.running {
+		if _, ok := running[hash]; !ok {
+			configsStopped.Inc()
+		}
+		deltas[providerType]--
+	}
+
+	for providerType, delta := range deltas {
+		// Configs restored from a previous run don't belong to any provider
+		// until they are emitted again.
+		if providerType != "" && delta != 0 {
+			getProviderMetrics(providerType).running.Add(delta)
+		}
+	}
+	configsRunning.Add(int64(len(running) - len(a.running)))
+	a.running = running
+}
+
 func (a *Autodiscover) getMeta(event bus.Event) common.MapStr {
 	m := event["meta"]
 	if m == nil {
@@ -263,8 +542,9 @@ func (a *Autodiscover) getMeta(event bus.Event) common.MapStr {
 	return meta
 }
 
-// getID returns the event "id" field string if present
-func getID(e bus.Event) string {
+// getID returns the event "id" field string if present, prefixed with the
+// stable key of the provider that emitted it.
+func (a *Autodiscover) getID(e bus.Event) string {
 	provider, ok := e["provider"]
 	if !ok {
 		return ""
@@ -275,9 +555,28 @@ func getID(e bus.Event) string {
 		return ""
 	}
 
+	if key, ok := a.providerKeys[fmt.Sprint(provider)]; ok {
+		provider = key
+	}
 	return fmt.Sprintf("%s:%s", provider, id)
 }
 
+// providerKey returns a key identifying a provider across restarts, its
+// configured ID, or its type and a hash of its settings.
+func providerKey(config ProviderConfig, c *common.Config) (string, error) {
+	if config.ID != "" {
+		if strings.Contains(config.ID, ":") {
+			return "", fmt.Errorf("invalid autodiscover provider id '%s', it cannot contain ':'", config.ID)
+		}
+		return config.ID, nil
+	}
+	hash, err := cfgfile.HashConfig(c)
+	if err != nil {
+		return "", errors.Wrap(err, "error hashing autodiscover provider settings")
+	}
+	return fmt.Sprintf("%s-%x", strings.ToLower(config.Type), hash), nil
+}
+
 // Stop autodiscover process
 func (a *Autodiscover) Stop() {
 	if a == nil {
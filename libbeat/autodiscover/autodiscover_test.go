@@ -19,6 +19,9 @@ package autodiscover
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sync"
 	"testing"
 	"time"
@@ -259,6 +262,327 @@ func TestAutodiscover(t *testing.T) {
 	assert.True(t, runners[1].stopped)
 }
 
+func TestAutodiscoverDebounce(t *testing.T) {
+	goroutines := resources.NewGoroutinesChecker()
+	defer goroutines.Check(t)
+
+	// Register mock autodiscover provider
+	busChan := make(chan bus.Bus, 1)
+	Registry = NewRegistry()
+	Registry.AddProvider("mock", func(b bus.Bus, uuid uuid.UUID, c *common.Config, k keystore.Keystore) (Provider, error) {
+		// intercept bus to mock events
+		busChan <- b
+
+		return &mockProvider{}, nil
+	})
+
+	// Create a mock adapter
+	runnerConfig, _ := common.NewConfigFrom(map[string]string{
+		"runner": "1",
+	})
+	adapter := mockAdapter{
+		configs: []*common.Config{runnerConfig},
+	}
+
+	// and settings:
+	providerConfig, _ := common.NewConfigFrom(map[string]string{
+		"type": "mock",
+	})
+	config := Config{
+		Providers: []*common.Config{providerConfig},
+		Debounce:  200 * time.Millisecond,
+	}
+	k, _ := keystore.NewFileKeystore("test")
+	// Create autodiscover manager
+	autodiscover, err := NewAutodiscover("test", nil, &adapter, &adapter, &config, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start it
+	autodiscover.Start()
+	defer autodiscover.Stop()
+	eventBus := <-busChan
+
+	// Test start event
+	eventBus.Publish(bus.Event{
+		"id":       "foo",
+		"provider": "mock",
+		"start":    true,
+		"meta": common.MapStr{
+			"foo": "bar",
+		},
+	})
+	wait(t, func() bool { return len(adapter.Runners()) == 1 })
+
+	// Test flapping stop/start, collapsed in the window
+	for i := 0; i < 3; i++ {
+		eventBus.Publish(bus.Event{
+			"id":       "foo",
+			"provider": "mock",
+			"stop":     true,
+			"meta": common.MapStr{
+				"foo": "bar",
+			},
+		})
+		eventBus.Publish(bus.Event{
+			"id":       "foo",
+			"provider": "mock",
+			"start":    true,
+			"meta": common.MapStr{
+				"foo": "bar",
+			},
+		})
+	}
+	time.Sleep(2 * config.Debounce)
+
+	runners := adapter.Runners()
+	assert.Equal(t, len(runners), 1)
+	assert.True(t, runners[0].started)
+	assert.False(t, runners[0].stopped)
+
+	// Test stop event, applied once the window expires
+	eventBus.Publish(bus.Event{
+		"id":       "foo",
+		"provider": "mock",
+		"stop":     true,
+		"meta": common.MapStr{
+			"foo": "bar",
+		},
+	})
+	wait(t, func() bool { return adapter.Runners()[0].stopped })
+
+	runners = adapter.Runners()
+	assert.Equal(t, len(runners), 1)
+	assert.Equal(t, len(autodiscover.configs["mock:foo"]), 0)
+}
+
+// idAdapter creates a different config for each event id
+type idAdapter struct {
+	mockAdapter
+}
+
+func (m *idAdapter) CreateConfig(event bus.Event) ([]*common.Config, error) {
+	config, err := common.NewConfigFrom(map[string]interface{}{
+		"runner": event["id"],
+	})
+	return []*common.Config{config}, err
+}
+
+func TestAutodiscoverRateLimit(t *testing.T) {
+	goroutines := resources.NewGoroutinesChecker()
+	defer goroutines.Check(t)
+
+	// Register mock autodiscover provider
+	busChan := make(chan bus.Bus, 1)
+	Registry = NewRegistry()
+	Registry.AddProvider("mock", func(b bus.Bus, uuid uuid.UUID, c *common.Config, k keystore.Keystore) (Provider, error) {
+		// intercept bus to mock events
+		busChan <- b
+
+		return &mockProvider{}, nil
+	})
+
+	adapter := idAdapter{}
+
+	// and settings:
+	providerConfig, _ := common.NewConfigFrom(map[string]string{
+		"type": "mock",
+	})
+	config := Config{
+		Providers: []*common.Config{providerConfig},
+		RateLimit: RateLimitConfig{Limit: 20, Burst: 2},
+	}
+	k, _ := keystore.NewFileKeystore("test")
+	// Create autodiscover manager
+	autodiscover, err := NewAutodiscover("test", nil, &adapter, &adapter, &config, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Start it
+	autodiscover.Start()
+	defer autodiscover.Stop()
+	eventBus := <-busChan
+
+	const count = 10
+	// Minimum time to apply all the starts or stops, after the burst
+	minDuration := time.Duration(count-config.RateLimit.Burst) * time.Second / 20
+
+	publish := func(action string) {
+		for i := 0; i < count; i++ {
+			eventBus.Publish(bus.Event{
+				"id":       fmt.Sprintf("foo%d", i),
+				"provider": "mock",
+				action:     true,
+				"meta": common.MapStr{
+					"foo": "bar",
+				},
+			})
+		}
+	}
+
+	// Test start events, throttled by the limiter
+	start := time.Now()
+	publish("start")
+	wait(t, func() bool { return len(adapter.Runners()) == count })
+	assert.True(t, time.Since(start) >= minDuration)
+	assert.Equal(t, int64(0), configsPending.Get())
+
+	for _, runner := range adapter.Runners() {
+		assert.True(t, runner.started)
+		assert.False(t, runner.stopped)
+	}
+
+	// Test stop events, throttled by the limiter
+	start = time.Now()
+	publish("stop")
+	wait(t, func() bool {
+		for _, runner := range adapter.Runners() {
+			if !runner.stopped {
+				return false
+			}
+		}
+		return true
+	})
+	assert.True(t, time.Since(start) >= minDuration)
+	assert.Equal(t, int64(0), configsPending.Get())
+}
+
+func TestAutodiscoverPersist(t *testing.T) {
+	goroutines := resources.NewGoroutinesChecker()
+	defer goroutines.Check(t)
+
+	dir, err := ioutil.TempDir("", "autodiscover")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	statePath := filepath.Join(dir, "state.json")
+
+	// Register mock autodiscover provider, events are published with the ID
+	// the provider is built with, that changes on every run
+	type builtProvider struct {
+		bus bus.Bus
+		id  uuid.UUID
+	}
+	providerChan := make(chan builtProvider, 1)
+	Registry = NewRegistry()
+	Registry.AddProvider("mock", func(b bus.Bus, uuid uuid.UUID, c *common.Config, k keystore.Keystore) (Provider, error) {
+		providerChan <- builtProvider{bus: b, id: uuid}
+
+		return &mockProvider{}, nil
+	})
+
+	providerConfig, _ := common.NewConfigFrom(map[string]string{
+		"type": "mock",
+	})
+	config := Config{
+		Providers: []*common.Config{providerConfig},
+		Persist: PersistConfig{
+			Enabled:      true,
+			Path:         statePath,
+			StaleTimeout: 500 * time.Millisecond,
+		},
+	}
+	k, _ := keystore.NewFileKeystore("test")
+
+	start := func() (*Autodiscover, *mockAdapter) {
+		runnerConfig, _ := common.NewConfigFrom(map[string]string{
+			"runner": "1",
+		})
+		adapter := &mockAdapter{
+			configs: []*common.Config{runnerConfig},
+		}
+		autodiscover, err := NewAutodiscover("test", nil, adapter, adapter, &config, k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		autodiscover.Start()
+		return autodiscover, adapter
+	}
+	startEvent := func(provider uuid.UUID) bus.Event {
+		return bus.Event{
+			"id":       "foo",
+			"provider": provider,
+			"start":    true,
+			"meta": common.MapStr{
+				"foo": "bar",
+			},
+		}
+	}
+
+	// Run a config and save it
+	autodiscover, adapter := start()
+	provider := <-providerChan
+	provider.bus.Publish(startEvent(provider.id))
+	wait(t, func() bool { return len(adapter.Runners()) == 1 })
+	autodiscover.Stop()
+
+	key, err := providerKey(ProviderConfig{Type: "mock"}, providerConfig)
+	assert.NoError(t, err)
+
+	saved, err := (&stateStore{path: statePath}).load()
+	assert.NoError(t, err)
+	if assert.Len(t, saved, 1) {
+		assert.Equal(t, key+":foo", saved[0].ID)
+		assert.Equal(t, common.MapStr{"runner": "1"}, saved[0].Config)
+		assert.Equal(t, common.MapStr{"foo": "bar"}, saved[0].Meta)
+	}
+
+	// The config is restored before any event, and kept when emitted again
+	// by the provider built for the new run
+	autodiscover, adapter = start()
+	restartedProvider := <-providerChan
+	assert.NotEqual(t, provider.id, restartedProvider.id)
+	runners := adapter.Runners()
+	if assert.Len(t, runners, 1) {
+		assert.True(t, runners[0].started)
+		assert.Equal(t, "bar", runners[0].meta.Get()["foo"])
+	}
+
+	restartedProvider.bus.Publish(startEvent(restartedProvider.id))
+	time.Sleep(2 * config.Persist.StaleTimeout)
+	runners = adapter.Runners()
+	assert.Len(t, runners, 1)
+	assert.False(t, runners[0].stopped)
+	autodiscover.Stop()
+
+	// The restored config is stopped if not emitted again
+	autodiscover, adapter = start()
+	<-providerChan
+	defer autodiscover.Stop()
+	wait(t, func() bool {
+		runners := adapter.Runners()
+		return len(runners) == 1 && runners[0].stopped
+	})
+
+	wait(t, func() bool {
+		saved, err := (&stateStore{path: statePath}).load()
+		return err == nil && len(saved) == 0
+	})
+}
+
+func TestProviderKey(t *testing.T) {
+	mock := common.MustNewConfigFrom(map[string]interface{}{"type": "mock"})
+	other := common.MustNewConfigFrom(map[string]interface{}{"type": "mock", "setting": "other"})
+	withID := common.MustNewConfigFrom(map[string]interface{}{"type": "mock", "id": "custom"})
+
+	key, err := providerKey(ProviderConfig{Type: "mock"}, mock)
+	assert.NoError(t, err)
+	again, err := providerKey(ProviderConfig{Type: "mock"}, common.MustNewConfigFrom(map[string]interface{}{"type": "mock"}))
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	otherKey, err := providerKey(ProviderConfig{Type: "mock"}, other)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key, otherKey)
+
+	idKey, err := providerKey(ProviderConfig{Type: "mock", ID: "custom"}, withID)
+	assert.NoError(t, err)
+	assert.Equal(t, "custom", idKey)
+}
+
 func TestAutodiscoverHash(t *testing.T) {
 	goroutines := resources.NewGoroutinesChecker()
 	defer goroutines.Check(t)
@@ -385,6 +709,97 @@ func TestAutodiscoverWithConfigCheckFailures(t *testing.T) {
 	assert.Equal(t, 1, len(autodiscover.configs["mock:foo"]))
 }
 
+func TestAutodiscoverMetrics(t *testing.T) {
+	goroutines := resources.NewGoroutinesChecker()
+	defer goroutines.Check(t)
+
+	// Register mock autodiscover provider
+	busChan := make(chan bus.Bus, 1)
+	uuidChan := make(chan uuid.UUID, 1)
+	Registry = NewRegistry()
+	Registry.AddProvider("mock", func(b bus.Bus, uuid uuid.UUID, c *common.Config, k keystore.Keystore) (Provider, error) {
+		// intercept bus and ID to mock events
+		busChan <- b
+		uuidChan <- uuid
+
+		return &mockProvider{}, nil
+	})
+
+	// Create a mock adapter, the first config fails the check
+	runnerConfig1, _ := common.NewConfigFrom(map[string]string{
+		"broken": "true",
+	})
+	runnerConfig2, _ := common.NewConfigFrom(map[string]string{
+		"runner": "1",
+	})
+	adapter := mockAdapter{
+		configs: []*common.Config{runnerConfig1, runnerConfig2},
+	}
+
+	// and settings:
+	providerConfig, _ := common.NewConfigFrom(map[string]string{
+		"type": "mock",
+	})
+	config := Config{
+		Providers: []*common.Config{providerConfig},
+	}
+	k, _ := keystore.NewFileKeystore("test")
+	// Create autodiscover manager
+	autodiscover, err := NewAutodiscover("test", nil, &adapter, &adapter, &config, k)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Metrics are global, only check the changes
+	provider := getProviderMetrics("mock")
+	events, started, stopped, failed := eventsReceived.Get(), configsStarted.Get(), configsStopped.Get(), configsFailed.Get()
+	running, providerEvents, providerRunning := configsRunning.Get(), provider.events.Get(), provider.running.Get()
+
+	// Start it
+	autodiscover.Start()
+	eventBus := <-busChan
+	providerID := <-uuidChan
+
+	eventBus.Publish(bus.Event{
+		"id":       "foo",
+		"provider": providerID,
+		"start":    true,
+		"meta":     common.MapStr{},
+	})
+	wait(t, func() bool { return configsRunning.Get() == running+1 })
+
+	assert.Equal(t, events+1, eventsReceived.Get())
+	assert.Equal(t, started+1, configsStarted.Get())
+	assert.Equal(t, failed+1, configsFailed.Get())
+	assert.Equal(t, providerEvents+1, provider.events.Get())
+	assert.Equal(t, providerRunning+1, provider.running.Get())
+
+	eventBus.Publish(bus.Event{
+		"id":       "foo",
+		"provider": providerID,
+		"stop":     true,
+		"meta":     common.MapStr{},
+	})
+	wait(t, func() bool { return configsRunning.Get() == running })
+
+	assert.Equal(t, events+2, eventsReceived.Get())
+	assert.Equal(t, stopped+1, configsStopped.Get())
+	assert.Equal(t, providerEvents+2, provider.events.Get())
+	assert.Equal(t, providerRunning, provider.running.Get())
+
+	// Runners left running are stopped with the manager
+	eventBus.Publish(bus.Event{
+		"id":       "bar",
+		"provider": providerID,
+		"start":    true,
+		"meta":     common.MapStr{},
+	})
+	wait(t, func() bool { return configsRunning.Get() == running+1 })
+	autodiscover.Stop()
+	wait(t, func() bool { return configsRunning.Get() == running })
+	assert.Equal(t, providerRunning, provider.running.Get())
+}
+
 func wait(t *testing.T, test func() bool) {
 	sleep := 20 * time.Millisecond
 	ready := test()
@@ -18,8 +18,8 @@
 package autodiscover
 
 import (
-	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/elastic/go-ucfg"
@@ -35,13 +35,34 @@ type Builder interface {
 	CreateConfig(event bus.Event, options ...ucfg.Option) []*common.Config
 }
 
+// Strategies to combine the configs of a builder with the ones created by
+// builders with a lower precedence
+const (
+	// BuilderStrategyAppend adds the configs to the ones already created
+	BuilderStrategyAppend = "append"
+	// BuilderStrategyReplace discards the configs already created when the
+	// builder creates any config
+	BuilderStrategyReplace = "replace"
+	// BuilderStrategyMerge merges each config over the one already created
+	// at the same position, configs without counterpart are appended
+	BuilderStrategyMerge = "merge"
+)
+
 // builders is a struct of Builder list objects and a `keystoreProvider`, which
 // has access to a keystores registry
 type Builders struct {
-	builders         []Builder
+	builders         []builderEntry
 	keystoreProvider keystore.Provider
 }
 
+// builderEntry is a Builder along with the settings defining how its configs
+// are combined with the ones of other builders
+type builderEntry struct {
+	Builder
+	precedence int
+	strategy   string
+}
+
 // BuilderConstructor is a func used to generate a Builder object
 type BuilderConstructor func(*common.Config) (Builder, error)
 
@@ -107,7 +128,17 @@ func (b Builders) GetConfig(event bus.Event) []*common.Config {
 		}
 	}
 	for _, builder := range b.builders {
-		if config := builder.CreateConfig(event, opts...); config != nil {
+		config := builder.CreateConfig(event, opts...)
+		if len(config) == 0 {
+			continue
+		}
+
+		switch builder.strategy {
+		case BuilderStrategyReplace:
+			configs = config
+		case BuilderStrategyMerge:
+			configs = mergeBuilderConfigs(configs, config)
+		default:
 			configs = append(configs, config...)
 		}
 	}
@@ -115,8 +146,39 @@ func (b Builders) GetConfig(event bus.Event) []*common.Config {
 	return configs
 }
 
+// mergeBuilderConfigs merges each one of the overrides over the config at the
+// same position in base. Paths are replaced and processors appended, as it is
+// done with fileset overrides.
+func mergeBuilderConfigs(base, overrides []*common.Config) []*common.Config {
+	merged := make([]*common.Config, 0, len(base))
+	for i, override := range overrides {
+		if i >= len(base) {
+			merged = append(merged, override)
+			continue
+		}
+
+		config, err := common.MergeConfigsWithOptions(
+			[]*common.Config{base[i], override},
+			ucfg.FieldReplaceValues("**.paths"),
+			ucfg.FieldAppendValues("**.processors"),
+		)
+		if err != nil {
+			// Keep the config with the highest precedence
+			Registry.logger.Errorf("Error merging autodiscover builder configs: %v", err)
+			config = override
+		}
+		merged = append(merged, config)
+	}
+	if len(base) > len(overrides) {
+		merged = append(merged, base[len(overrides):]...)
+	}
+	return merged
+}
+
 // NewBuilders instances the given list of builders. hintsCfg holds `hints` settings
-// for simplified mode (single 'hints' builder), `keystoreProvider` has access to keystore registry
+// for the 'hints' builder, `keystoreProvider` has access to keystore registry.
+// Builders are sorted by precedence, configs of builders with a higher precedence
+// are combined with the previous ones following their strategy.
 func NewBuilders(
 	bConfigs []*common.Config,
 	hintsCfg *common.Config,
@@ -124,22 +186,30 @@ func NewBuilders(
 ) (Builders, error) {
 	var builders Builders
 	if hintsCfg.Enabled() {
-		if len(bConfigs) > 0 {
-			return Builders{}, errors.New("hints.enabled is incompatible with manually defining builders")
-		}
-
 		// pass rest of hints settings to the builder
 		hintsCfg.SetString("type", -1, "hints")
 		bConfigs = append(bConfigs, hintsCfg)
 	}
 
 	for _, bcfg := range bConfigs {
+		var config BuilderConfig
+		if err := bcfg.Unpack(&config); err != nil {
+			return Builders{}, err
+		}
+
 		builder, err := Registry.BuildBuilder(bcfg)
 		if err != nil {
 			return Builders{}, err
 		}
-		builders.builders = append(builders.builders, builder)
+		builders.builders = append(builders.builders, builderEntry{
+			Builder:    builder,
+			precedence: config.Precedence,
+			strategy:   config.Strategy,
+		})
 	}
+	sort.SliceStable(builders.builders, func(i, j int) bool {
+		return builders.builders[i].precedence < builders.builders[j].precedence
+	})
 	builders.keystoreProvider = keystoreProvider
 	return builders, nil
 }
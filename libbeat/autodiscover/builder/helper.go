@@ -24,6 +24,8 @@ import (
 	"strconv"
 	"strings"
 
+	"gopkg.in/yaml.v2"
+
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/logp"
@@ -75,7 +77,8 @@ func GetHintMapStr(hints common.MapStr, key, config string) common.MapStr {
 	return nil
 }
 
-// GetHintAsList takes a hint and returns the value as lists.
+// GetHintAsList takes a hint and returns the value as lists. The value can be a
+// comma-separated string, or a list in YAML or JSON syntax, like `["a", "b"]`.
 func GetHintAsList(hints common.MapStr, key, config string) []string {
 	if str := GetHintString(hints, key, config); str != "" {
 		return getStringAsList(str)
@@ -84,7 +87,9 @@ func GetHintAsList(hints common.MapStr, key, config string) []string {
 	return nil
 }
 
-// GetProcessors gets processor definitions from the hints and returns a list of configs as a MapStr
+// GetProcessors gets processor definitions from the hints and returns a list of configs as a MapStr.
+// Processor settings can be given as JSON strings at any level, they are decoded and merged with
+// the settings given as separate hints.
 func GetProcessors(hints common.MapStr, key string) []common.MapStr {
 	processors := GetConfigs(hints, key, "processors")
 	for _, proc := range processors {
@@ -98,11 +103,39 @@ func GetProcessors(hints common.MapStr, key string) []common.MapStr {
 				proc[key] = cfg
 			}
 		}
+		decodeJSONSettings(proc)
 	}
 	return processors
 }
 
-// GetConfigs takes in a key and returns a list of configs as a slice of MapStr
+// decodeJSONSettings replaces the nested string settings containing JSON objects
+// or lists by their decoded values.
+func decodeJSONSettings(cfg common.MapStr) {
+	for key, value := range cfg {
+		switch v := value.(type) {
+		case common.MapStr:
+			decodeJSONSettings(v)
+		case map[string]interface{}:
+			decodeJSONSettings(common.MapStr(v))
+		case string:
+			trimmed := strings.TrimSpace(v)
+			if !strings.HasPrefix(trimmed, "{") && !strings.HasPrefix(trimmed, "[") {
+				continue
+			}
+			var decoded interface{}
+			if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+				logp.Debug("autodiscover.builder", "unable to unmarshal json setting %s due to error: %v", key, err)
+				continue
+			}
+			cfg[key] = decoded
+		}
+	}
+}
+
+// GetConfigs takes in a key and returns a list of configs as a slice of MapStr.
+// Configs under numeric keys come first, ordered by their numeric value, configs
+// for the same index (like `1` and `01`) are deep merged. They are followed by the
+// configs under other keys, ordered by key.
 func GetConfigs(hints common.MapStr, key, name string) []common.MapStr {
 	raw := GetHintMapStr(hints, key, name)
 	if raw == nil {
@@ -110,24 +143,39 @@ func GetConfigs(hints common.MapStr, key, name string) []common.MapStr {
 	}
 
 	var words, nums []string
-
 	for key := range raw {
 		if _, err := strconv.Atoi(key); err != nil {
 			words = append(words, key)
-			continue
 		} else {
 			nums = append(nums, key)
 		}
 	}
 
-	sort.Strings(nums)
+	sort.Slice(nums, func(i, j int) bool {
+		a, _ := strconv.Atoi(nums[i])
+		b, _ := strconv.Atoi(nums[j])
+		if a != b {
+			return a < b
+		}
+		return nums[i] < nums[j]
+	})
+	sort.Strings(words)
 
 	var configs []common.MapStr
+	lastIndex := -1
 	for _, key := range nums {
-		rawCfg, _ := raw[key]
-		if config, ok := rawCfg.(common.MapStr); ok {
-			configs = append(configs, config)
+		config, ok := raw[key].(common.MapStr)
+		if !ok {
+			continue
+		}
+
+		index, _ := strconv.Atoi(key)
+		if index == lastIndex && len(configs) > 0 {
+			configs[len(configs)-1].DeepUpdate(config.Clone())
+			continue
 		}
+		configs = append(configs, config.Clone())
+		lastIndex = index
 	}
 
 	for _, word := range words {
@@ -143,6 +191,9 @@ func getStringAsList(input string) []string {
 	if input == "" {
 		return []string{}
 	}
+	if list, ok := parseList(input); ok {
+		return list
+	}
 	list := strings.Split(input, ",")
 
 	for i := 0; i < len(list); i++ {
@@ -152,6 +203,39 @@ func getStringAsList(input string) []string {
 	return list
 }
 
+// parseList decodes values in YAML or JSON list syntax. Values with a single
+// unquoted element, like `[::1]` or `[0-9]`, are ambiguous and are not considered
+// lists, so IPv6 addresses and regular expressions keep their literal meaning.
+func parseList(input string) ([]string, bool) {
+	trimmed := strings.TrimSpace(input)
+	if !strings.HasPrefix(trimmed, "[") || !strings.HasSuffix(trimmed, "]") {
+		return nil, false
+	}
+
+	var values []interface{}
+	if err := yaml.Unmarshal([]byte(trimmed), &values); err != nil {
+		logp.Debug("autodiscover.builder", "unable to unmarshal list %s due to error: %v", input, err)
+		return nil, false
+	}
+
+	inner := strings.TrimSpace(trimmed[1 : len(trimmed)-1])
+	if len(values) == 1 && !strings.HasPrefix(inner, `"`) && !strings.HasPrefix(inner, "'") {
+		return nil, false
+	}
+
+	list := make([]string, 0, len(values))
+	for _, value := range values {
+		switch value.(type) {
+		case []interface{}, map[interface{}]interface{}:
+			return nil, false
+		case nil:
+			continue
+		}
+		list = append(list, strings.TrimSpace(fmt.Sprint(value)))
+	}
+	return list, true
+}
+
 // GetHintAsConfigs can read a hint in the form of a stringified JSON and return a common.MapStr
 func GetHintAsConfigs(hints common.MapStr, key string) []common.MapStr {
 	if str := GetHintString(hints, key, "raw"); str != "" {
@@ -49,6 +49,72 @@ func TestGetProcessors(t *testing.T) {
 	}, procs)
 }
 
+func TestGetProcessorsOrdering(t *testing.T) {
+	hints := common.MapStr{
+		"co": common.MapStr{
+			"elastic": common.MapStr{
+				"metrics": common.MapStr{
+					"processors": common.MapStr{
+						"10": common.MapStr{"drop_fields": common.MapStr{"fields": `["a"]`}},
+						"2": common.MapStr{
+							"rename": common.MapStr{
+								"fields":         `[{"from": "b", "to": "a"}]`,
+								"ignore_missing": "true",
+							},
+						},
+						"02":         common.MapStr{"rename": common.MapStr{"fail_on_error": "false"}},
+						"1":          common.MapStr{"add_tags": common.MapStr{"tags": "web"}},
+						"drop_event": `{"when": {"equals": {"a": "c"}}}`,
+						"add_fields": `{"fields": {"foo": "bar"}}`,
+					},
+				},
+			},
+		},
+	}
+
+	expected := []common.MapStr{
+		{"add_tags": common.MapStr{"tags": "web"}},
+		{"rename": common.MapStr{
+			"fields":         []interface{}{map[string]interface{}{"from": "b", "to": "a"}},
+			"ignore_missing": "true",
+			"fail_on_error":  "false",
+		}},
+		{"drop_fields": common.MapStr{"fields": []interface{}{"a"}}},
+		{"add_fields": common.MapStr{"fields": map[string]interface{}{"foo": "bar"}}},
+		{"drop_event": common.MapStr{"when": map[string]interface{}{"equals": map[string]interface{}{"a": "c"}}}},
+	}
+
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, expected, GetProcessors(hints, "co.elastic.metrics"))
+	}
+}
+
+func TestGetHintAsList(t *testing.T) {
+	tests := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"comma separated":      {"a, b,c", []string{"a", "b", "c"}},
+		"single value":         {"a", []string{"a"}},
+		"json list":            {`["a","b"]`, []string{"a", "b"}},
+		"yaml list":            {"[a, 'b', 8080]", []string{"a", "b", "8080"}},
+		"single element list":  {`["a"]`, []string{"a"}},
+		"empty list":           {"[]", []string{}},
+		"ipv6 address":         {"[::1]", []string{"[::1]"}},
+		"ipv6 addresses":       {"[::1]:8080, [::2]:8080", []string{"[::1]:8080", "[::2]:8080"}},
+		"regular expression":   {"[0-9]", []string{"[0-9]"}},
+		"invalid list":         {`["a", "b"`, []string{`["a"`, `"b"`}},
+		"nested list fallback": {"[[a], b]", []string{"[[a]", "b]"}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			hints := common.MapStr{"metrics": common.MapStr{"hosts": test.value}}
+			assert.Equal(t, test.expected, GetHintAsList(hints, "metrics", "hosts"))
+		})
+	}
+}
+
 func TestGenerateHints(t *testing.T) {
 	tests := []struct {
 		annotations map[string]string
@@ -30,6 +30,28 @@ import (
 
 type fakeBuilder struct{}
 
+type staticBuilder struct {
+	configs []map[string]interface{}
+}
+
+func (s *staticBuilder) CreateConfig(event bus.Event, options ...ucfg.Option) []*common.Config {
+	var configs []*common.Config
+	for _, c := range s.configs {
+		configs = append(configs, common.MustNewConfigFrom(c))
+	}
+	return configs
+}
+
+func newStaticBuilder(c *common.Config) (Builder, error) {
+	var config struct {
+		Configs []map[string]interface{} `config:"configs"`
+	}
+	if err := c.Unpack(&config); err != nil {
+		return nil, err
+	}
+	return &staticBuilder{configs: config.Configs}, nil
+}
+
 func (f *fakeBuilder) CreateConfig(event bus.Event, options ...ucfg.Option) []*common.Config {
 	return []*common.Config{common.NewConfig()}
 }
@@ -67,10 +89,123 @@ func TestBuilderRegistry(t *testing.T) {
 	assert.Equal(t, len(res), 1)
 
 	builders := Builders{}
-	builders.builders = append(builders.builders, builder)
+	builders.builders = append(builders.builders, builderEntry{Builder: builder})
 
 	// Try using builders object for the same as above and expect
 	// the same result
 	res = builders.GetConfig(nil)
 	assert.Equal(t, len(res), 1)
 }
+
+func TestBuildersPrecedence(t *testing.T) {
+	Registry.AddBuilder("static", newStaticBuilder)
+
+	defaults := map[string]interface{}{
+		"type":       "static",
+		"precedence": 1,
+		"configs": []map[string]interface{}{
+			{
+				"type":       "log",
+				"paths":      []string{"/var/log/default.log", "/var/log/other.log"},
+				"processors": []map[string]interface{}{{"add_tags": map[string]interface{}{"tags": "default"}}},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		strategy string
+		configs  []map[string]interface{}
+		expected []common.MapStr
+	}{
+		"append by default": {
+			configs: []map[string]interface{}{
+				{"type": "log", "paths": []string{"/var/log/hints.log"}},
+			},
+			expected: []common.MapStr{
+				{
+					"type":       "log",
+					"paths":      []interface{}{"/var/log/default.log", "/var/log/other.log"},
+					"processors": []interface{}{map[string]interface{}{"add_tags": map[string]interface{}{"tags": "default"}}},
+				},
+				{"type": "log", "paths": []interface{}{"/var/log/hints.log"}},
+			},
+		},
+		"replace": {
+			strategy: "replace",
+			configs: []map[string]interface{}{
+				{"type": "log", "paths": []string{"/var/log/hints.log"}},
+			},
+			expected: []common.MapStr{
+				{"type": "log", "paths": []interface{}{"/var/log/hints.log"}},
+			},
+		},
+		"replace without configs keeps previous ones": {
+			strategy: "replace",
+			expected: []common.MapStr{
+				{
+					"type":       "log",
+					"paths":      []interface{}{"/var/log/default.log", "/var/log/other.log"},
+					"processors": []interface{}{map[string]interface{}{"add_tags": map[string]interface{}{"tags": "default"}}},
+				},
+			},
+		},
+		"merge": {
+			strategy: "merge",
+			configs: []map[string]interface{}{
+				{
+					"paths":             []string{"/var/log/hints.log"},
+					"multiline.pattern": "^\\[",
+					"processors":        []map[string]interface{}{{"add_tags": map[string]interface{}{"tags": "hints"}}},
+				},
+				{"type": "stdin"},
+			},
+			expected: []common.MapStr{
+				{
+					"type":      "log",
+					"paths":     []interface{}{"/var/log/hints.log"},
+					"multiline": map[string]interface{}{"pattern": "^\\["},
+					"processors": []interface{}{
+						map[string]interface{}{"add_tags": map[string]interface{}{"tags": "default"}},
+						map[string]interface{}{"add_tags": map[string]interface{}{"tags": "hints"}},
+					},
+				},
+				{"type": "stdin"},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// Defined after the overriding builder, precedence decides the order
+			override := common.MustNewConfigFrom(map[string]interface{}{
+				"type":       "static",
+				"precedence": 2,
+				"strategy":   test.strategy,
+				"configs":    test.configs,
+			})
+			builders, err := NewBuilders([]*common.Config{override, common.MustNewConfigFrom(defaults)}, nil, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			var configs []common.MapStr
+			for _, c := range builders.GetConfig(nil) {
+				var config common.MapStr
+				assert.NoError(t, c.Unpack(&config))
+				configs = append(configs, config)
+			}
+			assert.Equal(t, test.expected, configs)
+		})
+	}
+}
+
+func TestBuildersInvalidStrategy(t *testing.T) {
+	Registry.AddBuilder("static", newStaticBuilder)
+
+	config := common.MustNewConfigFrom(map[string]interface{}{
+		"type":     "static",
+		"strategy": "override",
+	})
+	_, err := NewBuilders([]*common.Config{config}, nil, nil)
+	assert.Error(t, err)
+}
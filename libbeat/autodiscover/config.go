@@ -18,22 +18,74 @@
 package autodiscover
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/elastic/beats/v7/libbeat/common"
 )
 
 // Config settings for Autodiscover
 type Config struct {
 	Providers []*common.Config `config:"providers"`
+	// Debounce is the window during which events are collapsed before
+	// reloading the runners, disabled if zero.
+	Debounce time.Duration `config:"debounce" validate:"min=0"`
+	// Persist saves the running configs to disk, so they are restored on
+	// restart without waiting for the providers to emit them again.
+	Persist PersistConfig `config:"persist"`
+	// RateLimit limits the runners started and stopped per second, so mass
+	// events don't start or stop all of them at once.
+	RateLimit RateLimitConfig `config:"rate_limit"`
+}
+
+// PersistConfig settings
+type PersistConfig struct {
+	Enabled bool `config:"enabled"`
+	// Path of the state file, relative to the data path. Defaults to
+	// autodiscover/<name>.json.
+	Path string `config:"path"`
+	// StaleTimeout is how long restored configs keep running if no provider
+	// emits them again, defaults to 5 minutes.
+	StaleTimeout time.Duration `config:"stale_timeout" validate:"min=0"`
+}
+
+// RateLimitConfig settings
+type RateLimitConfig struct {
+	// Limit is the number of runners started or stopped per second,
+	// disabled if zero.
+	Limit float64 `config:"limit" validate:"min=0"`
+	// Burst is the number of runners that can be started or stopped at
+	// once, defaults to the limit rounded up.
+	Burst int `config:"burst" validate:"min=0"`
 }
 
 // ProviderConfig settings
 type ProviderConfig struct {
 	Type string `config:"type"`
+	// ID identifies the provider in the persisted state, defaults to its
+	// type and a hash of its settings.
+	ID string `config:"id"`
 }
 
 // BuilderConfig settings
 type BuilderConfig struct {
 	Type string `config:"type"`
+	// Precedence orders the builders, configs of builders with a higher
+	// precedence are combined with the previous ones following Strategy.
+	Precedence int `config:"precedence"`
+	// Strategy is one of append (default), replace or merge.
+	Strategy string `config:"strategy"`
+}
+
+// Validate checks the builder strategy
+func (c *BuilderConfig) Validate() error {
+	switch c.Strategy {
+	case "", BuilderStrategyAppend, BuilderStrategyReplace, BuilderStrategyMerge:
+		return nil
+	default:
+		return fmt.Errorf("unknown builder strategy '%s', expected one of %s, %s or %s",
+			c.Strategy, BuilderStrategyAppend, BuilderStrategyReplace, BuilderStrategyMerge)
+	}
 }
 
 // AppenderConfig settings
@@ -0,0 +1,61 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package autodiscover
+
+import (
+	"sync"
+
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+var (
+	eventsReceived = monitoring.NewInt(nil, "libbeat.autodiscover.events.received")
+	configsStarted = monitoring.NewInt(nil, "libbeat.autodiscover.configs.started")
+	configsStopped = monitoring.NewInt(nil, "libbeat.autodiscover.configs.stopped")
+	configsFailed  = monitoring.NewInt(nil, "libbeat.autodiscover.configs.failed")
+	configsRunning = monitoring.NewInt(nil, "libbeat.autodiscover.configs.running")
+	configsPending = monitoring.NewInt(nil, "libbeat.autodiscover.configs.pending")
+
+	providersMutex   sync.Mutex
+	providersMetrics = map[string]*providerMetrics{}
+)
+
+// providerMetrics are the metrics of all the providers of a type.
+type providerMetrics struct {
+	events  *monitoring.Int // Events received from the providers.
+	running *monitoring.Int // Configs from the providers with a running runner.
+}
+
+// getProviderMetrics returns the metrics of the providers of the given type,
+// registering them the first time a provider of the type is used.
+func getProviderMetrics(providerType string) *providerMetrics {
+	providersMutex.Lock()
+	defer providersMutex.Unlock()
+
+	if m := providersMetrics[providerType]; m != nil {
+		return m
+	}
+
+	prefix := "libbeat.autodiscover.providers." + providerType
+	m := &providerMetrics{
+		events:  monitoring.NewInt(nil, prefix+".events"),
+		running: monitoring.NewInt(nil, prefix+".running"),
+	}
+	providersMetrics[providerType] = m
+	return m
+}
@@ -71,6 +71,16 @@ func (r *registry) GetProvider(name string) ProviderBuilder {
 
 // BuildProvider reads provider configuration and instantiate one
 func (r *registry) BuildProvider(bus bus.Bus, c *common.Config, keystore keystore.Keystore) (Provider, error) {
+	uuid, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.buildProvider(bus, uuid, c, keystore)
+}
+
+// buildProvider instantiates a provider with the given ID.
+func (r *registry) buildProvider(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (Provider, error) {
 	var config ProviderConfig
 	err := c.Unpack(&config)
 	if err != nil {
@@ -82,10 +92,5 @@ func (r *registry) BuildProvider(bus bus.Bus, c *common.Config, keystore keystor
 		return nil, fmt.Errorf("unknown autodiscover provider %s", config.Type)
 	}
 
-	uuid, err := uuid.NewV4()
-	if err != nil {
-		return nil, err
-	}
-
 	return builder(bus, uuid, c, keystore)
 }
@@ -38,6 +38,7 @@ type Config struct {
 	Templates      template.MapperSettings `config:"templates"`
 	Dedot          bool                    `config:"labels.dedot"`
 	CleanupTimeout time.Duration           `config:"cleanup_timeout" validate:"positive"`
+	WaitForHealthy bool                    `config:"wait_for_healthy"`
 }
 
 func defaultConfig() *Config {
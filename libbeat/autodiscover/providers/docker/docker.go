@@ -218,6 +218,10 @@ func (d *Provider) generateMetaDocker(event bus.Event) (*docker.Container, *dock
 		},
 	}
 
+	if container.Health != "" {
+		meta.Docker.Put("container.health", container.Health)
+	}
+
 	return container, meta
 }
 
@@ -234,6 +238,13 @@ func (d *Provider) startContainer(event bus.Event) {
 		return
 	}
 
+	// Containers with a HEALTHCHECK are started once healthy, the watcher
+	// sends a new start event on each health status change.
+	if d.config.WaitForHealthy && container.Health != "" && container.Health != docker.HealthHealthy {
+		d.logger.Debugf("Container %s is %s, waiting for it to be healthy", container.ID, container.Health)
+		return
+	}
+
 	d.emitContainer(container, meta, "start")
 }
 
@@ -19,12 +19,14 @@ package docker
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/bus"
 	"github.com/elastic/beats/v7/libbeat/common/docker"
+	"github.com/elastic/beats/v7/libbeat/logp"
 )
 
 func TestGenerateHints(t *testing.T) {
@@ -234,3 +236,43 @@ func TestGenerateMetaDockerWithDedot(t *testing.T) {
 	assert.Equal(t, expectedMeta.Container, meta.Container)
 	assert.Equal(t, expectedMeta.Metadata, meta.Metadata)
 }
+
+func TestStartContainerWaitForHealthy(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.WaitForHealthy = true
+	p := Provider{
+		config:   cfg,
+		bus:      bus.New(logp.NewLogger("bus"), "test"),
+		stoppers: make(map[string]*time.Timer),
+		logger:   logp.NewLogger("docker"),
+	}
+	listener := p.bus.Subscribe("start")
+	defer listener.Stop()
+
+	for _, health := range []string{docker.HealthStarting, docker.HealthUnhealthy, "", docker.HealthHealthy} {
+		p.startContainer(bus.Event{
+			"start": true,
+			"container": &docker.Container{
+				ID:     "abc",
+				Name:   "foobar",
+				Health: health,
+			},
+		})
+	}
+
+	for _, health := range []interface{}{nil, docker.HealthHealthy} {
+		select {
+		case event := <-listener.Events():
+			value, _ := common.MapStr(event).GetValue("docker.container.health")
+			assert.Equal(t, health, value)
+		case <-time.After(time.Second):
+			t.Fatal("timeout waiting for start event")
+		}
+	}
+
+	select {
+	case event := <-listener.Events():
+		t.Fatalf("unexpected start event: %v", event)
+	default:
+	}
+}
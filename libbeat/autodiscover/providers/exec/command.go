@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+)
+
+// maxStderrSize is the maximum size of the error output of the command
+// included in errors.
+const maxStderrSize = 1024
+
+// discoveryOutput is the output of the discovery command when it is an
+// object, it lists the services under services.
+type discoveryOutput struct {
+	Services []servicelist.Service `json:"services"`
+}
+
+// parseServices returns the services printed by the discovery command, as a
+// JSON list or as an object with a list of services.
+func parseServices(data []byte) ([]*servicelist.Service, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, fmt.Errorf("empty output")
+	}
+
+	var list []servicelist.Service
+	if data[0] == '[' {
+		if err := decodeStrict(data, &list); err != nil {
+			return nil, err
+		}
+	} else {
+		var output discoveryOutput
+		if err := decodeStrict(data, &output); err != nil {
+			return nil, err
+		}
+		list = output.Services
+	}
+	return servicelist.Validate(list)
+}
+
+func decodeStrict(data []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if dec.More() {
+		return fmt.Errorf("unexpected data after the JSON document")
+	}
+	return nil
+}
+
+// runFunc runs the discovery command and returns its output.
+type runFunc func(ctx context.Context) ([]byte, error)
+
+// commandSource returns the source of the services printed by a command.
+func commandSource(run runFunc) servicelist.Source {
+	return func(ctx context.Context) ([]*servicelist.Service, error) {
+		output, err := run(ctx)
+		if err != nil {
+			return nil, err
+		}
+		services, err := parseServices(output)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid output of discovery command")
+		}
+		return services, nil
+	}
+}
+
+// commandRunner returns a function that runs a command with the given
+// arguments. Commands that fail include their error output in the error.
+func commandRunner(command string, args []string) runFunc {
+	return func(ctx context.Context) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+		cmd := exec.CommandContext(ctx, command, args...)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+		if err := cmd.Run(); err != nil {
+			if ctx.Err() != nil {
+				err = ctx.Err()
+			}
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				if len(msg) > maxStderrSize {
+					msg = msg[:maxStderrSize] + "..."
+				}
+				return nil, errors.Wrapf(err, "discovery command failed: %s", msg)
+			}
+			return nil, errors.Wrap(err, "discovery command failed")
+		}
+		return stdout.Bytes(), nil
+	}
+}
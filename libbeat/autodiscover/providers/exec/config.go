@@ -0,0 +1,44 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+)
+
+// Config for the exec autodiscover provider
+type Config struct {
+	servicelist.Config `config:",inline"`
+
+	// Command is the discovery command, it prints the services as JSON.
+	Command string   `config:"command" validate:"required"`
+	Args    []string `config:"args"`
+
+	Period  time.Duration `config:"period" validate:"positive,nonzero"`
+	Timeout time.Duration `config:"timeout" validate:"positive,nonzero"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Config:  servicelist.DefaultConfig(),
+		Period:  1 * time.Minute,
+		Timeout: 30 * time.Second,
+	}
+}
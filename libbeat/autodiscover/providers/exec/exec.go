@@ -0,0 +1,56 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("exec", AutodiscoverBuilder)
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider for
+// services printed by a discovery command
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("exec autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up exec autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	source := commandSource(commandRunner(config.Command, config.Args))
+	p, err := servicelist.NewProvider("exec", bus, uuid, &config.Config, source, config.Period, config.Timeout, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
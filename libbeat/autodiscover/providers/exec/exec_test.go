@@ -0,0 +1,195 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package exec
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const redisOutput = `[{
+  "name": "redis",
+  "host": "10.0.0.5",
+  "port": 6379,
+  "labels": {
+    "env": "production",
+    "co.elastic.metrics/module": "redis",
+    "co.elastic.metrics/period": "30s"
+  }
+}]`
+
+const webOutput = `{
+  "services": [
+    {"id": "web-1", "name": "web", "host": "10.0.0.6"},
+    {"id": "web-2", "name": "web", "host": "10.0.0.7"}
+  ]
+}`
+
+func TestParseServices(t *testing.T) {
+	services, err := parseServices([]byte(redisOutput))
+	require.NoError(t, err)
+	assert.Equal(t, []*servicelist.Service{{
+		ID:   "redis",
+		Name: "redis",
+		Host: "10.0.0.5",
+		Port: 6379,
+		Labels: map[string]string{
+			"env":                       "production",
+			"co.elastic.metrics/module": "redis",
+			"co.elastic.metrics/period": "30s",
+		},
+	}}, services)
+
+	services, err = parseServices([]byte(webOutput))
+	require.NoError(t, err)
+	assert.Len(t, services, 2)
+	assert.Equal(t, "web-1", services[0].ID)
+	assert.Equal(t, "web-2", services[1].ID)
+
+	services, err = parseServices([]byte(" []\n"))
+	require.NoError(t, err)
+	assert.Empty(t, services)
+
+	for name, data := range map[string]string{
+		"empty":         "",
+		"no name":       `[{"host": "10.0.0.5"}]`,
+		"duplicated id": `[{"name": "web"}, {"name": "web"}]`,
+		"invalid port":  `[{"name": "web", "port": 70000}]`,
+		"unknown field": `[{"name": "web", "address": "10.0.0.5"}]`,
+		"file field":    `[{"name": "web", "file": "web.json"}]`,
+		"trailing data": `[{"name": "web"}] [{"name": "redis"}]`,
+		"invalid json":  `[{"name": "web"`,
+	} {
+		_, err := parseServices([]byte(data))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestCommandSource(t *testing.T) {
+	output := []byte(webOutput)
+	var runErr error
+	source := commandSource(func(ctx context.Context) ([]byte, error) { return output, runErr })
+
+	services, err := source(context.Background())
+	require.NoError(t, err)
+	require.Len(t, services, 2)
+	assert.Equal(t, "web-1", services[0].Key())
+
+	runErr = errors.New("inventory unavailable")
+	_, err = source(context.Background())
+	assert.Error(t, err)
+
+	runErr = nil
+	output = []byte(`[{"name": "redis"`)
+	_, err = source(context.Background())
+	assert.Error(t, err)
+}
+
+func TestCommandRunner(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("discovery scripts in this test require a unix shell")
+	}
+
+	dir, err := ioutil.TempDir("", "autodiscover-exec")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	script := filepath.Join(dir, "discover.sh")
+	require.NoError(t, ioutil.WriteFile(script, []byte(`#!/bin/sh
+case "$1" in
+  ok) echo '[{"name": "'"$2"'"}]' ;;
+  fail) echo "inventory unavailable" >&2; exit 1 ;;
+  slow) exec sleep 10 ;;
+esac
+`), 0700))
+
+	output, err := commandRunner(script, []string{"ok", "redis"})(context.Background())
+	require.NoError(t, err)
+	services, err := parseServices(output)
+	require.NoError(t, err)
+	require.Len(t, services, 1)
+	assert.Equal(t, "redis", services[0].Name)
+
+	_, err = commandRunner(script, []string{"fail"})(context.Background())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "inventory unavailable")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	_, err = commandRunner(script, []string{"slow"})(ctx)
+	assert.Error(t, err)
+
+	_, err = commandRunner(filepath.Join(dir, "missing.sh"), nil)(context.Background())
+	assert.Error(t, err)
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, c := range []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{config: map[string]interface{}{"command": "/usr/local/bin/discover"}, valid: true},
+		{config: map[string]interface{}{"command": "/usr/local/bin/discover", "args": []string{"--env", "production"}}, valid: true},
+		{config: map[string]interface{}{}},
+		{config: map[string]interface{}{"command": "/usr/local/bin/discover", "period": 0}},
+		{config: map[string]interface{}{"command": "/usr/local/bin/discover", "timeout": 0}},
+	} {
+		config := defaultConfig()
+		err := common.MustNewConfigFrom(c.config).Unpack(config)
+		if c.valid {
+			assert.NoError(t, err, "%v", c.config)
+		} else {
+			assert.Error(t, err, "%v", c.config)
+		}
+	}
+}
+
+func TestAutodiscoverBuilder(t *testing.T) {
+	p, err := AutodiscoverBuilder(bus.New(logp.L(), "test"), uuid.Nil, common.MustNewConfigFrom(map[string]interface{}{
+		"command": "/usr/local/bin/discover",
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{
+				"equals": map[string]interface{}{"service_definition.name": "web"},
+			},
+			"config": []map[string]interface{}{{"type": "log"}},
+		}},
+	}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "exec", p.String())
+
+	_, err = AutodiscoverBuilder(bus.New(logp.L(), "test"), uuid.Nil, common.MustNewConfigFrom(map[string]interface{}{
+		"command": "/usr/local/bin/discover",
+	}), nil)
+	assert.Error(t, err, "templates or hints are required")
+}
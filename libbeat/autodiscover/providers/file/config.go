@@ -0,0 +1,41 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+)
+
+// Config for the file autodiscover provider
+type Config struct {
+	servicelist.Config `config:",inline"`
+
+	// Paths are the glob patterns of the service definition files.
+	Paths []string `config:"paths" validate:"required"`
+
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Config: servicelist.DefaultConfig(),
+		Period: 10 * time.Second,
+	}
+}
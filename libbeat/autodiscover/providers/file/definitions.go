@@ -0,0 +1,150 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// definitionFile is the content of a service definition file, it defines a
+// single service, or a list of them under services.
+type definitionFile struct {
+	servicelist.Service `yaml:",inline"`
+	Services            []servicelist.Service `yaml:"services"`
+}
+
+// parseServices returns the services defined in a file.
+func parseServices(path string, data []byte) ([]*servicelist.Service, error) {
+	var file definitionFile
+	if err := yaml.UnmarshalStrict(data, &file); err != nil {
+		return nil, err
+	}
+
+	list := file.Services
+	if !reflect.DeepEqual(file.Service, servicelist.Service{}) {
+		if len(list) > 0 {
+			return nil, fmt.Errorf("a file cannot define a service and a list of services")
+		}
+		list = []servicelist.Service{file.Service}
+	}
+	for i := range list {
+		list[i].File = path
+	}
+	return servicelist.Validate(list)
+}
+
+// definition is a service definition file that has been read.
+type definition struct {
+	modTime  time.Time
+	size     int64
+	services []*servicelist.Service
+}
+
+// definitions reads the services of the files matching some glob patterns.
+type definitions struct {
+	paths  []string
+	files  map[string]*definition
+	logger *logp.Logger
+}
+
+func newDefinitions(paths []string, logger *logp.Logger) *definitions {
+	return &definitions{
+		paths:  paths,
+		files:  map[string]*definition{},
+		logger: logger,
+	}
+}
+
+// services scans the files and returns the services defined in them. Files
+// are only read again if their modification time or size changed. The known
+// services of files that cannot be read or parsed are kept, so an incomplete
+// write doesn't stop them.
+func (d *definitions) services(_ context.Context) ([]*servicelist.Service, error) {
+	files := map[string]*definition{}
+	for _, pattern := range d.paths {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			d.logger.Errorf("Invalid glob pattern %q: %v", pattern, err)
+			continue
+		}
+		for _, path := range matches {
+			if _, found := files[path]; found {
+				continue
+			}
+			def, err := d.read(path)
+			if err != nil {
+				d.logger.Error(errors.Wrapf(err, "error reading service definition file %s", path))
+				if known, found := d.files[path]; found {
+					files[path] = known
+				}
+				continue
+			}
+			if def != nil {
+				files[path] = def
+			}
+		}
+	}
+	d.files = files
+
+	var services []*servicelist.Service
+	for _, def := range files {
+		services = append(services, def.services...)
+	}
+	return services, nil
+}
+
+// read returns the definition in a file, or the known one if the file didn't
+// change. Directories are ignored.
+func (d *definitions) read(path string) (*definition, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, nil
+	}
+	if known, found := d.files[path]; found && known.modTime.Equal(info.ModTime()) && known.size == info.Size() {
+		return known, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	services, err := parseServices(path, data)
+	if err != nil {
+		return nil, err
+	}
+	return &definition{
+		modTime:  info.ModTime(),
+		size:     info.Size(),
+		services: services,
+	}, nil
+}
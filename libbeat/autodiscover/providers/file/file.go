@@ -0,0 +1,57 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("file", AutodiscoverBuilder)
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider for
+// services defined in files
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("file autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up file autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	definitions := newDefinitions(config.Paths, logp.NewLogger("autodiscover.file"))
+	p, err := servicelist.NewProvider("file", bus, uuid, &config.Config, definitions.services, config.Period, 0, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package file
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/servicelist"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const redisDefinition = `
+name: redis
+host: 10.0.0.5
+port: 6379
+labels:
+  env: production
+  co.elastic.metrics/module: redis
+  co.elastic.metrics/period: 30s
+`
+
+const webDefinition = `
+services:
+  - id: web-1
+    name: web
+    host: 10.0.0.6
+  - id: web-2
+    name: web
+    host: 10.0.0.7
+`
+
+func TestParseServices(t *testing.T) {
+	services, err := parseServices("/etc/services.d/redis.yml", []byte(redisDefinition))
+	require.NoError(t, err)
+	assert.Equal(t, []*servicelist.Service{{
+		ID:   "redis",
+		Name: "redis",
+		Host: "10.0.0.5",
+		Port: 6379,
+		Labels: map[string]string{
+			"env":                       "production",
+			"co.elastic.metrics/module": "redis",
+			"co.elastic.metrics/period": "30s",
+		},
+		File: "/etc/services.d/redis.yml",
+	}}, services)
+	assert.Equal(t, "/etc/services.d/redis.yml:redis", services[0].Key())
+
+	services, err = parseServices("web.yml", []byte(webDefinition))
+	require.NoError(t, err)
+	assert.Len(t, services, 2)
+	assert.Equal(t, "web-1", services[0].ID)
+	assert.Equal(t, "web-2", services[1].ID)
+
+	services, err = parseServices("empty.yml", []byte(""))
+	require.NoError(t, err)
+	assert.Empty(t, services)
+
+	for name, data := range map[string]string{
+		"no name":        "host: 10.0.0.5",
+		"duplicated id":  "services: [{name: web}, {name: web}]",
+		"invalid port":   "{name: web, port: 70000}",
+		"unknown field":  "{name: web, address: 10.0.0.5}",
+		"file field":     "{name: web, file: web.yml}",
+		"service & list": "{name: web, services: [{name: redis}]}",
+		"invalid yaml":   "name: [web",
+	} {
+		_, err := parseServices("test.yml", []byte(data))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestDefinitions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "autodiscover-file")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	redisPath := filepath.Join(dir, "redis.yml")
+	webPath := filepath.Join(dir, "web.yml")
+	require.NoError(t, ioutil.WriteFile(redisPath, []byte(redisDefinition), 0600))
+	require.NoError(t, ioutil.WriteFile(webPath, []byte(webDefinition), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "dir.yml"), 0700))
+
+	d := newDefinitions([]string{filepath.Join(dir, "*.yml"), filepath.Join(dir, "redis.*")}, logp.NewLogger("test"))
+	ids := func() []string {
+		services, err := d.services(context.Background())
+		require.NoError(t, err)
+		var ids []string
+		for _, s := range services {
+			ids = append(ids, s.ID)
+		}
+		sort.Strings(ids)
+		return ids
+	}
+
+	assert.Equal(t, []string{"redis", "web-1", "web-2"}, ids())
+	redis := d.files[redisPath].services[0]
+
+	// Files are only read again when they change.
+	assert.Equal(t, []string{"redis", "web-1", "web-2"}, ids())
+	assert.True(t, redis == d.files[redisPath].services[0])
+
+	// Updated files are read again, the services of invalid files are kept.
+	require.NoError(t, ioutil.WriteFile(redisPath, []byte("{name: redis, port: 6380}"), 0600))
+	require.NoError(t, ioutil.WriteFile(webPath, []byte("services: [{name: web}, {name: web}]"), 0600))
+	touch(t, redisPath, webPath)
+	assert.Equal(t, []string{"redis", "web-1", "web-2"}, ids())
+	assert.Equal(t, 6380, d.files[redisPath].services[0].Port)
+
+	// Services of removed files are not returned anymore.
+	require.NoError(t, os.Remove(redisPath))
+	require.NoError(t, os.Remove(webPath))
+	assert.Empty(t, ids())
+	assert.Empty(t, d.files)
+}
+
+// touch changes the modification time of files, as files written in quick
+// succession can keep the same one.
+func touch(t *testing.T, paths ...string) {
+	modTime := time.Now().Add(time.Minute)
+	for _, path := range paths {
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, c := range []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{config: map[string]interface{}{"paths": []string{"/etc/services.d/*.yml"}}, valid: true},
+		{config: map[string]interface{}{}},
+		{config: map[string]interface{}{"paths": []string{"/etc/services.d/*.yml"}, "period": 0}},
+	} {
+		config := defaultConfig()
+		err := common.MustNewConfigFrom(c.config).Unpack(config)
+		if c.valid {
+			assert.NoError(t, err, "%v", c.config)
+		} else {
+			assert.Error(t, err, "%v", c.config)
+		}
+	}
+}
+
+func TestAutodiscoverBuilder(t *testing.T) {
+	p, err := AutodiscoverBuilder(bus.New(logp.L(), "test"), uuid.Nil, common.MustNewConfigFrom(map[string]interface{}{
+		"paths":        []string{"/etc/services.d/*.yml"},
+		"prefix":       "co.example",
+		"labels.dedot": false,
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{
+				"equals": map[string]interface{}{"service_definition.name": "web"},
+			},
+			"config": []map[string]interface{}{{"type": "log"}},
+		}},
+	}), nil)
+	require.NoError(t, err)
+	assert.Equal(t, "file", p.String())
+
+	_, err = AutodiscoverBuilder(bus.New(logp.L(), "test"), uuid.Nil, common.MustNewConfigFrom(map[string]interface{}{
+		"paths": []string{"/etc/services.d/*.yml"},
+	}), nil)
+	assert.Error(t, err, "templates or hints are required")
+}
+
+func TestConfigInline(t *testing.T) {
+	config := defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{
+		"paths":        []string{"/etc/services.d/*.yml"},
+		"prefix":       "co.example",
+		"labels.dedot": false,
+	}).Unpack(config))
+	assert.Equal(t, "co.example", config.Prefix)
+	assert.False(t, config.Dedot)
+
+	config = defaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(map[string]interface{}{
+		"paths": []string{"/etc/services.d/*.yml"},
+	}).Unpack(config))
+	assert.Equal(t, "co.elastic", config.Prefix)
+	assert.True(t, config.Dedot)
+}
@@ -18,6 +18,8 @@
 package jolokia
 
 import (
+	"fmt"
+	"net"
 	"time"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
@@ -53,6 +55,18 @@ type InterfaceConfig struct {
 
 	// Time since an instance is last seen and is considered removed
 	GracePeriod time.Duration `config:"grace_period" validate:"positive,nonzero"`
+
+	// Network in CIDR notation to select the address of the interface used
+	// for probes, interfaces without addresses in this network are not used
+	Network string `config:"network"`
+
+	// Credentials and TLS settings added to the configurations of the agents
+	// discovered with this interface
+	Username string         `config:"username"`
+	Password string         `config:"password"`
+	TLS      *common.Config `config:"ssl"`
+
+	network *net.IPNet
 }
 
 // Unpack implements the config unpacker for interface configs
@@ -69,6 +83,12 @@ func (c *InterfaceConfig) Unpack(from *common.Config) error {
 	}
 
 	*c = InterfaceConfig(tmp)
+	if c.Network != "" {
+		_, c.network, err = net.ParseCIDR(c.Network)
+		if err != nil {
+			return fmt.Errorf("invalid network %s for interface %s: %v", c.Network, c.Name, err)
+		}
+	}
 	return nil
 }
 
@@ -59,6 +59,27 @@ func TestInterfaceConfigsUnpack(t *testing.T) {
 			},
 			Valid: true,
 		},
+		{
+			Description: "interface with invalid network",
+			Config: map[string]interface{}{
+				"name":    "any",
+				"network": "10.0.0.0",
+			},
+			Valid: false,
+		},
+		{
+			Description: "interface with network and credentials",
+			Config: map[string]interface{}{
+				"name":     "any",
+				"network":  "10.0.0.0/8",
+				"username": "user",
+				"password": "secret",
+				"ssl": map[string]interface{}{
+					"verification_mode": "none",
+				},
+			},
+			Valid: true,
+		},
 	}
 
 	for _, c := range cases {
@@ -77,6 +77,7 @@ type Event struct {
 	Type         string
 	AgentID      string
 	Message      common.MapStr
+	Interface    *InterfaceConfig
 }
 
 // BusEvent converts a Jolokia Discovery event to a autodiscover bus event
@@ -184,14 +185,16 @@ func matchInterfaceName(name, candidate string) bool {
 	return name == candidate
 }
 
-func getIPv4Addr(i net.Interface) (net.IP, error) {
+// getIPv4Addr returns the first IPv4 address of the interface, in the given
+// network if any
+func getIPv4Addr(i net.Interface, network *net.IPNet) (net.IP, error) {
 	addrs, err := i.Addrs()
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get addresses for "+i.Name)
 	}
 	for _, a := range addrs {
 		if ip, _, err := net.ParseCIDR(a.String()); err == nil && ip != nil {
-			if ipv4 := ip.To4(); ipv4 != nil {
+			if ipv4 := ip.To4(); ipv4 != nil && (network == nil || network.Contains(ipv4)) {
 				return ipv4, nil
 			}
 		}
@@ -213,7 +216,7 @@ func (d *Discovery) sendProbe(config InterfaceConfig) {
 
 	var wg sync.WaitGroup
 	for _, i := range interfaces {
-		ip, err := getIPv4Addr(i)
+		ip, err := getIPv4Addr(i, config.network)
 		if err != nil {
 			log.Error(err.Error())
 			continue
@@ -300,7 +303,7 @@ func (d *Discovery) update(config InterfaceConfig, message common.MapStr) {
 	if !found {
 		i = &Instance{Message: message, AgentID: agentID}
 		d.instances[agentID] = i
-		d.events <- Event{d.ProviderUUID, "start", agentID, message}
+		d.events <- Event{d.ProviderUUID, "start", agentID, message, &config}
 	}
 	i.LastSeen = time.Now()
 	i.LastInterface = &config
@@ -312,7 +315,7 @@ func (d *Discovery) checkStopped() {
 
 	for id, i := range d.instances {
 		if time.Since(i.LastSeen) > i.LastInterface.GracePeriod {
-			d.events <- Event{d.ProviderUUID, "stop", i.AgentID, i.Message}
+			d.events <- Event{d.ProviderUUID, "stop", i.AgentID, i.Message, i.LastInterface}
 			delete(d.instances, id)
 		}
 	}
@@ -101,22 +101,42 @@ func (p *Provider) Start() {
 	p.discovery.Start()
 	go func() {
 		for event := range p.discovery.Events() {
-			p.publish(event.BusEvent())
+			p.publish(event.BusEvent(), event.Interface)
 		}
 	}()
 }
 
-func (p *Provider) publish(event bus.Event) {
+func (p *Provider) publish(event bus.Event, iface *InterfaceConfig) {
 	if config := p.templates.GetConfig(event); config != nil {
 		event["config"] = config
 	} else if config := p.builders.GetConfig(event); config != nil {
 		event["config"] = config
 	}
 
+	if configs, ok := event["config"].([]*common.Config); ok && iface != nil {
+		addCredentials(configs, iface)
+	}
+
 	p.appenders.Append(event)
 	p.bus.Publish(event)
 }
 
+// addCredentials adds the credentials and TLS settings of the interface an
+// agent was discovered with to the configs that don't set them
+func addCredentials(configs []*common.Config, iface *InterfaceConfig) {
+	for _, config := range configs {
+		if iface.Username != "" && !config.HasField("username") {
+			config.SetString("username", -1, iface.Username)
+		}
+		if iface.Password != "" && !config.HasField("password") {
+			config.SetString("password", -1, iface.Password)
+		}
+		if iface.TLS != nil && !config.HasField("ssl") {
+			config.SetChild("ssl", -1, iface.TLS)
+		}
+	}
+}
+
 // Stop stops autodiscover provider
 func (p *Provider) Stop() {
 	p.discovery.Stop()
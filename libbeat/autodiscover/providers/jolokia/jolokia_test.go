@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package jolokia
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestAddCredentials(t *testing.T) {
+	iface := &InterfaceConfig{
+		Username: "user",
+		Password: "secret",
+		TLS: common.MustNewConfigFrom(map[string]interface{}{
+			"verification_mode": "none",
+		}),
+	}
+
+	configs := []*common.Config{
+		common.MustNewConfigFrom(map[string]interface{}{
+			"module": "jolokia",
+		}),
+		common.MustNewConfigFrom(map[string]interface{}{
+			"module":   "jolokia",
+			"username": "other",
+		}),
+	}
+	addCredentials(configs, iface)
+
+	var result []map[string]interface{}
+	for _, config := range configs {
+		var m map[string]interface{}
+		err := config.Unpack(&m)
+		assert.NoError(t, err)
+		result = append(result, m)
+	}
+
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"module":   "jolokia",
+			"username": "user",
+			"password": "secret",
+			"ssl":      map[string]interface{}{"verification_mode": "none"},
+		},
+		{
+			"module":   "jolokia",
+			"username": "other",
+			"password": "secret",
+			"ssl":      map[string]interface{}{"verification_mode": "none"},
+		},
+	}, result)
+}
@@ -21,8 +21,12 @@ package kubernetes
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+
 	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
@@ -44,6 +48,12 @@ type Config struct {
 	// Scope can be either node or cluster.
 	Scope    string `config:"scope"`
 	Resource string `config:"resource"`
+	// LabelSelector and FieldSelector limit the watched resources to the
+	// matching ones, as in `kubectl get -l` and `--field-selector`.
+	LabelSelector string `config:"label_selector"`
+	FieldSelector string `config:"field_selector"`
+	// Needed when resource is custom
+	CustomResource customResourceConfig `config:"custom_resource"`
 
 	Prefix    string                  `config:"prefix"`
 	Hints     *common.Config          `config:"hints"`
@@ -52,6 +62,43 @@ type Config struct {
 	Templates template.MapperSettings `config:"templates"`
 
 	AddResourceMetadata *metadata.AddResourceMetadataConfig `config:"add_resource_metadata"`
+
+	LeaderElection leaderElectionConfig `config:"leader_election"`
+	Sharding       shardingConfig       `config:"sharding"`
+}
+
+// customResourceConfig selects the custom resource watched when resource is
+// custom, and the fields of its objects that are added to the events.
+type customResourceConfig struct {
+	Group   string `config:"group"`
+	Version string `config:"version"`
+	Kind    string `config:"kind"`
+	// Plural name of the resource, defaults to the lowercased kind with an s.
+	Resource string `config:"resource"`
+	// Fields maps the names of the fields added to the events to their
+	// paths in the objects, as in spec.endpoint.port.
+	Fields map[string]string `config:"fields"`
+}
+
+// leaderElectionConfig configures the election of a leader between all the
+// Beats using the same lease.
+type leaderElectionConfig struct {
+	Enabled       bool          `config:"enabled"`
+	Lease         string        `config:"lease"`
+	Namespace     string        `config:"namespace"`
+	LeaseDuration time.Duration `config:"lease_duration" validate:"positive"`
+	RenewDeadline time.Duration `config:"renew_deadline" validate:"positive"`
+	RetryPeriod   time.Duration `config:"retry_period" validate:"positive"`
+}
+
+// shardingConfig configures the split of the discovered resources between
+// all the Beats in the same group.
+type shardingConfig struct {
+	Enabled       bool          `config:"enabled"`
+	Group         string        `config:"group"`
+	Namespace     string        `config:"namespace"`
+	LeaseDuration time.Duration `config:"lease_duration" validate:"positive"`
+	RenewPeriod   time.Duration `config:"renew_period" validate:"positive"`
 }
 
 func defaultConfig() *Config {
@@ -60,6 +107,17 @@ func defaultConfig() *Config {
 		Resource:       "pod",
 		CleanupTimeout: 60 * time.Second,
 		Prefix:         "co.elastic",
+		LeaderElection: leaderElectionConfig{
+			Lease:         "beats-autodiscover-leader",
+			LeaseDuration: 15 * time.Second,
+			RenewDeadline: 10 * time.Second,
+			RetryPeriod:   2 * time.Second,
+		},
+		Sharding: shardingConfig{
+			Group:         "beats-autodiscover-shard",
+			LeaseDuration: 15 * time.Second,
+			RenewPeriod:   5 * time.Second,
+		},
 	}
 }
 
@@ -95,9 +153,51 @@ func (c *Config) Validate() error {
 		c.Scope = "cluster"
 	}
 
+	if c.Resource == "custom" {
+		if err := c.CustomResource.validate(); err != nil {
+			return err
+		}
+	}
+
 	if c.Scope != "node" && c.Scope != "cluster" {
 		return fmt.Errorf("invalid `scope` configured. supported values are `node` and `cluster`")
 	}
 
+	if _, err := labels.Parse(c.LabelSelector); err != nil {
+		return fmt.Errorf("invalid `label_selector`: %v", err)
+	}
+	if _, err := fields.ParseSelector(c.FieldSelector); err != nil {
+		return fmt.Errorf("invalid `field_selector`: %v", err)
+	}
+
+	if c.Sharding.Enabled {
+		if c.Sharding.Group == "" {
+			return fmt.Errorf("`sharding.group` cannot be empty")
+		}
+		if c.Sharding.RenewPeriod >= c.Sharding.LeaseDuration {
+			return fmt.Errorf("`sharding.renew_period` must be shorter than `sharding.lease_duration`")
+		}
+	}
+
+	return nil
+}
+
+func (c *customResourceConfig) validate() error {
+	if c.Version == "" || c.Kind == "" {
+		return fmt.Errorf("`custom_resource.version` and `custom_resource.kind` are required when resource is custom")
+	}
+	if c.Resource == "" {
+		c.Resource = strings.ToLower(c.Kind) + "s"
+	}
+
+	for name, path := range c.Fields {
+		switch name {
+		case "name", "uid":
+			return fmt.Errorf("`custom_resource.fields` can not override %s", name)
+		}
+		if path == "" {
+			return fmt.Errorf("empty path for field %s in `custom_resource.fields`", name)
+		}
+	}
 	return nil
 }
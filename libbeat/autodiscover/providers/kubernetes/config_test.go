@@ -71,6 +71,83 @@ func TestConfigWithIncorrectScope(t *testing.T) {
 	assert.Equal(t, "cluster", c.Scope)
 }
 
+func TestConfigCustomResource(t *testing.T) {
+	cfg := common.MapStr{
+		"resource":      "custom",
+		"hints.enabled": true,
+		"custom_resource": common.MapStr{
+			"group":   "example.com",
+			"version": "v1",
+			"kind":    "Database",
+			"fields": common.MapStr{
+				"host": "spec.host",
+			},
+		},
+	}
+
+	config := common.MustNewConfigFrom(&cfg)
+	c := defaultConfig()
+	err := config.Unpack(&c)
+	assert.NoError(t, err)
+
+	assert.Equal(t, "cluster", c.Scope)
+	assert.Equal(t, "databases", c.CustomResource.Resource)
+	assert.Equal(t, map[string]string{"host": "spec.host"}, c.CustomResource.Fields)
+
+	for name, customResource := range map[string]common.MapStr{
+		"missing kind": {
+			"version": "v1",
+		},
+		"overridden name": {
+			"version": "v1",
+			"kind":    "Database",
+			"fields":  common.MapStr{"name": "spec.name"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := common.MapStr{
+				"resource":        "custom",
+				"hints.enabled":   true,
+				"custom_resource": customResource,
+			}
+			c := defaultConfig()
+			err := common.MustNewConfigFrom(&cfg).Unpack(&c)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfigSelectors(t *testing.T) {
+	cfg := common.MapStr{
+		"resource":       "pod",
+		"hints.enabled":  true,
+		"label_selector": "app in (nginx,redis),tier!=frontend",
+		"field_selector": "status.phase=Running",
+	}
+
+	c := defaultConfig()
+	err := common.MustNewConfigFrom(&cfg).Unpack(&c)
+	assert.NoError(t, err)
+	assert.Equal(t, "app in (nginx,redis),tier!=frontend", c.LabelSelector)
+	assert.Equal(t, "status.phase=Running", c.FieldSelector)
+
+	for name, selectors := range map[string]common.MapStr{
+		"invalid label selector": {"label_selector": "app in nginx"},
+		"invalid field selector": {"field_selector": "status.phase"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := common.MapStr{
+				"resource":      "pod",
+				"hints.enabled": true,
+			}
+			cfg.Update(selectors)
+			c := defaultConfig()
+			err := common.MustNewConfigFrom(&cfg).Unpack(&c)
+			assert.Error(t, err)
+		})
+	}
+}
+
 type mockBuilder struct {
 }
 
@@ -0,0 +1,303 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+type customResource struct {
+	uuid             uuid.UUID
+	config           *Config
+	kind             string
+	resource         *metadata.Resource
+	namespaceMeta    metadata.MetaGen
+	logger           *logp.Logger
+	publish          func(bus.Event)
+	watcher          kubernetes.Watcher
+	namespaceWatcher kubernetes.Watcher
+}
+
+// NewCustomResourceEventer creates an eventer that can discover and process the objects of a custom resource
+func NewCustomResourceEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface, dynamicClient dynamic.Interface, publish func(event bus.Event)) (Eventer, error) {
+	logger := logp.NewLogger("autodiscover.customresource")
+
+	config := defaultConfig()
+	err := cfg.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	gvr := schema.GroupVersionResource{
+		Group:    config.CustomResource.Group,
+		Version:  config.CustomResource.Version,
+		Resource: config.CustomResource.Resource,
+	}
+	watcher, err := kubernetes.NewCustomResourceWatcher(dynamicClient, gvr, kubernetes.WatchOptions{
+		SyncTimeout:   config.SyncPeriod,
+		Namespace:     config.Namespace,
+		LabelSelector: config.LabelSelector,
+		FieldSelector: config.FieldSelector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create watcher for %v due to error %+v", gvr, err)
+	}
+
+	var namespaceMeta metadata.MetaGen
+	var namespaceWatcher kubernetes.Watcher
+	metaConf := config.AddResourceMetadata
+	if metaConf != nil {
+		if metaConf.Namespace != nil && metaConf.Namespace.Enabled() {
+			namespaceWatcher, err = kubernetes.NewWatcher(client, &kubernetes.Namespace{}, kubernetes.WatchOptions{
+				SyncTimeout: config.SyncPeriod,
+				Namespace:   config.Namespace,
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't create watcher for %T due to error %+v", &kubernetes.Namespace{}, err)
+			}
+
+			namespaceMeta = metadata.NewNamespaceMetadataGenerator(metaConf.Namespace, namespaceWatcher.Store())
+		}
+	}
+
+	c := &customResource{
+		config:           config,
+		uuid:             uuid,
+		publish:          publish,
+		kind:             strings.ToLower(config.CustomResource.Kind),
+		resource:         metadata.NewResourceMetadataGenerator(cfg),
+		namespaceMeta:    namespaceMeta,
+		namespaceWatcher: namespaceWatcher,
+		logger:           logger,
+		watcher:          watcher,
+	}
+
+	watcher.AddEventHandler(c)
+	return c, nil
+}
+
+// OnAdd ensures processing of custom resource objects that are newly created
+func (c *customResource) OnAdd(obj interface{}) {
+	c.logger.Debugf("Watcher custom resource add: %+v", obj)
+	c.emit(obj.(*kubernetes.CustomResource), "start")
+}
+
+// OnUpdate ensures processing of custom resource objects that are updated
+func (c *customResource) OnUpdate(obj interface{}) {
+	cr := obj.(*kubernetes.CustomResource)
+	// Once the object is in terminated state, mark it for deletion
+	if cr.GetDeletionTimestamp() != nil {
+		time.AfterFunc(c.config.CleanupTimeout, func() { c.emit(cr, "stop") })
+	} else {
+		c.logger.Debugf("Watcher custom resource update: %+v", obj)
+		c.emit(cr, "stop")
+		c.emit(cr, "start")
+	}
+}
+
+// OnDelete ensures processing of custom resource objects that are deleted
+func (c *customResource) OnDelete(obj interface{}) {
+	c.logger.Debugf("Watcher custom resource delete: %+v", obj)
+	time.AfterFunc(c.config.CleanupTimeout, func() { c.emit(obj.(*kubernetes.CustomResource), "stop") })
+}
+
+// GenerateHints creates hints needed for hints builder
+func (c *customResource) GenerateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	ev := bus.Event{}
+	var kubeMeta common.MapStr
+
+	annotations := make(common.MapStr, 0)
+	rawMeta, ok := event["kubernetes"]
+	if ok {
+		kubeMeta = rawMeta.(common.MapStr)
+		// The builder base config can configure any of the field values of kubernetes if need be.
+		ev["kubernetes"] = kubeMeta
+		if rawAnn, ok := kubeMeta["annotations"]; ok {
+			anns, _ := rawAnn.(common.MapStr)
+			if len(anns) != 0 {
+				annotations = anns.Clone()
+			}
+		}
+
+		// Look at all the namespace level default annotations and do a merge with priority going to the object annotations.
+		if rawNsAnn, ok := kubeMeta["namespace_annotations"]; ok {
+			nsAnn, _ := rawNsAnn.(common.MapStr)
+			if len(nsAnn) != 0 {
+				mergeNamespaceAnnotations(annotations, nsAnn)
+			}
+		}
+	}
+	if host, ok := event["host"]; ok {
+		ev["host"] = host
+	}
+	if port, ok := event["port"]; ok {
+		ev["port"] = port
+	}
+
+	hints := builder.GenerateHints(annotations, "", c.config.Prefix)
+	c.logger.Debugf("Generated hints %+v", hints)
+
+	if len(hints) != 0 {
+		ev["hints"] = hints
+	}
+
+	c.logger.Debugf("Generated builder event %+v", ev)
+
+	return ev
+}
+
+// Start starts the eventer
+func (c *customResource) Start() error {
+	if c.namespaceWatcher != nil {
+		if err := c.namespaceWatcher.Start(); err != nil {
+			return err
+		}
+	}
+	return c.watcher.Start()
+}
+
+// Stop stops the eventer
+func (c *customResource) Stop() {
+	c.watcher.Stop()
+
+	if c.namespaceWatcher != nil {
+		c.namespaceWatcher.Stop()
+	}
+}
+
+// Resync emits the events of all the known custom resource objects again, the
+// provider only restarts the configs that changed
+func (c *customResource) Resync() {
+	for _, obj := range c.watcher.Store().List() {
+		if cr, ok := obj.(*kubernetes.CustomResource); ok {
+			c.emit(cr, resyncFlag)
+		}
+	}
+}
+
+// generate creates the metadata of a custom resource object, including the
+// fields mapped from the object in the config.
+func (c *customResource) generate(cr *kubernetes.CustomResource) common.MapStr {
+	meta := c.resource.Generate(c.kind, cr)
+	if meta == nil {
+		return nil
+	}
+
+	for name, path := range c.config.CustomResource.Fields {
+		value, found, err := unstructured.NestedFieldNoCopy(cr.Object, strings.Split(path, ".")...)
+		if err != nil || !found {
+			continue
+		}
+		safemapstr.Put(meta, c.kind+"."+name, value)
+	}
+
+	if c.namespaceMeta != nil {
+		nsMeta := c.namespaceMeta.GenerateFromName(cr.GetNamespace())
+		if nsMeta != nil {
+			meta.DeepUpdate(nsMeta)
+		}
+	}
+
+	return meta
+}
+
+func (c *customResource) emit(cr *kubernetes.CustomResource, flag string) {
+	meta := c.generate(cr)
+	if meta == nil {
+		return
+	}
+
+	kubemeta := meta.Clone()
+	// Pass annotations to all events so that it can be used in templating and by annotation builders.
+	annotations := common.MapStr{}
+	for k, v := range cr.GetAnnotations() {
+		safemapstr.Put(annotations, k, v)
+	}
+	kubemeta["annotations"] = annotations
+
+	if c.namespaceWatcher != nil {
+		if rawNs, ok, err := c.namespaceWatcher.Store().GetByKey(cr.GetNamespace()); ok && err == nil {
+			if namespace, ok := rawNs.(*kubernetes.Namespace); ok {
+				nsAnns := common.MapStr{}
+
+				for k, v := range namespace.GetAnnotations() {
+					safemapstr.Put(nsAnns, k, v)
+				}
+				kubemeta["namespace_annotations"] = nsAnns
+			}
+		}
+	}
+
+	event := bus.Event{
+		"provider":   c.uuid,
+		"id":         fmt.Sprint(cr.GetUID()),
+		flag:         true,
+		"kubernetes": kubemeta,
+		"meta": common.MapStr{
+			"kubernetes": meta,
+		},
+	}
+
+	// The host and port fields, if mapped, are used as the address of the event
+	if host, err := meta.GetValue(c.kind + ".host"); err == nil {
+		event["host"] = fmt.Sprint(host)
+	}
+	if port, err := meta.GetValue(c.kind + ".port"); err == nil {
+		if p, ok := customResourcePort(port); ok {
+			event["port"] = p
+		} else {
+			c.logger.Debugf("Ignoring invalid port %v of %s %s/%s", port, c.kind, cr.GetNamespace(), cr.GetName())
+		}
+	}
+
+	c.publish(event)
+}
+
+// customResourcePort converts a port read from an unstructured object.
+func customResourcePort(port interface{}) (int, bool) {
+	switch p := port.(type) {
+	case int64:
+		return int(p), true
+	case float64:
+		return int(p), p == float64(int(p))
+	case string:
+		i, err := strconv.Atoi(p)
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
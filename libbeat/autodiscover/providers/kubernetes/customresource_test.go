@@ -0,0 +1,210 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestEmitEvent_CustomResource(t *testing.T) {
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	newDatabase := func(spec map[string]interface{}) *kubernetes.CustomResource {
+		return &kubernetes.CustomResource{
+			Object: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"metadata": map[string]interface{}{
+					"name":      "orders",
+					"namespace": "default",
+					"uid":       uid,
+					"annotations": map[string]interface{}{
+						"co.elastic.metrics/module": "mysql",
+					},
+				},
+				"spec": spec,
+			},
+		}
+	}
+
+	fields := map[string]string{
+		"host":   "spec.endpoint.host",
+		"port":   "spec.endpoint.port",
+		"engine": "spec.engine",
+	}
+
+	tests := []struct {
+		Message  string
+		Flag     string
+		Object   *kubernetes.CustomResource
+		Expected bus.Event
+	}{
+		{
+			Message: "Test custom resource start",
+			Flag:    "start",
+			Object: newDatabase(map[string]interface{}{
+				"engine": "mysql",
+				"endpoint": map[string]interface{}{
+					"host": "orders.default.svc",
+					"port": int64(3306),
+				},
+			}),
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "orders.default.svc",
+				"port":     3306,
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"database": common.MapStr{
+						"name":   "orders",
+						"uid":    uid,
+						"host":   "orders.default.svc",
+						"port":   int64(3306),
+						"engine": "mysql",
+					},
+					"namespace": "default",
+					"annotations": common.MapStr{
+						"co": common.MapStr{"elastic": common.MapStr{"metrics/module": "mysql"}},
+					},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"database": common.MapStr{
+							"name":   "orders",
+							"uid":    uid,
+							"host":   "orders.default.svc",
+							"port":   int64(3306),
+							"engine": "mysql",
+						},
+						"namespace": "default",
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+		{
+			Message: "Test custom resource stop without mapped fields",
+			Flag:    "stop",
+			Object:  newDatabase(map[string]interface{}{}),
+			Expected: bus.Event{
+				"stop":     true,
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"database": common.MapStr{
+						"name": "orders",
+						"uid":  uid,
+					},
+					"namespace": "default",
+					"annotations": common.MapStr{
+						"co": common.MapStr{"elastic": common.MapStr{"metrics/module": "mysql"}},
+					},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"database": common.MapStr{
+							"name": "orders",
+							"uid":  uid,
+						},
+						"namespace": "default",
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Message, func(t *testing.T) {
+			mapper, err := template.NewConfigMapper(nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			config := defaultConfig()
+			config.Resource = "custom"
+			config.CustomResource = customResourceConfig{
+				Version: "v1",
+				Kind:    "Database",
+				Fields:  fields,
+			}
+
+			p := &Provider{
+				config:    config,
+				bus:       bus.New(logp.NewLogger("bus"), "test"),
+				templates: mapper,
+				logger:    logp.NewLogger("kubernetes"),
+			}
+
+			c := &customResource{
+				config:   config,
+				kind:     "database",
+				resource: metadata.NewResourceMetadataGenerator(common.NewConfig()),
+				publish:  p.publish,
+				uuid:     UUID,
+				logger:   logp.NewLogger("kubernetes.customresource"),
+			}
+
+			p.eventer = c
+
+			listener := p.bus.Subscribe()
+
+			c.emit(test.Object, test.Flag)
+
+			select {
+			case event := <-listener.Events():
+				assert.Equal(t, test.Expected, event, test.Message)
+			case <-time.After(2 * time.Second):
+				t.Fatal("Timeout while waiting for event")
+			}
+		})
+	}
+}
+
+func TestCustomResourcePort(t *testing.T) {
+	for value, expected := range map[interface{}]int{
+		int64(8080): 8080,
+		float64(80): 80,
+		"9200":      9200,
+	} {
+		port, ok := customResourcePort(value)
+		assert.True(t, ok)
+		assert.Equal(t, expected, port)
+	}
+
+	for _, value := range []interface{}{"http", float64(1.5), true, nil} {
+		_, ok := customResourcePort(value)
+		assert.False(t, ok)
+	}
+}
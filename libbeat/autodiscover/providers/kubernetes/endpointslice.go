@@ -0,0 +1,368 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	"k8s.io/apimachinery/pkg/types"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+type endpointSlice struct {
+	uuid             uuid.UUID
+	config           *Config
+	metagen          metadata.MetaGen
+	logger           *logp.Logger
+	publish          func(bus.Event)
+	watcher          kubernetes.Watcher
+	serviceWatcher   kubernetes.Watcher
+	namespaceWatcher kubernetes.Watcher
+
+	// slices are the last known state of the endpoint slices, so the
+	// endpoints removed from a slice can be stopped when it is updated.
+	slices     map[types.UID]*kubernetes.EndpointSlice
+	slicesLock sync.Mutex
+}
+
+// NewEndpointSliceEventer creates an eventer that can discover and process the endpoints of endpoint slice objects
+func NewEndpointSliceEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface, publish func(event bus.Event)) (Eventer, error) {
+	logger := logp.NewLogger("autodiscover.endpointslice")
+
+	config := defaultConfig()
+	err := cfg.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	watcher, err := kubernetes.NewWatcher(client, &kubernetes.EndpointSlice{}, kubernetes.WatchOptions{
+		SyncTimeout:   config.SyncPeriod,
+		Namespace:     config.Namespace,
+		LabelSelector: config.LabelSelector,
+		FieldSelector: config.FieldSelector,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create watcher for %T due to error %+v", &kubernetes.EndpointSlice{}, err)
+	}
+
+	// Services are watched for the annotations used as hints by the endpoints
+	serviceWatcher, err := kubernetes.NewWatcher(client, &kubernetes.Service{}, kubernetes.WatchOptions{
+		SyncTimeout: config.SyncPeriod,
+		Namespace:   config.Namespace,
+	}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create watcher for %T due to error %+v", &kubernetes.Service{}, err)
+	}
+
+	var namespaceMeta metadata.MetaGen
+	var namespaceWatcher kubernetes.Watcher
+	metaConf := config.AddResourceMetadata
+	if metaConf != nil {
+		if metaConf.Namespace != nil && metaConf.Namespace.Enabled() {
+			namespaceWatcher, err = kubernetes.NewWatcher(client, &kubernetes.Namespace{}, kubernetes.WatchOptions{
+				SyncTimeout: config.SyncPeriod,
+				Namespace:   config.Namespace,
+			}, nil)
+			if err != nil {
+				return nil, fmt.Errorf("couldn't create watcher for %T due to error %+v", &kubernetes.Namespace{}, err)
+			}
+
+			namespaceMeta = metadata.NewNamespaceMetadataGenerator(metaConf.Namespace, namespaceWatcher.Store())
+		}
+	}
+
+	e := &endpointSlice{
+		config:           config,
+		uuid:             uuid,
+		publish:          publish,
+		metagen:          metadata.NewEndpointSliceMetadataGenerator(cfg, watcher.Store(), namespaceMeta),
+		namespaceWatcher: namespaceWatcher,
+		serviceWatcher:   serviceWatcher,
+		logger:           logger,
+		watcher:          watcher,
+		slices:           make(map[types.UID]*kubernetes.EndpointSlice),
+	}
+
+	watcher.AddEventHandler(e)
+	serviceWatcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
+		UpdateFunc: func(obj interface{}) {
+			// Endpoints get the annotations of their services
+			if svc, ok := obj.(*kubernetes.Service); ok {
+				e.resyncService(svc)
+			}
+		},
+	})
+	return e, nil
+}
+
+// OnAdd ensures processing of endpoint slice objects that are newly created
+func (e *endpointSlice) OnAdd(obj interface{}) {
+	e.logger.Debugf("Watcher endpoint slice add: %+v", obj)
+	e.update(obj.(*kubernetes.EndpointSlice))
+}
+
+// OnUpdate ensures processing of endpoint slice objects that are updated
+func (e *endpointSlice) OnUpdate(obj interface{}) {
+	slice := obj.(*kubernetes.EndpointSlice)
+	// Once endpoint slice is in terminated state, mark it for deletion
+	if slice.GetObjectMeta().GetDeletionTimestamp() != nil {
+		e.OnDelete(slice)
+	} else {
+		e.logger.Debugf("Watcher endpoint slice update: %+v", obj)
+		e.update(slice)
+	}
+}
+
+// OnDelete ensures processing of endpoint slice objects that are deleted
+func (e *endpointSlice) OnDelete(obj interface{}) {
+	e.logger.Debugf("Watcher endpoint slice delete: %+v", obj)
+	slice := obj.(*kubernetes.EndpointSlice)
+
+	e.slicesLock.Lock()
+	known, found := e.slices[slice.UID]
+	delete(e.slices, slice.UID)
+	e.slicesLock.Unlock()
+
+	if !found {
+		known = slice
+	}
+	time.AfterFunc(e.config.CleanupTimeout, func() { e.emit(known, "stop") })
+}
+
+// update stops the endpoints of the last known state of the slice and starts
+// the current ones
+func (e *endpointSlice) update(slice *kubernetes.EndpointSlice) {
+	e.slicesLock.Lock()
+	defer e.slicesLock.Unlock()
+
+	if known, found := e.slices[slice.UID]; found {
+		e.emit(known, "stop")
+	}
+	e.slices[slice.UID] = slice
+	e.emit(slice, "start")
+}
+
+// resyncService emits stop and start events for the endpoints of a service
+func (e *endpointSlice) resyncService(svc *kubernetes.Service) {
+	e.slicesLock.Lock()
+	defer e.slicesLock.Unlock()
+
+	for _, slice := range e.slices {
+		if slice.Namespace == svc.Namespace && slice.Labels[discoveryv1beta1.LabelServiceName] == svc.Name {
+			e.emit(slice, "stop")
+			e.emit(slice, "start")
+		}
+	}
+}
+
+// GenerateHints creates hints needed for hints builder
+func (e *endpointSlice) GenerateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	ev := bus.Event{}
+	var kubeMeta common.MapStr
+
+	annotations := make(common.MapStr, 0)
+	rawMeta, ok := event["kubernetes"]
+	if ok {
+		kubeMeta = rawMeta.(common.MapStr)
+		// The builder base config can configure any of the field values of kubernetes if need be.
+		ev["kubernetes"] = kubeMeta
+		if rawAnn, ok := kubeMeta["annotations"]; ok {
+			anns, _ := rawAnn.(common.MapStr)
+			if len(anns) != 0 {
+				annotations = anns.Clone()
+			}
+		}
+
+		// Look at all the namespace level default annotations and do a merge with priority going to the service annotations.
+		if rawNsAnn, ok := kubeMeta["namespace_annotations"]; ok {
+			nsAnn, _ := rawNsAnn.(common.MapStr)
+			if len(nsAnn) != 0 {
+				mergeNamespaceAnnotations(annotations, nsAnn)
+			}
+		}
+	}
+	if host, ok := event["host"]; ok {
+		ev["host"] = host
+	}
+	if port, ok := event["port"]; ok {
+		ev["port"] = port
+	}
+
+	hints := builder.GenerateHints(annotations, "", e.config.Prefix)
+	e.logger.Debugf("Generated hints %+v", hints)
+
+	if len(hints) != 0 {
+		ev["hints"] = hints
+	}
+
+	e.logger.Debugf("Generated builder event %+v", ev)
+
+	return ev
+}
+
+// Start starts the eventer
+func (e *endpointSlice) Start() error {
+	if e.namespaceWatcher != nil {
+		if err := e.namespaceWatcher.Start(); err != nil {
+			return err
+		}
+	}
+	if err := e.serviceWatcher.Start(); err != nil {
+		return err
+	}
+	return e.watcher.Start()
+}
+
+// Stop stops the eventer
+func (e *endpointSlice) Stop() {
+	e.watcher.Stop()
+	e.serviceWatcher.Stop()
+
+	if e.namespaceWatcher != nil {
+		e.namespaceWatcher.Stop()
+	}
+}
+
+// Resync emits the events of all the known endpoint slices again, the provider only
+// restarts the configs that changed
+func (e *endpointSlice) Resync() {
+	e.slicesLock.Lock()
+	defer e.slicesLock.Unlock()
+
+	for _, slice := range e.slices {
+		e.emit(slice, resyncFlag)
+	}
+}
+
+// emit publishes an event for each address and port of the endpoints of the
+// slice. Only ready endpoints are started, all of them are stopped.
+func (e *endpointSlice) emit(slice *kubernetes.EndpointSlice, flag string) {
+	meta := e.metagen.Generate(slice)
+
+	// Endpoints get the annotations of their service, so hints can be set
+	// once for all the endpoints of a service, and of the slice itself.
+	annotations := common.MapStr{}
+	if svcName := slice.Labels[discoveryv1beta1.LabelServiceName]; svcName != "" && e.serviceWatcher != nil {
+		if rawSvc, ok, err := e.serviceWatcher.Store().GetByKey(slice.Namespace + "/" + svcName); ok && err == nil {
+			if svc, ok := rawSvc.(*kubernetes.Service); ok {
+				for k, v := range svc.GetAnnotations() {
+					safemapstr.Put(annotations, k, v)
+				}
+			}
+		}
+	}
+	for k, v := range slice.GetAnnotations() {
+		safemapstr.Put(annotations, k, v)
+	}
+
+	var nsAnns common.MapStr
+	if e.namespaceWatcher != nil {
+		if rawNs, ok, err := e.namespaceWatcher.Store().GetByKey(slice.Namespace); ok && err == nil {
+			if namespace, ok := rawNs.(*kubernetes.Namespace); ok {
+				nsAnns = common.MapStr{}
+				for k, v := range namespace.GetAnnotations() {
+					safemapstr.Put(nsAnns, k, v)
+				}
+			}
+		}
+	}
+
+	for _, endpoint := range slice.Endpoints {
+		// Endpoints without the ready condition are considered ready
+		if flag != "stop" && endpoint.Conditions.Ready != nil && !*endpoint.Conditions.Ready {
+			continue
+		}
+
+		endpointMeta := meta.Clone()
+		if ref := endpoint.TargetRef; ref != nil && ref.Kind == "Pod" {
+			safemapstr.Put(endpointMeta, "pod.name", ref.Name)
+			safemapstr.Put(endpointMeta, "pod.uid", string(ref.UID))
+		}
+		if node, ok := endpoint.Topology[v1.LabelHostname]; ok {
+			safemapstr.Put(endpointMeta, "node.name", node)
+		}
+		if endpoint.Hostname != nil {
+			safemapstr.Put(endpointMeta, "endpoint.hostname", *endpoint.Hostname)
+		}
+
+		kubemeta := endpointMeta.Clone()
+		// Pass annotations to all events so that it can be used in templating and by annotation builders.
+		kubemeta["annotations"] = annotations.Clone()
+		if nsAnns != nil {
+			kubemeta["namespace_annotations"] = nsAnns.Clone()
+		}
+
+		for _, address := range endpoint.Addresses {
+			eventID := fmt.Sprintf("%s.%s", slice.GetObjectMeta().GetUID(), address)
+			event := bus.Event{
+				"provider":   e.uuid,
+				"id":         eventID,
+				flag:         true,
+				"host":       address,
+				"kubernetes": kubemeta,
+				"meta": common.MapStr{
+					"kubernetes": endpointMeta,
+				},
+			}
+
+			// Without ports, or with ports matching all the ports of the
+			// endpoints, a single event is emitted for the address
+			ports := endpointSlicePorts(slice)
+			if len(ports) == 0 {
+				e.publish(event)
+				continue
+			}
+			for _, port := range ports {
+				portEvent := bus.Event{}
+				for k, v := range event {
+					portEvent[k] = v
+				}
+				portEvent["port"] = port
+				e.publish(portEvent)
+			}
+		}
+	}
+}
+
+// endpointSlicePorts returns the ports of the endpoints of a slice, nil if
+// they have no ports or if the slice matches all of their ports.
+func endpointSlicePorts(slice *kubernetes.EndpointSlice) []int {
+	var ports []int
+	for _, port := range slice.Ports {
+		if port.Port == nil {
+			return nil
+		}
+		ports = append(ports, int(*port.Port))
+	}
+	return ports
+}
@@ -0,0 +1,272 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestGenerateHints_EndpointSlice(t *testing.T) {
+	event := bus.Event{
+		"host": "10.0.0.1",
+		"port": 6379,
+		"kubernetes": common.MapStr{
+			"annotations": getNestedAnnotations(common.MapStr{
+				"co.elastic.metrics/module": "redis",
+				"not.to.include":            "true",
+			}),
+			"namespace_annotations": getNestedAnnotations(common.MapStr{
+				"co.elastic.metrics/module": "prometheus",
+				"co.elastic.metrics/period": "10s",
+			}),
+		},
+	}
+
+	cfg := defaultConfig()
+	e := endpointSlice{
+		config: cfg,
+		logger: logp.NewLogger("kubernetes.endpointslice"),
+	}
+
+	hints := e.GenerateHints(event)
+	assert.Equal(t, "10.0.0.1", hints["host"])
+	assert.Equal(t, 6379, hints["port"])
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "redis",
+			"period": "10s",
+		},
+	}, hints["hints"])
+}
+
+func TestEmitEvent_EndpointSlice(t *testing.T) {
+	name := "redis-x7k2p"
+	namespace := "default"
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	podUID := "7ba8ea6a-7f35-4fb1-b0e8-ed4eb4cd38f2"
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ready := true
+	notReady := false
+	port := int32(6379)
+	slice := &kubernetes.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			UID:       types.UID(uid),
+			Namespace: namespace,
+			Labels: map[string]string{
+				discoveryv1beta1.LabelServiceName: "redis",
+			},
+			Annotations: map[string]string{},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "EndpointSlice",
+			APIVersion: "discovery.k8s.io/v1beta1",
+		},
+		Endpoints: []discoveryv1beta1.Endpoint{
+			{
+				Addresses:  []string{"10.0.0.1"},
+				Conditions: discoveryv1beta1.EndpointConditions{Ready: &ready},
+				TargetRef: &v1.ObjectReference{
+					Kind: "Pod",
+					Name: "redis-0",
+					UID:  types.UID(podUID),
+				},
+				Topology: map[string]string{
+					v1.LabelHostname: "node-1",
+				},
+			},
+			{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1beta1.EndpointConditions{Ready: &notReady},
+			},
+		},
+		Ports: []discoveryv1beta1.EndpointPort{
+			{Port: &port},
+		},
+	}
+
+	expectedMeta := common.MapStr{
+		"endpointslice": common.MapStr{
+			"name": name,
+			"uid":  uid,
+		},
+		"service": common.MapStr{
+			"name": "redis",
+		},
+		"labels": common.MapStr{
+			"kubernetes_io/service-name": "redis",
+		},
+		"namespace": namespace,
+		"pod": common.MapStr{
+			"name": "redis-0",
+			"uid":  podUID,
+		},
+		"node": common.MapStr{
+			"name": "node-1",
+		},
+	}
+	expectedKubernetes := expectedMeta.Clone()
+	expectedKubernetes["annotations"] = common.MapStr{}
+
+	tests := []struct {
+		Message  string
+		Flag     string
+		Expected []bus.Event
+	}{
+		{
+			Message: "Test endpoint slice start",
+			Flag:    "start",
+			Expected: []bus.Event{
+				{
+					"start":      true,
+					"host":       "10.0.0.1",
+					"id":         uid + ".10.0.0.1",
+					"provider":   UUID,
+					"port":       6379,
+					"kubernetes": expectedKubernetes,
+					"meta": common.MapStr{
+						"kubernetes": expectedMeta,
+					},
+					"config": []*common.Config{},
+				},
+			},
+		},
+		{
+			Message: "Test endpoint slice stop",
+			Flag:    "stop",
+			Expected: []bus.Event{
+				{
+					"stop":       true,
+					"host":       "10.0.0.1",
+					"id":         uid + ".10.0.0.1",
+					"provider":   UUID,
+					"port":       6379,
+					"kubernetes": expectedKubernetes,
+					"meta": common.MapStr{
+						"kubernetes": expectedMeta,
+					},
+					"config": []*common.Config{},
+				},
+				{
+					"stop":     true,
+					"host":     "10.0.0.2",
+					"id":       uid + ".10.0.0.2",
+					"provider": UUID,
+					"port":     6379,
+					"kubernetes": common.MapStr{
+						"endpointslice": expectedMeta["endpointslice"],
+						"service":       expectedMeta["service"],
+						"labels":        expectedMeta["labels"],
+						"namespace":     namespace,
+						"annotations":   common.MapStr{},
+					},
+					"meta": common.MapStr{
+						"kubernetes": common.MapStr{
+							"endpointslice": expectedMeta["endpointslice"],
+							"service":       expectedMeta["service"],
+							"labels":        expectedMeta["labels"],
+							"namespace":     namespace,
+						},
+					},
+					"config": []*common.Config{},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Message, func(t *testing.T) {
+			mapper, err := template.NewConfigMapper(nil, nil, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			cfg := common.MustNewConfigFrom(map[string]interface{}{
+				"labels.dedot": true,
+			})
+			metaGen := metadata.NewEndpointSliceMetadataGenerator(cfg, nil, nil)
+
+			p := &Provider{
+				config:    defaultConfig(),
+				bus:       bus.New(logp.NewLogger("bus"), "test"),
+				templates: mapper,
+				logger:    logp.NewLogger("kubernetes"),
+			}
+
+			e := &endpointSlice{
+				metagen: metaGen,
+				config:  defaultConfig(),
+				publish: p.publish,
+				uuid:    UUID,
+				logger:  logp.NewLogger("kubernetes.endpointslice"),
+			}
+
+			p.eventer = e
+
+			listener := p.bus.Subscribe()
+
+			e.emit(slice, test.Flag)
+
+			for _, expected := range test.Expected {
+				select {
+				case event := <-listener.Events():
+					assert.Equal(t, expected, event, test.Message)
+				case <-time.After(2 * time.Second):
+					t.Fatal("Timeout while waiting for event")
+				}
+			}
+
+			select {
+			case event := <-listener.Events():
+				t.Fatalf("Unexpected event: %+v", event)
+			default:
+			}
+		})
+	}
+}
+
+func TestEndpointSlicePorts(t *testing.T) {
+	http, https := int32(80), int32(443)
+
+	assert.Nil(t, endpointSlicePorts(&kubernetes.EndpointSlice{}))
+	assert.Nil(t, endpointSlicePorts(&kubernetes.EndpointSlice{
+		Ports: []discoveryv1beta1.EndpointPort{{Port: &http}, {Port: nil}},
+	}))
+	assert.Equal(t, []int{80, 443}, endpointSlicePorts(&kubernetes.EndpointSlice{
+		Ports: []discoveryv1beta1.EndpointPort{{Port: &http}, {Port: &https}},
+	}))
+}
@@ -20,21 +20,37 @@
 package kubernetes
 
 import (
+	"context"
 	"fmt"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/leaderelection"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover"
 	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/common/bus"
 	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
 	"github.com/elastic/beats/v7/libbeat/common/kubernetes/k8skeystore"
 	"github.com/elastic/beats/v7/libbeat/keystore"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
 
+// leaders is the number of kubernetes providers holding the leader lease.
+var leaders = monitoring.NewInt(nil, "libbeat.autodiscover.leader")
+
+// resyncFlag flags the events emitted again for known resources by Resync,
+// they are published as start events only if their configs changed.
+const resyncFlag = "resync"
+
 func init() {
 	autodiscover.Registry.AddProvider("kubernetes", AutodiscoverBuilder)
 }
@@ -45,6 +61,7 @@ type Eventer interface {
 	GenerateHints(event bus.Event) bus.Event
 	Start() error
 	Stop()
+	Resync()
 }
 
 // Provider implements autodiscover provider for docker containers
@@ -56,6 +73,18 @@ type Provider struct {
 	appenders autodiscover.Appenders
 	logger    *logp.Logger
 	eventer   Eventer
+
+	leaderElector      *leaderelection.LeaderElector
+	isLeader           atomic.Bool
+	stopLeaderElection context.CancelFunc
+
+	sharder      *sharder
+	stopSharding context.CancelFunc
+
+	// published holds the hashes of the configs published for each
+	// resource, so resyncs only restart the configs that changed.
+	publishMutex sync.Mutex
+	published    map[string][]uint64
 }
 
 // AutodiscoverBuilder builds and returns an autodiscover provider
@@ -110,6 +139,14 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore
 		p.eventer, err = NewNodeEventer(uuid, c, client, p.publish)
 	case "service":
 		p.eventer, err = NewServiceEventer(uuid, c, client, p.publish)
+	case "endpointslice":
+		p.eventer, err = NewEndpointSliceEventer(uuid, c, client, p.publish)
+	case "custom":
+		var dynamicClient dynamic.Interface
+		dynamicClient, err = kubernetes.GetKubernetesDynamicClient(config.KubeConfig)
+		if err == nil {
+			p.eventer, err = NewCustomResourceEventer(uuid, c, client, dynamicClient, p.publish)
+		}
 	default:
 		return nil, fmt.Errorf("unsupported autodiscover resource %s", config.Resource)
 	}
@@ -118,6 +155,17 @@ func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore
 		return nil, errWrap(err)
 	}
 
+	if config.LeaderElection.Enabled {
+		p.leaderElector, err = newLeaderElector(uuid, config.LeaderElection, client, p.onLeaderChange)
+		if err != nil {
+			return nil, errWrap(err)
+		}
+	}
+
+	if config.Sharding.Enabled {
+		p.sharder = newSharder(uuid, config.Sharding, client, logger, p.onShardingChange)
+	}
+
 	return p, nil
 }
 
@@ -126,25 +174,104 @@ func (p *Provider) Start() {
 	if err := p.eventer.Start(); err != nil {
 		p.logger.Errorf("Error starting kubernetes autodiscover provider: %s", err)
 	}
+
+	if p.leaderElector != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.stopLeaderElection = cancel
+		go func() {
+			// Run returns when the leadership is lost, keep running for a new election
+			for ctx.Err() == nil {
+				p.leaderElector.Run(ctx)
+			}
+		}()
+	}
+
+	if p.sharder != nil {
+		ctx, cancel := context.WithCancel(context.Background())
+		p.stopSharding = cancel
+		go p.sharder.Run(ctx)
+	}
 }
 
 // Stop signals the stop channel to force the watch loop routine to stop.
 func (p *Provider) Stop() {
+	if p.stopLeaderElection != nil {
+		p.stopLeaderElection()
+	}
+	if p.stopSharding != nil {
+		p.stopSharding()
+	}
 	p.eventer.Stop()
 }
 
+// onLeaderChange updates the leadership status, and emits the events of all
+// the known resources again, so configs that depend on it are updated.
+// Only the configs that changed are restarted.
+func (p *Provider) onLeaderChange(leader bool) {
+	if p.isLeader.Swap(leader) == leader {
+		return
+	}
+
+	if leader {
+		p.logger.Info("Kubernetes autodiscover provider is now the leader")
+		leaders.Inc()
+	} else {
+		p.logger.Info("Kubernetes autodiscover provider is no longer the leader")
+		leaders.Dec()
+	}
+	p.eventer.Resync()
+}
+
+// onShardingChange emits the events of all the known resources again when
+// the members of the shard group change, so the configs of the resources
+// assigned to other members are stopped, and the ones of the resources now
+// assigned to this Beat are started. The configs of the resources still
+// assigned to this Beat keep running.
+func (p *Provider) onShardingChange() {
+	p.eventer.Resync()
+}
+
 // String returns a description of kubernetes autodiscover provider.
 func (p *Provider) String() string {
 	return "kubernetes"
 }
 
 func (p *Provider) publish(event bus.Event) {
+	p.publishMutex.Lock()
+	defer p.publishMutex.Unlock()
+
+	_, resync := event[resyncFlag]
+	if resync {
+		delete(event, resyncFlag)
+		event["start"] = true
+	}
+	id, _ := event["id"].(string)
+
+	// Stop events are always published, so configs are stopped when resources
+	// are assigned to other members of the shard group
+	if _, start := event["start"]; start && p.sharder != nil {
+		if !p.sharder.Owns(id) {
+			p.logger.Debugf("Resource %s is assigned to another member of the shard group", id)
+			if _, published := p.published[id]; resync && published {
+				p.publishStop(id, event)
+			}
+			return
+		}
+	}
+
+	if p.leaderElector != nil {
+		event["leader"] = p.isLeader.Load()
+	}
+
 	// Try to match a config
 	if config := p.templates.GetConfig(event); config != nil {
 		event["config"] = config
 	} else {
 		// If there isn't a default template then attempt to use builders
 		e := p.eventer.GenerateHints(event)
+		if leader, ok := event["leader"]; ok {
+			e["leader"] = leader
+		}
 		if config := p.builders.GetConfig(e); config != nil {
 			event["config"] = config
 		}
@@ -152,5 +279,94 @@ func (p *Provider) publish(event bus.Event) {
 
 	// Call all appenders to append any extra configuration
 	p.appenders.Append(event)
+
+	if _, stop := event["stop"]; stop {
+		delete(p.published, id)
+		p.bus.Publish(event)
+		return
+	}
+
+	hashes := configHashes(event)
+	if resync {
+		previous, published := p.published[id]
+		if equalHashes(previous, hashes) {
+			return
+		}
+		if published {
+			p.publishStop(id, event)
+		}
+	}
+	if p.published == nil {
+		p.published = map[string][]uint64{}
+	}
+	p.published[id] = hashes
 	p.bus.Publish(event)
 }
+
+// publishStop publishes a stop event for a resource whose configs were
+// published, from the start event emitted for it by a resync.
+func (p *Provider) publishStop(id string, event bus.Event) {
+	stop := bus.Event{}
+	for k, v := range event {
+		if k != "start" && k != "config" {
+			stop[k] = v
+		}
+	}
+	stop["stop"] = true
+	delete(p.published, id)
+	p.bus.Publish(stop)
+}
+
+// configHashes returns the sorted hashes of the configs of an event.
+func configHashes(event bus.Event) []uint64 {
+	configs, _ := event["config"].([]*common.Config)
+	hashes := make([]uint64, 0, len(configs))
+	for _, c := range configs {
+		if hash, err := cfgfile.HashConfig(c); err == nil {
+			hashes = append(hashes, hash)
+		}
+	}
+	sort.Slice(hashes, func(i, j int) bool { return hashes[i] < hashes[j] })
+	return hashes
+}
+
+func equalHashes(a, b []uint64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeNamespaceAnnotations adds to annotations the namespace level defaults
+// they don't override. Lists of processors set in annotations replace the ones
+// set in the namespace, instead of being merged item by item.
+func mergeNamespaceAnnotations(annotations, nsAnnotations common.MapStr) {
+	defaults := nsAnnotations.Clone()
+	dropOverriddenProcessors(defaults, annotations)
+	annotations.DeepUpdateNoOverwrite(defaults)
+}
+
+func dropOverriddenProcessors(defaults, annotations common.MapStr) {
+	for k, v := range defaults {
+		override, ok := annotations[k]
+		if !ok {
+			continue
+		}
+		if strings.HasSuffix(k, "/processors") {
+			delete(defaults, k)
+			continue
+		}
+		nested, ok := v.(common.MapStr)
+		if !ok {
+			continue
+		}
+		if overrideNested, ok := override.(common.MapStr); ok {
+			dropOverriddenProcessors(nested, overrideNested)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/gofrs/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+)
+
+// newLeaderElector creates an elector of a leader between all the Beats
+// using the same lease. onChange is called with the new leadership status
+// every time this instance starts or stops being the leader.
+func newLeaderElector(uuid uuid.UUID, config leaderElectionConfig, client k8s.Interface, onChange func(bool)) (*leaderelection.LeaderElector, error) {
+	namespace := leaseNamespace(config.Namespace)
+	id := leaseIdentity(uuid)
+
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      config.Lease,
+			Namespace: namespace,
+		},
+		Client: client.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: id,
+		},
+	}
+
+	return leaderelection.NewLeaderElector(leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   config.LeaseDuration,
+		RenewDeadline:   config.RenewDeadline,
+		RetryPeriod:     config.RetryPeriod,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: func(ctx context.Context) {
+				onChange(true)
+			},
+			OnStoppedLeading: func() {
+				onChange(false)
+			},
+		},
+	})
+}
+
+// leaseNamespace returns the namespace of leases, by default the namespace
+// of the Beat.
+func leaseNamespace(namespace string) string {
+	if namespace != "" {
+		return namespace
+	}
+	namespace, err := kubernetes.InClusterNamespace()
+	if err != nil {
+		return "default"
+	}
+	return namespace
+}
+
+// leaseIdentity returns the identity of this Beat in leases.
+func leaseIdentity(uuid uuid.UUID) string {
+	id := uuid.String()
+	if hostname, err := os.Hostname(); err == nil {
+		id = fmt.Sprintf("%s-%s", hostname, id)
+	}
+	return id
+}
@@ -64,8 +64,10 @@ func NewNodeEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface, pu
 	logger.Debugf("Initializing a new Kubernetes watcher using node: %v", config.Node)
 
 	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Node{}, kubernetes.WatchOptions{
-		SyncTimeout: config.SyncPeriod,
-		Node:        config.Node,
+		SyncTimeout:   config.SyncPeriod,
+		Node:          config.Node,
+		LabelSelector: config.LabelSelector,
+		FieldSelector: config.FieldSelector,
 	}, nil)
 
 	if err != nil {
@@ -137,6 +139,9 @@ func (n *node) GenerateHints(event bus.Event) bus.Event {
 	if port, ok := event["port"]; ok {
 		e["port"] = port
 	}
+	if ips, ok := event["ips"]; ok {
+		e["ips"] = ips
+	}
 
 	hints := builder.GenerateHints(annotations, "", n.config.Prefix)
 	n.logger.Debugf("Generated hints %+v", hints)
@@ -159,8 +164,22 @@ func (n *node) Stop() {
 	n.watcher.Stop()
 }
 
+// Resync emits the events of all the known nodes again, the provider only
+// restarts the configs that changed
+func (n *node) Resync() {
+	for _, obj := range n.watcher.Store().List() {
+		if node, ok := obj.(*kubernetes.Node); ok {
+			n.emit(node, resyncFlag)
+		}
+	}
+}
+
 func (n *node) emit(node *kubernetes.Node, flag string) {
-	host := getAddress(node)
+	var host string
+	addresses := getAddresses(node)
+	if len(addresses) > 0 {
+		host = addresses[0]
+	}
 
 	// If a node doesn't have an IP then dont monitor it
 	if host == "" && flag != "stop" {
@@ -187,23 +206,28 @@ func (n *node) emit(node *kubernetes.Node, flag string) {
 			"kubernetes": meta,
 		},
 	}
+	// All the addresses of dual-stack nodes, so builders can choose a family.
+	if len(addresses) > 1 {
+		event["ips"] = addresses
+	}
 	n.publish(event)
 }
 
-func getAddress(node *kubernetes.Node) string {
-	for _, address := range node.Status.Addresses {
-		if address.Type == v1.NodeExternalIP && address.Address != "" {
-			return address.Address
+// getAddresses returns the external IPs of the node, or its internal IPs if it
+// has no external IP. Dual-stack nodes have an address of each family.
+func getAddresses(node *kubernetes.Node) []string {
+	for _, addressType := range []v1.NodeAddressType{v1.NodeExternalIP, v1.NodeInternalIP} {
+		var addresses []string
+		for _, address := range node.Status.Addresses {
+			if address.Type == addressType && address.Address != "" {
+				addresses = append(addresses, address.Address)
+			}
 		}
-	}
-
-	for _, address := range node.Status.Addresses {
-		if address.Type == v1.NodeInternalIP && address.Address != "" {
-			return address.Address
+		if len(addresses) > 0 {
+			return addresses
 		}
 	}
-
-	return ""
+	return nil
 }
 
 func isNodeReady(node *kubernetes.Node) bool {
@@ -177,6 +177,54 @@ func TestEmitEvent_Node(t *testing.T) {
 				"config": []*common.Config{},
 			},
 		},
+		{
+			Message: "Test dual-stack node start",
+			Flag:    "start",
+			Node: &kubernetes.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					UID:         types.UID(uid),
+					Labels:      map[string]string{},
+					Annotations: map[string]string{},
+				},
+				TypeMeta: typeMeta,
+				Status: v1.NodeStatus{
+					Addresses: []v1.NodeAddress{
+						{
+							Type:    v1.NodeInternalIP,
+							Address: nodeIP,
+						},
+						{
+							Type:    v1.NodeInternalIP,
+							Address: "fd00::1",
+						},
+					},
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "192.168.0.1",
+				"ips":      []string{"192.168.0.1", "fd00::1"},
+				"id":       uid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"node": common.MapStr{
+						"name": "metricbeat",
+						"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+					},
+					"annotations": common.MapStr{},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"node": common.MapStr{
+							"name": "metricbeat",
+							"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
 		{
 			Message: "Test service without host",
 			Flag:    "start",
@@ -19,6 +19,7 @@ package kubernetes
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/gofrs/uuid"
@@ -44,6 +45,10 @@ type pod struct {
 	nodeWatcher      kubernetes.Watcher
 	namespaceWatcher kubernetes.Watcher
 	namespaceStore   cache.Store
+
+	// Last known annotations of each namespace, only accessed by the
+	// namespace watcher handlers.
+	namespaceAnnotations map[string]map[string]string
 }
 
 // NewPodEventer creates an eventer that can discover and process pod objects
@@ -67,9 +72,11 @@ func NewPodEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface, pub
 	logger.Debugf("Initializing a new Kubernetes watcher using node: %v", config.Node)
 
 	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Pod{}, kubernetes.WatchOptions{
-		SyncTimeout: config.SyncPeriod,
-		Node:        config.Node,
-		Namespace:   config.Namespace,
+		SyncTimeout:   config.SyncPeriod,
+		Node:          config.Node,
+		Namespace:     config.Namespace,
+		LabelSelector: config.LabelSelector,
+		FieldSelector: config.FieldSelector,
 	}, nil)
 	if err != nil {
 		return nil, fmt.Errorf("couldn't create watcher for %T due to error %+v", &kubernetes.Pod{}, err)
@@ -119,6 +126,18 @@ func NewPodEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface, pub
 	}
 
 	watcher.AddEventHandler(p)
+	if namespaceWatcher != nil {
+		p.namespaceAnnotations = map[string]map[string]string{}
+		namespaceWatcher.AddEventHandler(kubernetes.ResourceEventHandlerFuncs{
+			AddFunc:    p.onNamespaceUpdate,
+			UpdateFunc: p.onNamespaceUpdate,
+			DeleteFunc: func(obj interface{}) {
+				if ns, ok := obj.(*kubernetes.Namespace); ok {
+					delete(p.namespaceAnnotations, ns.Name)
+				}
+			},
+		})
+	}
 	return p, nil
 }
 
@@ -153,6 +172,30 @@ func (p *pod) OnDelete(obj interface{}) {
 	time.AfterFunc(p.config.CleanupTimeout, func() { p.emit(obj.(*kubernetes.Pod), "stop") })
 }
 
+// onNamespaceUpdate recreates the resources associated to the pods of a
+// namespace when its annotations change, so namespace level default hints are
+// applied to the pods that are already running.
+func (p *pod) onNamespaceUpdate(obj interface{}) {
+	ns, ok := obj.(*kubernetes.Namespace)
+	if !ok {
+		return
+	}
+	annotations := ns.GetAnnotations()
+	previous, known := p.namespaceAnnotations[ns.Name]
+	p.namespaceAnnotations[ns.Name] = annotations
+	if !known || (len(previous) == 0 && len(annotations) == 0) || reflect.DeepEqual(previous, annotations) {
+		return
+	}
+
+	p.logger.Debugf("Annotations of namespace %s changed, updating its pods", ns.Name)
+	for _, obj := range p.watcher.Store().List() {
+		if pod, ok := obj.(*kubernetes.Pod); ok && pod.Namespace == ns.Name {
+			p.emit(pod, "stop")
+			p.emit(pod, "start")
+		}
+	}
+}
+
 func (p *pod) GenerateHints(event bus.Event) bus.Event {
 	// Try to build a config with enabled builders. Send a provider agnostic payload.
 	// Builders are Beat specific.
@@ -176,7 +219,7 @@ func (p *pod) GenerateHints(event bus.Event) bus.Event {
 		if rawNsAnn, ok := kubeMeta["namespace_annotations"]; ok {
 			nsAnn, _ := rawNsAnn.(common.MapStr)
 			if len(nsAnn) != 0 {
-				annotations.DeepUpdateNoOverwrite(nsAnn)
+				mergeNamespaceAnnotations(annotations, nsAnn)
 			}
 		}
 	}
@@ -186,6 +229,12 @@ func (p *pod) GenerateHints(event bus.Event) bus.Event {
 	if port, ok := event["port"]; ok {
 		e["port"] = port
 	}
+	if ips, ok := event["ips"]; ok {
+		e["ips"] = ips
+	}
+	if ports, ok := event["ports"]; ok {
+		e["ports"] = ports
+	}
 
 	if rawCont, ok := kubeMeta["container"]; ok {
 		container = rawCont.(common.MapStr)
@@ -209,6 +258,53 @@ func (p *pod) GenerateHints(event bus.Event) bus.Event {
 	return e
 }
 
+// PodHintsEvents returns the events that would be passed to the builders when
+// the given pod object starts, with the hints generated from its annotations. It is
+// intended to debug hints offline, it doesn't access the Kubernetes API, so
+// metadata and annotations of other resources, like nodes or namespaces, are
+// not included. When leader election is enabled, the events are generated as
+// if this instance was the leader.
+func PodHintsEvents(cfg *common.Config, obj *kubernetes.Pod) ([]bus.Event, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+
+	var events []bus.Event
+	p := newOfflinePod(config, cfg)
+	p.publish = func(event bus.Event) {
+		events = append(events, p.hintsEvent(event))
+	}
+	p.emit(obj, "start")
+	return events, nil
+}
+
+// EventHints returns the event that would be passed to the builders for an
+// event published by the pod eventer, like PodHintsEvents does.
+func EventHints(cfg *common.Config, event bus.Event) ([]bus.Event, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, err
+	}
+	return []bus.Event{newOfflinePod(config, cfg).hintsEvent(event)}, nil
+}
+
+func newOfflinePod(config *Config, cfg *common.Config) *pod {
+	return &pod{
+		config:  config,
+		metagen: metadata.NewPodMetadataGenerator(cfg, nil, nil, nil),
+		logger:  logp.NewLogger("autodiscover.pod"),
+	}
+}
+
+func (p *pod) hintsEvent(event bus.Event) bus.Event {
+	e := p.GenerateHints(event)
+	if p.config.LeaderElection.Enabled {
+		e["leader"] = true
+	}
+	return e
+}
+
 // Start starts the eventer
 func (p *pod) Start() error {
 	if p.nodeWatcher != nil {
@@ -240,6 +336,16 @@ func (p *pod) Stop() {
 	}
 }
 
+// Resync emits the events of all the known pods again, the provider only
+// restarts the configs that changed
+func (p *pod) Resync() {
+	for _, obj := range p.watcher.Store().List() {
+		if pod, ok := obj.(*kubernetes.Pod); ok {
+			p.emit(pod, resyncFlag)
+		}
+	}
+}
+
 func (p *pod) emit(pod *kubernetes.Pod, flag string) {
 	// Emit events for all containers
 	p.emitEvents(pod, flag, pod.Spec.Containers, pod.Status.ContainerStatuses)
@@ -252,6 +358,12 @@ func (p *pod) emitEvents(pod *kubernetes.Pod, flag string, containers []kubernet
 	containerstatuses []kubernetes.PodContainerStatus) {
 	host := pod.Status.PodIP
 
+	// All the addresses of dual-stack pods, so builders can choose a family.
+	var ips []string
+	for _, podIP := range pod.Status.PodIPs {
+		ips = append(ips, podIP.IP)
+	}
+
 	// If the container doesn't exist in the runtime or its network
 	// is not configured, it won't have an IP. Skip it as we cannot
 	// generate configs without host, and an update will arrive when
@@ -321,6 +433,14 @@ func (p *pod) emitEvents(pod *kubernetes.Pod, flag string, containers []kubernet
 			}
 		}
 
+		// Named ports of the container, so builders can refer to them by name.
+		ports := common.MapStr{}
+		for _, port := range c.Ports {
+			if port.Name != "" {
+				ports[port.Name] = port.ContainerPort
+			}
+		}
+
 		// Without this check there would be overlapping configurations with and without ports.
 		if len(c.Ports) == 0 {
 			event := bus.Event{
@@ -333,6 +453,9 @@ func (p *pod) emitEvents(pod *kubernetes.Pod, flag string, containers []kubernet
 					"kubernetes": meta,
 				},
 			}
+			if len(ips) > 1 {
+				event["ips"] = ips
+			}
 			p.publish(event)
 		}
 
@@ -348,6 +471,12 @@ func (p *pod) emitEvents(pod *kubernetes.Pod, flag string, containers []kubernet
 					"kubernetes": meta,
 				},
 			}
+			if len(ports) != 0 {
+				event["ports"] = ports
+			}
+			if len(ips) > 1 {
+				event["ips"] = ips
+			}
 			p.publish(event)
 		}
 	}
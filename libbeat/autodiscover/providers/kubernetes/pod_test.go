@@ -26,6 +26,8 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/leaderelection"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
 	"github.com/elastic/beats/v7/libbeat/common"
@@ -317,6 +319,63 @@ func TestGenerateHints(t *testing.T) {
 				},
 			},
 		},
+		// Processors set in pod annotations replace the ones set in the namespace
+		{
+			event: bus.Event{
+				"kubernetes": common.MapStr{
+					"annotations": getNestedAnnotations(common.MapStr{
+						"co.elastic.metrics/module":                     "prometheus",
+						"co.elastic.metrics/processors.1.add_tags.tags": "pod",
+					}),
+					"namespace_annotations": getNestedAnnotations(common.MapStr{
+						"co.elastic.metrics/period":                          "10s",
+						"co.elastic.metrics/processors.1.drop_fields.fields": "ns",
+						"co.elastic.metrics/processors.2.add_tags.tags":      "ns",
+					}),
+					"namespace": "ns",
+				},
+			},
+			result: bus.Event{
+				"kubernetes": common.MapStr{
+					"annotations": getNestedAnnotations(common.MapStr{
+						"co.elastic.metrics/module":                     "prometheus",
+						"co.elastic.metrics/processors.1.add_tags.tags": "pod",
+					}),
+					"namespace_annotations": getNestedAnnotations(common.MapStr{
+						"co.elastic.metrics/period":                          "10s",
+						"co.elastic.metrics/processors.1.drop_fields.fields": "ns",
+						"co.elastic.metrics/processors.2.add_tags.tags":      "ns",
+					}),
+					"namespace": "ns",
+				},
+				"hints": common.MapStr{
+					"metrics": common.MapStr{
+						"module": "prometheus",
+						"period": "10s",
+						"processors": common.MapStr{
+							"1": common.MapStr{
+								"add_tags": common.MapStr{
+									"tags": "pod",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		// Addresses of dual-stack pods are passed to builders
+		{
+			event: bus.Event{
+				"host": "10.0.0.4",
+				"ips":  []string{"10.0.0.4", "fd00::4"},
+				"port": 9090,
+			},
+			result: bus.Event{
+				"host": "10.0.0.4",
+				"ips":  []string{"10.0.0.4", "fd00::4"},
+				"port": 9090,
+			},
+		},
 	}
 
 	cfg := defaultConfig()
@@ -428,6 +487,87 @@ func TestEmitEvent(t *testing.T) {
 				"config": []*common.Config{},
 			},
 		},
+		{
+			Message: "Test pod with named ports",
+			Flag:    "start",
+			Pod: &kubernetes.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					UID:         types.UID(uid),
+					Namespace:   namespace,
+					Labels:      map[string]string{},
+					Annotations: map[string]string{},
+				},
+				TypeMeta: typeMeta,
+				Status: v1.PodStatus{
+					PodIP: podIP,
+					ContainerStatuses: []kubernetes.PodContainerStatus{
+						{
+							Name:        name,
+							ContainerID: containerID,
+							State: v1.ContainerState{
+								Running: &v1.ContainerStateRunning{},
+							},
+						},
+					},
+				},
+				Spec: v1.PodSpec{
+					NodeName: node,
+					Containers: []kubernetes.Container{
+						{
+							Image: containerImage,
+							Name:  name,
+							Ports: []v1.ContainerPort{
+								{
+									Name:          "metrics",
+									ContainerPort: 9090,
+								},
+							},
+						},
+					},
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "127.0.0.1",
+				"port":     int32(9090),
+				"ports":    common.MapStr{"metrics": int32(9090)},
+				"id":       cid,
+				"provider": UUID,
+				"kubernetes": common.MapStr{
+					"container": common.MapStr{
+						"id":      "foobar",
+						"name":    "filebeat",
+						"image":   "elastic/filebeat:6.3.0",
+						"runtime": "docker",
+					},
+					"pod": common.MapStr{
+						"name": "filebeat",
+						"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+					},
+					"node": common.MapStr{
+						"name": "node",
+					},
+					"namespace":   "default",
+					"annotations": common.MapStr{},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"namespace": "default",
+						"container": common.MapStr{
+							"name":  "filebeat",
+							"image": "elastic/filebeat:6.3.0",
+						}, "pod": common.MapStr{
+							"name": "filebeat",
+							"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+						}, "node": common.MapStr{
+							"name": "node",
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
 		{
 			Message: "Test pod without host",
 			Flag:    "start",
@@ -672,6 +812,76 @@ func TestEmitEvent(t *testing.T) {
 	}
 }
 
+func TestNamespaceAnnotationsUpdate(t *testing.T) {
+	newPod := func(name, namespace string) *kubernetes.Pod {
+		return &kubernetes.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				UID:       types.UID(name),
+				Namespace: namespace,
+			},
+			Status: v1.PodStatus{
+				PodIP: "127.0.0.1",
+				ContainerStatuses: []kubernetes.PodContainerStatus{
+					{
+						Name:        "app",
+						ContainerID: "docker://" + name,
+						State: v1.ContainerState{
+							Running: &v1.ContainerStateRunning{},
+						},
+					},
+				},
+			},
+			Spec: v1.PodSpec{
+				Containers: []kubernetes.Container{{Name: "app", Image: "app:1.0"}},
+			},
+		}
+	}
+	newNamespace := func(annotations map[string]string) *kubernetes.Namespace {
+		return &kubernetes.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: "ns", Annotations: annotations},
+		}
+	}
+
+	store := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	assert.NoError(t, store.Add(newPod("foo", "ns")))
+	assert.NoError(t, store.Add(newPod("bar", "other")))
+
+	var events []bus.Event
+	p := &pod{
+		config:               defaultConfig(),
+		metagen:              metadata.NewPodMetadataGenerator(common.NewConfig(), nil, nil, nil),
+		logger:               logp.NewLogger("kubernetes.pod"),
+		publish:              func(e bus.Event) { events = append(events, e) },
+		watcher:              &mockWatcher{store: store},
+		namespaceAnnotations: map[string]map[string]string{},
+	}
+
+	p.onNamespaceUpdate(newNamespace(map[string]string{"co.elastic.metrics/period": "10s"}))
+	assert.Empty(t, events, "pods are not updated when the namespace is discovered")
+
+	p.onNamespaceUpdate(newNamespace(map[string]string{"co.elastic.metrics/period": "10s"}))
+	assert.Empty(t, events, "pods are not updated if annotations don't change")
+
+	p.onNamespaceUpdate(newNamespace(map[string]string{"co.elastic.metrics/period": "1m"}))
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, true, events[0]["stop"])
+		assert.Equal(t, true, events[1]["start"])
+		for _, e := range events {
+			assert.Equal(t, "foo.app", e["id"])
+		}
+	}
+}
+
+type mockWatcher struct {
+	store cache.Store
+}
+
+func (m *mockWatcher) Start() error                                    { return nil }
+func (m *mockWatcher) Stop()                                           {}
+func (m *mockWatcher) AddEventHandler(kubernetes.ResourceEventHandler) {}
+func (m *mockWatcher) Store() cache.Store                              { return m.store }
+
 func getNestedAnnotations(in common.MapStr) common.MapStr {
 	out := common.MapStr{}
 
@@ -680,3 +890,101 @@ func getNestedAnnotations(in common.MapStr) common.MapStr {
 	}
 	return out
 }
+
+func TestResyncRestartsChangedConfigs(t *testing.T) {
+	UUID, err := uuid.NewV4()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var settings struct {
+		Templates template.MapperSettings `config:"templates"`
+	}
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{"equals.leader": true},
+			"config":    []map[string]interface{}{{"type": "log"}},
+		}},
+	}).Unpack(&settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mapper, err := template.NewConfigMapper(settings.Templates, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Provider{
+		config:        defaultConfig(),
+		bus:           bus.New(logp.NewLogger("bus"), "test"),
+		templates:     mapper,
+		logger:        logp.NewLogger("kubernetes"),
+		leaderElector: &leaderelection.LeaderElector{},
+	}
+	pod := &pod{
+		metagen: metadata.NewPodMetadataGenerator(common.NewConfig(), nil, nil, nil),
+		config:  defaultConfig(),
+		publish: p.publish,
+		uuid:    UUID,
+		logger:  logp.NewLogger("kubernetes.pod"),
+	}
+	p.eventer = pod
+
+	k8sPod := &kubernetes.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "filebeat",
+			UID:       types.UID("005f3b90-4b9d-12f8-acf0-31020a840133"),
+			Namespace: "default",
+		},
+		TypeMeta: metav1.TypeMeta{Kind: "Pod", APIVersion: "v1"},
+		Status: v1.PodStatus{
+			PodIP: "127.0.0.1",
+			ContainerStatuses: []kubernetes.PodContainerStatus{{
+				Name:        "filebeat",
+				ContainerID: "docker://foobar",
+				State:       v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			}},
+		},
+		Spec: v1.PodSpec{
+			NodeName:   "node",
+			Containers: []kubernetes.Container{{Image: "elastic/filebeat:6.3.0", Name: "filebeat"}},
+		},
+	}
+
+	listener := p.bus.Subscribe()
+	defer listener.Stop()
+	flags := func() []string {
+		var flags []string
+		for {
+			select {
+			case event := <-listener.Events():
+				for _, flag := range []string{"start", "stop"} {
+					if _, ok := event[flag]; ok {
+						flags = append(flags, flag)
+					}
+				}
+			case <-time.After(100 * time.Millisecond):
+				return flags
+			}
+		}
+	}
+
+	pod.emit(k8sPod, "start")
+	assert.Equal(t, []string{"start"}, flags())
+
+	// Configs don't depend on the leadership yet
+	pod.emit(k8sPod, resyncFlag)
+	assert.Empty(t, flags())
+
+	// The template only matches on the leader
+	p.isLeader.Store(true)
+	pod.emit(k8sPod, resyncFlag)
+	assert.Equal(t, []string{"stop", "start"}, flags())
+
+	pod.emit(k8sPod, resyncFlag)
+	assert.Empty(t, flags())
+
+	pod.emit(k8sPod, "stop")
+	assert.Equal(t, []string{"stop"}, flags())
+	assert.Empty(t, p.published)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/kubernetes/metadata"
 
 	"github.com/gofrs/uuid"
+	v1 "k8s.io/api/core/v1"
 	k8s "k8s.io/client-go/kubernetes"
 
 	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
@@ -55,8 +56,10 @@ func NewServiceEventer(uuid uuid.UUID, cfg *common.Config, client k8s.Interface,
 	}
 
 	watcher, err := kubernetes.NewWatcher(client, &kubernetes.Service{}, kubernetes.WatchOptions{
-		SyncTimeout: config.SyncPeriod,
-		Namespace:   config.Namespace,
+		SyncTimeout:   config.SyncPeriod,
+		Namespace:     config.Namespace,
+		LabelSelector: config.LabelSelector,
+		FieldSelector: config.FieldSelector,
 	}, nil)
 
 	if err != nil {
@@ -143,7 +146,7 @@ func (s *service) GenerateHints(event bus.Event) bus.Event {
 		if rawNsAnn, ok := kubeMeta["namespace_annotations"]; ok {
 			nsAnn, _ := rawNsAnn.(common.MapStr)
 			if len(nsAnn) != 0 {
-				annotations.DeepUpdateNoOverwrite(nsAnn)
+				mergeNamespaceAnnotations(annotations, nsAnn)
 			}
 		}
 	}
@@ -185,8 +188,18 @@ func (s *service) Stop() {
 	}
 }
 
+// Resync emits the events of all the known services again, the provider only
+// restarts the configs that changed
+func (s *service) Resync() {
+	for _, obj := range s.watcher.Store().List() {
+		if svc, ok := obj.(*kubernetes.Service); ok {
+			s.emit(svc, resyncFlag)
+		}
+	}
+}
+
 func (s *service) emit(svc *kubernetes.Service, flag string) {
-	host := svc.Spec.ClusterIP
+	host := getServiceHost(svc)
 
 	// If a service doesn't have an IP then dont monitor it
 	if host == "" && flag != "stop" {
@@ -233,3 +246,18 @@ func (s *service) emit(svc *kubernetes.Service, flag string) {
 	}
 
 }
+
+// getServiceHost returns the address to reach the service. Headless services
+// don't have a cluster IP and are reached by their DNS name, that resolves to
+// the addresses of their pods, and external name services by their external
+// name.
+func getServiceHost(svc *kubernetes.Service) string {
+	switch {
+	case svc.Spec.Type == v1.ServiceTypeExternalName:
+		return svc.Spec.ExternalName
+	case svc.Spec.ClusterIP == v1.ClusterIPNone:
+		return fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+	default:
+		return svc.Spec.ClusterIP
+	}
+}
@@ -300,6 +300,103 @@ func TestEmitEvent_Service(t *testing.T) {
 				"config": []*common.Config{},
 			},
 		},
+		{
+			Message: "Test headless service start",
+			Flag:    "start",
+			Service: &kubernetes.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					UID:         types.UID(uid),
+					Namespace:   namespace,
+					Labels:      map[string]string{},
+					Annotations: map[string]string{},
+				},
+				TypeMeta: typeMeta,
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					},
+					ClusterIP: v1.ClusterIPNone,
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "metricbeat.default.svc",
+				"id":       uid,
+				"provider": UUID,
+				"port":     8080,
+				"kubernetes": common.MapStr{
+					"service": common.MapStr{
+						"name": "metricbeat",
+						"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+					},
+					"namespace":   "default",
+					"annotations": common.MapStr{},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"namespace": "default",
+						"service": common.MapStr{
+							"name": "metricbeat",
+							"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
+		{
+			Message: "Test external name service start",
+			Flag:    "start",
+			Service: &kubernetes.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        name,
+					UID:         types.UID(uid),
+					Namespace:   namespace,
+					Labels:      map[string]string{},
+					Annotations: map[string]string{},
+				},
+				TypeMeta: typeMeta,
+				Spec: v1.ServiceSpec{
+					Ports: []v1.ServicePort{
+						{
+							Name: "http",
+							Port: 8080,
+						},
+					},
+					Type:         v1.ServiceTypeExternalName,
+					ExternalName: "metrics.example.com",
+				},
+			},
+			Expected: bus.Event{
+				"start":    true,
+				"host":     "metrics.example.com",
+				"id":       uid,
+				"provider": UUID,
+				"port":     8080,
+				"kubernetes": common.MapStr{
+					"service": common.MapStr{
+						"name": "metricbeat",
+						"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+					},
+					"namespace":   "default",
+					"annotations": common.MapStr{},
+				},
+				"meta": common.MapStr{
+					"kubernetes": common.MapStr{
+						"namespace": "default",
+						"service": common.MapStr{
+							"name": "metricbeat",
+							"uid":  "005f3b90-4b9d-12f8-acf0-31020a840133",
+						},
+					},
+				},
+				"config": []*common.Config{},
+			},
+		},
 		{
 			Message: "Test service without host",
 			Flag:    "start",
@@ -0,0 +1,243 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package kubernetes
+
+import (
+	"context"
+	"hash/fnv"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8s "k8s.io/client-go/kubernetes"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// shardGroupLabel is the label of the leases of the members of a group.
+const shardGroupLabel = "beats.elastic.co/shard-group"
+
+// sharder splits the discovered resources between all the Beats in the same
+// group. Each Beat keeps renewing a lease of its own, and the members of the
+// group are the Beats whose leases are not expired. Resources are assigned
+// to members with rendezvous hashing, so when a Beat joins or leaves the
+// group only the resources assigned to it are moved to other members.
+type sharder struct {
+	name      string
+	namespace string
+	config    shardingConfig
+	client    k8s.Interface
+	logger    *logp.Logger
+
+	// onChange is called every time the members of the group change.
+	onChange func()
+
+	mutex   sync.RWMutex
+	members []string
+
+	lastRenewal time.Time
+}
+
+func newSharder(uuid uuid.UUID, config shardingConfig, client k8s.Interface, logger *logp.Logger, onChange func()) *sharder {
+	return &sharder{
+		name:      leaseName(config.Group, leaseIdentity(uuid)),
+		namespace: leaseNamespace(config.Namespace),
+		config:    config,
+		client:    client,
+		logger:    logger,
+		onChange:  onChange,
+	}
+}
+
+// leaseName builds a valid lease name for a member of a group.
+func leaseName(group, id string) string {
+	name := []rune(strings.ToLower(group + "-" + id))
+	for i, r := range name {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '.' {
+			name[i] = '-'
+		}
+	}
+	if len(name) > 253 {
+		name = name[len(name)-253:]
+	}
+	return strings.Trim(string(name), "-.")
+}
+
+// Run keeps the lease of this Beat renewed and the members of the group
+// updated until the context is done, then it leaves the group.
+func (s *sharder) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.config.RenewPeriod)
+	defer ticker.Stop()
+
+	for {
+		s.sync(ctx)
+
+		select {
+		case <-ctx.Done():
+			s.leave()
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Owns checks if a resource is assigned to this Beat. No resource is
+// assigned to it while it is not a member of the group.
+func (s *sharder) Owns(key string) bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	var owner string
+	var max uint64
+	for _, member := range s.members {
+		if weight := shardWeight(member, key); owner == "" || weight > max {
+			owner, max = member, weight
+		}
+	}
+	return owner == s.name
+}
+
+// shardWeight is the weight of a member for a resource in rendezvous hashing.
+func shardWeight(member, key string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(member))
+	h.Write([]byte{0})
+	h.Write([]byte(key))
+
+	// Finalizer of MurmurHash3, to spread the weights of similar keys
+	w := h.Sum64()
+	w ^= w >> 33
+	w *= 0xff51afd7ed558ccd
+	w ^= w >> 33
+	w *= 0xc4ceb9fe1a85ec53
+	w ^= w >> 33
+	return w
+}
+
+// sync renews the lease of this Beat and updates the members of the group.
+// If the lease cannot be renewed before it expires, this Beat stops being a
+// member, as the other members don't consider it anymore.
+func (s *sharder) sync(ctx context.Context) {
+	now := time.Now()
+	if err := s.renew(ctx, now); err != nil {
+		s.logger.Errorf("Error renewing lease %s/%s of shard group %s: %v", s.namespace, s.name, s.config.Group, err)
+		if now.Sub(s.lastRenewal) > s.config.LeaseDuration {
+			s.setMembers(nil)
+		}
+		return
+	}
+	s.lastRenewal = now
+
+	members, err := s.listMembers(ctx, now)
+	if err != nil {
+		s.logger.Errorf("Error listing members of shard group %s: %v", s.config.Group, err)
+		return
+	}
+	s.setMembers(members)
+}
+
+func (s *sharder) renew(ctx context.Context, now time.Time) error {
+	leases := s.client.CoordinationV1().Leases(s.namespace)
+	renewTime := metav1.NewMicroTime(now)
+	duration := int32(s.config.LeaseDuration / time.Second)
+	if duration < 1 {
+		duration = 1
+	}
+
+	lease, err := leases.Get(ctx, s.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = leases.Create(ctx, &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      s.name,
+				Namespace: s.namespace,
+				Labels:    map[string]string{shardGroupLabel: s.config.Group},
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       &s.name,
+				LeaseDurationSeconds: &duration,
+				AcquireTime:          &renewTime,
+				RenewTime:            &renewTime,
+			},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	lease.Spec.LeaseDurationSeconds = &duration
+	lease.Spec.RenewTime = &renewTime
+	_, err = leases.Update(ctx, lease, metav1.UpdateOptions{})
+	return err
+}
+
+// listMembers returns the sorted names of the leases of the group that are
+// not expired.
+func (s *sharder) listMembers(ctx context.Context, now time.Time) ([]string, error) {
+	list, err := s.client.CoordinationV1().Leases(s.namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: shardGroupLabel + "=" + s.config.Group,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := []string{s.name}
+	for _, lease := range list.Items {
+		if lease.Name == s.name || lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+			continue
+		}
+		expiration := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+		if expiration.After(now) {
+			members = append(members, lease.Name)
+		}
+	}
+	sort.Strings(members)
+	return members, nil
+}
+
+func (s *sharder) setMembers(members []string) {
+	s.mutex.Lock()
+	changed := !reflect.DeepEqual(s.members, members)
+	s.members = members
+	s.mutex.Unlock()
+
+	if changed {
+		s.logger.Infof("Members of shard group %s changed to %v", s.config.Group, members)
+		s.onChange()
+	}
+}
+
+// leave deletes the lease of this Beat, so the other members take its
+// resources without waiting for the lease to expire.
+func (s *sharder) leave() {
+	ctx, cancel := context.WithTimeout(context.Background(), s.config.RenewPeriod)
+	defer cancel()
+
+	err := s.client.CoordinationV1().Leases(s.namespace).Delete(ctx, s.name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		s.logger.Errorf("Error deleting lease %s/%s of shard group %s: %v", s.namespace, s.name, s.config.Group, err)
+	}
+}
@@ -0,0 +1,153 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func newTestSharder(name string, client *k8sfake.Clientset, onChange func()) *sharder {
+	config := defaultConfig().Sharding
+	return &sharder{
+		name:      name,
+		namespace: "default",
+		config:    config,
+		client:    client,
+		logger:    logp.NewLogger("autodiscover"),
+		onChange:  onChange,
+	}
+}
+
+func TestShardingOwnership(t *testing.T) {
+	members := []string{"a", "b", "c"}
+	sharders := map[string]*sharder{}
+	for _, name := range members {
+		sharders[name] = &sharder{name: name, members: members}
+	}
+
+	owners := map[string]string{}
+	assigned := map[string]int{}
+	for i := 0; i < 300; i++ {
+		key := fmt.Sprintf("resource-%d", i)
+		for name, s := range sharders {
+			if s.Owns(key) {
+				assert.Empty(t, owners[key], "resource %s assigned to more than one member", key)
+				owners[key] = name
+				assigned[name]++
+			}
+		}
+		assert.NotEmpty(t, owners[key], "resource %s not assigned", key)
+	}
+	for _, name := range members {
+		assert.True(t, assigned[name] > 50, "member %s has only %d resources", name, assigned[name])
+	}
+
+	// When a member leaves, only its resources are moved
+	remaining := []string{"a", "b"}
+	for _, name := range remaining {
+		sharders[name].members = remaining
+	}
+	for key, owner := range owners {
+		if owner == "c" {
+			assert.True(t, sharders["a"].Owns(key) != sharders["b"].Owns(key))
+			continue
+		}
+		assert.True(t, sharders[owner].Owns(key), "resource %s moved from %s", key, owner)
+	}
+
+	// Nothing is assigned to a Beat out of the group
+	assert.False(t, (&sharder{name: "a"}).Owns("resource-0"))
+}
+
+func TestShardingMembership(t *testing.T) {
+	client := k8sfake.NewSimpleClientset()
+	ctx := context.Background()
+
+	expired := metav1.NewMicroTime(time.Now().Add(-time.Hour))
+	duration := int32(15)
+	_, err := client.CoordinationV1().Leases("default").Create(ctx, &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "expired",
+			Labels: map[string]string{shardGroupLabel: "beats-autodiscover-shard"},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			LeaseDurationSeconds: &duration,
+			RenewTime:            &expired,
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	changes := 0
+	a := newTestSharder("a", client, func() { changes++ })
+	b := newTestSharder("b", client, func() {})
+
+	a.sync(ctx)
+	assert.Equal(t, []string{"a"}, a.members)
+	assert.Equal(t, 1, changes)
+
+	b.sync(ctx)
+	a.sync(ctx)
+	assert.Equal(t, []string{"a", "b"}, a.members)
+	assert.Equal(t, []string{"a", "b"}, b.members)
+	assert.Equal(t, 2, changes)
+
+	// No changes if the members are the same
+	a.sync(ctx)
+	assert.Equal(t, 2, changes)
+
+	b.leave()
+	a.sync(ctx)
+	assert.Equal(t, []string{"a"}, a.members)
+	assert.Equal(t, 3, changes)
+
+	lease, err := client.CoordinationV1().Leases("default").Get(ctx, "a", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "beats-autodiscover-shard", lease.Labels[shardGroupLabel])
+	assert.Equal(t, "a", *lease.Spec.HolderIdentity)
+}
+
+func TestShardingLeaseName(t *testing.T) {
+	assert.Equal(t, "beats-shard-host-1.example-abc", leaseName("beats-shard", "Host_1.example-abc"))
+}
+
+func TestShardingConfig(t *testing.T) {
+	cfg := common.MustNewConfigFrom(common.MapStr{
+		"hints.enabled": true,
+		"sharding": common.MapStr{
+			"enabled":        true,
+			"lease_duration": "5s",
+			"renew_period":   "10s",
+		},
+	})
+	config := defaultConfig()
+	assert.Error(t, cfg.Unpack(&config))
+}
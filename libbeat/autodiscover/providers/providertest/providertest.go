@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package providertest contains helpers to test autodiscover providers.
+package providertest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Unpack unpacks into config the settings of a provider, and a template that
+// launches a log input for the events where field is equal to value.
+func Unpack(t testing.TB, config interface{}, settings common.MapStr, field, value string) {
+	t.Helper()
+
+	c := common.MapStr{
+		"templates": []common.MapStr{{
+			"condition": common.MapStr{
+				"equals": common.MapStr{field: value},
+			},
+			"config": []common.MapStr{{"type": "log"}},
+		}},
+	}
+	c.DeepUpdate(settings)
+	require.NoError(t, common.MustNewConfigFrom(c).Unpack(config))
+}
+
+// Bus returns b, or a new bus if it is nil.
+func Bus(b bus.Bus) bus.Bus {
+	if b == nil {
+		return bus.New(logp.L(), "test")
+	}
+	return b
+}
+
+// Events waits for n events published in the bus of the listener, and fails
+// the test if they are not published in 5 seconds.
+func Events(t testing.TB, listener bus.Listener, n int) []bus.Event {
+	t.Helper()
+
+	var events []bus.Event
+	timeout := time.After(5 * time.Second)
+	for len(events) < n {
+		select {
+		case event := <-listener.Events():
+			events = append(events, event)
+		case <-timeout:
+			t.Fatalf("timeout waiting for events, got %d of %d", len(events), n)
+		}
+	}
+	return events
+}
@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package servicelist
+
+import (
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Config is the configuration shared by the providers of service lists, it is
+// inlined in the configuration of each provider.
+type Config struct {
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+	Dedot     bool                    `config:"labels.dedot"`
+}
+
+// DefaultConfig returns the default shared configuration.
+func DefaultConfig() Config {
+	return Config{
+		Prefix: "co.elastic",
+		Dedot:  true,
+	}
+}
@@ -0,0 +1,197 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package servicelist implements the autodiscover providers of lists of
+// services, like the ones defined in files or printed by commands. Providers
+// only differ in the source of the services.
+package servicelist
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Provider implements autodiscover provider for a list of services
+type Provider struct {
+	name      string
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+}
+
+// NewProvider returns a provider named name for the services of source. The
+// source is queried every period, and cancelled after timeout if it is set.
+func NewProvider(
+	name string,
+	bus bus.Bus,
+	uuid uuid.UUID,
+	config *Config,
+	source Source,
+	period, timeout time.Duration,
+	keystore keystore.Keystore,
+) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		name:      name,
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover." + name),
+	}
+	p.watcher = newWatcher(source, period, timeout, p.onStart, p.onStop, p.logger)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return p.name
+}
+
+func (p *Provider) onStart(s *Service) {
+	p.publish(p.serviceEvent(s, "start"))
+}
+
+func (p *Provider) onStop(s *Service) {
+	p.publish(p.serviceEvent(s, "stop"))
+}
+
+func (p *Provider) serviceEvent(s *Service, flag string) bus.Event {
+	event := bus.Event{
+		"provider":           p.uuid,
+		"id":                 s.Key(),
+		flag:                 true,
+		"service_definition": serviceMetadata(s, false),
+		"meta": common.MapStr{
+			"service_definition": serviceMetadata(s, p.config.Dedot),
+		},
+	}
+	if s.Host != "" {
+		event["host"] = s.Host
+	}
+	if s.Port != 0 {
+		event["port"] = s.Port
+	}
+	return event
+}
+
+// serviceMetadata returns the metadata of the service, with the labels
+// dedotted if requested.
+func serviceMetadata(s *Service, dedot bool) common.MapStr {
+	meta := common.MapStr{
+		"id":   s.ID,
+		"name": s.Name,
+	}
+	if s.File != "" {
+		meta["file"] = s.File
+	}
+	if len(s.Labels) > 0 {
+		meta["labels"] = labelMap(s.Labels, dedot)
+	}
+	return meta
+}
+
+func labelMap(labels map[string]string, dedot bool) common.MapStr {
+	labelMap := common.MapStr{}
+	for k, v := range labels {
+		if dedot {
+			labelMap.Put(common.DeDot(k), v)
+		} else {
+			safemapstr.Put(labelMap, k, v)
+		}
+	}
+	return labelMap
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "port", "service_definition"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	labels := common.MapStr{}
+	if value, err := common.MapStr(event).GetValue("service_definition.labels"); err == nil {
+		labels = value.(common.MapStr)
+	}
+	e["hints"] = builder.GenerateHints(labels, "", p.config.Prefix)
+	return e
+}
@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package servicelist
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+var redis = Service{
+	Name: "redis",
+	Host: "10.0.0.5",
+	Port: 6379,
+	Labels: map[string]string{
+		"env":                       "production",
+		"co.elastic.metrics/module": "redis",
+		"co.elastic.metrics/period": "30s",
+	},
+	File: "redis.yml",
+}
+
+func TestValidate(t *testing.T) {
+	services, err := Validate([]Service{redis, {ID: "web-1", Name: "web"}, {ID: "web-2", Name: "web"}})
+	require.NoError(t, err)
+	require.Len(t, services, 3)
+	assert.Equal(t, "redis", services[0].ID)
+	assert.Equal(t, "redis.yml:redis", services[0].Key())
+	assert.Equal(t, "web-1", services[1].Key())
+
+	for name, list := range map[string][]Service{
+		"no name":       {{Host: "10.0.0.5"}},
+		"duplicated id": {{Name: "web"}, {Name: "web"}},
+		"invalid port":  {{Name: "web", Port: 70000}},
+	} {
+		_, err := Validate(list)
+		assert.Error(t, err, name)
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	var services []*Service
+	var sourceErr error
+	var deadline bool
+	source := func(ctx context.Context) ([]*Service, error) {
+		_, deadline = ctx.Deadline()
+		return services, sourceErr
+	}
+
+	var started, stopped []string
+	w := newWatcher(source, 0, time.Second,
+		func(s *Service) { started = append(started, s.Key()) },
+		func(s *Service) { stopped = append(stopped, s.Key()) },
+		logp.NewLogger("test"),
+	)
+
+	services, err := Validate([]Service{redis, {Name: "web"}})
+	require.NoError(t, err)
+	require.NoError(t, w.once())
+	assert.True(t, deadline)
+	sort.Strings(started)
+	assert.Equal(t, []string{"redis.yml:redis", "web"}, started)
+	assert.Empty(t, stopped)
+
+	// Services are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 2)
+
+	// Known services are kept when the source fails.
+	sourceErr = errors.New("inventory unavailable")
+	assert.Error(t, w.once())
+	assert.Len(t, w.services, 2)
+	assert.Empty(t, stopped)
+	sourceErr = nil
+
+	// Updated services are stopped and started again, missing services are
+	// stopped.
+	services = []*Service{{ID: "redis", Name: "redis", Port: 6380, File: "redis.yml"}}
+	started = nil
+	require.NoError(t, w.once())
+	sort.Strings(stopped)
+	assert.Equal(t, []string{"redis.yml:redis"}, started)
+	assert.Equal(t, []string{"redis.yml:redis", "web"}, stopped)
+	assert.Equal(t, 6380, w.services["redis.yml:redis"].Port)
+
+	services = nil
+	stopped = nil
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{"redis.yml:redis"}, stopped)
+	assert.Empty(t, w.services)
+
+	// Sources are not cancelled if there is no timeout.
+	w.timeout = 0
+	require.NoError(t, w.once())
+	assert.False(t, deadline)
+}
+
+func TestServiceEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	services, err := Validate([]Service{redis})
+	require.NoError(t, err)
+
+	event := p.serviceEvent(services[0], "start")
+	assert.Equal(t, "redis.yml:redis", event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "10.0.0.5", event["host"])
+	assert.Equal(t, 6379, event["port"])
+	assert.Equal(t, common.MapStr{
+		"id":   "redis",
+		"name": "redis",
+		"file": "redis.yml",
+		"labels": common.MapStr{
+			"env": "production",
+			"co":  common.MapStr{"elastic": common.MapStr{"metrics/module": "redis", "metrics/period": "30s"}},
+		},
+	}, event["service_definition"])
+	assert.Equal(t, common.MapStr{
+		"service_definition": common.MapStr{
+			"id":   "redis",
+			"name": "redis",
+			"file": "redis.yml",
+			"labels": common.MapStr{
+				"env":                       "production",
+				"co_elastic_metrics/module": "redis",
+				"co_elastic_metrics/period": "30s",
+			},
+		},
+	}, event["meta"])
+
+	event = p.serviceEvent(&Service{ID: "web", Name: "web"}, "stop")
+	assert.Equal(t, "web", event["id"])
+	assert.NotContains(t, event, "host")
+	assert.NotContains(t, event, "port")
+	assert.NotContains(t, event["service_definition"], "file")
+	assert.NotContains(t, event["service_definition"], "labels")
+}
+
+func TestEmit(t *testing.T) {
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+	p := newTestProvider(t, b)
+
+	p.onStart(&Service{ID: "web-1", Name: "web"})
+	p.onStop(&redis)
+	events := providertest.Events(t, listener, 2)
+	assert.Equal(t, true, events[0]["start"])
+	assert.Len(t, events[0]["config"], 1)
+	assert.Equal(t, true, events[1]["stop"])
+	assert.Empty(t, events[1]["config"])
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	hints := p.generateHints(p.serviceEvent(&redis, "start"))
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "redis",
+			"period": "30s",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.0.5", hints["host"])
+	assert.Equal(t, 6379, hints["port"])
+	assert.Contains(t, hints, "service_definition")
+}
+
+func TestNewProvider(t *testing.T) {
+	config := DefaultConfig()
+	_, err := NewProvider("test", bus.New(logp.L(), "test"), uuid.Nil, &config, nil, time.Second, 0, nil)
+	assert.Error(t, err, "providers need templates or hints")
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := DefaultConfig()
+	providertest.Unpack(t, &config, nil, "service_definition.name", "web")
+
+	p, err := NewProvider("test", providertest.Bus(b), uuid.Nil, &config, nil, time.Second, 0, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "test", p.String())
+	return p
+}
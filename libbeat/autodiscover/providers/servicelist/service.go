@@ -0,0 +1,69 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package servicelist
+
+import (
+	"fmt"
+)
+
+// Service is a service of a list, as defined in a file or printed by a
+// command.
+type Service struct {
+	ID     string            `yaml:"id" json:"id"`
+	Name   string            `yaml:"name" json:"name"`
+	Host   string            `yaml:"host" json:"host"`
+	Port   int               `yaml:"port" json:"port"`
+	Labels map[string]string `yaml:"labels" json:"labels"`
+
+	// File defining the service, if any.
+	File string `yaml:"-" json:"-"`
+}
+
+// Key identifies the service among the services of all the lists of a
+// provider.
+func (s *Service) Key() string {
+	if s.File == "" {
+		return s.ID
+	}
+	return s.File + ":" + s.ID
+}
+
+// Validate checks a list of services and returns them. Services without ID
+// are identified by their name.
+func Validate(list []Service) ([]*Service, error) {
+	var services []*Service
+	ids := map[string]bool{}
+	for i := range list {
+		s := &list[i]
+		if s.Name == "" {
+			return nil, fmt.Errorf("service %d has no name", i)
+		}
+		if s.ID == "" {
+			s.ID = s.Name
+		}
+		if ids[s.ID] {
+			return nil, fmt.Errorf("duplicated service id %q", s.ID)
+		}
+		if s.Port < 0 || s.Port > 65535 {
+			return nil, fmt.Errorf("invalid port %d for service %q", s.Port, s.ID)
+		}
+		ids[s.ID] = true
+		services = append(services, s)
+	}
+	return services, nil
+}
@@ -0,0 +1,129 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package servicelist
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Source returns the current services of a provider. If it fails, the known
+// services are kept, so a failing source doesn't stop them.
+type Source func(ctx context.Context) ([]*Service, error)
+
+// watcher periodically gets the services from a source, and notifies when
+// services appear or disappear. Services whose definition changed are stopped
+// and started again, so their configurations are updated.
+type watcher struct {
+	source  Source
+	period  time.Duration
+	timeout time.Duration
+	onStart func(s *Service)
+	onStop  func(s *Service)
+
+	services map[string]*Service
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	closed chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(source Source, period, timeout time.Duration, onStart, onStop func(s *Service), logger *logp.Logger) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watcher{
+		source:   source,
+		period:   period,
+		timeout:  timeout,
+		onStart:  onStart,
+		onStop:   onStop,
+		services: map[string]*Service{},
+		ctx:      ctx,
+		cancel:   cancel,
+		closed:   make(chan struct{}),
+		logger:   logger,
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.closed
+}
+
+func (w *watcher) forever() {
+	defer close(w.closed)
+	for {
+		err := w.once()
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error(errors.Wrap(err, "error discovering services"))
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(w.period):
+		}
+	}
+}
+
+// once gets the services from the source and notifies the changes since the
+// last time. The source is cancelled after the timeout, if any.
+func (w *watcher) once() error {
+	ctx, cancel := w.ctx, context.CancelFunc(func() {})
+	if w.timeout > 0 {
+		ctx, cancel = context.WithTimeout(w.ctx, w.timeout)
+	}
+	defer cancel()
+
+	list, err := w.source(ctx)
+	if err != nil {
+		return err
+	}
+
+	services := map[string]*Service{}
+	for _, s := range list {
+		services[s.Key()] = s
+	}
+	w.logger.Debugf("found %d services for autodiscover", len(services))
+
+	for key, known := range w.services {
+		if s, found := services[key]; !found || !reflect.DeepEqual(known, s) {
+			delete(w.services, key)
+			w.onStop(known)
+		}
+	}
+	for key, s := range services {
+		if _, found := w.services[key]; !found {
+			w.services[key] = s
+			w.onStart(s)
+		}
+	}
+	return nil
+}
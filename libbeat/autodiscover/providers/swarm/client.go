@@ -0,0 +1,209 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package swarm
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/filters"
+	dockerswarm "github.com/docker/docker/api/types/swarm"
+	"github.com/docker/go-connections/tlsconfig"
+
+	"github.com/elastic/beats/v7/libbeat/common/docker"
+)
+
+// swarmClient is the subset of the Docker API used to discover tasks, it must
+// be served by a manager of the swarm.
+type swarmClient interface {
+	Info(ctx context.Context) (types.Info, error)
+	ServiceList(ctx context.Context, options types.ServiceListOptions) ([]dockerswarm.Service, error)
+	TaskList(ctx context.Context, options types.TaskListOptions) ([]dockerswarm.Task, error)
+}
+
+func newSwarmClient(config *Config) (swarmClient, error) {
+	var httpClient *http.Client
+	if config.TLS != nil {
+		options := tlsconfig.Options{
+			CAFile:   config.TLS.CA,
+			CertFile: config.TLS.Certificate,
+			KeyFile:  config.TLS.Key,
+		}
+
+		tlsc, err := tlsconfig.Client(options)
+		if err != nil {
+			return nil, err
+		}
+
+		httpClient = &http.Client{
+			Transport: &http.Transport{
+				TLSClientConfig: tlsc,
+			},
+		}
+	}
+	return docker.NewClient(config.Host, httpClient, nil)
+}
+
+// task is a running task of a swarm service.
+type task struct {
+	ID          string
+	Slot        int
+	NodeID      string
+	ContainerID string
+	Image       string
+	Labels      map[string]string
+	// Addresses of the task in the networks it is attached to, without their
+	// network mask.
+	Addresses []string
+
+	Service service
+}
+
+// service contains the details of a service used by its tasks.
+type service struct {
+	ID     string
+	Name   string
+	Mode   string
+	Labels map[string]string
+	// VirtualIPs are the addresses balancing the requests to the tasks of the
+	// service, without their network mask.
+	VirtualIPs []string
+	// Ports are the ports of the tasks exposed by the service.
+	Ports []uint32
+}
+
+// host returns the first address of the task.
+func (t *task) host() string {
+	if len(t.Addresses) > 0 {
+		return t.Addresses[0]
+	}
+	return ""
+}
+
+func newService(s dockerswarm.Service) service {
+	svc := service{
+		ID:     s.ID,
+		Name:   s.Spec.Name,
+		Labels: s.Spec.Labels,
+	}
+	switch {
+	case s.Spec.Mode.Global != nil:
+		svc.Mode = "global"
+	case s.Spec.Mode.Replicated != nil:
+		svc.Mode = "replicated"
+	}
+	for _, vip := range s.Endpoint.VirtualIPs {
+		if vip.Addr != "" {
+			svc.VirtualIPs = append(svc.VirtualIPs, stripMask(vip.Addr))
+		}
+	}
+	seen := map[uint32]bool{}
+	for _, port := range s.Endpoint.Ports {
+		if port.TargetPort != 0 && !seen[port.TargetPort] {
+			seen[port.TargetPort] = true
+			svc.Ports = append(svc.Ports, port.TargetPort)
+		}
+	}
+	return svc
+}
+
+func newTask(t dockerswarm.Task, svc service) *task {
+	tsk := &task{
+		ID:      t.ID,
+		Slot:    t.Slot,
+		NodeID:  t.NodeID,
+		Service: svc,
+	}
+	if t.Status.ContainerStatus != nil {
+		tsk.ContainerID = t.Status.ContainerStatus.ContainerID
+	}
+	if spec := t.Spec.ContainerSpec; spec != nil {
+		// Images are pinned to their digest by the managers, it is removed
+		// so it doesn't clutter the image name.
+		tsk.Image = strings.SplitN(spec.Image, "@", 2)[0]
+		tsk.Labels = spec.Labels
+	}
+	for _, attachment := range t.NetworksAttachments {
+		// Addresses in the ingress network are only used by the routing mesh.
+		if attachment.Network.Spec.Ingress {
+			continue
+		}
+		for _, address := range attachment.Addresses {
+			tsk.Addresses = append(tsk.Addresses, stripMask(address))
+		}
+	}
+	return tsk
+}
+
+// stripMask removes the network mask of an address in CIDR notation.
+func stripMask(address string) string {
+	return strings.SplitN(address, "/", 2)[0]
+}
+
+// listTasks returns the running tasks of the node, or of the whole swarm if
+// the node is empty, by ID.
+func listTasks(ctx context.Context, client swarmClient, node string, services []string) (map[string]*task, error) {
+	serviceFilters := filters.NewArgs()
+	for _, name := range services {
+		serviceFilters.Add("name", name)
+	}
+	serviceList, err := client.ServiceList(ctx, types.ServiceListOptions{Filters: serviceFilters})
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]service{}
+	for _, s := range serviceList {
+		// The name filter matches prefixes of names, the exact names are
+		// checked here.
+		if len(services) > 0 && !contains(services, s.Spec.Name) {
+			continue
+		}
+		byID[s.ID] = newService(s)
+	}
+
+	taskFilters := filters.NewArgs(filters.Arg("desired-state", string(dockerswarm.TaskStateRunning)))
+	if node != "" {
+		taskFilters.Add("node", node)
+	}
+	taskList, err := client.TaskList(ctx, types.TaskListOptions{Filters: taskFilters})
+	if err != nil {
+		return nil, err
+	}
+	tasks := map[string]*task{}
+	for _, t := range taskList {
+		svc, found := byID[t.ServiceID]
+		if !found || t.Status.State != dockerswarm.TaskStateRunning {
+			continue
+		}
+		tasks[t.ID] = newTask(t, svc)
+	}
+	return tasks, nil
+}
+
+func contains(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
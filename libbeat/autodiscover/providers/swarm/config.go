@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package swarm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/docker"
+)
+
+// Config for the docker_swarm autodiscover provider
+type Config struct {
+	Host string            `config:"host"`
+	TLS  *docker.TLSConfig `config:"ssl"`
+
+	// Scope is `node` to discover the tasks running in a node, or `cluster`
+	// to discover the tasks of all the nodes of the swarm.
+	Scope string `config:"scope"`
+	// Node is the ID or the hostname of the node discovered with the `node`
+	// scope, the node of the Docker daemon at Host by default.
+	Node string `config:"node"`
+	// Services limits the discovered tasks to the ones of these services.
+	Services []string `config:"services"`
+
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+	Dedot     bool                    `config:"labels.dedot"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Host:   "unix:///var/run/docker.sock",
+		Scope:  "node",
+		Period: 10 * time.Second,
+		Prefix: "co.elastic",
+		Dedot:  true,
+	}
+}
+
+// Validate ensures correctness of config
+func (c *Config) Validate() error {
+	if c.Scope != "node" && c.Scope != "cluster" {
+		return fmt.Errorf("invalid scope %q, must be node or cluster", c.Scope)
+	}
+	if c.Scope == "cluster" && c.Node != "" {
+		return fmt.Errorf("node cannot be set with the cluster scope")
+	}
+	return nil
+}
@@ -0,0 +1,272 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package swarm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("docker_swarm", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for the tasks of Docker Swarm services
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("docker_swarm autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up docker_swarm autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	client, err := newSwarmClient(config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	info, err := client.Info(ctx)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	// Only managers can list the services and tasks of the swarm.
+	if !info.Swarm.ControlAvailable {
+		return nil, errWrap(fmt.Errorf("the Docker daemon at %s is not a swarm manager", config.Host))
+	}
+
+	node := config.Node
+	if config.Scope == "node" && node == "" {
+		node = info.Swarm.NodeID
+	}
+
+	p, err := internalBuilder(bus, uuid, config, client, node, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client swarmClient, node string, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover.docker_swarm"),
+	}
+	p.watcher = newWatcher(client, node, config.Services, config.Period, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return "docker_swarm"
+}
+
+func (p *Provider) onStart(t *task) {
+	p.emit(t, "start")
+}
+
+func (p *Provider) onStop(t *task) {
+	p.emit(t, "stop")
+}
+
+func (p *Provider) emit(t *task, flag string) {
+	// Without this check there would be overlapping configurations with and without ports.
+	if len(t.Service.Ports) == 0 {
+		p.publish(p.taskEvent(t, flag))
+	}
+
+	// Emit an event for each port exposed by the service
+	for _, port := range t.Service.Ports {
+		event := p.taskEvent(t, flag)
+		event["port"] = port
+		p.publish(event)
+	}
+}
+
+func (p *Provider) taskEvent(t *task, flag string) bus.Event {
+	container := common.MapStr{
+		"id": t.ContainerID,
+		"image": common.MapStr{
+			"name": t.Image,
+		},
+		"labels": labelMap(t.Labels, false),
+	}
+	return bus.Event{
+		"provider":     p.uuid,
+		"id":           t.ID,
+		flag:           true,
+		"host":         t.host(),
+		"container":    container,
+		"docker_swarm": taskMetadata(t, false),
+		"meta": common.MapStr{
+			"container": common.MapStr{
+				"id": t.ContainerID,
+				"image": common.MapStr{
+					"name": t.Image,
+				},
+			},
+			"docker_swarm": taskMetadata(t, p.config.Dedot),
+		},
+	}
+}
+
+// taskMetadata returns the metadata of the task and its service, with the
+// labels dedotted if requested. Selectors are not dedotted.
+func taskMetadata(t *task, dedot bool) common.MapStr {
+	meta := common.MapStr{
+		"service": common.MapStr{
+			"id":     t.Service.ID,
+			"name":   t.Service.Name,
+			"labels": labelMap(t.Service.Labels, dedot),
+		},
+		"task": common.MapStr{
+			"id": t.ID,
+		},
+		"node": common.MapStr{
+			"id": t.NodeID,
+		},
+	}
+	if t.Service.Mode != "" {
+		meta.Put("service.mode", t.Service.Mode)
+	}
+	if len(t.Service.VirtualIPs) > 0 {
+		meta.Put("service.virtual_ips", t.Service.VirtualIPs)
+	}
+	// Tasks of global services don't have slots.
+	if t.Slot != 0 {
+		meta.Put("task.slot", t.Slot)
+	}
+	return meta
+}
+
+func labelMap(labels map[string]string, dedot bool) common.MapStr {
+	labelMap := common.MapStr{}
+	for k, v := range labels {
+		if dedot {
+			labelMap.Put(common.DeDot(k), v)
+		} else {
+			safemapstr.Put(labelMap, k, v)
+		}
+	}
+	return labelMap
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "port", "container", "docker_swarm"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	// Hints can be set as labels of the containers or of the services, the
+	// labels of the services take precedence.
+	labels := common.MapStr{}
+	if value, err := common.MapStr(event).GetValue("container.labels"); err == nil {
+		labels.DeepUpdate(value.(common.MapStr).Clone())
+	}
+	if value, err := common.MapStr(event).GetValue("docker_swarm.service.labels"); err == nil {
+		labels.DeepUpdate(value.(common.MapStr).Clone())
+	}
+	e["hints"] = builder.GenerateHints(labels, "", p.config.Prefix)
+	return e
+}
@@ -0,0 +1,371 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package swarm
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/docker/docker/api/types"
+	dockerswarm "github.com/docker/docker/api/types/swarm"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// fakeClient serves services and tasks applying the filters used by the
+// provider.
+type fakeClient struct {
+	services []dockerswarm.Service
+	tasks    []dockerswarm.Task
+}
+
+func (c *fakeClient) Info(ctx context.Context) (types.Info, error) {
+	return types.Info{}, nil
+}
+
+func (c *fakeClient) ServiceList(ctx context.Context, options types.ServiceListOptions) ([]dockerswarm.Service, error) {
+	var services []dockerswarm.Service
+	for _, s := range c.services {
+		if options.Filters.Len() == 0 || options.Filters.Match("name", s.Spec.Name) {
+			services = append(services, s)
+		}
+	}
+	return services, nil
+}
+
+func (c *fakeClient) TaskList(ctx context.Context, options types.TaskListOptions) ([]dockerswarm.Task, error) {
+	var tasks []dockerswarm.Task
+	for _, t := range c.tasks {
+		if !options.Filters.ExactMatch("desired-state", string(t.DesiredState)) {
+			continue
+		}
+		if options.Filters.Contains("node") && !options.Filters.ExactMatch("node", t.NodeID) {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+	return tasks, nil
+}
+
+var (
+	redisService = dockerswarm.Service{
+		ID: "service-redis",
+		Spec: dockerswarm.ServiceSpec{
+			Annotations: dockerswarm.Annotations{
+				Name: "redis",
+				Labels: map[string]string{
+					"co.elastic.metrics/module": "redis",
+					"co.elastic.metrics/period": "30s",
+				},
+			},
+			Mode: dockerswarm.ServiceMode{Replicated: &dockerswarm.ReplicatedService{}},
+		},
+		Endpoint: dockerswarm.Endpoint{
+			Ports: []dockerswarm.PortConfig{
+				{TargetPort: 6379, PublishedPort: 6379},
+			},
+			VirtualIPs: []dockerswarm.EndpointVirtualIP{
+				{NetworkID: "ingress", Addr: "10.0.0.2/24"},
+				{NetworkID: "backend", Addr: "10.0.1.2/24"},
+			},
+		},
+	}
+	redisTask = dockerswarm.Task{
+		ID:           "task-redis-1",
+		ServiceID:    "service-redis",
+		Slot:         1,
+		NodeID:       "node-1",
+		DesiredState: dockerswarm.TaskStateRunning,
+		Status: dockerswarm.TaskStatus{
+			State:           dockerswarm.TaskStateRunning,
+			ContainerStatus: &dockerswarm.ContainerStatus{ContainerID: "container-redis-1"},
+		},
+		Spec: dockerswarm.TaskSpec{
+			ContainerSpec: &dockerswarm.ContainerSpec{
+				Image: "redis:6@sha256:0123456789abcdef",
+				Labels: map[string]string{
+					"co.elastic.metrics/period": "10s",
+					"co.elastic.logs/enabled":   "false",
+				},
+			},
+		},
+		NetworksAttachments: []dockerswarm.NetworkAttachment{
+			{
+				Network: dockerswarm.Network{
+					ID:   "ingress",
+					Spec: dockerswarm.NetworkSpec{Ingress: true},
+				},
+				Addresses: []string{"10.0.0.5/24"},
+			},
+			{
+				Network:   dockerswarm.Network{ID: "backend"},
+				Addresses: []string{"10.0.1.5/24"},
+			},
+		},
+	}
+	webService = dockerswarm.Service{
+		ID: "service-web",
+		Spec: dockerswarm.ServiceSpec{
+			Annotations: dockerswarm.Annotations{Name: "web"},
+			Mode:        dockerswarm.ServiceMode{Global: &dockerswarm.GlobalService{}},
+		},
+	}
+	webTask = dockerswarm.Task{
+		ID:           "task-web-1",
+		ServiceID:    "service-web",
+		NodeID:       "node-2",
+		DesiredState: dockerswarm.TaskStateRunning,
+		Status:       dockerswarm.TaskStatus{State: dockerswarm.TaskStateRunning},
+	}
+)
+
+func TestListTasks(t *testing.T) {
+	shutdown := redisTask
+	shutdown.ID = "task-redis-old"
+	shutdown.DesiredState = dockerswarm.TaskStateShutdown
+	starting := redisTask
+	starting.ID = "task-redis-2"
+	starting.Slot = 2
+	starting.Status.State = dockerswarm.TaskStateStarting
+	client := &fakeClient{
+		services: []dockerswarm.Service{redisService, webService},
+		tasks:    []dockerswarm.Task{redisTask, shutdown, starting, webTask},
+	}
+
+	tasks, err := listTasks(context.Background(), client, "", nil)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, &task{
+		ID:          "task-redis-1",
+		Slot:        1,
+		NodeID:      "node-1",
+		ContainerID: "container-redis-1",
+		Image:       "redis:6",
+		Labels:      redisTask.Spec.ContainerSpec.Labels,
+		Addresses:   []string{"10.0.1.5"},
+		Service: service{
+			ID:         "service-redis",
+			Name:       "redis",
+			Mode:       "replicated",
+			Labels:     redisService.Spec.Labels,
+			VirtualIPs: []string{"10.0.0.2", "10.0.1.2"},
+			Ports:      []uint32{6379},
+		},
+	}, tasks["task-redis-1"])
+	assert.Equal(t, "global", tasks["task-web-1"].Service.Mode)
+	assert.Equal(t, "", tasks["task-web-1"].host())
+
+	tasks, err = listTasks(context.Background(), client, "node-2", nil)
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	assert.Contains(t, tasks, "task-web-1")
+
+	// Service names are matched exactly.
+	client.services = append(client.services, dockerswarm.Service{
+		ID:   "service-redis-exporter",
+		Spec: dockerswarm.ServiceSpec{Annotations: dockerswarm.Annotations{Name: "redis-exporter"}},
+	})
+	tasks, err = listTasks(context.Background(), client, "", []string{"redis"})
+	require.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	assert.Contains(t, tasks, "task-redis-1")
+}
+
+func TestWatcher(t *testing.T) {
+	client := &fakeClient{
+		services: []dockerswarm.Service{redisService, webService},
+		tasks:    []dockerswarm.Task{redisTask, webTask},
+	}
+	var started, stopped []string
+	w := newWatcher(client, "", nil, 0,
+		func(t *task) { started = append(started, t.ID) },
+		func(t *task) { stopped = append(stopped, t.ID) },
+	)
+
+	require.NoError(t, w.once())
+	sort.Strings(started)
+	assert.Equal(t, []string{"task-redis-1", "task-web-1"}, started)
+	assert.Empty(t, stopped)
+
+	// Running tasks are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 2)
+
+	// Tasks of updated services are stopped and started again.
+	updated := redisService
+	updated.Spec.Labels = map[string]string{"co.elastic.metrics/module": "redis"}
+	client.services = []dockerswarm.Service{updated, webService}
+	started = nil
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{"task-redis-1"}, started)
+	assert.Equal(t, []string{"task-redis-1"}, stopped)
+
+	client.tasks = nil
+	stopped = nil
+	require.NoError(t, w.once())
+	sort.Strings(stopped)
+	assert.Equal(t, []string{"task-redis-1", "task-web-1"}, stopped)
+	assert.Empty(t, w.tasks)
+}
+
+func TestTaskEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	tasks, err := listTasks(context.Background(), &fakeClient{
+		services: []dockerswarm.Service{redisService},
+		tasks:    []dockerswarm.Task{redisTask},
+	}, "", nil)
+	require.NoError(t, err)
+
+	event := p.taskEvent(tasks["task-redis-1"], "start")
+	assert.Equal(t, "task-redis-1", event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "10.0.1.5", event["host"])
+	assert.Equal(t, common.MapStr{
+		"service": common.MapStr{
+			"id":   "service-redis",
+			"name": "redis",
+			"mode": "replicated",
+			"labels": common.MapStr{
+				"co": common.MapStr{"elastic": common.MapStr{"metrics/module": "redis", "metrics/period": "30s"}},
+			},
+			"virtual_ips": []string{"10.0.0.2", "10.0.1.2"},
+		},
+		"task": common.MapStr{
+			"id":   "task-redis-1",
+			"slot": 1,
+		},
+		"node": common.MapStr{
+			"id": "node-1",
+		},
+	}, event["docker_swarm"])
+
+	meta := event["meta"].(common.MapStr)
+	labels, err := meta.GetValue("docker_swarm.service.labels")
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{
+		"co_elastic_metrics/module": "redis",
+		"co_elastic_metrics/period": "30s",
+	}, labels)
+	containerID, err := meta.GetValue("container.id")
+	require.NoError(t, err)
+	assert.Equal(t, "container-redis-1", containerID)
+}
+
+func TestEmit(t *testing.T) {
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+	p := newTestProvider(t, b)
+
+	tasks, err := listTasks(context.Background(), &fakeClient{
+		services: []dockerswarm.Service{redisService, webService},
+		tasks:    []dockerswarm.Task{redisTask, webTask},
+	}, "", nil)
+	require.NoError(t, err)
+
+	// An event is published for each port of the service.
+	p.onStart(tasks["task-redis-1"])
+	event := <-listener.Events()
+	assert.Equal(t, uint32(6379), event["port"])
+	assert.Empty(t, event["config"])
+
+	p.onStop(tasks["task-web-1"])
+	event = <-listener.Events()
+	assert.Equal(t, true, event["stop"])
+	assert.NotContains(t, event, "port")
+	assert.Len(t, event["config"], 1)
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	tasks, err := listTasks(context.Background(), &fakeClient{
+		services: []dockerswarm.Service{redisService},
+		tasks:    []dockerswarm.Task{redisTask},
+	}, "", nil)
+	require.NoError(t, err)
+
+	event := p.taskEvent(tasks["task-redis-1"], "start")
+	event["port"] = uint32(6379)
+	hints := p.generateHints(event)
+	// Labels of the services take precedence over the labels of the containers.
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "redis",
+			"period": "30s",
+		},
+		"logs": common.MapStr{
+			"enabled": "false",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.1.5", hints["host"])
+	assert.Equal(t, uint32(6379), hints["port"])
+	assert.Contains(t, hints, "container")
+	assert.Contains(t, hints, "docker_swarm")
+}
+
+func TestConfigValidate(t *testing.T) {
+	for _, c := range []struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		{config: map[string]interface{}{}, valid: true},
+		{config: map[string]interface{}{"scope": "cluster"}, valid: true},
+		{config: map[string]interface{}{"scope": "node", "node": "node-1"}, valid: true},
+		{config: map[string]interface{}{"scope": "cluster", "node": "node-1"}},
+		{config: map[string]interface{}{"scope": "swarm"}},
+		{config: map[string]interface{}{"period": 0}},
+	} {
+		config := defaultConfig()
+		err := common.MustNewConfigFrom(c.config).Unpack(config)
+		if c.valid {
+			assert.NoError(t, err, "%v", c.config)
+		} else {
+			assert.Error(t, err, "%v", c.config)
+		}
+	}
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	if b == nil {
+		b = bus.New(logp.L(), "test")
+	}
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{
+				"equals": map[string]interface{}{"docker_swarm.service.name": "web"},
+			},
+			"config": []map[string]interface{}{{"type": "log"}},
+		}},
+	}).Unpack(config)
+	require.NoError(t, err)
+
+	p, err := internalBuilder(b, uuid.Nil, config, &fakeClient{}, "", nil)
+	require.NoError(t, err)
+	return p
+}
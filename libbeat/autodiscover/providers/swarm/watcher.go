@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build linux darwin windows
+
+package swarm
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// watcher periodically lists the running tasks of the swarm, and notifies
+// when tasks start or stop. Tasks whose service changed are stopped and
+// started again, so their configurations are updated.
+type watcher struct {
+	client   swarmClient
+	node     string
+	services []string
+	period   time.Duration
+	onStart  func(t *task)
+	onStop   func(t *task)
+
+	tasks map[string]*task
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	closed chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client swarmClient,
+	node string,
+	services []string,
+	period time.Duration,
+	onStart, onStop func(t *task),
+) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watcher{
+		client:   client,
+		node:     node,
+		services: services,
+		period:   period,
+		onStart:  onStart,
+		onStop:   onStop,
+		tasks:    map[string]*task{},
+		ctx:      ctx,
+		cancel:   cancel,
+		closed:   make(chan struct{}),
+		logger:   logp.NewLogger("autodiscover.docker_swarm"),
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.closed
+}
+
+func (w *watcher) forever() {
+	defer close(w.closed)
+	for {
+		if err := w.once(); err != nil && w.ctx.Err() == nil {
+			w.logger.Error(errors.Wrap(err, "error while watching swarm tasks"))
+		}
+
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(w.period):
+		}
+	}
+}
+
+// once lists the running tasks and notifies the changes since the last time.
+// Known tasks are kept on errors, as they are likely still running.
+func (w *watcher) once() error {
+	tasks, err := listTasks(w.ctx, w.client, w.node, w.services)
+	if err != nil {
+		return err
+	}
+	w.logger.Debugf("fetched %d running tasks from the swarm for autodiscover", len(tasks))
+
+	for id, known := range w.tasks {
+		if t, found := tasks[id]; !found || !reflect.DeepEqual(known, t) {
+			delete(w.tasks, id)
+			w.onStop(known)
+		}
+	}
+	for id, t := range tasks {
+		if _, found := w.tasks[id]; !found {
+			w.tasks[id] = t
+			w.onStart(t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package autodiscover
+
+import (
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+)
+
+// newRateLimiter returns the limiter of the runners started and stopped, or
+// nil if rate limiting is disabled.
+func newRateLimiter(config RateLimitConfig) *rate.Limiter {
+	if config.Limit <= 0 {
+		return nil
+	}
+	burst := config.Burst
+	if burst == 0 {
+		burst = int(math.Ceil(config.Limit))
+	}
+	return rate.NewLimiter(rate.Limit(config.Limit), burst)
+}
+
+// throttleDelay is the time to wait before applying the deferred changes.
+func (a *Autodiscover) throttleDelay() time.Duration {
+	return time.Duration(float64(time.Second) / float64(a.limiter.Limit()))
+}
+
+// limitChanges returns the configs to reload the runners with, applying only
+// the starts and stops allowed by the limiter to the configs applied on the
+// previous reload. The runners to stop are handled first, so they release
+// their resources before new runners start. It also returns the number of
+// starts and stops deferred to a later reload.
+func (a *Autodiscover) limitChanges(configs []*reload.ConfigWithMeta) ([]*reload.ConfigWithMeta, int) {
+	desired := make(map[uint64]*reload.ConfigWithMeta, len(configs))
+	for _, c := range configs {
+		hash, err := cfgfile.HashConfig(c.Config)
+		if err != nil {
+			a.logger.Debugf("Could not hash config %v: %v", common.DebugString(c.Config, true), err)
+			continue
+		}
+		desired[hash] = c
+	}
+
+	pending := 0
+	applied := make(map[uint64]*reload.ConfigWithMeta, len(desired))
+	for hash, c := range a.applied {
+		if d, ok := desired[hash]; ok {
+			applied[hash] = d
+		} else if !a.limiter.Allow() {
+			applied[hash] = c
+			pending++
+		}
+	}
+	for hash, c := range desired {
+		if _, ok := a.applied[hash]; ok {
+			continue
+		}
+		if a.limiter.Allow() {
+			applied[hash] = c
+		} else {
+			pending++
+		}
+	}
+	a.applied = applied
+
+	result := make([]*reload.ConfigWithMeta, 0, len(applied))
+	for _, c := range applied {
+		result = append(result, c)
+	}
+	return result, pending
+}
@@ -0,0 +1,99 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package autodiscover
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/file"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+)
+
+// persistedConfig is a running config saved to the state file.
+type persistedConfig struct {
+	ID     string        `json:"id"`
+	Config common.MapStr `json:"config"`
+	Meta   common.MapStr `json:"meta,omitempty"`
+}
+
+type persistedState struct {
+	Configs []persistedConfig `json:"configs"`
+}
+
+// stateStore saves the running configs of the autodiscover manager, so they
+// can be restored after a restart without waiting for the providers.
+type stateStore struct {
+	path string
+}
+
+// load returns the saved configs, or none if the state file does not exist.
+func (s *stateStore) load() ([]persistedConfig, error) {
+	data, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var state persistedState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, err
+	}
+	return state.Configs, nil
+}
+
+// save replaces the state file with the given configs. Configs can contain
+// credentials, so the file is only readable by the owner.
+func (s *stateStore) save(configs map[string]map[uint64]*reload.ConfigWithMeta) error {
+	state := persistedState{Configs: []persistedConfig{}}
+	for id, list := range configs {
+		for _, c := range list {
+			var config common.MapStr
+			if err := c.Config.Unpack(&config); err != nil {
+				return err
+			}
+
+			var meta common.MapStr
+			if c.Meta != nil {
+				meta = c.Meta.Get()
+			}
+			state.Configs = append(state.Configs, persistedConfig{ID: id, Config: config, Meta: meta})
+		}
+	}
+	sort.Slice(state.Configs, func(i, j int) bool { return state.Configs[i].ID < state.Configs[j].ID })
+
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0750); err != nil {
+		return err
+	}
+	tmp := s.path + ".new"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return file.SafeFileRotate(s.path, tmp)
+}
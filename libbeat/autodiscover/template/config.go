@@ -128,6 +128,7 @@ func ApplyConfigTemplate(event bus.Event, configs []*common.Config, options ...u
 		ucfg.Env(vars),
 		ucfg.ResolveEnv,
 		ucfg.VarExp,
+		pipelineResolver(event),
 	}
 	opts = append(opts, options...)
 
@@ -139,7 +140,7 @@ func ApplyConfigTemplate(event bus.Event, configs []*common.Config, options ...u
 		}
 		// Unpack config to process any vars in the template:
 		var unpacked map[string]interface{}
-		c.Unpack(&unpacked, opts...)
+		err = c.Unpack(&unpacked, opts...)
 		if err != nil {
 			logp.Err("Error unpacking config: %v", err)
 			continue
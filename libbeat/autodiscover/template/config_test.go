@@ -74,6 +74,62 @@ func TestConfigsMapping(t *testing.T) {
 			},
 			expected: []*common.Config{config},
 		},
+		// Match regexp
+		{
+			mapping: `
+- condition.regexp:
+    docker.container.image: "^myregistry/.*-prod$"
+  config:
+  - correct: config`,
+			event: bus.Event{
+				"docker": common.MapStr{
+					"container": common.MapStr{
+						"image": "myregistry/api-prod",
+					},
+				},
+			},
+			expected: []*common.Config{config},
+		},
+		// No regexp match
+		{
+			mapping: `
+- condition.regexp:
+    docker.container.image: "^myregistry/.*-prod$"
+  config:
+  - correct: config`,
+			event: bus.Event{
+				"docker": common.MapStr{
+					"container": common.MapStr{
+						"image": "myregistry/api-staging",
+					},
+				},
+			},
+			expected: nil,
+		},
+		// Match network
+		{
+			mapping: `
+- condition.network:
+    host: 10.0.0.0/8
+  config:
+  - correct: config`,
+			event: bus.Event{
+				"host": "10.1.2.3",
+			},
+			expected: []*common.Config{config},
+		},
+		// No network match
+		{
+			mapping: `
+- condition.network:
+    host: [10.0.0.0/8, loopback]
+  config:
+  - correct: config`,
+			event: bus.Event{
+				"host": "192.168.1.10",
+			},
+			expected: nil,
+		},
 	}
 
 	for _, test := range tests {
@@ -223,6 +279,83 @@ func (kr *mockKeystore) GetKeystore(event bus.Event) keystore.Keystore {
 	return keystore
 }
 
+func TestApplyConfigTemplatePipelines(t *testing.T) {
+	event := bus.Event{
+		"host": " 10.0.0.1 ",
+		"port": 8080,
+		"kubernetes": common.MapStr{
+			"pod": common.MapStr{
+				"name": "Redis-Master",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		template string
+		expected interface{}
+	}{
+		"plain variable": {
+			template: "${data.port}",
+			expected: uint64(8080),
+		},
+		"default not used": {
+			template: "${data.port:9090}",
+			expected: uint64(8080),
+		},
+		"default used": {
+			template: "${data.metrics_port:9090}",
+			expected: uint64(9090),
+		},
+		"lower": {
+			template: "${data.kubernetes.pod.name|lower}",
+			expected: "redis-master",
+		},
+		"trim in splice": {
+			template: "${data.host|trim}:${data.port}",
+			expected: "10.0.0.1:8080",
+		},
+		"replace": {
+			template: "${data.kubernetes.pod.name|lower|replace(-,_)}",
+			expected: "redis_master",
+		},
+		"functions with default not used": {
+			template: "${data.kubernetes.pod.name|upper:default}",
+			expected: "REDIS-MASTER",
+		},
+		"functions with default used": {
+			template: "${data.namespace|upper:default}",
+			expected: "default",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := common.MustNewConfigFrom(common.MapStr{"value": test.template})
+			configs := ApplyConfigTemplate(event, []*common.Config{config})
+			if !assert.Len(t, configs, 1) {
+				return
+			}
+
+			var result map[string]interface{}
+			err := configs[0].Unpack(&result)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, result["value"])
+		})
+	}
+
+	for name, template := range map[string]string{
+		"missing without default": "${data.metrics_port|trim}",
+		"unknown function":        "${data.kubernetes.pod.name|lowr}",
+		"hints function":          "${data.host|ipv4}",
+		"default after pipe":      "${data.metrics_port|9090}",
+	} {
+		t.Run(name, func(t *testing.T) {
+			config := common.MustNewConfigFrom(common.MapStr{"value": template})
+			assert.Empty(t, ApplyConfigTemplate(event, []*common.Config{config}))
+		})
+	}
+}
+
 func TestNilConditionConfig(t *testing.T) {
 	var mappings MapperSettings
 	data := `
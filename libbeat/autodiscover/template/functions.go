@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package template
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/elastic/go-ucfg"
+	"github.com/elastic/go-ucfg/parse"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+)
+
+const (
+	pipeSep    = "|"
+	dataPrefix = "data."
+)
+
+// templateFunctions are the functions that can be applied to the value of a
+// variable, as in `${data.kubernetes.pod.name|upper}`.
+var templateFunctions = map[string]func(value string, args []string) (string, error){
+	"lower": func(value string, _ []string) (string, error) {
+		return strings.ToLower(value), nil
+	},
+	"upper": func(value string, _ []string) (string, error) {
+		return strings.ToUpper(value), nil
+	},
+	"trim": func(value string, _ []string) (string, error) {
+		return strings.TrimSpace(value), nil
+	},
+	"replace": func(value string, args []string) (string, error) {
+		if len(args) != 2 {
+			return "", fmt.Errorf("replace expects 2 arguments, got %d", len(args))
+		}
+		return strings.Replace(value, args[0], args[1], -1), nil
+	},
+}
+
+// hintsFunctions are functions only available in the hosts of hints, they are
+// reserved so they are not silently ignored in templates.
+var hintsFunctions = map[string]bool{
+	"ipv4": true,
+	"ipv6": true,
+}
+
+// pipelineResolver returns a resolver for variables of the event data followed
+// by a pipeline of functions, separated by `|`, as in
+// `${data.kubernetes.pod.name|lower|replace(-,_)}`. Unknown functions are an
+// error. Default values use the usual syntax, as in `${data.port|trim:9090}`,
+// and are used if the variable is missing.
+func pipelineResolver(event bus.Event) ucfg.Option {
+	return ucfg.Resolve(func(name string) (string, parse.Config, error) {
+		if !strings.HasPrefix(name, dataPrefix) || !strings.Contains(name, pipeSep) {
+			return "", parse.DefaultConfig, ucfg.ErrMissing
+		}
+
+		steps := strings.Split(name, pipeSep)
+		var functions []func(string) (string, error)
+		for _, step := range steps[1:] {
+			step = strings.TrimSpace(step)
+			fn, args, ok := parseFunction(step)
+			if !ok {
+				if hintsFunctions[step] {
+					return "", parse.DefaultConfig, fmt.Errorf("function '%s' in '%s' is only available in hints", step, name)
+				}
+				return "", parse.DefaultConfig, fmt.Errorf("unknown function '%s' in '%s'", step, name)
+			}
+			functions = append(functions, func(value string) (string, error) {
+				value, err := fn(value, args)
+				if err != nil {
+					return "", fmt.Errorf("error applying '%s' to '%s': %v", step, steps[0], err)
+				}
+				return value, nil
+			})
+		}
+
+		v, err := common.MapStr(event).GetValue(strings.TrimSpace(strings.TrimPrefix(steps[0], dataPrefix)))
+		if err != nil || v == nil {
+			return "", parse.DefaultConfig, ucfg.ErrMissing
+		}
+
+		value := fmt.Sprint(v)
+		for _, fn := range functions {
+			if value, err = fn(value); err != nil {
+				return "", parse.DefaultConfig, err
+			}
+		}
+		return value, parse.DefaultConfig, nil
+	})
+}
+
+// parseFunction parses a step of a pipeline as a function, with or without
+// arguments, as in `lower` or `replace(-,_)`.
+func parseFunction(step string) (func(string, []string) (string, error), []string, bool) {
+	name, args := step, []string(nil)
+	if i := strings.Index(step, "("); i > 0 && strings.HasSuffix(step, ")") {
+		name = step[:i]
+		args = strings.Split(step[i+1:len(step)-1], ",")
+	}
+	fn, ok := templateFunctions[name]
+	return fn, args, ok
+}
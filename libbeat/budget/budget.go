@@ -0,0 +1,261 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package budget watches the goroutines, the heap and the open file
+// descriptors of a Beat, and protects the host when they exceed the configured
+// budgets, as when a leak makes them grow without limit.
+package budget
+
+import (
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+// Number of components reported when the budget of goroutines is exceeded.
+const topComponents = 5
+
+// Actions are the hooks used to protect the Beat when a budget is exceeded.
+type Actions struct {
+	// Pause pauses the inputs that can be paused, and returns a function to
+	// resume them.
+	Pause func() (resume func())
+	// RestartInputs restarts the dynamic inputs and modules.
+	RestartInputs func() error
+	// Shutdown stops the Beat.
+	Shutdown func()
+}
+
+// Usage is the usage of the resources with a budget.
+type Usage struct {
+	Goroutines uint64
+	Heap       uint64
+	FDs        uint64
+	// FDsKnown is false if the open file descriptors cannot be counted in
+	// this system.
+	FDsKnown bool
+}
+
+// ReadUsage reads the current usage of the resources of the process.
+func ReadUsage() Usage {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+
+	usage := Usage{
+		Goroutines: uint64(runtime.NumGoroutine()),
+		Heap:       stats.HeapAlloc,
+	}
+	usage.FDs, usage.FDsKnown = openFDs()
+	return usage
+}
+
+// budget is the state of a budget.
+type budget struct {
+	name     string
+	max      uint64
+	action   Action
+	exceeded bool
+	count    *monitoring.Uint
+}
+
+// Watcher checks the budgets periodically and runs their actions when they
+// are exceeded. Actions run once each time a budget is exceeded, inputs
+// paused by degrade are resumed when the budgets are respected again.
+type Watcher struct {
+	log     *logp.Logger
+	period  time.Duration
+	actions Actions
+	usage   func() Usage
+
+	budgets  []*budget
+	resume   func()
+	degraded *monitoring.Bool
+	shutdown sync.Once
+
+	done chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWatcher creates a watcher of the budgets of the config. The number of
+// times each budget is exceeded is reported in the registry, if any.
+func NewWatcher(log *logp.Logger, config Config, actions Actions, registry *monitoring.Registry) *Watcher {
+	if log == nil {
+		log = logp.NewLogger("")
+	}
+	if registry == nil {
+		registry = monitoring.NewRegistry()
+	}
+
+	w := &Watcher{
+		log:      log.Named("budget"),
+		period:   config.Period,
+		actions:  actions,
+		usage:    ReadUsage,
+		degraded: monitoring.NewBool(registry, "degraded"),
+		done:     make(chan struct{}),
+	}
+	w.add(registry, "goroutines", config.Goroutines.Max, config.Goroutines.Action)
+	w.add(registry, "heap", uint64(config.Heap.Max), config.Heap.Action)
+	w.add(registry, "fds", config.FDs.Max, config.FDs.Action)
+	return w
+}
+
+func (w *Watcher) add(registry *monitoring.Registry, name string, max uint64, action Action) {
+	if max == 0 {
+		return
+	}
+	w.budgets = append(w.budgets, &budget{
+		name:   name,
+		max:    max,
+		action: action,
+		count:  monitoring.NewUint(registry, name+".exceeded"),
+	})
+}
+
+// Start starts checking the budgets periodically.
+func (w *Watcher) Start() {
+	for _, b := range w.budgets {
+		w.log.Infof("Budget of %s set to %d, action on exceed: %s", b.name, b.max, b.action)
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+
+		ticker := time.NewTicker(w.period)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-w.done:
+				return
+			case <-ticker.C:
+				w.Check()
+			}
+		}
+	}()
+}
+
+// Stop stops checking the budgets and resumes the inputs paused.
+func (w *Watcher) Stop() {
+	close(w.done)
+	w.wg.Wait()
+
+	if w.resume != nil {
+		w.resume()
+		w.resume = nil
+	}
+}
+
+// Check checks the budgets once and runs the actions of the ones exceeded.
+func (w *Watcher) Check() {
+	usage := w.usage()
+
+	degrade := false
+	for _, b := range w.budgets {
+		value, known := b.value(usage)
+		if !known {
+			continue
+		}
+
+		if value <= b.max {
+			if b.exceeded {
+				w.log.Infof("Usage of %s back to %d, under its budget of %d", b.name, value, b.max)
+				b.exceeded = false
+			}
+			continue
+		}
+
+		if b.action == ActionDegrade {
+			degrade = true
+		}
+		if b.exceeded {
+			continue
+		}
+		b.exceeded = true
+		b.count.Inc()
+		w.exceed(b, value)
+	}
+
+	if degrade && w.resume == nil && w.actions.Pause != nil {
+		w.log.Warn("Pausing inputs until the budgets are respected")
+		w.resume = w.actions.Pause()
+		w.degraded.Set(true)
+		debug.FreeOSMemory()
+	} else if !degrade && w.resume != nil {
+		w.log.Info("Resuming inputs, the budgets are respected")
+		w.resume()
+		w.resume = nil
+		w.degraded.Set(false)
+	}
+}
+
+// exceed reports an exceeded budget and runs its action, except degrade that
+// depends on the state of all the budgets.
+func (w *Watcher) exceed(b *budget, value uint64) {
+	msg := fmt.Sprintf("Usage of %s is %d, over its budget of %d", b.name, value, b.max)
+	if b.name == "goroutines" {
+		msg += fmt.Sprintf(", goroutines by component: %s", topGoroutines(topComponents))
+	}
+
+	switch b.action {
+	case ActionRestartInputs:
+		w.log.Warnf("%s, restarting inputs", msg)
+		if w.actions.RestartInputs != nil {
+			if err := w.actions.RestartInputs(); err != nil {
+				w.log.Errorf("Error restarting inputs: %v", err)
+			}
+		}
+	case ActionShutdown:
+		w.log.Errorf("%s, shutting down", msg)
+		if w.actions.Shutdown != nil {
+			w.shutdown.Do(func() { go w.actions.Shutdown() })
+		}
+	default:
+		w.log.Warn(msg)
+	}
+}
+
+func (b *budget) value(usage Usage) (uint64, bool) {
+	switch b.name {
+	case "goroutines":
+		return usage.Goroutines, true
+	case "heap":
+		return usage.Heap, true
+	case "fds":
+		return usage.FDs, usage.FDsKnown
+	}
+	return 0, false
+}
+
+// topGoroutines formats the components with more goroutines.
+func topGoroutines(n int) string {
+	components := Goroutines()
+	if len(components) > n {
+		components = components[:n]
+	}
+	parts := make([]string, len(components))
+	for i, c := range components {
+		parts[i] = fmt.Sprintf("%s=%d", c.Component, c.Count)
+	}
+	return strings.Join(parts, ", ")
+}
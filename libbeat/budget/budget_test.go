@@ -0,0 +1,233 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package budget
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+type fakeActions struct {
+	paused    int
+	resumed   int
+	restarted int
+	shutdown  chan struct{}
+}
+
+func (f *fakeActions) actions() Actions {
+	f.shutdown = make(chan struct{})
+	return Actions{
+		Pause: func() func() {
+			f.paused++
+			return func() { f.resumed++ }
+		},
+		RestartInputs: func() error {
+			f.restarted++
+			return errors.New("restart failed")
+		},
+		Shutdown: func() { close(f.shutdown) },
+	}
+}
+
+func newTestWatcher(t *testing.T, config map[string]interface{}, usage *Usage) (*Watcher, *fakeActions, *monitoring.Registry) {
+	c := DefaultConfig()
+	require.NoError(t, common.MustNewConfigFrom(config).Unpack(&c))
+
+	actions := &fakeActions{}
+	registry := monitoring.NewRegistry()
+	w := NewWatcher(nil, c, actions.actions(), registry)
+	w.usage = func() Usage { return *usage }
+	return w, actions, registry
+}
+
+func TestConfig(t *testing.T) {
+	c := DefaultConfig()
+	assert.False(t, c.IsEnabled())
+
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"heap.max":   "512MiB",
+		"fds.max":    1000,
+		"fds.action": "restart_inputs",
+	}).Unpack(&c)
+	require.NoError(t, err)
+	assert.True(t, c.IsEnabled())
+	assert.EqualValues(t, 512*1024*1024, c.Heap.Max)
+	assert.Equal(t, ActionLog, c.Heap.Action)
+	assert.Equal(t, ActionRestartInputs, c.FDs.Action)
+
+	c = DefaultConfig()
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"goroutines.max":    1000,
+		"goroutines.action": "panic",
+	}).Unpack(&c)
+	assert.Error(t, err)
+}
+
+func TestWatcherLog(t *testing.T) {
+	usage := &Usage{Goroutines: 10, Heap: 100, FDs: 10, FDsKnown: true}
+	w, actions, registry := newTestWatcher(t, map[string]interface{}{
+		"goroutines.max": 20,
+		"heap.max":       "1KiB",
+	}, usage)
+
+	w.Check()
+	assert.False(t, w.budgets[0].exceeded)
+
+	// Exceeded budgets are only reported once until they are respected.
+	usage.Goroutines = 30
+	w.Check()
+	w.Check()
+	assert.True(t, w.budgets[0].exceeded)
+	assert.Equal(t, uint64(1), registry.Get("goroutines.exceeded").(*monitoring.Uint).Get())
+
+	usage.Goroutines = 10
+	w.Check()
+	assert.False(t, w.budgets[0].exceeded)
+	usage.Goroutines = 30
+	w.Check()
+	assert.Equal(t, uint64(2), registry.Get("goroutines.exceeded").(*monitoring.Uint).Get())
+
+	assert.Equal(t, uint64(0), registry.Get("heap.exceeded").(*monitoring.Uint).Get())
+	assert.Nil(t, registry.Get("fds.exceeded"))
+	assert.Equal(t, 0, actions.paused+actions.restarted)
+}
+
+func TestWatcherDegrade(t *testing.T) {
+	usage := &Usage{Heap: 100, FDs: 10, FDsKnown: true}
+	w, actions, registry := newTestWatcher(t, map[string]interface{}{
+		"heap.max":    "1KiB",
+		"heap.action": "degrade",
+		"fds.max":     100,
+		"fds.action":  "degrade",
+	}, usage)
+	degraded := registry.Get("degraded").(*monitoring.Bool)
+
+	usage.Heap = 2048
+	w.Check()
+	assert.Equal(t, 1, actions.paused)
+	assert.True(t, degraded.Get())
+
+	// Inputs are resumed once all the budgets are respected.
+	usage.FDs = 200
+	w.Check()
+	usage.Heap = 100
+	w.Check()
+	assert.Equal(t, 1, actions.paused)
+	assert.Equal(t, 0, actions.resumed)
+
+	usage.FDs = 10
+	w.Check()
+	assert.Equal(t, 1, actions.resumed)
+	assert.False(t, degraded.Get())
+
+	// Inputs still paused are resumed when the watcher stops.
+	usage.Heap = 2048
+	w.Check()
+	w.Start()
+	w.Stop()
+	assert.Equal(t, 2, actions.paused)
+	assert.Equal(t, 2, actions.resumed)
+}
+
+func TestWatcherRestartInputs(t *testing.T) {
+	usage := &Usage{FDs: 200, FDsKnown: false}
+	w, actions, _ := newTestWatcher(t, map[string]interface{}{
+		"fds.max":    100,
+		"fds.action": "restart_inputs",
+	}, usage)
+
+	// Budgets of unknown usages are ignored.
+	w.Check()
+	assert.Equal(t, 0, actions.restarted)
+
+	usage.FDsKnown = true
+	w.Check()
+	w.Check()
+	assert.Equal(t, 1, actions.restarted)
+}
+
+func TestWatcherShutdown(t *testing.T) {
+	usage := &Usage{Goroutines: 30}
+	w, actions, _ := newTestWatcher(t, map[string]interface{}{
+		"goroutines.max":    20,
+		"goroutines.action": "shutdown",
+	}, usage)
+
+	w.Check()
+	select {
+	case <-actions.shutdown:
+	case <-time.After(time.Second):
+		t.Fatal("beat not shut down")
+	}
+
+	// Shutdown is only requested once.
+	usage.Goroutines = 10
+	w.Check()
+	usage.Goroutines = 30
+	w.Check()
+}
+
+func TestGoroutines(t *testing.T) {
+	var wg sync.WaitGroup
+	done := make(chan struct{})
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-done
+		}()
+	}
+	defer func() {
+		close(done)
+		wg.Wait()
+	}()
+
+	components := Goroutines()
+	require.NotEmpty(t, components)
+	assert.Equal(t, "libbeat/budget", components[0].Component)
+	assert.True(t, components[0].Count >= 20)
+
+	top := topGoroutines(1)
+	assert.True(t, strings.HasPrefix(top, "libbeat/budget="), top)
+}
+
+func TestFunctionPackage(t *testing.T) {
+	assert.Equal(t, "net/http", functionPackage("net/http.(*conn).serve"))
+	assert.Equal(t, "github.com/elastic/beats/v7/libbeat/budget",
+		functionPackage("github.com/elastic/beats/v7/libbeat/budget.(*Watcher).Start.func1"))
+	assert.Equal(t, "runtime", functionPackage("runtime.goexit"))
+	assert.Equal(t, "", functionPackage("main"))
+}
+
+func TestReadUsage(t *testing.T) {
+	usage := ReadUsage()
+	assert.True(t, usage.Goroutines > 0)
+	assert.True(t, usage.Heap > 0)
+	if usage.FDsKnown {
+		assert.True(t, usage.FDs > 0)
+	}
+}
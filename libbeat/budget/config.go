@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package budget
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+)
+
+// Action is what is done when a budget is exceeded.
+type Action string
+
+const (
+	// ActionLog logs a warning.
+	ActionLog Action = "log"
+	// ActionDegrade pauses the inputs that can be paused and frees memory,
+	// until all the budgets with this action are respected again.
+	ActionDegrade Action = "degrade"
+	// ActionRestartInputs restarts the dynamic inputs and modules.
+	ActionRestartInputs Action = "restart_inputs"
+	// ActionShutdown stops the Beat cleanly.
+	ActionShutdown Action = "shutdown"
+)
+
+// Unpack validates the name of an action.
+func (a *Action) Unpack(s string) error {
+	switch action := Action(s); action {
+	case ActionLog, ActionDegrade, ActionRestartInputs, ActionShutdown:
+		*a = action
+		return nil
+	default:
+		return fmt.Errorf("invalid action '%s', must be one of %s, %s, %s or %s",
+			s, ActionLog, ActionDegrade, ActionRestartInputs, ActionShutdown)
+	}
+}
+
+// Config is the configuration of the budgets of a Beat.
+type Config struct {
+	Period     time.Duration `config:"period" validate:"positive,nonzero"`
+	Goroutines Limit         `config:"goroutines"`
+	Heap       HeapLimit     `config:"heap"`
+	FDs        Limit         `config:"fds"`
+}
+
+// Limit is the budget of a resource, it is disabled if Max is 0.
+type Limit struct {
+	Max    uint64 `config:"max"`
+	Action Action `config:"action"`
+}
+
+// HeapLimit is the budget of the heap in bytes, it is disabled if Max is 0.
+type HeapLimit struct {
+	Max    cfgtype.ByteSize `config:"max"`
+	Action Action           `config:"action"`
+}
+
+// DefaultConfig returns the default configuration, with all the budgets
+// disabled.
+func DefaultConfig() Config {
+	return Config{
+		Period:     10 * time.Second,
+		Goroutines: Limit{Action: ActionLog},
+		Heap:       HeapLimit{Action: ActionLog},
+		FDs:        Limit{Action: ActionLog},
+	}
+}
+
+// IsEnabled returns true if any budget is set.
+func (c *Config) IsEnabled() bool {
+	return c.Goroutines.Max > 0 || c.Heap.Max > 0 || c.FDs.Max > 0
+}
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package budget
+
+import (
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+const beatsModule = "github.com/elastic/beats/v7/"
+
+// ComponentCount is the number of goroutines of a component.
+type ComponentCount struct {
+	Component string
+	Count     int
+}
+
+// Goroutines returns the number of goroutines by component, sorted from the
+// component with more goroutines. The component of a goroutine is the package
+// of the outermost function of its stack that is part of the Beats, like
+// `filebeat/input/log`, or the package of the function that started it if
+// there is none, like `net/http`.
+func Goroutines() []ComponentCount {
+	var records []runtime.StackRecord
+	n := runtime.NumGoroutine()
+	for {
+		// Leave some room for goroutines started in between.
+		records = make([]runtime.StackRecord, n+n/10+10)
+		var ok bool
+		n, ok = runtime.GoroutineProfile(records)
+		if ok {
+			records = records[:n]
+			break
+		}
+	}
+
+	counts := map[string]int{}
+	for i := range records {
+		counts[stackComponent(records[i].Stack())]++
+	}
+
+	components := make([]ComponentCount, 0, len(counts))
+	for component, count := range counts {
+		components = append(components, ComponentCount{Component: component, Count: count})
+	}
+	sort.Slice(components, func(i, j int) bool {
+		if components[i].Count != components[j].Count {
+			return components[i].Count > components[j].Count
+		}
+		return components[i].Component < components[j].Component
+	})
+	return components
+}
+
+// stackComponent returns the component of a goroutine from its stack.
+func stackComponent(stack []uintptr) string {
+	var entry, beats string
+	frames := runtime.CallersFrames(stack)
+	for {
+		frame, more := frames.Next()
+		if pkg := functionPackage(frame.Function); pkg != "" {
+			// Frames go from the innermost to the outermost function.
+			entry = pkg
+			if strings.HasPrefix(pkg, beatsModule) {
+				beats = strings.TrimPrefix(pkg, beatsModule)
+			}
+		}
+		if !more {
+			break
+		}
+	}
+	if beats != "" {
+		return beats
+	}
+	if entry != "" {
+		return entry
+	}
+	return "unknown"
+}
+
+// functionPackage returns the package of a fully qualified function name, as
+// in `net/http.(*conn).serve`.
+func functionPackage(function string) string {
+	slash := strings.LastIndex(function, "/")
+	dot := strings.Index(function[slash+1:], ".")
+	if dot < 0 {
+		return ""
+	}
+	return function[:slash+1+dot]
+}
+
+// ReportGoroutines reports the number of goroutines by component.
+func ReportGoroutines(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	for _, c := range Goroutines() {
+		monitoring.ReportInt(V, common.DeDot(c.Component), int64(c.Count))
+	}
+}
@@ -188,6 +188,49 @@ func Load(path string, beatOverrides []ConditionalOverride) (*common.Config, err
 	return config, nil
 }
 
+// Source is a single configuration layer taking part in Load, together with
+// a human readable description of where it comes from.
+type Source struct {
+	Name   string
+	Config *common.Config
+}
+
+// Sources returns the configuration layers merged by Load when reading the
+// files given by the '-c' command line flag. Layers are returned in merge
+// order, so a setting defined in a later layer takes precedence.
+func Sources(beatOverrides []ConditionalOverride) ([]Source, error) {
+	cfgpath := GetPathConfig()
+
+	var files []Source
+	var configs []*common.Config
+	for _, cfg := range configfiles.List() {
+		if !filepath.IsAbs(cfg) {
+			cfg = filepath.Join(cfgpath, cfg)
+		}
+		config, err := common.LoadFile(cfg)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, Source{Name: cfg, Config: config})
+		configs = append(configs, config)
+	}
+
+	merged, err := common.MergeConfigs(configs...)
+	if err != nil {
+		return nil, err
+	}
+
+	sources := []Source{{Name: "defaults", Config: defaults}}
+	for _, o := range beatOverrides {
+		if o.Check(merged) {
+			sources = append(sources, Source{Name: "beat overrides", Config: o.Config})
+		}
+	}
+	sources = append(sources, files...)
+	sources = append(sources, Source{Name: "-E flags", Config: overwrites})
+	return sources, nil
+}
+
 // LoadList loads a list of configs data from the given file.
 func LoadList(file string) ([]*common.Config, error) {
 	logp.Debug("cfgfile", "Load config from file: %s", file)
@@ -33,6 +33,7 @@ import (
 // RunnerList implements a reloadable.List of Runners
 type RunnerList struct {
 	runners  map[uint64]Runner
+	configs  map[uint64]*reload.ConfigWithMeta
 	mutex    sync.RWMutex
 	factory  RunnerFactory
 	pipeline beat.PipelineConnector
@@ -41,12 +42,15 @@ type RunnerList struct {
 
 // NewRunnerList builds and returns a RunnerList
 func NewRunnerList(name string, factory RunnerFactory, pipeline beat.PipelineConnector) *RunnerList {
-	return &RunnerList{
+	r := &RunnerList{
 		runners:  map[uint64]Runner{},
+		configs:  map[uint64]*reload.ConfigWithMeta{},
 		factory:  factory,
 		pipeline: pipeline,
 		logger:   logp.NewLogger(name),
 	}
+	runnerLists.add(r)
+	return r
 }
 
 // Reload the list of runners to match the given state
@@ -54,6 +58,9 @@ func (r *RunnerList) Reload(configs []*reload.ConfigWithMeta) error {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	// Lists can be reloaded again after being stopped
+	runnerLists.add(r)
+
 	var errs multierror.Errors
 
 	startList := map[uint64]*reload.ConfigWithMeta{}
@@ -83,6 +90,7 @@ func (r *RunnerList) Reload(configs []*reload.ConfigWithMeta) error {
 	for hash, runner := range stopList {
 		r.logger.Debugf("Stopping runner: %s", runner)
 		delete(r.runners, hash)
+		delete(r.configs, hash)
 		go runner.Stop()
 	}
 
@@ -98,6 +106,34 @@ func (r *RunnerList) Reload(configs []*reload.ConfigWithMeta) error {
 			continue
 		}
 
+		r.logger.Debugf("Starting runner: %s", runner)
+		r.runners[hash] = runner
+		r.configs[hash] = config
+		runner.Start()
+	}
+
+	return errs.Err()
+}
+
+// Restart stops all runners and starts them again with the same configs.
+func (r *RunnerList) Restart() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.logger.Infof("Restarting %v runners ...", len(r.runners))
+	r.stopAll()
+
+	var errs multierror.Errors
+	for hash, config := range r.configs {
+		c, _ := common.NewConfigFrom(config.Config)
+		runner, err := r.factory.Create(r.pipeline, c, config.Meta)
+		if err != nil {
+			r.logger.Errorf("Error creating runner from config: %s", err)
+			errs = append(errs, errors.Wrap(err, "Error creating runner from config"))
+			delete(r.configs, hash)
+			continue
+		}
+
 		r.logger.Debugf("Starting runner: %s", runner)
 		r.runners[hash] = runner
 		runner.Start()
@@ -111,6 +147,14 @@ func (r *RunnerList) Stop() {
 	r.mutex.Lock()
 	defer r.mutex.Unlock()
 
+	runnerLists.remove(r)
+	r.configs = map[uint64]*reload.ConfigWithMeta{}
+	r.stopAll()
+}
+
+// stopAll stops the runners in parallel and waits for them, the list must be
+// locked.
+func (r *RunnerList) stopAll() {
 	if len(r.runners) == 0 {
 		return
 	}
@@ -157,3 +201,44 @@ func (r *RunnerList) copyRunnerList() map[uint64]Runner {
 	}
 	return list
 }
+
+// runnerLists holds the lists of runners not stopped, so their runners can be
+// restarted all at once.
+var runnerLists = &runnerListRegistry{lists: map[*RunnerList]struct{}{}}
+
+type runnerListRegistry struct {
+	mutex sync.Mutex
+	lists map[*RunnerList]struct{}
+}
+
+func (r *runnerListRegistry) add(list *RunnerList) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.lists[list] = struct{}{}
+}
+
+func (r *runnerListRegistry) remove(list *RunnerList) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.lists, list)
+}
+
+// RestartRunners restarts the runners of all the lists not stopped, as the
+// inputs and modules started by config reloading, autodiscover or central
+// management.
+func RestartRunners() error {
+	runnerLists.mutex.Lock()
+	lists := make([]*RunnerList, 0, len(runnerLists.lists))
+	for list := range runnerLists.lists {
+		lists = append(lists, list)
+	}
+	runnerLists.mutex.Unlock()
+
+	var errs multierror.Errors
+	for _, list := range lists {
+		if err := list.Restart(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errs.Err()
+}
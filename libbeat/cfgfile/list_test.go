@@ -187,6 +187,36 @@ func TestStopAll(t *testing.T) {
 	}
 }
 
+func TestRestart(t *testing.T) {
+	factory := &runnerFactory{}
+	list := NewRunnerList("", factory, nil)
+
+	list.Reload([]*reload.ConfigWithMeta{
+		createConfig(1),
+		createConfig(2),
+	})
+	previous := factory.runners
+
+	err := RestartRunners()
+	assert.NoError(t, err)
+
+	for _, r := range previous {
+		assert.True(t, r.stopped)
+	}
+	assert.Len(t, factory.runners, 4)
+	assert.Len(t, list.copyRunnerList(), 2)
+	for _, r := range list.copyRunnerList() {
+		assert.True(t, r.(*runner).started)
+		assert.False(t, r.(*runner).stopped)
+	}
+
+	// Stopped lists are not restarted
+	list.Stop()
+	err = RestartRunners()
+	assert.NoError(t, err)
+	assert.Len(t, factory.runners, 4)
+}
+
 func TestHas(t *testing.T) {
 	factory := &runnerFactory{}
 	list := NewRunnerList("", factory, nil)
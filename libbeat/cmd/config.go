@@ -0,0 +1,254 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/elastic/go-ucfg"
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cli"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// secretKeys are the last path segments of settings whose value is never printed.
+	secretKeys = regexp.MustCompile(`(?i)^(key|auth|.*(password|passwd|passphrase|secret|token|api_key))$`)
+
+	// varRefs matches ${VAR} and ${VAR:default} references in raw config values.
+	varRefs = regexp.MustCompile(`\$\{([^}:]+)`)
+)
+
+func genConfigCmd(settings instance.Settings) *cobra.Command {
+	configCmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the effective configuration",
+	}
+
+	configCmd.AddCommand(&cobra.Command{
+		Use:   "render",
+		Short: "Print the effective configuration and where each setting comes from",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			return renderConfig(settings, os.Stdout)
+		}),
+	})
+
+	return configCmd
+}
+
+func renderConfig(settings instance.Settings, w io.Writer) error {
+	b, err := instance.NewInitializedBeat(settings)
+	if err != nil {
+		return fmt.Errorf("error initializing beat: %s", err)
+	}
+
+	sources, err := cfgfile.Sources(settings.ConfigOverrides)
+	if err != nil {
+		return fmt.Errorf("error loading config sources: %s", err)
+	}
+
+	var effective common.MapStr
+	if err := b.RawConfig.Unpack(&effective); err != nil {
+		return fmt.Errorf("error unpacking config: %s", err)
+	}
+
+	if b.Manager.Enabled() {
+		fmt.Fprintln(w, "# Central management is enabled, configuration blocks received from it")
+		fmt.Fprintln(w, "# at runtime are not included below.")
+	}
+
+	return renderWithProvenance(w, effective, sources, b.Keystore())
+}
+
+// renderWithProvenance writes every leaf setting of the effective config
+// together with the last source defining it. Values of secret settings, or
+// values expanded from the keystore, are redacted.
+func renderWithProvenance(w io.Writer, effective common.MapStr, sources []cfgfile.Source, store keystore.Keystore) error {
+	type origin struct {
+		source string
+		raw    interface{}
+	}
+
+	origins := map[string]origin{}
+	for _, source := range sources {
+		var raw common.MapStr
+		err := (*ucfg.Config)(source.Config).Unpack(&raw, ucfg.PathSep("."), ucfg.ResolveNOOP)
+		if err != nil {
+			return fmt.Errorf("error unpacking %s: %s", source.Name, err)
+		}
+		for k, v := range raw.Flatten() {
+			origins[k] = origin{source: source.Name, raw: v}
+		}
+	}
+
+	flat := effective.Flatten()
+	keys := make([]string, 0, len(flat))
+	for k := range flat {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		value := flat[k]
+
+		provenance := "computed"
+		var raw interface{}
+		if o, found := origins[k]; found {
+			provenance = o.source
+			raw = o.raw
+			if expansions := expandedFrom(o.raw, store); len(expansions) > 0 {
+				provenance += ", expanded from " + strings.Join(expansions, ", ")
+			}
+		}
+
+		path := strings.Split(k, ".")
+		if secretKeys.MatchString(path[len(path)-1]) {
+			value = redacted
+		} else {
+			value = redactSecrets(value, raw, store)
+		}
+
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return fmt.Errorf("error encoding %s: %s", k, err)
+		}
+		fmt.Fprintf(w, "%s: %s  # %s\n", k, encoded, provenance)
+	}
+	return nil
+}
+
+// redactSecrets returns the value with the secret settings and the values
+// expanded from the keystore redacted. Flattened configs keep lists as
+// leaves, so the entries of lists, like modules or inputs, are redacted
+// recursively, using the raw value to know where each entry was expanded
+// from.
+func redactSecrets(value, raw interface{}, store keystore.Keystore) interface{} {
+	switch v := value.(type) {
+	case []interface{}:
+		rawList, _ := raw.([]interface{})
+		redactedList := make([]interface{}, len(v))
+		for i, entry := range v {
+			var rawEntry interface{}
+			if i < len(rawList) {
+				rawEntry = rawList[i]
+			}
+			redactedList[i] = redactSecrets(entry, rawEntry, store)
+		}
+		return redactedList
+	case map[string]interface{}:
+		return redactMap(v, raw, store)
+	case common.MapStr:
+		return common.MapStr(redactMap(v, raw, store))
+	default:
+		for _, e := range expandedFrom(raw, store) {
+			if strings.HasPrefix(e, "keystore:") {
+				return redacted
+			}
+		}
+		return value
+	}
+}
+
+func redactMap(m map[string]interface{}, raw interface{}, store keystore.Keystore) map[string]interface{} {
+	rawMap := toMap(raw)
+	redactedMap := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if secretKeys.MatchString(k) {
+			redactedMap[k] = redacted
+			continue
+		}
+		redactedMap[k] = redactSecrets(v, rawMap[k], store)
+	}
+	return redactedMap
+}
+
+func toMap(raw interface{}) map[string]interface{} {
+	switch m := raw.(type) {
+	case map[string]interface{}:
+		return m
+	case common.MapStr:
+		return m
+	default:
+		return nil
+	}
+}
+
+// expandedFrom returns the origin of all variables referenced by a raw value.
+func expandedFrom(raw interface{}, store keystore.Keystore) []string {
+	switch v := raw.(type) {
+	case []interface{}:
+		var expansions []string
+		for _, entry := range v {
+			expansions = append(expansions, expandedFrom(entry, store)...)
+		}
+		return expansions
+	case map[string]interface{}, common.MapStr:
+		m := toMap(v)
+		keys := make([]string, 0, len(m))
+		for k := range m {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		var expansions []string
+		for _, k := range keys {
+			expansions = append(expansions, expandedFrom(m[k], store)...)
+		}
+		return expansions
+	}
+
+	s, ok := raw.(string)
+	if !ok {
+		return nil
+	}
+
+	var expansions []string
+	for _, match := range varRefs.FindAllStringSubmatch(s, -1) {
+		name := match[1]
+		switch {
+		case store != nil && inKeystore(store, name):
+			expansions = append(expansions, "keystore:"+name)
+		case isEnvSet(name):
+			expansions = append(expansions, "env:"+name)
+		default:
+			expansions = append(expansions, "reference:"+name)
+		}
+	}
+	return expansions
+}
+
+func inKeystore(store keystore.Keystore, name string) bool {
+	_, err := store.Retrieve(name)
+	return err == nil
+}
+
+func isEnvSet(name string) bool {
+	_, found := os.LookupEnv(name)
+	return found
+}
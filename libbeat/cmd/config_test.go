@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+)
+
+func TestRenderWithProvenance(t *testing.T) {
+	os.Setenv("TEST_RENDER_HOST", "es:9200")
+	defer os.Unsetenv("TEST_RENDER_HOST")
+
+	sources := []cfgfile.Source{
+		{Name: "beat.yml", Config: common.MustNewConfigFrom(common.MapStr{
+			"name": "from-file",
+			"output.elasticsearch": common.MapStr{
+				"hosts":    []string{"${TEST_RENDER_HOST}"},
+				"password": "changeme",
+			},
+		})},
+		{Name: "-E flags", Config: common.MustNewConfigFrom(common.MapStr{
+			"name": "from-flag",
+		})},
+	}
+
+	effective := common.MapStr{
+		"name": "from-flag",
+		"output.elasticsearch": common.MapStr{
+			"hosts":    []string{"es:9200"},
+			"password": "changeme",
+		},
+		"cloud.auth": "elastic:secret",
+	}
+
+	var buf bytes.Buffer
+	err := renderWithProvenance(&buf, effective, sources, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, `cloud.auth: "[REDACTED]"  # computed
+name: "from-flag"  # -E flags
+output.elasticsearch.hosts: ["es:9200"]  # beat.yml, expanded from env:TEST_RENDER_HOST
+output.elasticsearch.password: "[REDACTED]"  # beat.yml
+`, buf.String())
+}
+
+func TestRenderWithProvenanceRedactsLists(t *testing.T) {
+	sources := []cfgfile.Source{
+		{Name: "beat.yml", Config: common.MustNewConfigFrom(common.MapStr{
+			"metricbeat.modules": []common.MapStr{
+				{"module": "mysql", "hosts": []string{"db:3306"}, "password": "changeme"},
+				{"module": "redis", "username": "${REDIS_USER}", "ssl": common.MapStr{"key": "/etc/redis.key"}},
+			},
+		})},
+	}
+
+	effective := common.MapStr{
+		"metricbeat.modules": []interface{}{
+			map[string]interface{}{"module": "mysql", "hosts": []interface{}{"db:3306"}, "password": "changeme"},
+			map[string]interface{}{"module": "redis", "username": "admin", "ssl": map[string]interface{}{"key": "/etc/redis.key"}},
+		},
+	}
+
+	store := &testKeystore{keys: map[string]string{"REDIS_USER": "admin"}}
+
+	var buf bytes.Buffer
+	err := renderWithProvenance(&buf, effective, sources, store)
+	require.NoError(t, err)
+
+	assert.Equal(t, `metricbeat.modules: [{"hosts":["db:3306"],"module":"mysql","password":"[REDACTED]"},{"module":"redis","ssl":{"key":"[REDACTED]"},"username":"[REDACTED]"}]  # beat.yml, expanded from keystore:REDIS_USER
+`, buf.String())
+}
+
+type testKeystore struct {
+	keys map[string]string
+}
+
+func (k *testKeystore) Retrieve(key string) (*keystore.SecureString, error) {
+	value, found := k.keys[key]
+	if !found {
+		return nil, keystore.ErrKeyDoesntExists
+	}
+	return keystore.NewSecureString([]byte(value)), nil
+}
+
+func (k *testKeystore) GetConfig() (*common.Config, error) {
+	return common.NewConfig(), nil
+}
+
+func (k *testKeystore) IsPersisted() bool {
+	return false
+}
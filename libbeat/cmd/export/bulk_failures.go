@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/outputs/elasticsearch"
+)
+
+// GenBulkFailuresCmd is the command used to export the failed bulk requests
+// recorded by the Elasticsearch output.
+func GenBulkFailuresCmd(settings instance.Settings) *cobra.Command {
+	genBulkFailuresCmd := &cobra.Command{
+		Use:   "bulk-failures",
+		Short: "Export the failed bulk requests recorded by the Elasticsearch output to stdout",
+		Run: func(cmd *cobra.Command, args []string) {
+			path, _ := cmd.Flags().GetString("path")
+			asJSON, _ := cmd.Flags().GetBool("json")
+
+			if path == "" {
+				b, err := instance.NewInitializedBeat(settings)
+				if err != nil {
+					fatalfInitCmd(err)
+				}
+				if b.Config.Output.Name() != "elasticsearch" {
+					fatalf("Bulk failures are only recorded by the Elasticsearch output.")
+				}
+				path, err = elasticsearch.BulkFailuresPath(b.Config.Output.Config())
+				if err != nil {
+					fatalf("Error reading the failure recorder config: %+v.", err)
+				}
+			}
+
+			entries, err := elasticsearch.ReadBulkFailures(path)
+			if os.IsNotExist(err) {
+				fmt.Fprintf(os.Stderr, "No bulk failures recorded in %s.\n", path)
+				return
+			}
+			if err != nil {
+				fatalf("Error reading bulk failures: %+v.", err)
+			}
+
+			if asJSON {
+				err = writeBulkFailuresJSON(os.Stdout, entries)
+			} else {
+				err = writeBulkFailures(os.Stdout, entries)
+			}
+			if err != nil {
+				fatalf("Error exporting bulk failures: %+v.", err)
+			}
+		},
+	}
+
+	genBulkFailuresCmd.Flags().String("path", "", "Path of the file with the recorded failures. By default the path configured in the Elasticsearch output is used.")
+	genBulkFailuresCmd.Flags().Bool("json", false, "Print the failures as newline delimited JSON")
+
+	return genBulkFailuresCmd
+}
+
+func writeBulkFailuresJSON(w io.Writer, entries []elasticsearch.BulkFailure) error {
+	enc := json.NewEncoder(w)
+	for _, entry := range entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeBulkFailures(w io.Writer, entries []elasticsearch.BulkFailure) error {
+	for i, entry := range entries {
+		if i > 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintf(w, "%s %s status=%d events=%d failed=%d\n",
+			entry.Timestamp.Format(time.RFC3339), entry.URL, entry.Status, entry.Events, entry.Failed)
+		if entry.Error != "" {
+			fmt.Fprintf(w, "error: %s\n", entry.Error)
+		}
+		if entry.Truncated {
+			fmt.Fprintln(w, "(request or response truncated)")
+		}
+		fmt.Fprintf(w, "--- request\n%s\n", strings.TrimRight(entry.Request, "\n"))
+		if entry.Response != "" {
+			fmt.Fprintf(w, "--- response\n%s\n", strings.TrimRight(entry.Response, "\n"))
+		}
+	}
+	return nil
+}
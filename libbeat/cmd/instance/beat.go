@@ -31,6 +31,7 @@ import (
 	"os"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	"go.elastic.co/apm"
@@ -48,6 +49,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/file"
 	"github.com/elastic/beats/v7/libbeat/common/reload"
 	"github.com/elastic/beats/v7/libbeat/common/seccomp"
+	"github.com/elastic/beats/v7/libbeat/control"
 	"github.com/elastic/beats/v7/libbeat/dashboards"
 	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
 	"github.com/elastic/beats/v7/libbeat/idxmgmt"
@@ -97,10 +99,13 @@ type beatConfig struct {
 
 	// beat internal components configurations
 	HTTP          *common.Config `config:"http"`
+	Control       *common.Config `config:"control"`
 	Path          paths.Path     `config:"path"`
 	Logging       *common.Config `config:"logging"`
 	MetricLogging *common.Config `config:"logging.metrics"`
 	Keystore      *common.Config `config:"keystore"`
+	Preflight     *common.Config `config:"preflight"`
+	Budget        *common.Config `config:"budget"`
 
 	// output/publishing related configurations
 	Pipeline pipeline.Config `config:",inline"`
@@ -404,6 +409,10 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	}
 	defer bl.unlock()
 
+	if err := b.preflight(settings); err != nil {
+		return err
+	}
+
 	// Set Beat ID in registry vars, in case it was loaded from meta file
 	infoRegistry := monitoring.GetNamespace("info").GetRegistry()
 	monitoring.NewString(infoRegistry, "uuid").Set(b.Info.ID.String())
@@ -417,13 +426,25 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	// Start the API Server before the Seccomp lock down, we do this so we can create the unix socket
 	// set the appropriate permission on the unix domain file without having to whitelist anything
 	// that would be set at runtime.
+	var apiServer *api.Server
 	if b.Config.HTTP.Enabled() {
-		s, err := api.NewWithDefaultRoutes(logp.NewLogger(""), b.Config.HTTP, monitoring.GetNamespace)
+		apiServer, err = api.NewWithDefaultRoutes(logp.NewLogger(""), b.Config.HTTP, monitoring.GetNamespace)
 		if err != nil {
 			return errw.Wrap(err, "could not start the HTTP server for the API")
 		}
-		s.Start()
-		defer s.Stop()
+		apiServer.Start()
+		defer apiServer.Stop()
+	}
+
+	// The control API socket is also created before the Seccomp lock down, it starts
+	// serving once the beater is created, so it can stop it.
+	var controlServer *control.Server
+	if b.Config.Control.Enabled() {
+		controlServer, err = control.New(logp.NewLogger(""), b.Info, reload.Register, b.Config.Control)
+		if err != nil {
+			return errw.Wrap(err, "could not create the control API")
+		}
+		defer controlServer.Stop()
 	}
 
 	if err = seccomp.LoadFilter(b.Config.Seccomp); err != nil {
@@ -435,6 +456,28 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 		return err
 	}
 
+	if apiServer != nil {
+		apiServer.AttachInjectHandler(b.processing, b.Publisher)
+	}
+
+	// The beater can be stopped by signals, the manager or the control API,
+	// but its Stop method must be invoked at most once.
+	var stopOnce sync.Once
+	stopBeater := func() { stopOnce.Do(beater.Stop) }
+
+	if controlServer != nil {
+		controlServer.Start(stopBeater)
+	}
+
+	budgetWatcher, err := b.budgetWatcher(stopBeater)
+	if err != nil {
+		return err
+	}
+	if budgetWatcher != nil {
+		budgetWatcher.Start()
+		defer budgetWatcher.Stop()
+	}
+
 	r, err := b.setupMonitoring(settings)
 	if err != nil {
 		return err
@@ -452,7 +495,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
-	svc.HandleSignals(beater.Stop, cancel)
+	svc.HandleSignals(stopBeater, cancel)
 
 	err = b.loadDashboards(ctx, false)
 	if err != nil {
@@ -462,7 +505,7 @@ func (b *Beat) launch(settings Settings, bt beat.Creator) error {
 	logp.Info("%s start running.", b.Info.Beat)
 
 	// Launch config manager
-	b.Manager.Start(beater.Stop)
+	b.Manager.Start(stopBeater)
 	defer b.Manager.Stop()
 
 	return beater.Run(&b.Beat)
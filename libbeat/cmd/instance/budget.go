@@ -0,0 +1,48 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package instance
+
+import (
+	"fmt"
+
+	"github.com/elastic/beats/v7/libbeat/budget"
+	"github.com/elastic/beats/v7/libbeat/cfgfile"
+	"github.com/elastic/beats/v7/libbeat/control"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// budgetWatcher returns the watcher of the budgets of the beat, or nil if no
+// budget is set. stop is called when a budget requires to shut down the beat.
+func (b *Beat) budgetWatcher(stop func()) (*budget.Watcher, error) {
+	config := budget.DefaultConfig()
+	if b.Config.Budget != nil {
+		if err := b.Config.Budget.Unpack(&config); err != nil {
+			return nil, fmt.Errorf("invalid budget config: %v", err)
+		}
+	}
+	if !config.IsEnabled() {
+		return nil, nil
+	}
+
+	actions := budget.Actions{
+		Pause:         control.Pausables.PauseAll,
+		RestartInputs: cfgfile.RestartRunners,
+		Shutdown:      stop,
+	}
+	return budget.NewWatcher(logp.NewLogger(""), config, actions, beatMetrics.NewRegistry("budget")), nil
+}
@@ -22,6 +22,7 @@ import (
 
 	"github.com/gofrs/uuid"
 
+	"github.com/elastic/beats/v7/libbeat/budget"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/libbeat/monitoring/report/log"
@@ -36,6 +37,9 @@ func init() {
 	beatMetrics = monitoring.Default.NewRegistry("beat")
 	monitoring.NewFunc(beatMetrics, "info", reportInfo, monitoring.Report)
 
+	goroutines := monitoring.GetNamespace("goroutines").GetRegistry()
+	monitoring.NewFunc(goroutines, "components", budget.ReportGoroutines)
+
 	var err error
 	ephemeralID, err = uuid.NewV4()
 	if err != nil {
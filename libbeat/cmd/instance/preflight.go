@@ -0,0 +1,215 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package instance
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/api"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/idxmgmt"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/paths"
+	"github.com/elastic/beats/v7/libbeat/preflight"
+)
+
+// preflight runs the preflight checks when they are enabled, logs their report
+// and aborts the start of the beat if any of them fails in strict mode.
+func (b *Beat) preflight(settings Settings) error {
+	config, err := b.preflightConfig()
+	if err != nil {
+		return err
+	}
+	if !config.IsEnabled() {
+		return nil
+	}
+
+	report := b.runPreflight(settings, config)
+	report.Log(logp.NewLogger("preflight"))
+	if config.IsStrict() {
+		return report.Err()
+	}
+	return nil
+}
+
+// Preflight runs the preflight checks for the current settings and returns
+// their report, whether they are enabled or not.
+func (b *Beat) Preflight(settings Settings) (*preflight.Report, error) {
+	config, err := b.preflightConfig()
+	if err != nil {
+		return nil, err
+	}
+	return b.runPreflight(settings, config), nil
+}
+
+func (b *Beat) preflightConfig() (preflight.Config, error) {
+	config := preflight.DefaultConfig()
+	if b.Config.Preflight != nil {
+		if err := b.Config.Preflight.Unpack(&config); err != nil {
+			return config, fmt.Errorf("invalid preflight config: %v", err)
+		}
+	}
+	return config, nil
+}
+
+func (b *Beat) runPreflight(settings Settings, config preflight.Config) *preflight.Report {
+	checks := b.preflightChecks(config)
+	checks = append(checks, settings.Preflight...)
+
+	if b.Config.Output.IsSet() {
+		check, closeOutput := b.outputPreflightCheck()
+		defer closeOutput()
+		checks = append(checks, check)
+
+		urls, client, err := b.clockSkewURLs(config)
+		if err != nil {
+			checks = append(checks, failedPreflightCheck("clock is in sync", err))
+		} else {
+			checks = append(checks, preflight.ClockSkewCheck(client, urls, config.ClockSkew.Max))
+		}
+	}
+
+	return preflight.Run(context.Background(), config.Timeout, checks)
+}
+
+// preflightChecks returns the checks of the paths, disk space and ports used
+// by the beat.
+func (b *Beat) preflightChecks(config preflight.Config) []preflight.Check {
+	dataPath := paths.Resolve(paths.Data, "")
+	checks := []preflight.Check{
+		preflight.PathCheck("path.data", dataPath),
+		preflight.DiskSpaceCheck("path.data", dataPath, uint64(config.Disk.MinFree)),
+	}
+
+	// The logs directory is only created when logging to files.
+	logsPath := paths.Resolve(paths.Logs, "")
+	if _, err := os.Stat(logsPath); err == nil {
+		checks = append(checks, preflight.PathCheck("path.logs", logsPath))
+	}
+
+	if b.Config.Pipeline.Queue.Name() == "spool" {
+		var spool struct {
+			File struct {
+				Path string `config:"path"`
+			} `config:"file"`
+		}
+		if err := b.Config.Pipeline.Queue.Config().Unpack(&spool); err == nil && spool.File.Path != "" {
+			spoolPath := filepath.Dir(spool.File.Path)
+			checks = append(checks,
+				preflight.PathCheck("queue.spool", spoolPath),
+				preflight.DiskSpaceCheck("queue.spool", spoolPath, uint64(config.Disk.MinFree)),
+			)
+		}
+	}
+
+	if b.Config.HTTP.Enabled() {
+		apiConfig := api.DefaultConfig
+		if err := b.Config.HTTP.Unpack(&apiConfig); err == nil && !isSocketHost(apiConfig.Host) {
+			address := apiConfig.Host + ":" + strconv.Itoa(apiConfig.Port)
+			checks = append(checks, preflight.PortCheck("http", address))
+		}
+	}
+
+	return checks
+}
+
+// outputPreflightCheck loads the output to check the connectivity to it, the
+// returned function closes its clients.
+func (b *Beat) outputPreflightCheck() (preflight.Check, func()) {
+	name := b.Config.Output.Name()
+	im, _ := idxmgmt.DefaultSupport(nil, b.Info, nil)
+	output, err := outputs.Load(im, b.Info, nil, name, b.Config.Output.Config())
+	if err != nil {
+		return failedPreflightCheck("output "+name+" is reachable", err), func() {}
+	}
+
+	return preflight.OutputCheck(name, output.Clients), func() {
+		for _, client := range output.Clients {
+			client.Close()
+		}
+	}
+}
+
+// clockSkewURLs returns the URLs to compare the clock with, and the client to
+// query them. The URLs of the config are used if any, otherwise the hosts of
+// the Elasticsearch output.
+func (b *Beat) clockSkewURLs(config preflight.Config) ([]string, *http.Client, error) {
+	if len(config.ClockSkew.URLs) > 0 || b.Config.Output.Name() != "elasticsearch" {
+		return config.ClockSkew.URLs, &http.Client{}, nil
+	}
+
+	esConfig := struct {
+		Hosts    []string          `config:"hosts"`
+		Protocol string            `config:"protocol"`
+		Path     string            `config:"path"`
+		TLS      *tlscommon.Config `config:"ssl"`
+	}{}
+	if err := b.Config.Output.Config().Unpack(&esConfig); err != nil {
+		return nil, nil, err
+	}
+
+	var urls []string
+	for _, host := range esConfig.Hosts {
+		url, err := common.MakeURL(esConfig.Protocol, esConfig.Path, host, 9200)
+		if err != nil {
+			return nil, nil, err
+		}
+		urls = append(urls, url)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(esConfig.TLS)
+	if err != nil {
+		return nil, nil, err
+	}
+	dialer := transport.NetDialer(config.Timeout)
+	tlsDialer, err := transport.TLSDialer(dialer, tlsConfig, config.Timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			Dial:            dialer.Dial,
+			DialTLS:         tlsDialer.Dial,
+			TLSClientConfig: tlsConfig.ToConfig(),
+		},
+	}
+	return urls, client, nil
+}
+
+func failedPreflightCheck(name string, err error) preflight.Check {
+	return preflight.Check{
+		Name: name,
+		Run: func(_ context.Context) error {
+			return err
+		},
+	}
+}
+
+func isSocketHost(host string) bool {
+	return strings.HasPrefix(host, "unix://") || strings.HasPrefix(host, "npipe://")
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/idxmgmt"
 	"github.com/elastic/beats/v7/libbeat/idxmgmt/ilm"
 	"github.com/elastic/beats/v7/libbeat/monitoring/report"
+	"github.com/elastic/beats/v7/libbeat/preflight"
 	"github.com/elastic/beats/v7/libbeat/publisher/processing"
 )
 
@@ -45,5 +46,8 @@ type Settings struct {
 
 	Processing processing.SupportFactory
 
+	// additional checks of the preflight phase, specific to the beat
+	Preflight []preflight.Check
+
 	Umask *int
 }
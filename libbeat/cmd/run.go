@@ -45,6 +45,7 @@ func genRunCmd(settings instance.Settings, beatCreator beat.Creator) *cobra.Comm
 	runCmd.Flags().AddGoFlag(flag.CommandLine.Lookup("httpprof"))
 	runCmd.Flags().AddGoFlag(flag.CommandLine.Lookup("cpuprofile"))
 	runCmd.Flags().AddGoFlag(flag.CommandLine.Lookup("memprofile"))
+	runCmd.Flags().AddGoFlag(flag.CommandLine.Lookup("strict"))
 
 	if settings.RunFlags != nil {
 		runCmd.Flags().AddFlagSet(settings.RunFlags)
@@ -0,0 +1,172 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/api/npipe"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// client reads the metrics of a running Beat from its HTTP endpoint.
+type client struct {
+	base string
+	http *http.Client
+}
+
+// newClient returns a client for the HTTP endpoint at host. The host is given
+// as in the `http.host` setting, with the port used for hosts without scheme.
+func newClient(host string, port int) (*client, error) {
+	if npipe.IsNPipe(host) {
+		return nil, fmt.Errorf("named pipes are not supported, use a TCP or unix socket endpoint")
+	}
+
+	u, err := url.Parse(host)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{}
+	base := "http://" + net.JoinHostPort(host, strconv.Itoa(port))
+	switch {
+	case u.Host == "" && u.Scheme == "":
+	case u.Scheme == "http":
+		base = "http://" + u.Host
+	case u.Scheme == "unix":
+		path := u.Path
+		transport.DialContext = func(ctx context.Context, _, _ string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", path)
+		}
+		base = "http://unix"
+	default:
+		return nil, fmt.Errorf("unknown scheme %s for host %s", u.Scheme, host)
+	}
+
+	return &client{
+		base: base,
+		http: &http.Client{Transport: transport, Timeout: 5 * time.Second},
+	}, nil
+}
+
+// snapshot is a sample of the metrics of a Beat.
+type snapshot struct {
+	time   time.Time
+	info   map[string]string
+	stats  map[string]float64
+	output string
+}
+
+func (c *client) snapshot() (*snapshot, error) {
+	s := &snapshot{time: time.Now()}
+
+	var info common.MapStr
+	if err := c.get("/", &info); err != nil {
+		return nil, err
+	}
+	s.info = map[string]string{}
+	for k, v := range info.Flatten() {
+		s.info[k] = fmt.Sprint(v)
+	}
+
+	var stats common.MapStr
+	if err := c.get("/stats", &stats); err != nil {
+		return nil, err
+	}
+	s.stats = map[string]float64{}
+	for k, v := range stats.Flatten() {
+		if n, ok := v.(float64); ok {
+			s.stats[k] = n
+		}
+	}
+	if output, err := stats.GetValue("libbeat.output.type"); err == nil {
+		s.output = fmt.Sprint(output)
+	}
+	return s, nil
+}
+
+func (c *client) get(path string, out interface{}) error {
+	resp, err := c.http.Get(c.base + path)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request to %s failed with status %d", path, resp.StatusCode)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// input is a source of events of the Beat, like a metricset of Metricbeat.
+type input struct {
+	name     string
+	events   string
+	failures string
+}
+
+// inputs returns the inputs with their own event counters, like the
+// `metricbeat.<module>.<metricset>.events` metrics of Metricbeat. Beats
+// without them have a single input counting all the events published to the
+// pipeline.
+func (s *snapshot) inputs() []input {
+	beat := s.info["beat"]
+	prefix := beat + "."
+	var inputs []input
+	for key := range s.stats {
+		if len(key) <= len(prefix)+len(".events") || !strings.HasPrefix(key, prefix) || !strings.HasSuffix(key, ".events") {
+			continue
+		}
+		name := key[len(prefix) : len(key)-len(".events")]
+		inputs = append(inputs, input{
+			name:     strings.Replace(name, ".", "/", 1),
+			events:   key,
+			failures: prefix + name + ".failures",
+		})
+	}
+	sort.Slice(inputs, func(i, j int) bool { return inputs[i].name < inputs[j].name })
+
+	if len(inputs) == 0 {
+		inputs = append(inputs, input{name: beat, events: "libbeat.pipeline.events.total"})
+	}
+	return inputs
+}
+
+// rate returns the change per second of a counter between two snapshots. It
+// returns false if there is no previous snapshot or the counter was reset.
+func rate(prev, cur *snapshot, key string) (float64, bool) {
+	if prev == nil {
+		return 0, false
+	}
+	elapsed := cur.time.Sub(prev.time).Seconds()
+	delta := cur.stats[key] - prev.stats[key]
+	if elapsed <= 0 || delta < 0 {
+		return 0, false
+	}
+	return delta / elapsed, true
+}
@@ -0,0 +1,225 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	labelWidth = 24
+	valueWidth = 12
+	totalWidth = 16
+	maxBar     = 50
+)
+
+// counter is a row of the screen with the rate and the total of a counter.
+type counter struct {
+	label string
+	key   string
+}
+
+// frame renders the metrics of a snapshot, with the rates since the previous
+// one, as the flow of events from the inputs to the output.
+type frame struct {
+	prev, cur *snapshot
+	width     int
+	interval  time.Duration
+	hint      string
+}
+
+func (f *frame) render(w io.Writer) {
+	var b strings.Builder
+
+	info := f.cur.info
+	header := fmt.Sprintf("%s %s on %s, up %s, refresh every %s",
+		info["beat"], info["version"], info["hostname"], f.uptime(), f.interval)
+	if f.hint != "" && len(header)+len(f.hint)+1 < f.width {
+		header += strings.Repeat(" ", f.width-len(header)-len(f.hint)) + f.hint
+	}
+	fmt.Fprintln(&b, header)
+	fmt.Fprintln(&b)
+
+	inputs := f.cur.inputs()
+	pipeline := []counter{
+		{"published", "libbeat.pipeline.events.published"},
+		{"filtered", "libbeat.pipeline.events.filtered"},
+		{"dropped", "libbeat.pipeline.events.dropped"},
+		{"failed", "libbeat.pipeline.events.failed"},
+		{"retry", "libbeat.pipeline.events.retry"},
+	}
+	queue := []counter{
+		{"acked", "libbeat.pipeline.queue.acked"},
+	}
+	output := []counter{
+		{"acked", "libbeat.output.events.acked"},
+		{"failed", "libbeat.output.events.failed"},
+		{"dropped", "libbeat.output.events.dropped"},
+		{"duplicates", "libbeat.output.events.duplicates"},
+		{"too many requests", "libbeat.output.events.toomany"},
+		{"batches", "libbeat.output.events.batches"},
+	}
+
+	// All the bars share the same scale, so the width of the flows can be
+	// compared between stages.
+	var keys []string
+	for _, in := range inputs {
+		keys = append(keys, in.events)
+	}
+	for _, rows := range [][]counter{pipeline, queue, output} {
+		for _, c := range rows {
+			keys = append(keys, c.key)
+		}
+	}
+	scale := 0.0
+	for _, key := range keys {
+		if r, ok := rate(f.prev, f.cur, key); ok && r > scale {
+			scale = r
+		}
+	}
+
+	fmt.Fprintf(&b, "%-*s%s%*s%*s\n", labelWidth, "INPUTS", strings.Repeat(" ", f.barWidth()), valueWidth, "events/s", totalWidth, "total")
+	for _, in := range inputs {
+		f.counter(&b, counter{"  " + in.name, in.events}, scale)
+		if failures, ok := f.cur.stats[in.failures]; ok && failures > 0 {
+			f.counter(&b, counter{"    failures", in.failures}, scale)
+		}
+	}
+	f.arrow(&b)
+
+	fmt.Fprintln(&b, "PIPELINE")
+	for _, c := range pipeline {
+		f.counter(&b, counter{"  " + c.label, c.key}, scale)
+	}
+	f.gauge(&b, "  active", f.number("libbeat.pipeline.events.active"))
+	f.arrow(&b)
+
+	fmt.Fprintln(&b, "QUEUE")
+	for _, c := range queue {
+		f.counter(&b, counter{"  " + c.label, c.key}, scale)
+	}
+	f.arrow(&b)
+
+	fmt.Fprintf(&b, "OUTPUT %s\n", f.cur.output)
+	for _, c := range output {
+		f.counter(&b, counter{"  " + c.label, c.key}, scale)
+	}
+	f.gauge(&b, "  active", f.number("libbeat.output.events.active"))
+	f.gauge(&b, "  write", f.bytesRate("libbeat.output.write.bytes")+
+		fmt.Sprintf(", %s errors", humanize.Comma(int64(f.cur.stats["libbeat.output.write.errors"]))))
+	f.gauge(&b, "  read", f.bytesRate("libbeat.output.read.bytes")+
+		fmt.Sprintf(", %s errors", humanize.Comma(int64(f.cur.stats["libbeat.output.read.errors"]))))
+	fmt.Fprintln(&b)
+
+	fmt.Fprintln(&b, "MEMORY")
+	f.gauge(&b, "  allocated", f.bytes("beat.memstats.memory_alloc"))
+	f.gauge(&b, "  next GC", f.bytes("beat.memstats.gc_next"))
+	f.gauge(&b, "  RSS", f.bytes("beat.memstats.rss"))
+	f.gauge(&b, "  allocation rate", f.bytesRate("beat.memstats.memory_total"))
+	f.gauge(&b, "  goroutines", f.number("beat.runtime.goroutines"))
+
+	io.WriteString(w, b.String())
+}
+
+func (f *frame) counter(b *strings.Builder, c counter, scale float64) {
+	value := "-"
+	bar := ""
+	if r, ok := rate(f.prev, f.cur, c.key); ok {
+		value = fmt.Sprintf("%.1f/s", r)
+		bar = f.bar(r, scale)
+	}
+	fmt.Fprintf(b, "%-*s%-*s%*s%*s\n", labelWidth, truncate(c.label, labelWidth-1), f.barWidth(), bar,
+		valueWidth, value, totalWidth, humanize.Comma(int64(f.cur.stats[c.key])))
+}
+
+func (f *frame) gauge(b *strings.Builder, label, value string) {
+	fmt.Fprintf(b, "%-*s%s\n", labelWidth, label, value)
+}
+
+func (f *frame) arrow(b *strings.Builder) {
+	indent := strings.Repeat(" ", labelWidth+f.barWidth()/2)
+	fmt.Fprintf(b, "%s│\n%s▼\n", indent, indent)
+}
+
+// number and bytes format the current value of a metric, or - for metrics
+// not reported by the Beat.
+func (f *frame) number(key string) string {
+	v, ok := f.cur.stats[key]
+	if !ok {
+		return "-"
+	}
+	return humanize.Comma(int64(v))
+}
+
+func (f *frame) bytes(key string) string {
+	v, ok := f.cur.stats[key]
+	if !ok {
+		return "-"
+	}
+	return humanize.Bytes(uint64(v))
+}
+
+func (f *frame) bytesRate(key string) string {
+	r, ok := rate(f.prev, f.cur, key)
+	if !ok {
+		return "-"
+	}
+	return humanize.Bytes(uint64(r)) + "/s"
+}
+
+// bar returns a bar proportional to the rate, with at least one block for any
+// non zero rate.
+func (f *frame) bar(r, scale float64) string {
+	if r <= 0 || scale <= 0 {
+		return ""
+	}
+	n := int(math.Round(r / scale * float64(f.barWidth()-1)))
+	if n < 1 {
+		n = 1
+	}
+	return strings.Repeat("█", n)
+}
+
+func (f *frame) barWidth() int {
+	width := f.width - labelWidth - valueWidth - totalWidth
+	if width > maxBar {
+		width = maxBar
+	}
+	if width < 2 {
+		width = 2
+	}
+	return width
+}
+
+func (f *frame) uptime() time.Duration {
+	uptime := time.Duration(f.cur.stats["beat.info.uptime.ms"]) * time.Millisecond
+	return uptime.Truncate(time.Second)
+}
+
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n-1] + "…"
+}
@@ -0,0 +1,189 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/crypto/ssh/terminal"
+
+	"github.com/elastic/beats/v7/libbeat/api"
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/common/cli"
+)
+
+const (
+	clearScreen = "\x1b[H\x1b[2J"
+	hideCursor  = "\x1b[?25l"
+	showCursor  = "\x1b[?25h"
+)
+
+// GenTopCmd is the command showing live statistics of a running Beat.
+func GenTopCmd(settings instance.Settings) *cobra.Command {
+	topCmd := &cobra.Command{
+		Use:   "top",
+		Short: "Show live statistics of the running " + settings.Name,
+		Long: "Show the live event rates from the inputs to the queue and the output of the running " + settings.Name +
+			", with its drop and retry counters and its memory usage. Statistics are read from the HTTP endpoint " +
+			"of the running " + settings.Name + ", that needs to be enabled with http.enabled.",
+		Run: cli.RunWith(func(cmd *cobra.Command, args []string) error {
+			host, _ := cmd.Flags().GetString("host")
+			interval, _ := cmd.Flags().GetDuration("interval")
+			iterations, _ := cmd.Flags().GetInt("iterations")
+			batch, _ := cmd.Flags().GetBool("batch")
+
+			if interval <= 0 {
+				return fmt.Errorf("interval must be positive")
+			}
+
+			c, err := topClient(settings, host)
+			if err != nil {
+				return err
+			}
+
+			interactive := !batch && terminal.IsTerminal(int(os.Stdout.Fd()))
+			return run(c, os.Stdout, interval, iterations, interactive)
+		}),
+	}
+
+	topCmd.Flags().String("host", "", "Address of the HTTP endpoint of the running Beat, like localhost:5066 or unix:///var/run/beat.sock. By default the address in the http settings is used.")
+	topCmd.Flags().Duration("interval", time.Second, "Time between refreshes")
+	topCmd.Flags().Int("iterations", 0, "Number of refreshes before exiting, 0 to run until interrupted")
+	topCmd.Flags().Bool("batch", false, "Print each refresh after the previous one, without clearing the screen or reading keys")
+
+	return topCmd
+}
+
+// topClient returns a client for the endpoint given in the flags, or for the
+// endpoint configured in the http settings of the Beat.
+func topClient(settings instance.Settings, host string) (*client, error) {
+	if host != "" {
+		if !strings.Contains(host, "://") {
+			host = "http://" + host
+		}
+		return newClient(host, api.DefaultConfig.Port)
+	}
+
+	b, err := instance.NewInitializedBeat(settings)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing beat: %s", err)
+	}
+
+	if !b.Config.HTTP.Enabled() {
+		return nil, fmt.Errorf("the HTTP endpoint of %s is not enabled, set http.enabled to true or use --host", settings.Name)
+	}
+	config := api.DefaultConfig
+	if err := b.Config.HTTP.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("error reading the http settings: %s", err)
+	}
+	return newClient(config.Host, config.Port)
+}
+
+// run refreshes the statistics every interval. In interactive mode the screen
+// is cleared before each refresh and the q key stops the command.
+func run(c *client, out io.Writer, interval time.Duration, iterations int, interactive bool) error {
+	quit := make(chan struct{})
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	width := 80
+	hint := ""
+	if interactive {
+		fd := int(os.Stdout.Fd())
+		if w, _, err := terminal.GetSize(fd); err == nil && w > 0 {
+			width = w
+		}
+
+		// Keys are read in raw mode, where lines need an explicit carriage
+		// return.
+		if stdin := int(os.Stdin.Fd()); terminal.IsTerminal(stdin) {
+			if state, err := terminal.MakeRaw(stdin); err == nil {
+				defer terminal.Restore(stdin, state)
+				out = &rawWriter{out}
+				hint = "q: quit"
+				go readKeys(os.Stdin, quit)
+			}
+		}
+
+		fmt.Fprint(out, hideCursor)
+		defer fmt.Fprint(out, showCursor)
+	}
+
+	var prev *snapshot
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for i := 0; iterations == 0 || i < iterations; i++ {
+		if i > 0 {
+			select {
+			case <-quit:
+				return nil
+			case <-signals:
+				return nil
+			case <-ticker.C:
+			}
+		}
+
+		cur, err := c.snapshot()
+		if err != nil {
+			return fmt.Errorf("error reading statistics: %s", err)
+		}
+
+		if interactive {
+			fmt.Fprint(out, clearScreen)
+		} else if i > 0 {
+			fmt.Fprintln(out)
+		}
+		f := &frame{prev: prev, cur: cur, width: width, interval: interval, hint: hint}
+		f.render(out)
+		prev = cur
+	}
+	return nil
+}
+
+// readKeys closes quit when q, Q or Ctrl-C are pressed.
+func readKeys(in io.Reader, quit chan struct{}) {
+	buf := make([]byte, 1)
+	for {
+		if _, err := in.Read(buf); err != nil {
+			return
+		}
+		switch buf[0] {
+		case 'q', 'Q', 3:
+			close(quit)
+			return
+		}
+	}
+}
+
+// rawWriter writes line breaks as expected by terminals in raw mode.
+type rawWriter struct {
+	w io.Writer
+}
+
+func (r *rawWriter) Write(p []byte) (int, error) {
+	_, err := r.w.Write([]byte(strings.Replace(string(p), "\n", "\r\n", -1)))
+	return len(p), err
+}
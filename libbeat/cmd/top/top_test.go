@@ -0,0 +1,203 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package top
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeBeat serves the HTTP API of a Metricbeat whose counters grow on each
+// request of the stats.
+type fakeBeat struct {
+	mu       sync.Mutex
+	requests int
+}
+
+func (f *fakeBeat) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/":
+		fmt.Fprint(w, `{"beat":"metricbeat","hostname":"host1","name":"host1","version":"7.10.0"}`)
+	case "/stats":
+		n := f.requests * 100
+		f.requests++
+		fmt.Fprintf(w, `{
+			"beat": {
+				"info": {"uptime": {"ms": 3723000}},
+				"memstats": {"gc_next": 16777216, "memory_alloc": 8388608, "memory_total": %d},
+				"runtime": {"goroutines": 42}
+			},
+			"libbeat": {
+				"output": {
+					"type": "elasticsearch",
+					"events": {"acked": %d, "active": 10, "batches": 3, "dropped": 0, "duplicates": 0, "failed": 5, "toomany": 0, "total": 100},
+					"read": {"bytes": 1000, "errors": 0},
+					"write": {"bytes": %d, "errors": 1}
+				},
+				"pipeline": {
+					"events": {"active": 20, "dropped": 0, "failed": 0, "filtered": 2, "published": %d, "retry": 5, "total": %d},
+					"queue": {"acked": %d}
+				}
+			},
+			"metricbeat": {
+				"system": {
+					"cpu": {"events": %d, "failures": 0, "success": 10},
+					"memory": {"events": %d, "failures": 2, "success": 10}
+				}
+			}
+		}`, n*1024, n, n*512, n, n, n, n/4, n*3/4)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestSnapshot(t *testing.T) {
+	server := httptest.NewServer(&fakeBeat{})
+	defer server.Close()
+
+	c, err := newClient(server.URL, 0)
+	require.NoError(t, err)
+
+	s, err := c.snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, "metricbeat", s.info["beat"])
+	assert.Equal(t, "elasticsearch", s.output)
+	assert.Equal(t, float64(42), s.stats["beat.runtime.goroutines"])
+
+	assert.Equal(t, []input{
+		{name: "system/cpu", events: "metricbeat.system.cpu.events", failures: "metricbeat.system.cpu.failures"},
+		{name: "system/memory", events: "metricbeat.system.memory.events", failures: "metricbeat.system.memory.failures"},
+	}, s.inputs())
+
+	// Beats without counters per input have a single one.
+	s.info["beat"] = "filebeat"
+	assert.Equal(t, []input{{name: "filebeat", events: "libbeat.pipeline.events.total"}}, s.inputs())
+}
+
+func TestRate(t *testing.T) {
+	now := time.Now()
+	prev := &snapshot{time: now, stats: map[string]float64{"a": 100}}
+	cur := &snapshot{time: now.Add(2 * time.Second), stats: map[string]float64{"a": 300}}
+
+	r, ok := rate(prev, cur, "a")
+	assert.True(t, ok)
+	assert.Equal(t, float64(100), r)
+
+	_, ok = rate(nil, cur, "a")
+	assert.False(t, ok)
+
+	// Counters are reset when the Beat restarts.
+	_, ok = rate(cur, &snapshot{time: now.Add(3 * time.Second), stats: map[string]float64{"a": 10}}, "a")
+	assert.False(t, ok)
+}
+
+func TestRender(t *testing.T) {
+	server := httptest.NewServer(&fakeBeat{})
+	defer server.Close()
+	c, err := newClient(server.URL, 0)
+	require.NoError(t, err)
+
+	prev, err := c.snapshot()
+	require.NoError(t, err)
+	cur, err := c.snapshot()
+	require.NoError(t, err)
+	now := time.Now()
+	prev.time = now
+	cur.time = now.Add(time.Second)
+
+	var buf bytes.Buffer
+	f := &frame{prev: prev, cur: cur, width: 100, interval: time.Second, hint: "q: quit"}
+	f.render(&buf)
+	out := buf.String()
+
+	header := strings.Split(out, "\n")[0]
+	assert.True(t, strings.HasPrefix(header, "metricbeat 7.10.0 on host1, up 1h2m3s, refresh every 1s "))
+	assert.True(t, strings.HasSuffix(header, "q: quit"))
+	assert.Len(t, header, 100)
+
+	assert.Regexp(t, `\n  system/cpu +█+ +25\.0/s +25\n`, out)
+	assert.Regexp(t, `\n  system/memory +█+ +75\.0/s +75\n    failures +0\.0/s +2\n`, out)
+	assert.Regexp(t, `\nPIPELINE\n  published +█{47} +100\.0/s +100\n`, out)
+	assert.Regexp(t, `\n  retry +0\.0/s +5\n`, out)
+	assert.Regexp(t, `\nOUTPUT elasticsearch\n  acked +█{47} +100\.0/s +100\n`, out)
+	assert.Regexp(t, `\n  write +51 kB/s, 1 errors\n`, out)
+	assert.Regexp(t, `\n  allocated +8\.4 MB\n`, out)
+	assert.Regexp(t, `\n  RSS +-\n`, out)
+	assert.Regexp(t, `\n  goroutines +42\n`, out)
+
+	// There are no rates without a previous snapshot.
+	buf.Reset()
+	f = &frame{cur: cur, width: 100, interval: time.Second}
+	f.render(&buf)
+	assert.Regexp(t, `\n  system/cpu +- +25\n`, buf.String())
+}
+
+func TestRunBatch(t *testing.T) {
+	server := httptest.NewServer(&fakeBeat{})
+	defer server.Close()
+	c, err := newClient(server.URL, 0)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, run(c, &buf, time.Millisecond, 3, false))
+	assert.Equal(t, 3, strings.Count(buf.String(), "metricbeat 7.10.0 on host1"))
+	assert.NotContains(t, buf.String(), clearScreen)
+}
+
+func TestNewClient(t *testing.T) {
+	_, err := newClient("ftp://localhost", 0)
+	assert.Error(t, err)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("unix sockets are not supported on windows")
+	}
+
+	dir, err := ioutil.TempDir("", "top")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "beat.sock")
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	server := &http.Server{Handler: &fakeBeat{}}
+	go server.Serve(l)
+	defer server.Close()
+
+	c, err := newClient("unix://"+path, 0)
+	require.NoError(t, err)
+	s, err := c.snapshot()
+	require.NoError(t, err)
+	assert.Equal(t, "metricbeat", s.info["beat"])
+}
@@ -22,6 +22,7 @@ package docker
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 	"time"
 
@@ -44,6 +45,13 @@ const (
 	dockerEventsWatchPityTimerTimeout  = 10 * time.Minute
 )
 
+// Health statuses of containers with a HEALTHCHECK
+const (
+	HealthStarting  = "starting"
+	HealthHealthy   = "healthy"
+	HealthUnhealthy = "unhealthy"
+)
+
 // Watcher reads docker events and keeps a list of known containers
 type Watcher interface {
 	// Start watching docker API for new containers
@@ -96,6 +104,8 @@ type Container struct {
 	Labels      map[string]string
 	IPAddresses []string
 	Ports       []types.Port
+	// Health is the status of the container HEALTHCHECK, empty if it has none
+	Health string
 }
 
 // Client for docker interface
@@ -268,8 +278,8 @@ func (w *watcher) watch() {
 				w.lastValidTimestamp = event.Time
 				w.lastWatchReceivedEventTime = time.Now()
 
-				// Add / update
-				if event.Action == "start" || event.Action == "update" {
+				// Add / update, health status actions are like `health_status: healthy`
+				if event.Action == "start" || event.Action == "update" || strings.HasPrefix(event.Action, "health_status") {
 					filter := filters.NewArgs()
 					filter.Add("id", event.Actor.ID)
 
@@ -384,12 +394,28 @@ func (w *watcher) listContainers(options types.ContainerListOptions) ([]*Contain
 			Labels:      c.Labels,
 			Ports:       c.Ports,
 			IPAddresses: ipaddresses,
+			Health:      containerHealth(c.Status),
 		})
 	}
 
 	return result, nil
 }
 
+// containerHealth gets the health of a container from its status, like
+// `Up 2 minutes (healthy)` or `Up 5 seconds (health: starting)`.
+func containerHealth(status string) string {
+	switch {
+	case strings.HasSuffix(status, "(health: starting)"):
+		return HealthStarting
+	case strings.HasSuffix(status, "(healthy)"):
+		return HealthHealthy
+	case strings.HasSuffix(status, "(unhealthy)"):
+		return HealthUnhealthy
+	default:
+		return ""
+	}
+}
+
 // Clean up deleted containers after they are not used anymore
 func (w *watcher) cleanupWorker() {
 	log := w.log
@@ -357,6 +357,52 @@ func TestWatcherUpdateEventShortID(t *testing.T) {
 	assert.Equal(t, 0, len(watcher.deleted))
 }
 
+func TestWatcherHealthStatusEvent(t *testing.T) {
+	watcher := runWatcher(t, true,
+		[][]types.Container{
+			[]types.Container{
+				types.Container{
+					ID:              "0332dbd79e20",
+					Names:           []string{"/containername", "othername"},
+					Image:           "busybox",
+					Status:          "Up 5 seconds (health: starting)",
+					NetworkSettings: &types.SummaryNetworkSettings{},
+				},
+			},
+			[]types.Container{
+				types.Container{
+					ID:              "0332dbd79e20",
+					Names:           []string{"/containername", "othername"},
+					Image:           "busybox",
+					Status:          "Up 40 seconds (healthy)",
+					NetworkSettings: &types.SummaryNetworkSettings{},
+				},
+			},
+		},
+		[]interface{}{
+			events.Message{
+				Action: "health_status: healthy",
+				Actor: events.Actor{
+					ID: "0332dbd79e20",
+					Attributes: map[string]string{
+						"name":  "containername",
+						"image": "busybox",
+					},
+				},
+			},
+		},
+	)
+
+	assert.Equal(t, map[string]*Container{
+		"0332dbd79e20": &Container{
+			ID:     "0332dbd79e20",
+			Name:   "containername",
+			Image:  "busybox",
+			Health: HealthHealthy,
+		},
+	}, watcher.Containers())
+}
+
 func TestWatcherDie(t *testing.T) {
 	t.Skip("flaky test: https://github.com/elastic/beats/issues/7906")
 
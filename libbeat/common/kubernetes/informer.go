@@ -23,20 +23,55 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 )
 
 func nodeSelector(options *metav1.ListOptions, opt WatchOptions) {
 	if opt.Node != "" {
-		options.FieldSelector = "spec.nodeName=" + opt.Node
+		addFieldSelector(options, "spec.nodeName="+opt.Node)
 	}
 }
 
 func nameSelector(options *metav1.ListOptions, name string) {
 	if name != "" {
-		options.FieldSelector = "metadata.name=" + name
+		addFieldSelector(options, "metadata.name="+name)
+	}
+}
+
+// addFieldSelector adds a field selector to the ones already set, so all of
+// them need to match.
+func addFieldSelector(options *metav1.ListOptions, selector string) {
+	if options.FieldSelector != "" {
+		selector = options.FieldSelector + "," + selector
+	}
+	options.FieldSelector = selector
+}
+
+// withSelectors wraps a ListWatch so it only lists and watches the objects
+// matching the label and field selectors of the watch options.
+func withSelectors(listwatch *cache.ListWatch, opts WatchOptions) *cache.ListWatch {
+	if opts.LabelSelector == "" && opts.FieldSelector == "" {
+		return listwatch
+	}
+
+	selectors := func(options *metav1.ListOptions) {
+		options.LabelSelector = opts.LabelSelector
+		options.FieldSelector = opts.FieldSelector
+	}
+	listFunc, watchFunc := listwatch.ListFunc, listwatch.WatchFunc
+	return &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			selectors(&options)
+			return listFunc(options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			selectors(&options)
+			return watchFunc(options)
+		},
 	}
 }
 
@@ -149,9 +184,22 @@ func NewInformer(client kubernetes.Interface, resource Resource, opts WatchOptio
 		}
 
 		objType = "service"
+	case *EndpointSlice:
+		es := client.DiscoveryV1beta1().EndpointSlices(opts.Namespace)
+		listwatch = &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return es.List(ctx, options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return es.Watch(ctx, options)
+			},
+		}
+
+		objType = "endpointslice"
 	default:
 		return nil, "", fmt.Errorf("unsupported resource type for watching %T", resource)
 	}
+	listwatch = withSelectors(listwatch, opts)
 
 	if indexers != nil {
 		return cache.NewSharedIndexInformer(listwatch, resource, opts.SyncTimeout, indexers), objType, nil
@@ -159,3 +207,24 @@ func NewInformer(client kubernetes.Interface, resource Resource, opts WatchOptio
 
 	return cache.NewSharedInformer(listwatch, resource, opts.SyncTimeout), objType, nil
 }
+
+// NewCustomResourceInformer creates an informer for the objects of a custom resource
+func NewCustomResourceInformer(client dynamic.Interface, resource schema.GroupVersionResource, opts WatchOptions) (cache.SharedInformer, string, error) {
+	if resource.Version == "" || resource.Resource == "" {
+		return nil, "", fmt.Errorf("version and resource are required to watch custom resources, got %v", resource)
+	}
+
+	ctx := context.TODO()
+	cr := client.Resource(resource).Namespace(opts.Namespace)
+	listwatch := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return cr.List(ctx, options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return cr.Watch(ctx, options)
+		},
+	}
+	listwatch = withSelectors(listwatch, opts)
+
+	return cache.NewSharedInformer(listwatch, &CustomResource{}, opts.SyncTimeout), resource.Resource, nil
+}
@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadata
+
+import (
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+)
+
+type endpointSlice struct {
+	store     cache.Store
+	namespace MetaGen
+	resource  *Resource
+}
+
+// NewEndpointSliceMetadataGenerator creates a metagen for endpoint slice resources
+func NewEndpointSliceMetadataGenerator(cfg *common.Config, endpointSlices cache.Store, namespace MetaGen) MetaGen {
+	return &endpointSlice{
+		resource:  NewResourceMetadataGenerator(cfg),
+		store:     endpointSlices,
+		namespace: namespace,
+	}
+}
+
+// Generate generates endpoint slice metadata from a resource object, including
+// the name of the service the slice belongs to
+func (e *endpointSlice) Generate(obj kubernetes.Resource, opts ...FieldOptions) common.MapStr {
+	slice, ok := obj.(*kubernetes.EndpointSlice)
+	if !ok {
+		return nil
+	}
+
+	out := e.resource.Generate("endpointslice", obj, opts...)
+
+	if service := slice.GetLabels()[discoveryv1beta1.LabelServiceName]; service != "" {
+		safemapstr.Put(out, "service.name", service)
+	}
+
+	if e.namespace != nil {
+		meta := e.namespace.GenerateFromName(slice.GetNamespace())
+		if meta != nil {
+			out.DeepUpdate(meta)
+		}
+	}
+
+	return out
+}
+
+// GenerateFromName generates endpoint slice metadata from an endpoint slice name
+func (e *endpointSlice) GenerateFromName(name string, opts ...FieldOptions) common.MapStr {
+	if e.store == nil {
+		return nil
+	}
+
+	if obj, ok, _ := e.store.GetByKey(name); ok {
+		slice, ok := obj.(*kubernetes.EndpointSlice)
+		if !ok {
+			return nil
+		}
+
+		return e.Generate(slice, opts...)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestEndpointSlice_Generate(t *testing.T) {
+	uid := "005f3b90-4b9d-12f8-acf0-31020a840133"
+	slice := &discoveryv1beta1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "redis-x7k2p",
+			UID:       types.UID(uid),
+			Namespace: "default",
+			Labels: map[string]string{
+				"app":                             "redis",
+				discoveryv1beta1.LabelServiceName: "redis",
+			},
+		},
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "EndpointSlice",
+			APIVersion: "discovery.k8s.io/v1beta1",
+		},
+	}
+	expected := common.MapStr{
+		"endpointslice": common.MapStr{
+			"name": "redis-x7k2p",
+			"uid":  uid,
+		},
+		"service": common.MapStr{
+			"name": "redis",
+		},
+		"labels": common.MapStr{
+			"app":                        "redis",
+			"kubernetes_io/service-name": "redis",
+		},
+		"namespace": "default",
+	}
+
+	cfg := common.MustNewConfigFrom(map[string]interface{}{
+		"labels.dedot": true,
+	})
+	metagen := NewEndpointSliceMetadataGenerator(cfg, nil, nil)
+	assert.Equal(t, expected, metagen.Generate(slice))
+
+	slices := cache.NewStore(cache.MetaNamespaceKeyFunc)
+	slices.Add(slice)
+	metagen = NewEndpointSliceMetadataGenerator(cfg, slices, nil)
+	assert.Equal(t, expected, metagen.GenerateFromName("default/redis-x7k2p"))
+	assert.Nil(t, metagen.GenerateFromName("default/other"))
+}
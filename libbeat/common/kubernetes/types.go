@@ -23,7 +23,9 @@ import (
 
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
+	discoveryv1beta1 "k8s.io/api/discovery/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -72,6 +74,12 @@ type StatefulSet = appsv1.StatefulSet
 // Service data
 type Service = v1.Service
 
+// EndpointSlice data
+type EndpointSlice = discoveryv1beta1.EndpointSlice
+
+// CustomResource data
+type CustomResource = unstructured.Unstructured
+
 const (
 	// PodPending phase
 	PodPending = v1.PodPending
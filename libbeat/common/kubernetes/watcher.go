@@ -24,8 +24,10 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -66,6 +68,10 @@ type WatchOptions struct {
 	Node string
 	// Namespace is used for filtering watched resource to given namespace, use "" for all namespaces
 	Namespace string
+	// LabelSelector is used for filtering watched resource to the ones with matching labels, use "" for all
+	LabelSelector string
+	// FieldSelector is used for filtering watched resource to the ones with matching fields, use "" for all
+	FieldSelector string
 }
 
 type item struct {
@@ -87,22 +93,34 @@ type watcher struct {
 // NewWatcher initializes the watcher client to provide a events handler for
 // resource from the cluster (filtered to the given node)
 func NewWatcher(client kubernetes.Interface, resource Resource, opts WatchOptions, indexers cache.Indexers) (Watcher, error) {
-	var store cache.Store
-	var queue workqueue.RateLimitingInterface
-
 	informer, objType, err := NewInformer(client, resource, opts, indexers)
 	if err != nil {
 		return nil, err
 	}
 
-	store = informer.GetStore()
-	queue = workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), objType)
+	w := newWatcher(informer, objType)
+	w.client = client
+	return w, nil
+}
+
+// NewCustomResourceWatcher initializes a watcher for the objects of a custom
+// resource, that are received as unstructured objects
+func NewCustomResourceWatcher(client dynamic.Interface, resource schema.GroupVersionResource, opts WatchOptions) (Watcher, error) {
+	informer, objType, err := NewCustomResourceInformer(client, resource, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWatcher(informer, objType), nil
+}
+
+func newWatcher(informer cache.SharedInformer, objType string) *watcher {
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), objType)
 	ctx, cancel := context.WithCancel(context.Background())
 
 	w := &watcher{
-		client:   client,
 		informer: informer,
-		store:    store,
+		store:    informer.GetStore(),
 		queue:    queue,
 		ctx:      ctx,
 		stop:     cancel,
@@ -128,7 +146,7 @@ func NewWatcher(client kubernetes.Interface, resource Resource, opts WatchOption
 		},
 	})
 
-	return w, nil
+	return w
 }
 
 // AddEventHandler adds a resource handler to process each request that is coming into the watcher
@@ -40,10 +40,11 @@ type Client struct {
 }
 
 type Config struct {
-	Proxy   *ProxyConfig
-	TLS     *tlscommon.TLSConfig
-	Timeout time.Duration
-	Stats   IOStatser
+	Proxy     *ProxyConfig
+	TLS       *tlscommon.TLSConfig
+	Timeout   time.Duration
+	Stats     IOStatser
+	DualStack *DualStackConfig
 }
 
 func NewClient(c Config, network, host string, defaultPort int) (*Client, error) {
@@ -0,0 +1,245 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transport
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/testing"
+)
+
+// DualStackConfig configures how connections are established to hosts
+// resolving to both IPv6 and IPv4 addresses. When enabled, connection
+// attempts are raced between both address families as described in
+// RFC 8305 (Happy Eyeballs v2).
+type DualStackConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Address family of the first connection attempt, ipv6 or ipv4.
+	Prefer string `config:"prefer"`
+
+	// Time to wait for a connection attempt before starting the next one in
+	// parallel.
+	AttemptDelay time.Duration `config:"attempt_delay"`
+}
+
+const (
+	dualStackPreferIPv6 = "ipv6"
+	dualStackPreferIPv4 = "ipv4"
+
+	// Limits for the connection attempt delay recommended by RFC 8305.
+	minAttemptDelay = 10 * time.Millisecond
+	maxAttemptDelay = 2 * time.Second
+)
+
+// DefaultDualStackConfig returns the defaults recommended by RFC 8305.
+// Dual-stack dialing is disabled by default.
+func DefaultDualStackConfig() DualStackConfig {
+	return DualStackConfig{
+		Prefer:       dualStackPreferIPv6,
+		AttemptDelay: 250 * time.Millisecond,
+	}
+}
+
+// IsEnabled returns true if dual-stack dialing is enabled.
+func (c *DualStackConfig) IsEnabled() bool {
+	return c != nil && c.Enabled
+}
+
+func (c *DualStackConfig) Validate() error {
+	switch c.Prefer {
+	case dualStackPreferIPv6, dualStackPreferIPv4:
+	default:
+		return fmt.Errorf("invalid dual_stack.prefer '%v', expected '%v' or '%v'",
+			c.Prefer, dualStackPreferIPv6, dualStackPreferIPv4)
+	}
+
+	if c.AttemptDelay < minAttemptDelay || c.AttemptDelay > maxAttemptDelay {
+		return fmt.Errorf("dual_stack.attempt_delay must be between %v and %v", minAttemptDelay, maxAttemptDelay)
+	}
+	return nil
+}
+
+// DualStackDialer creates a dialer that resolves the host name and races
+// connections to its IPv6 and IPv4 addresses.
+func DualStackDialer(timeout time.Duration, config *DualStackConfig) Dialer {
+	return TestDualStackDialer(testing.NullDriver, timeout, config)
+}
+
+func TestDualStackDialer(d testing.Driver, timeout time.Duration, config *DualStackConfig) Dialer {
+	return DialerFunc(func(network, address string) (net.Conn, error) {
+		switch network {
+		case "tcp", "tcp4", "tcp6":
+		case "udp", "udp4", "udp6":
+			// There is no connection establishment to race with UDP.
+			return TestNetDialer(d, timeout).Dial(network, address)
+		default:
+			d.Fatal("network type", fmt.Errorf("unsupported network type %v", network))
+			return nil, fmt.Errorf("unsupported network type %v", network)
+		}
+
+		host, port, err := net.SplitHostPort(address)
+		d.Fatal("parse host", err)
+		if err != nil {
+			return nil, err
+		}
+		addresses, err := net.LookupHost(host)
+		d.Fatal("dns lookup", err)
+		d.Info("addresses", strings.Join(addresses, ", "))
+		if err != nil {
+			logp.NewLogger(logSelector).Warnf(`DNS lookup failure "%s": %+v`, host, err)
+			return nil, err
+		}
+
+		ctx := context.Background()
+		if timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+
+		dialer := &net.Dialer{}
+		return DialHappyEyeballs(ctx, dialer.DialContext, network, host, addresses, port, config)
+	})
+}
+
+// DialHappyEyeballs dials the addresses of a host, sorted by interleaving
+// address families starting with the preferred one. A new attempt is started
+// each time the previous one fails or the attempt delay expires, and the first
+// connection established is returned. Other attempts are cancelled.
+func DialHappyEyeballs(
+	ctx context.Context,
+	dial func(ctx context.Context, network, address string) (net.Conn, error),
+	network, host string,
+	addresses []string,
+	port string,
+	config *DualStackConfig,
+) (net.Conn, error) {
+	addresses = sortDualStack(network, addresses, config.Prefer)
+	if len(addresses) == 0 {
+		return nil, fmt.Errorf("no route to host %v", host)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		conn net.Conn
+		err  error
+	}
+	results := make(chan result)
+
+	delay := time.NewTimer(config.AttemptDelay)
+	defer delay.Stop()
+
+	next, pending := 0, 0
+	startAttempt := func() {
+		address := net.JoinHostPort(addresses[next], port)
+		next++
+		pending++
+		go func() {
+			conn, err := dial(ctx, network, address)
+			select {
+			case results <- result{conn, err}:
+			case <-ctx.Done():
+				if conn != nil {
+					conn.Close()
+				}
+			}
+		}()
+
+		if !delay.Stop() {
+			select {
+			case <-delay.C:
+			default:
+			}
+		}
+		delay.Reset(config.AttemptDelay)
+	}
+
+	var lastErr error
+	startAttempt()
+	for pending > 0 {
+		select {
+		case res := <-results:
+			pending--
+			if res.err == nil {
+				return res.conn, nil
+			}
+			lastErr = res.err
+			// Start the next attempt right away on failure.
+			if next < len(addresses) {
+				startAttempt()
+			}
+		case <-delay.C:
+			if next < len(addresses) {
+				startAttempt()
+			}
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if lastErr == nil {
+		lastErr = fmt.Errorf("unable to connect to '%v'", host)
+	}
+	return nil, lastErr
+}
+
+// sortDualStack returns the addresses usable for the network interleaving
+// IPv6 and IPv4 addresses, starting with the preferred family. Addresses of
+// the same family are shuffled to spread the load between them, as done by
+// DialWith.
+func sortDualStack(network string, addresses []string, prefer string) []string {
+	var ipv6, ipv4 []string
+	for _, i := range rand.Perm(len(addresses)) {
+		ip := net.ParseIP(addresses[i])
+		if ip == nil {
+			continue
+		}
+		if ip.To4() != nil {
+			if network != "tcp6" {
+				ipv4 = append(ipv4, addresses[i])
+			}
+		} else if network != "tcp4" {
+			ipv6 = append(ipv6, addresses[i])
+		}
+	}
+
+	first, second := ipv6, ipv4
+	if prefer == dualStackPreferIPv4 {
+		first, second = ipv4, ipv6
+	}
+
+	sorted := make([]string, 0, len(first)+len(second))
+	for i := 0; i < len(first) || i < len(second); i++ {
+		if i < len(first) {
+			sorted = append(sorted, first[i])
+		}
+		if i < len(second) {
+			sorted = append(sorted, second[i])
+		}
+	}
+	return sorted
+}
@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestDualStackConfig(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		err    bool
+	}{
+		"defaults": {
+			config: map[string]interface{}{},
+		},
+		"prefer ipv4": {
+			config: map[string]interface{}{"enabled": true, "prefer": "ipv4"},
+		},
+		"invalid prefer": {
+			config: map[string]interface{}{"prefer": "ipv5"},
+			err:    true,
+		},
+		"attempt delay too short": {
+			config: map[string]interface{}{"attempt_delay": "1ms"},
+			err:    true,
+		},
+		"attempt delay too long": {
+			config: map[string]interface{}{"attempt_delay": "1m"},
+			err:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := DefaultDualStackConfig()
+			err := common.MustNewConfigFrom(test.config).Unpack(&config)
+			if test.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSortDualStack(t *testing.T) {
+	addresses := []string{"10.0.0.1", "::1", "10.0.0.2", "invalid"}
+
+	sorted := sortDualStack("tcp", addresses, "ipv6")
+	require.Len(t, sorted, 3)
+	assert.Equal(t, "::1", sorted[0])
+	assert.Contains(t, []string{"10.0.0.1", "10.0.0.2"}, sorted[1])
+	assert.Contains(t, []string{"10.0.0.1", "10.0.0.2"}, sorted[2])
+
+	sorted = sortDualStack("tcp", addresses, "ipv4")
+	require.Len(t, sorted, 3)
+	assert.Contains(t, []string{"10.0.0.1", "10.0.0.2"}, sorted[0])
+	assert.Equal(t, "::1", sorted[1])
+
+	assert.Equal(t, []string{"::1"}, sortDualStack("tcp6", addresses, "ipv4"))
+	assert.Len(t, sortDualStack("tcp4", addresses, "ipv6"), 2)
+}
+
+type fakeConn struct {
+	net.Conn
+	address string
+	closed  bool
+}
+
+func (c *fakeConn) Close() error {
+	c.closed = true
+	return nil
+}
+
+// fakeDial returns a dial function that connects after the given delay to
+// addresses in it, and fails right away for the others.
+func fakeDial(delays map[string]time.Duration) (func(context.Context, string, string) (net.Conn, error), func() []string) {
+	var mu sync.Mutex
+	var attempts []string
+	dial := func(ctx context.Context, network, address string) (net.Conn, error) {
+		mu.Lock()
+		attempts = append(attempts, address)
+		mu.Unlock()
+
+		delay, ok := delays[address]
+		if !ok {
+			return nil, errors.New("connection refused")
+		}
+		select {
+		case <-time.After(delay):
+			return &fakeConn{address: address}, nil
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+	getAttempts := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string{}, attempts...)
+	}
+	return dial, getAttempts
+}
+
+func TestDialHappyEyeballs(t *testing.T) {
+	config := DefaultDualStackConfig()
+	config.AttemptDelay = 20 * time.Millisecond
+
+	t.Run("preferred family connects", func(t *testing.T) {
+		dial, attempts := fakeDial(map[string]time.Duration{
+			"[::1]:5044":       0,
+			"192.168.0.1:5044": 0,
+		})
+		conn, err := DialHappyEyeballs(context.Background(), dial, "tcp", "localhost", []string{"192.168.0.1", "::1"}, "5044", &config)
+		require.NoError(t, err)
+		assert.Equal(t, "[::1]:5044", conn.(*fakeConn).address)
+		assert.Equal(t, []string{"[::1]:5044"}, attempts())
+	})
+
+	t.Run("fallback after attempt delay", func(t *testing.T) {
+		dial, attempts := fakeDial(map[string]time.Duration{
+			"[::1]:5044":       time.Second,
+			"192.168.0.1:5044": 0,
+		})
+		conn, err := DialHappyEyeballs(context.Background(), dial, "tcp", "localhost", []string{"192.168.0.1", "::1"}, "5044", &config)
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.0.1:5044", conn.(*fakeConn).address)
+		assert.Equal(t, []string{"[::1]:5044", "192.168.0.1:5044"}, attempts())
+	})
+
+	t.Run("fallback on failure", func(t *testing.T) {
+		slow := config
+		slow.AttemptDelay = maxAttemptDelay
+		dial, _ := fakeDial(map[string]time.Duration{
+			"192.168.0.1:5044": 0,
+		})
+		start := time.Now()
+		conn, err := DialHappyEyeballs(context.Background(), dial, "tcp", "localhost", []string{"192.168.0.1", "::1"}, "5044", &slow)
+		require.NoError(t, err)
+		assert.Equal(t, "192.168.0.1:5044", conn.(*fakeConn).address)
+		assert.True(t, time.Since(start) < maxAttemptDelay)
+	})
+
+	t.Run("all attempts fail", func(t *testing.T) {
+		dial, attempts := fakeDial(nil)
+		_, err := DialHappyEyeballs(context.Background(), dial, "tcp", "localhost", []string{"192.168.0.1", "::1"}, "5044", &config)
+		assert.Error(t, err)
+		assert.Len(t, attempts(), 2)
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		dial, _ := fakeDial(map[string]time.Duration{
+			"[::1]:5044": time.Second,
+		})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err := DialHappyEyeballs(ctx, dial, "tcp", "localhost", []string{"::1"}, "5044", &config)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("no addresses for network", func(t *testing.T) {
+		dial, attempts := fakeDial(nil)
+		_, err := DialHappyEyeballs(context.Background(), dial, "tcp4", "localhost", []string{"::1"}, "5044", &config)
+		assert.Error(t, err)
+		assert.Empty(t, attempts())
+	})
+}
@@ -49,6 +49,9 @@ func Dial(c Config, network, address string) (net.Conn, error) {
 func MakeDialer(c Config) (Dialer, error) {
 	var err error
 	dialer := NetDialer(c.Timeout)
+	if c.DualStack.IsEnabled() {
+		dialer = DualStackDialer(c.Timeout, c.DualStack)
+	}
 	dialer, err = ProxyDialer(logp.NewLogger(logSelector), c.Proxy, dialer)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,52 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"os"
+	"time"
+)
+
+// Config is the configuration for the control API.
+type Config struct {
+	Enabled bool `config:"enabled"`
+	// Path of the unix socket, relative to the data path.
+	Path  string      `config:"path"`
+	Drain DrainConfig `config:"drain"`
+}
+
+// DrainConfig is the configuration for the drain operation.
+type DrainConfig struct {
+	// Timeout is the maximum time to wait for the active events in the
+	// pipeline when the request doesn't set one.
+	Timeout time.Duration `config:"timeout" validate:"min=0"`
+}
+
+var (
+	// DefaultConfig is the default configuration used by the control API.
+	DefaultConfig = Config{
+		Enabled: false,
+		Path:    "control.sock",
+		Drain: DrainConfig{
+			Timeout: 30 * time.Second,
+		},
+	}
+)
+
+// File mode for the socket file, only the owner of the process can use it.
+const socketFileMode = os.FileMode(0700)
@@ -0,0 +1,376 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// Messages and service of control.proto. The messages carry the protobuf
+// struct tags, so they are encoded on the wire as defined in the proto file.
+
+// State of the Beat
+type State int32
+
+// Beat states
+const (
+	StateRunning  State = 0
+	StateDraining State = 1
+	StateDrained  State = 2
+)
+
+var stateNames = map[State]string{
+	StateRunning:  "RUNNING",
+	StateDraining: "DRAINING",
+	StateDrained:  "DRAINED",
+}
+
+func (s State) String() string {
+	if name, found := stateNames[s]; found {
+		return name
+	}
+	return "UNKNOWN"
+}
+
+// ServingStatus reported by the health check
+type ServingStatus int32
+
+// Serving statuses
+const (
+	ServingStatusUnknown    ServingStatus = 0
+	ServingStatusServing    ServingStatus = 1
+	ServingStatusNotServing ServingStatus = 2
+)
+
+// Input is an input managed through the control API
+type Input struct {
+	ID     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	List   string `protobuf:"bytes,2,opt,name=list,proto3" json:"list,omitempty"`
+	Config string `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+// StatusRequest message
+type StatusRequest struct{}
+
+// StatusResponse message
+type StatusResponse struct {
+	Beat         string   `protobuf:"bytes,1,opt,name=beat,proto3" json:"beat,omitempty"`
+	Name         string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Version      string   `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	ID           string   `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Hostname     string   `protobuf:"bytes,5,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	UptimeMs     int64    `protobuf:"varint,6,opt,name=uptime_ms,json=uptimeMs,proto3" json:"uptime_ms,omitempty"`
+	State        State    `protobuf:"varint,7,opt,name=state,proto3,enum=control.State" json:"state,omitempty"`
+	Reloadables  []string `protobuf:"bytes,8,rep,name=reloadables,proto3" json:"reloadables,omitempty"`
+	Inputs       []*Input `protobuf:"bytes,9,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	PausedInputs []string `protobuf:"bytes,10,rep,name=paused_inputs,json=pausedInputs,proto3" json:"paused_inputs,omitempty"`
+}
+
+// HealthRequest message
+type HealthRequest struct{}
+
+// HealthResponse message
+type HealthResponse struct {
+	Status ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=control.ServingStatus" json:"status,omitempty"`
+}
+
+// AddInputRequest message
+type AddInputRequest struct {
+	Input *Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+}
+
+// AddInputResponse message
+type AddInputResponse struct{}
+
+// RemoveInputRequest message
+type RemoveInputRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+// RemoveInputResponse message
+type RemoveInputResponse struct{}
+
+// ReloadRequest message
+type ReloadRequest struct {
+	Name   string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Config string   `protobuf:"bytes,2,opt,name=config,proto3" json:"config,omitempty"`
+	Inputs []*Input `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+}
+
+// ReloadResponse message
+type ReloadResponse struct{}
+
+// DrainRequest message
+type DrainRequest struct {
+	TimeoutMs int64 `protobuf:"varint,1,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
+	Stop      bool  `protobuf:"varint,2,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+// DrainResponse message
+type DrainResponse struct {
+	ActiveEvents uint64 `protobuf:"varint,1,opt,name=active_events,json=activeEvents,proto3" json:"active_events,omitempty"`
+}
+
+// PauseInputRequest message
+type PauseInputRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+// PauseInputResponse message
+type PauseInputResponse struct{}
+
+// ResumeInputRequest message
+type ResumeInputRequest struct {
+	ID string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+// ResumeInputResponse message
+type ResumeInputResponse struct{}
+
+func (m *Input) Reset()                       { *m = Input{} }
+func (m *Input) String() string               { return proto.CompactTextString(m) }
+func (*Input) ProtoMessage()                  {}
+func (m *StatusRequest) Reset()               { *m = StatusRequest{} }
+func (m *StatusRequest) String() string       { return proto.CompactTextString(m) }
+func (*StatusRequest) ProtoMessage()          {}
+func (m *StatusResponse) Reset()              { *m = StatusResponse{} }
+func (m *StatusResponse) String() string      { return proto.CompactTextString(m) }
+func (*StatusResponse) ProtoMessage()         {}
+func (m *HealthRequest) Reset()               { *m = HealthRequest{} }
+func (m *HealthRequest) String() string       { return proto.CompactTextString(m) }
+func (*HealthRequest) ProtoMessage()          {}
+func (m *HealthResponse) Reset()              { *m = HealthResponse{} }
+func (m *HealthResponse) String() string      { return proto.CompactTextString(m) }
+func (*HealthResponse) ProtoMessage()         {}
+func (m *AddInputRequest) Reset()             { *m = AddInputRequest{} }
+func (m *AddInputRequest) String() string     { return proto.CompactTextString(m) }
+func (*AddInputRequest) ProtoMessage()        {}
+func (m *AddInputResponse) Reset()            { *m = AddInputResponse{} }
+func (m *AddInputResponse) String() string    { return proto.CompactTextString(m) }
+func (*AddInputResponse) ProtoMessage()       {}
+func (m *RemoveInputRequest) Reset()          { *m = RemoveInputRequest{} }
+func (m *RemoveInputRequest) String() string  { return proto.CompactTextString(m) }
+func (*RemoveInputRequest) ProtoMessage()     {}
+func (m *RemoveInputResponse) Reset()         { *m = RemoveInputResponse{} }
+func (m *RemoveInputResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveInputResponse) ProtoMessage()    {}
+func (m *ReloadRequest) Reset()               { *m = ReloadRequest{} }
+func (m *ReloadRequest) String() string       { return proto.CompactTextString(m) }
+func (*ReloadRequest) ProtoMessage()          {}
+func (m *ReloadResponse) Reset()              { *m = ReloadResponse{} }
+func (m *ReloadResponse) String() string      { return proto.CompactTextString(m) }
+func (*ReloadResponse) ProtoMessage()         {}
+func (m *DrainRequest) Reset()                { *m = DrainRequest{} }
+func (m *DrainRequest) String() string        { return proto.CompactTextString(m) }
+func (*DrainRequest) ProtoMessage()           {}
+func (m *DrainResponse) Reset()               { *m = DrainResponse{} }
+func (m *DrainResponse) String() string       { return proto.CompactTextString(m) }
+func (*DrainResponse) ProtoMessage()          {}
+func (m *PauseInputRequest) Reset()           { *m = PauseInputRequest{} }
+func (m *PauseInputRequest) String() string   { return proto.CompactTextString(m) }
+func (*PauseInputRequest) ProtoMessage()      {}
+func (m *PauseInputResponse) Reset()          { *m = PauseInputResponse{} }
+func (m *PauseInputResponse) String() string  { return proto.CompactTextString(m) }
+func (*PauseInputResponse) ProtoMessage()     {}
+func (m *ResumeInputRequest) Reset()          { *m = ResumeInputRequest{} }
+func (m *ResumeInputRequest) String() string  { return proto.CompactTextString(m) }
+func (*ResumeInputRequest) ProtoMessage()     {}
+func (m *ResumeInputResponse) Reset()         { *m = ResumeInputResponse{} }
+func (m *ResumeInputResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeInputResponse) ProtoMessage()    {}
+
+// ControlServer is the server API of the Control service
+type ControlServer interface {
+	Status(context.Context, *StatusRequest) (*StatusResponse, error)
+	Health(context.Context, *HealthRequest) (*HealthResponse, error)
+	AddInput(context.Context, *AddInputRequest) (*AddInputResponse, error)
+	RemoveInput(context.Context, *RemoveInputRequest) (*RemoveInputResponse, error)
+	Reload(context.Context, *ReloadRequest) (*ReloadResponse, error)
+	Drain(context.Context, *DrainRequest) (*DrainResponse, error)
+	PauseInput(context.Context, *PauseInputRequest) (*PauseInputResponse, error)
+	ResumeInput(context.Context, *ResumeInputRequest) (*ResumeInputResponse, error)
+}
+
+// ControlClient is the client API of the Control service
+type ControlClient interface {
+	Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error)
+	AddInput(ctx context.Context, in *AddInputRequest, opts ...grpc.CallOption) (*AddInputResponse, error)
+	RemoveInput(ctx context.Context, in *RemoveInputRequest, opts ...grpc.CallOption) (*RemoveInputResponse, error)
+	Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error)
+	Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error)
+	PauseInput(ctx context.Context, in *PauseInputRequest, opts ...grpc.CallOption) (*PauseInputResponse, error)
+	ResumeInput(ctx context.Context, in *ResumeInputRequest, opts ...grpc.CallOption) (*ResumeInputResponse, error)
+}
+
+const serviceName = "control.Control"
+
+// RegisterControlServer registers the Control service in a gRPC server
+func RegisterControlServer(s *grpc.Server, srv ControlServer) {
+	s.RegisterService(&controlServiceDesc, srv)
+}
+
+var controlServiceDesc = grpc.ServiceDesc{
+	ServiceName: serviceName,
+	HandlerType: (*ControlServer)(nil),
+	Methods: []grpc.MethodDesc{
+		unaryMethod("Status", func() interface{} { return new(StatusRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.Status(ctx, req.(*StatusRequest))
+			}),
+		unaryMethod("Health", func() interface{} { return new(HealthRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.Health(ctx, req.(*HealthRequest))
+			}),
+		unaryMethod("AddInput", func() interface{} { return new(AddInputRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.AddInput(ctx, req.(*AddInputRequest))
+			}),
+		unaryMethod("RemoveInput", func() interface{} { return new(RemoveInputRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.RemoveInput(ctx, req.(*RemoveInputRequest))
+			}),
+		unaryMethod("Reload", func() interface{} { return new(ReloadRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.Reload(ctx, req.(*ReloadRequest))
+			}),
+		unaryMethod("Drain", func() interface{} { return new(DrainRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.Drain(ctx, req.(*DrainRequest))
+			}),
+		unaryMethod("PauseInput", func() interface{} { return new(PauseInputRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.PauseInput(ctx, req.(*PauseInputRequest))
+			}),
+		unaryMethod("ResumeInput", func() interface{} { return new(ResumeInputRequest) },
+			func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error) {
+				return srv.ResumeInput(ctx, req.(*ResumeInputRequest))
+			}),
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "control.proto",
+}
+
+// unaryMethod builds the description of an unary method, decoding the request
+// in the message returned by newRequest.
+func unaryMethod(
+	name string,
+	newRequest func() interface{},
+	call func(srv ControlServer, ctx context.Context, req interface{}) (interface{}, error),
+) grpc.MethodDesc {
+	return grpc.MethodDesc{
+		MethodName: name,
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+			in := newRequest()
+			if err := dec(in); err != nil {
+				return nil, err
+			}
+			if interceptor == nil {
+				return call(srv.(ControlServer), ctx, in)
+			}
+			info := &grpc.UnaryServerInfo{
+				Server:     srv,
+				FullMethod: "/" + serviceName + "/" + name,
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return call(srv.(ControlServer), ctx, req)
+			}
+			return interceptor(ctx, in, info, handler)
+		},
+	}
+}
+
+type controlClient struct {
+	cc *grpc.ClientConn
+}
+
+// NewControlClient creates a client of the Control service
+func NewControlClient(cc *grpc.ClientConn) ControlClient {
+	return &controlClient{cc}
+}
+
+func (c *controlClient) invoke(ctx context.Context, method string, in, out interface{}, opts []grpc.CallOption) error {
+	return c.cc.Invoke(ctx, "/"+serviceName+"/"+method, in, out, opts...)
+}
+
+func (c *controlClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	if err := c.invoke(ctx, "Status", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) Health(ctx context.Context, in *HealthRequest, opts ...grpc.CallOption) (*HealthResponse, error) {
+	out := new(HealthResponse)
+	if err := c.invoke(ctx, "Health", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) AddInput(ctx context.Context, in *AddInputRequest, opts ...grpc.CallOption) (*AddInputResponse, error) {
+	out := new(AddInputResponse)
+	if err := c.invoke(ctx, "AddInput", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) RemoveInput(ctx context.Context, in *RemoveInputRequest, opts ...grpc.CallOption) (*RemoveInputResponse, error) {
+	out := new(RemoveInputResponse)
+	if err := c.invoke(ctx, "RemoveInput", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) Reload(ctx context.Context, in *ReloadRequest, opts ...grpc.CallOption) (*ReloadResponse, error) {
+	out := new(ReloadResponse)
+	if err := c.invoke(ctx, "Reload", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) Drain(ctx context.Context, in *DrainRequest, opts ...grpc.CallOption) (*DrainResponse, error) {
+	out := new(DrainResponse)
+	if err := c.invoke(ctx, "Drain", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) PauseInput(ctx context.Context, in *PauseInputRequest, opts ...grpc.CallOption) (*PauseInputResponse, error) {
+	out := new(PauseInputResponse)
+	if err := c.invoke(ctx, "PauseInput", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlClient) ResumeInput(ctx context.Context, in *ResumeInputRequest, opts ...grpc.CallOption) (*ResumeInputResponse, error) {
+	out := new(ResumeInputResponse)
+	if err := c.invoke(ctx, "ResumeInput", in, out, opts); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"sort"
+	"sync"
+)
+
+// Pausable is an input that can be paused and resumed through the control
+// API. Paused inputs stop reading, but keep their state, so they continue
+// from where they were when resumed.
+type Pausable interface {
+	Pause()
+	Resume()
+	Paused() bool
+}
+
+// PausableRegistry holds the running pausable inputs by ID.
+type PausableRegistry struct {
+	mutex  sync.Mutex
+	inputs map[string]Pausable
+}
+
+// Pausables is the registry of pausable inputs used by the control API, inputs
+// are registered while they are running.
+var Pausables = NewPausableRegistry()
+
+// NewPausableRegistry creates an empty registry of pausable inputs.
+func NewPausableRegistry() *PausableRegistry {
+	return &PausableRegistry{inputs: make(map[string]Pausable)}
+}
+
+// Register adds an input. It replaces the input registered with the same ID,
+// as inputs reloaded with the same ID can be started before the previous one
+// is stopped.
+func (r *PausableRegistry) Register(id string, input Pausable) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.inputs[id] = input
+}
+
+// Unregister removes an input, if it is the one registered with the ID.
+func (r *PausableRegistry) Unregister(id string, input Pausable) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.inputs[id] == input {
+		delete(r.inputs, id)
+	}
+}
+
+// Get returns the input registered with the ID, or nil if there is none.
+func (r *PausableRegistry) Get(id string) Pausable {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.inputs[id]
+}
+
+// Paused returns the sorted IDs of the paused inputs.
+func (r *PausableRegistry) Paused() []string {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var ids []string
+	for id, input := range r.inputs {
+		if input.Paused() {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// PauseAll pauses all the running inputs that are not paused yet, and returns
+// a function that resumes them.
+func (r *PausableRegistry) PauseAll() (resume func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var paused []Pausable
+	for _, input := range r.inputs {
+		if !input.Paused() {
+			input.Pause()
+			paused = append(paused, input)
+		}
+	}
+	return func() {
+		for _, input := range paused {
+			input.Resume()
+		}
+	}
+}
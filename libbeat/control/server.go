@@ -0,0 +1,473 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"context"
+	"net"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/beats/v7/libbeat/monitoring/report/log"
+	"github.com/elastic/beats/v7/libbeat/paths"
+)
+
+// Interval to check the active events while draining
+const drainCheckInterval = 100 * time.Millisecond
+
+// Server exposes the Control service on a unix socket. Inputs are managed
+// through the lists registered in the reload registry, the same way central
+// management does.
+type Server struct {
+	log      *logp.Logger
+	config   Config
+	info     beat.Info
+	registry *reload.Registry
+	path     string
+	listener net.Listener
+	grpc     *grpc.Server
+
+	// pausables holds the running inputs that can be paused by ID
+	pausables *PausableRegistry
+
+	// activeEvents returns the number of events in the pipeline not
+	// acknowledged yet
+	activeEvents func() uint64
+
+	mutex  sync.Mutex
+	state  State
+	inputs map[string]*Input
+	stop   func()
+}
+
+// New creates a new control API server, listening on the configured socket.
+func New(log *logp.Logger, info beat.Info, registry *reload.Registry, config *common.Config) (*Server, error) {
+	if log == nil {
+		log = logp.NewLogger("")
+	}
+
+	cfg := DefaultConfig
+	if err := config.Unpack(&cfg); err != nil {
+		return nil, err
+	}
+
+	path := paths.Resolve(paths.Data, cfg.Path)
+	l, err := makeListener(path)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		log:          log.Named("control"),
+		config:       cfg,
+		info:         info,
+		registry:     registry,
+		pausables:    Pausables,
+		path:         path,
+		listener:     l,
+		grpc:         grpc.NewServer(),
+		activeEvents: pipelineActiveEvents,
+		inputs:       make(map[string]*Input),
+	}
+	RegisterControlServer(s.grpc, s)
+	return s, nil
+}
+
+func makeListener(path string) (net.Listener, error) {
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		if err := os.Remove(path); err != nil {
+			return nil, errors.Wrapf(err, "cannot remove existing unix socket file at location %s", path)
+		}
+	}
+
+	l, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.Chmod(path, socketFileMode); err != nil {
+		l.Close()
+		return nil, errors.Wrapf(err, "could not set mode %d for unix socket file at location %s", socketFileMode, path)
+	}
+	return l, nil
+}
+
+// pipelineActiveEvents reads the active events from the pipeline metrics.
+func pipelineActiveEvents() uint64 {
+	if v, ok := monitoring.Default.Get("libbeat.pipeline.events.active").(*monitoring.Uint); ok {
+		return v.Get()
+	}
+	return 0
+}
+
+// Start starts serving requests, stop is called to stop the Beat when
+// requested on drain.
+func (s *Server) Start(stop func()) {
+	s.mutex.Lock()
+	s.stop = stop
+	s.mutex.Unlock()
+
+	s.log.Infof("Control API listening on: %s", s.path)
+	go func() {
+		err := s.grpc.Serve(s.listener)
+		s.log.Infof("Control API (%s) finished: %v", s.path, err)
+	}()
+}
+
+// Stop stops the server and removes the socket file.
+func (s *Server) Stop() {
+	s.grpc.Stop()
+	os.Remove(s.path)
+}
+
+// Status returns the information of the Beat and the inputs managed through the API.
+func (s *Server) Status(_ context.Context, _ *StatusRequest) (*StatusResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	reloadables := s.registry.GetRegisteredNames()
+	sort.Strings(reloadables)
+
+	return &StatusResponse{
+		Beat:         s.info.Beat,
+		Name:         s.info.Name,
+		Version:      s.info.Version,
+		ID:           s.info.ID.String(),
+		Hostname:     s.info.Hostname,
+		UptimeMs:     int64(time.Since(log.StartTime) / time.Millisecond),
+		State:        s.state,
+		Reloadables:  reloadables,
+		Inputs:       s.sortedInputs(""),
+		PausedInputs: s.pausables.Paused(),
+	}, nil
+}
+
+// Health reports the Beat as serving until it is drained.
+func (s *Server) Health(_ context.Context, _ *HealthRequest) (*HealthResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.state != StateRunning {
+		return &HealthResponse{Status: ServingStatusNotServing}, nil
+	}
+	return &HealthResponse{Status: ServingStatusServing}, nil
+}
+
+// AddInput starts a new input in a reloadable list.
+func (s *Server) AddInput(_ context.Context, req *AddInputRequest) (*AddInputResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.checkRunning(); err != nil {
+		return nil, err
+	}
+
+	input := req.Input
+	if input == nil || input.ID == "" {
+		return nil, status.Error(codes.InvalidArgument, "input id is required")
+	}
+	if _, found := s.inputs[input.ID]; found {
+		return nil, status.Errorf(codes.AlreadyExists, "input %s already exists", input.ID)
+	}
+
+	list, err := s.resolveList(input.List)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parseConfig(input.Config); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid config for input %s: %v", input.ID, err)
+	}
+
+	s.inputs[input.ID] = &Input{ID: input.ID, List: list, Config: input.Config}
+	if err := s.reloadList(list); err != nil {
+		delete(s.inputs, input.ID)
+		return nil, status.Errorf(codes.Internal, "failed to start input %s: %v", input.ID, err)
+	}
+
+	s.log.Infof("Input %s added to %s", input.ID, list)
+	return &AddInputResponse{}, nil
+}
+
+// RemoveInput stops an input added through the API.
+func (s *Server) RemoveInput(_ context.Context, req *RemoveInputRequest) (*RemoveInputResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	input, found := s.inputs[req.ID]
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "input %s not found", req.ID)
+	}
+
+	delete(s.inputs, req.ID)
+	if err := s.reloadList(input.List); err != nil {
+		s.inputs[req.ID] = input
+		return nil, status.Errorf(codes.Internal, "failed to stop input %s: %v", req.ID, err)
+	}
+
+	s.log.Infof("Input %s removed from %s", req.ID, input.List)
+	return &RemoveInputResponse{}, nil
+}
+
+// Reload applies a configuration to a single reloadable, or replaces all the
+// inputs of a reloadable list managed through the API.
+func (s *Server) Reload(_ context.Context, req *ReloadRequest) (*ReloadResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.checkRunning(); err != nil {
+		return nil, err
+	}
+
+	if obj := s.registry.GetReloadable(req.Name); obj != nil {
+		config, err := parseConfig(req.Config)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid config for %s: %v", req.Name, err)
+		}
+		if err := obj.Reload(&reload.ConfigWithMeta{Config: config}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to reload %s: %v", req.Name, err)
+		}
+		s.log.Infof("Reloaded %s", req.Name)
+		return &ReloadResponse{}, nil
+	}
+
+	list, err := s.resolveList(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := make(map[string]*Input, len(req.Inputs))
+	for _, input := range req.Inputs {
+		if input.ID == "" {
+			return nil, status.Error(codes.InvalidArgument, "input id is required")
+		}
+		if _, found := inputs[input.ID]; found {
+			return nil, status.Errorf(codes.InvalidArgument, "duplicated input %s", input.ID)
+		}
+		if current, found := s.inputs[input.ID]; found && current.List != list {
+			return nil, status.Errorf(codes.AlreadyExists, "input %s already exists in %s", input.ID, current.List)
+		}
+		if _, err := parseConfig(input.Config); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid config for input %s: %v", input.ID, err)
+		}
+		inputs[input.ID] = &Input{ID: input.ID, List: list, Config: input.Config}
+	}
+
+	previous := s.sortedInputs(list)
+	for _, input := range previous {
+		delete(s.inputs, input.ID)
+	}
+	for id, input := range inputs {
+		s.inputs[id] = input
+	}
+
+	if err := s.reloadList(list); err != nil {
+		for id := range inputs {
+			delete(s.inputs, id)
+		}
+		for _, input := range previous {
+			s.inputs[input.ID] = input
+		}
+		return nil, status.Errorf(codes.Internal, "failed to reload %s: %v", list, err)
+	}
+
+	s.log.Infof("Reloaded %s with %d inputs", list, len(inputs))
+	return &ReloadResponse{}, nil
+}
+
+// Drain stops all the reloadable lists and waits for the active events in the
+// pipeline to be acknowledged. Inputs configured in the configuration file
+// are not stopped.
+func (s *Server) Drain(ctx context.Context, req *DrainRequest) (*DrainResponse, error) {
+	s.mutex.Lock()
+	if s.state != StateRunning {
+		s.mutex.Unlock()
+		return nil, status.Errorf(codes.FailedPrecondition, "beat is %s", s.state)
+	}
+	s.state = StateDraining
+	s.log.Info("Draining")
+
+	for _, name := range s.registry.GetRegisteredNames() {
+		list := s.registry.GetReloadableList(name)
+		if list == nil {
+			continue
+		}
+		if err := list.Reload(nil); err != nil {
+			s.log.Errorf("Error stopping %s: %v", name, err)
+		}
+	}
+	s.inputs = make(map[string]*Input)
+	stop := s.stop
+	s.mutex.Unlock()
+
+	timeout := s.config.Drain.Timeout
+	if req.TimeoutMs > 0 {
+		timeout = time.Duration(req.TimeoutMs) * time.Millisecond
+	}
+	active := s.waitActiveEvents(ctx, timeout)
+
+	s.mutex.Lock()
+	s.state = StateDrained
+	s.mutex.Unlock()
+
+	if active > 0 {
+		s.log.Warnf("Drain finished with %d active events", active)
+	} else {
+		s.log.Info("Drain finished")
+	}
+
+	if req.Stop && stop != nil {
+		// Stop asynchronously so the response can still be sent
+		go stop()
+	}
+	return &DrainResponse{ActiveEvents: active}, nil
+}
+
+// PauseInput pauses a running input, it keeps its state while paused.
+func (s *Server) PauseInput(_ context.Context, req *PauseInputRequest) (*PauseInputResponse, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := s.checkRunning(); err != nil {
+		return nil, err
+	}
+
+	input := s.pausables.Get(req.ID)
+	if input == nil {
+		return nil, status.Errorf(codes.NotFound, "pausable input %s not found", req.ID)
+	}
+	if !input.Paused() {
+		input.Pause()
+		s.log.Infof("Input %s paused", req.ID)
+	}
+	return &PauseInputResponse{}, nil
+}
+
+// ResumeInput resumes a paused input.
+func (s *Server) ResumeInput(_ context.Context, req *ResumeInputRequest) (*ResumeInputResponse, error) {
+	input := s.pausables.Get(req.ID)
+	if input == nil {
+		return nil, status.Errorf(codes.NotFound, "pausable input %s not found", req.ID)
+	}
+	if input.Paused() {
+		input.Resume()
+		s.log.Infof("Input %s resumed", req.ID)
+	}
+	return &ResumeInputResponse{}, nil
+}
+
+func (s *Server) waitActiveEvents(ctx context.Context, timeout time.Duration) uint64 {
+	ticker := time.NewTicker(drainCheckInterval)
+	defer ticker.Stop()
+
+	deadline := time.After(timeout)
+	for {
+		active := s.activeEvents()
+		if active == 0 {
+			return 0
+		}
+
+		select {
+		case <-ctx.Done():
+			return active
+		case <-deadline:
+			return active
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Server) checkRunning() error {
+	if s.state != StateRunning {
+		return status.Errorf(codes.FailedPrecondition, "beat is %s", s.state)
+	}
+	return nil
+}
+
+// resolveList returns the reloadable list to use, defaulting to <beat>.inputs
+// or to the only list registered.
+func (s *Server) resolveList(name string) (string, error) {
+	if name != "" {
+		if s.registry.GetReloadableList(name) == nil {
+			return "", status.Errorf(codes.NotFound, "reloadable list %s not found", name)
+		}
+		return name, nil
+	}
+
+	if s.registry.GetReloadableList(s.info.Beat+".inputs") != nil {
+		return s.info.Beat + ".inputs", nil
+	}
+
+	var lists []string
+	for _, name := range s.registry.GetRegisteredNames() {
+		if s.registry.GetReloadableList(name) != nil {
+			lists = append(lists, name)
+		}
+	}
+	if len(lists) == 1 {
+		return lists[0], nil
+	}
+	sort.Strings(lists)
+	return "", status.Errorf(codes.InvalidArgument, "list is required, available lists: %v", lists)
+}
+
+// reloadList applies all the inputs of a list
+func (s *Server) reloadList(name string) error {
+	var configs []*reload.ConfigWithMeta
+	for _, input := range s.sortedInputs(name) {
+		config, err := parseConfig(input.Config)
+		if err != nil {
+			return err
+		}
+		configs = append(configs, &reload.ConfigWithMeta{Config: config})
+	}
+	return s.registry.GetReloadableList(name).Reload(configs)
+}
+
+// sortedInputs returns the inputs of a list sorted by id, or all the inputs
+// if no list is given.
+func (s *Server) sortedInputs(list string) []*Input {
+	var inputs []*Input
+	for _, input := range s.inputs {
+		if list == "" || input.List == list {
+			inputs = append(inputs, input)
+		}
+	}
+	sort.Slice(inputs, func(i, j int) bool {
+		return inputs[i].ID < inputs[j].ID
+	})
+	return inputs
+}
+
+func parseConfig(config string) (*common.Config, error) {
+	if config == "" {
+		return nil, errors.New("config is required")
+	}
+	return common.NewConfigWithYAML([]byte(config), "control API")
+}
@@ -0,0 +1,399 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package control
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/common/reload"
+)
+
+type fakeList struct {
+	sync.Mutex
+	configs []*reload.ConfigWithMeta
+	err     error
+}
+
+func (l *fakeList) Reload(configs []*reload.ConfigWithMeta) error {
+	l.Lock()
+	defer l.Unlock()
+	if l.err != nil {
+		return l.err
+	}
+	l.configs = configs
+	return nil
+}
+
+func (l *fakeList) types(t *testing.T) []string {
+	l.Lock()
+	defer l.Unlock()
+	var types []string
+	for _, c := range l.configs {
+		var config struct {
+			Type string `config:"type"`
+		}
+		require.NoError(t, c.Config.Unpack(&config))
+		types = append(types, config.Type)
+	}
+	return types
+}
+
+type fakePausable struct {
+	paused atomic.Bool
+}
+
+func (p *fakePausable) Pause()       { p.paused.Store(true) }
+func (p *fakePausable) Resume()      { p.paused.Store(false) }
+func (p *fakePausable) Paused() bool { return p.paused.Load() }
+
+func newTestServer(t *testing.T, registry *reload.Registry) (*Server, ControlClient, func()) {
+	dir, err := ioutil.TempDir("", "control")
+	require.NoError(t, err)
+
+	config := common.MustNewConfigFrom(map[string]interface{}{
+		"enabled": true,
+		"path":    filepath.Join(dir, "control.sock"),
+	})
+	info := beat.Info{Beat: "testbeat", Name: "test", Version: "8.0.0"}
+	s, err := New(nil, info, registry, config)
+	require.NoError(t, err)
+	s.activeEvents = func() uint64 { return 0 }
+	s.pausables = NewPausableRegistry()
+	s.Start(nil)
+
+	conn, err := grpc.Dial(s.path, grpc.WithInsecure(), grpc.WithContextDialer(
+		func(ctx context.Context, addr string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "unix", addr)
+		}),
+	)
+	require.NoError(t, err)
+
+	return s, NewControlClient(conn), func() {
+		conn.Close()
+		s.Stop()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestSocketFileMode(t *testing.T) {
+	s, _, cleanup := newTestServer(t, reload.NewRegistry())
+	defer cleanup()
+
+	info, err := os.Stat(s.path)
+	require.NoError(t, err)
+	assert.Equal(t, socketFileMode, info.Mode().Perm())
+}
+
+func TestStatusAndHealth(t *testing.T) {
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.inputs", &fakeList{})
+	registry.MustRegister("output", reload.ReloadableFunc(func(*reload.ConfigWithMeta) error { return nil }))
+
+	_, client, cleanup := newTestServer(t, registry)
+	defer cleanup()
+
+	resp, err := client.Status(context.Background(), &StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "testbeat", resp.Beat)
+	assert.Equal(t, "test", resp.Name)
+	assert.Equal(t, "8.0.0", resp.Version)
+	assert.Equal(t, StateRunning, resp.State)
+	assert.Equal(t, []string{"output", "testbeat.inputs"}, resp.Reloadables)
+	assert.Empty(t, resp.Inputs)
+
+	health, err := client.Health(context.Background(), &HealthRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, ServingStatusServing, health.Status)
+}
+
+func TestAddRemoveInput(t *testing.T) {
+	inputs := &fakeList{}
+	modules := &fakeList{}
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.inputs", inputs)
+	registry.MustRegisterList("testbeat.modules", modules)
+
+	_, client, cleanup := newTestServer(t, registry)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "b", Config: "type: log"}})
+	require.NoError(t, err)
+	_, err = client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "a", Config: `{"type": "stdin"}`}})
+	require.NoError(t, err)
+	_, err = client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "c", List: "testbeat.modules", Config: "type: nginx"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"stdin", "log"}, inputs.types(t))
+	assert.Equal(t, []string{"nginx"}, modules.types(t))
+
+	resp, err := client.Status(ctx, &StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*Input{
+		{ID: "a", List: "testbeat.inputs", Config: `{"type": "stdin"}`},
+		{ID: "b", List: "testbeat.inputs", Config: "type: log"},
+		{ID: "c", List: "testbeat.modules", Config: "type: nginx"},
+	}, resp.Inputs)
+
+	_, err = client.RemoveInput(ctx, &RemoveInputRequest{ID: "a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"log"}, inputs.types(t))
+	assert.Equal(t, []string{"nginx"}, modules.types(t))
+
+	_, err = client.RemoveInput(ctx, &RemoveInputRequest{ID: "a"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestAddInputErrors(t *testing.T) {
+	failing := &fakeList{err: errors.New("oops")}
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.inputs", &fakeList{})
+	registry.MustRegisterList("testbeat.failing", failing)
+
+	_, client, cleanup := newTestServer(t, registry)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "a", Config: "type: log"}})
+	require.NoError(t, err)
+
+	cases := map[string]struct {
+		input *Input
+		code  codes.Code
+	}{
+		"missing id":     {&Input{Config: "type: log"}, codes.InvalidArgument},
+		"duplicated id":  {&Input{ID: "a", Config: "type: log"}, codes.AlreadyExists},
+		"missing config": {&Input{ID: "b"}, codes.InvalidArgument},
+		"invalid config": {&Input{ID: "b", Config: "type: [log"}, codes.InvalidArgument},
+		"unknown list":   {&Input{ID: "b", List: "unknown", Config: "type: log"}, codes.NotFound},
+		"failing reload": {&Input{ID: "b", List: "testbeat.failing", Config: "type: log"}, codes.Internal},
+		"missing input":  {nil, codes.InvalidArgument},
+	}
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := client.AddInput(ctx, &AddInputRequest{Input: c.input})
+			assert.Equal(t, c.code, status.Code(err), "%v", err)
+		})
+	}
+
+	// Failed inputs are not kept
+	resp, err := client.Status(ctx, &StatusRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.Inputs, 1)
+}
+
+func TestDefaultList(t *testing.T) {
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.modules", &fakeList{})
+
+	s, _, cleanup := newTestServer(t, registry)
+	defer cleanup()
+
+	list, err := s.resolveList("")
+	require.NoError(t, err)
+	assert.Equal(t, "testbeat.modules", list)
+
+	registry.MustRegisterList("testbeat.monitors", &fakeList{})
+	_, err = s.resolveList("")
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestReload(t *testing.T) {
+	inputs := &fakeList{}
+	var output *common.Config
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.inputs", inputs)
+	registry.MustRegister("output", reload.ReloadableFunc(func(c *reload.ConfigWithMeta) error {
+		output = c.Config
+		return nil
+	}))
+
+	_, client, cleanup := newTestServer(t, registry)
+	defer cleanup()
+	ctx := context.Background()
+
+	_, err := client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "a", Config: "type: log"}})
+	require.NoError(t, err)
+
+	_, err = client.Reload(ctx, &ReloadRequest{
+		Name: "testbeat.inputs",
+		Inputs: []*Input{
+			{ID: "b", Config: "type: stdin"},
+			{ID: "c", Config: "type: udp"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stdin", "udp"}, inputs.types(t))
+
+	resp, err := client.Status(ctx, &StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []*Input{
+		{ID: "b", List: "testbeat.inputs", Config: "type: stdin"},
+		{ID: "c", List: "testbeat.inputs", Config: "type: udp"},
+	}, resp.Inputs)
+
+	_, err = client.Reload(ctx, &ReloadRequest{Name: "output", Config: "console.enabled: true"})
+	require.NoError(t, err)
+	require.NotNil(t, output)
+	assert.True(t, output.HasField("console"))
+
+	_, err = client.Reload(ctx, &ReloadRequest{Name: "testbeat.inputs", Inputs: []*Input{{ID: "d"}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, []string{"stdin", "udp"}, inputs.types(t))
+}
+
+func TestDrain(t *testing.T) {
+	inputs := &fakeList{}
+	registry := reload.NewRegistry()
+	registry.MustRegisterList("testbeat.inputs", inputs)
+
+	s, client, cleanup := newTestServer(t, registry)
+	defer cleanup()
+	ctx := context.Background()
+
+	var stopped atomic.Bool
+	s.mutex.Lock()
+	s.stop = func() { stopped.Store(true) }
+	s.mutex.Unlock()
+
+	active := atomic.MakeUint64(3)
+	s.activeEvents = func() uint64 {
+		if active.Load() == 0 {
+			return 0
+		}
+		return active.Dec()
+	}
+
+	_, err := client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "a", Config: "type: log"}})
+	require.NoError(t, err)
+
+	resp, err := client.Drain(ctx, &DrainRequest{TimeoutMs: 5000, Stop: true})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), resp.ActiveEvents)
+	assert.Empty(t, inputs.types(t))
+
+	status, err := client.Status(ctx, &StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, StateDrained, status.State)
+	assert.Empty(t, status.Inputs)
+
+	health, err := client.Health(ctx, &HealthRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, ServingStatusNotServing, health.Status)
+
+	_, err = client.AddInput(ctx, &AddInputRequest{Input: &Input{ID: "a", Config: "type: log"}})
+	assert.Error(t, err)
+
+	assert.Eventually(t, stopped.Load, time.Second, 10*time.Millisecond)
+}
+
+func TestDrainTimeout(t *testing.T) {
+	s, client, cleanup := newTestServer(t, reload.NewRegistry())
+	defer cleanup()
+
+	s.activeEvents = func() uint64 { return 42 }
+
+	resp, err := client.Drain(context.Background(), &DrainRequest{TimeoutMs: 200})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), resp.ActiveEvents)
+}
+
+func TestPauseResumeInput(t *testing.T) {
+	s, client, cleanup := newTestServer(t, reload.NewRegistry())
+	defer cleanup()
+
+	logs, metrics := &fakePausable{}, &fakePausable{}
+	s.pausables.Register("logs", logs)
+	s.pausables.Register("metrics", metrics)
+
+	_, err := client.PauseInput(context.Background(), &PauseInputRequest{ID: "logs"})
+	require.NoError(t, err)
+	assert.True(t, logs.Paused())
+	assert.False(t, metrics.Paused())
+
+	// Pausing a paused input has no effect.
+	_, err = client.PauseInput(context.Background(), &PauseInputRequest{ID: "logs"})
+	require.NoError(t, err)
+
+	resp, err := client.Status(context.Background(), &StatusRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"logs"}, resp.PausedInputs)
+
+	_, err = client.ResumeInput(context.Background(), &ResumeInputRequest{ID: "logs"})
+	require.NoError(t, err)
+	assert.False(t, logs.Paused())
+
+	resp, err = client.Status(context.Background(), &StatusRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.PausedInputs)
+
+	_, err = client.PauseInput(context.Background(), &PauseInputRequest{ID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = client.ResumeInput(context.Background(), &ResumeInputRequest{ID: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestPausableRegistry(t *testing.T) {
+	registry := NewPausableRegistry()
+	first, second := &fakePausable{}, &fakePausable{}
+
+	registry.Register("logs", first)
+	registry.Register("logs", second)
+	assert.Equal(t, second, registry.Get("logs"))
+
+	// Only the registered input can unregister the ID.
+	registry.Unregister("logs", first)
+	assert.Equal(t, second, registry.Get("logs"))
+	registry.Unregister("logs", second)
+	assert.Nil(t, registry.Get("logs"))
+}
+
+func TestPausableRegistryPauseAll(t *testing.T) {
+	registry := NewPausableRegistry()
+	logs, metrics := &fakePausable{}, &fakePausable{}
+	registry.Register("logs", logs)
+	registry.Register("metrics", metrics)
+	metrics.Pause()
+
+	resume := registry.PauseAll()
+	assert.True(t, logs.Paused())
+	assert.Equal(t, []string{"logs", "metrics"}, registry.Paused())
+
+	// Inputs paused before are kept paused.
+	resume()
+	assert.False(t, logs.Paused())
+	assert.True(t, metrics.Paused())
+}
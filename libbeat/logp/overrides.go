@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// levelOverrides holds the levels set at runtime for some selectors. The map
+// is replaced on every change, so it can be read without locking.
+type levelOverrides struct {
+	levels map[string]Level
+	min    Level // Lowest level in levels.
+}
+
+var (
+	_overridesMu sync.Mutex
+	_overrides   atomic.Value // *levelOverrides
+)
+
+func init() {
+	_overrides.Store(&levelOverrides{})
+}
+
+func loadOverrides() *levelOverrides {
+	return _overrides.Load().(*levelOverrides)
+}
+
+// SetSelectorLevel sets the level of the loggers with the given selector, and
+// of the loggers named after it (e.g. "autodiscover.pod" for "autodiscover").
+// It takes precedence over the configured level and debug selectors, so it can
+// be used to debug a single component at runtime without restarting.
+func SetSelectorLevel(selector string, level Level) {
+	updateOverrides(func(levels map[string]Level) {
+		levels[selector] = level
+	})
+}
+
+// ResetSelectorLevel removes the level set with SetSelectorLevel for the given
+// selector, its loggers use the configured level again.
+func ResetSelectorLevel(selector string) {
+	updateOverrides(func(levels map[string]Level) {
+		delete(levels, selector)
+	})
+}
+
+// SelectorLevels returns the levels set with SetSelectorLevel.
+func SelectorLevels() map[string]Level {
+	levels := map[string]Level{}
+	for selector, level := range loadOverrides().levels {
+		levels[selector] = level
+	}
+	return levels
+}
+
+func updateOverrides(update func(map[string]Level)) {
+	_overridesMu.Lock()
+	defer _overridesMu.Unlock()
+
+	levels := SelectorLevels()
+	update(levels)
+
+	o := &levelOverrides{levels: levels, min: CriticalLevel}
+	for _, level := range levels {
+		if level < o.min {
+			o.min = level
+		}
+	}
+	_overrides.Store(o)
+}
+
+// levelFor returns the level set for the logger with the given name, looking
+// for the most specific selector it is named after.
+func (o *levelOverrides) levelFor(name string) (Level, bool) {
+	if len(o.levels) == 0 {
+		return 0, false
+	}
+	for {
+		if level, found := o.levels[name]; found {
+			return level, true
+		}
+		i := strings.LastIndexByte(name, '.')
+		if i < 0 {
+			return 0, false
+		}
+		name = name[:i]
+	}
+}
+
+// overridesCore applies the levels set at runtime for some selectors on top
+// of the level and debug selectors of the wrapped core.
+type overridesCore struct {
+	core zapcore.Core
+}
+
+func overridesWrapper(core zapcore.Core) zapcore.Core {
+	return &overridesCore{core: core}
+}
+
+// Enabled returns whether a given logging level is enabled when logging a
+// message.
+func (c *overridesCore) Enabled(level zapcore.Level) bool {
+	if c.core.Enabled(level) {
+		return true
+	}
+	o := loadOverrides()
+	return len(o.levels) > 0 && o.min.zapLevel() <= level
+}
+
+// With adds structured context to the Core.
+func (c *overridesCore) With(fields []zapcore.Field) zapcore.Core {
+	return overridesWrapper(c.core.With(fields))
+}
+
+// Check determines whether the supplied Entry should be logged. Entries of
+// loggers with a level set at runtime skip the checks of the wrapped core.
+func (c *overridesCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if level, found := loadOverrides().levelFor(ent.LoggerName); found {
+		if ent.Level >= level.zapLevel() {
+			return ce.AddCore(ent, c)
+		}
+		return ce
+	}
+	return c.core.Check(ent, ce)
+}
+
+// Write serializes the Entry and any Fields supplied at the log site and
+// writes them to their destination.
+func (c *overridesCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.core.Write(ent, fields)
+}
+
+// Sync flushes buffered logs (if any).
+func (c *overridesCore) Sync() error {
+	return c.core.Sync()
+}
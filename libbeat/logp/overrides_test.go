@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package logp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSelectorLevels(t *testing.T) {
+	if err := DevelopmentSetup(WithLevel(InfoLevel), ToObserverOutput()); err != nil {
+		t.Fatal(err)
+	}
+	defer ResetSelectorLevel("hints")
+	defer ResetSelectorLevel("publisher")
+
+	hints := NewLogger("hints").Named("builder")
+	other := NewLogger("other")
+
+	hints.Debug("not logged")
+	other.Debug("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 0)
+
+	SetSelectorLevel("hints", DebugLevel)
+	SetSelectorLevel("publisher", ErrorLevel)
+	assert.Equal(t, map[string]Level{"hints": DebugLevel, "publisher": ErrorLevel}, SelectorLevels())
+
+	hints.Debug("is logged")
+	Debug("hints", "is also logged")
+	other.Debug("not logged")
+	logs := ObserverLogs().TakeAll()
+	if assert.Len(t, logs, 2) {
+		assert.Equal(t, "hints.builder", logs[0].LoggerName)
+		assert.Equal(t, "hints", logs[1].LoggerName)
+	}
+
+	// Levels can also be raised.
+	NewLogger("publisher").Info("not logged")
+	NewLogger("publisher").Error("is logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 1)
+
+	ResetSelectorLevel("hints")
+	hints.Debug("not logged")
+	assert.Len(t, ObserverLogs().TakeAll(), 0)
+	assert.Equal(t, map[string]Level{"publisher": ErrorLevel}, SelectorLevels())
+}
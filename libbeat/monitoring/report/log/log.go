@@ -35,33 +35,36 @@ import (
 // TODO: Replace this with a proper solution that uses the metric type from
 // where it is defined. See: https://github.com/elastic/beats/issues/5433
 var gauges = map[string]bool{
-	"libbeat.pipeline.events.active": true,
-	"libbeat.pipeline.clients":       true,
-	"libbeat.config.module.running":  true,
-	"registrar.states.current":       true,
-	"filebeat.harvester.running":     true,
-	"filebeat.harvester.open_files":  true,
-	"beat.memstats.memory_total":     true,
-	"beat.memstats.memory_alloc":     true,
-	"beat.memstats.gc_next":          true,
-	"beat.info.uptime.ms":            true,
-	"beat.cpu.user.ticks":            true,
-	"beat.cpu.user.time":             true,
-	"beat.cpu.system.ticks":          true,
-	"beat.cpu.system.time":           true,
-	"beat.cpu.total.value":           true,
-	"beat.cpu.total.ticks":           true,
-	"beat.cpu.total.time":            true,
-	"beat.handles.open":              true,
-	"beat.handles.limit.hard":        true,
-	"beat.handles.limit.soft":        true,
-	"beat.runtime.goroutines":        true,
-	"system.load.1":                  true,
-	"system.load.5":                  true,
-	"system.load.15":                 true,
-	"system.load.norm.1":             true,
-	"system.load.norm.5":             true,
-	"system.load.norm.15":            true,
+	"libbeat.pipeline.events.active":       true,
+	"libbeat.pipeline.clients":             true,
+	"libbeat.config.module.running":        true,
+	"libbeat.autodiscover.configs.pending": true,
+	"libbeat.autodiscover.configs.running": true,
+	"libbeat.autodiscover.leader":          true,
+	"registrar.states.current":             true,
+	"filebeat.harvester.running":           true,
+	"filebeat.harvester.open_files":        true,
+	"beat.memstats.memory_total":           true,
+	"beat.memstats.memory_alloc":           true,
+	"beat.memstats.gc_next":                true,
+	"beat.info.uptime.ms":                  true,
+	"beat.cpu.user.ticks":                  true,
+	"beat.cpu.user.time":                   true,
+	"beat.cpu.system.ticks":                true,
+	"beat.cpu.system.time":                 true,
+	"beat.cpu.total.value":                 true,
+	"beat.cpu.total.ticks":                 true,
+	"beat.cpu.total.time":                  true,
+	"beat.handles.open":                    true,
+	"beat.handles.limit.hard":              true,
+	"beat.handles.limit.soft":              true,
+	"beat.runtime.goroutines":              true,
+	"system.load.1":                        true,
+	"system.load.5":                        true,
+	"system.load.15":                       true,
+	"system.load.norm.1":                   true,
+	"system.load.norm.5":                   true,
+	"system.load.norm.15":                  true,
 }
 
 // TODO: Change this when gauges are refactored, too.
@@ -0,0 +1,254 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	"github.com/elastic/beats/v7/libbeat/testing"
+)
+
+// CircuitBreakerConfig configures the per host circuit breaker installed by
+// WithCircuitBreaker.
+type CircuitBreakerConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Window is the number of most recent publish requests the error and
+	// latency ratios are computed on.
+	Window int `config:"window" validate:"min=1"`
+
+	// ErrorRatio is the ratio of failed requests in the window tripping the
+	// breaker. 0 disables the check.
+	ErrorRatio float64 `config:"error_ratio" validate:"min=0, max=1"`
+
+	// SlowRatio is the ratio of requests slower than SlowThreshold in the
+	// window tripping the breaker. 0 disables the check.
+	SlowRatio     float64       `config:"slow_ratio" validate:"min=0, max=1"`
+	SlowThreshold time.Duration `config:"slow_threshold"`
+
+	// Cooldown is the time an open breaker waits before probing the host again.
+	Cooldown time.Duration `config:"cooldown"`
+}
+
+// DefaultCircuitBreakerConfig returns the circuit breaker defaults. The
+// breaker is disabled by default.
+func DefaultCircuitBreakerConfig() CircuitBreakerConfig {
+	return CircuitBreakerConfig{
+		Enabled:       false,
+		Window:        20,
+		ErrorRatio:    0.5,
+		SlowRatio:     0.5,
+		SlowThreshold: 10 * time.Second,
+		Cooldown:      30 * time.Second,
+	}
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+var errCircuitOpen = errors.New("circuit breaker is open")
+
+type requestOutcome struct {
+	failed bool
+	slow   bool
+}
+
+type circuitBreakerClient struct {
+	client NetworkClient
+	config CircuitBreakerConfig
+	log    *logp.Logger
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	mu       sync.Mutex
+	state    breakerState
+	openedAt time.Time
+	outcomes []requestOutcome
+	next     int
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// WithCircuitBreaker wraps a NetworkClient, tripping a circuit breaker if the
+// host it talks to becomes unhealthy. Other than backoff, which only reacts
+// to hard connection failures, the breaker also trips on brownouts, when too
+// many of the recent publish requests failed or were slow.
+//
+// While the breaker is open, batches are handed back to the pipeline so
+// healthy hosts can pick them up. After the cooldown the breaker reconnects to
+// the host in the background and lets a single batch through as a probe. If
+// the probe succeeds, the breaker closes again.
+func WithCircuitBreaker(client NetworkClient, config CircuitBreakerConfig) NetworkClient {
+	if !config.Enabled {
+		return client
+	}
+
+	return &circuitBreakerClient{
+		client:   client,
+		config:   config,
+		log:      logp.NewLogger("circuit_breaker"),
+		done:     make(chan struct{}),
+		outcomes: make([]requestOutcome, 0, config.Window),
+		now:      time.Now,
+	}
+}
+
+func (c *circuitBreakerClient) Connect() error {
+	c.mu.Lock()
+	state, wait := c.state, c.config.Cooldown-c.now().Sub(c.openedAt)
+	c.mu.Unlock()
+
+	if state == breakerOpen {
+		if wait > 0 {
+			select {
+			case <-c.done:
+				return errCircuitOpen
+			case <-time.After(wait):
+			}
+		}
+
+		c.mu.Lock()
+		c.state = breakerHalfOpen
+		c.mu.Unlock()
+		c.log.Infof("Probing %v after circuit breaker cooldown", c.client)
+	}
+
+	err := c.client.Connect()
+	if err != nil {
+		c.mu.Lock()
+		if c.state == breakerHalfOpen {
+			c.trip("probe connection failed")
+		}
+		c.mu.Unlock()
+	}
+	return err
+}
+
+func (c *circuitBreakerClient) Close() error {
+	c.closeOnce.Do(func() { close(c.done) })
+	return c.client.Close()
+}
+
+func (c *circuitBreakerClient) Publish(ctx context.Context, batch publisher.Batch) error {
+	c.mu.Lock()
+	open := c.state == breakerOpen
+	c.mu.Unlock()
+
+	if open {
+		// Return the batch to the other output workers and force a reconnect,
+		// which waits for the cooldown.
+		batch.Cancelled()
+		c.client.Close()
+		return errCircuitOpen
+	}
+
+	start := c.now()
+	err := c.client.Publish(ctx, batch)
+	c.record(err != nil, c.now().Sub(start))
+	return err
+}
+
+// record adds the outcome of a publish request to the window and updates the
+// breaker state.
+func (c *circuitBreakerClient) record(failed bool, took time.Duration) {
+	slow := c.config.SlowThreshold > 0 && took > c.config.SlowThreshold
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.state == breakerHalfOpen {
+		if failed || slow {
+			c.trip("probe request failed or was slow")
+			return
+		}
+		c.state = breakerClosed
+		c.outcomes = c.outcomes[:0]
+		c.next = 0
+		c.log.Infof("Circuit breaker for %v closed, host is healthy again", c.client)
+		return
+	}
+
+	outcome := requestOutcome{failed: failed, slow: slow}
+	if len(c.outcomes) < c.config.Window {
+		c.outcomes = append(c.outcomes, outcome)
+	} else {
+		c.outcomes[c.next] = outcome
+		c.next = (c.next + 1) % c.config.Window
+	}
+
+	if len(c.outcomes) < c.config.Window {
+		return
+	}
+
+	var failures, slowdowns int
+	for _, o := range c.outcomes {
+		if o.failed {
+			failures++
+		}
+		if o.slow {
+			slowdowns++
+		}
+	}
+
+	total := float64(len(c.outcomes))
+	switch {
+	case c.config.ErrorRatio > 0 && float64(failures)/total >= c.config.ErrorRatio:
+		c.trip(fmt.Sprintf("%d of the last %d requests failed", failures, len(c.outcomes)))
+	case c.config.SlowRatio > 0 && float64(slowdowns)/total >= c.config.SlowRatio:
+		c.trip(fmt.Sprintf("%d of the last %d requests took longer than %v", slowdowns, len(c.outcomes), c.config.SlowThreshold))
+	}
+}
+
+// trip opens the breaker. Must be called with mu held.
+func (c *circuitBreakerClient) trip(reason string) {
+	c.state = breakerOpen
+	c.openedAt = c.now()
+	c.outcomes = c.outcomes[:0]
+	c.next = 0
+	c.log.Warnf("Circuit breaker for %v opened for %v: %s", c.client, c.config.Cooldown, reason)
+}
+
+func (c *circuitBreakerClient) Client() NetworkClient {
+	return c.client
+}
+
+func (c *circuitBreakerClient) Test(d testing.Driver) {
+	t, ok := c.client.(testing.Testable)
+	if !ok {
+		d.Fatal("output", errors.New("client doesn't support testing"))
+	}
+
+	t.Test(d)
+}
+
+func (c *circuitBreakerClient) String() string {
+	return "circuit_breaker(" + c.client.String() + ")"
+}
@@ -0,0 +1,141 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package outputs
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+type mockNetworkClient struct {
+	publish    func() error
+	published  int
+	connects   int
+	connectErr error
+}
+
+func (m *mockNetworkClient) Connect() error { m.connects++; return m.connectErr }
+func (m *mockNetworkClient) Close() error   { return nil }
+func (m *mockNetworkClient) String() string { return "mock" }
+
+func (m *mockNetworkClient) Publish(_ context.Context, _ publisher.Batch) error {
+	m.published++
+	return m.publish()
+}
+
+func newTestBreaker(client NetworkClient, now *time.Time) *circuitBreakerClient {
+	config := DefaultCircuitBreakerConfig()
+	config.Enabled = true
+	config.Window = 4
+	config.SlowThreshold = time.Second
+	config.Cooldown = 0
+
+	c := WithCircuitBreaker(client, config).(*circuitBreakerClient)
+	c.now = func() time.Time { return *now }
+	return c
+}
+
+func TestCircuitBreakerDisabled(t *testing.T) {
+	client := &mockNetworkClient{}
+	assert.Equal(t, client, WithCircuitBreaker(client, DefaultCircuitBreakerConfig()))
+}
+
+func TestCircuitBreakerTripsOnErrors(t *testing.T) {
+	now := time.Now()
+	client := &mockNetworkClient{}
+	c := newTestBreaker(client, &now)
+
+	fail := true
+	client.publish = func() error {
+		fail = !fail
+		if fail {
+			return errors.New("bulk request failed")
+		}
+		return nil
+	}
+
+	for i := 0; i < 3; i++ {
+		c.Publish(context.Background(), outest.NewBatch())
+	}
+	assert.Equal(t, breakerClosed, c.state, "window not full yet")
+
+	c.Publish(context.Background(), outest.NewBatch())
+	assert.Equal(t, breakerOpen, c.state)
+
+	// Open breaker hands batches back without reaching the host.
+	batch := outest.NewBatch()
+	err := c.Publish(context.Background(), batch)
+	assert.Equal(t, errCircuitOpen, err)
+	assert.Equal(t, 4, client.published)
+	assert.Equal(t, []outest.BatchSignal{{Tag: outest.BatchCancelled}}, batch.Signals)
+}
+
+func TestCircuitBreakerTripsOnLatency(t *testing.T) {
+	now := time.Now()
+	client := &mockNetworkClient{}
+	c := newTestBreaker(client, &now)
+
+	client.publish = func() error {
+		now = now.Add(2 * time.Second)
+		return nil
+	}
+
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, c.Publish(context.Background(), outest.NewBatch()))
+	}
+	assert.Equal(t, breakerOpen, c.state)
+}
+
+func TestCircuitBreakerProbe(t *testing.T) {
+	now := time.Now()
+	client := &mockNetworkClient{}
+	c := newTestBreaker(client, &now)
+
+	failing := errors.New("bulk request failed")
+	client.publish = func() error { return failing }
+
+	for i := 0; i < 4; i++ {
+		c.Publish(context.Background(), outest.NewBatch())
+	}
+	assert.Equal(t, breakerOpen, c.state)
+
+	// Failing probe opens the breaker again.
+	assert.NoError(t, c.Connect())
+	assert.Equal(t, breakerHalfOpen, c.state)
+	c.Publish(context.Background(), outest.NewBatch())
+	assert.Equal(t, breakerOpen, c.state)
+
+	// Failing probe connection keeps the breaker open.
+	client.connectErr = errors.New("connection refused")
+	assert.Error(t, c.Connect())
+	assert.Equal(t, breakerOpen, c.state)
+
+	// Successful probe closes it.
+	client.connectErr = nil
+	client.publish = func() error { return nil }
+	assert.NoError(t, c.Connect())
+	assert.NoError(t, c.Publish(context.Background(), outest.NewBatch()))
+	assert.Equal(t, breakerClosed, c.state)
+}
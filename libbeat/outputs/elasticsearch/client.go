@@ -46,6 +46,8 @@ type Client struct {
 	pipeline *outil.Selector
 
 	observer outputs.Observer
+	recorder *failureRecorder
+	hedger   *hedger
 
 	log *logp.Logger
 }
@@ -56,6 +58,9 @@ type ClientSettings struct {
 	Index    outputs.IndexSelector
 	Pipeline *outil.Selector
 	Observer outputs.Observer
+
+	recorder *failureRecorder
+	hedger   *hedger
 }
 
 type bulkResultStats struct {
@@ -130,6 +135,8 @@ func NewClient(
 		pipeline: pipeline,
 
 		observer: s.Observer,
+		recorder: s.recorder,
+		hedger:   s.hedger,
 
 		log: logp.NewLogger("elasticsearch"),
 	}
@@ -168,6 +175,7 @@ func (client *Client) Clone() *Client {
 			},
 			Index:    client.index,
 			Pipeline: client.pipeline,
+			recorder: client.recorder,
 		},
 		nil, // XXX: do not pass connection callback?
 	)
@@ -202,6 +210,10 @@ func (client *Client) publishEvents(ctx context.Context, data []publisher.Event)
 		return nil, nil
 	}
 
+	if client.hedger != nil {
+		client.hedger.assignIDs(data)
+	}
+
 	// encode events into bulk request buffer, dropping failed elements from
 	// events slice
 	origCount := len(data)
@@ -216,11 +228,13 @@ func (client *Client) publishEvents(ctx context.Context, data []publisher.Event)
 		return nil, nil
 	}
 
-	status, result, sendErr := client.conn.Bulk(ctx, "", "", nil, bulkItems)
+	resp := client.bulk(ctx, bulkItems)
+	status, result, sendErr := resp.status, resp.result, resp.err
 	if sendErr != nil {
 		err := apm.CaptureError(ctx, fmt.Errorf("failed to perform any bulk index operations: %w", sendErr))
 		err.Send()
 		client.log.Error(err)
+		client.recorder.Record(resp.conn.URL, bulkItems, status, result, sendErr, len(data), len(data))
 		return data, sendErr
 	}
 	pubCount := len(data)
@@ -241,6 +255,9 @@ func (client *Client) publishEvents(ctx context.Context, data []publisher.Event)
 	}
 
 	failed := len(failedEvents)
+	if failed > 0 || stats.nonIndexable > 0 {
+		client.recorder.Record(resp.conn.URL, bulkItems, status, result, nil, len(data), failed+stats.nonIndexable)
+	}
 	span.Context.SetLabel("events_failed", failed)
 	if st := client.observer; st != nil {
 		dropped := stats.nonIndexable
@@ -263,6 +280,14 @@ func (client *Client) publishEvents(ctx context.Context, data []publisher.Event)
 	return nil, nil
 }
 
+// bulk sends the bulk request, hedging it across hosts if enabled.
+func (client *Client) bulk(ctx context.Context, bulkItems []interface{}) bulkResponse {
+	if client.hedger != nil {
+		return client.hedger.bulk(ctx, &client.conn, bulkItems)
+	}
+	return sendBulk(ctx, &client.conn, bulkItems)
+}
+
 // bulkEncodePublishRequest encodes all bulk requests and returns slice of events
 // successfully added to the list of bulk items and the list of bulk items.
 func bulkEncodePublishRequest(
@@ -423,6 +448,9 @@ func (client *Client) Connect() error {
 }
 
 func (client *Client) Close() error {
+	if client.hedger != nil {
+		client.hedger.Close()
+	}
 	return client.conn.Close()
 }
 
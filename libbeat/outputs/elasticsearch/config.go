@@ -24,27 +24,30 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/transport/kerberos"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 )
 
 type elasticsearchConfig struct {
-	Protocol         string            `config:"protocol"`
-	Path             string            `config:"path"`
-	Params           map[string]string `config:"parameters"`
-	Headers          map[string]string `config:"headers"`
-	Username         string            `config:"username"`
-	Password         string            `config:"password"`
-	APIKey           string            `config:"api_key"`
-	ProxyURL         string            `config:"proxy_url"`
-	ProxyDisable     bool              `config:"proxy_disable"`
-	LoadBalance      bool              `config:"loadbalance"`
-	CompressionLevel int               `config:"compression_level" validate:"min=0, max=9"`
-	EscapeHTML       bool              `config:"escape_html"`
-	TLS              *tlscommon.Config `config:"ssl"`
-	Kerberos         *kerberos.Config  `config:"kerberos"`
-	BulkMaxSize      int               `config:"bulk_max_size"`
-	MaxRetries       int               `config:"max_retries"`
-	Timeout          time.Duration     `config:"timeout"`
-	Backoff          Backoff           `config:"backoff"`
+	Protocol         string                       `config:"protocol"`
+	Path             string                       `config:"path"`
+	Params           map[string]string            `config:"parameters"`
+	Headers          map[string]string            `config:"headers"`
+	Username         string                       `config:"username"`
+	Password         string                       `config:"password"`
+	APIKey           string                       `config:"api_key"`
+	ProxyURL         string                       `config:"proxy_url"`
+	ProxyDisable     bool                         `config:"proxy_disable"`
+	LoadBalance      bool                         `config:"loadbalance"`
+	CompressionLevel int                          `config:"compression_level" validate:"min=0, max=9"`
+	EscapeHTML       bool                         `config:"escape_html"`
+	TLS              *tlscommon.Config            `config:"ssl"`
+	Kerberos         *kerberos.Config             `config:"kerberos"`
+	BulkMaxSize      int                          `config:"bulk_max_size"`
+	MaxRetries       int                          `config:"max_retries"`
+	Timeout          time.Duration                `config:"timeout"`
+	Backoff          Backoff                      `config:"backoff"`
+	CircuitBreaker   outputs.CircuitBreakerConfig `config:"circuit_breaker"`
+	Hedging          hedgingConfig                `config:"hedging"`
 }
 
 type Backoff struct {
@@ -77,6 +80,8 @@ var (
 			Init: 1 * time.Second,
 			Max:  60 * time.Second,
 		},
+		CircuitBreaker: outputs.DefaultCircuitBreakerConfig(),
+		Hedging:        defaultHedgingConfig(),
 	}
 )
 
@@ -77,52 +77,102 @@ func makeES(
 		}
 	}
 
+	recorder, err := newFailureRecorder(cfg)
+	if err != nil {
+		return outputs.Fail(err)
+	}
+
 	params := config.Params
 	if len(params) == 0 {
 		params = nil
 	}
 
-	clients := make([]outputs.NetworkClient, len(hosts))
+	esURLs := make([]string, len(hosts))
 	for i, host := range hosts {
 		esURL, err := common.MakeURL(config.Protocol, config.Path, host, 9200)
 		if err != nil {
 			log.Errorf("Invalid host param set: %s, Error: %+v", host, err)
 			return outputs.Fail(err)
 		}
+		esURLs[i] = esURL
+	}
+
+	connectionSettings := func(esURL string) eslegclient.ConnectionSettings {
+		return eslegclient.ConnectionSettings{
+			URL:              esURL,
+			Proxy:            proxyURL,
+			ProxyDisable:     config.ProxyDisable,
+			TLS:              tlsConfig,
+			Kerberos:         config.Kerberos,
+			Username:         config.Username,
+			Password:         config.Password,
+			APIKey:           config.APIKey,
+			Parameters:       params,
+			Headers:          config.Headers,
+			Timeout:          config.Timeout,
+			CompressionLevel: config.CompressionLevel,
+			Observer:         observer,
+			EscapeHTML:       config.EscapeHTML,
+		}
+	}
+
+	if config.Hedging.Enabled && len(esURLs) < 2 {
+		log.Warn("Hedging of bulk requests requires multiple hosts, it is disabled.")
+	}
+
+	clients := make([]outputs.NetworkClient, len(esURLs))
+	for i, esURL := range esURLs {
+		var hedge *hedger
+		if config.Hedging.Enabled && len(esURLs) > 1 {
+			hedge, err = makeHedger(config.Hedging, esURLs, i, connectionSettings)
+			if err != nil {
+				return outputs.Fail(err)
+			}
+		}
 
 		var client outputs.NetworkClient
 		client, err = NewClient(ClientSettings{
-			ConnectionSettings: eslegclient.ConnectionSettings{
-				URL:              esURL,
-				Proxy:            proxyURL,
-				ProxyDisable:     config.ProxyDisable,
-				TLS:              tlsConfig,
-				Kerberos:         config.Kerberos,
-				Username:         config.Username,
-				Password:         config.Password,
-				APIKey:           config.APIKey,
-				Parameters:       params,
-				Headers:          config.Headers,
-				Timeout:          config.Timeout,
-				CompressionLevel: config.CompressionLevel,
-				Observer:         observer,
-				EscapeHTML:       config.EscapeHTML,
-			},
-			Index:    index,
-			Pipeline: pipeline,
-			Observer: observer,
+			ConnectionSettings: connectionSettings(esURL),
+			Index:              index,
+			Pipeline:           pipeline,
+			Observer:           observer,
+			recorder:           recorder,
+			hedger:             hedge,
 		}, &connectCallbackRegistry)
 		if err != nil {
 			return outputs.Fail(err)
 		}
 
 		client = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+		client = outputs.WithCircuitBreaker(client, config.CircuitBreaker)
 		clients[i] = client
 	}
 
 	return outputs.SuccessNet(config.LoadBalance, config.BulkMaxSize, config.MaxRetries, clients)
 }
 
+// makeHedger creates the hedger of the client sending to esURLs[self], with
+// its own connections to all other hosts.
+func makeHedger(
+	config hedgingConfig,
+	esURLs []string,
+	self int,
+	settings func(string) eslegclient.ConnectionSettings,
+) (*hedger, error) {
+	var peers []*eslegclient.Connection
+	for i, esURL := range esURLs {
+		if i == self {
+			continue
+		}
+		conn, err := eslegclient.NewConnection(settings(esURL))
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, conn)
+	}
+	return newHedger(config, peers)
+}
+
 func buildSelectors(
 	im outputs.IndexManager,
 	beat beat.Info,
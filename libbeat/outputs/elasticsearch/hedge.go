@@ -0,0 +1,239 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat/events"
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors/add_id/generator"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+// minHedgingSamples is the number of bulk requests that must have completed
+// before the latency percentile is trusted and requests are hedged.
+const minHedgingSamples = 10
+
+// hedgingConfig configures hedged bulk requests.
+type hedgingConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Percentile of the recent bulk request latencies after which a request
+	// is sent to another host as well.
+	Percentile float64 `config:"percentile"`
+
+	// MinDelay is the lower bound of the hedging delay, so that requests
+	// are not duplicated when all of them are fast.
+	MinDelay time.Duration `config:"min_delay" validate:"min=0"`
+
+	// Window is the number of most recent latencies the percentile is
+	// computed on.
+	Window int `config:"window" validate:"min=10"`
+}
+
+func defaultHedgingConfig() hedgingConfig {
+	return hedgingConfig{
+		Enabled:    false,
+		Percentile: 95,
+		MinDelay:   100 * time.Millisecond,
+		Window:     100,
+	}
+}
+
+func (c *hedgingConfig) Validate() error {
+	if c.Percentile <= 0 || c.Percentile >= 100 {
+		return fmt.Errorf("hedging.percentile must be between 0 and 100, got %v", c.Percentile)
+	}
+	return nil
+}
+
+// hedger sends a bulk request to a second host when the first one does not
+// answer within the configured percentile of the recent latencies, and uses
+// the first successful response. Each client has its own hedger, with its
+// own connections to the other hosts.
+type hedger struct {
+	config hedgingConfig
+	peers  []*eslegclient.Connection
+	ids    generator.IDGenerator
+	log    *logp.Logger
+
+	mu        sync.Mutex
+	next      int
+	latencies []time.Duration
+	pos       int
+}
+
+type bulkResponse struct {
+	conn   *eslegclient.Connection
+	status int
+	result eslegclient.BulkResult
+	err    error
+}
+
+func newHedger(config hedgingConfig, peers []*eslegclient.Connection) (*hedger, error) {
+	ids, err := generator.Factory("elasticsearch")
+	if err != nil {
+		return nil, err
+	}
+	return &hedger{
+		config: config,
+		peers:  peers,
+		ids:    ids,
+		log:    logp.NewLogger(logSelector),
+	}, nil
+}
+
+// assignIDs sets an _id on all events not having one yet. The same document
+// being written by two hosts is then reported as a conflict instead of being
+// indexed twice.
+func (h *hedger) assignIDs(data []publisher.Event) {
+	for i := range data {
+		event := &data[i].Content
+		if id, _ := events.GetMetaStringValue(*event, events.FieldMetaID); id != "" {
+			continue
+		}
+		event.SetID(h.ids.NextID())
+	}
+}
+
+// bulk sends the bulk request to primary. If no response has been received
+// after the hedging delay, the request is sent to a peer as well, and the
+// first successful response is returned. All requests have completed when
+// bulk returns, so the connections can be reused right away.
+func (h *hedger) bulk(
+	ctx context.Context,
+	primary *eslegclient.Connection,
+	bulkItems []interface{},
+) bulkResponse {
+	begin := time.Now()
+	delay, ok := h.delay()
+	if !ok || len(h.peers) == 0 {
+		resp := sendBulk(ctx, primary, bulkItems)
+		if resp.err == nil {
+			h.observe(time.Since(begin))
+		}
+		return resp
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make(chan bulkResponse, 2)
+	go func() { responses <- sendBulk(ctx, primary, bulkItems) }()
+	pending := 1
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	var failed *bulkResponse
+	for {
+		select {
+		case <-timer.C:
+			peer := h.nextPeer()
+			h.log.Debugf("Bulk request to %s took longer than %v, sending it to %s as well",
+				primary.URL, delay, peer.URL)
+			go func() { responses <- sendBulk(ctx, peer, bulkItems) }()
+			pending++
+
+		case resp := <-responses:
+			pending--
+			if resp.err == nil {
+				h.observe(time.Since(begin))
+				cancel()
+				for ; pending > 0; pending-- {
+					<-responses
+				}
+				return resp
+			}
+
+			if failed == nil {
+				failed = &resp
+			}
+			if pending == 0 {
+				// Failures are not hedged, they are retried by the
+				// pipeline, possibly on another host.
+				return *failed
+			}
+		}
+	}
+}
+
+func sendBulk(ctx context.Context, conn *eslegclient.Connection, bulkItems []interface{}) bulkResponse {
+	status, result, err := conn.Bulk(ctx, "", "", nil, bulkItems)
+	return bulkResponse{conn: conn, status: status, result: result, err: err}
+}
+
+// delay returns the configured percentile of the recent latencies. It
+// returns false until enough requests have completed.
+func (h *hedger) delay() (time.Duration, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < minHedgingSamples {
+		return 0, false
+	}
+
+	sorted := make([]time.Duration, len(h.latencies))
+	copy(sorted, h.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(math.Ceil(h.config.Percentile/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	delay := sorted[idx]
+	if delay < h.config.MinDelay {
+		delay = h.config.MinDelay
+	}
+	return delay, true
+}
+
+func (h *hedger) observe(latency time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.latencies) < h.config.Window {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.pos] = latency
+	h.pos = (h.pos + 1) % h.config.Window
+}
+
+func (h *hedger) nextPeer() *eslegclient.Connection {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	peer := h.peers[h.next%len(h.peers)]
+	h.next++
+	return peer
+}
+
+func (h *hedger) Close() error {
+	for _, peer := range h.peers {
+		peer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,226 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package elasticsearch
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/beat/events"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+	"github.com/elastic/beats/v7/libbeat/outputs/outil"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+func TestHedgingConfig(t *testing.T) {
+	for _, percentile := range []float64{0, 100, 120} {
+		config := defaultConfig
+		err := common.MustNewConfigFrom(map[string]interface{}{
+			"hedging.enabled":    true,
+			"hedging.percentile": percentile,
+		}).Unpack(&config)
+		assert.Error(t, err, "percentile %v", percentile)
+	}
+
+	config := defaultConfig
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"hedging.enabled":    true,
+		"hedging.percentile": 99.5,
+	}).Unpack(&config)
+	require.NoError(t, err)
+	assert.True(t, config.Hedging.Enabled)
+	assert.Equal(t, 99.5, config.Hedging.Percentile)
+	assert.Equal(t, 100, config.Hedging.Window)
+}
+
+func TestHedgerDelay(t *testing.T) {
+	config := defaultHedgingConfig()
+	config.MinDelay = 5 * time.Millisecond
+	config.Window = 20
+	h, err := newHedger(config, nil)
+	require.NoError(t, err)
+
+	for i := 1; i < minHedgingSamples; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	_, ok := h.delay()
+	assert.False(t, ok, "delay must not be known before enough samples")
+
+	for i := minHedgingSamples; i <= 20; i++ {
+		h.observe(time.Duration(i) * time.Millisecond)
+	}
+	delay, ok := h.delay()
+	assert.True(t, ok)
+	assert.Equal(t, 19*time.Millisecond, delay)
+
+	// Older latencies are replaced once the window is full.
+	for i := 0; i < 20; i++ {
+		h.observe(time.Millisecond)
+	}
+	delay, _ = h.delay()
+	assert.Equal(t, config.MinDelay, delay)
+}
+
+func TestHedgerAssignIDs(t *testing.T) {
+	h, err := newHedger(defaultHedgingConfig(), nil)
+	require.NoError(t, err)
+
+	data := []publisher.Event{
+		{Content: beat.Event{Fields: common.MapStr{"message": "a"}}},
+		{Content: beat.Event{Fields: common.MapStr{"message": "b"}, Meta: common.MapStr{"_id": "existing"}}},
+	}
+	h.assignIDs(data)
+
+	id, err := events.GetMetaStringValue(data[0].Content, events.FieldMetaID)
+	require.NoError(t, err)
+	assert.NotEmpty(t, id)
+
+	id, err = events.GetMetaStringValue(data[1].Content, events.FieldMetaID)
+	require.NoError(t, err)
+	assert.Equal(t, "existing", id)
+}
+
+func newHedgingTestServer(t *testing.T, bulk http.HandlerFunc) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			fmt.Fprintln(w, `{ "version": { "number": "7.6.0" } }`)
+			return
+		}
+		bulk(w, r)
+	}))
+}
+
+func newHedgingTestClient(t *testing.T, primary, peer string) *Client {
+	config := defaultHedgingConfig()
+	config.MinDelay = 10 * time.Millisecond
+	hedge, err := makeHedger(config, []string{primary, peer}, 0, func(esURL string) eslegclient.ConnectionSettings {
+		return eslegclient.ConnectionSettings{URL: esURL}
+	})
+	require.NoError(t, err)
+
+	client, err := NewClient(ClientSettings{
+		ConnectionSettings: eslegclient.ConnectionSettings{URL: primary},
+		Index:              outil.MakeSelector(outil.ConstSelectorExpr("test", outil.SelectorLowerCase)),
+		hedger:             hedge,
+	}, nil)
+	require.NoError(t, err)
+	require.NoError(t, client.Connect())
+
+	for i := 0; i < minHedgingSamples; i++ {
+		hedge.observe(time.Millisecond)
+	}
+	return client
+}
+
+func TestClientHedgedBulk(t *testing.T) {
+	primaryCancelled := make(chan struct{})
+	primary := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		// The request context is only cancelled once the body has been read.
+		ioutil.ReadAll(r.Body)
+		select {
+		case <-r.Context().Done():
+			close(primaryCancelled)
+		case <-time.After(5 * time.Second):
+			fmt.Fprintln(w, `{"items":[{"create":{"status":201}}]}`)
+		}
+	})
+	defer primary.Close()
+
+	var peerBody string
+	peer := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		peerBody = string(body)
+		fmt.Fprintln(w, `{"items":[{"create":{"status":201}}]}`)
+	})
+	defer peer.Close()
+
+	client := newHedgingTestClient(t, primary.URL, peer.URL)
+	defer client.Close()
+
+	batch := outest.NewBatch(beat.Event{Fields: common.MapStr{"message": "hello"}})
+	require.NoError(t, client.Publish(context.Background(), batch))
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+	assert.Contains(t, peerBody, `"create":{"_index":"test","_id":"`)
+
+	select {
+	case <-primaryCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("primary request has not been cancelled")
+	}
+}
+
+func TestClientHedgingFastPrimary(t *testing.T) {
+	primary := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintln(w, `{"items":[{"create":{"status":201}}]}`)
+	})
+	defer primary.Close()
+
+	var peerRequests int32
+	peer := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&peerRequests, 1)
+		fmt.Fprintln(w, `{"items":[{"create":{"status":201}}]}`)
+	})
+	defer peer.Close()
+
+	client := newHedgingTestClient(t, primary.URL, peer.URL)
+	defer client.Close()
+
+	batch := outest.NewBatch(beat.Event{Fields: common.MapStr{"message": "hello"}})
+	require.NoError(t, client.Publish(context.Background(), batch))
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&peerRequests))
+}
+
+func TestClientHedgingFailedPrimary(t *testing.T) {
+	primary := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+	defer primary.Close()
+
+	var peerRequests int32
+	peer := newHedgingTestServer(t, func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&peerRequests, 1)
+		fmt.Fprintln(w, `{"items":[{"create":{"status":201}}]}`)
+	})
+	defer peer.Close()
+
+	client := newHedgingTestClient(t, primary.URL, peer.URL)
+	defer client.Close()
+
+	// Failures are retried by the pipeline, not hedged.
+	batch := outest.NewBatch(beat.Event{Fields: common.MapStr{"message": "hello"}})
+	assert.Error(t, client.Publish(context.Background(), batch))
+	assert.Equal(t, outest.BatchRetryEvents, batch.Signals[0].Tag)
+	assert.Equal(t, int32(0), atomic.LoadInt32(&peerRequests))
+}
@@ -0,0 +1,270 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package elasticsearch
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/esleg/eslegclient"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/paths"
+)
+
+const redactedValue = "REDACTED"
+
+// recorderConfig configures the capture of failed bulk requests to disk.
+type recorderConfig struct {
+	Enabled      bool             `config:"enabled"`
+	Path         string           `config:"path"`
+	MaxEntries   int              `config:"max_entries" validate:"min=1"`
+	MaxBytes     cfgtype.ByteSize `config:"max_bytes" validate:"min=1"`
+	RedactFields []string         `config:"redact_fields"`
+}
+
+func defaultRecorderConfig() recorderConfig {
+	return recorderConfig{
+		Enabled:    false,
+		Path:       "elasticsearch/bulk_failures.ndjson",
+		MaxEntries: 10,
+		MaxBytes:   64 * 1024,
+	}
+}
+
+// BulkFailure is a failed bulk request captured by the failure recorder.
+type BulkFailure struct {
+	Timestamp time.Time `json:"@timestamp"`
+	URL       string    `json:"url"`
+	Status    int       `json:"status,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	Events    int       `json:"events"`
+	Failed    int       `json:"failed"`
+	Request   string    `json:"request"`
+	Response  string    `json:"response,omitempty"`
+	Truncated bool      `json:"truncated,omitempty"`
+}
+
+// failureRecorder keeps the last failed bulk requests in a file, rewritten
+// on each failure so it can be read after the Beat has stopped.
+type failureRecorder struct {
+	config recorderConfig
+	path   string
+	log    *logp.Logger
+
+	mu      sync.Mutex
+	entries []BulkFailure
+}
+
+// BulkFailuresPath returns the path of the file with the failed bulk requests
+// recorded by an Elasticsearch output with the given config.
+func BulkFailuresPath(cfg *common.Config) (string, error) {
+	config, err := readRecorderConfig(cfg)
+	if err != nil {
+		return "", err
+	}
+	return paths.Resolve(paths.Data, config.Path), nil
+}
+
+// ReadBulkFailures reads the failed bulk requests recorded in a file, from
+// the oldest to the most recent one.
+func ReadBulkFailures(path string) ([]BulkFailure, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []BulkFailure
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, 16*1024*1024)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry BulkFailure
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			return entries, fmt.Errorf("invalid entry in %s: %w", path, err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+func readRecorderConfig(cfg *common.Config) (recorderConfig, error) {
+	config := defaultRecorderConfig()
+	if cfg == nil || !cfg.HasField("failure_recorder") {
+		return config, nil
+	}
+	sub, err := cfg.Child("failure_recorder", -1)
+	if err != nil {
+		return config, err
+	}
+	if err := sub.Unpack(&config); err != nil {
+		return config, fmt.Errorf("invalid failure_recorder config: %w", err)
+	}
+	return config, nil
+}
+
+// newFailureRecorder creates a recorder if enabled in the output config, it
+// returns nil otherwise. Failures recorded by previous runs are kept.
+func newFailureRecorder(cfg *common.Config) (*failureRecorder, error) {
+	config, err := readRecorderConfig(cfg)
+	if err != nil || !config.Enabled {
+		return nil, err
+	}
+
+	r := &failureRecorder{
+		config: config,
+		path:   paths.Resolve(paths.Data, config.Path),
+		log:    logp.NewLogger(logSelector),
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0750); err != nil {
+		return nil, fmt.Errorf("failed to create directory for bulk failures: %w", err)
+	}
+
+	entries, err := ReadBulkFailures(r.path)
+	if err != nil && !os.IsNotExist(err) {
+		r.log.Warnf("Ignoring bulk failures recorded in %s: %v", r.path, err)
+		entries = nil
+	}
+	if len(entries) > config.MaxEntries {
+		entries = entries[len(entries)-config.MaxEntries:]
+	}
+	r.entries = entries
+	return r, nil
+}
+
+// Record captures a failed bulk request with its response, or the error if no
+// response was received. The Authorization header, the credentials and the
+// parameters of the URL are never recorded.
+func (r *failureRecorder) Record(
+	rawURL string,
+	bulkItems []interface{},
+	status int,
+	response []byte,
+	sendErr error,
+	events, failed int,
+) {
+	if r == nil {
+		return
+	}
+
+	entry := BulkFailure{
+		Timestamp: time.Now().UTC(),
+		URL:       redactURL(rawURL),
+		Status:    status,
+		Events:    events,
+		Failed:    failed,
+	}
+	if sendErr != nil {
+		entry.Error = sendErr.Error()
+	}
+
+	var truncated bool
+	entry.Request, truncated = r.encodeRequest(bulkItems)
+	entry.Truncated = truncated
+	if len(response) > int(r.config.MaxBytes) {
+		response = response[:r.config.MaxBytes]
+		entry.Truncated = true
+	}
+	entry.Response = string(response)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.entries = append(r.entries, entry)
+	if len(r.entries) > r.config.MaxEntries {
+		r.entries = r.entries[len(r.entries)-r.config.MaxEntries:]
+	}
+	if err := r.write(); err != nil {
+		r.log.Errorf("Failed to record bulk failure in %s: %v", r.path, err)
+	}
+}
+
+// encodeRequest encodes the bulk items as the body of the bulk request, with
+// the redacted fields replaced. It stops once the size limit is exceeded.
+func (r *failureRecorder) encodeRequest(bulkItems []interface{}) (string, bool) {
+	var buf bytes.Buffer
+	enc := eslegclient.NewJSONEncoder(&buf, false)
+	limit := int(r.config.MaxBytes)
+
+	for _, item := range bulkItems {
+		if event, ok := item.(*beat.Event); ok {
+			item = r.redact(event)
+		}
+		if err := enc.AddRaw(item); err != nil {
+			r.log.Debugf("Failed to encode bulk item of failed request: %v", err)
+		}
+		if buf.Len() > limit {
+			return string(buf.Bytes()[:limit]), true
+		}
+	}
+	return buf.String(), false
+}
+
+func (r *failureRecorder) redact(event *beat.Event) beat.Event {
+	fields := event.Fields
+	if len(r.config.RedactFields) > 0 {
+		fields = fields.Clone()
+		for _, key := range r.config.RedactFields {
+			if found, _ := fields.HasKey(key); found {
+				fields.Put(key, redactedValue)
+			}
+		}
+	}
+	return beat.Event{Timestamp: event.Timestamp, Fields: fields}
+}
+
+// write replaces the file with the current entries. The file is renamed into
+// place so readers never see a partial write.
+func (r *failureRecorder) write() error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, entry := range r.entries {
+		if err := enc.Encode(entry); err != nil {
+			return err
+		}
+	}
+
+	tmp := r.path + ".new"
+	if err := ioutil.WriteFile(tmp, buf.Bytes(), 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.path)
+}
+
+func redactURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	u.User = nil
+	u.RawQuery = ""
+	return u.String()
+}
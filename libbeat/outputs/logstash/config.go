@@ -27,22 +27,25 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/common/transport"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 )
 
 type Config struct {
-	Index            string                `config:"index"`
-	LoadBalance      bool                  `config:"loadbalance"`
-	BulkMaxSize      int                   `config:"bulk_max_size"`
-	SlowStart        bool                  `config:"slow_start"`
-	Timeout          time.Duration         `config:"timeout"`
-	TTL              time.Duration         `config:"ttl"               validate:"min=0"`
-	Pipelining       int                   `config:"pipelining"        validate:"min=0"`
-	CompressionLevel int                   `config:"compression_level" validate:"min=0, max=9"`
-	MaxRetries       int                   `config:"max_retries"       validate:"min=-1"`
-	TLS              *tlscommon.Config     `config:"ssl"`
-	Proxy            transport.ProxyConfig `config:",inline"`
-	Backoff          Backoff               `config:"backoff"`
-	EscapeHTML       bool                  `config:"escape_html"`
+	Index            string                       `config:"index"`
+	LoadBalance      bool                         `config:"loadbalance"`
+	BulkMaxSize      int                          `config:"bulk_max_size"`
+	SlowStart        bool                         `config:"slow_start"`
+	Timeout          time.Duration                `config:"timeout"`
+	TTL              time.Duration                `config:"ttl"               validate:"min=0"`
+	Pipelining       int                          `config:"pipelining"        validate:"min=0"`
+	CompressionLevel int                          `config:"compression_level" validate:"min=0, max=9"`
+	MaxRetries       int                          `config:"max_retries"       validate:"min=-1"`
+	TLS              *tlscommon.Config            `config:"ssl"`
+	Proxy            transport.ProxyConfig        `config:",inline"`
+	Backoff          Backoff                      `config:"backoff"`
+	CircuitBreaker   outputs.CircuitBreakerConfig `config:"circuit_breaker"`
+	EscapeHTML       bool                         `config:"escape_html"`
+	DualStack        transport.DualStackConfig    `config:"dual_stack"`
 }
 
 type Backoff struct {
@@ -64,7 +67,9 @@ func defaultConfig() Config {
 			Init: 1 * time.Second,
 			Max:  60 * time.Second,
 		},
-		EscapeHTML: false,
+		CircuitBreaker: outputs.DefaultCircuitBreakerConfig(),
+		EscapeHTML:     false,
+		DualStack:      transport.DefaultDualStackConfig(),
 	}
 }
 
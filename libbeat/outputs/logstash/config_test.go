@@ -23,6 +23,8 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/outputs"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -50,8 +52,10 @@ func TestConfig(t *testing.T) {
 					Init: 1 * time.Second,
 					Max:  60 * time.Second,
 				},
-				EscapeHTML: false,
-				Index:      "bar",
+				CircuitBreaker: outputs.DefaultCircuitBreakerConfig(),
+				EscapeHTML:     false,
+				Index:          "bar",
+				DualStack:      transport.DefaultDualStackConfig(),
 			},
 		},
 		"config given": {
@@ -74,8 +78,10 @@ func TestConfig(t *testing.T) {
 					Init: 1 * time.Second,
 					Max:  60 * time.Second,
 				},
-				EscapeHTML: false,
-				Index:      "beat-index",
+				CircuitBreaker: outputs.DefaultCircuitBreakerConfig(),
+				EscapeHTML:     false,
+				Index:          "beat-index",
+				DualStack:      transport.DefaultDualStackConfig(),
 			},
 		},
 		"removed config setting": {
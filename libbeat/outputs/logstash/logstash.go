@@ -57,10 +57,11 @@ func makeLogstash(
 	}
 
 	transp := transport.Config{
-		Timeout: config.Timeout,
-		Proxy:   &config.Proxy,
-		TLS:     tls,
-		Stats:   observer,
+		Timeout:   config.Timeout,
+		Proxy:     &config.Proxy,
+		TLS:       tls,
+		Stats:     observer,
+		DualStack: &config.DualStack,
 	}
 
 	clients := make([]outputs.NetworkClient, len(hosts))
@@ -82,6 +83,7 @@ func makeLogstash(
 		}
 
 		client = outputs.WithBackoff(client, config.Backoff.Init, config.Backoff.Max)
+		client = outputs.WithCircuitBreaker(client, config.CircuitBreaker)
 		clients[i] = client
 	}
 
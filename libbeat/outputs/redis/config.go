@@ -27,19 +27,20 @@ import (
 )
 
 type redisConfig struct {
-	Password    string                `config:"password"`
-	Index       string                `config:"index"`
-	Key         string                `config:"key"`
-	LoadBalance bool                  `config:"loadbalance"`
-	Timeout     time.Duration         `config:"timeout"`
-	BulkMaxSize int                   `config:"bulk_max_size"`
-	MaxRetries  int                   `config:"max_retries"`
-	TLS         *tlscommon.Config     `config:"ssl"`
-	Proxy       transport.ProxyConfig `config:",inline"`
-	Codec       codec.Config          `config:"codec"`
-	Db          int                   `config:"db"`
-	DataType    string                `config:"datatype"`
-	Backoff     backoff               `config:"backoff"`
+	Password    string                    `config:"password"`
+	Index       string                    `config:"index"`
+	Key         string                    `config:"key"`
+	LoadBalance bool                      `config:"loadbalance"`
+	Timeout     time.Duration             `config:"timeout"`
+	BulkMaxSize int                       `config:"bulk_max_size"`
+	MaxRetries  int                       `config:"max_retries"`
+	TLS         *tlscommon.Config         `config:"ssl"`
+	Proxy       transport.ProxyConfig     `config:",inline"`
+	Codec       codec.Config              `config:"codec"`
+	Db          int                       `config:"db"`
+	DataType    string                    `config:"datatype"`
+	Backoff     backoff                   `config:"backoff"`
+	DualStack   transport.DualStackConfig `config:"dual_stack"`
 }
 
 type backoff struct {
@@ -60,6 +61,7 @@ var (
 			Init: 1 * time.Second,
 			Max:  60 * time.Second,
 		},
+		DualStack: transport.DefaultDualStackConfig(),
 	}
 )
 
@@ -129,10 +129,11 @@ func makeRedis(
 		}
 
 		transp := transport.Config{
-			Timeout: config.Timeout,
-			Proxy:   &config.Proxy,
-			TLS:     tls,
-			Stats:   observer,
+			Timeout:   config.Timeout,
+			Proxy:     &config.Proxy,
+			TLS:       tls,
+			Stats:     observer,
+			DualStack: &config.DualStack,
 		}
 
 		switch hostUrl.Scheme {
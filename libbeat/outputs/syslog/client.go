@@ -0,0 +1,104 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+)
+
+type client struct {
+	log *logp.Logger
+	*transport.Client
+	observer  outputs.Observer
+	timeout   time.Duration
+	formatter *formatter
+}
+
+func newClient(
+	tc *transport.Client,
+	observer outputs.Observer,
+	timeout time.Duration,
+	formatter *formatter,
+) *client {
+	return &client{
+		log:       logp.NewLogger("syslog"),
+		Client:    tc,
+		observer:  observer,
+		timeout:   timeout,
+		formatter: formatter,
+	}
+}
+
+func (c *client) Connect() error {
+	c.log.Debug("connect")
+	return c.Client.Connect()
+}
+
+func (c *client) Close() error {
+	c.log.Debug("close connection")
+	return c.Client.Close()
+}
+
+// Publish writes the messages of all the events in the batch. If writing
+// fails, the events whose messages were completely written are acknowledged
+// and the rest are retried.
+func (c *client) Publish(_ context.Context, batch publisher.Batch) error {
+	events := batch.Events()
+	c.observer.NewBatch(len(events))
+
+	var buf bytes.Buffer
+	ends := make([]int, len(events))
+	for i := range events {
+		buf.Write(c.formatter.Format(&events[i].Content))
+		ends[i] = buf.Len()
+	}
+
+	if err := c.Client.SetWriteDeadline(time.Now().Add(c.timeout)); err != nil {
+		c.observer.Failed(len(events))
+		batch.RetryEvents(events)
+		return err
+	}
+
+	n, err := c.Client.Write(buf.Bytes())
+	if err != nil {
+		sent := 0
+		for sent < len(ends) && ends[sent] <= n {
+			sent++
+		}
+		c.log.Errorf("Failed to write syslog messages, %d of %d events sent: %+v", sent, len(events), err)
+		c.observer.Acked(sent)
+		c.observer.Failed(len(events) - sent)
+		batch.RetryEvents(events[sent:])
+		return err
+	}
+
+	c.observer.Acked(len(events))
+	batch.ACK()
+	return nil
+}
+
+func (c *client) String() string {
+	return "syslog(" + c.Client.String() + ")"
+}
@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/outputs/outest"
+)
+
+func TestClientPublish(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan []string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var lines []string
+		scanner := bufio.NewScanner(conn)
+		for len(lines) < 2 && scanner.Scan() {
+			lines = append(lines, scanner.Text())
+		}
+		received <- lines
+	}()
+
+	conn, err := transport.NewClient(transport.Config{Timeout: time.Second}, "tcp", listener.Addr().String(), defaultPort)
+	require.NoError(t, err)
+
+	c := newClient(conn, outputs.NewNilObserver(), time.Second,
+		testFormatter(t, map[string]interface{}{"framing": "non_transparent"}))
+	require.NoError(t, c.Connect())
+	defer c.Close()
+
+	batch := outest.NewBatch(
+		beat.Event{Timestamp: testTimestamp, Fields: common.MapStr{"message": "first"}},
+		beat.Event{Timestamp: testTimestamp, Fields: common.MapStr{"message": "second"}},
+	)
+	require.NoError(t, c.Publish(context.Background(), batch))
+
+	require.Len(t, batch.Signals, 1)
+	assert.Equal(t, outest.BatchACK, batch.Signals[0].Tag)
+
+	select {
+	case lines := <-received:
+		assert.Equal(t, []string{
+			"<14>1 2020-06-01T10:00:00.123456Z - filebeat - - - first",
+			"<14>1 2020-06-01T10:00:00.123456Z - filebeat - - - second",
+		}, lines)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for messages")
+	}
+}
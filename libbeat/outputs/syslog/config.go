@@ -0,0 +1,171 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+	"github.com/elastic/beats/v7/libbeat/common/transport"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+const (
+	formatRFC5424 = "rfc5424"
+	formatCEF     = "cef"
+
+	framingOctetCounting  = "octet_counting"
+	framingNonTransparent = "non_transparent"
+)
+
+type syslogConfig struct {
+	LoadBalance bool                  `config:"loadbalance"`
+	Timeout     time.Duration         `config:"timeout"`
+	BulkMaxSize int                   `config:"bulk_max_size"`
+	MaxRetries  int                   `config:"max_retries"`
+	TLS         *tlscommon.Config     `config:"ssl"`
+	Proxy       transport.ProxyConfig `config:",inline"`
+	Backoff     backoff               `config:"backoff"`
+
+	Format         string                    `config:"format"`
+	Framing        string                    `config:"framing"`
+	Facility       string                    `config:"facility"`
+	Severity       *fmtstr.EventFormatString `config:"severity"`
+	Hostname       *fmtstr.EventFormatString `config:"hostname"`
+	AppName        *fmtstr.EventFormatString `config:"app_name"`
+	ProcID         *fmtstr.EventFormatString `config:"proc_id"`
+	MsgID          *fmtstr.EventFormatString `config:"msg_id"`
+	Message        *fmtstr.EventFormatString `config:"message"`
+	StructuredData []sdElementConfig         `config:"structured_data"`
+	CEF            cefConfig                 `config:"cef"`
+}
+
+// sdElementConfig is an element of the structured data of RFC5424 messages.
+type sdElementConfig struct {
+	ID     string                               `config:"id" validate:"required"`
+	Params map[string]*fmtstr.EventFormatString `config:"params"`
+}
+
+// cefConfig configures the header and extensions of CEF messages.
+type cefConfig struct {
+	DeviceVendor  string                               `config:"device_vendor"`
+	DeviceProduct string                               `config:"device_product"`
+	DeviceVersion string                               `config:"device_version"`
+	SignatureID   *fmtstr.EventFormatString            `config:"signature_id"`
+	Name          *fmtstr.EventFormatString            `config:"name"`
+	Severity      *fmtstr.EventFormatString            `config:"severity"`
+	Extensions    map[string]*fmtstr.EventFormatString `config:"extensions"`
+}
+
+type backoff struct {
+	Init time.Duration
+	Max  time.Duration
+}
+
+func defaultConfig() syslogConfig {
+	return syslogConfig{
+		LoadBalance: true,
+		Timeout:     5 * time.Second,
+		BulkMaxSize: 2048,
+		MaxRetries:  3,
+		Backoff: backoff{
+			Init: 1 * time.Second,
+			Max:  60 * time.Second,
+		},
+		Format:   formatRFC5424,
+		Framing:  framingOctetCounting,
+		Facility: "user",
+		Severity: fmtstr.MustCompileEvent("%{[log.syslog.severity.code]}"),
+		Hostname: fmtstr.MustCompileEvent("%{[host.name]}"),
+		ProcID:   fmtstr.MustCompileEvent("%{[process.pid]}"),
+		Message:  fmtstr.MustCompileEvent("%{[message]}"),
+		CEF: cefConfig{
+			DeviceVendor: "Elastic",
+			SignatureID:  fmtstr.MustCompileEvent("%{[event.code]}"),
+			Name:         fmtstr.MustCompileEvent("%{[event.action]}"),
+			Severity:     fmtstr.MustCompileEvent("%{[event.severity]}"),
+		},
+	}
+}
+
+func (c *syslogConfig) Validate() error {
+	switch c.Format {
+	case formatRFC5424, formatCEF:
+	default:
+		return fmt.Errorf("syslog format %v not supported, it must be '%v' or '%v'", c.Format, formatRFC5424, formatCEF)
+	}
+
+	switch c.Framing {
+	case framingOctetCounting, framingNonTransparent:
+	default:
+		return fmt.Errorf("syslog framing %v not supported, it must be '%v' or '%v'", c.Framing, framingOctetCounting, framingNonTransparent)
+	}
+
+	if _, err := parseFacility(c.Facility); err != nil {
+		return err
+	}
+
+	for _, element := range c.StructuredData {
+		if !validSDName(element.ID) {
+			return fmt.Errorf("invalid structured data id '%v'", element.ID)
+		}
+		for name := range element.Params {
+			if !validSDName(name) {
+				return fmt.Errorf("invalid parameter name '%v' in structured data element '%v'", name, element.ID)
+			}
+		}
+	}
+
+	for key := range c.CEF.Extensions {
+		if !validCEFKey(key) {
+			return fmt.Errorf("invalid CEF extension key '%v', it must only contain letters and digits", key)
+		}
+	}
+
+	return nil
+}
+
+// validSDName checks structured data ids and parameter names, that must be
+// printable ASCII characters other than '=', ' ', ']' and '"', and at most 32
+// characters long.
+func validSDName(name string) bool {
+	if name == "" || len(name) > 32 {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if c < 33 || c > 126 || strings.IndexByte(`= ]"`, c) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func validCEFKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,284 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+)
+
+const (
+	// nilValue is written for the header fields without value.
+	nilValue = "-"
+
+	timestampFormat = "2006-01-02T15:04:05.000000Z07:00"
+
+	// Maximum lengths of the header fields.
+	maxHostname = 255
+	maxAppName  = 48
+	maxProcID   = 128
+	maxMsgID    = 32
+
+	defaultSeverity = 6 // informational
+)
+
+var facilities = map[string]int{
+	"kern":     0,
+	"user":     1,
+	"mail":     2,
+	"daemon":   3,
+	"auth":     4,
+	"syslog":   5,
+	"lpr":      6,
+	"news":     7,
+	"uucp":     8,
+	"cron":     9,
+	"authpriv": 10,
+	"ftp":      11,
+	"ntp":      12,
+	"security": 13,
+	"console":  14,
+	"local0":   16,
+	"local1":   17,
+	"local2":   18,
+	"local3":   19,
+	"local4":   20,
+	"local5":   21,
+	"local6":   22,
+	"local7":   23,
+}
+
+var severities = map[string]int{
+	"emergency":     0,
+	"emerg":         0,
+	"alert":         1,
+	"critical":      2,
+	"crit":          2,
+	"error":         3,
+	"err":           3,
+	"warning":       4,
+	"warn":          4,
+	"notice":        5,
+	"informational": 6,
+	"info":          6,
+	"debug":         7,
+}
+
+// parseFacility parses a facility given by name or by code.
+func parseFacility(s string) (int, error) {
+	if code, found := facilities[strings.ToLower(s)]; found {
+		return code, nil
+	}
+	if code, err := strconv.Atoi(s); err == nil && code >= 0 && code <= 23 {
+		return code, nil
+	}
+	return 0, fmt.Errorf("invalid syslog facility '%v'", s)
+}
+
+// parseSeverity parses a severity given by name or by code.
+func parseSeverity(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if code, found := severities[strings.ToLower(s)]; found {
+		return code, true
+	}
+	if code, err := strconv.Atoi(s); err == nil && code >= 0 && code <= 7 {
+		return code, true
+	}
+	return 0, false
+}
+
+// formatter renders events into framed syslog messages.
+type formatter struct {
+	config   syslogConfig
+	facility int
+}
+
+func newFormatter(config syslogConfig) (*formatter, error) {
+	facility, err := parseFacility(config.Facility)
+	if err != nil {
+		return nil, err
+	}
+	return &formatter{config: config, facility: facility}, nil
+}
+
+// Format renders an event into an RFC5424 message, whose content is a CEF
+// message with the cef format, and frames it.
+func (f *formatter) Format(event *beat.Event) []byte {
+	var b bytes.Buffer
+
+	severity, ok := parseSeverity(render(f.config.Severity, event))
+	if !ok {
+		severity = defaultSeverity
+	}
+	fmt.Fprintf(&b, "<%d>1 ", f.facility*8+severity)
+
+	if event.Timestamp.IsZero() {
+		b.WriteString(nilValue)
+	} else {
+		b.WriteString(event.Timestamp.UTC().Format(timestampFormat))
+	}
+	b.WriteByte(' ')
+	writeHeaderField(&b, render(f.config.Hostname, event), maxHostname)
+	b.WriteByte(' ')
+	writeHeaderField(&b, render(f.config.AppName, event), maxAppName)
+	b.WriteByte(' ')
+	writeHeaderField(&b, render(f.config.ProcID, event), maxProcID)
+	b.WriteByte(' ')
+	writeHeaderField(&b, render(f.config.MsgID, event), maxMsgID)
+	b.WriteByte(' ')
+	f.writeStructuredData(&b, event)
+
+	var msg string
+	if f.config.Format == formatCEF {
+		msg = f.cefMessage(event)
+	} else {
+		msg = render(f.config.Message, event)
+	}
+	if msg != "" {
+		b.WriteByte(' ')
+		b.WriteString(msg)
+	}
+
+	return f.frame(b.Bytes())
+}
+
+func (f *formatter) frame(msg []byte) []byte {
+	if f.config.Framing == framingNonTransparent {
+		// The trailer cannot be part of the message with this framing
+		msg = bytes.ReplaceAll(msg, []byte{'\n'}, []byte{' '})
+		return append(msg, '\n')
+	}
+	return append([]byte(strconv.Itoa(len(msg))+" "), msg...)
+}
+
+// writeHeaderField writes a header field, replacing the characters that are
+// not printable ASCII, or the nil value if it is empty.
+func writeHeaderField(b *bytes.Buffer, value string, max int) {
+	if value == "" {
+		b.WriteString(nilValue)
+		return
+	}
+	if len(value) > max {
+		value = value[:max]
+	}
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c < 33 || c > 126 {
+			c = '_'
+		}
+		b.WriteByte(c)
+	}
+}
+
+// writeStructuredData writes the configured structured data elements, their
+// parameters are omitted when they are empty, and the elements without
+// parameters are omitted too.
+func (f *formatter) writeStructuredData(b *bytes.Buffer, event *beat.Event) {
+	written := false
+	for _, element := range f.config.StructuredData {
+		names := make([]string, 0, len(element.Params))
+		for name := range element.Params {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var params bytes.Buffer
+		for _, name := range names {
+			value := render(element.Params[name], event)
+			if value == "" {
+				continue
+			}
+			fmt.Fprintf(&params, " %s=\"%s\"", name, sdEscaper.Replace(value))
+		}
+		if params.Len() == 0 {
+			continue
+		}
+
+		b.WriteByte('[')
+		b.WriteString(element.ID)
+		b.Write(params.Bytes())
+		b.WriteByte(']')
+		written = true
+	}
+	if !written {
+		b.WriteString(nilValue)
+	}
+}
+
+var (
+	sdEscaper        = strings.NewReplacer(`"`, `\"`, `\`, `\\`, `]`, `\]`)
+	cefHeaderEscaper = strings.NewReplacer(`\`, `\\`, `|`, `\|`, "\r", " ", "\n", " ")
+	cefValueEscaper  = strings.NewReplacer(`\`, `\\`, `=`, `\=`, "\r", `\r`, "\n", `\n`)
+)
+
+// cefMessage renders an event as a CEF message, the extensions are sorted by
+// key and the empty ones are omitted.
+func (f *formatter) cefMessage(event *beat.Event) string {
+	config := f.config.CEF
+
+	severity := render(config.Severity, event)
+	if severity == "" {
+		severity = "Unknown"
+	}
+
+	header := []string{
+		"CEF:0",
+		cefHeaderEscaper.Replace(config.DeviceVendor),
+		cefHeaderEscaper.Replace(config.DeviceProduct),
+		cefHeaderEscaper.Replace(config.DeviceVersion),
+		cefHeaderEscaper.Replace(render(config.SignatureID, event)),
+		cefHeaderEscaper.Replace(render(config.Name, event)),
+		cefHeaderEscaper.Replace(severity),
+	}
+
+	keys := make([]string, 0, len(config.Extensions))
+	for key := range config.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	extensions := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := render(config.Extensions[key], event)
+		if value == "" {
+			continue
+		}
+		extensions = append(extensions, key+"="+cefValueEscaper.Replace(value))
+	}
+
+	return strings.Join(header, "|") + "|" + strings.Join(extensions, " ")
+}
+
+// render evaluates a format string, fields that are not found render as an
+// empty string.
+func render(fs *fmtstr.EventFormatString, event *beat.Event) string {
+	if fs == nil {
+		return ""
+	}
+	s, err := fs.Run(event)
+	if err != nil {
+		return ""
+	}
+	return s
+}
@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package syslog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/fmtstr"
+)
+
+var testTimestamp = time.Date(2020, 6, 1, 10, 0, 0, 123456000, time.UTC)
+
+func testFormatter(t *testing.T, settings map[string]interface{}) *formatter {
+	config := defaultConfig()
+	config.AppName = fmtstr.MustCompileEvent("filebeat")
+	config.CEF.DeviceProduct = "filebeat"
+	config.CEF.DeviceVersion = "7.9.0"
+	require.NoError(t, common.MustNewConfigFrom(settings).Unpack(&config))
+
+	f, err := newFormatter(config)
+	require.NoError(t, err)
+	return f
+}
+
+func TestFormat(t *testing.T) {
+	tests := map[string]struct {
+		settings map[string]interface{}
+		fields   common.MapStr
+		expected string
+	}{
+		"defaults": {
+			fields: common.MapStr{
+				"message": "hello world",
+				"host":    common.MapStr{"name": "web-01"},
+			},
+			expected: "67 <14>1 2020-06-01T10:00:00.123456Z web-01 filebeat - - - hello world",
+		},
+		"severity, facility and header fields": {
+			settings: map[string]interface{}{
+				"facility": "local4",
+				"severity": "%{[log.level]}",
+				"msg_id":   "%{[event.action]}",
+			},
+			fields: common.MapStr{
+				"message": "denied",
+				"log":     common.MapStr{"level": "warning"},
+				"host":    common.MapStr{"name": "web 01"},
+				"process": common.MapStr{"pid": 42},
+				"event":   common.MapStr{"action": "deny"},
+			},
+			expected: "67 <164>1 2020-06-01T10:00:00.123456Z web_01 filebeat 42 deny - denied",
+		},
+		"structured data": {
+			settings: map[string]interface{}{
+				"structured_data": []map[string]interface{}{
+					{
+						"id": "origin@32473",
+						"params": map[string]interface{}{
+							"ip":   "%{[source.ip]}",
+							"user": "%{[user.name]}",
+						},
+					},
+					{
+						"id":     "empty@32473",
+						"params": map[string]interface{}{"missing": "%{[missing]}"},
+					},
+				},
+			},
+			fields: common.MapStr{
+				"message": "login",
+				"source":  common.MapStr{"ip": "10.0.0.1"},
+				"user":    common.MapStr{"name": `j"o]h\n`},
+			},
+			expected: `101 <14>1 2020-06-01T10:00:00.123456Z - filebeat - - [origin@32473 ip="10.0.0.1" user="j\"o\]h\\n"] login`,
+		},
+		"cef": {
+			settings: map[string]interface{}{
+				"format": "cef",
+				"cef.extensions": map[string]interface{}{
+					"src": "%{[source.ip]}",
+					"msg": "%{[message]}",
+					"act": "%{[missing]}",
+				},
+			},
+			fields: common.MapStr{
+				"message": "a=b\nc",
+				"source":  common.MapStr{"ip": "10.0.0.1"},
+				"event":   common.MapStr{"code": "4625", "action": "logon|failed", "severity": 7},
+			},
+			expected: `125 <14>1 2020-06-01T10:00:00.123456Z - filebeat - - - CEF:0|Elastic|filebeat|7.9.0|4625|logon\|failed|7|msg=a\=b\nc src=10.0.0.1`,
+		},
+		"non transparent framing": {
+			settings: map[string]interface{}{
+				"framing": "non_transparent",
+			},
+			fields: common.MapStr{
+				"message": "multi\nline",
+			},
+			expected: "<14>1 2020-06-01T10:00:00.123456Z - filebeat - - - multi line\n",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := testFormatter(t, test.settings)
+			msg := f.Format(&beat.Event{Timestamp: testTimestamp, Fields: test.fields})
+			assert.Equal(t, test.expected, string(msg))
+		})
+	}
+}
+
+func TestConfigValidation(t *testing.T) {
+	tests := map[string]map[string]interface{}{
+		"format":        {"format": "rfc3164"},
+		"framing":       {"framing": "udp"},
+		"facility":      {"facility": "local9"},
+		"facility code": {"facility": 24},
+		"sd id":         {"structured_data": []map[string]interface{}{{"id": "bad id", "params": map[string]interface{}{"a": "b"}}}},
+		"sd param":      {"structured_data": []map[string]interface{}{{"id": "id", "params": map[string]interface{}{"a=": "b"}}}},
+		"cef extension": {"cef.extensions": map[string]interface{}{"bad-key": "b"}},
+	}
+
+	for name, settings := range tests {
+		config := defaultConfig()
+		err := common.MustNewConfigFrom(settings).Unpack(&config)
+		assert.Error(t, err, name)
+	}
+
+	config := defaultConfig()
+	assert.NoError(t, common.MustNewConfigFrom(map[string]interface{}{"facility": 16}).Unpack(&config))
+}
@@ -0,0 +1,219 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package preflight
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	sigar "github.com/elastic/gosigar"
+
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/testing"
+)
+
+// PathCheck checks that the directory at the path exists and that the Beat
+// can write files in it.
+func PathCheck(name, path string) Check {
+	return Check{
+		Name: name + " is writable",
+		Run: func(_ context.Context) error {
+			info, err := os.Stat(path)
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() {
+				return fmt.Errorf("%s is not a directory", path)
+			}
+
+			f, err := ioutil.TempFile(path, ".preflight-")
+			if err != nil {
+				return err
+			}
+			f.Close()
+			return os.Remove(f.Name())
+		},
+	}
+}
+
+// PortCheck checks that the Beat can listen on the TCP address.
+func PortCheck(name, address string) Check {
+	return Check{
+		Name: name + " can listen on " + address,
+		Run: func(_ context.Context) error {
+			l, err := net.Listen("tcp", address)
+			if err != nil {
+				return err
+			}
+			return l.Close()
+		},
+	}
+}
+
+// DiskSpaceCheck checks that the file system of the path has at least the
+// given free space available.
+func DiskSpaceCheck(name, path string, minFree uint64) Check {
+	return Check{
+		Name: name + " has enough free disk space",
+		Run: func(_ context.Context) error {
+			usage := sigar.FileSystemUsage{}
+			if err := usage.Get(path); err != nil {
+				return Warnf("could not get the disk usage of %s: %v", path, err)
+			}
+			if usage.Avail < minFree {
+				return fmt.Errorf("%s available in %s, at least %s required",
+					humanize.IBytes(usage.Avail), path, humanize.IBytes(minFree))
+			}
+			return nil
+		},
+	}
+}
+
+// ClockSkewCheck compares the local clock with the Date header of the
+// responses of the URLs, and checks that their difference is below the
+// maximum. The first URL responding is used.
+func ClockSkewCheck(client *http.Client, urls []string, maxSkew time.Duration) Check {
+	return Check{
+		Name: "clock is in sync",
+		Run: func(ctx context.Context) error {
+			if len(urls) == 0 {
+				return Skipf("no URL to compare the clock with")
+			}
+
+			var errs []string
+			for _, url := range urls {
+				skew, err := clockSkew(ctx, client, url)
+				if err != nil {
+					errs = append(errs, err.Error())
+					continue
+				}
+				if skew < -maxSkew || skew > maxSkew {
+					return fmt.Errorf("local clock is %v away from the clock of %s, more than %v", skew, url, maxSkew)
+				}
+				return nil
+			}
+			return Warnf("could not get the time from any URL: %s", strings.Join(errs, "; "))
+		},
+	}
+}
+
+// clockSkew returns the difference between the local clock and the clock of
+// the server at the URL. The local time is taken in the middle of the request
+// to compensate for the round trip.
+func clockSkew(ctx context.Context, client *http.Client, url string) (time.Duration, error) {
+	req, err := http.NewRequest(http.MethodHead, url, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	local := start.Add(time.Since(start) / 2)
+
+	date, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return 0, fmt.Errorf("invalid Date header in the response of %s: %v", url, err)
+	}
+
+	// The Date header has a precision of one second.
+	skew := local.Truncate(time.Second).Sub(date)
+	return skew, nil
+}
+
+// OutputCheck runs the self tests of the clients of the output, like
+// connecting to the hosts, and reports the errors they find.
+func OutputCheck(name string, clients []outputs.Client) Check {
+	return Check{
+		Name: "output " + name + " is reachable",
+		Run: func(ctx context.Context) error {
+			for _, client := range clients {
+				testable, ok := client.(testing.Testable)
+				if !ok {
+					return Skipf("the %s output doesn't support testing", name)
+				}
+
+				d := &checkDriver{errs: new([]string)}
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					testable.Test(d)
+				}()
+
+				select {
+				case <-done:
+				case <-ctx.Done():
+					return fmt.Errorf("testing %s: %v", client, ctx.Err())
+				}
+
+				if len(*d.errs) > 0 {
+					return fmt.Errorf("%s", strings.Join(*d.errs, "; "))
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// checkDriver is a testing driver collecting the errors of the self tests.
+type checkDriver struct {
+	prefix string
+	errs   *[]string
+}
+
+func (d *checkDriver) Run(name string, f func(testing.Driver)) {
+	f(&checkDriver{prefix: d.field(name), errs: d.errs})
+}
+
+func (d *checkDriver) Info(field, value string) {}
+
+func (d *checkDriver) Warn(field, reason string) {}
+
+func (d *checkDriver) Error(field string, err error) {
+	if err != nil {
+		*d.errs = append(*d.errs, fmt.Sprintf("%s: %v", d.field(field), err))
+	}
+}
+
+// Fatal stops the test, that always runs in its own goroutine.
+func (d *checkDriver) Fatal(field string, err error) {
+	if err != nil {
+		d.Error(field, err)
+		runtime.Goexit()
+	}
+}
+
+func (d *checkDriver) Result(data string) {}
+
+func (d *checkDriver) field(name string) string {
+	if d.prefix == "" {
+		return name
+	}
+	return d.prefix + " > " + name
+}
@@ -0,0 +1,241 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package preflight runs checks on the environment of a Beat before it starts,
+// like the permissions of its paths, the free disk space or the connectivity to
+// the output, and reports all the failures at once instead of letting them
+// surface later as errors of the components using them.
+package preflight
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/testing"
+)
+
+var flagStrict = flag.Bool("strict", false, "Run the preflight checks and abort if any of them fails")
+
+// Config is the configuration of the preflight checks.
+type Config struct {
+	Enabled bool          `config:"enabled"`
+	Strict  bool          `config:"strict"`
+	Timeout time.Duration `config:"timeout" validate:"positive"`
+
+	Disk struct {
+		MinFree cfgtype.ByteSize `config:"min_free"`
+	} `config:"disk"`
+
+	ClockSkew struct {
+		Max  time.Duration `config:"max" validate:"positive"`
+		URLs []string      `config:"urls"`
+	} `config:"clock_skew"`
+}
+
+// DefaultConfig returns the default configuration of the preflight checks.
+func DefaultConfig() Config {
+	var config Config
+	config.Timeout = 10 * time.Second
+	config.Disk.MinFree = 100 * 1024 * 1024
+	config.ClockSkew.Max = 30 * time.Second
+	return config
+}
+
+// IsStrict returns true if the Beat must not start when a check fails, because
+// of the config or of the --strict flag.
+func (c *Config) IsStrict() bool {
+	return c.Strict || *flagStrict
+}
+
+// IsEnabled returns true if the checks must be run when the Beat starts. The
+// checks are always run in strict mode.
+func (c *Config) IsEnabled() bool {
+	return c.Enabled || c.IsStrict()
+}
+
+// Status is the status of a check once run.
+type Status int
+
+const (
+	// StatusOK is the status of the checks that pass.
+	StatusOK Status = iota
+	// StatusSkipped is the status of the checks that don't apply to the Beat.
+	StatusSkipped
+	// StatusWarning is the status of the checks finding possible problems.
+	StatusWarning
+	// StatusFailed is the status of the checks that fail.
+	StatusFailed
+)
+
+var statusNames = map[Status]string{
+	StatusOK:      "ok",
+	StatusSkipped: "skipped",
+	StatusWarning: "warning",
+	StatusFailed:  "failed",
+}
+
+func (s Status) String() string {
+	if name, ok := statusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("status(%d)", int(s))
+}
+
+// Check is a preflight check.
+type Check struct {
+	// Name of the check in the report.
+	Name string
+
+	// Run runs the check. The check passes if it returns nil, errors created
+	// with Warnf and Skipf report a warning or skip the check, and any other
+	// error fails it.
+	Run func(ctx context.Context) error
+}
+
+type statusError struct {
+	status Status
+	msg    string
+}
+
+func (e *statusError) Error() string {
+	return e.msg
+}
+
+// Warnf returns an error reporting a warning for a check.
+func Warnf(format string, args ...interface{}) error {
+	return &statusError{status: StatusWarning, msg: fmt.Sprintf(format, args...)}
+}
+
+// Skipf returns an error reporting that a check doesn't apply.
+func Skipf(format string, args ...interface{}) error {
+	return &statusError{status: StatusSkipped, msg: fmt.Sprintf(format, args...)}
+}
+
+// Result is the result of a check.
+type Result struct {
+	Name    string
+	Status  Status
+	Message string
+}
+
+// Report is the consolidated result of the checks.
+type Report struct {
+	Results []Result
+}
+
+// Run runs the checks one after the other, each one with the given timeout,
+// and returns their results.
+func Run(ctx context.Context, timeout time.Duration, checks []Check) *Report {
+	report := &Report{}
+	for _, check := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := check.Run(checkCtx)
+		cancel()
+
+		result := Result{Name: check.Name, Status: StatusOK}
+		if err != nil {
+			result.Status = StatusFailed
+			result.Message = err.Error()
+
+			var statusErr *statusError
+			if errors.As(err, &statusErr) {
+				result.Status = statusErr.status
+			}
+		}
+		report.Results = append(report.Results, result)
+	}
+	return report
+}
+
+// Failed returns true if any check failed.
+func (r *Report) Failed() bool {
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns an error listing the failed checks, or nil if none failed.
+func (r *Report) Err() error {
+	var failed []string
+	for _, result := range r.Results {
+		if result.Status == StatusFailed {
+			failed = append(failed, fmt.Sprintf("%s: %s", result.Name, result.Message))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("preflight checks failed: %s", strings.Join(failed, "; "))
+}
+
+// Summary returns the number of checks by status.
+func (r *Report) Summary() string {
+	counts := map[Status]int{}
+	for _, result := range r.Results {
+		counts[result.Status]++
+	}
+	return fmt.Sprintf("%d passed, %d failed, %d warnings, %d skipped",
+		counts[StatusOK], counts[StatusFailed], counts[StatusWarning], counts[StatusSkipped])
+}
+
+// Print writes the report to the console, like the output of the test commands.
+func (r *Report) Print(w io.Writer) {
+	d := testing.NewConsoleDriverWithKiller(w, func() {})
+	d.Run("preflight checks", func(d testing.Driver) {
+		for _, result := range r.Results {
+			switch result.Status {
+			case StatusOK:
+				d.Error(result.Name, nil)
+			case StatusSkipped:
+				d.Info(result.Name, "skipped, "+result.Message)
+			case StatusWarning:
+				d.Warn(result.Name, result.Message)
+			default:
+				d.Error(result.Name, errors.New(result.Message))
+			}
+		}
+		d.Info("summary", r.Summary())
+	})
+}
+
+// Log logs the results of the checks, failures as errors and warnings as
+// warnings.
+func (r *Report) Log(logger *logp.Logger) {
+	for _, result := range r.Results {
+		switch result.Status {
+		case StatusOK:
+			logger.Infof("Preflight check %q passed", result.Name)
+		case StatusSkipped:
+			logger.Debugf("Preflight check %q skipped: %s", result.Name, result.Message)
+		case StatusWarning:
+			logger.Warnf("Preflight check %q found a possible problem: %s", result.Name, result.Message)
+		default:
+			logger.Errorf("Preflight check %q failed: %s", result.Name, result.Message)
+		}
+	}
+	logger.Infof("Preflight checks: %s", r.Summary())
+}
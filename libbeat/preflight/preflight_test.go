@@ -0,0 +1,142 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package preflight
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/outputs"
+	"github.com/elastic/beats/v7/libbeat/publisher"
+	bt "github.com/elastic/beats/v7/libbeat/testing"
+)
+
+func TestRun(t *testing.T) {
+	checks := []Check{
+		{Name: "ok", Run: func(context.Context) error { return nil }},
+		{Name: "skipped", Run: func(context.Context) error { return Skipf("not %s", "here") }},
+		{Name: "warning", Run: func(context.Context) error { return Warnf("maybe") }},
+		{Name: "failed", Run: func(context.Context) error { return errors.New("broken") }},
+		{Name: "timeout", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}},
+	}
+
+	report := Run(context.Background(), 10*time.Millisecond, checks)
+	assert.Equal(t, []Result{
+		{Name: "ok", Status: StatusOK},
+		{Name: "skipped", Status: StatusSkipped, Message: "not here"},
+		{Name: "warning", Status: StatusWarning, Message: "maybe"},
+		{Name: "failed", Status: StatusFailed, Message: "broken"},
+		{Name: "timeout", Status: StatusFailed, Message: "context deadline exceeded"},
+	}, report.Results)
+	assert.True(t, report.Failed())
+	assert.Equal(t, "1 passed, 2 failed, 1 warnings, 1 skipped", report.Summary())
+	assert.EqualError(t, report.Err(), "preflight checks failed: failed: broken; timeout: context deadline exceeded")
+
+	report = Run(context.Background(), time.Second, checks[:3])
+	assert.False(t, report.Failed())
+	assert.NoError(t, report.Err())
+}
+
+func TestPathCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preflight")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, runCheck(PathCheck("dir", dir)))
+	assert.Error(t, runCheck(PathCheck("missing", dir+"/missing")))
+}
+
+func TestPortCheck(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+
+	assert.Error(t, runCheck(PortCheck("used", l.Addr().String())))
+	assert.NoError(t, runCheck(PortCheck("free", "127.0.0.1:0")))
+}
+
+func TestDiskSpaceCheck(t *testing.T) {
+	dir, err := ioutil.TempDir("", "preflight")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assert.NoError(t, runCheck(DiskSpaceCheck("dir", dir, 1)))
+	assert.Error(t, runCheck(DiskSpaceCheck("dir", dir, 1<<62)))
+}
+
+func TestClockSkewCheck(t *testing.T) {
+	offset := time.Duration(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Date", time.Now().Add(offset).UTC().Format(http.TimeFormat))
+	}))
+	defer server.Close()
+
+	check := ClockSkewCheck(server.Client(), []string{"http://127.0.0.1:1", server.URL}, 30*time.Second)
+	assert.NoError(t, runCheck(check))
+
+	offset = 10 * time.Minute
+	assert.Error(t, runCheck(check))
+
+	assert.Equal(t, StatusWarning, statusOf(runCheck(ClockSkewCheck(server.Client(), []string{"http://127.0.0.1:1"}, time.Second))))
+	assert.Equal(t, StatusSkipped, statusOf(runCheck(ClockSkewCheck(server.Client(), nil, time.Second))))
+}
+
+func TestOutputCheck(t *testing.T) {
+	ok := &testableClient{}
+	failing := &testableClient{err: errors.New("connection refused")}
+
+	assert.NoError(t, runCheck(OutputCheck("test", []outputs.Client{ok})))
+	assert.EqualError(t, runCheck(OutputCheck("test", []outputs.Client{ok, failing})),
+		"test > connection: connection refused")
+}
+
+type testableClient struct {
+	err error
+}
+
+func (c *testableClient) Close() error                                   { return nil }
+func (c *testableClient) Publish(context.Context, publisher.Batch) error { return nil }
+func (c *testableClient) String() string                                 { return "test" }
+
+func (c *testableClient) Test(d bt.Driver) {
+	d.Run("test", func(d bt.Driver) {
+		d.Fatal("connection", c.err)
+		d.Error("handshake", c.err)
+	})
+}
+
+func runCheck(check Check) error {
+	return check.Run(context.Background())
+}
+
+func statusOf(err error) Status {
+	return Run(context.Background(), time.Second, []Check{{Run: func(context.Context) error { return err }}}).Results[0].Status
+}
@@ -18,9 +18,7 @@
 package actions
 
 import (
-	"encoding/json"
 	"fmt"
-	"io"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -28,6 +26,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/beat/events"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/processors"
@@ -43,6 +42,8 @@ type decodeJSONFields struct {
 	processArray  bool
 	documentID    string
 	target        *string
+	maxSize       int
+	decoder       jsonDecoder
 	logger        *logp.Logger
 }
 
@@ -54,12 +55,17 @@ type config struct {
 	ProcessArray  bool     `config:"process_array"`
 	Target        *string  `config:"target"`
 	DocumentID    string   `config:"document_id"`
+
+	// Guards against pathological documents from untrusted sources
+	MaxNestingDepth int              `config:"max_nesting_depth" validate:"min=0"`
+	MaxSize         cfgtype.ByteSize `config:"max_size"`
 }
 
 var (
 	defaultConfig = config{
-		MaxDepth:     1,
-		ProcessArray: false,
+		MaxDepth:        1,
+		ProcessArray:    false,
+		MaxNestingDepth: 100,
 	}
 	errProcessingSkipped = errors.New("processing skipped")
 )
@@ -68,7 +74,8 @@ func init() {
 	processors.RegisterPlugin("decode_json_fields",
 		checks.ConfigChecked(NewDecodeJSONFields,
 			checks.RequireFields("fields"),
-			checks.AllowedFields("fields", "max_depth", "overwrite_keys", "add_error_key", "process_array", "target", "when", "document_id")))
+			checks.AllowedFields("fields", "max_depth", "overwrite_keys", "add_error_key", "process_array", "target", "when", "document_id",
+				"max_nesting_depth", "max_size")))
 
 	jsprocessor.RegisterPlugin("DecodeJSONFields", NewDecodeJSONFields)
 }
@@ -92,6 +99,8 @@ func NewDecodeJSONFields(c *common.Config) (processors.Processor, error) {
 		processArray:  config.ProcessArray,
 		documentID:    config.DocumentID,
 		target:        config.Target,
+		maxSize:       int(config.MaxSize),
+		decoder:       jsonDecoder{maxNesting: config.MaxNestingDepth},
 		logger:        logger,
 	}
 	return f, nil
@@ -114,8 +123,14 @@ func (f *decodeJSONFields) Run(event *beat.Event) (*beat.Event, error) {
 			continue
 		}
 
+		if f.maxSize > 0 && len(text) > f.maxSize {
+			f.logger.Debugf("Skipping field %s, its size of %d bytes exceeds the max size of %d bytes", field, len(text), f.maxSize)
+			errs = append(errs, fmt.Sprintf("field %s exceeds the max size of %d bytes", field, f.maxSize))
+			continue
+		}
+
 		var output interface{}
-		err = unmarshal(f.maxDepth, text, &output, f.processArray)
+		err = f.unmarshal(f.maxDepth, text, &output, 0)
 		if err != nil {
 			f.logger.Debugf("Error trying to unmarshal %s", text)
 			errs = append(errs, err.Error())
@@ -170,10 +185,15 @@ func (f *decodeJSONFields) Run(event *beat.Event) (*beat.Event, error) {
 	return event, nil
 }
 
-func unmarshal(maxDepth int, text string, fields *interface{}, processArray bool) error {
-	if err := decodeJSON(text, fields); err != nil {
+// unmarshal decodes the text, and the JSON documents embedded in its strings
+// up to maxDepth. Embedded documents that cannot be decoded are kept as
+// strings, so the output of a field is either fully decoded or not modified.
+func (f *decodeJSONFields) unmarshal(maxDepth int, text string, fields *interface{}, nesting int) error {
+	value, err := f.decoder.decode(text, nesting)
+	if err != nil {
 		return err
 	}
+	*fields = value
 
 	maxDepth--
 	if maxDepth == 0 {
@@ -189,7 +209,7 @@ func unmarshal(maxDepth int, text string, fields *interface{}, processArray bool
 		}
 
 		var tmp interface{}
-		err := unmarshal(maxDepth, str, &tmp, processArray)
+		err := f.unmarshal(maxDepth, str, &tmp, nesting+1)
 		if err != nil {
 			return v, err == errProcessingSkipped
 		}
@@ -207,7 +227,7 @@ func unmarshal(maxDepth int, text string, fields *interface{}, processArray bool
 		}
 	// We want to process arrays here
 	case []interface{}:
-		if !processArray {
+		if !f.processArray {
 			return errProcessingSkipped
 		}
 
@@ -220,30 +240,6 @@ func unmarshal(maxDepth int, text string, fields *interface{}, processArray bool
 	return nil
 }
 
-func decodeJSON(text string, to *interface{}) error {
-	dec := json.NewDecoder(strings.NewReader(text))
-	dec.UseNumber()
-	err := dec.Decode(to)
-
-	if err != nil {
-		return err
-	}
-
-	if dec.More() {
-		return errors.New("multiple json elements found")
-	}
-
-	if _, err := dec.Token(); err != nil && err != io.EOF {
-		return err
-	}
-
-	switch O := interface{}(*to).(type) {
-	case map[string]interface{}:
-		jsontransform.TransformNumbers(O)
-	}
-	return nil
-}
-
 func (f decodeJSONFields) String() string {
 	return "decode_json_fields=" + strings.Join(f.fields, ", ")
 }
@@ -19,6 +19,7 @@ package actions
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -400,6 +401,88 @@ func TestAddErrKeyOption(t *testing.T) {
 	}
 }
 
+func TestNestingDepthGuard(t *testing.T) {
+	deep := strings.Repeat("[", 10) + strings.Repeat("]", 10)
+	input := common.MapStr{
+		"msg": `{"a":` + deep + `}`,
+	}
+
+	testConfig, _ = common.NewConfigFrom(map[string]interface{}{
+		"fields":            fields,
+		"max_nesting_depth": 5,
+	})
+
+	actual := getActualValue(t, testConfig, input)
+	assert.Equal(t, input.String(), actual.String())
+
+	testConfig, _ = common.NewConfigFrom(map[string]interface{}{
+		"fields":            fields,
+		"max_nesting_depth": 11,
+	})
+
+	actual = getActualValue(t, testConfig, input)
+	value, err := actual.GetValue("msg.a")
+	require.NoError(t, err)
+	assert.IsType(t, []interface{}{}, value)
+}
+
+func TestNestingDepthGuardEmbedded(t *testing.T) {
+	input := common.MapStr{
+		"msg": `{"log":"{\"a\":{\"b\":{}}}","level":"info"}`,
+	}
+
+	testConfig, _ = common.NewConfigFrom(map[string]interface{}{
+		"fields":            fields,
+		"max_depth":         2,
+		"max_nesting_depth": 3,
+	})
+
+	// The embedded document would be nested 4 levels deep, so it is kept as a
+	// string.
+	actual := getActualValue(t, testConfig, input)
+	expected := common.MapStr{
+		"msg": map[string]interface{}{
+			"log":   `{"a":{"b":{}}}`,
+			"level": "info",
+		},
+	}
+	assert.Equal(t, expected.String(), actual.String())
+}
+
+func TestMaxSize(t *testing.T) {
+	input := common.MapStr{
+		"msg":   `{"message":"a long enough message"}`,
+		"short": `{"a":1}`,
+	}
+
+	testConfig, _ = common.NewConfigFrom(map[string]interface{}{
+		"fields":   []string{"msg", "short"},
+		"max_size": 16,
+	})
+
+	p, err := NewDecodeJSONFields(testConfig)
+	require.NoError(t, err)
+
+	actual, err := p.Run(&beat.Event{Fields: input.Clone()})
+	assert.Error(t, err)
+	expected := common.MapStr{
+		"msg": `{"message":"a long enough message"}`,
+		"short": map[string]interface{}{
+			"a": int64(1),
+		},
+	}
+	assert.Equal(t, expected, actual.Fields)
+}
+
+func TestInvalidMaxNestingDepth(t *testing.T) {
+	config, _ := common.NewConfigFrom(map[string]interface{}{
+		"fields":            fields,
+		"max_nesting_depth": -1,
+	})
+	_, err := NewDecodeJSONFields(config)
+	assert.Error(t, err)
+}
+
 func getActualValue(t *testing.T, config *common.Config, input common.MapStr) common.MapStr {
 	log := logp.NewLogger("decode_json_fields_test")
 
@@ -0,0 +1,126 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package actions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+)
+
+var errMultipleJSONElements = errors.New("multiple json elements found")
+
+// jsonDecoder decodes JSON documents token by token, so the limits of nesting
+// are checked while decoding, before pathological documents are fully built
+// in memory.
+type jsonDecoder struct {
+	// maxNesting is the maximum number of nested objects and arrays, 0 for
+	// no limit.
+	maxNesting int
+}
+
+// decode decodes a single JSON document, nesting is the number of objects
+// and arrays the document is nested into.
+func (d *jsonDecoder) decode(text string, nesting int) (interface{}, error) {
+	dec := json.NewDecoder(strings.NewReader(text))
+	dec.UseNumber()
+
+	value, err := d.value(dec, nesting)
+	if err != nil {
+		return nil, err
+	}
+
+	if dec.More() {
+		return nil, errMultipleJSONElements
+	}
+	if _, err := dec.Token(); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	switch O := value.(type) {
+	case map[string]interface{}:
+		jsontransform.TransformNumbers(O)
+	}
+	return value, nil
+}
+
+func (d *jsonDecoder) value(dec *json.Decoder, nesting int) (interface{}, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch t := token.(type) {
+	case json.Delim:
+		if d.maxNesting > 0 && nesting >= d.maxNesting {
+			return nil, fmt.Errorf("json document exceeds the max nesting depth of %d", d.maxNesting)
+		}
+		if t == '{' {
+			return d.object(dec, nesting+1)
+		}
+		return d.array(dec, nesting+1)
+	default:
+		// numbers, strings, booleans and nulls
+		return t, nil
+	}
+}
+
+func (d *jsonDecoder) object(dec *json.Decoder, nesting int) (map[string]interface{}, error) {
+	obj := map[string]interface{}{}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key := token.(string)
+
+		value, err := d.value(dec, nesting)
+		if err != nil {
+			return nil, err
+		}
+		obj[key] = value
+	}
+
+	// Consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+func (d *jsonDecoder) array(dec *json.Decoder, nesting int) ([]interface{}, error) {
+	arr := []interface{}{}
+	for dec.More() {
+		value, err := d.value(dec, nesting)
+		if err != nil {
+			return nil, err
+		}
+		arr = append(arr, value)
+	}
+
+	// Consume the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return arr, nil
+}
@@ -0,0 +1,68 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limit_cardinality
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	actionHash = "hash"
+	actionDrop = "drop"
+)
+
+type config struct {
+	// Fields to guard, a trailing .* guards all the fields under an object.
+	Fields []string `config:"fields" validate:"required"`
+
+	// MaxValues is the number of distinct values kept for a field, new values
+	// past this limit are hashed or dropped.
+	MaxValues int `config:"max_values" validate:"min=1"`
+
+	// MaxFields is the number of distinct fields kept under a guarded object,
+	// new fields past this limit are dropped.
+	MaxFields int `config:"max_fields" validate:"min=1"`
+
+	// Action is the action taken on values past the limit, hash or drop.
+	Action string `config:"action"`
+
+	// Buckets is the number of distinct hashes new values past the limit are
+	// replaced with, so hashing keeps the cardinality of the field bounded.
+	Buckets uint64 `config:"buckets" validate:"min=1"`
+
+	// Period after which the tracked fields and values are forgotten.
+	Period time.Duration `config:"period" validate:"min=0, nonzero"`
+}
+
+func defaultConfig() config {
+	return config{
+		MaxValues: 1000,
+		MaxFields: 100,
+		Action:    actionHash,
+		Buckets:   100,
+		Period:    24 * time.Hour,
+	}
+}
+
+func (c *config) Validate() error {
+	if c.Action != actionHash && c.Action != actionDrop {
+		return fmt.Errorf("invalid action '%s', it must be one of %s or %s", c.Action, actionHash, actionDrop)
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limit_cardinality
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+)
+
+func init() {
+	processors.RegisterPlugin(processorName, New)
+	jsprocessor.RegisterPlugin("LimitCardinality", New)
+}
+
+const processorName = "limit_cardinality"
+
+// limitCardinality protects the mappings and indexes of the outputs from
+// cardinality explosions, like workloads adding labels with unique names or
+// values. It tracks the distinct fields and values of the guarded fields and,
+// past the configured limits, drops new fields and hashes new values into a
+// fixed number of buckets or drops them.
+type limitCardinality struct {
+	config config
+	log    *logp.Logger
+
+	mu       sync.Mutex
+	since    time.Time
+	fields   map[string]map[string]struct{} // distinct fields per guarded object
+	values   map[string]map[string]struct{} // distinct values per field
+	reported map[string]bool                // fields already reported as exceeding limits
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// New constructs a new limit_cardinality processor.
+func New(cfg *common.Config) (processors.Processor, error) {
+	config := defaultConfig()
+	if err := cfg.Unpack(&config); err != nil {
+		return nil, errors.Wrapf(err, "fail to unpack the %v configuration", processorName)
+	}
+
+	p := &limitCardinality{
+		config: config,
+		log:    logp.NewLogger(processorName),
+		now:    time.Now,
+	}
+	p.reset(p.now())
+	return p, nil
+}
+
+func (p *limitCardinality) reset(now time.Time) {
+	p.since = now
+	p.fields = map[string]map[string]struct{}{}
+	p.values = map[string]map[string]struct{}{}
+	p.reported = map[string]bool{}
+}
+
+// Run checks the guarded fields of the event against the limits.
+func (p *limitCardinality) Run(event *beat.Event) (*beat.Event, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if now := p.now(); now.Sub(p.since) >= p.config.Period {
+		p.reset(now)
+	}
+
+	for _, field := range p.config.Fields {
+		if prefix := strings.TrimSuffix(field, ".*"); prefix != field {
+			p.guardObject(event, prefix)
+		} else {
+			p.guardValue(event, field)
+		}
+	}
+	return event, nil
+}
+
+func (p *limitCardinality) guardObject(event *beat.Event, prefix string) {
+	value, err := event.GetValue(prefix)
+	if err != nil {
+		return
+	}
+	object, ok := tryToMapStr(value)
+	if !ok {
+		return
+	}
+
+	known := p.fields[prefix]
+	if known == nil {
+		known = map[string]struct{}{}
+		p.fields[prefix] = known
+	}
+
+	for key := range object.Flatten() {
+		field := prefix + "." + key
+		if _, found := known[field]; !found {
+			if len(known) >= p.config.MaxFields {
+				p.report(prefix, "has more than %d distinct fields, new fields like %s are dropped", p.config.MaxFields, field)
+				event.Delete(field)
+				continue
+			}
+			known[field] = struct{}{}
+		}
+		p.guardValue(event, field)
+	}
+}
+
+func (p *limitCardinality) guardValue(event *beat.Event, field string) {
+	value, err := event.GetValue(field)
+	if err != nil {
+		return
+	}
+	str := fmt.Sprint(value)
+
+	known := p.values[field]
+	if known == nil {
+		known = map[string]struct{}{}
+		p.values[field] = known
+	}
+	if _, found := known[str]; found {
+		return
+	}
+	if len(known) < p.config.MaxValues {
+		known[str] = struct{}{}
+		return
+	}
+
+	switch p.config.Action {
+	case actionDrop:
+		p.report(field, "has more than %d distinct values, new values are dropped", p.config.MaxValues)
+		event.Delete(field)
+	case actionHash:
+		p.report(field, "has more than %d distinct values, new values are hashed", p.config.MaxValues)
+		h := fnv.New64a()
+		h.Write([]byte(str))
+		event.PutValue(field, fmt.Sprintf("%x", h.Sum64()%p.config.Buckets))
+	}
+}
+
+// report logs the first time a field exceeds the limits in a period.
+// Processors only transform the events they receive and cannot publish new
+// ones, so the report is a warning in the logs instead of a separate event.
+func (p *limitCardinality) report(field string, format string, args ...interface{}) {
+	if p.reported[field] {
+		return
+	}
+	p.reported[field] = true
+	p.log.Warnw(fmt.Sprintf("Field %s "+format, append([]interface{}{field}, args...)...),
+		"field", field, "since", p.since)
+}
+
+func (p *limitCardinality) String() string {
+	return fmt.Sprintf("%v=[fields=%v, max_values=%d, max_fields=%d, action=%v, buckets=%d]",
+		processorName, p.config.Fields, p.config.MaxValues, p.config.MaxFields, p.config.Action, p.config.Buckets)
+}
+
+func tryToMapStr(v interface{}) (common.MapStr, bool) {
+	switch m := v.(type) {
+	case common.MapStr:
+		return m, true
+	case map[string]interface{}:
+		return common.MapStr(m), true
+	default:
+		return nil, false
+	}
+}
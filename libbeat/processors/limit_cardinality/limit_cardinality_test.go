@@ -0,0 +1,159 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package limit_cardinality
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func newTestProcessor(t *testing.T, cfg common.MapStr) *limitCardinality {
+	t.Helper()
+	p, err := New(common.MustNewConfigFrom(cfg))
+	require.NoError(t, err)
+	return p.(*limitCardinality)
+}
+
+func TestLimitValues(t *testing.T) {
+	tests := map[string]struct {
+		action   string
+		expected []interface{}
+	}{
+		"hash": {
+			action:   actionHash,
+			expected: []interface{}{"a", "b", "a", "12"},
+		},
+		"drop": {
+			action:   actionDrop,
+			expected: []interface{}{"a", "b", "a", nil},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := newTestProcessor(t, common.MapStr{
+				"fields":     []string{"user.name"},
+				"max_values": 2,
+				"action":     test.action,
+			})
+
+			for i, value := range []string{"a", "b", "a", "c"} {
+				event, err := p.Run(&beat.Event{Fields: common.MapStr{"user": common.MapStr{"name": value}}})
+				require.NoError(t, err)
+
+				v, _ := event.GetValue("user.name")
+				assert.Equal(t, test.expected[i], v)
+			}
+		})
+	}
+}
+
+func TestLimitFields(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{
+		"fields":     []string{"kubernetes.labels.*"},
+		"max_fields": 2,
+		"max_values": 1,
+	})
+
+	labels := []common.MapStr{
+		{"app": "nginx", "tier": "frontend"},
+		{"app": "nginx", "pod-template-hash": "123"},
+		{"app": "redis", "tier": "frontend"},
+	}
+	expected := []common.MapStr{
+		{"app": "nginx", "tier": "frontend"},
+		{"app": "nginx"},
+		{"app": "50", "tier": "frontend"},
+	}
+
+	for i, l := range labels {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"kubernetes": common.MapStr{"labels": l}}})
+		require.NoError(t, err)
+
+		v, err := event.GetValue("kubernetes.labels")
+		require.NoError(t, err)
+		assert.Equal(t, expected[i], v, "event %d", i)
+	}
+}
+
+func TestLimitHashBuckets(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{
+		"fields":     []string{"user.name"},
+		"max_values": 1,
+		"buckets":    4,
+	})
+
+	values := map[interface{}]bool{}
+	for i := 0; i < 1000; i++ {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"user": common.MapStr{"name": fmt.Sprintf("user-%d", i)}}})
+		require.NoError(t, err)
+
+		v, err := event.GetValue("user.name")
+		require.NoError(t, err)
+		values[v] = true
+	}
+	assert.LessOrEqual(t, len(values), 1+4)
+}
+
+func TestLimitPeriod(t *testing.T) {
+	p := newTestProcessor(t, common.MapStr{
+		"fields":     []string{"user.name"},
+		"max_values": 1,
+		"action":     actionDrop,
+		"period":     "1h",
+	})
+	now := time.Now()
+	p.now = func() time.Time { return now }
+	p.reset(now)
+
+	run := func(value string) interface{} {
+		event, err := p.Run(&beat.Event{Fields: common.MapStr{"user": common.MapStr{"name": value}}})
+		require.NoError(t, err)
+		v, _ := event.GetValue("user.name")
+		return v
+	}
+
+	assert.Equal(t, "a", run("a"))
+	assert.Nil(t, run("b"))
+
+	now = now.Add(time.Hour)
+	assert.Equal(t, "b", run("b"))
+}
+
+func TestInvalidAction(t *testing.T) {
+	_, err := New(common.MustNewConfigFrom(common.MapStr{
+		"fields": []string{"user.name"},
+		"action": "truncate",
+	}))
+	assert.Error(t, err)
+}
+
+func TestInvalidBuckets(t *testing.T) {
+	_, err := New(common.MustNewConfigFrom(common.MapStr{
+		"fields":  []string{"user.name"},
+		"buckets": 0,
+	}))
+	assert.Error(t, err)
+}
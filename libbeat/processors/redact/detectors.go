@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"net"
+	"regexp"
+	"strings"
+)
+
+// detector finds sensitive values in strings.
+type detector struct {
+	name   string
+	regexp *regexp.Regexp
+
+	// valid discards the matches of the regexp that are not sensitive values,
+	// it is optional.
+	valid func(string) bool
+
+	// partial masks the match keeping part of it, defaults to keeping the
+	// last characters.
+	partial func(string) string
+}
+
+const (
+	// Card numbers in groups of 4 digits, American Express groups, or without separators.
+	creditCardPattern = `\b(?:[0-9]{4}[ \-]){3}[0-9]{4}\b|\b[0-9]{4}[ \-][0-9]{6}[ \-][0-9]{5}\b|\b[0-9]{13,19}\b`
+
+	ipv4Pattern = `\b(?:(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\.){3}(?:25[0-5]|2[0-4][0-9]|1[0-9]{2}|[1-9]?[0-9])\b`
+	ipv6Pattern = `(?i)\b(?:[0-9a-f]{1,4}:){7}[0-9a-f]{1,4}\b` +
+		`|(?i)\b(?:[0-9a-f]{1,4}:){1,7}:(?:[0-9a-f]{1,4}(?::[0-9a-f]{1,4}){0,6})?` +
+		`|::(?:[0-9a-f]{1,4}(?::[0-9a-f]{1,4}){0,6})?`
+)
+
+// builtinDetectors are the detectors that can be enabled by name.
+var builtinDetectors = map[string]*detector{
+	"email": {
+		name:    "email",
+		regexp:  regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9\-]+(?:\.[a-zA-Z0-9\-]+)*\.[a-zA-Z]{2,}`),
+		partial: partialEmail,
+	},
+	"credit_card": {
+		name:   "credit_card",
+		regexp: regexp.MustCompile(creditCardPattern),
+		valid:  validCreditCard,
+	},
+	"ip": {
+		name:   "ip",
+		regexp: regexp.MustCompile(ipv4Pattern + `|` + ipv6Pattern),
+		valid:  func(s string) bool { return net.ParseIP(s) != nil },
+	},
+	"us_ssn": {
+		name:   "us_ssn",
+		regexp: regexp.MustCompile(`\b[0-9]{3}-[0-9]{2}-[0-9]{4}\b`),
+		valid:  validUSSSN,
+	},
+	"uk_nino": {
+		name:   "uk_nino",
+		regexp: regexp.MustCompile(`\b[A-CEGHJ-PR-TW-Z][A-CEGHJ-NPR-TW-Z] ?[0-9]{2} ?[0-9]{2} ?[0-9]{2} ?[A-D]\b`),
+		valid:  validUKNINO,
+	},
+}
+
+// validCreditCard checks the number of digits and the Luhn checksum of a
+// card number.
+func validCreditCard(s string) bool {
+	var digits []int
+	for _, r := range s {
+		if r >= '0' && r <= '9' {
+			digits = append(digits, int(r-'0'))
+		}
+	}
+	if len(digits) < 13 || len(digits) > 19 {
+		return false
+	}
+
+	sum := 0
+	for i := range digits {
+		d := digits[len(digits)-1-i]
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// validUSSSN discards the area, group and serial numbers never assigned.
+func validUSSSN(s string) bool {
+	parts := strings.Split(s, "-")
+	area, group, serial := parts[0], parts[1], parts[2]
+	return area != "000" && area != "666" && area[0] != '9' &&
+		group != "00" && serial != "0000"
+}
+
+// validUKNINO discards the prefixes never used in national insurance numbers.
+func validUKNINO(s string) bool {
+	switch s[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
+
+// partialEmail keeps the first character of the local part and the domain.
+func partialEmail(s string) string {
+	at := strings.LastIndexByte(s, '@')
+	if at <= 0 {
+		return partialDefault(s)
+	}
+	return s[:1] + strings.Repeat("*", at-1) + s[at:]
+}
+
+// partialKeep is the number of letters and digits kept by partialDefault.
+const partialKeep = 4
+
+// partialDefault masks all letters and digits but the last ones, keeping
+// separators so the format of the value is still recognizable.
+func partialDefault(s string) string {
+	runes := []rune(s)
+	keep := partialKeep
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !isAlphanumeric(runes[i]) {
+			continue
+		}
+		if keep > 0 {
+			keep--
+			continue
+		}
+		runes[i] = '*'
+	}
+	return string(runes)
+}
+
+func isAlphanumeric(r rune) bool {
+	return (r >= '0' && r <= '9') || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
@@ -0,0 +1,298 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/checks"
+	jsprocessor "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module/processor"
+)
+
+const processorName = "redact"
+
+const (
+	strategyHash    = "hash"
+	strategyPartial = "partial"
+	strategyRemove  = "remove"
+)
+
+type redact struct {
+	config    redactConfig
+	detectors []*detector
+	allowed   map[string]map[string]bool // Allowed values by field, "" for all fields.
+	log       *logp.Logger
+}
+
+type redactConfig struct {
+	Fields        []string        `config:"fields" validate:"required"`
+	Detectors     []string        `config:"detectors"`
+	Patterns      []patternConfig `config:"patterns"`
+	Strategy      string          `config:"strategy"`
+	HashKey       string          `config:"hash_key"`
+	AllowList     []allowConfig   `config:"allow_list"`
+	IgnoreMissing bool            `config:"ignore_missing"`
+	FailOnError   bool            `config:"fail_on_error"`
+}
+
+type patternConfig struct {
+	Name    string `config:"name" validate:"required"`
+	Pattern string `config:"pattern" validate:"required"`
+}
+
+type allowConfig struct {
+	Field  string   `config:"field"`
+	Values []string `config:"values" validate:"required"`
+}
+
+func init() {
+	processors.RegisterPlugin(processorName,
+		checks.ConfigChecked(New,
+			checks.RequireFields("fields"),
+			checks.AllowedFields("fields", "detectors", "patterns", "strategy", "hash_key",
+				"allow_list", "ignore_missing", "fail_on_error", "when")))
+	jsprocessor.RegisterPlugin("Redact", New)
+}
+
+// New constructs a new redact processor.
+func New(c *common.Config) (processors.Processor, error) {
+	config := redactConfig{
+		Strategy:    strategyHash,
+		FailOnError: true,
+	}
+
+	if err := c.Unpack(&config); err != nil {
+		return nil, fmt.Errorf("failed to unpack the configuration of redact processor: %s", err)
+	}
+
+	switch config.Strategy {
+	case strategyHash, strategyPartial, strategyRemove:
+	default:
+		return nil, fmt.Errorf("invalid strategy '%s' in redact processor, it must be one of '%s', '%s' or '%s'",
+			config.Strategy, strategyHash, strategyPartial, strategyRemove)
+	}
+
+	names := config.Detectors
+	if names == nil {
+		for name := range builtinDetectors {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	var detectors []*detector
+	for _, name := range names {
+		d, found := builtinDetectors[name]
+		if !found {
+			return nil, fmt.Errorf("unknown detector '%s' in redact processor", name)
+		}
+		detectors = append(detectors, d)
+	}
+	for _, pattern := range config.Patterns {
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern '%s' in redact processor: %v", pattern.Name, err)
+		}
+		detectors = append(detectors, &detector{name: pattern.Name, regexp: re})
+	}
+	if len(detectors) == 0 {
+		return nil, errors.New("redact processor requires at least one detector or pattern")
+	}
+
+	allowed := map[string]map[string]bool{}
+	for _, allow := range config.AllowList {
+		if allowed[allow.Field] == nil {
+			allowed[allow.Field] = map[string]bool{}
+		}
+		for _, value := range allow.Values {
+			allowed[allow.Field][value] = true
+		}
+	}
+
+	return &redact{
+		config:    config,
+		detectors: detectors,
+		allowed:   allowed,
+		log:       logp.NewLogger(processorName),
+	}, nil
+}
+
+// Run redacts the sensitive values found in the configured fields. Fields
+// already redacted are kept redacted when an error happens.
+func (p *redact) Run(event *beat.Event) (*beat.Event, error) {
+	for _, field := range p.config.Fields {
+		err := p.redactField(field, event)
+		if err != nil {
+			errMsg := fmt.Errorf("failed to redact fields in redact processor: %v", err)
+			p.log.Debug(errMsg.Error())
+			if p.config.FailOnError {
+				event.PutValue("error.message", errMsg.Error())
+				return event, err
+			}
+		}
+	}
+
+	return event, nil
+}
+
+func (p *redact) redactField(field string, event *beat.Event) error {
+	value, err := event.GetValue(field)
+	if err != nil {
+		if p.config.IgnoreMissing && errors.Cause(err) == common.ErrKeyNotFound {
+			return nil
+		}
+		return fmt.Errorf("could not fetch value for key: %s, Error: %v", field, err)
+	}
+
+	var redacted interface{}
+	switch v := value.(type) {
+	case string:
+		redacted = p.redactString(field, v)
+	case []string:
+		values := make([]string, len(v))
+		for i, s := range v {
+			values[i] = p.redactString(field, s)
+		}
+		redacted = values
+	case []interface{}:
+		values := make([]interface{}, len(v))
+		for i, e := range v {
+			r, err := p.redactScalar(field, e)
+			if err != nil {
+				return err
+			}
+			values[i] = r
+		}
+		redacted = values
+	default:
+		redacted, err = p.redactScalar(field, v)
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, err := event.PutValue(field, redacted); err != nil {
+		return fmt.Errorf("could not put value: %v, %v", field, err)
+	}
+	return nil
+}
+
+// redactScalar redacts strings, and other scalars like card numbers stored as
+// numbers, which are only replaced if something is redacted in them.
+func (p *redact) redactScalar(field string, value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string:
+		return p.redactString(field, v), nil
+	case map[string]interface{}, common.MapStr, []interface{}:
+		return nil, fmt.Errorf("invalid type for field %s, expecting a string received %T", field, value)
+	}
+
+	s := fmt.Sprint(value)
+	if redacted := p.redactString(field, s); redacted != s {
+		return redacted, nil
+	}
+	return value, nil
+}
+
+type match struct {
+	start, end int
+	detector   *detector
+}
+
+// redactString replaces the sensitive values found by all detectors in s.
+// Overlapping matches are resolved in favour of the first and longest one.
+func (p *redact) redactString(field, s string) string {
+	var matches []match
+	for _, d := range p.detectors {
+		for _, loc := range d.regexp.FindAllStringIndex(s, -1) {
+			value := s[loc[0]:loc[1]]
+			if loc[0] == loc[1] || (d.valid != nil && !d.valid(value)) || p.isAllowed(field, value) {
+				continue
+			}
+			matches = append(matches, match{start: loc[0], end: loc[1], detector: d})
+		}
+	}
+	if len(matches) == 0 {
+		return s
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].start != matches[j].start {
+			return matches[i].start < matches[j].start
+		}
+		return matches[i].end > matches[j].end
+	})
+
+	var b strings.Builder
+	last := 0
+	for _, m := range matches {
+		if m.start < last {
+			continue
+		}
+		b.WriteString(s[last:m.start])
+		b.WriteString(p.mask(m.detector, s[m.start:m.end]))
+		last = m.end
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+func (p *redact) isAllowed(field, value string) bool {
+	return p.allowed[""][value] || p.allowed[field][value]
+}
+
+func (p *redact) mask(d *detector, value string) string {
+	switch p.config.Strategy {
+	case strategyPartial:
+		if d.partial != nil {
+			return d.partial(value)
+		}
+		return partialDefault(value)
+	case strategyRemove:
+		return ""
+	default:
+		if p.config.HashKey != "" {
+			mac := hmac.New(sha256.New, []byte(p.config.HashKey))
+			mac.Write([]byte(value))
+			return hex.EncodeToString(mac.Sum(nil))
+		}
+		sum := sha256.Sum256([]byte(value))
+		return hex.EncodeToString(sum[:])
+	}
+}
+
+func (p *redact) String() string {
+	names := make([]string, len(p.detectors))
+	for i, d := range p.detectors {
+		names[i] = d.name
+	}
+	return fmt.Sprintf("%v=[fields=%v, detectors=%v, strategy=%v]",
+		processorName, p.config.Fields, names, p.config.Strategy)
+}
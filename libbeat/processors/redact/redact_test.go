@@ -0,0 +1,268 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package redact
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func sha256Hex(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestRedact(t *testing.T) {
+	var testCases = []struct {
+		description string
+		config      map[string]interface{}
+		Input       common.MapStr
+		Output      common.MapStr
+		error       bool
+	}{
+		{
+			description: "remove all built-in detectors",
+			config: map[string]interface{}{
+				"fields":   []string{"message"},
+				"strategy": "remove",
+			},
+			Input: common.MapStr{
+				"message": "user john.doe@example.com paid with 4111 1111 1111 1111 from 10.1.2.3 ssn 123-45-6789 nino AB123456C",
+			},
+			Output: common.MapStr{
+				"message": "user  paid with  from  ssn  nino ",
+			},
+		},
+		{
+			description: "partial",
+			config: map[string]interface{}{
+				"fields":   []string{"message"},
+				"strategy": "partial",
+			},
+			Input: common.MapStr{
+				"message": "john.doe@example.com 4111-1111-1111-1111 192.168.1.10",
+			},
+			Output: common.MapStr{
+				"message": "j*******@example.com ****-****-****-1111 ***.**8.1.10",
+			},
+		},
+		{
+			description: "hash is the default strategy",
+			config: map[string]interface{}{
+				"fields":    []string{"user.email"},
+				"detectors": []string{"email"},
+			},
+			Input: common.MapStr{
+				"user": common.MapStr{"email": "john.doe@example.com"},
+			},
+			Output: common.MapStr{
+				"user": common.MapStr{"email": sha256Hex("john.doe@example.com")},
+			},
+		},
+		{
+			description: "values failing validation are not redacted",
+			config: map[string]interface{}{
+				"fields":   []string{"message"},
+				"strategy": "remove",
+			},
+			Input: common.MapStr{
+				"message": "order 1234567812345678 at 10:00:00 ssn 000-12-3456 version 1.2.3",
+			},
+			Output: common.MapStr{
+				"message": "order 1234567812345678 at 10:00:00 ssn 000-12-3456 version 1.2.3",
+			},
+		},
+		{
+			description: "ipv6",
+			config: map[string]interface{}{
+				"fields":    []string{"message"},
+				"detectors": []string{"ip"},
+				"strategy":  "remove",
+			},
+			Input: common.MapStr{
+				"message": "from fe80::1 and 2001:db8::8a2e:370:7334, host:fe80::2",
+			},
+			Output: common.MapStr{
+				"message": "from  and , host:",
+			},
+		},
+		{
+			description: "custom patterns",
+			config: map[string]interface{}{
+				"fields":    []string{"message"},
+				"detectors": []string{},
+				"patterns": []map[string]interface{}{
+					{"name": "employee_id", "pattern": `EMP-[0-9]{6}`},
+				},
+				"strategy": "partial",
+			},
+			Input: common.MapStr{
+				"message": "badge EMP-123456 used by john@example.com",
+			},
+			Output: common.MapStr{
+				"message": "badge ***-**3456 used by john@example.com",
+			},
+		},
+		{
+			description: "allow list by field",
+			config: map[string]interface{}{
+				"fields":    []string{"message", "source.address"},
+				"detectors": []string{"ip"},
+				"strategy":  "remove",
+				"allow_list": []map[string]interface{}{
+					{"values": []string{"127.0.0.1"}},
+					{"field": "source.address", "values": []string{"10.0.0.1"}},
+				},
+			},
+			Input: common.MapStr{
+				"message": "127.0.0.1 10.0.0.1",
+				"source":  common.MapStr{"address": "10.0.0.1"},
+			},
+			Output: common.MapStr{
+				"message": "127.0.0.1 ",
+				"source":  common.MapStr{"address": "10.0.0.1"},
+			},
+		},
+		{
+			description: "lists and numbers",
+			config: map[string]interface{}{
+				"fields":    []string{"emails", "card", "count"},
+				"detectors": []string{"email", "credit_card"},
+				"strategy":  "remove",
+			},
+			Input: common.MapStr{
+				"emails": []interface{}{"a@example.com", "none"},
+				"card":   int64(4111111111111111),
+				"count":  3,
+			},
+			Output: common.MapStr{
+				"emails": []interface{}{"", "none"},
+				"card":   "",
+				"count":  3,
+			},
+		},
+		{
+			description: "missing field",
+			config: map[string]interface{}{
+				"fields": []string{"message"},
+			},
+			Input: common.MapStr{
+				"other": "john@example.com",
+			},
+			Output: common.MapStr{
+				"other": "john@example.com",
+				"error": common.MapStr{
+					"message": "failed to redact fields in redact processor: could not fetch value for key: message, Error: key not found",
+				},
+			},
+			error: true,
+		},
+		{
+			description: "missing field ignored",
+			config: map[string]interface{}{
+				"fields":         []string{"message"},
+				"ignore_missing": true,
+			},
+			Input: common.MapStr{
+				"other": "john@example.com",
+			},
+			Output: common.MapStr{
+				"other": "john@example.com",
+			},
+		},
+		{
+			description: "object field keeps the other fields redacted",
+			config: map[string]interface{}{
+				"fields":        []string{"user", "message"},
+				"strategy":      "remove",
+				"fail_on_error": false,
+			},
+			Input: common.MapStr{
+				"user":    common.MapStr{"email": "john@example.com"},
+				"message": "john@example.com",
+			},
+			Output: common.MapStr{
+				"user":    common.MapStr{"email": "john@example.com"},
+				"message": "",
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		test := test
+		t.Run(test.description, func(t *testing.T) {
+			p, err := New(common.MustNewConfigFrom(test.config))
+			require.NoError(t, err)
+
+			event := &beat.Event{
+				Fields: test.Input,
+			}
+
+			newEvent, err := p.Run(event)
+			if !test.error {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+
+			assert.Equal(t, test.Output, newEvent.Fields)
+		})
+	}
+}
+
+func TestRedactHashKey(t *testing.T) {
+	p, err := New(common.MustNewConfigFrom(map[string]interface{}{
+		"fields":   []string{"message"},
+		"hash_key": "secret",
+	}))
+	require.NoError(t, err)
+
+	event, err := p.Run(&beat.Event{Fields: common.MapStr{"message": "john@example.com"}})
+	require.NoError(t, err)
+
+	redacted, _ := event.GetValue("message")
+	assert.Len(t, redacted, 64)
+	assert.NotEqual(t, sha256Hex("john@example.com"), redacted)
+}
+
+func TestRedactInvalidConfig(t *testing.T) {
+	for name, config := range map[string]map[string]interface{}{
+		"missing fields":   {"strategy": "remove"},
+		"unknown strategy": {"fields": []string{"message"}, "strategy": "mask"},
+		"unknown detector": {"fields": []string{"message"}, "detectors": []string{"phone"}},
+		"invalid pattern":  {"fields": []string{"message"}, "patterns": []map[string]interface{}{{"name": "bad", "pattern": "("}}},
+		"no detectors":     {"fields": []string{"message"}, "detectors": []string{}},
+	} {
+		_, err := New(common.MustNewConfigFrom(config))
+		assert.Error(t, err, name)
+	}
+}
+
+func TestValidCreditCard(t *testing.T) {
+	assert.True(t, validCreditCard("4111 1111 1111 1111"))
+	assert.True(t, validCreditCard("3782 822463 10005"))
+	assert.False(t, validCreditCard("4111 1111 1111 1112"))
+	assert.False(t, validCreditCard("4111"))
+}
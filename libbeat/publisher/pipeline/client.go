@@ -95,6 +95,12 @@ func (c *client) publish(e beat.Event) {
 		}
 	}
 
+	downsampled := false
+	if d := c.pipeline.downsampler; d != nil && publish && !d.keep(event) {
+		publish = false
+		downsampled = true
+	}
+
 	if event != nil {
 		e = *event
 	}
@@ -107,6 +113,9 @@ func (c *client) publish(e beat.Event) {
 	}
 
 	if !publish {
+		if downsampled {
+			c.pipeline.observer.downsampledEvent()
+		}
 		c.onFilteredOut(e)
 		return
 	}
@@ -129,6 +138,9 @@ func (c *client) publish(e beat.Event) {
 	}
 
 	if published {
+		if d := c.pipeline.downsampler; d != nil {
+			d.published()
+		}
 		c.onPublished()
 	} else {
 		c.onDroppedOnPublish(e)
@@ -175,6 +187,10 @@ func (c *client) unlink() {
 	n := c.producer.Cancel() // close connection to queue
 	log.Debugf("client: cancelled %v events", n)
 
+	if d := c.pipeline.downsampler; d != nil && n > 0 {
+		d.acked(n)
+	}
+
 	if c.reportEvents {
 		log.Debugf("client: remove client events")
 		if n > 0 {
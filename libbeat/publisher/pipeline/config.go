@@ -34,6 +34,9 @@ type Config struct {
 
 	// Event queue
 	Queue common.ConfigNamespace `config:"queue"`
+
+	// Behavior on output backpressure
+	Backpressure BackpressureConfig `config:"backpressure"`
 }
 
 // validateClientConfig checks a ClientConfig can be used with (*Pipeline).ConnectWith.
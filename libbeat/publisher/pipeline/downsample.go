@@ -0,0 +1,178 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"sync"
+
+	"github.com/mitchellh/hashstructure"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// BackpressureConfig configures how the pipeline reacts to outputs not
+// keeping up with the events being published.
+type BackpressureConfig struct {
+	Downsample DownsampleConfig `config:"downsample"`
+}
+
+// DownsampleConfig configures the decimation of time series events while the
+// queue is filled above a threshold.
+type DownsampleConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Threshold is the fraction of the queue capacity in use above which
+	// time series events are downsampled. Defaults to 0.8.
+	Threshold float64 `config:"threshold" validate:"min=0, max=1"`
+
+	// KeepEvery is the N in "keep every Nth event of each time series".
+	// Defaults to 10.
+	KeepEvery int `config:"keep_every" validate:"min=0"`
+}
+
+const (
+	defaultDownsampleThreshold = 0.8
+	defaultDownsampleKeepEvery = 10
+)
+
+// downsampler decimates time series events while too many events are
+// waiting in the queue. Instead of blocking the beat or dropping random
+// events, every Nth event of each time series is kept, so all series keep
+// being reported with a lower resolution. Kept events are annotated with
+// the downsampled fields, so gaps in the data can be explained.
+type downsampler struct {
+	log       *logp.Logger
+	threshold uint
+	keepEvery uint64
+
+	// active is the number of events published to the queue and not ACKed yet.
+	active atomic.Uint
+
+	mu           sync.Mutex
+	downsampling bool
+	series       map[uint64]*seriesState
+}
+
+type seriesState struct {
+	seen    uint64
+	skipped uint64
+}
+
+// newDownsampler returns nil if downsampling is disabled.
+func newDownsampler(config DownsampleConfig, queueSize int, log *logp.Logger) *downsampler {
+	if !config.Enabled {
+		return nil
+	}
+
+	threshold := config.Threshold
+	if threshold <= 0 {
+		threshold = defaultDownsampleThreshold
+	}
+	keepEvery := config.KeepEvery
+	if keepEvery <= 0 {
+		keepEvery = defaultDownsampleKeepEvery
+	}
+
+	return &downsampler{
+		log:       log,
+		threshold: uint(threshold * float64(queueSize)),
+		keepEvery: uint64(keepEvery),
+		series:    map[uint64]*seriesState{},
+	}
+}
+
+func (d *downsampler) published() { d.active.Inc() }
+
+func (d *downsampler) acked(n int) { d.active.Sub(uint(n)) }
+
+// keep reports if the event must be published. Events kept while downsampling
+// are annotated with the number of events skipped for their time series.
+func (d *downsampler) keep(event *beat.Event) bool {
+	if !event.TimeSeries {
+		return true
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.checkBackpressure() {
+		return true
+	}
+
+	key, err := seriesKey(event)
+	if err != nil {
+		return true
+	}
+
+	state := d.series[key]
+	if state == nil {
+		state = &seriesState{}
+		d.series[key] = state
+	}
+
+	state.seen++
+	if (state.seen-1)%d.keepEvery != 0 {
+		state.skipped++
+		return false
+	}
+
+	event.PutValue("downsampled", common.MapStr{
+		"every":   d.keepEvery,
+		"skipped": state.skipped,
+	})
+	state.skipped = 0
+	return true
+}
+
+// checkBackpressure updates the downsampling state from the number of active
+// events. Must be called with mu held.
+func (d *downsampler) checkBackpressure() bool {
+	over := d.active.Load() >= d.threshold
+	if over != d.downsampling {
+		d.downsampling = over
+		if over {
+			d.log.Warnf("Queue is filled above %d events, downsampling time series events to 1 of every %d", d.threshold, d.keepEvery)
+		} else {
+			d.log.Info("Queue is below the downsampling threshold, publishing all time series events")
+			d.series = map[uint64]*seriesState{}
+		}
+	}
+	return d.downsampling
+}
+
+// seriesKey identifies the time series of an event, by its timeseries.instance
+// if available, or by its string fields otherwise.
+func seriesKey(event *beat.Event) (uint64, error) {
+	if instance, err := event.GetValue("timeseries.instance"); err == nil {
+		if h, ok := instance.(uint64); ok {
+			return h, nil
+		}
+		return hashstructure.Hash(instance, nil)
+	}
+
+	dimensions := common.MapStr{}
+	for k, v := range event.Fields.Flatten() {
+		if _, ok := v.(string); ok {
+			dimensions[k] = v
+		}
+	}
+	return hashstructure.Hash(dimensions, nil)
+}
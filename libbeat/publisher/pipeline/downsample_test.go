@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package pipeline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestDownsamplerDisabled(t *testing.T) {
+	assert.Nil(t, newDownsampler(DownsampleConfig{}, 100, logp.L()))
+}
+
+func TestDownsampler(t *testing.T) {
+	config := DownsampleConfig{Enabled: true, Threshold: 0.5, KeepEvery: 3}
+	d := newDownsampler(config, 10, logp.L())
+
+	event := func(host string) *beat.Event {
+		return &beat.Event{
+			TimeSeries: true,
+			Fields: common.MapStr{
+				"host":   common.MapStr{"name": host},
+				"metric": 42,
+			},
+		}
+	}
+
+	// Below the threshold all events are kept
+	for i := 0; i < 4; i++ {
+		e := event("a")
+		assert.True(t, d.keep(e))
+		assert.NotContains(t, e.Fields, "downsampled")
+		d.published()
+	}
+
+	// Events not belonging to time series are never downsampled
+	d.published()
+	assert.True(t, d.keep(&beat.Event{Fields: common.MapStr{"message": "hello"}}))
+
+	// Over the threshold every 3rd event of each series is kept
+	var kept []*beat.Event
+	for i := 0; i < 7; i++ {
+		for _, host := range []string{"a", "b"} {
+			e := event(host)
+			if d.keep(e) {
+				kept = append(kept, e)
+			}
+		}
+	}
+	if assert.Len(t, kept, 6) {
+		for i, skipped := range []uint64{0, 0, 2, 2, 2, 2} {
+			assert.Equal(t, common.MapStr{"every": uint64(3), "skipped": skipped}, kept[i].Fields["downsampled"])
+		}
+	}
+
+	// ACKs bring the queue below the threshold again
+	d.acked(5)
+	e := event("a")
+	assert.True(t, d.keep(e))
+	assert.NotContains(t, e.Fields, "downsampled")
+}
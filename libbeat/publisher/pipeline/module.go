@@ -94,6 +94,7 @@ func LoadWithSettings(
 	}
 
 	name := beatInfo.Name
+	settings.Backpressure = config.Backpressure
 
 	queueBuilder, err := createQueueBuilder(config.Queue, monitors)
 	if err != nil {
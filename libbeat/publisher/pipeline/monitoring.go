@@ -37,6 +37,7 @@ type pipelineObserver interface {
 type clientObserver interface {
 	newEvent()
 	filteredEvent()
+	downsampledEvent()
 	publishedEvent()
 	failedPublishEvent()
 }
@@ -68,6 +69,7 @@ type metricsObserver struct {
 
 	// events publish/dropped stats
 	events, filtered, published, failed *monitoring.Uint
+	downsampled                         *monitoring.Uint
 	dropped, retry                      *monitoring.Uint // (retryer) drop/retry counters
 	activeEvents                        *monitoring.Uint
 
@@ -85,12 +87,13 @@ func newMetricsObserver(metrics *monitoring.Registry) *metricsObserver {
 		metrics: metrics,
 		clients: monitoring.NewUint(reg, "clients"),
 
-		events:    monitoring.NewUint(reg, "events.total"),
-		filtered:  monitoring.NewUint(reg, "events.filtered"),
-		published: monitoring.NewUint(reg, "events.published"),
-		failed:    monitoring.NewUint(reg, "events.failed"),
-		dropped:   monitoring.NewUint(reg, "events.dropped"),
-		retry:     monitoring.NewUint(reg, "events.retry"),
+		events:      monitoring.NewUint(reg, "events.total"),
+		filtered:    monitoring.NewUint(reg, "events.filtered"),
+		downsampled: monitoring.NewUint(reg, "events.downsampled"),
+		published:   monitoring.NewUint(reg, "events.published"),
+		failed:      monitoring.NewUint(reg, "events.failed"),
+		dropped:     monitoring.NewUint(reg, "events.dropped"),
+		retry:       monitoring.NewUint(reg, "events.retry"),
 
 		ackedQueue: monitoring.NewUint(reg, "queue.acked"),
 
@@ -133,6 +136,12 @@ func (o *metricsObserver) filteredEvent() {
 	o.activeEvents.Dec()
 }
 
+// (client) time series event is skipped by the downsampler, it is also
+// reported as filtered
+func (o *metricsObserver) downsampledEvent() {
+	o.downsampled.Inc()
+}
+
 // (client) managed to push an event into the publisher pipeline
 func (o *metricsObserver) publishedEvent() {
 	o.published.Inc()
@@ -190,6 +199,7 @@ func (*emptyObserver) clientClosing()      {}
 func (*emptyObserver) clientClosed()       {}
 func (*emptyObserver) newEvent()           {}
 func (*emptyObserver) filteredEvent()      {}
+func (*emptyObserver) downsampledEvent()   {}
 func (*emptyObserver) publishedEvent()     {}
 func (*emptyObserver) failedPublishEvent() {}
 func (*emptyObserver) queueACKed(n int)    {}
@@ -77,6 +77,9 @@ type Pipeline struct {
 	ackBuilder ackBuilder
 	eventSema  *sema
 
+	// downsampler decimates time series events on backpressure, nil if disabled
+	downsampler *downsampler
+
 	// closeRef signal propagation support
 	guardStartSigPropagation sync.Once
 	sigNewClient             chan *client
@@ -93,6 +96,8 @@ type Settings struct {
 	WaitCloseMode WaitCloseMode
 
 	Processors processing.Supporter
+
+	Backpressure BackpressureConfig
 }
 
 // WaitCloseMode enumerates the possible behaviors of WaitClose in a pipeline.
@@ -126,9 +131,10 @@ type pipelineEventer struct {
 	mutex      sync.Mutex
 	modifyable bool
 
-	observer  queueObserver
-	waitClose *waitCloser
-	cb        *pipelineEventCB
+	observer    queueObserver
+	waitClose   *waitCloser
+	downsampler *downsampler
+	cb          *pipelineEventCB
 }
 
 type waitCloser struct {
@@ -191,6 +197,9 @@ func New(
 	}
 	p.eventSema = newSema(maxEvents)
 
+	p.downsampler = newDownsampler(settings.Backpressure.Downsample, maxEvents, monitors.Logger)
+	p.eventer.downsampler = p.downsampler
+
 	p.output = newOutputController(beat, monitors, p.observer, p.queue)
 	p.output.Set(out)
 
@@ -470,6 +479,9 @@ func (e *pipelineEventer) OnACK(n int) {
 	if wc := e.waitClose; wc != nil {
 		wc.dec(n)
 	}
+	if d := e.downsampler; d != nil {
+		d.acked(n)
+	}
 	if e.cb != nil {
 		e.cb.reportQueueACK(n)
 	}
@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package readfile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/reader"
+)
+
+const (
+	defaultOriginalField    = "event.original"
+	defaultOriginalMaxBytes = 16 * 1024
+	defaultOriginalPeriod   = time.Minute
+)
+
+// OriginalConfig holds the options of readers preserving the original lines.
+type OriginalConfig struct {
+	Enabled bool `config:"enabled"`
+
+	// Field the original line is stored in, event.original by default.
+	Field string `config:"field"`
+
+	// Compress stores the original lines gzipped and base64 encoded.
+	Compress bool `config:"compress"`
+
+	// MaxBytes is the maximum size of the stored original of an event, after
+	// compression. Originals exceeding it are not stored.
+	MaxBytes int `config:"max_bytes" validate:"min=0"`
+
+	// SourceMaxBytes is the maximum size of the originals stored for a source
+	// during SourcePeriod. 0 means no limit.
+	SourceMaxBytes int           `config:"source_max_bytes" validate:"min=0"`
+	SourcePeriod   time.Duration `config:"source_period" validate:"min=0"`
+}
+
+// OriginalReader stores the original line read in a field of the message,
+// so it is kept untouched by the readers decoding it and by processors.
+type OriginalReader struct {
+	reader reader.Reader
+	config OriginalConfig
+
+	budgetStart time.Time
+	budgetUsed  int
+
+	// now is replaced in tests.
+	now func() time.Time
+}
+
+// NewOriginalReader creates a new reader preserving the original lines.
+func NewOriginalReader(r reader.Reader, config OriginalConfig) *OriginalReader {
+	if config.Field == "" {
+		config.Field = defaultOriginalField
+	}
+	if config.MaxBytes == 0 {
+		config.MaxBytes = defaultOriginalMaxBytes
+	}
+	if config.SourcePeriod == 0 {
+		config.SourcePeriod = defaultOriginalPeriod
+	}
+	return &OriginalReader{reader: r, config: config, now: time.Now}
+}
+
+// Next returns the next line with its original, unless it exceeds the per
+// event or per source size limits. In this case the log.flags field of the
+// message is set to original_too_large or original_over_budget.
+func (r *OriginalReader) Next() (reader.Message, error) {
+	message, err := r.reader.Next()
+	if err != nil || len(message.Content) == 0 {
+		return message, err
+	}
+
+	original, err := r.encode(bytes.TrimRight(message.Content, "\r\n"))
+	if err != nil {
+		return message, err
+	}
+
+	if len(original) > r.config.MaxBytes {
+		message.AddFlagsWithKey("log.flags", "original_too_large")
+		return message, nil
+	}
+
+	if r.config.SourceMaxBytes > 0 {
+		now := r.now()
+		if now.Sub(r.budgetStart) >= r.config.SourcePeriod {
+			r.budgetStart = now
+			r.budgetUsed = 0
+		}
+		if r.budgetUsed+len(original) > r.config.SourceMaxBytes {
+			message.AddFlagsWithKey("log.flags", "original_over_budget")
+			return message, nil
+		}
+		r.budgetUsed += len(original)
+	}
+
+	fields := common.MapStr{}
+	fields.Put(r.config.Field, original)
+	message.AddFields(fields)
+	return message, nil
+}
+
+func (r *OriginalReader) encode(content []byte) (string, error) {
+	if !r.config.Compress {
+		return string(content), nil
+	}
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(content); err != nil {
+		return "", err
+	}
+	if err := w.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package readfile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOriginalReader(t *testing.T) {
+	line := `{"level": "info", "msg": "hello"}`
+	r := NewOriginalReader(&mockReader{[]byte(line)}, OriginalConfig{})
+
+	msg, err := r.Next()
+	require.NoError(t, err)
+	original, err := msg.Fields.GetValue("event.original")
+	require.NoError(t, err)
+	assert.Equal(t, line, original)
+}
+
+func TestOriginalReaderCompress(t *testing.T) {
+	line := `{"level": "info", "msg": "hello"}`
+	r := NewOriginalReader(&mockReader{[]byte(line)}, OriginalConfig{Field: "log.original", Compress: true})
+
+	msg, err := r.Next()
+	require.NoError(t, err)
+	original, err := msg.Fields.GetValue("log.original")
+	require.NoError(t, err)
+
+	compressed, err := base64.StdEncoding.DecodeString(original.(string))
+	require.NoError(t, err)
+	gz, err := gzip.NewReader(bytes.NewReader(compressed))
+	require.NoError(t, err)
+	decompressed, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+	assert.Equal(t, line, string(decompressed))
+}
+
+func TestOriginalReaderLimits(t *testing.T) {
+	now := time.Now()
+	r := NewOriginalReader(&mockReader{[]byte("0123456789")}, OriginalConfig{
+		MaxBytes:       5,
+		SourceMaxBytes: 25,
+	})
+	r.now = func() time.Time { return now }
+
+	msg, err := r.Next()
+	require.NoError(t, err)
+	assertOriginal(t, "", "original_too_large", msg.Fields.GetValue)
+
+	r.config.MaxBytes = 10
+	for i := 0; i < 2; i++ {
+		msg, err = r.Next()
+		require.NoError(t, err)
+		assertOriginal(t, "0123456789", "", msg.Fields.GetValue)
+	}
+
+	msg, err = r.Next()
+	require.NoError(t, err)
+	assertOriginal(t, "", "original_over_budget", msg.Fields.GetValue)
+
+	// The budget is renewed every period.
+	now = now.Add(time.Minute)
+	msg, err = r.Next()
+	require.NoError(t, err)
+	assertOriginal(t, "0123456789", "", msg.Fields.GetValue)
+}
+
+func assertOriginal(t *testing.T, original, flag string, get func(string) (interface{}, error)) {
+	t.Helper()
+
+	value, err := get("event.original")
+	if original == "" {
+		assert.Error(t, err)
+	} else {
+		assert.Equal(t, original, value)
+	}
+
+	flags, err := get("log.flags")
+	if flag == "" {
+		assert.Error(t, err)
+	} else {
+		assert.Equal(t, []string{flag}, flags)
+	}
+}
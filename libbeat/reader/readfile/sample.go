@@ -0,0 +1,239 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package readfile
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/reader"
+)
+
+// Severity levels the lines are sampled by.
+const (
+	LevelError   = "error"
+	LevelWarn    = "warn"
+	LevelInfo    = "info"
+	LevelDebug   = "debug"
+	LevelTrace   = "trace"
+	LevelUnknown = "unknown"
+)
+
+// SampleLevels are the severity levels lines can be sampled by.
+var SampleLevels = []string{LevelError, LevelWarn, LevelInfo, LevelDebug, LevelTrace, LevelUnknown}
+
+// sampleHeaderBytes is the size of the beginning of a line the level is
+// searched in, so words in the message are not taken for the level.
+const sampleHeaderBytes = 256
+
+// sampleEpsilon absorbs the rounding errors of the sum of the ratios.
+const sampleEpsilon = 1e-9
+
+var (
+	// E0102 15:04:05.000000, as written by glog and klog.
+	glogLevelRegexp = regexp.MustCompile(`^([IWEF])\d{4} \d{2}:\d{2}:\d{2}`)
+
+	// level=info, "level":"info", severity: INFO, lvl=warn.
+	keyLevelRegexp = regexp.MustCompile(`(?i)\b(?:level|lvl|severity|loglevel)"?\s*[=:]\s*"?([a-z]+)`)
+
+	// [error], <warn>, as written by nginx and apache.
+	bracketLevelRegexp = regexp.MustCompile(`(?i)[\[<](trace|debug|info|notice|warn|warning|error|err|crit|critical|alert|emerg|fatal)[\]>]`)
+
+	// ERROR, WARN, as written by most logging libraries.
+	wordLevelRegexp = regexp.MustCompile(`\b(TRACE|DEBUG|INFO|NOTICE|WARN|WARNING|ERROR|ERR|SEVERE|CRITICAL|CRIT|FATAL|PANIC|ALERT|EMERG|FINE|FINER|FINEST)\b`)
+
+	glogLevels = map[string]string{
+		"I": LevelInfo,
+		"W": LevelWarn,
+		"E": LevelError,
+		"F": LevelError,
+	}
+
+	levelNames = map[string]string{
+		"finest":        LevelTrace,
+		"finer":         LevelTrace,
+		"trace":         LevelTrace,
+		"fine":          LevelDebug,
+		"debug":         LevelDebug,
+		"info":          LevelInfo,
+		"informational": LevelInfo,
+		"notice":        LevelInfo,
+		"warn":          LevelWarn,
+		"warning":       LevelWarn,
+		"err":           LevelError,
+		"error":         LevelError,
+		"severe":        LevelError,
+		"crit":          LevelError,
+		"critical":      LevelError,
+		"fatal":         LevelError,
+		"panic":         LevelError,
+		"alert":         LevelError,
+		"emerg":         LevelError,
+		"emergency":     LevelError,
+	}
+
+	// Keys the level is read from in decoded JSON lines.
+	jsonLevelKeys = []string{"level", "log.level", "severity", "lvl"}
+)
+
+// SampleConfig holds the options of readers sampling lines by severity.
+type SampleConfig struct {
+	Enabled bool         `config:"enabled"`
+	Ratios  SampleRatios `config:"ratios"`
+}
+
+// SampleRatios are the ratios of lines kept for each severity level, between
+// 0, to drop all the lines, and 1, to keep all of them.
+type SampleRatios struct {
+	Error   float64 `config:"error" validate:"min=0,max=1"`
+	Warn    float64 `config:"warn" validate:"min=0,max=1"`
+	Info    float64 `config:"info" validate:"min=0,max=1"`
+	Debug   float64 `config:"debug" validate:"min=0,max=1"`
+	Trace   float64 `config:"trace" validate:"min=0,max=1"`
+	Unknown float64 `config:"unknown" validate:"min=0,max=1"`
+}
+
+// DefaultSampleConfig returns the default sampling options, that keep all
+// errors and warnings, 10% of the informational lines and 1% of the debug
+// and trace lines. Lines without a detected level are kept.
+func DefaultSampleConfig() SampleConfig {
+	return SampleConfig{
+		Ratios: SampleRatios{
+			Error:   1,
+			Warn:    1,
+			Info:    0.1,
+			Debug:   0.01,
+			Trace:   0.01,
+			Unknown: 1,
+		},
+	}
+}
+
+func (r SampleRatios) get(level string) float64 {
+	switch level {
+	case LevelError:
+		return r.Error
+	case LevelWarn:
+		return r.Warn
+	case LevelInfo:
+		return r.Info
+	case LevelDebug:
+		return r.Debug
+	case LevelTrace:
+		return r.Trace
+	default:
+		return r.Unknown
+	}
+}
+
+// SampleReader drops a share of the lines depending on their severity level.
+// The lines kept are evenly spread, e.g. with a ratio of 0.1 one line out of
+// ten is kept.
+type SampleReader struct {
+	reader  reader.Reader
+	config  SampleConfig
+	dropped func(level string)
+
+	// credits accumulate the ratio of each level, a line is kept when the
+	// credit of its level reaches one.
+	credits map[string]float64
+}
+
+// NewSampleReader creates a new reader sampling lines by severity. dropped is
+// called with the level of every line dropped, it can be nil.
+func NewSampleReader(r reader.Reader, config SampleConfig, dropped func(level string)) *SampleReader {
+	credits := map[string]float64{}
+	for _, level := range SampleLevels {
+		// The first line of each level is kept.
+		credits[level] = 1
+	}
+	return &SampleReader{reader: r, config: config, dropped: dropped, credits: credits}
+}
+
+// Next returns the next line. Lines dropped are returned without content, so
+// the offset of the source is still updated.
+func (r *SampleReader) Next() (reader.Message, error) {
+	message, err := r.reader.Next()
+	if err != nil || message.IsEmpty() {
+		return message, err
+	}
+
+	level := detectLevel(message)
+	if r.keep(level) {
+		return message, nil
+	}
+
+	if r.dropped != nil {
+		r.dropped(level)
+	}
+	message.Content = nil
+	message.Fields = nil
+	return message, nil
+}
+
+// keep returns true if the next line of the given level is kept.
+func (r *SampleReader) keep(level string) bool {
+	ratio := r.config.Ratios.get(level)
+	if ratio <= 0 {
+		return false
+	}
+
+	credit := r.credits[level]
+	keep := credit >= 1-sampleEpsilon
+	if keep {
+		credit--
+	}
+	r.credits[level] = credit + ratio
+	return keep
+}
+
+// detectLevel returns the severity level of a line, from the fields of
+// decoded JSON lines or from the beginning of the content.
+func detectLevel(message reader.Message) string {
+	if jsonFields, ok := message.Fields["json"].(common.MapStr); ok {
+		for _, key := range jsonLevelKeys {
+			if v, err := jsonFields.GetValue(key); err == nil {
+				if s, ok := v.(string); ok {
+					return normalizeLevel(s)
+				}
+			}
+		}
+	}
+
+	header := message.Content
+	if len(header) > sampleHeaderBytes {
+		header = header[:sampleHeaderBytes]
+	}
+	if m := glogLevelRegexp.FindSubmatch(header); m != nil {
+		return glogLevels[string(m[1])]
+	}
+	for _, re := range []*regexp.Regexp{keyLevelRegexp, bracketLevelRegexp, wordLevelRegexp} {
+		if m := re.FindSubmatch(header); m != nil {
+			return normalizeLevel(string(m[1]))
+		}
+	}
+	return LevelUnknown
+}
+
+func normalizeLevel(name string) string {
+	if level, ok := levelNames[strings.ToLower(name)]; ok {
+		return level
+	}
+	return LevelUnknown
+}
@@ -0,0 +1,127 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package readfile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/reader"
+)
+
+type sliceReader struct {
+	messages []reader.Message
+}
+
+func (s *sliceReader) Next() (reader.Message, error) {
+	msg := s.messages[0]
+	s.messages = s.messages[1:]
+	return msg, nil
+}
+
+func TestDetectLevel(t *testing.T) {
+	tests := map[string]string{
+		`2020-01-15 13:31:09,123 ERROR [main] Connection refused`:           LevelError,
+		`2020-01-15T13:31:09Z WARN  server.go:10 slow request`:              LevelWarn,
+		`time="2020-01-15T13:31:09Z" level=info msg="started"`:              LevelInfo,
+		`{"ts":1579095069,"level":"debug","msg":"tick"}`:                    LevelDebug,
+		`2020/01/15 13:31:09 [error] 12#12: *1 open() failed`:               LevelError,
+		`[Wed Jan 15 13:31:09 2020] [notice] Apache configured`:             LevelInfo,
+		`I0115 13:31:09.123456       1 controller.go:42] Synced`:            LevelInfo,
+		`E0115 13:31:09.123456       1 controller.go:42] Sync failed`:       LevelError,
+		`Jan 15, 2020 1:31:09 PM org.example.Main run FINEST: entering`:     LevelTrace,
+		`2020-01-15 13:31:09 TRACE entering handler`:                        LevelTrace,
+		`127.0.0.1 - - [15/Jan/2020:13:31:09 +0000] "GET / HTTP/1.1" 200 2`: LevelUnknown,
+		`2020-01-15 13:31:09 processed 10 items, no error`:                  LevelUnknown,
+	}
+
+	for line, level := range tests {
+		msg := reader.Message{Content: []byte(line), Bytes: len(line)}
+		assert.Equal(t, level, detectLevel(msg), line)
+	}
+}
+
+func TestDetectLevelJSON(t *testing.T) {
+	msg := reader.Message{
+		Bytes: 10,
+		Fields: common.MapStr{
+			"json": common.MapStr{
+				"log": common.MapStr{"level": "WARNING"},
+			},
+		},
+	}
+	assert.Equal(t, LevelWarn, detectLevel(msg))
+}
+
+func TestSampleReader(t *testing.T) {
+	var messages []reader.Message
+	for i := 0; i < 100; i++ {
+		for _, line := range []string{"ERROR failed", "INFO started", "DEBUG tick", "no level"} {
+			messages = append(messages, reader.Message{Content: []byte(line), Bytes: len(line) + 1})
+		}
+	}
+
+	dropped := map[string]int{}
+	r := NewSampleReader(&sliceReader{messages}, DefaultSampleConfig(), func(level string) {
+		dropped[level]++
+	})
+
+	kept := map[string]int{}
+	for i := 0; i < len(messages); i++ {
+		msg, err := r.Next()
+		require.NoError(t, err)
+		require.NotZero(t, msg.Bytes, "bytes of dropped lines must be kept to update the offset")
+		if msg.IsEmpty() {
+			continue
+		}
+		kept[detectLevel(msg)]++
+	}
+
+	assert.Equal(t, map[string]int{
+		LevelError:   100,
+		LevelInfo:    10,
+		LevelDebug:   1,
+		LevelUnknown: 100,
+	}, kept)
+	assert.Equal(t, map[string]int{
+		LevelInfo:  90,
+		LevelDebug: 99,
+	}, dropped)
+}
+
+func TestSampleConfigValidation(t *testing.T) {
+	config := DefaultSampleConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"ratios.info": 2,
+	}).Unpack(&config)
+	assert.Error(t, err)
+
+	config = DefaultSampleConfig()
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"enabled":      true,
+		"ratios.debug": 0,
+	}).Unpack(&config)
+	require.NoError(t, err)
+	assert.Equal(t, float64(0), config.Ratios.Debug)
+	assert.Equal(t, 0.1, config.Ratios.Info)
+}
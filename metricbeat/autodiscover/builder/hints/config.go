@@ -17,11 +17,26 @@
 
 package hints
 
-import "github.com/elastic/beats/v7/metricbeat/mb"
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+)
 
 type config struct {
-	Key      string `config:"key"`
-	Registry *mb.Register
+	Key                   string                    `config:"key"`
+	RejectUnknownHints    bool                      `config:"reject_unknown_hints"`
+	RejectInvalidConfigs  bool                      `config:"reject_invalid_configs"`
+	Templates             map[string]*common.Config `config:"templates"`
+	NodeScrapeWorkers     int                       `config:"node_scrape_workers" validate:"min=0"`
+	AllowModules          []string                  `config:"allow_modules"`
+	DenyModules           []string                  `config:"deny_modules"`
+	PrometheusAnnotations bool                      `config:"prometheus_annotations"`
+	DefaultPeriod         time.Duration             `config:"default_period" validate:"positive"`
+	DefaultTimeout        time.Duration             `config:"default_timeout" validate:"positive"`
+	SeparateMetricSets    bool                      `config:"separate_metricsets"`
+	Registry              *mb.Register
 }
 
 func defaultConfig() config {
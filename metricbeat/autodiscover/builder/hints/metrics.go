@@ -18,9 +18,15 @@
 package hints
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/elastic/go-ucfg"
 
@@ -30,6 +36,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/common/bus"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
@@ -37,25 +44,100 @@ func init() {
 	autodiscover.Registry.AddBuilder("hints", NewMetricHints)
 }
 
+var (
+	hintsMetrics = monitoring.Default.NewRegistry("metricbeat.autodiscover.hints")
+	unknownHints = monitoring.NewInt(hintsMetrics, "unknown")
+	deniedHints  = monitoring.NewInt(hintsMetrics, "denied")
+	invalidHints = monitoring.NewInt(hintsMetrics, "invalid")
+
+	generatedConfigs    = monitoring.NewInt(hintsMetrics, "configs.generated")
+	rawConfigs          = monitoring.NewInt(hintsMetrics, "configs.raw")
+	hostMismatchConfigs = monitoring.NewInt(hintsMetrics, "configs.rejected.host_mismatch")
+	unparsableConfigs   = monitoring.NewInt(hintsMetrics, "configs.rejected.unparsable")
+
+	// namedPorts matches references to named ports like ${data.ports.metrics}
+	// or ${data.ports[metrics]}, with an optional default port
+	namedPorts = regexp.MustCompile(`\$\{data\.ports(?:\.([^}:\[\]]+)|\[([^}\]]+)\])(?::([^}]*))?\}`)
+
+	// hostFunctions matches the host with a helper function like ${data.host|ipv4}
+	hostFunctions = regexp.MustCompile(`\$\{data\.host\|([^}]*)\}`)
+)
+
 const (
 	module      = "module"
 	namespace   = "namespace"
 	hosts       = "hosts"
 	metricsets  = "metricsets"
+	exclude     = "exclude"
 	period      = "period"
 	timeout     = "timeout"
 	ssl         = "ssl"
 	metricspath = "metrics_path"
+	basepath    = "basepath"
 	username    = "username"
 	password    = "password"
+	bearerToken = "bearer_token_file"
+	apiKey      = "api_key"
+	query       = "query"
+	fields      = "fields"
+	tags        = "tags"
+	index       = "index"
+	pipeline    = "pipeline"
+	tmpl        = "template"
+	enabled     = "enabled"
+	leaderOnly  = "leader_only"
+	ports       = "ports"
+	passthrough = "config"
+
+	fieldsUnderRoot = "fields_under_root"
 
 	defaultTimeout = "3s"
 	defaultPeriod  = "1m"
 )
 
+// knownHints are the hints understood by the builder, besides the metricsets
+// hint and the hints of specific metricsets.
+var knownHints = map[string]bool{
+	module:          true,
+	namespace:       true,
+	hosts:           true,
+	period:          true,
+	timeout:         true,
+	ssl:             true,
+	metricspath:     true,
+	basepath:        true,
+	username:        true,
+	password:        true,
+	bearerToken:     true,
+	apiKey:          true,
+	query:           true,
+	fields:          true,
+	fieldsUnderRoot: true,
+	tags:            true,
+	index:           true,
+	pipeline:        true,
+	tmpl:            true,
+	enabled:         true,
+	leaderOnly:      true,
+	ports:           true,
+	passthrough:     true,
+	"processors":    true,
+	"raw":           true,
+}
+
 type metricHints struct {
-	Key      string
-	Registry *mb.Register
+	Key                   string
+	RejectUnknownHints    bool
+	RejectInvalidConfigs  bool
+	Templates             map[string]*common.Config
+	NodeScrapeWorkers     int
+	AllowModules          []string
+	DenyModules           []string
+	PrometheusAnnotations bool
+	DefaultPeriod         string
+	DefaultTimeout        string
+	SeparateMetricSets    bool
+	Registry              *mb.Register
 }
 
 // NewMetricHints builds a new metrics builder based on hints
@@ -67,58 +149,139 @@ func NewMetricHints(cfg *common.Config) (autodiscover.Builder, error) {
 		return nil, fmt.Errorf("unable to unpack hints config due to error: %v", err)
 	}
 
-	return &metricHints{config.Key, config.Registry}, nil
+	m := &metricHints{
+		Key:                   config.Key,
+		RejectUnknownHints:    config.RejectUnknownHints,
+		RejectInvalidConfigs:  config.RejectInvalidConfigs,
+		Templates:             config.Templates,
+		NodeScrapeWorkers:     config.NodeScrapeWorkers,
+		AllowModules:          config.AllowModules,
+		DenyModules:           config.DenyModules,
+		PrometheusAnnotations: config.PrometheusAnnotations,
+		SeparateMetricSets:    config.SeparateMetricSets,
+		Registry:              config.Registry,
+	}
+	if config.DefaultPeriod > 0 {
+		m.DefaultPeriod = config.DefaultPeriod.String()
+	}
+	if config.DefaultTimeout > 0 {
+		m.DefaultTimeout = config.DefaultTimeout.String()
+	}
+	return m, nil
 }
 
 // Create configs based on hints passed from providers
 func (m *metricHints) CreateConfig(event bus.Event, options ...ucfg.Option) []*common.Config {
 	var config []*common.Config
 	host, _ := event["host"].(string)
-	if host == "" {
-		return config
-	}
-
 	port, _ := common.TryToInt(event["port"])
 
 	hints, ok := event["hints"].(common.MapStr)
+	if m.PrometheusAnnotations {
+		hints, ok = m.addPrometheusHints(hints, event, port)
+	}
 	if !ok {
 		return config
 	}
 
+	// Events without host, like the ones of some services, can only use
+	// hosts given explicitly in the hints
+	if host == "" && !m.hasExplicitHosts(hints) {
+		return config
+	}
+
+	// If explicitly disabled, return nothing
+	if builder.IsDisabled(hints, m.Key) {
+		logp.Debug("hints.builder", "metrics disabled by hint: %+v", redact(event))
+		return config
+	}
+
+	// Configs for the leader only are not generated if the provider is not the leader
+	if m.isLeaderOnly(hints) {
+		leader, found := event["leader"].(bool)
+		if !found {
+			logp.Debug("hints.builder", "leader_only hint ignored, leader election is not enabled in the provider: %+v", redact(event))
+		} else if !leader {
+			logp.Debug("hints.builder", "config only started by the leader: %+v", redact(event))
+			return config
+		}
+	}
+
+	if unknown := m.getUnknownHints(hints); len(unknown) != 0 {
+		unknownHints.Add(int64(len(unknown)))
+		if m.RejectUnknownHints {
+			logp.Warn("hints.builder: ignoring hints with unknown keys %v, check them for typos", unknown)
+			return config
+		}
+		logp.Warn("hints.builder: unknown hint keys %v are ignored, check them for typos", unknown)
+	}
+
 	modulesConfig := m.getModules(hints)
+	if modulesConfig == nil && builder.GetHintString(hints, m.Key, "raw") != "" {
+		unparsableConfigs.Inc()
+		logp.Warn("hints.builder: ignoring raw hint, it cannot be parsed as a JSON config or list of configs")
+	}
 	// here we handle raw configs if provided
 	if modulesConfig != nil {
 		configs := []*common.Config{}
 		for _, cfg := range modulesConfig {
-			if config, err := common.NewConfigFrom(cfg); err == nil {
-				configs = append(configs, config)
+			mod, _ := cfg["module"].(string)
+			if !m.isModuleAllowed(mod) {
+				continue
+			}
+			config, err := common.NewConfigFrom(cfg)
+			if err != nil {
+				unparsableConfigs.Inc()
+				logp.Debug("hints.builder", "raw config failed with error: %v", err)
+				continue
 			}
+			rawConfigs.Inc()
+			configs = append(configs, config)
 		}
-		logp.Debug("hints.builder", "generated config %+v", configs)
+		logp.Debug("hints.builder", "generated config %s", debugConfigs(configs))
 		// Apply information in event to the template to generate the final config
-		return template.ApplyConfigTemplate(event, configs, options...)
+		return m.validateConfigs(template.ApplyConfigTemplate(event, configs, options...))
 
 	}
 
-	mod := m.getModule(hints)
-	if mod == "" {
-		return config
+	// here we handle named templates defined in the builder config
+	if name := m.getTemplate(hints); name != "" {
+		cfg := m.getTemplateConfig(name, host, port)
+		if cfg == nil {
+			return config
+		}
+		logp.Debug("hints.builder", "generated config from template %s: %s", name, debugConfig(cfg))
+		return m.validateConfigs(template.ApplyConfigTemplate(event, []*common.Config{cfg}, options...))
 	}
 
-	hosts, ok := m.getHostsWithPort(hints, port)
-	if !ok {
+	mod := m.getModule(hints)
+	if mod == "" || !m.isModuleAllowed(mod) {
 		return config
 	}
 
+	hosts, hostsMatch := m.getHostsWithPort(hints, port, event)
+
 	ns := m.getNamespace(hints)
 	msets := m.getMetricSets(hints, mod)
+	if len(msets) == 0 {
+		logp.Debug("hints.builder", "no metricsets selected for module %s with hints: %+v", mod, redact(hints))
+		return config
+	}
 	tout := m.getTimeout(hints)
 	ival := m.getPeriod(hints)
 	sslConf := m.getSSLConfig(hints)
 	procs := m.getProcessors(hints)
 	metricspath := m.getMetricPath(hints)
+	basepath := m.getBasePath(hints)
 	username := m.getUsername(hints)
 	password := m.getPassword(hints)
+	bearerTokenFile := m.getBearerTokenFile(hints)
+	key := m.getAPIKey(hints)
+	queryParams := m.getQuery(hints)
+	fieldsMap := m.getFields(hints)
+	tagsList := m.getTags(hints)
+	idx := m.getIndex(hints)
+	pipelineID := m.getPipeline(hints)
 
 	moduleConfig := common.MapStr{
 		"module":     mod,
@@ -137,27 +300,385 @@ func (m *metricHints) CreateConfig(event bus.Event, options ...ucfg.Option) []*c
 	if metricspath != "" {
 		moduleConfig["metrics_path"] = metricspath
 	}
+	if basepath != "" {
+		moduleConfig["basepath"] = basepath
+	}
 	if username != "" {
 		moduleConfig["username"] = username
 	}
 	if password != "" {
 		moduleConfig["password"] = password
 	}
+	if bearerTokenFile != "" {
+		moduleConfig["bearer_token_file"] = bearerTokenFile
+	}
+	if key != "" {
+		moduleConfig["api_key"] = key
+	}
+	if len(queryParams) != 0 {
+		moduleConfig["query"] = queryParams
+	}
+	if idx != "" {
+		moduleConfig["index"] = idx
+	}
+	if pipelineID != "" {
+		moduleConfig["pipeline"] = pipelineID
+	}
+	if len(fieldsMap) != 0 {
+		moduleConfig["fields"] = fieldsMap
+		if underRoot, ok := m.getFieldsUnderRoot(hints); ok {
+			moduleConfig["fields_under_root"] = underRoot
+		}
+	}
+	if len(tagsList) != 0 {
+		moduleConfig["tags"] = tagsList
+	}
 
-	logp.Debug("hints.builder", "generated config: %v", moduleConfig)
+	if pool := m.getScrapePool(mod, event); pool != nil {
+		moduleConfig["scrape_pool"] = pool
+	}
 
-	// Create config object
-	cfg, err := common.NewConfigFrom(moduleConfig)
-	if err != nil {
-		logp.Debug("hints.builder", "config merge failed with error: %v", err)
+	// Settings given by other hints take precedence
+	moduleConfig.DeepUpdateNoOverwrite(m.getPassthroughConfig(hints))
+
+	// Metricsets with their own period or hosts hints, or all of them with
+	// separate_metricsets, get a config of their own
+	for _, moduleConfig := range m.getMetricSetConfigs(hints, moduleConfig, msets, port, event, hostsMatch) {
+		// Create config object
+		cfg, err := common.NewConfigFrom(moduleConfig)
+		if err != nil {
+			unparsableConfigs.Inc()
+			logp.Debug("hints.builder", "config merge failed with error: %v", err)
+			continue
+		}
+		logp.Debug("hints.builder", "generated config: %s", debugConfig(cfg))
+		config = append(config, cfg)
 	}
-	logp.Debug("hints.builder", "generated config: %+v", common.DebugString(cfg, true))
-	config = append(config, cfg)
 
 	// Apply information in event to the template to generate the final config
 	// This especially helps in a scenario where endpoints are configured as:
 	// co.elastic.metrics/hosts= "${data.host}:9090"
-	return template.ApplyConfigTemplate(event, config, options...)
+	return m.validateConfigs(template.ApplyConfigTemplate(event, config, options...))
+}
+
+// validateConfigs drops the configs that would start modules failing right
+// away, like configs missing the hosts or credentials required by the module,
+// when invalid configs are rejected. It counts the configs finally generated.
+func (m *metricHints) validateConfigs(configs []*common.Config) []*common.Config {
+	for _, cfg := range configs {
+		normalizeHosts(cfg)
+	}
+
+	if !m.RejectInvalidConfigs {
+		generatedConfigs.Add(int64(len(configs)))
+		return configs
+	}
+
+	var valid []*common.Config
+	for _, cfg := range configs {
+		if err := m.validateConfig(cfg); err != nil {
+			invalidHints.Inc()
+			mod, _ := cfg.String("module", -1)
+			logp.Err("hints.builder: ignoring config generated from hints for module %q, it is not valid: %v. "+
+				"Check that the hints give all the settings required by the module", mod, err)
+			continue
+		}
+		valid = append(valid, cfg)
+	}
+	generatedConfigs.Add(int64(len(valid)))
+	return valid
+}
+
+// normalizeHosts normalizes the casing of the scheme and host of the hosts of
+// a config once its variables are resolved, and removes the duplicated ones,
+// so a host given both as `${data.host}:9090` and by its IP is not collected
+// twice.
+func normalizeHosts(cfg *common.Config) {
+	count, err := cfg.CountField("hosts")
+	if err != nil {
+		return
+	}
+
+	var hosts []string
+	seen := make(map[string]bool, count)
+	for i := 0; i < count; i++ {
+		host, err := cfg.String("hosts", i)
+		if err != nil {
+			return
+		}
+		host = normalizeHost(host)
+		if seen[host] {
+			continue
+		}
+		seen[host] = true
+		hosts = append(hosts, host)
+	}
+
+	if len(hosts) < count {
+		logp.Debug("hints.builder", "removed %d duplicated hosts from generated config", count-len(hosts))
+	}
+	if _, err := cfg.Remove("hosts", -1); err != nil {
+		return
+	}
+	for i, host := range hosts {
+		cfg.SetString("hosts", i, host)
+	}
+}
+
+// normalizeHost lowercases the scheme and the host name of URLs, leaving the
+// credentials, the path and any other kind of host, like key/value DSNs, as
+// they are.
+func normalizeHost(host string) string {
+	host = strings.TrimSpace(host)
+
+	u, err := url.Parse(host)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return host
+	}
+	i := strings.Index(host, "://")
+	if i < 0 {
+		return host
+	}
+	scheme, rest := host[:i+3], host[i+3:]
+
+	authority := rest
+	if end := strings.IndexAny(rest, "/?#"); end >= 0 {
+		authority = rest[:end]
+	}
+	rest = rest[len(authority):]
+
+	var userinfo string
+	if i := strings.LastIndex(authority, "@"); i >= 0 {
+		userinfo, authority = authority[:i+1], authority[i+1:]
+	}
+	return strings.ToLower(scheme) + userinfo + strings.ToLower(authority) + rest
+}
+
+// validateConfig checks the config against the module and its metricsets, by
+// building them as they would be built when the config is started.
+func (m *metricHints) validateConfig(cfg *common.Config) error {
+	_, metricsets, err := mb.NewModule(cfg, m.Registry)
+	for _, ms := range metricsets {
+		if closer, ok := ms.(mb.Closer); ok {
+			closer.Close()
+		}
+	}
+	if err == mb.ErrModuleDisabled {
+		return nil
+	}
+	return err
+}
+
+// isModuleAllowed checks the module against the allowed and denied modules of
+// the builder, so operators can restrict the modules enabled with hints.
+func (m *metricHints) isModuleAllowed(mod string) bool {
+	allowed := len(m.AllowModules) == 0
+	for _, allow := range m.AllowModules {
+		if allow == mod {
+			allowed = true
+			break
+		}
+	}
+	for _, deny := range m.DenyModules {
+		if deny == mod {
+			allowed = false
+			break
+		}
+	}
+
+	if !allowed {
+		deniedHints.Inc()
+		logp.Warn("hints.builder: ignoring hints for module %q, it is not allowed by the builder configuration", mod)
+	}
+	return allowed
+}
+
+// addPrometheusHints translates the standard prometheus.io annotations of the
+// Pod into hints for the prometheus module. Hints set with annotations of the
+// builder take precedence, and no hints are added if they enable another module.
+func (m *metricHints) addPrometheusHints(hints common.MapStr, event bus.Event, port int) (common.MapStr, bool) {
+	var annotations common.MapStr
+	if kubeMeta, ok := event["kubernetes"].(common.MapStr); ok {
+		annotations, _ = kubeMeta["annotations"].(common.MapStr)
+	}
+	annotation := func(name string) string {
+		value, _ := annotations.GetValue("prometheus.io/" + name)
+		s, _ := value.(string)
+		return strings.TrimSpace(s)
+	}
+	if scrape, _ := strconv.ParseBool(annotation("scrape")); !scrape {
+		return hints, hints != nil
+	}
+	if mod := builder.GetHintString(hints, m.Key, module); mod != "" && mod != "prometheus" {
+		return hints, true
+	}
+
+	// Without the port annotation all the ports of the container are scraped.
+	host := "${data.host}:" + annotation("port")
+	if annotation("port") == "" {
+		if port == 0 {
+			return hints, hints != nil
+		}
+		host = "${data.host}:${data.port}"
+	}
+	if scheme := annotation("scheme"); scheme != "" {
+		host = scheme + "://" + host
+	}
+	promHints := common.MapStr{
+		module: "prometheus",
+		hosts:  host,
+	}
+	if path := annotation("path"); path != "" {
+		promHints[metricspath] = path
+	}
+
+	result := common.MapStr{}
+	if hints != nil {
+		result = hints.Clone()
+	}
+	if current, ok := result[m.Key].(common.MapStr); ok {
+		current.DeepUpdateNoOverwrite(promHints)
+	} else {
+		result[m.Key] = promHints
+	}
+	return result, true
+}
+
+// getScrapePool returns the scrape pool shared by the prometheus instances
+// of the same node, so they reuse connections and scrape workers.
+func (m *metricHints) getScrapePool(mod string, event bus.Event) common.MapStr {
+	if mod != "prometheus" {
+		return nil
+	}
+
+	node, _ := common.MapStr(event).GetValue("kubernetes.node.name")
+	if name, _ := node.(string); name != "" {
+		pool := common.MapStr{"name": name}
+		if m.NodeScrapeWorkers > 0 {
+			pool["workers"] = m.NodeScrapeWorkers
+		}
+		return pool
+	}
+	return nil
+}
+
+// getPassthroughConfig returns the settings given in the config.* hints, to be
+// passed as is to the module config. Their values are coerced to the type they
+// look like, as annotations can only contain strings.
+func (m *metricHints) getPassthroughConfig(hints common.MapStr) common.MapStr {
+	settings := builder.GetHintMapStr(hints, m.Key, passthrough)
+	if len(settings) == 0 {
+		return nil
+	}
+
+	config := common.MapStr{}
+	for k, v := range settings.Flatten() {
+		if str, ok := v.(string); ok {
+			v = coerceHintValue(str)
+		}
+		config.Put(k, v)
+	}
+	return config
+}
+
+// coerceHintValue converts a hint value to a boolean, a number or a JSON
+// list or object if it can be parsed as such. Other values are kept as strings.
+func coerceHintValue(value string) interface{} {
+	trimmed := strings.TrimSpace(value)
+	switch trimmed {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	if i, err := strconv.ParseInt(trimmed, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return f
+	}
+	if strings.HasPrefix(trimmed, "[") || strings.HasPrefix(trimmed, "{") {
+		var parsed interface{}
+		if err := json.Unmarshal([]byte(trimmed), &parsed); err == nil {
+			return parsed
+		}
+	}
+	return value
+}
+
+// getUnknownHints returns the keys of the hints that are not known by the builder.
+func (m *metricHints) getUnknownHints(hints common.MapStr) []string {
+	raw := builder.GetHintMapStr(hints, m.Key, "")
+	msets := m.Registry.MetricSets(builder.GetHintString(hints, m.Key, module))
+
+	var unknown []string
+	for key, value := range raw {
+		switch {
+		case knownHints[key]:
+		case key == metricsets:
+			// metricsets can be a list or have the exclude hint
+			if sub, ok := value.(common.MapStr); ok {
+				for subKey := range sub {
+					if subKey != exclude {
+						unknown = append(unknown, key+"."+subKey)
+					}
+				}
+			}
+		case stringInSlice(key, msets):
+			// per metricset hints
+			sub, ok := value.(common.MapStr)
+			if !ok {
+				unknown = append(unknown, key)
+			}
+			for subKey := range sub {
+				if subKey != period && subKey != hosts {
+					unknown = append(unknown, key+"."+subKey)
+				}
+			}
+		default:
+			unknown = append(unknown, key)
+		}
+	}
+
+	sort.Strings(unknown)
+	return unknown
+}
+
+func (m *metricHints) isLeaderOnly(hints common.MapStr) bool {
+	leader, _ := strconv.ParseBool(builder.GetHintString(hints, m.Key, leaderOnly))
+	return leader
+}
+
+func (m *metricHints) getTemplate(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, tmpl)
+}
+
+// getTemplateConfig returns a copy of the named template, using the host and
+// port of the event as hosts if the template doesn't define them.
+func (m *metricHints) getTemplateConfig(name, host string, port int) *common.Config {
+	tpl, ok := m.Templates[name]
+	if !ok {
+		logp.Warn("hints.builder: unknown template %s in hints", name)
+		return nil
+	}
+
+	cfg, err := common.MergeConfigs(tpl)
+	if err != nil {
+		logp.Debug("hints.builder", "config merge failed with error: %v", err)
+		return nil
+	}
+
+	if !cfg.HasField(hosts) {
+		if port != 0 {
+			host = fmt.Sprintf("%s:%d", host, port)
+		}
+		if err := cfg.Merge(common.MapStr{hosts: []string{host}}); err != nil {
+			logp.Debug("hints.builder", "config merge failed with error: %v", err)
+			return nil
+		}
+	}
+
+	return cfg
 }
 
 func (m *metricHints) getModule(hints common.MapStr) string {
@@ -178,19 +699,180 @@ func (m *metricHints) getMetricSets(hints common.MapStr, module string) []string
 		}
 	}
 
-	return msets
-}
+	// Drop the metricsets explicitly excluded
+	excluded := builder.GetHintAsList(hints, m.Key, metricsets+"."+exclude)
+	if len(excluded) == 0 {
+		return msets
+	}
 
-func (m *metricHints) getHostsWithPort(hints common.MapStr, port int) ([]string, bool) {
 	var result []string
+	for _, mset := range msets {
+		if !stringInSlice(mset, excluded) {
+			result = append(result, mset)
+		}
+	}
+
+	return result
+}
+
+func stringInSlice(str string, list []string) bool {
+	for _, s := range list {
+		if s == str {
+			return true
+		}
+	}
+	return false
+}
+
+// hasExplicitHosts returns true if the hosts hint gives hosts that don't depend
+// on the host of the event, like the endpoint of a cluster level service.
+func (m *metricHints) hasExplicitHosts(hints common.MapStr) bool {
+	thosts := builder.GetHintAsList(hints, m.Key, hosts)
+	for _, h := range thosts {
+		if strings.Contains(h, "data.host") {
+			return false
+		}
+	}
+	return len(thosts) > 0
+}
+
+func (m *metricHints) getHostsWithPort(hints common.MapStr, port int, event bus.Event) ([]string, bool) {
 	thosts := builder.GetHintAsList(hints, m.Key, hosts)
+	if len(thosts) == 0 {
+		eventPorts, _ := event["ports"].(common.MapStr)
+		return m.getHostsWithPorts(hints, port, eventPorts)
+	}
+	return m.filterHostsWithPort(thosts, port, event)
+}
+
+// resolveHost replaces the references to named ports in the host with the port
+// numbers of the container, and the host helper functions with the address they
+// select, so the host can be matched against the port of the event. It returns
+// false if the host refers to unknown ports or to addresses the event doesn't
+// have.
+func (m *metricHints) resolveHost(h string, event bus.Event) (string, bool) {
+	eventPorts, _ := event["ports"].(common.MapStr)
+
+	resolved := true
+	h = namedPorts.ReplaceAllStringFunc(h, func(ref string) string {
+		match := namedPorts.FindStringSubmatch(ref)
+		name := match[1]
+		if name == "" {
+			name = match[2]
+		}
+		if number, ok := common.TryToInt(eventPorts[name]); ok {
+			return strconv.Itoa(number)
+		}
+		if match[3] != "" {
+			return match[3]
+		}
+		logp.Debug("hints.builder", "host %s refers to ports not found in the container ports: %+v", h, eventPorts)
+		resolved = false
+		return ref
+	})
+
+	h = hostFunctions.ReplaceAllStringFunc(h, func(ref string) string {
+		function := hostFunctions.FindStringSubmatch(ref)[1]
+		address, err := hostAddress(event, function)
+		if err != nil {
+			logp.Debug("hints.builder", "host %s can't be resolved: %v", h, err)
+			resolved = false
+			return ref
+		}
+		return address
+	})
+
+	return h, resolved
+}
+
+// hostAddress returns the first address of the event of the family selected by
+// the function, ipv4 or ipv6. IPv6 addresses are enclosed in brackets, so a
+// port can be appended to them.
+func hostAddress(event bus.Event, function string) (string, error) {
+	var addresses []string
+	switch ips := event["ips"].(type) {
+	case []string:
+		addresses = append(addresses, ips...)
+	case []interface{}:
+		for _, ip := range ips {
+			if s, ok := ip.(string); ok {
+				addresses = append(addresses, s)
+			}
+		}
+	}
+	if host, ok := event["host"].(string); ok {
+		addresses = append(addresses, host)
+	}
+
+	for _, address := range addresses {
+		ip := net.ParseIP(address)
+		if ip == nil {
+			continue
+		}
+		switch function {
+		case "ipv4":
+			if ip.To4() != nil {
+				return ip.String(), nil
+			}
+		case "ipv6":
+			if ip.To4() == nil {
+				return "[" + ip.String() + "]", nil
+			}
+		default:
+			return "", fmt.Errorf("unknown host function %q, valid functions are ipv4 and ipv6", function)
+		}
+	}
+	return "", fmt.Errorf("no %s address in %v", function, addresses)
+}
+
+// getHostsWithPorts returns a host for each port in the ports hint, ports can be
+// numbers or names of the container ports. Only the event of the first port, or
+// the event without port, gets the hosts, so containers exposing several of the
+// ports don't get duplicated configs.
+func (m *metricHints) getHostsWithPorts(hints common.MapStr, port int, eventPorts common.MapStr) ([]string, bool) {
+	hintPorts := builder.GetHintAsList(hints, m.Key, ports)
+	if len(hintPorts) == 0 {
+		return nil, true
+	}
+
+	var numbers []int
+	for _, p := range hintPorts {
+		number, ok := common.TryToInt(p)
+		if !ok {
+			number, ok = common.TryToInt(eventPorts[p])
+		}
+		if !ok {
+			logp.Debug("hints.builder", "port %s of the ports hint not found in the container ports: %+v", p, eventPorts)
+			continue
+		}
+		numbers = append(numbers, number)
+	}
+
+	if len(numbers) == 0 || (port != 0 && port != numbers[0]) {
+		return nil, false
+	}
+
+	result := make([]string, len(numbers))
+	for i, number := range numbers {
+		result[i] = fmt.Sprintf("${data.host}:%d", number)
+	}
+	return result, true
+}
+
+func (m *metricHints) filterHostsWithPort(thosts []string, port int, event bus.Event) ([]string, bool) {
+	var result []string
 
 	// Only pick hosts that have ${data.port} or the port on current event. This will make
 	// sure that incorrect meta mapping doesn't happen
-	for _, h := range thosts {
+	for _, th := range thosts {
+		h, ok := m.resolveHost(th, event)
+		if !ok {
+			continue
+		}
 		if strings.Contains(h, "data.port") || m.checkHostPort(h, port) ||
-			// Use the event that has no port config if there is a ${data.host}:9090 like input
-			(port == 0 && strings.Contains(h, "data.host")) {
+			// Use the event that has no port config if there is a ${data.host}:9090 like input,
+			// or an explicit host, like in the events of nodes
+			(port == 0 && (strings.Contains(th, "data.host") || !strings.Contains(th, "${data."))) {
 			result = append(result, h)
 		}
 	}
@@ -203,6 +885,56 @@ func (m *metricHints) getHostsWithPort(hints common.MapStr, port int) ([]string,
 	return result, true
 }
 
+// getMetricSetConfigs splits the module config into one config per metricset having
+// its own period or hosts hints, plus a combined config for the rest of metricsets.
+// With separate_metricsets, every metricset gets a config of its own.
+// Configs whose hosts don't match the port of the event are discarded.
+func (m *metricHints) getMetricSetConfigs(hints, moduleConfig common.MapStr, msets []string, port int, event bus.Event, hostsMatch bool) []common.MapStr {
+	var configs []common.MapStr
+	var rest []string
+	for _, mset := range msets {
+		ival := builder.GetHintString(hints, m.Key, mset+"."+period)
+		if ival != "" && !validDurationHint(mset+"."+period, ival) {
+			ival = ""
+		}
+		thosts := builder.GetHintAsList(hints, m.Key, mset+"."+hosts)
+		if ival == "" && len(thosts) == 0 && !m.SeparateMetricSets {
+			rest = append(rest, mset)
+			continue
+		}
+
+		cfg := moduleConfig.Clone()
+		cfg[metricsets] = []string{mset}
+		if ival != "" {
+			cfg[period] = ival
+		}
+		if len(thosts) != 0 {
+			msetHosts, ok := m.filterHostsWithPort(thosts, port, event)
+			if !ok {
+				hostMismatchConfigs.Inc()
+				continue
+			}
+			cfg[hosts] = msetHosts
+		} else if !hostsMatch {
+			hostMismatchConfigs.Inc()
+			continue
+		}
+		configs = append(configs, cfg)
+	}
+
+	if len(rest) != 0 {
+		if !hostsMatch {
+			hostMismatchConfigs.Inc()
+			return configs
+		}
+		cfg := moduleConfig.Clone()
+		cfg[metricsets] = rest
+		configs = append([]common.MapStr{cfg}, configs...)
+	}
+
+	return configs
+}
+
 func (m *metricHints) checkHostPort(h string, p int) bool {
 	port := strconv.Itoa(p)
 
@@ -232,6 +964,10 @@ func (m *metricHints) getMetricPath(hints common.MapStr) string {
 	return builder.GetHintString(hints, m.Key, metricspath)
 }
 
+func (m *metricHints) getBasePath(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, basepath)
+}
+
 func (m *metricHints) getUsername(hints common.MapStr) string {
 	return builder.GetHintString(hints, m.Key, username)
 }
@@ -240,23 +976,181 @@ func (m *metricHints) getPassword(hints common.MapStr) string {
 	return builder.GetHintString(hints, m.Key, password)
 }
 
+func (m *metricHints) getBearerTokenFile(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, bearerToken)
+}
+
+func (m *metricHints) getAPIKey(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, apiKey)
+}
+
+// getQuery returns the query parameters hint, given either as a map or in the
+// `key=value,key2=value2` shorthand. Repeated keys are collected in a list.
+func (m *metricHints) getQuery(hints common.MapStr) common.MapStr {
+	if params := builder.GetHintMapStr(hints, m.Key, query); len(params) != 0 {
+		return params
+	}
+
+	params := common.MapStr{}
+	for _, param := range builder.GetHintAsList(hints, m.Key, query) {
+		parts := strings.SplitN(param, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if key == "" {
+			continue
+		}
+		value := ""
+		if len(parts) == 2 {
+			value = strings.TrimSpace(parts[1])
+		}
+
+		switch current := params[key].(type) {
+		case nil:
+			params[key] = value
+		case string:
+			params[key] = []interface{}{current, value}
+		case []interface{}:
+			params[key] = append(current, value)
+		}
+	}
+
+	return params
+}
+
+func (m *metricHints) getIndex(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, index)
+}
+
+func (m *metricHints) getPipeline(hints common.MapStr) string {
+	return builder.GetHintString(hints, m.Key, pipeline)
+}
+
+func (m *metricHints) getFields(hints common.MapStr) common.MapStr {
+	return builder.GetHintMapStr(hints, m.Key, fields)
+}
+
+func (m *metricHints) getTags(hints common.MapStr) []string {
+	var list []string
+	for _, tag := range builder.GetHintAsList(hints, m.Key, tags) {
+		if tag != "" {
+			list = append(list, tag)
+		}
+	}
+	return list
+}
+
+func (m *metricHints) getFieldsUnderRoot(hints common.MapStr) (bool, bool) {
+	str := builder.GetHintString(hints, m.Key, fieldsUnderRoot)
+	if str == "" {
+		return false, false
+	}
+
+	underRoot, err := strconv.ParseBool(str)
+	if err != nil {
+		logp.Debug("hints.builder", "unable to parse %s hint '%s': %v", fieldsUnderRoot, str, err)
+		return false, false
+	}
+	return underRoot, true
+}
+
 func (m *metricHints) getPeriod(hints common.MapStr) string {
-	if ival := builder.GetHintString(hints, m.Key, period); ival != "" {
+	if ival := builder.GetHintString(hints, m.Key, period); ival != "" && validDurationHint(period, ival) {
 		return ival
 	}
-
+	if m.DefaultPeriod != "" {
+		return m.DefaultPeriod
+	}
 	return defaultPeriod
 }
 
 func (m *metricHints) getTimeout(hints common.MapStr) string {
-	if tout := builder.GetHintString(hints, m.Key, timeout); tout != "" {
+	if tout := builder.GetHintString(hints, m.Key, timeout); tout != "" && validDurationHint(timeout, tout) {
 		return tout
 	}
+	if m.DefaultTimeout != "" {
+		return m.DefaultTimeout
+	}
 	return defaultTimeout
 }
 
+// validDurationHint checks that the value of a duration hint can be parsed,
+// so invalid values like `60` or `1 minute` don't end up in the module config.
+func validDurationHint(name, value string) bool {
+	if d, err := time.ParseDuration(value); err != nil || d <= 0 {
+		logp.Warn("hints.builder: ignoring invalid %s hint '%s', it must be a positive duration like '10s' or '1m', using the default", name, value)
+		return false
+	}
+	return true
+}
+
+// sslListSettings are the SSL settings that are lists, they can be given in
+// hints as comma separated values or as JSON lists.
+var sslListSettings = map[string]bool{
+	"certificate_authorities": true,
+	"supported_protocols":     true,
+	"cipher_suites":           true,
+	"curve_types":             true,
+	"ca_sha256":               true,
+}
+
+// getSSLConfig returns the SSL settings given as nested hints, like
+// co.elastic.metrics/ssl.verification_mode, or as a JSON object in the ssl
+// hint, converting the values of list and boolean settings.
 func (m *metricHints) getSSLConfig(hints common.MapStr) common.MapStr {
-	return builder.GetHintMapStr(hints, m.Key, ssl)
+	raw, err := hints.GetValue(m.Key + "." + ssl)
+	if err != nil {
+		return nil
+	}
+
+	var settings common.MapStr
+	switch v := raw.(type) {
+	case common.MapStr:
+		settings = v.Flatten()
+	case string:
+		decoded := common.MapStr{}
+		if err := json.Unmarshal([]byte(v), &decoded); err != nil {
+			logp.Warn("hints.builder: ignoring ssl hint, it cannot be parsed as a JSON object: %v", err)
+			return nil
+		}
+		settings = decoded.Flatten()
+	default:
+		return nil
+	}
+
+	sslConfig := common.MapStr{}
+	for key, value := range settings {
+		if str, ok := value.(string); ok {
+			value = coerceSSLSetting(key, str)
+		}
+		sslConfig.Put(key, value)
+	}
+	return sslConfig
+}
+
+// coerceSSLSetting converts the value of a list or boolean SSL setting given
+// as a string, other settings are kept as strings.
+func coerceSSLSetting(key, value string) interface{} {
+	trimmed := strings.TrimSpace(value)
+	switch {
+	case sslListSettings[key]:
+		if strings.HasPrefix(trimmed, "[") {
+			var list []interface{}
+			if err := json.Unmarshal([]byte(trimmed), &list); err == nil {
+				return list
+			}
+		}
+		list := []string{}
+		for _, item := range strings.Split(trimmed, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				list = append(list, item)
+			}
+		}
+		return list
+	case key == "enabled":
+		if enabled, err := strconv.ParseBool(trimmed); err == nil {
+			return enabled
+		}
+	}
+	return value
 }
 
 func (m *metricHints) getModules(hints common.MapStr) []common.MapStr {
@@ -52,4 +52,5 @@ func init() {
 	RootCmd = cmd.GenRootCmdWithSettings(beater.DefaultCreator(), settings)
 	RootCmd.AddCommand(cmd.GenModulesCmd(Name, "", BuildModulesManager))
 	RootCmd.TestCmd.AddCommand(test.GenTestModulesCmd(Name, "", beater.DefaultTestModulesCreator()))
+	RootCmd.TestCmd.AddCommand(test.GenTestHintsCmd(Name, ""))
 }
@@ -0,0 +1,263 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+	v1 "k8s.io/api/core/v1"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/kubernetes"
+	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/jsontransform"
+	k8s "github.com/elastic/beats/v7/libbeat/common/kubernetes"
+	"github.com/elastic/beats/v7/metricbeat/autodiscover/builder/hints"
+)
+
+// GenTestHintsCmd generates the command that runs the hints builder against a
+// pod manifest or an autodiscover event, and prints the generated configs.
+func GenTestHintsCmd(name, beatVersion string) *cobra.Command {
+	var podFile, eventFile, host string
+	cmd := &cobra.Command{
+		Use:   "hints",
+		Short: "Test the configs generated from hints",
+		Long: "Runs the hints builder against a pod manifest or an autodiscover event and prints the\n" +
+			"generated module configs. The hints settings of the first kubernetes provider found in\n" +
+			"the configuration are used, or the defaults if there is none.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if (podFile == "") == (eventFile == "") {
+				fmt.Fprintf(os.Stderr, "Exactly one of --pod or --event must be set\n")
+				os.Exit(1)
+			}
+
+			b, err := instance.NewInitializedBeat(instance.Settings{Name: name, Version: beatVersion})
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing beat: %s\n", err)
+				os.Exit(1)
+			}
+
+			providerCfg, err := kubernetesProviderConfig(b.Beat.BeatConfig)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading autodiscover settings: %s\n", err)
+				os.Exit(1)
+			}
+
+			var events []bus.Event
+			if podFile != "" {
+				events, err = readFile(podFile, func(r io.Reader) ([]bus.Event, error) {
+					return podEvents(r, providerCfg, host)
+				})
+			} else {
+				events, err = readFile(eventFile, func(r io.Reader) ([]bus.Event, error) {
+					return autodiscoverEvent(r, providerCfg)
+				})
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error reading input: %s\n", err)
+				os.Exit(1)
+			}
+
+			hintsCfg, err := providerCfg.Child("hints", -1)
+			if err != nil {
+				hintsCfg = common.NewConfig()
+			}
+			builder, err := hints.NewMetricHints(hintsCfg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error initializing hints builder: %s\n", err)
+				os.Exit(1)
+			}
+
+			if err := printHintsConfigs(os.Stdout, builder, events); err != nil {
+				fmt.Fprintf(os.Stderr, "Error printing configs: %s\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+	cmd.Flags().StringVar(&podFile, "pod", "", "Pod manifest, in YAML or JSON")
+	cmd.Flags().StringVar(&eventFile, "event", "", "Autodiscover event, in JSON")
+	cmd.Flags().StringVar(&host, "host", "127.0.0.1", "Pod IP used when the manifest has no status")
+	return cmd
+}
+
+func readFile(path string, decode func(io.Reader) ([]bus.Event, error)) ([]bus.Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return decode(f)
+}
+
+// kubernetesProviderConfig returns the settings of the first kubernetes
+// autodiscover provider. If there is none, hints are enabled with the default
+// settings.
+func kubernetesProviderConfig(beatConfig *common.Config) (*common.Config, error) {
+	var settings struct {
+		Autodiscover struct {
+			Providers []*common.Config `config:"providers"`
+		} `config:"autodiscover"`
+	}
+	if beatConfig != nil {
+		if err := beatConfig.Unpack(&settings); err != nil {
+			return nil, err
+		}
+	}
+	for _, provider := range settings.Autodiscover.Providers {
+		var p struct {
+			Type string `config:"type"`
+		}
+		if err := provider.Unpack(&p); err == nil && p.Type == "kubernetes" {
+			return provider, nil
+		}
+	}
+	return common.MustNewConfigFrom(common.MapStr{"hints.enabled": true}), nil
+}
+
+// podEvents returns the events of the pod described by a manifest. The pod
+// template of workloads, like deployments, is used. When the manifest has no
+// status, containers are considered running and the pod IP is host.
+func podEvents(r io.Reader, providerCfg *common.Config, host string) ([]bus.Event, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest struct {
+		Kind string `json:"kind"`
+		Spec struct {
+			Template *v1.PodTemplateSpec `json:"template"`
+		} `json:"spec"`
+	}
+	if err := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096).Decode(&manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to decode manifest")
+	}
+
+	pod := &k8s.Pod{}
+	switch {
+	case manifest.Kind == "Pod" || manifest.Kind == "":
+		if err := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096).Decode(pod); err != nil {
+			return nil, errors.Wrap(err, "failed to decode pod")
+		}
+	case manifest.Spec.Template != nil:
+		pod.ObjectMeta = manifest.Spec.Template.ObjectMeta
+		pod.Spec = manifest.Spec.Template.Spec
+	default:
+		return nil, errors.Errorf("manifest of kind %v doesn't contain a pod template", manifest.Kind)
+	}
+	if len(pod.Spec.Containers) == 0 {
+		return nil, errors.New("manifest doesn't define any container")
+	}
+
+	if pod.Status.PodIP == "" {
+		pod.Status.PodIP = host
+	}
+	running := map[string]bool{}
+	for _, status := range pod.Status.ContainerStatuses {
+		running[status.Name] = status.State.Running != nil
+	}
+	for _, c := range pod.Spec.Containers {
+		if _, found := running[c.Name]; !found {
+			pod.Status.ContainerStatuses = append(pod.Status.ContainerStatuses, k8s.PodContainerStatus{
+				Name:        c.Name,
+				ContainerID: "unknown://" + c.Name,
+				State:       v1.ContainerState{Running: &v1.ContainerStateRunning{}},
+			})
+		}
+	}
+
+	return kubernetes.PodHintsEvents(providerCfg, pod)
+}
+
+// autodiscoverEvent decodes an autodiscover event in JSON. Events with
+// Kubernetes metadata but without hints get the hints generated from their
+// annotations, as the kubernetes provider does.
+func autodiscoverEvent(r io.Reader, providerCfg *common.Config) ([]bus.Event, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var fields common.MapStr
+	if err := dec.Decode(&fields); err != nil {
+		return nil, errors.Wrap(err, "failed to decode event")
+	}
+	jsontransform.TransformNumbers(fields)
+	fields = common.NewGenericEventConverter(false).Convert(fields)
+
+	event := bus.Event(fields)
+	if _, found := event["hints"]; !found {
+		if _, found := event["kubernetes"]; found {
+			return kubernetes.EventHints(providerCfg, event)
+		}
+	}
+	return []bus.Event{event}, nil
+}
+
+// printHintsConfigs prints the configs generated for each event.
+func printHintsConfigs(w io.Writer, builder autodiscover.Builder, events []bus.Event) error {
+	if len(events) == 0 {
+		fmt.Fprintln(w, "# No events generated, the pod has no running containers.")
+		return nil
+	}
+	for _, event := range events {
+		fmt.Fprintf(w, "# %s\n", describeEvent(event))
+		configs := builder.CreateConfig(event)
+		if len(configs) == 0 {
+			fmt.Fprintln(w, "# No configs generated.")
+			continue
+		}
+
+		var modules []map[string]interface{}
+		for _, cfg := range configs {
+			var module map[string]interface{}
+			if err := cfg.Unpack(&module); err != nil {
+				return err
+			}
+			modules = append(modules, module)
+		}
+		out, err := yaml.Marshal(modules)
+		if err != nil {
+			return err
+		}
+		w.Write(out)
+	}
+	return nil
+}
+
+func describeEvent(event bus.Event) string {
+	desc := "Event"
+	if name, err := common.MapStr(event).GetValue("container.name"); err == nil {
+		desc += fmt.Sprintf(" for container %v", name)
+	}
+	if host, found := event["host"]; found {
+		desc += fmt.Sprintf(", host: %v", host)
+	}
+	if port, found := event["port"]; found {
+		desc += fmt.Sprintf(", port: %v", port)
+	}
+	return desc
+}
@@ -0,0 +1,119 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/autodiscover/builder/hints"
+	_ "github.com/elastic/beats/v7/metricbeat/module/redis/info"
+)
+
+const deployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: redis
+spec:
+  template:
+    metadata:
+      name: redis
+      annotations:
+        co.elastic.metrics/module: redis
+        co.elastic.metrics/hosts: '${data.host}:${data.port}'
+        co.elastic.metrics/metricsets: info
+    spec:
+      containers:
+      - name: redis
+        image: redis:6
+        ports:
+        - containerPort: 6379
+      - name: sidecar
+        image: busybox
+`
+
+func TestTestHints(t *testing.T) {
+	providerCfg, err := kubernetesProviderConfig(nil)
+	require.NoError(t, err)
+
+	events, err := podEvents(strings.NewReader(deployment), providerCfg, "10.0.0.1")
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	builder, err := hints.NewMetricHints(common.NewConfig())
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, printHintsConfigs(&out, builder, events))
+	assert.Equal(t, `# Event for container redis, host: 10.0.0.1, port: 6379
+- enabled: true
+  hosts:
+  - 10.0.0.1:6379
+  metricsets:
+  - info
+  module: redis
+  period: 1m
+  timeout: 3s
+# Event for container sidecar, host: 10.0.0.1
+# No configs generated.
+`, out.String())
+}
+
+func TestTestHintsEvent(t *testing.T) {
+	providerCfg, err := kubernetesProviderConfig(common.MustNewConfigFrom(common.MapStr{
+		"autodiscover.providers": []common.MapStr{
+			{"type": "docker", "hints.enabled": true},
+			{"type": "kubernetes", "prefix": "custom", "hints.enabled": true},
+		},
+	}))
+	require.NoError(t, err)
+
+	event := `{
+		"host": "10.0.0.2",
+		"port": 6379,
+		"kubernetes": {
+			"container": {"name": "redis"},
+			"annotations": {"custom": {"metrics/module": "redis", "metrics/metricsets": "info"}}
+		}
+	}`
+	events, err := autodiscoverEvent(strings.NewReader(event), providerCfg)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	hostsHint, err := common.MapStr(events[0]).GetValue("hints.metrics.module")
+	require.NoError(t, err)
+	assert.Equal(t, "redis", hostsHint)
+	assert.Equal(t, int64(6379), events[0]["port"])
+}
+
+func TestTestHintsInvalidManifest(t *testing.T) {
+	providerCfg, err := kubernetesProviderConfig(nil)
+	require.NoError(t, err)
+
+	_, err = podEvents(strings.NewReader("kind: Service\nspec:\n  ports: []\n"), providerCfg, "10.0.0.1")
+	assert.EqualError(t, err, "manifest of kind Service doesn't contain a pod template")
+
+	_, err = podEvents(strings.NewReader("kind: Pod\nspec: {}\n"), providerCfg, "10.0.0.1")
+	assert.Error(t, err)
+}
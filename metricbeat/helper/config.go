@@ -30,6 +30,7 @@ type Config struct {
 	Timeout         time.Duration     `config:"timeout"`
 	Headers         map[string]string `config:"headers"`
 	BearerTokenFile string            `config:"bearer_token_file"`
+	APIKey          string            `config:"api_key"`
 }
 
 func defaultConfig() Config {
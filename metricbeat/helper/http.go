@@ -44,6 +44,7 @@ type HTTP struct {
 	uri      string
 	method   string
 	body     []byte
+	stats    *mb.FetchStats // Stats of the current fetch, updated with the size of responses.
 }
 
 // NewHTTP creates new http helper
@@ -53,7 +54,12 @@ func NewHTTP(base mb.BaseMetricSet) (*HTTP, error) {
 		return nil, err
 	}
 
-	return newHTTPFromConfig(config, base.Name(), base.HostData())
+	h, err := newHTTPFromConfig(config, base.Name(), base.HostData())
+	if err != nil {
+		return nil, err
+	}
+	h.stats = base.FetchStats()
+	return h, nil
 }
 
 // newHTTPWithConfig creates a new http helper from some configuration
@@ -66,6 +72,10 @@ func newHTTPFromConfig(config Config, name string, hostData mb.HostData) (*HTTP,
 		headers.Set(k, v)
 	}
 
+	if config.BearerTokenFile != "" && config.APIKey != "" {
+		return nil, errors.New("bearer_token_file and api_key cannot be used together")
+	}
+
 	if config.BearerTokenFile != "" {
 		header, err := getAuthHeaderFromToken(config.BearerTokenFile)
 		if err != nil {
@@ -74,6 +84,10 @@ func newHTTPFromConfig(config Config, name string, hostData mb.HostData) (*HTTP,
 		headers.Set("Authorization", header)
 	}
 
+	if config.APIKey != "" {
+		headers.Set("Authorization", "ApiKey "+config.APIKey)
+	}
+
 	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
 	if err != nil {
 		return nil, err
@@ -137,10 +151,26 @@ func (h *HTTP) FetchResponse() (*http.Response, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error making http request: %v", err)
 	}
+	if h.stats != nil {
+		resp.Body = &countingReadCloser{ReadCloser: resp.Body, stats: h.stats}
+	}
 
 	return resp, nil
 }
 
+// countingReadCloser adds the size of the data read from a response body to
+// the fetch stats.
+type countingReadCloser struct {
+	io.ReadCloser
+	stats *mb.FetchStats
+}
+
+func (r *countingReadCloser) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.stats.AddBytes(int64(n))
+	return n, err
+}
+
 // SetHeader sets HTTP headers to use in requests
 func (h *HTTP) SetHeader(key, value string) {
 	h.headers.Set(key, value)
@@ -161,6 +191,17 @@ func (h *HTTP) SetMethod(method string) {
 	h.method = method
 }
 
+// Transport returns the transport used to make requests
+func (h *HTTP) Transport() http.RoundTripper {
+	return h.client.Transport
+}
+
+// SetTransport sets the transport used to make requests, it allows to share
+// connections between helpers
+func (h *HTTP) SetTransport(transport http.RoundTripper) {
+	h.client.Transport = transport
+}
+
 // GetURI gets the URI used in requests
 func (h *HTTP) GetURI() string {
 	return h.uri
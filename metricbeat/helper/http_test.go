@@ -162,6 +162,19 @@ func TestAuthentication(t *testing.T) {
 	assert.Equal(t, http.StatusOK, response.StatusCode, "response status code")
 }
 
+func TestAPIKey(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.APIKey = "id:key"
+
+	h, err := newHTTPFromConfig(cfg, "test", mb.HostData{})
+	require.NoError(t, err)
+	assert.Equal(t, "ApiKey id:key", h.headers.Get("Authorization"))
+
+	cfg.BearerTokenFile = "/var/run/secrets/token"
+	_, err = newHTTPFromConfig(cfg, "test", mb.HostData{})
+	assert.Error(t, err)
+}
+
 func TestSetHeader(t *testing.T) {
 	cfg := defaultConfig()
 	cfg.Headers = map[string]string{
@@ -190,6 +203,32 @@ func TestSetHeaderDefault(t *testing.T) {
 	assert.Equal(t, "default", v)
 }
 
+func TestFetchStats(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"status":"ok"}`)
+	}))
+	defer ts.Close()
+
+	cfg := defaultConfig()
+	hostData := mb.HostData{
+		URI:          ts.URL,
+		SanitizedURI: ts.URL,
+	}
+
+	h, err := newHTTPFromConfig(cfg, "test", hostData)
+	require.NoError(t, err)
+	h.stats = &mb.FetchStats{}
+
+	for i := 1; i <= 2; i++ {
+		_, err = h.FetchJSON()
+		require.NoError(t, err)
+		assert.Equal(t, int64(i*15), h.stats.Bytes())
+	}
+
+	h.stats.Reset()
+	assert.Equal(t, int64(0), h.stats.Bytes())
+}
+
 func TestOverUnixSocket(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skipf("unix domain socket aren't supported under Windows")
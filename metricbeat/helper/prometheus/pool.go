@@ -0,0 +1,154 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/mitchellh/hashstructure"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+// scrapePoolConfig groups prometheus clients, typically all the instances
+// generated by autodiscover for the pods of a node, so they share HTTP
+// connections and a bounded number of scrape workers.
+type scrapePoolConfig struct {
+	ScrapePool struct {
+		Name    string `config:"name"`
+		Workers int    `config:"workers" validate:"min=0"`
+	} `config:"scrape_pool"`
+
+	// Settings affecting the transport, clients only share a pool if these are equal.
+	TLS            *tlscommon.Config `config:"ssl"`
+	ConnectTimeout time.Duration     `config:"connect_timeout"`
+}
+
+// scrapePool is shared by all the prometheus clients with the same pool name
+// and transport settings.
+type scrapePool struct {
+	key       string
+	transport http.RoundTripper
+	workers   chan struct{} // nil if scrapes are not limited
+	refs      int
+}
+
+var (
+	scrapePoolsMutex sync.Mutex
+	scrapePools      = map[string]*scrapePool{}
+
+	poolMetrics      = monitoring.Default.NewRegistry("metricbeat.prometheus.scrape_pools")
+	scrapePoolsCount = monitoring.NewInt(poolMetrics, "active")
+)
+
+// acquireScrapePool returns the pool for the given config, creating it with
+// the given transport if it doesn't exist yet.
+func acquireScrapePool(config scrapePoolConfig, transport http.RoundTripper) (*scrapePool, error) {
+	hash, err := hashstructure.Hash(config, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash scrape pool config: %v", err)
+	}
+	key := fmt.Sprintf("%s/%d", config.ScrapePool.Name, hash)
+
+	scrapePoolsMutex.Lock()
+	defer scrapePoolsMutex.Unlock()
+
+	pool, found := scrapePools[key]
+	if !found {
+		pool = &scrapePool{key: key, transport: transport}
+		if workers := config.ScrapePool.Workers; workers > 0 {
+			pool.workers = make(chan struct{}, workers)
+		}
+		scrapePools[key] = pool
+		scrapePoolsCount.Inc()
+	}
+	pool.refs++
+	return pool, nil
+}
+
+// release drops a reference to the pool, idle connections are closed when
+// the last client using it is released.
+func (p *scrapePool) release() {
+	scrapePoolsMutex.Lock()
+	defer scrapePoolsMutex.Unlock()
+
+	p.refs--
+	if p.refs > 0 {
+		return
+	}
+
+	delete(scrapePools, p.key)
+	scrapePoolsCount.Dec()
+	if t, ok := p.transport.(interface{ CloseIdleConnections() }); ok {
+		t.CloseIdleConnections()
+	}
+}
+
+// acquireWorker blocks until a scrape worker of the pool is available.
+func (p *scrapePool) acquireWorker() {
+	if p.workers != nil {
+		p.workers <- struct{}{}
+	}
+}
+
+func (p *scrapePool) releaseWorker() {
+	if p.workers != nil {
+		<-p.workers
+	}
+}
+
+// pooledFetcher makes requests using the transport and workers of a scrape pool.
+type pooledFetcher struct {
+	httpfetcher
+	pool *scrapePool
+}
+
+func (f *pooledFetcher) FetchResponse() (*http.Response, error) {
+	f.pool.acquireWorker()
+	resp, err := f.httpfetcher.FetchResponse()
+	if err != nil {
+		f.pool.releaseWorker()
+		return nil, err
+	}
+
+	// The worker is busy till the response is consumed.
+	resp.Body = &workerReleasingBody{ReadCloser: resp.Body, pool: f.pool}
+	return resp, nil
+}
+
+func (f *pooledFetcher) Close() error {
+	f.pool.release()
+	return nil
+}
+
+type workerReleasingBody struct {
+	io.ReadCloser
+	pool *scrapePool
+	once sync.Once
+}
+
+func (b *workerReleasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.pool.releaseWorker)
+	return err
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package prometheus
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScrapePoolSharing(t *testing.T) {
+	var config scrapePoolConfig
+	config.ScrapePool.Name = "node-1"
+
+	first, err := acquireScrapePool(config, &http.Transport{})
+	require.NoError(t, err)
+	second, err := acquireScrapePool(config, &http.Transport{})
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Same(t, first.transport, second.transport)
+
+	// Different transport settings get a pool of their own
+	config.ConnectTimeout = time.Second
+	other, err := acquireScrapePool(config, &http.Transport{})
+	require.NoError(t, err)
+	assert.NotSame(t, first, other)
+
+	other.release()
+	first.release()
+	assert.Contains(t, scrapePools, first.key)
+	second.release()
+	assert.NotContains(t, scrapePools, first.key)
+}
+
+func TestScrapePoolWorkers(t *testing.T) {
+	var config scrapePoolConfig
+	config.ScrapePool.Name = "node-1"
+	config.ScrapePool.Workers = 1
+
+	pool, err := acquireScrapePool(config, &http.Transport{})
+	require.NoError(t, err)
+	defer pool.release()
+
+	fetcher := &pooledFetcher{mockFetcher{response: promMetrics}, pool}
+	resp, err := fetcher.FetchResponse()
+	require.NoError(t, err)
+
+	fetched := make(chan struct{})
+	go func() {
+		resp, err := fetcher.FetchResponse()
+		if err == nil {
+			resp.Body.Close()
+		}
+		close(fetched)
+	}()
+
+	select {
+	case <-fetched:
+		t.Fatal("second scrape should wait for the worker of the first one")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	resp.Body.Close()
+	<-fetched
+}
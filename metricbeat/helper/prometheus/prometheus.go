@@ -43,6 +43,9 @@ type Prometheus interface {
 	GetProcessedMetrics(mapping *MetricsMapping) ([]common.MapStr, error)
 
 	ReportProcessedMetrics(mapping *MetricsMapping, r mb.ReporterV2) error
+
+	// Close releases the resources of the client
+	Close() error
 }
 
 type prometheus struct {
@@ -62,7 +65,29 @@ func NewPrometheusClient(base mb.BaseMetricSet) (Prometheus, error) {
 	}
 
 	http.SetHeaderDefault("Accept", acceptHeader)
-	return &prometheus{http, base.Logger()}, nil
+
+	var config scrapePoolConfig
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+	if config.ScrapePool.Name == "" {
+		return &prometheus{http, base.Logger()}, nil
+	}
+
+	pool, err := acquireScrapePool(config, http.Transport())
+	if err != nil {
+		return nil, err
+	}
+	http.SetTransport(pool.transport)
+	return &prometheus{&pooledFetcher{http, pool}, base.Logger()}, nil
+}
+
+// Close releases the resources of the client, like its reference to a scrape pool
+func (p *prometheus) Close() error {
+	if c, ok := p.httpfetcher.(io.Closer); ok {
+		return c.Close()
+	}
+	return nil
 }
 
 // GetFamilies requests metric families from prometheus endpoint and returns them
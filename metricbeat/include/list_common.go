@@ -74,6 +74,7 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/golang"
 	_ "github.com/elastic/beats/v7/metricbeat/module/golang/expvar"
 	_ "github.com/elastic/beats/v7/metricbeat/module/golang/heap"
+	_ "github.com/elastic/beats/v7/metricbeat/module/golang/profile"
 	_ "github.com/elastic/beats/v7/metricbeat/module/graphite"
 	_ "github.com/elastic/beats/v7/metricbeat/module/graphite/server"
 	_ "github.com/elastic/beats/v7/metricbeat/module/haproxy"
@@ -100,6 +101,8 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node_stats"
+	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/pipeline"
+	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/plugin"
 	_ "github.com/elastic/beats/v7/metricbeat/module/memcached"
 	_ "github.com/elastic/beats/v7/metricbeat/module/memcached/stats"
 	_ "github.com/elastic/beats/v7/metricbeat/module/mongodb"
@@ -33,6 +33,8 @@ import (
 	_ "github.com/elastic/beats/v7/metricbeat/module/docker/memory"
 	_ "github.com/elastic/beats/v7/metricbeat/module/docker/network"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/apiserver"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/apiserver_etcd"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/apiserver_flowcontrol"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/container"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/controllermanager"
 	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes/event"
@@ -190,12 +190,13 @@ func newBaseMetricSets(r *Register, m Module) ([]BaseMetricSet, error) {
 			monitoring.NewString(metrics, "id").Set(msID)
 
 			metricsets = append(metricsets, BaseMetricSet{
-				id:      msID,
-				name:    name,
-				module:  m,
-				host:    host,
-				metrics: metrics,
-				logger:  logp.NewLogger(m.Name() + "." + name),
+				id:         msID,
+				name:       name,
+				module:     m,
+				host:       host,
+				metrics:    metrics,
+				logger:     logp.NewLogger(m.Name() + "." + name),
+				fetchStats: &FetchStats{},
 			})
 		}
 	}
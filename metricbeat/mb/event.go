@@ -45,6 +45,8 @@ type Event struct {
 	Service   string        // Service type
 	Took      time.Duration // Amount of time it took to collect the event data.
 	Period    time.Duration // Period that is set to retrieve the events
+	Bytes     int64         // Size of the data read from the service to collect the event data.
+	Attempt   int           // Attempt number of the fetch that collected the event data.
 
 	DisableTimeSeries bool // true if the event doesn't contain timeseries data
 }
@@ -111,9 +113,9 @@ func (e *Event) BeatEvent(module, metricSet string, modifiers ...EventModifier)
 
 // AddMetricSetInfo is an EventModifier that adds information about the
 // MetricSet that generated the event. It will always add the metricset and
-// module names. And it will add the host, period (in milliseconds), and
-// duration (round-trip time in nanoseconds) values if they are non-zero
-// values.
+// module names. And it will add the host, period (in milliseconds), duration
+// (round-trip time in nanoseconds), response size (in bytes) and fetch attempt
+// values if they are non-zero values.
 //
 //   {
 //     "event": {
@@ -126,7 +128,11 @@ func (e *Event) BeatEvent(module, metricSet string, modifiers ...EventModifier)
 //     },
 //     "metricset": {
 //       "name": "status",
-//       "period": 10000
+//       "period": 10000,
+//       "fetch": {
+//         "attempt": 1,
+//         "bytes": 512
+//       }
 //     }
 //   }
 //
@@ -154,6 +160,12 @@ func AddMetricSetInfo(module, metricset string, event *Event) {
 	if event.Period > 0 {
 		e.Put("metricset.period", event.Period/time.Millisecond)
 	}
+	if event.Bytes > 0 {
+		e.Put("metricset.fetch.bytes", event.Bytes)
+	}
+	if event.Attempt > 0 {
+		e.Put("metricset.fetch.attempt", event.Attempt)
+	}
 
 	if event.RootFields == nil {
 		event.RootFields = e
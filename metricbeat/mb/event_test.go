@@ -180,8 +180,10 @@ func TestAddMetricSetInfo(t *testing.T) {
 
 	t.Run("all fields", func(t *testing.T) {
 		e := Event{
-			Host: host,
-			Took: elapsed,
+			Host:    host,
+			Took:    elapsed,
+			Bytes:   512,
+			Attempt: 2,
 		}
 
 		AddMetricSetInfo(moduleName, metricSetName, &e)
@@ -197,6 +199,10 @@ func TestAddMetricSetInfo(t *testing.T) {
 			},
 			"metricset": common.MapStr{
 				"name": metricSetName,
+				"fetch": common.MapStr{
+					"bytes":   int64(512),
+					"attempt": 2,
+				},
 			},
 		}, e.RootFields)
 	})
@@ -30,6 +30,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/atomic"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 	"github.com/elastic/beats/v7/metricbeat/helper/dialer"
@@ -130,6 +131,43 @@ func (m *BaseModule) WithConfig(config common.Config) (*BaseModule, error) {
 	return newBM, nil
 }
 
+// CapabilitiesProber is an optional interface that a Module can implement to
+// probe the version and the features of the service in a host when it
+// starts, before its MetricSets collect any data. The probe is done once per
+// host, with the host data of the first MetricSet started for it.
+type CapabilitiesProber interface {
+	ProbeCapabilities(ctx context.Context, host HostData) (Capabilities, error)
+}
+
+// Capabilities describes the version and the features of a monitored service,
+// as probed by a CapabilitiesProber.
+type Capabilities struct {
+	Version  string   // Version of the service, empty if unknown.
+	Features []string // Features supported by the service, like its license level.
+
+	// Disabled contains the MetricSets that are not supported by the service,
+	// with the reason why.
+	Disabled map[string]string
+}
+
+// HasFeature returns true if the service supports the given feature.
+func (c Capabilities) HasFeature(feature string) bool {
+	for _, f := range c.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// Disable disables a MetricSet that is not supported by the service.
+func (c *Capabilities) Disable(metricSet, reason string) {
+	if c.Disabled == nil {
+		c.Disabled = map[string]string{}
+	}
+	c.Disabled[metricSet] = reason
+}
+
 // MetricSet interfaces
 
 // MetricSet is the common interface for all MetricSet implementations. In
@@ -148,6 +186,14 @@ type MetricSet interface {
 	Logger() *logp.Logger                // MetricSet specific logger
 }
 
+// CapabilitiesAware is an optional interface that a MetricSet can implement
+// to adjust the data it collects, like its queries, to the capabilities of
+// the service probed by its Module. SetCapabilities is called before the next
+// fetch once the probe succeeds.
+type CapabilitiesAware interface {
+	SetCapabilities(c Capabilities)
+}
+
 // Closer is an optional interface that a MetricSet can implement in order to
 // cleanup any resources it has open at shutdown.
 type Closer interface {
@@ -309,6 +355,7 @@ type BaseMetricSet struct {
 	registration MetricSetRegistration
 	metrics      *monitoring.Registry
 	logger       *logp.Logger
+	fetchStats   *FetchStats
 }
 
 func (b *BaseMetricSet) String() string {
@@ -337,6 +384,12 @@ func (b *BaseMetricSet) Logger() *logp.Logger {
 	return b.logger
 }
 
+// FetchStats returns the statistics of the current fetch, helpers used to
+// collect the data update them.
+func (b *BaseMetricSet) FetchStats() *FetchStats {
+	return b.fetchStats
+}
+
 // Name returns the name of the MetricSet. It should not include the name of
 // the module.
 func (b *BaseMetricSet) Name() string {
@@ -371,6 +424,40 @@ func (b *BaseMetricSet) Registration() MetricSetRegistration {
 	return b.registration
 }
 
+// FetchStats contains statistics about the current fetch of a MetricSet. They
+// are reset when a fetch starts and added to the events reported by the fetch.
+// All methods can be called on a nil FetchStats.
+type FetchStats struct {
+	bytes atomic.Int64
+}
+
+// FetchStatsProvider is implemented by MetricSets embedding BaseMetricSet.
+type FetchStatsProvider interface {
+	FetchStats() *FetchStats
+}
+
+// AddBytes adds the size of data read from the monitored service.
+func (s *FetchStats) AddBytes(n int64) {
+	if s != nil {
+		s.bytes.Add(n)
+	}
+}
+
+// Bytes returns the size of the data read since the fetch started.
+func (s *FetchStats) Bytes() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.bytes.Load()
+}
+
+// Reset resets the statistics at the start of a fetch.
+func (s *FetchStats) Reset() {
+	if s != nil {
+		s.bytes.Store(0)
+	}
+}
+
 // Configuration types
 
 // ModuleConfig is the base configuration data for all Modules.
@@ -388,6 +475,11 @@ type ModuleConfig struct {
 	Raw         bool          `config:"raw"`
 	Query       QueryParams   `config:"query"`
 	ServiceName string        `config:"service.name"`
+	Startup     StartupConfig `config:"startup"`
+
+	// ProbeCapabilities enables probing the capabilities of the service when
+	// the module implements CapabilitiesProber.
+	ProbeCapabilities bool `config:"capabilities.probe"`
 }
 
 func (c ModuleConfig) String() string {
@@ -399,6 +491,36 @@ func (c ModuleConfig) String() string {
 
 func (c ModuleConfig) GoString() string { return c.String() }
 
+// StartupConfig controls how periodic metricsets behave when the service they
+// monitor is not available yet when they are started.
+type StartupConfig struct {
+	// Timeout is how long failing fetches are retried in the background
+	// before errors are reported every period. Zero disables the behavior.
+	Timeout time.Duration        `config:"timeout" validate:"positive"`
+	Backoff StartupBackoffConfig `config:"backoff"`
+}
+
+// StartupBackoffConfig holds the exponential backoff used to retry fetches
+// while the service is not available. Max is capped to the module period.
+type StartupBackoffConfig struct {
+	Init time.Duration `config:"init" validate:"positive"`
+	Max  time.Duration `config:"max"  validate:"positive"`
+}
+
+// Validate validates the StartupConfig.
+func (c *StartupConfig) Validate() error {
+	if c.Timeout == 0 {
+		return nil
+	}
+	if c.Backoff.Init == 0 {
+		return errors.New("startup.backoff.init must be greater than zero")
+	}
+	if c.Backoff.Max < c.Backoff.Init {
+		return errors.New("startup.backoff.max must be greater than or equal to startup.backoff.init")
+	}
+	return nil
+}
+
 // QueryParams is a convenient map[string]interface{} wrapper to implement the String interface which returns the
 // values in common query params format (key=value&key2=value2) which is the way that the url package expects this
 // params (without the initial '?')
@@ -429,8 +551,15 @@ func (q QueryParams) String() (s string) {
 
 // defaultModuleConfig contains the default values for ModuleConfig instances.
 var defaultModuleConfig = ModuleConfig{
-	Enabled: true,
-	Period:  time.Second * 10,
+	Enabled:           true,
+	Period:            time.Second * 10,
+	ProbeCapabilities: true,
+	Startup: StartupConfig{
+		Backoff: StartupBackoffConfig{
+			Init: time.Second,
+			Max:  30 * time.Second,
+		},
+	},
 }
 
 // DefaultModuleConfig returns a ModuleConfig with the default values populated.
@@ -94,12 +94,30 @@ func TestModuleConfig(t *testing.T) {
 				"metricsets": []string{"test"},
 			},
 			out: ModuleConfig{
-				Module:     "example",
-				MetricSets: []string{"test"},
-				Enabled:    true,
-				Period:     time.Second * 10,
-				Timeout:    0,
-				Query:      nil,
+				Module:            "example",
+				MetricSets:        []string{"test"},
+				Enabled:           true,
+				Period:            time.Second * 10,
+				Timeout:           0,
+				Query:             nil,
+				Startup:           defaultModuleConfig.Startup,
+				ProbeCapabilities: true,
+			},
+		},
+		{
+			name: "capabilities probe disabled",
+			in: map[string]interface{}{
+				"module":             "example",
+				"metricsets":         []string{"test"},
+				"capabilities.probe": false,
+			},
+			out: ModuleConfig{
+				Module:            "example",
+				MetricSets:        []string{"test"},
+				Enabled:           true,
+				Period:            time.Second * 10,
+				Startup:           defaultModuleConfig.Startup,
+				ProbeCapabilities: false,
 			},
 		},
 		{
@@ -120,6 +138,17 @@ func TestModuleConfig(t *testing.T) {
 			},
 			err: "negative value accessing 'timeout'",
 		},
+		{
+			name: "startup backoff max lower than init",
+			in: map[string]interface{}{
+				"module":               "example",
+				"metricsets":           []string{"test"},
+				"startup.timeout":      "5m",
+				"startup.backoff.init": "10s",
+				"startup.backoff.max":  "1s",
+			},
+			err: "startup.backoff.max must be greater than or equal to startup.backoff.init",
+		},
 	}
 
 	for i, test := range tests {
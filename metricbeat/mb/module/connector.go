@@ -36,6 +36,7 @@ type Connector struct {
 	dynamicFields *common.MapStrPointer
 	timeSeries    bool
 	keepNull      bool
+	pipelineID    string
 }
 
 type connectorConfig struct {
@@ -46,6 +47,9 @@ type connectorConfig struct {
 	// KeepNull determines whether published events will keep null values or omit them.
 	KeepNull bool `config:"keep_null"`
 
+	// ES Ingest pipeline name
+	Pipeline string `config:"pipeline"`
+
 	common.EventMetadata `config:",inline"` // Fields and tags to add to events.
 }
 
@@ -73,6 +77,7 @@ func NewConnector(
 		eventMeta:     config.EventMetadata,
 		dynamicFields: dynFields,
 		keepNull:      config.KeepNull,
+		pipelineID:    config.Pipeline,
 	}, nil
 }
 
@@ -98,9 +103,15 @@ func (c *Connector) UseMetricSetProcessors(r metricSetRegister, moduleName, metr
 }
 
 func (c *Connector) Connect() (beat.Client, error) {
+	var meta common.MapStr
+	if c.pipelineID != "" {
+		meta = common.MapStr{"pipeline": c.pipelineID}
+	}
+
 	return c.pipeline.ConnectWith(beat.ClientConfig{
 		Processing: beat.ProcessingConfig{
 			EventMetadata: c.eventMeta,
+			Meta:          meta,
 			Processor:     c.processors,
 			DynamicFields: c.dynamicFields,
 			KeepNull:      c.keepNull,
@@ -132,6 +132,31 @@ func TestUseMetricSetProcessors_ReadingProcessorsSucceeded(t *testing.T) {
 	require.Len(t, connector.processors.List, 2)
 }
 
+type fakePipelineConnector struct {
+	clientConfig beat.ClientConfig
+}
+
+func (p *fakePipelineConnector) ConnectWith(cfg beat.ClientConfig) (beat.Client, error) {
+	p.clientConfig = cfg
+	return nil, nil
+}
+
+func (p *fakePipelineConnector) Connect() (beat.Client, error) {
+	return p.ConnectWith(beat.ClientConfig{})
+}
+
+func TestConnectWithPipeline(t *testing.T) {
+	pipeline := &fakePipelineConnector{}
+	connector, err := NewConnector(beat.Info{}, pipeline, common.MustNewConfigFrom(map[string]interface{}{
+		"pipeline": "my-pipeline",
+	}), nil)
+	require.NoError(t, err)
+
+	_, err = connector.Connect()
+	require.NoError(t, err)
+	assert.Equal(t, common.MapStr{"pipeline": "my-pipeline"}, pipeline.clientConfig.Processing.Meta)
+}
+
 // Helper function to convert from YML input string to an unpacked
 // connectorConfig
 func connectorConfigFromString(s string) (connectorConfig, error) {
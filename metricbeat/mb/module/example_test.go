@@ -101,6 +101,9 @@ func ExampleWrapper() {
 	//     }
 	//   },
 	//   "metricset": {
+	//     "fetch": {
+	//       "attempt": 1
+	//     },
 	//     "name": "eventfetcher",
 	//     "period": 10000
 	//   },
@@ -24,6 +24,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/elastic/beats/v7/libbeat/beat"
 	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
@@ -57,16 +59,44 @@ type Wrapper struct {
 	// Options
 	maxStartDelay  time.Duration
 	eventModifiers []mb.EventModifier
+
+	// Capabilities of the service in each host, shared by the MetricSets.
+	probesLock sync.Mutex
+	probes     map[string]*capabilitiesProbe
+}
+
+// capabilitiesProbe holds the capabilities of the service in a host.
+type capabilitiesProbe struct {
+	sync.Mutex
+	capabilities *mb.Capabilities // Set once a probe succeeds.
 }
 
 // metricSetWrapper contains the MetricSet and the private data associated with
 // running the MetricSet. It contains a pointer to the parent Module.
 type metricSetWrapper struct {
 	mb.MetricSet
-	module *Wrapper // Parent Module.
-	stats  *stats   // stats for this MetricSet.
+	module     *Wrapper       // Parent Module.
+	stats      *stats         // stats for this MetricSet.
+	fetchStats *mb.FetchStats // stats of the current fetch, nil if not provided.
 
 	periodic bool // Set to true if this metricset is a periodic fetcher
+
+	// Set while the metricset waits for the service to become available, see
+	// waitForService.
+	startup *startupState
+
+	probed      bool // Set once the capabilities of the service are known.
+	probeFailed bool // Set if a probe of the capabilities failed.
+	disabled    bool // Set if the capabilities of the service disable the metricset.
+}
+
+// startupState tracks the fetches done while a periodic metricset waits for
+// the service it monitors to become available.
+type startupState struct {
+	notified bool  // Set once the pending event has been published.
+	ready    bool  // Set when the current fetch reported a successful event.
+	err      error // Last error reported by the current fetch.
+	attempt  int   // Number of the current fetch attempt.
 }
 
 // stats bundles common metricset stats.
@@ -96,6 +126,7 @@ func createWrapper(module mb.Module, metricSets []mb.MetricSet, options ...Optio
 	wrapper := &Wrapper{
 		Module:     module,
 		metricSets: make([]*metricSetWrapper, len(metricSets)),
+		probes:     map[string]*capabilitiesProbe{},
 	}
 
 	for _, applyOption := range options {
@@ -103,11 +134,15 @@ func createWrapper(module mb.Module, metricSets []mb.MetricSet, options ...Optio
 	}
 
 	for i, metricSet := range metricSets {
-		wrapper.metricSets[i] = &metricSetWrapper{
+		msw := &metricSetWrapper{
 			MetricSet: metricSet,
 			module:    wrapper,
 			stats:     getMetricSetStats(wrapper.Name(), metricSet.Name()),
 		}
+		if provider, ok := metricSet.(mb.FetchStatsProvider); ok {
+			msw.fetchStats = provider.FetchStats()
+		}
+		wrapper.metricSets[i] = msw
 	}
 	return wrapper, nil
 }
@@ -196,6 +231,10 @@ func (msw *metricSetWrapper) run(done <-chan struct{}, out chan<- beat.Event) {
 		done: done,
 	}
 
+	if !msw.probeCapabilities(&channelContext{done}, reporter) {
+		return
+	}
+
 	switch ms := msw.MetricSet.(type) {
 	case mb.PushMetricSet:
 		ms.Run(reporter.V1())
@@ -221,12 +260,18 @@ func (msw *metricSetWrapper) startPeriodicFetching(ctx context.Context, reporter
 	msw.periodic = true
 
 	// Fetch immediately.
-	msw.fetch(ctx, reporter)
+	if msw.Module().Config().Startup.Timeout > 0 {
+		if !msw.waitForService(ctx, reporter) {
+			return
+		}
+	} else {
+		msw.fetch(ctx, reporter)
+	}
 
 	// Start timer for future fetches.
 	t := time.NewTicker(msw.Module().Config().Period)
 	defer t.Stop()
-	for {
+	for !msw.disabled {
 		select {
 		case <-reporter.V2().Done():
 			return
@@ -236,10 +281,110 @@ func (msw *metricSetWrapper) startPeriodicFetching(ctx context.Context, reporter
 	}
 }
 
+// waitForService performs the first fetches of the MetricSet. Failing fetches
+// are retried with an exponential backoff instead of waiting for the next
+// period, and only the first error is published, as a "pending" event. Once a
+// fetch succeeds or startup.timeout expires, errors are reported as usual. It
+// returns false if the done channel is closed meanwhile.
+func (msw *metricSetWrapper) waitForService(ctx context.Context, reporter reporter) bool {
+	config := msw.Module().Config()
+	backoff, maxBackoff := config.Startup.Backoff.Init, config.Startup.Backoff.Max
+	if maxBackoff > config.Period {
+		maxBackoff = config.Period
+	}
+	deadline := time.Now().Add(config.Startup.Timeout)
+
+	msw.startup = &startupState{}
+	defer func() { msw.startup = nil }()
+	for {
+		msw.startup.ready, msw.startup.err = false, nil
+		msw.startup.attempt++
+		msw.fetch(ctx, reporter)
+		if msw.startup.ready || msw.startup.err == nil {
+			if msw.startup.notified {
+				logp.Info("Service for metricset %s.%s is available", msw.module.Name(), msw.Name())
+			}
+			return true
+		}
+
+		if backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			logp.Warn("Service for metricset %s.%s is not available after %v, "+
+				"reporting errors every period: %v", msw.module.Name(), msw.Name(),
+				config.Startup.Timeout, msw.startup.err)
+			return true
+		}
+		debugf("Retrying %s in %v: %v", msw, backoff, msw.startup.err)
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-reporter.V2().Done():
+			timer.Stop()
+			return false
+		case <-timer.C:
+		}
+		backoff *= 2
+	}
+}
+
+// probeCapabilities probes the capabilities of the service if the module
+// supports it and they are not known yet. Once known, they are passed to the
+// MetricSet and published in a health event with the version and the features
+// of the service. If the probe fails, the MetricSet runs and the probe is
+// retried before the next fetch. It returns false if the MetricSet is disabled
+// for the service.
+func (msw *metricSetWrapper) probeCapabilities(ctx context.Context, reporter reporter) bool {
+	if msw.disabled {
+		return false
+	}
+	prober, ok := msw.module.Module.(mb.CapabilitiesProber)
+	if !ok || msw.probed || !msw.Module().Config().ProbeCapabilities {
+		return true
+	}
+
+	reporter.StartFetchTimer()
+	capabilities, err := msw.module.capabilities(ctx, prober, msw)
+	if err != nil {
+		if !msw.probeFailed {
+			logp.Warn("Failed to probe the capabilities of the service for metricset %s.%s, "+
+				"running it with the default capabilities: %v", msw.module.Name(), msw.Name(), err)
+		}
+		msw.probeFailed = true
+		return true
+	}
+	msw.probed = true
+
+	event := mb.Event{RootFields: common.MapStr{}}
+	if capabilities.Version != "" {
+		event.RootFields.Put("service.version", capabilities.Version)
+	}
+	if len(capabilities.Features) > 0 {
+		event.RootFields.Put("metricset.capabilities.features", capabilities.Features)
+	}
+	if reason, found := capabilities.Disabled[msw.Name()]; found {
+		logp.Info("Metricset %s.%s is disabled for host '%s', not supported by the service: %s",
+			msw.module.Name(), msw.Name(), msw.Host(), reason)
+		event.RootFields.Put("metricset.capabilities.disabled", reason)
+		msw.disabled = true
+	} else if aware, ok := msw.MetricSet.(mb.CapabilitiesAware); ok {
+		aware.SetCapabilities(capabilities)
+	}
+	if len(event.RootFields) > 0 {
+		reporter.V2().Event(event)
+	}
+	return !msw.disabled
+}
+
 // fetch invokes the appropriate Fetch method for the MetricSet and publishes
 // the result using the publisher client. This method will recover from panics
 // and log a stack track if one occurs.
 func (msw *metricSetWrapper) fetch(ctx context.Context, reporter reporter) {
+	if !msw.probeCapabilities(ctx, reporter) {
+		return
+	}
+
 	switch fetcher := msw.MetricSet.(type) {
 	case mb.EventFetcher:
 		msw.singleEventFetch(fetcher, reporter)
@@ -297,6 +442,34 @@ func (msw *metricSetWrapper) close() error {
 	return nil
 }
 
+// capabilities returns the capabilities of the service in the host of a
+// MetricSet, probing them if they are not known yet.
+func (mw *Wrapper) capabilities(ctx context.Context, prober mb.CapabilitiesProber, msw *metricSetWrapper) (mb.Capabilities, error) {
+	mw.probesLock.Lock()
+	probe, found := mw.probes[msw.Host()]
+	if !found {
+		probe = &capabilitiesProbe{}
+		mw.probes[msw.Host()] = probe
+	}
+	mw.probesLock.Unlock()
+
+	probe.Lock()
+	defer probe.Unlock()
+	if probe.capabilities == nil {
+		if timeout := mw.Config().Timeout; timeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		capabilities, err := prober.ProbeCapabilities(ctx, msw.HostData())
+		if err != nil {
+			return mb.Capabilities{}, err
+		}
+		probe.capabilities = &capabilities
+	}
+	return *probe.capabilities, nil
+}
+
 // String returns a string representation of metricSetWrapper.
 func (msw *metricSetWrapper) String() string {
 	return fmt.Sprintf("metricSetWrapper[module=%s, name=%s, host=%s]",
@@ -328,8 +501,12 @@ type eventReporter struct {
 }
 
 // startFetchTimer demarcates the start of a new fetch. The elapsed time of a
-// fetch is computed based on the time of this call.
-func (r *eventReporter) StartFetchTimer() { r.start = time.Now() }
+// fetch is computed based on the time of this call, and the fetch stats are
+// reset.
+func (r *eventReporter) StartFetchTimer() {
+	r.start = time.Now()
+	r.msw.fetchStats.Reset()
+}
 func (r *eventReporter) V1() mb.PushReporter {
 	return reporterV1{v2: r.V2(), module: r.msw.module.Name()}
 }
@@ -376,8 +553,38 @@ type reporterV2 struct {
 func (r reporterV2) Done() <-chan struct{} { return r.done }
 func (r reporterV2) Error(err error) bool  { return r.Event(mb.Event{Error: err}) }
 func (r reporterV2) Event(event mb.Event) bool {
-	if event.Took == 0 && !r.start.IsZero() {
-		event.Took = time.Since(r.start)
+	if startup := r.msw.startup; startup != nil {
+		if event.Error == nil {
+			startup.ready = true
+		} else if !startup.ready {
+			// Hold back errors while waiting for the service, except the
+			// first one, that is published to report the pending state.
+			startup.err = event.Error
+			if startup.notified {
+				return true
+			}
+			startup.notified = true
+			event.Error = errors.Wrap(event.Error, "waiting for service to become available")
+			if event.RootFields == nil {
+				event.RootFields = common.MapStr{}
+			}
+			event.RootFields.Put("service.state", "pending")
+		}
+	}
+
+	if !r.start.IsZero() {
+		if event.Took == 0 {
+			event.Took = time.Since(r.start)
+		}
+		if event.Bytes == 0 {
+			event.Bytes = r.msw.fetchStats.Bytes()
+		}
+		if event.Attempt == 0 {
+			event.Attempt = 1
+			if r.msw.startup != nil {
+				event.Attempt = r.msw.startup.attempt
+			}
+		}
 	}
 	if r.msw.periodic {
 		event.Period = r.msw.Module().Config().Period
@@ -20,6 +20,9 @@
 package module_test
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 
@@ -36,6 +39,12 @@ const (
 	eventFetcherName     = "EventFetcher"
 	reportingFetcherName = "ReportingFetcher"
 	pushMetricSetName    = "PushMetricSet"
+	failingFetcherName   = "FailingFetcher"
+
+	proberModuleName  = "prober"
+	supportedName     = "Supported"
+	unsupportedName   = "Unsupported"
+	unsupportedReason = "requires version 2"
 )
 
 // fakeMetricSet
@@ -50,6 +59,9 @@ func init() {
 	if err := mb.Registry.AddMetricSet(moduleName, pushMetricSetName, newFakePushMetricSet); err != nil {
 		panic(err)
 	}
+	if err := mb.Registry.AddMetricSet(moduleName, failingFetcherName, newFakeFailingFetcher); err != nil {
+		panic(err)
+	}
 }
 
 // EventFetcher
@@ -103,6 +115,92 @@ func newFakePushMetricSet(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &fakePushMetricSet{BaseMetricSet: base}, nil
 }
 
+// FailingFetcher
+
+type fakeFailingFetcher struct {
+	mb.BaseMetricSet
+	failures int
+	fetches  int
+}
+
+func (ms *fakeFailingFetcher) Fetch(r mb.ReporterV2) error {
+	ms.fetches++
+	if ms.fetches <= ms.failures {
+		return errors.New("connection refused")
+	}
+	ms.FetchStats().AddBytes(128)
+	r.Event(mb.Event{MetricSetFields: common.MapStr{"fetches": ms.fetches}})
+	return nil
+}
+
+func newFakeFailingFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	config := struct {
+		Failures int `config:"failures"`
+	}{}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+	return &fakeFailingFetcher{BaseMetricSet: base, failures: config.Failures}, nil
+}
+
+// ProberModule
+
+type fakeProberModule struct {
+	mb.BaseModule
+
+	sync.Mutex
+	failures int
+	probes   int
+}
+
+func (m *fakeProberModule) ProbeCapabilities(_ context.Context, host mb.HostData) (mb.Capabilities, error) {
+	m.Lock()
+	defer m.Unlock()
+	m.probes++
+	if m.probes <= m.failures {
+		return mb.Capabilities{}, errors.New("connection refused")
+	}
+	c := mb.Capabilities{Version: "1.2.3", Features: []string{"basic"}}
+	c.Disable("unsupported", unsupportedReason)
+	return c, nil
+}
+
+func (m *fakeProberModule) probeCount() int {
+	m.Lock()
+	defer m.Unlock()
+	return m.probes
+}
+
+func newFakeProberModule(base mb.BaseModule) (mb.Module, error) {
+	config := struct {
+		Failures int `config:"probe_failures"`
+	}{}
+	if err := base.UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+	return &fakeProberModule{BaseModule: base, failures: config.Failures}, nil
+}
+
+// CapabilitiesAwareFetcher
+
+type fakeCapabilitiesAwareFetcher struct {
+	mb.BaseMetricSet
+	version string
+}
+
+func (ms *fakeCapabilitiesAwareFetcher) SetCapabilities(c mb.Capabilities) {
+	ms.version = c.Version
+}
+
+func (ms *fakeCapabilitiesAwareFetcher) Fetch(r mb.ReporterV2) error {
+	r.Event(mb.Event{MetricSetFields: common.MapStr{"version": ms.version}})
+	return nil
+}
+
+func newFakeCapabilitiesAwareFetcher(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	return &fakeCapabilitiesAwareFetcher{BaseMetricSet: base}, nil
+}
+
 // test utilities
 
 func newTestRegistry(t testing.TB) *mb.Register {
@@ -114,6 +212,15 @@ func newTestRegistry(t testing.TB) *mb.Register {
 	require.NoError(t, err)
 	err = r.AddMetricSet(moduleName, pushMetricSetName, newFakePushMetricSet)
 	require.NoError(t, err)
+	err = r.AddMetricSet(moduleName, failingFetcherName, newFakeFailingFetcher)
+	require.NoError(t, err)
+
+	err = r.AddModule(proberModuleName, newFakeProberModule)
+	require.NoError(t, err)
+	err = r.AddMetricSet(proberModuleName, supportedName, newFakeCapabilitiesAwareFetcher)
+	require.NoError(t, err)
+	err = r.AddMetricSet(proberModuleName, unsupportedName, newFakeCapabilitiesAwareFetcher)
+	require.NoError(t, err)
 	return r
 }
 
@@ -287,3 +394,225 @@ func TestNewWrapperForMetricSet(t *testing.T) {
 		assert.Fail(t, "received unexpected event")
 	}
 }
+
+func TestWrapperWaitsForService(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":               moduleName,
+		"metricsets":           []string{failingFetcherName},
+		"hosts":                []string{"alpha"},
+		"period":               "1h",
+		"failures":             3,
+		"startup.timeout":      "1m",
+		"startup.backoff.init": "1ms",
+		"startup.backoff.max":  "5ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	// Only the first error is published, as a pending event.
+	event := <-output
+	assert.Equal(t, "pending", event.Fields["service"].(common.MapStr)["state"])
+	message, _ := event.GetValue("error.message")
+	assert.Contains(t, message, "waiting for service to become available: connection refused")
+
+	// Retries don't wait for the period.
+	select {
+	case event = <-output:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the metricset to recover")
+	}
+	_, err = event.GetValue("error")
+	assert.Error(t, err, "unexpected error in event %+v", event)
+	fetches, _ := event.GetValue("fake.failingfetcher.fetches")
+	assert.Equal(t, 4, fetches)
+}
+
+func TestFetchInfoIsAddedToEvent(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":               moduleName,
+		"metricsets":           []string{failingFetcherName},
+		"hosts":                []string{"alpha"},
+		"period":               "1h",
+		"failures":             1,
+		"startup.timeout":      "1m",
+		"startup.backoff.init": "1ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t), module.WithMetricSetInfo())
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	event := <-output
+	attempt, _ := event.GetValue("metricset.fetch.attempt")
+	assert.Equal(t, 1, attempt)
+	_, err = event.GetValue("metricset.fetch.bytes")
+	assert.Error(t, err, "failed fetch should not have a size")
+	_, err = event.GetValue("event.duration")
+	assert.NoError(t, err)
+
+	event = <-output
+	attempt, _ = event.GetValue("metricset.fetch.attempt")
+	assert.Equal(t, 2, attempt)
+	bytes, _ := event.GetValue("metricset.fetch.bytes")
+	assert.Equal(t, int64(128), bytes)
+	_, err = event.GetValue("event.duration")
+	assert.NoError(t, err)
+}
+
+func TestWrapperStartupTimeout(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":               moduleName,
+		"metricsets":           []string{failingFetcherName},
+		"hosts":                []string{"alpha"},
+		"period":               "10ms",
+		"failures":             100,
+		"startup.timeout":      "50ms",
+		"startup.backoff.init": "1ms",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	event := <-output
+	assert.Equal(t, "pending", event.Fields["service"].(common.MapStr)["state"])
+
+	// Once the timeout expires errors are reported every period.
+	for i := 0; i < 2; i++ {
+		event = <-output
+		message, _ := event.GetValue("error.message")
+		assert.Equal(t, "connection refused", message)
+		_, err := event.GetValue("service.state")
+		assert.Error(t, err)
+	}
+}
+
+func TestWrapperStartupConfigValidation(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":               moduleName,
+		"metricsets":           []string{failingFetcherName},
+		"startup.timeout":      "1m",
+		"startup.backoff.init": "10s",
+		"startup.backoff.max":  "1s",
+	})
+
+	_, err := module.NewWrapper(c, newTestRegistry(t))
+	assert.Error(t, err)
+}
+
+func TestWrapperProbesCapabilities(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":     proberModuleName,
+		"metricsets": []string{supportedName, unsupportedName},
+		"hosts":      []string{"alpha"},
+		"period":     "1h",
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t), module.WithMetricSetInfo())
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	var health, data []common.MapStr
+	for i := 0; i < 3; i++ {
+		select {
+		case event := <-output:
+			if _, err := event.GetValue("service.version"); err == nil {
+				health = append(health, event.Fields)
+			} else {
+				data = append(data, event.Fields)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for events")
+		}
+	}
+
+	// The capabilities are probed once per host, and reported in a health
+	// event per metricset.
+	assert.Equal(t, 1, m.Module.(*fakeProberModule).probeCount())
+	require.Len(t, health, 2)
+	for _, event := range health {
+		features, _ := event.GetValue("metricset.capabilities.features")
+		assert.Equal(t, []string{"basic"}, features)
+		name, _ := event.GetValue("metricset.name")
+		reason, _ := event.GetValue("metricset.capabilities.disabled")
+		if name == "unsupported" {
+			assert.Equal(t, unsupportedReason, reason)
+		} else {
+			assert.Nil(t, reason)
+		}
+	}
+
+	// Disabled metricsets don't fetch, the others get the capabilities.
+	require.Len(t, data, 1)
+	version, _ := data[0].GetValue("prober.supported.version")
+	assert.Equal(t, "1.2.3", version)
+}
+
+func TestWrapperRetriesCapabilitiesProbe(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":         proberModuleName,
+		"metricsets":     []string{supportedName},
+		"hosts":          []string{"alpha"},
+		"period":         "10ms",
+		"probe_failures": 2,
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	// The metricset runs with the default capabilities while the probe fails.
+	event := <-output
+	version, _ := event.GetValue("prober.supported.version")
+	assert.Equal(t, "", version)
+
+	for {
+		select {
+		case event = <-output:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timeout waiting for the capabilities")
+		}
+		if version, _ := event.GetValue("prober.supported.version"); version == "1.2.3" {
+			break
+		}
+	}
+	assert.Equal(t, 3, m.Module.(*fakeProberModule).probeCount())
+}
+
+func TestWrapperCapabilitiesProbeDisabled(t *testing.T) {
+	c := newConfig(t, map[string]interface{}{
+		"module":             proberModuleName,
+		"metricsets":         []string{unsupportedName},
+		"hosts":              []string{"alpha"},
+		"capabilities.probe": false,
+	})
+
+	m, err := module.NewWrapper(c, newTestRegistry(t))
+	require.NoError(t, err)
+
+	done := make(chan struct{})
+	defer close(done)
+	output := m.Start(done)
+
+	event := <-output
+	_, err = event.GetValue("service.version")
+	assert.Error(t, err)
+	assert.Equal(t, 0, m.Module.(*fakeProberModule).probeCount())
+}
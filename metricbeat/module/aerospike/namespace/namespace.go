@@ -24,6 +24,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/match"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/module/aerospike"
 )
@@ -44,13 +45,23 @@ type MetricSet struct {
 	mb.BaseMetricSet
 	host   *as.Host
 	client *as.Client
+	nodes  nodesConfig
+}
+
+// nodesConfig selects the nodes of the cluster to report. Nodes are learnt from
+// the cluster topology by the client, starting from the configured host.
+type nodesConfig struct {
+	Include []match.Matcher `config:"include"`
+	Exclude []match.Matcher `config:"exclude"`
 }
 
 // New create a new instance of the MetricSet
 // Part of new is also setting up the configuration by processing additional
 // configuration entries if needed.
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
-	config := struct{}{}
+	config := struct {
+		Nodes nodesConfig `config:"nodes"`
+	}{}
 	if err := base.Module().UnpackConfig(&config); err != nil {
 		return nil, err
 	}
@@ -63,6 +74,7 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &MetricSet{
 		BaseMetricSet: base,
 		host:          host,
+		nodes:         config.Nodes,
 	}, nil
 }
 
@@ -75,6 +87,10 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 	}
 
 	for _, node := range m.client.GetNodes() {
+		if !m.nodes.selected(node.GetHost().String(), node.GetName()) {
+			continue
+		}
+
 		info, err := as.RequestNodeInfo(node, "namespaces")
 		if err != nil {
 			m.Logger().Error("Failed to retrieve namespaces from node %s", node.GetName())
@@ -102,6 +118,26 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 	return nil
 }
 
+// selected returns true if the host or the name of a node match any of the include
+// patterns, if any, and none of the exclude patterns.
+func (c nodesConfig) selected(host, name string) bool {
+	if len(c.Include) != 0 && !anyMatch(c.Include, host, name) {
+		return false
+	}
+	return !anyMatch(c.Exclude, host, name)
+}
+
+func anyMatch(matchers []match.Matcher, values ...string) bool {
+	for _, m := range matchers {
+		for _, v := range values {
+			if m.MatchString(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // create an aerospike client if it doesn't exist yet
 func (m *MetricSet) connect() error {
 	if m.client == nil {
@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package namespace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestNodesSelected(t *testing.T) {
+	cases := []struct {
+		config   map[string]interface{}
+		host     string
+		name     string
+		selected bool
+	}{
+		{map[string]interface{}{}, "10.0.0.1:3000", "BB9020011AC4202", true},
+		{map[string]interface{}{"include": []string{`^10\.0\.`}}, "10.0.0.1:3000", "BB9020011AC4202", true},
+		{map[string]interface{}{"include": []string{`^BB9020011AC4202$`}}, "10.1.0.1:3000", "BB9020011AC4202", true},
+		{map[string]interface{}{"include": []string{`^10\.0\.`}}, "10.1.0.1:3000", "BB9020011AC4202", false},
+		{map[string]interface{}{"exclude": []string{`^BB9020011AC4202$`}}, "10.0.0.1:3000", "BB9020011AC4202", false},
+	}
+
+	for _, c := range cases {
+		var config nodesConfig
+		require.NoError(t, common.MustNewConfigFrom(c.config).Unpack(&config))
+		assert.Equal(t, c.selected, config.selected(c.host, c.name), "%v - %s", c.config, c.host)
+	}
+}
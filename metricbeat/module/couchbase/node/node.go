@@ -20,6 +20,7 @@ package node
 import (
 	"github.com/pkg/errors"
 
+	"github.com/elastic/beats/v7/libbeat/common/match"
 	"github.com/elastic/beats/v7/metricbeat/helper"
 	"github.com/elastic/beats/v7/metricbeat/mb"
 	"github.com/elastic/beats/v7/metricbeat/mb/parse"
@@ -46,14 +47,29 @@ func init() {
 	)
 }
 
+// nodesConfig selects the nodes of the cluster to report. Nodes are learnt from
+// the cluster topology returned by the configured hosts.
+type nodesConfig struct {
+	Include []match.Matcher `config:"include"`
+	Exclude []match.Matcher `config:"exclude"`
+}
+
 // MetricSet type defines all fields of the MetricSet
 type MetricSet struct {
 	mb.BaseMetricSet
-	http *helper.HTTP
+	http  *helper.HTTP
+	nodes nodesConfig
 }
 
 // New create a new instance of the MetricSet
 func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	config := struct {
+		Nodes nodesConfig `config:"nodes"`
+	}{}
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
 	http, err := helper.NewHTTP(base)
 	if err != nil {
 		return nil, err
@@ -61,6 +77,7 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 	return &MetricSet{
 		BaseMetricSet: base,
 		http:          http,
+		nodes:         config.Nodes,
 	}, nil
 }
 
@@ -75,8 +92,30 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 
 	events := eventsMapping(content)
 	for _, event := range events {
+		hostname, _ := event["hostname"].(string)
+		if !m.nodes.selected(hostname) {
+			continue
+		}
 		reporter.Event(mb.Event{MetricSetFields: event})
 	}
 
 	return nil
 }
+
+// selected returns true if the node with the given hostname matches any of the
+// include patterns, if any, and none of the exclude patterns.
+func (c nodesConfig) selected(hostname string) bool {
+	if len(c.Include) != 0 && !anyMatch(c.Include, hostname) {
+		return false
+	}
+	return !anyMatch(c.Exclude, hostname)
+}
+
+func anyMatch(matchers []match.Matcher, s string) bool {
+	for _, m := range matchers {
+		if m.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
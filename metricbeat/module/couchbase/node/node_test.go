@@ -22,6 +22,10 @@ package node
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
 	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
 
 	_ "github.com/elastic/beats/v7/metricbeat/module/couchbase"
@@ -30,3 +34,23 @@ import (
 func TestData(t *testing.T) {
 	mbtest.TestDataFiles(t, "couchbase", "node")
 }
+
+func TestNodesSelected(t *testing.T) {
+	cases := []struct {
+		config   map[string]interface{}
+		hostname string
+		selected bool
+	}{
+		{map[string]interface{}{}, "10.0.0.1:8091", true},
+		{map[string]interface{}{"include": []string{`^10\.0\.`}}, "10.0.0.1:8091", true},
+		{map[string]interface{}{"include": []string{`^10\.0\.`}}, "10.1.0.1:8091", false},
+		{map[string]interface{}{"exclude": []string{`:8091$`}}, "10.0.0.1:8091", false},
+		{map[string]interface{}{"include": []string{`^10\.`}, "exclude": []string{`^10\.0\.0\.2:`}}, "10.0.0.2:8091", false},
+	}
+
+	for _, c := range cases {
+		var config nodesConfig
+		require.NoError(t, common.MustNewConfigFrom(c.config).Unpack(&config))
+		assert.Equal(t, c.selected, config.selected(c.hostname), "%v - %s", c.config, c.hostname)
+	}
+}
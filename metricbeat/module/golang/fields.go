@@ -32,5 +32,5 @@ func init() {
 // AssetGolang returns asset data.
 // This is the base64 encoded gzipped contents of module/golang.
 func AssetGolang() string {
-	return "eJzkV9tu4zYQffdXHPgpiyb+AANdYOui7kObFkhbFCgKYyyNJDa8CCRlW39fkJIcx5G0cjaBe9GTTQkz55y5cOYOj1wvkRtJOp8BXnjJS8zX8WA+A1J2iRWlF0Yv8XEGAM1LKJNWkmeAK4z1m8ToTORLZCRdOLUsmRwvkVP4hr0XOndL/DF3Ts5vMS+8L+d/zoBMsEzdMtq+gybFJ4jC4+syHFlTle1JD6rndk5t8aHckT0e99kbtNk8Lyw8J9c95wBOQSQqlULzs3cdkkeu98amZ+9G8ITnl4I7ozAZfCEc1galNbklBefJeuyFLxYvBCmYyi+RI7g+cRXMQejMWEXh8yCXcZxiW7fKLf710vUCy5NeTOdKTkC0JrulnJEYKTnxxsJVSpGtF2ef9ul0CskbT3JTUuXO9RrHNwFjI12wj/UK0QXSyjYhNzu2kCJjL1QUtbQmYefO8Y9xOOWhXb//loI0x+bwGhYAvu2QC437HpjPBU1Mpf2goANopuupK7VlG2Rbr7Anh4LKkjWnw7g0H/wmTzZSKHExsqZUl9jWnvuEnoL8ng++y9eg415I2eLGvmCN75nKT1KaBB+bEiMVVBymlJTVJrMUzQ0yyqShPr5TIH/XGg9Cr37+FTFXq7ZTrVfDyN6vnn4g51+Wkwu/hM7hC4YyWngT/5ZshelJimk1NZTEeMOywio4aTP5rEccOQnXJc4IoyfcrlKjqIf0vwT2UF+7FPN4JDClw2FKOC7hNqHbnQJTdHh3uX+kw39X7IvUpt2Q17dT+9OObZgu/n+K945trnaez1vKq0e3cM1109rtvhBJ0Vyr8R43W09Cc4rMGtU6ftVId4UbvmmJDZc+HrhxlQqd/vfD4aF22LI0+w/Dl2hn4wpUfhMU4/RQu9sRRou4ymxc7fB181OkkvFV+1tXjofpOU/J4xW4fRPpdFNMRAEKYxd5Y29BOg1ThOMLs/KJWLuwXSNuDbcOALyJA9FPD58p8JZ+GKTetMpP7L52P1PRRp8cX7pK3B93iNbHcEQzy70ReTsI0cNYK/iLE/8eEM73qTZknHY+P7PpXS3Jn4De8I41RCNi+gG+sKbKC1P5mP3dkj1M5GjqH0AmtB9tPGr2DR/cOFIMcmh2Q9qaHY/cGqEDX42H0NE/XEl6JHPCVrm7Kkpt9N0R6WL2dwAAAP//qwVEDQ=="
+	return "eJzlWcuO2zYU3fcrLrxKUMfZFF0YaIB02k4X7SToJEWBovDQEmWxoUiCpPzo1+eSomRZpmTZMwM3rRaGLcuX5xzeJ/0KPtHdHFaSE7H6CsAyy+kcJrf+xgTvpNQkminLpJjDG7wBUH0JhUxLTvGOyaW2i0SKjK3mkBFu3F1NOSUGja2Ie4Zay8TKzOHPiTF8MoVJbq2a/IXfZYzy1My97VcgSEFbiNxld8rd0rJU4U4E1aGdti26VWuim9sxe702q+vIwiG5+uoCaINIipQzQQ++q5HgHmykTjvfDeBx14ec1kZBZmBzZnBnQGm50qQAY4m2sGE2nx0JklOiHiOHW7q1lDMHTGRSF8Q97uSShqaw3AXlZl+8dFFgqySKqavkCES3RC/JClFJzmlipQZTFgXRu1nn0ZhObUhWWsIXipSmq9cwvhEY/fXB2YfbG/BLQFrqasvlmmrgLKOWFV5UFDOhxnTxD3Fo8xAmvn6gwGWTHC5hgdcPNXIm4C4C81DQRJbC9grag2a8nqIslqgfyobKboiBnChFBU37cQm6tYtVsuCsYGcjq0J1jgFqaUzoMcjvEEDtr07HDeM84IZNji8/Y1p4y7lM4E0VYqRwKvZTSlS5yDTx5noZZVySGN8xkH8Kxp3QN+8/gvfVMmSq25t+ZM8XT78QY4/Dybh3WC5RN4p1VjDMCO6joprJiFOMi6k+J94zeXxYwY1bJHhyJ0c0nNAXguMMMNrjxlQ4iLpP/3Ng9+W1czEP70SbVW+G2xMb2I5zuMHpbNcGVpDts8v9K9n+d8U+S22y7lv16dR+i8XZdRf/P8WjbZvZGUu7KeXi1s2Vubpbm25yluRVWfV1XC4twS4zhUzLIix8UUt3hQpfpcSKS4wHvEDWLtP/sd3e7wwsKZebl/1FtLZxBSq/M+L3CWFOBxjN/CizwA/wXfWWpZzC1+G9wNDpp4dTQ/LpCty+93TqLsajAOLaLoJNwxSISF0XgUF/nlfuiYWB7Rr7VnGrAWAk+Ibo3f2JAA/0XSP1pFHesnvpfFZ4GzE5HjtK3DUzRFijf0czTaM78nQQ/ApDqeBvrDTPAaE7T4UtQ+cJa56Y9K7m5HugL+gapydWiZi+RJdHd13lsrTe++shu59IY+pfQMalHyEt7Kit+GDVQJCAWaiaDclSrulA1XAZ+Go8sJvwFcAoIgY8x02V66uiFFK8apAeH/gpLTN2oOPZZ35uXg5mjB/vmvMyLKkJUbbUdUFBL2UaBLWv3RHva+V+BlSkSjLRDcAfCfZL6ND4qqmS2qLzCHARYEOKZf/Ug3BYvqppzVMoI1C0cmDWn+pRyEqRVOl6k1PtRmmctv3U78qgUfTwOKI5mlxSO/ZwEr1ZcbpwekZrzWUHlPjTmkO1AKwJL0Odn8JDosoHz7y9LbMowFIcnQ89AtlHtBZFhqAEEdLQRIrUjAVXDyKznkodCZ06bOqfnoe/GRQChwAvjq4iOBrZiaX31THYdYF7EkOsKl2KoCqOUb86hDL1H5ojMh8o7SOpQcSabKJ4l0xgw3Qe4t/IZo9pibH57TeYSRKZuijAEvlQI2Ei4WVKF7j4A+AwSwVZ8u75adP/hLTwVM3hO0EvzDjuOtUyutfe2hIP4hGY3XVHiiPgKKuX0m01w2yscJ4gq4Fuo1NYnhDevSx1Qv0CXZQD0woRZ09hI7C8l4a180YNpA4cK9V+792MCRkntoquAe3wmZl/5hkg3x8EeBXDAe0evTWUZycAqqT/DwaTEAyzRd+pfJ2sFcWdjB4+jyGSE914gM+HneR1GbekLK6qfZjMWykDSyvKyQc6TYf5C92OIbqfASLXHKU="
 }
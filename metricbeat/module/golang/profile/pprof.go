@@ -0,0 +1,357 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// profile contains the parts of a pprof profile needed to summarize it. See
+// https://github.com/google/pprof/blob/master/proto/profile.proto for the
+// format.
+type profile struct {
+	sampleTypes   []valueType
+	samples       []sample
+	locations     map[uint64][]uint64 // function ids of each location, innermost first
+	functions     map[uint64]function
+	strings       []string
+	durationNanos int64
+}
+
+type valueType struct {
+	typ, unit int64
+}
+
+type sample struct {
+	locationIDs []uint64
+	values      []int64
+}
+
+type function struct {
+	name, filename int64
+}
+
+// functionStats contains the time spent in a function, flat in the function
+// itself and cumulative with the functions it calls.
+type functionStats struct {
+	Name     string
+	Filename string
+	Flat     int64
+	Cum      int64
+}
+
+// Field numbers of the profile.proto messages.
+const (
+	profileSampleType    = 1
+	profileSample        = 2
+	profileLocation      = 4
+	profileFunction      = 5
+	profileStringTable   = 6
+	profileDurationNanos = 10
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+
+	functionID       = 1
+	functionName     = 2
+	functionFilename = 4
+)
+
+// parseProfile decodes a pprof profile, gzip compressed or not.
+func parseProfile(data []byte) (*profile, error) {
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("decompressing profile: %w", err)
+		}
+		data, err = ioutil.ReadAll(r)
+		if err != nil {
+			return nil, fmt.Errorf("decompressing profile: %w", err)
+		}
+	}
+
+	p := &profile{
+		locations: make(map[uint64][]uint64),
+		functions: make(map[uint64]function),
+	}
+	err := decodeMessage(data, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case profileSampleType:
+			v, n, err := decodeValueType(b)
+			p.sampleTypes = append(p.sampleTypes, v)
+			return n, err
+		case profileSample:
+			s, n, err := decodeSample(b)
+			p.samples = append(p.samples, s)
+			return n, err
+		case profileLocation:
+			return decodeLocation(b, p.locations)
+		case profileFunction:
+			return decodeFunction(b, p.functions)
+		case profileStringTable:
+			s, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return n, protowire.ParseError(n)
+			}
+			p.strings = append(p.strings, string(s))
+			return n, nil
+		case profileDurationNanos:
+			v, n := protowire.ConsumeVarint(b)
+			p.durationNanos = int64(v)
+			return n, nil
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("decoding profile: %w", err)
+	}
+	return p, nil
+}
+
+// decodeMessage calls fn for each field of a message, fn must consume the
+// value of the field and return its length.
+func decodeMessage(b []byte, fn func(protowire.Number, protowire.Type, []byte) (int, error)) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		n, err := fn(num, typ, b)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return nil
+}
+
+// decodeEmbedded decodes an embedded message with fn.
+func decodeEmbedded(b []byte, fn func(protowire.Number, protowire.Type, []byte) (int, error)) (int, error) {
+	msg, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return n, protowire.ParseError(n)
+	}
+	return n, decodeMessage(msg, fn)
+}
+
+// decodeVarints decodes repeated varint fields, packed or not.
+func decodeVarints(typ protowire.Type, b []byte, fn func(uint64)) (int, error) {
+	if typ == protowire.VarintType {
+		v, n := protowire.ConsumeVarint(b)
+		fn(v)
+		return n, nil
+	}
+
+	packed, n := protowire.ConsumeBytes(b)
+	if n < 0 {
+		return n, protowire.ParseError(n)
+	}
+	for len(packed) > 0 {
+		v, m := protowire.ConsumeVarint(packed)
+		if m < 0 {
+			return m, protowire.ParseError(m)
+		}
+		fn(v)
+		packed = packed[m:]
+	}
+	return n, nil
+}
+
+func decodeValueType(b []byte) (valueType, int, error) {
+	var v valueType
+	n, err := decodeEmbedded(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case valueTypeType:
+			x, n := protowire.ConsumeVarint(b)
+			v.typ = int64(x)
+			return n, nil
+		case valueTypeUnit:
+			x, n := protowire.ConsumeVarint(b)
+			v.unit = int64(x)
+			return n, nil
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	return v, n, err
+}
+
+func decodeSample(b []byte) (sample, int, error) {
+	var s sample
+	n, err := decodeEmbedded(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case sampleLocationID:
+			return decodeVarints(typ, b, func(v uint64) { s.locationIDs = append(s.locationIDs, v) })
+		case sampleValue:
+			return decodeVarints(typ, b, func(v uint64) { s.values = append(s.values, int64(v)) })
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	return s, n, err
+}
+
+func decodeLocation(b []byte, locations map[uint64][]uint64) (int, error) {
+	var id uint64
+	var functionIDs []uint64
+	n, err := decodeEmbedded(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case locationID:
+			v, n := protowire.ConsumeVarint(b)
+			id = v
+			return n, nil
+		case locationLine:
+			return decodeEmbedded(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+				if num == lineFunctionID {
+					v, n := protowire.ConsumeVarint(b)
+					functionIDs = append(functionIDs, v)
+					return n, nil
+				}
+				return protowire.ConsumeFieldValue(num, typ, b), nil
+			})
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	locations[id] = functionIDs
+	return n, err
+}
+
+func decodeFunction(b []byte, functions map[uint64]function) (int, error) {
+	var id uint64
+	var f function
+	n, err := decodeEmbedded(b, func(num protowire.Number, typ protowire.Type, b []byte) (int, error) {
+		switch num {
+		case functionID:
+			v, n := protowire.ConsumeVarint(b)
+			id = v
+			return n, nil
+		case functionName:
+			v, n := protowire.ConsumeVarint(b)
+			f.name = int64(v)
+			return n, nil
+		case functionFilename:
+			v, n := protowire.ConsumeVarint(b)
+			f.filename = int64(v)
+			return n, nil
+		}
+		return protowire.ConsumeFieldValue(num, typ, b), nil
+	})
+	functions[id] = f
+	return n, err
+}
+
+func (p *profile) string(i int64) string {
+	if i < 0 || i >= int64(len(p.strings)) {
+		return ""
+	}
+	return p.strings[i]
+}
+
+// valueIndex returns the index of the sample values of the given type, or of
+// the last one if there is no such type, as pprof does by default.
+func (p *profile) valueIndex(sampleType string) int {
+	for i, v := range p.sampleTypes {
+		if p.string(v.typ) == sampleType {
+			return i
+		}
+	}
+	return len(p.sampleTypes) - 1
+}
+
+// unit returns the unit of the sample values in the given index.
+func (p *profile) unit(index int) string {
+	if index < 0 || index >= len(p.sampleTypes) {
+		return ""
+	}
+	return p.string(p.sampleTypes[index].unit)
+}
+
+// topFunctions returns the n functions with the highest flat values of the
+// given index, and the total of these values.
+func (p *profile) topFunctions(index, n int) ([]functionStats, int64) {
+	stats := make(map[uint64]*functionStats)
+	var total int64
+
+	for _, s := range p.samples {
+		if index < 0 || index >= len(s.values) {
+			continue
+		}
+		value := s.values[index]
+		total += value
+
+		// Functions are counted once per sample in the cumulative value,
+		// even if they appear several times in recursive calls.
+		seen := make(map[uint64]bool)
+		for i, locationID := range s.locationIDs {
+			for j, functionID := range p.locations[locationID] {
+				st, found := stats[functionID]
+				if !found {
+					f := p.functions[functionID]
+					st = &functionStats{
+						Name:     p.string(f.name),
+						Filename: p.string(f.filename),
+					}
+					stats[functionID] = st
+				}
+				// The leaf function is the innermost one of the first location
+				if i == 0 && j == 0 {
+					st.Flat += value
+				}
+				if !seen[functionID] {
+					st.Cum += value
+					seen[functionID] = true
+				}
+			}
+		}
+	}
+
+	top := make([]functionStats, 0, len(stats))
+	for _, st := range stats {
+		top = append(top, *st)
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].Flat != top[j].Flat {
+			return top[i].Flat > top[j].Flat
+		}
+		if top[i].Cum != top[j].Cum {
+			return top[i].Cum > top[j].Cum
+		}
+		return top[i].Name < top[j].Name
+	})
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top, total
+}
@@ -0,0 +1,185 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/helper"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/mb/parse"
+)
+
+const (
+	defaultScheme = "http"
+	defaultPath   = "/debug/pprof/profile"
+
+	// cpuSampleType is the type of the values of CPU profiles, in nanoseconds.
+	cpuSampleType = "cpu"
+)
+
+var (
+	hostParser = parse.URLHostParserBuilder{
+		DefaultScheme: defaultScheme,
+		DefaultPath:   defaultPath,
+		PathConfigKey: "profile.path",
+	}.Build()
+)
+
+// init registers the MetricSet with the central registry.
+func init() {
+	mb.Registry.MustAddMetricSet("golang", "profile", New,
+		mb.WithHostParser(hostParser),
+	)
+}
+
+type config struct {
+	Duration   time.Duration `config:"profile.duration" validate:"positive"`
+	Top        int           `config:"profile.top" validate:"min=1"`
+	IncludeRaw bool          `config:"profile.include_raw"`
+}
+
+func defaultConfig() config {
+	return config{
+		Duration: 10 * time.Second,
+		Top:      10,
+	}
+}
+
+// MetricSet fetches CPU profiles from the net/http/pprof endpoints of Go
+// programs and reports the functions where most time is spent.
+type MetricSet struct {
+	mb.BaseMetricSet
+	http   *helper.HTTP
+	config config
+}
+
+// New creates a new instance of the MetricSet
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The golang profile metricset is beta.")
+
+	config := defaultConfig()
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	if config.Duration%time.Second != 0 {
+		return nil, fmt.Errorf("profile.duration must be a whole number of seconds, got %v", config.Duration)
+	}
+	if timeout := base.Module().Config().Timeout; config.Duration >= timeout {
+		return nil, fmt.Errorf("profile.duration (%v) must be shorter than the timeout (%v), increase the period or the timeout of the module", config.Duration, timeout)
+	}
+
+	http, err := helper.NewHTTP(base)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(http.GetURI())
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid profile URL")
+	}
+	q := u.Query()
+	q.Set("seconds", strconv.Itoa(int(config.Duration/time.Second)))
+	u.RawQuery = q.Encode()
+	http.SetURI(u.String())
+
+	return &MetricSet{
+		BaseMetricSet: base,
+		http:          http,
+		config:        config,
+	}, nil
+}
+
+// Fetch captures a CPU profile and reports an event summarizing it, followed
+// by an event for each of the top functions.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	data, err := m.http.FetchContent()
+	if err != nil {
+		return errors.Wrap(err, "error in http fetch")
+	}
+
+	p, err := parseProfile(data)
+	if err != nil {
+		return err
+	}
+
+	index := p.valueIndex(cpuSampleType)
+	if index < 0 {
+		return errors.New("profile doesn't contain samples")
+	}
+	top, total := p.topFunctions(index, m.config.Top)
+
+	summary := common.MapStr{
+		"sample_type": p.string(p.sampleTypes[index].typ),
+		"unit":        p.unit(index),
+		"duration": common.MapStr{
+			"ns": p.durationNanos,
+		},
+		"samples": len(p.samples),
+		"total":   total,
+	}
+	if m.config.IncludeRaw {
+		summary["raw"] = base64.StdEncoding.EncodeToString(data)
+	}
+	if !reporter.Event(mb.Event{MetricSetFields: summary}) {
+		return nil
+	}
+
+	for i, f := range top {
+		event := mb.Event{
+			MetricSetFields: common.MapStr{
+				"sample_type": summary["sample_type"],
+				"unit":        summary["unit"],
+				"function": common.MapStr{
+					"name": f.Name,
+					"file": f.Filename,
+					"rank": i + 1,
+					"flat": common.MapStr{
+						"value": f.Flat,
+						"pct":   share(f.Flat, total),
+					},
+					"cum": common.MapStr{
+						"value": f.Cum,
+						"pct":   share(f.Cum, total),
+					},
+				},
+			},
+		}
+		if !reporter.Event(event) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func share(value, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return common.Round(float64(value)/float64(total), common.DefaultDecimalPlacesCount)
+}
@@ -0,0 +1,238 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+// profileBuilder encodes pprof profiles for tests.
+type profileBuilder struct {
+	b       []byte
+	strings map[string]int64
+	table   []string
+}
+
+func newProfileBuilder() *profileBuilder {
+	pb := &profileBuilder{strings: make(map[string]int64)}
+	pb.str("")
+	return pb
+}
+
+func (pb *profileBuilder) str(s string) uint64 {
+	if i, found := pb.strings[s]; found {
+		return uint64(i)
+	}
+	pb.strings[s] = int64(len(pb.table))
+	pb.table = append(pb.table, s)
+	return uint64(len(pb.table) - 1)
+}
+
+func (pb *profileBuilder) embed(num protowire.Number, msg []byte) {
+	pb.b = protowire.AppendTag(pb.b, num, protowire.BytesType)
+	pb.b = protowire.AppendBytes(pb.b, msg)
+}
+
+func appendVarint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func (pb *profileBuilder) sampleType(typ, unit string) {
+	var msg []byte
+	msg = appendVarint(msg, valueTypeType, pb.str(typ))
+	msg = appendVarint(msg, valueTypeUnit, pb.str(unit))
+	pb.embed(profileSampleType, msg)
+}
+
+func (pb *profileBuilder) function(id uint64, name string) {
+	var msg []byte
+	msg = appendVarint(msg, functionID, id)
+	msg = appendVarint(msg, functionName, pb.str(name))
+	msg = appendVarint(msg, functionFilename, pb.str(name+".go"))
+	pb.embed(profileFunction, msg)
+}
+
+func (pb *profileBuilder) location(id uint64, functionIDs ...uint64) {
+	msg := appendVarint(nil, locationID, id)
+	for _, f := range functionIDs {
+		msg = protowire.AppendTag(msg, locationLine, protowire.BytesType)
+		msg = protowire.AppendBytes(msg, appendVarint(nil, lineFunctionID, f))
+	}
+	pb.embed(profileLocation, msg)
+}
+
+// sample adds a sample with packed locations and unpacked values.
+func (pb *profileBuilder) sample(locationIDs []uint64, values ...int64) {
+	var packed []byte
+	for _, id := range locationIDs {
+		packed = protowire.AppendVarint(packed, id)
+	}
+	msg := protowire.AppendTag(nil, sampleLocationID, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, packed)
+	for _, v := range values {
+		msg = appendVarint(msg, sampleValue, uint64(v))
+	}
+	pb.embed(profileSample, msg)
+}
+
+func (pb *profileBuilder) bytes(durationNanos int64) []byte {
+	b := append([]byte{}, pb.b...)
+	for _, s := range pb.table {
+		b = protowire.AppendTag(b, profileStringTable, protowire.BytesType)
+		b = protowire.AppendString(b, s)
+	}
+	return appendVarint(b, profileDurationNanos, uint64(durationNanos))
+}
+
+// testProfile returns a CPU profile where main calls work, that calls
+// compute, main.work is inlined in main.main in location 3.
+func testProfile(t *testing.T) []byte {
+	pb := newProfileBuilder()
+	pb.sampleType("samples", "count")
+	pb.sampleType("cpu", "nanoseconds")
+	pb.function(1, "main.main")
+	pb.function(2, "main.work")
+	pb.function(3, "main.compute")
+	pb.location(1, 3)
+	pb.location(2, 2)
+	pb.location(3, 2, 1)
+	pb.location(4, 1)
+
+	// 60ms in compute, 30ms in work, 10ms in main
+	pb.sample([]uint64{1, 2, 4}, 6, 60000000)
+	pb.sample([]uint64{3}, 3, 30000000)
+	pb.sample([]uint64{4}, 1, 10000000)
+
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	_, err := w.Write(pb.bytes(10000000000))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestParseProfile(t *testing.T) {
+	p, err := parseProfile(testProfile(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(10000000000), p.durationNanos)
+	assert.Len(t, p.samples, 3)
+
+	index := p.valueIndex("cpu")
+	assert.Equal(t, 1, index)
+	assert.Equal(t, "nanoseconds", p.unit(index))
+
+	top, total := p.topFunctions(index, 2)
+	assert.Equal(t, int64(100000000), total)
+	assert.Equal(t, []functionStats{
+		{Name: "main.compute", Filename: "main.compute.go", Flat: 60000000, Cum: 60000000},
+		{Name: "main.work", Filename: "main.work.go", Flat: 30000000, Cum: 90000000},
+	}, top)
+
+	top, _ = p.topFunctions(index, 10)
+	require.Len(t, top, 3)
+	assert.Equal(t, functionStats{Name: "main.main", Filename: "main.main.go", Flat: 10000000, Cum: 100000000}, top[2])
+
+	_, err = parseProfile([]byte{0xff})
+	assert.Error(t, err)
+}
+
+func TestFetch(t *testing.T) {
+	profile := testProfile(t)
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != defaultPath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query = r.URL.RawQuery
+		w.Write(profile)
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":              "golang",
+		"metricsets":          []string{"profile"},
+		"hosts":               []string{server.URL},
+		"period":              "1m",
+		"profile.duration":    "5s",
+		"profile.top":         2,
+		"profile.include_raw": true,
+	}
+
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+	events, errs := mbtest.ReportingFetchV2Error(f)
+	require.Empty(t, errs)
+	require.Len(t, events, 3)
+	assert.Equal(t, "seconds=5", query)
+
+	summary := events[0].MetricSetFields
+	assert.Equal(t, "cpu", summary["sample_type"])
+	assert.Equal(t, int64(100000000), summary["total"])
+	assert.Equal(t, 3, summary["samples"])
+	assert.NotEmpty(t, summary["raw"])
+
+	assert.Equal(t, common.MapStr{
+		"name": "main.work",
+		"file": "main.work.go",
+		"rank": 2,
+		"flat": common.MapStr{
+			"value": int64(30000000),
+			"pct":   0.3,
+		},
+		"cum": common.MapStr{
+			"value": int64(90000000),
+			"pct":   0.9,
+		},
+	}, events[2].MetricSetFields["function"])
+}
+
+func TestNewInvalidDuration(t *testing.T) {
+	for name, config := range map[string]map[string]interface{}{
+		"longer than timeout": {
+			"period":           "10s",
+			"profile.duration": "10s",
+		},
+		"fractional seconds": {
+			"period":           "1m",
+			"profile.duration": "1500ms",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			config["module"] = "golang"
+			config["metricsets"] = []string{"profile"}
+			config["hosts"] = []string{"localhost:6060"}
+
+			_, _, err := mb.NewModule(common.MustNewConfigFrom(config), mb.Registry)
+			assert.Error(t, err)
+		})
+	}
+}
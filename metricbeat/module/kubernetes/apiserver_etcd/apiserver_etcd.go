@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apiserver_etcd
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
+)
+
+const requestDurationMetric = "etcd_request_duration_seconds"
+
+// Operations aggregated as reads, any other operation is aggregated as a write.
+var readOperations = map[string]bool{
+	"get":           true,
+	"getToList":     true,
+	"list":          true,
+	"listWithCount": true,
+	"count":         true,
+}
+
+// Percentiles estimated from the requests done since the previous fetch
+var percentiles = map[string]float64{
+	"p50": 0.5,
+	"p90": 0.9,
+	"p99": 0.99,
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("kubernetes", "apiserver_etcd", New,
+		mb.WithHostParser(prometheus.HostParser))
+}
+
+// MetricSet for the etcd request latencies of the apiserver. Latencies are
+// aggregated per resource in reads and writes.
+type MetricSet struct {
+	mb.BaseMetricSet
+	prometheus prometheus.Prometheus
+
+	// histograms of the previous fetch, to estimate the percentiles of the
+	// requests done since then
+	previous map[histogramKey]*histogram
+}
+
+type histogramKey struct {
+	resource string
+	kind     string
+}
+
+// New creates a new apiserver_etcd metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The kubernetes apiserver_etcd metricset is beta.")
+
+	pc, err := prometheus.NewPrometheusClient(base)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricSet{
+		BaseMetricSet: base,
+		prometheus:    pc,
+	}, nil
+}
+
+// Fetch fetches the etcd request metrics of the apiserver.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	families, err := m.prometheus.GetFamilies()
+	if err != nil {
+		return errors.Wrap(err, "error getting metrics")
+	}
+
+	histograms := make(map[histogramKey]*histogram)
+	for _, family := range families {
+		if family.GetName() != requestDurationMetric {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if metric.GetHistogram() == nil {
+				continue
+			}
+
+			// Resources are reported with their Go type, e.g. *core.Pod
+			resource := strings.TrimPrefix(util.GetLabel(metric, "type"), "*")
+			if resource == "" {
+				continue
+			}
+			kind := "write"
+			if readOperations[util.GetLabel(metric, "operation")] {
+				kind = "read"
+			}
+
+			key := histogramKey{resource: resource, kind: kind}
+			h, found := histograms[key]
+			if !found {
+				h = newHistogram()
+				histograms[key] = h
+			}
+			h.add(metric.GetHistogram())
+		}
+	}
+
+	events := make(map[string]common.MapStr)
+	for key, h := range histograms {
+		event, found := events[key.resource]
+		if !found {
+			event = common.MapStr{"resource": key.resource}
+			events[key.resource] = event
+		}
+
+		duration := common.MapStr{
+			"sum": int64(h.sum * 1000000),
+		}
+		if delta := h.since(m.previous[key]); delta != nil && delta.count > 0 {
+			for name, q := range percentiles {
+				duration[name] = int64(delta.quantile(q) * 1000000)
+			}
+		}
+		event[key.kind] = common.MapStr{
+			"count": int64(h.count),
+			"duration": common.MapStr{
+				"us": duration,
+			},
+		}
+	}
+	m.previous = histograms
+
+	for _, event := range events {
+		if !reporter.Event(mb.Event{MetricSetFields: event}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+// Close closes the prometheus client.
+func (m *MetricSet) Close() error {
+	return m.prometheus.Close()
+}
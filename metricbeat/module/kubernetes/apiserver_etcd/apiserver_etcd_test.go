@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package apiserver_etcd
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus/ptest"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+	_ "github.com/elastic/beats/v7/metricbeat/module/kubernetes"
+)
+
+func TestEventMapping(t *testing.T) {
+	ptest.TestMetricSet(t, "kubernetes", "apiserver_etcd",
+		ptest.TestCases{
+			{
+				MetricsFile:  "./_meta/test/metrics.1.20",
+				ExpectedFile: "./_meta/test/metrics.1.20.expected",
+			},
+		},
+	)
+}
+
+func TestData(t *testing.T) {
+	mbtest.TestDataFiles(t, "kubernetes", "apiserver_etcd")
+}
+
+const metricsTemplate = `# TYPE etcd_request_duration_seconds histogram
+etcd_request_duration_seconds_bucket{operation="get",type="*core.Pod",le="0.1"} %d
+etcd_request_duration_seconds_bucket{operation="get",type="*core.Pod",le="1"} %d
+etcd_request_duration_seconds_bucket{operation="get",type="*core.Pod",le="+Inf"} %d
+etcd_request_duration_seconds_sum{operation="get",type="*core.Pod"} %f
+etcd_request_duration_seconds_count{operation="get",type="*core.Pod"} %d
+`
+
+func TestPercentilesSincePreviousFetch(t *testing.T) {
+	responses := []string{
+		// 100 requests below 100ms
+		fmt.Sprintf(metricsTemplate, 100, 100, 100, 2.5, 100),
+		// 100 new requests between 100ms and 1s
+		fmt.Sprintf(metricsTemplate, 100, 200, 200, 52.5, 200),
+		// Counters reset, 10 requests below 100ms
+		fmt.Sprintf(metricsTemplate, 10, 10, 10, 0.25, 10),
+	}
+	var response string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=ISO-8859-1")
+		w.Write([]byte(response))
+	}))
+	defer server.Close()
+
+	config := map[string]interface{}{
+		"module":     "kubernetes",
+		"metricsets": []string{"apiserver_etcd"},
+		"hosts":      []string{server.URL},
+	}
+	f := mbtest.NewReportingMetricSetV2Error(t, config)
+
+	fetch := func(body string) common.MapStr {
+		response = body
+		events, errs := mbtest.ReportingFetchV2Error(f)
+		require.Empty(t, errs)
+		require.Len(t, events, 1)
+		read, err := events[0].MetricSetFields.GetValue("read")
+		require.NoError(t, err)
+		return read.(common.MapStr)
+	}
+
+	// No percentiles on first fetch
+	read := fetch(responses[0])
+	assert.Equal(t, int64(100), read["count"])
+	assert.Equal(t, common.MapStr{"sum": int64(2500000)}, read["duration"].(common.MapStr)["us"])
+
+	read = fetch(responses[1])
+	assert.Equal(t, int64(200), read["count"])
+	assert.Equal(t, common.MapStr{
+		"sum": int64(52500000),
+		"p50": int64(550000),
+		"p90": int64(910000),
+		"p99": int64(991000),
+	}, read["duration"].(common.MapStr)["us"])
+
+	read = fetch(responses[2])
+	assert.Equal(t, int64(10), read["count"])
+	assert.Equal(t, common.MapStr{
+		"sum": int64(250000),
+		"p50": int64(50000),
+		"p90": int64(90000),
+		"p99": int64(99000),
+	}, read["duration"].(common.MapStr)["us"])
+}
@@ -0,0 +1,98 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apiserver_etcd
+
+import (
+	"math"
+	"sort"
+
+	dto "github.com/prometheus/client_model/go"
+)
+
+// histogram aggregates prometheus histograms with the same buckets.
+type histogram struct {
+	count uint64
+	sum   float64
+	// cumulative counts by upper bound
+	buckets map[float64]uint64
+}
+
+func newHistogram() *histogram {
+	return &histogram{buckets: make(map[float64]uint64)}
+}
+
+func (h *histogram) add(other *dto.Histogram) {
+	h.count += other.GetSampleCount()
+	h.sum += other.GetSampleSum()
+	for _, bucket := range other.GetBucket() {
+		h.buckets[bucket.GetUpperBound()] += bucket.GetCumulativeCount()
+	}
+}
+
+// since returns the histogram of the observations done since the previous
+// one, nil if there is no previous histogram. If the counters were reset, all
+// the observations are considered.
+func (h *histogram) since(previous *histogram) *histogram {
+	if previous == nil {
+		return nil
+	}
+	if h.count < previous.count {
+		return h
+	}
+
+	delta := newHistogram()
+	delta.count = h.count - previous.count
+	delta.sum = h.sum - previous.sum
+	for bound, count := range h.buckets {
+		prev := previous.buckets[bound]
+		if count < prev {
+			return h
+		}
+		delta.buckets[bound] = count - prev
+	}
+	return delta
+}
+
+// quantile estimates the given quantile assuming a linear distribution of the
+// observations in each bucket, as Prometheus histogram_quantile does.
+func (h *histogram) quantile(q float64) float64 {
+	bounds := make([]float64, 0, len(h.buckets))
+	for bound := range h.buckets {
+		bounds = append(bounds, bound)
+	}
+	sort.Float64s(bounds)
+
+	rank := q * float64(h.count)
+	var lowerBound float64
+	var lowerCount uint64
+	for _, bound := range bounds {
+		count := h.buckets[bound]
+		if float64(count) >= rank {
+			if math.IsInf(bound, 1) {
+				// Observations above the highest finite bound
+				return lowerBound
+			}
+			if count == lowerCount {
+				return bound
+			}
+			return lowerBound + (bound-lowerBound)*(rank-float64(lowerCount))/float64(count-lowerCount)
+		}
+		lowerBound, lowerCount = bound, count
+	}
+	return lowerBound
+}
@@ -0,0 +1,58 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package apiserver_etcd
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramQuantile(t *testing.T) {
+	h := &histogram{
+		count: 100,
+		buckets: map[float64]uint64{
+			0.1:         50,
+			1:           50,
+			2:           90,
+			math.Inf(1): 100,
+		},
+	}
+
+	assert.InDelta(t, 0.1, h.quantile(0.5), 1e-9)
+	assert.InDelta(t, 1.25, h.quantile(0.6), 1e-9)
+	// Observations above the highest finite bound
+	assert.InDelta(t, 2, h.quantile(0.99), 1e-9)
+}
+
+func TestHistogramSince(t *testing.T) {
+	previous := &histogram{count: 10, sum: 1, buckets: map[float64]uint64{1: 8, math.Inf(1): 10}}
+	current := &histogram{count: 15, sum: 3, buckets: map[float64]uint64{1: 9, math.Inf(1): 15}}
+
+	assert.Nil(t, current.since(nil))
+	assert.Equal(t,
+		&histogram{count: 5, sum: 2, buckets: map[float64]uint64{1: 1, math.Inf(1): 5}},
+		current.since(previous),
+	)
+
+	// Counters reset
+	assert.Equal(t, previous, previous.since(current))
+}
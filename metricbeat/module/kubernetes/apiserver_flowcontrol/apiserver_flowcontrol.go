@@ -0,0 +1,199 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package apiserver_flowcontrol
+
+import (
+	"github.com/pkg/errors"
+	dto "github.com/prometheus/client_model/go"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/metricbeat/helper/prometheus"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/kubernetes/util"
+)
+
+// Rejection reasons reported in their own fields, other reasons are only
+// counted in the total of rejected requests.
+var rejectionReasons = map[string]string{
+	"queue-full":        "queue_full",
+	"concurrency-limit": "concurrency_limit",
+	"time-out":          "time_out",
+}
+
+func init() {
+	mb.Registry.MustAddMetricSet("kubernetes", "apiserver_flowcontrol", New,
+		mb.WithHostParser(prometheus.HostParser))
+}
+
+// MetricSet for the API Priority and Fairness metrics of the apiserver. Metrics
+// are aggregated in one event per flow schema and priority level, and one
+// event per priority level with its concurrency limit.
+type MetricSet struct {
+	mb.BaseMetricSet
+	prometheus prometheus.Prometheus
+}
+
+// New creates a new apiserver_flowcontrol metricset.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	cfgwarn.Beta("The kubernetes apiserver_flowcontrol metricset is beta.")
+
+	pc, err := prometheus.NewPrometheusClient(base)
+	if err != nil {
+		return nil, err
+	}
+	return &MetricSet{
+		BaseMetricSet: base,
+		prometheus:    pc,
+	}, nil
+}
+
+type flowKey struct {
+	priorityLevel string
+	flowSchema    string
+}
+
+// flow holds the metrics aggregated for a flow schema and priority level
+type flow struct {
+	fields common.MapStr
+
+	queueLengthSum   float64
+	queueLengthCount uint64
+}
+
+// Fetch fetches the flow control metrics of the apiserver.
+func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
+	families, err := m.prometheus.GetFamilies()
+	if err != nil {
+		return errors.Wrap(err, "error getting metrics")
+	}
+
+	flows := make(map[flowKey]*flow)
+	getFlow := func(metric *dto.Metric) *flow {
+		key := flowKey{
+			priorityLevel: getLabel(metric, "priority_level", "priorityLevel"),
+			flowSchema:    getLabel(metric, "flow_schema", "flowSchema"),
+		}
+		if key.priorityLevel == "" || key.flowSchema == "" {
+			return nil
+		}
+		f, found := flows[key]
+		if !found {
+			f = &flow{fields: common.MapStr{
+				"priority_level": key.priorityLevel,
+				"flow_schema":    key.flowSchema,
+			}}
+			flows[key] = f
+		}
+		return f
+	}
+
+	limits := make(map[string]int64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			switch family.GetName() {
+			case "apiserver_flowcontrol_request_concurrency_limit":
+				if level := getLabel(metric, "priority_level", "priorityLevel"); level != "" {
+					limits[level] = int64(util.GetValue(metric))
+				}
+				continue
+			}
+
+			f := getFlow(metric)
+			if f == nil {
+				continue
+			}
+
+			switch family.GetName() {
+			case "apiserver_flowcontrol_current_inqueue_requests":
+				add(f.fields, "requests.inqueue.count", int64(util.GetValue(metric)))
+			case "apiserver_flowcontrol_current_executing_requests":
+				add(f.fields, "requests.executing.count", int64(util.GetValue(metric)))
+			case "apiserver_flowcontrol_dispatched_requests_total":
+				add(f.fields, "requests.dispatched.count", int64(util.GetValue(metric)))
+			case "apiserver_flowcontrol_rejected_requests_total":
+				value := int64(util.GetValue(metric))
+				add(f.fields, "requests.rejected.count", value)
+				if reason, found := rejectionReasons[util.GetLabel(metric, "reason")]; found {
+					add(f.fields, "requests.rejected."+reason+".count", value)
+				}
+			case "apiserver_flowcontrol_request_wait_duration_seconds":
+				// Aggregates requests waiting to be executed and rejected
+				// ones, labeled by the execute label
+				if h := metric.GetHistogram(); h != nil {
+					add(f.fields, "requests.wait.count", int64(h.GetSampleCount()))
+					add(f.fields, "requests.wait.duration.us.sum", int64(h.GetSampleSum()*1000000))
+				}
+			case "apiserver_flowcontrol_request_queue_length_after_enqueue":
+				if h := metric.GetHistogram(); h != nil {
+					f.queueLengthSum += h.GetSampleSum()
+					f.queueLengthCount += h.GetSampleCount()
+				}
+			}
+		}
+	}
+
+	for _, f := range flows {
+		if f.queueLengthCount > 0 {
+			f.fields.Put("queue.length.avg", f.queueLengthSum/float64(f.queueLengthCount))
+		}
+		if !reporter.Event(mb.Event{MetricSetFields: f.fields}) {
+			return nil
+		}
+	}
+
+	for level, limit := range limits {
+		event := mb.Event{MetricSetFields: common.MapStr{
+			"priority_level": level,
+			"concurrency": common.MapStr{
+				"limit": limit,
+			},
+		}}
+		if !reporter.Event(event) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// Close closes the prometheus client.
+func (m *MetricSet) Close() error {
+	return m.prometheus.Close()
+}
+
+// getLabel returns the first label found, labels are snake case since
+// Kubernetes 1.20, and camel case in previous versions.
+func getLabel(metric *dto.Metric, names ...string) string {
+	for _, name := range names {
+		if value := util.GetLabel(metric, name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// add sums the value to the one already stored in key.
+func add(fields common.MapStr, key string, value int64) {
+	if current, err := fields.GetValue(key); err == nil {
+		if v, ok := current.(int64); ok {
+			value += v
+		}
+	}
+	fields.Put(key, value)
+}
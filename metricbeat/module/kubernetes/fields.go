@@ -32,5 +32,5 @@ func init() {
 // AssetKubernetes returns asset data.
 // This is the base64 encoded gzipped contents of module/kubernetes.
 func AssetKubernetes() string {
-	return "eJzsXUFz27iSvudXoHLKbHl02NraQw5bNeN5b59rkjyvncwctrY0ENmSMCYBDgDa0fv1WwBBEiIBkBQhxbHJQyqWxO4P3Q2guwE0fkQPcHiPHsoNcAoSxBuEJJEZvEdvf20+fPsGoRREwkkhCaPv0X+9QQih9gcoB8lJot7mkAEW8B7t8BuEBEhJ6E68R//7Vojs7RV6u5eyePt/6rs943KdMLolu/doizMBbxDaEshS8V4z+BFRnEMHnnrkoVAcOCsL84kDnnpu6JbxHKuPEaYpEhJLIiRJBGJbVLBUoBxTvIMUbQ4Wn5WhYKOxEeGCCOCPwJtvXKACwDry++n2BlUELVHWz7FI66cLzYbH4a8ShFwlGQEqj35S43yAwxPjaee7AFr1XGt6CL5CUiq91oxEEAUHwUqeQDwcdxVlSJGTdheAKDfnxOAj34ORsCI+AKTJondJVgoJ/EozFQVO4KqRzg9BXI/AN/Fg/ePz51vUI9mzTJZGFIXm2SPZ50klULlWjOKrwWDQLFCPRRdLyg9rXtJ4MH4HuQeO5B5qHqgUIFDKD6jLqAvmgdAutxlIfiU0VaOroT6gkrxgNO4YVZNEe0zTTI1SllCCaLpj90wkalDXJNGW1ZoZMUw8AheERTQNQ7BB0W9mF4KW3NHkNhNC3UlchLvMc5B7FtEedcd0EO01momIZti0uEu1ZltwloAQTo4uQ3TN9za9pChXApLe9zXNlJWbrDvu9RpyffsFCUgYTbvIWk455Iwf1LROUqBytTm0nlmfb8bozvFl5Ze9R76Xj1D9rH6ECEU1T4NhCOIj4bLE2SURGpZDALepWLEC6CphZW/0G4R2xPpTmW+AqxFXEURbkkHzA8b9ahQScwlpBKO5rwwGCUIT0EOMMe6ah7MDqEAgmvU382rJtbe/KsWqAJ4AlSSD1b95W8g2f0LiUkD1xXqKHOo+X4NAOUk4M90JtXD8OnE1Q5T5TP2EcSVlXmZYkkdALlYhaPONt4amKekZqqY/CESQf0HVs2NqegpohWCSWi3IIa3GGJCOME5UsQXzHBpW5AMYRMGogG+q3grCFP32QZ9fwTbK0RruA42hYgPFTarv9Me3qbphzpmmSoOsQvy9vD1TbZ34QFggR5al0+R4Tl5Eb8GZu7GZZVgCTQ6nWLJLW6ImeKVMVCGo/iaV42TPSYOQ4plQg4lOF8ymTB5AXnTKMazRngjJdhznqALhBzvWlZiCoqZZaXKs8s7jObRYqO0IVx+OA/MN9NiiHq/JpORcjWPzZXdDtxnZ7eUIU2d0x0tKCd1FDVXa8TPRk5Z6GxlG4awyyCRdVXKPMpK3SX+jTYGw1Fyc7HGZErmCR58iprLX9JCm525vxZCDggZpRJ41yS7zdq6hEhM6b43Dkm5DL8oSh44s15Lk7lRuimX3i4GEzb0iiHoErfTK6Fl8KEN5+wWVAu/AIQhfs20o+l1vP3QBClE9aiTjLsLDxIcY2Ewcg3KXjWcsqZ8B+drPdWN0SurXjIMRPcXUO2EdocWUKbH4QA8CHgm2MgpIBxg2sFgKq8I5J7WoRIIzSNfbjGHfD+uQw0Q5MdqgpIsFwjVN9Tfb6rSQZBJnGjvCWcYSLPEmA/VesLEZyYn8/lqbwpZQSCv4Tfa9HQbfqU+8EkFki0qq34XUvYCXsd34/PFAqz6wnXLDt2ziYIQfMcmwOwk1f0DyRcJoTM8bCqfReF1r6TRNRQkucELkQbm+burNiGp++fKlU1nyeMmowe7lS0UP6eOFQtRI4F+pmDe3u713FHES+6xtoO0n3uZYCyEcwi5HLFSK0RhAHruMD0ibhgPQ8RpWtNTR6xiouxY4sAx3Plf6eQmkEoO3uc/cr/xooZ/oWnr0j569dzmmzTMcTGMQfh/TlhDvbVNAL6qP3N3fh3tIDfiJ8QdCdwL8abCXII/fq2YiAXKcXAq8gy0uM0cicUp60I2ozVspNsjDp5k18Z+MXwiP5uVF1fQexuQ24j6f1xBR3DEm9U4WcRAS8snBxetwdtxSst3v1x6DuSVkPO9vF4tdIMb44ogu7Mw+Z1kGvDr8MCvDf90QM0cp4uT3v8kW1EvuSr/0NtcLb29V/8Zj9wnnMG4X9b8Yjcj3hm45FpKXiSw59Ikvm3mr5iybeZfNvMtm3hHNWDbzuoEsm3lHY1w28y6beZfNvPM38zq8zKnbe58Yf/irhNLtcZ4y9SnQoBzOasvd/On8Q0Ww2VtnJvOQL1HSLaFE7KO4E18aYmNY4zSNYcO/13pRBAcMOYVC7qPy1BQHu4/kJEp/bfnaO5g1dXdgxlJYJSpgTyRzx9enGC48kkR7EjF9YL1sUVMOGewecCb3MfaFt8wbqsidCDrHnvwwpwqPZ6lqPLvbo4UkfyObMQlwCnxFxDrHQnpyMhvGMsBdR2/o0Pq+PbWudU0E6vB400Wjd6u+6bKfkLD6vAe79Ea1+7XOWYGah3TfaL6ReywR5oB2QIFjWdUKqfcKm3H1iAOhKrBVwv21W7kETUiG+Q3Mo+ugtK+r6VVxQRwSxlNRyb0xPklyqD4rMJckKTPMKyGgPRaIJXoDeupAqN+UOC8cKPuDSSjttyVcyLVhRT31OqZv7v1cA1Tt1DxQy0N91rUq+7DH2QEpFgN42lyI6K3EVRgkfJXjreFjRcdYAqRtcQDyCNQhjoQVh7VkLgTtnIZFJ9Tzp96C6O40pbHgGivsFt04kfvnQ9EssYc5OvKQPqMPc9TL9nXdCg4F47IqXEGEQxehDnTWihpbznL0tCfJXgunGhuIaEdGd24oaub5k5onFGHE6FgsVs4dp1ji+Rr7aCghLARLiJ4VnojcB/tQSG/uIXS6R9bYAYeeQlBowBqxsnQ0aGkGhNFwT2kB1XpZx10Z+G9D1pjEtjUGt/cbf1liFE9dkCkuY00SkboTVB3gCQ/1xnr1ZB29zsxvps6MLZDwYk1JIi6AfaHkrxKQXlIgW6LcSmYBcaSUmmEcsu06I/QhIpi7D2oc5yAUGlODyDeNEPrIskdI1w6M5xqdap4uuYTGKVyQ+Jbz0+1NU6XIWE9AXXHLVSneD6Zk1QDjuIOHPWAFmJ6vv9aUJ4g+bof9cvPLAG87aTEn5rOOIeo4czmBuJxA9DyxTyBqf/X7Pny4nEhwPcuJhM4T70TCsvG8A3jZeO4Gvmw8D2w8pyCV3UQbr/nXF218d5AAedTZfR+tZg2Cc9cq5kjMY/F89fFpckYvWyGfOaYiJ1I+H518duqkWbxYTnlUz0hp/n054DFRQMvZjvbpCec1HOuwtid4jo93QV3i3H+L6nmc+G/x+E79Nz5NSb0ZnFPGbZIrD/BMFRz8c8IwgyEmaGQPR2NTJGN6OpqWSrnJtcc7fdZAI2cO9JrFOGJuQVMGu1coQvcM1ASrRyez5uSwC5Z+lynsJSKtniUibZ/vSSHfXUT6KtaMnskqSQ/WcyylM6VE46sqy6im1KZyjuiWzjH1GBkFxDjKGQf7x4awIoE5DFVtjLyKtiwYdWA/y363lLCK1xlPrmP1OpKGR93F3+TO0uL6pa8tVmJ56q0w+oOLF774XAmkKcKgJKJPnw6IpcA7WJ9tjbMCNXq9dX0JNP7VVqv8x9fDnNjeOo+kac2/DLjZfO8od3PyqQxfBZ0215xGOYHhqpxj7eHvlriZw6VHzntqYa7UjulZptOrHDPl4NRRrOc8rxs8rTuyXsyx7zpULSbQ7cLj1yl1YjrQwlViIiML1odp1jvC1WECkGZUhjnK8HkOco83jCk1YZr+060Ic5pVT64FEywgMaYOTJQqMCH4rpoOsRAFS0eEQM0zztGVX7oQxhYGGa/V8WAHKoIEoPo1OHdoGV/pJQAvti4dNV6s0wnTKrzEVeS42i4hsOdU5eiaLiGAc5UZqObSNaGIduNyDocKt5xyqn1kyZZmOjzQZNSkFGT6UG6gctONs36giTMrPjC1lRmIkTPDsPjvDzS5VXDuFNnOFX1s23wwdNmiH9088/DiG3Ftnx+T9+q+mOOMF/rQ3X2ddc+C6x/nhO6iqf1TRRpZtCddzzgS4kzfNQhyggEMoLyINYQb4zeJXtZAJHtIy2xe+V0rc9DQW9IGfR4vLG3QO4p6IpuhwrqWZ1JmURp2b6wUYSkhL2SfdM2zGQ0islWd1UV3Sccs6ZghSEs6ZknHTES0pGOWdMySjlnSMUs6xokhWFmy4u+qKxmEMKWmZC8W61ZyPG2ShH+Hy4elf6MpkgwBTa3GuKelkbDnpCUmoAl0wC6ieT3CjSnUEwuWrgoOKkxRCHQh2nwujFuWopYoMkQDCEygFINvTSrY6kbiRkGXdPDuHcYyPJP0EM/z6VwgRk0YPRwzU6Y+K33TZdxslnvT5XLaAZP2DskYx0xOLhrVE097nT9xV7cTEssy3jHsYo+Ff7eguwHdRoT2KjfN0YzQO1NN+Ao9YSL1fyTwnFAcvp8TcOo/Ke6uzDwSZYtQM3HL98hjUhGofy8WoRJ2vRLSJ4Cp+AxWWe9VpLXBzNLf75WG0LsG1bWuiKmUds2x2H9grPgZJw9su71Cf+Ncnxm7LbPsCjX/Nd/3VasexhvtqxHo3TXLiwwkpFetJK4xpUzelVSzYPwK/fOfH38lWQbpD6b5K2dHmXIyZPDSAr392HcioqLr23U8Se3Xt190hTBRsQzovXZqLwLJsIMUuRkeyyl0emRgw2LBIVFDwXv0n6v/iIG8wTJSoCHsw/Dmbsf0Sf2iVcsqJZ7/ErIhEZgN3tXG+cGqB7UCvz3uVm313n3fedmEM/on28RyaSpqURya3urLeJcGXRscPRrdZcG5DJx0LIfRlJt394wxfFoSqGAZ6VBqDl0kymmecU9Pm1OoSKmYSLS3nfeMxPI7xVqUogCa9o6qh1yjI+52OqE2IaJiVhfd1nJ18W1Hmj8QhBzHqgVL9kj0Ev01hCcsnCW+m1EKC7muLSAaDiV0fVlBDYOX1N1B4OuZ2CvKg+xTwGlGqJ/zkM39Ygg0rPFWAm+6lEaSMH3NB1dO4BaTzNLEmP+E//SHeikUGTvkMy9BsQbGlmCUsbHAjsoQo7tbf/b41Ym04uIKR9oZr8hIgsfHgifhqLkgQrdsoieRgiA8UKRpVqz0S4ux3V9jOLao34kCkjkH5mJhbIuPePRmHXill4Nl8RoBrEidVy5EB1Xx6QOyT8BGGhxiVsuOmZgJpzxmBfa6YLOd7UDvJC/hCm1xJvT575I+UPZE/f2mpGamCBrprMSMRnnEJzQYxoz2reO35wuwm6rZ9mHfcHRdl4EaADWj/mqNqSk4dblS2ZbMv1UI98l39noo9mwU802RG7ThYmHWsstZdKdPsZ/LNG3dqBhpWCFnhaMP8XdL1jUCBi6IkEDlI8vKPNZ01ZJFFd167qqurlK//FENk/BjYE6DrwVwoqbaI+GcKyHwWwVUkfCkakP9Z1w8Y3g4KwiG1jqmNqJaxsBJwniqb71hlnY8fgHjeAfrJMO98/ajud9XRJAm0qQGepaFxgRcPgtNMkzys5lpkuHvwlhvf7sOWGrVmFk3fP1MaAppLRY/K5NGXBv7mdE37trsfd3R4vcPJTdNwE0bJwkIsc67G+EncPhJk0CKhJvHGXva7W/XK1/Hck+ps3pPpOqHxH3XXu/j8YkBhezm1slsz4Rcn4ejIu1jOzHsmsbYhEen1YM749J6B6ZZW7+r19ZvgarJabVanbqkHhPdvEizzkj6sw4xsTbcXHiv+mi7uTiIlbM0BE0Zn/lDwRmThTZUf9Yyxm1JM+rc7I9vjzfZwQI4uqv+uHdUhxqbx/xWuMJ9OB4q1X+nYmMbXeHnXEIzV4Dqi/UMJ7Q56Lm6Bad3eXGWdc/5oaN1pA2ERpdYUtyWWXaouQ1K09pupA+s/VWyo0t95w0tFs0og8v5VgPvDNb/0ViH1gS7UpqCoOJA6JbxHFL0bo95qicoAekPoQOEccKO44Z6l85l9wbwCSzsFlY9R716hf5QTf1DtfUP1dg/PPOHo+EntE+T06KszA8XRUZAIMn6gWr4T39gq4YDksTKuBhqoY5y4RD13iAKJFSyUkjgp7njN1QCpzhDN7eN3RshuLnB1+qFWWFx3aiaGPrl072/HzQsPS08haEnwMgYTtcbnGGa+CU6gt8HhlP0s6HTWJWH6Zx+XjesR6MJC+mOq2D89LbcVBR86GsGKm6bYxM1m3+46HQmH/ewP1DfvxZVc9X70Qt2iAnbMovn3dcUo7n3ISEMpYf63svnvSWS5t5+9A7ULF1NhvemBV0X8ALxxpHwGkfqpJDjzE6qVfej9lGPHD+fENE3iD16OydCAGtwrRd+bj1b/r7lwTwvdTdKtsA+DzXXyh0BrJNM7eZS5416dmr1GflTR7j8XlXB2SMRhNFeODl5Faml1LpYNgrfkoBeo1k7NqNOcsU1FbOltTo8f6A4JwlWIaqZSswChXtNyyyDbIjOM87K6n9kabXbMK3ulW1lQ+gOYZoiwyX+5H+k9gEXQF+CFKsfVDcqWfXfo7gAjhNykzThuBGl2dPu38t94QuWXsV9Lwnj57+8rVeCrM9m4NqxkWcAkZH1NeNgBE4x9RzV7mB8HhffnGnP03KxiT23vfDLCJab8I+f5Sb8cXiG72aIur/seFPZLIdkjkvel4q1iczJbbma3Dwj+99yNflUAS1Xk7fPq7ya/MvIC8kvcP/33z23fnehXOJu9MrJM2D+PwAA///vYoky"
+	return "eJzsXV+PGzeSf9enIPzkHCbC4nB3QPywQDJJbo0k3rkZO344HBROd0li3CI7JHvG2k9/KDbZ3eom2S2J0ozHWgcLj0eq36+qyGLxX/Fb8gm2b8in6h4kBw1qRohmuoA35NUvzT++mhGSg8okKzUT/A35+4wQQtoPkA1oyTL8toQCqII3ZEVnhCjQmvGVekP+95VSxasr8mqtdfnq//B3ayH1IhN8yVZvyJIWCmaELBkUuXpjAL4lnG6gRw9/obclIkhRlfZfPPTwv7d8KeSGImtCeU6UppopzTJFxJKUIldkQzldQU7utx2cuZXQZdNlREumQD6AbH7jIxUh1rPf9zdvSS2wY0r3Z9ekhPipdelJ+KsCpedZwYDrnY84np9g+yhk3vtdhC3+d23kEfgMWYV+dUAqykKCEpXMIB2P2xoWcuKV3SegqvtTcgiJH9DIRJmeADFiyeusqJQGeWVAVUkzuGqs802U1wPI+3S0/vH+/Q0ZiOxjZiJPaAqDORA5xOQauF4gUDps5wbLwUCQAUSfSy63C1nxdDQ+gl6DJHoNDoNUChTJ5Zb0gfpkPjGep2PyC+M5RlcrPYqciU0peNoY5USSNeV5gVGqY5Qom37sPpIJBnUjkiyF88yEMPEAUjGRsGlYgQ2LoZp9CsZyINNRcJ3EJ7gPvgG9Fnk6bNMxPUIHSgul06E2GvelOthSigyU8iL6GqJvvO/Ky8pqriAb/N7JzEV1X+y2PI8i1zcfiIJM8FwFkTawEXI7l6BYDlzP77dtZtb9X41bCL7y/LLOy96Q0Jd3WP2AHyKME4dpOYxRfGBSV7Q4J0MLOUZwmau5KIHPM1FxvS+1Heh31eYeJEZcFEiWrIDmA0KqIAWlqdSQJ2g0d3WDIYrxDEyIsY3bYcx8+DgRSNb6XSfOK2my/Xml5iXIDLhmBcz/LaihuP8TMp8D6l8s9rGD6/OOBNmwTArbnUhLR+2lhqo2R/onziurNlVBNXsA4oOKUTu+8TpqRpIZoZz8USKK/Qvqnp3S0/uQRgZ7ubVDOebVFAFph+OeLu7QPIWHUXyEgyoFV/Ck7q0p7OPfIenTO7jLcrKHh0RTuNhS8YsaJv3p25RTbOYDrpdB5jH8IHZgqLWyFKGKeFZZeionG+ZSZgtOhSBYQTXwbHtIS/Z5SzmBV9hEkUH9M6sTp+6YNEopXRNqOPH9DXNfZZ9An3XIsdBkzZQWK0k3pCYRJtsdrxN5spFZe3Kq87pM0jmw5dJ6UJRQ/+M0Mk/gx5b1dE9mlZQ43zredm/5smCrtZ7Q1AVfyYpzxlfzUwQfQjMzaCFrYoH8rBwj0Fk+r+3uZRRkE2DSLvpbbypCtUHxwtMqZ3oOD8DTwBt5xMjz62s+MJeACkOeENOJ7IMP9jgWPWP4h6/Ieoh/owOlOle7XY8rQlcrCSuK6+olyGbFDEcICTRXZhvnUTIN3i2Se9DTN0k8i3FHrvlYsigDndtZeFRXBOarOcmEhPlNcCmK5pNzhVEytDGvIq9XoK9IwZQ2BjStqBMmvzl7HjIkjH/aNiq79IM8Ug5ufkY/WoTWnR1eh49/5X/+7QRkf1KabUzf2UDOKG8g+xy92pBccNhZxIEHJipFlqCz9TS1vjutWt/9Ta87M7In0O+70+r33Xn0c7qZQJoq4nxEYS2b15kEquGKVGVONZi4k0MBGp514Hnc0SJI5PyRZ5fYFx96dtV5gbHnBAo+r+BzkILD7HJZiEfcmpeimI1Fob2TzBvJhGR6a6LPz5RJjtsSoWwTqRCVrWFDzRdK9+0CHqA4Mt90whZ9YUdmnY2KRq5zjnOLlwrquaj1TMfj547xNlRn697Wu7oiXGg8EoYNCn9RTzyQ8a6h/aQzwesZcLadF2zD9GxiRxjhfbemEvKueGLEO0vucvNS+6uCCuYF8JVez+nDajZ5r2aE2/cPIOkKSC3aMTJwZulRAXA8udY1M6FLDZLcA9ofuPlw7qXtvjE5ARgbnVmN5p2kRl00wRSdTXXllkKKLXmkzJxEs03K4Ksgwebk2jkpNqBBWjlTJfYYyE/Pq8XC/QZHLrrfFll6SEPNLkQ0LRiJUb7F9F1NYWa8vlhWRXF6+zlQcg8ZrZQZ7JgkhgJ5pIogjXHKnXCzMOHmaZgHwx6TvcBndJNAseWMq6fZBhaieiKttgTxcyIqTR7XmMvshgnnr6Aej/QcDtFrqk0AgxyHRFqTurJ9EnIiZKNenOrpZyPv2QaIKvHsTX+86di2USE2OXHMMemjjIM8Ju3rLN828lx+50vYJp+hNkdXFtiOZj5L4pR2tpcV71AgGQhsrFFWk8fhESQ8xFUpzBuGhpgyjJvvDn4bIxSTuqOkkH2rTRM+BtAF4Sr4kZF+MNG+3T/XTaNDq18LCdb0nPJBy/eypVzgorCaHUh4IlmkVynIRwAbWiKHeZnpKCuV0QLyxbIQNPRBd6bBzitT6IANmypCnUz82WbGWmhaEC5yILQoREY1vS+AXN98iCpbD8BfnLY5LBmH3I7b7nhvGwZfCxmxCGFLUnHzXci/mXntIlZqNrWbjmj1q1jhPv9SzKb1aseBPlBWUP8pt3jMiMULJz101GZSzxs7r7OHr411GlVJRkuaYerFeEC6U8B98uVbp+7b0y2Dwe7lWwW13MMoDGOjOolZQunyJLNMVPm9aQNtPwmq09JaSoCzsEKgKYQC7TI9IQTyEXJEvEfUw+0g1AacuMYrs5Bix7SuWH85Z6frt8CRc/6nS6Wfl0FqMwRnDi3tZ5lX/tZhv2dqGfD/888up+h8RIJpG0Q4x+xaSA7uQb2sPnJ7dxfvIY7wo5Cf8L486Bdtj4+1mmYvaJJdSrqCJa0KrYJ2CTDfa+8fYUgAx1HZ0D+FPBMfgxVk5RhJIfRSzaY2llBDceJcMhnU7CW0wFshtLkqp7ZKw8ZGsel59NeR7Pit1CY/lzmY30I28x4xTnCucLx5zjDH+OCZXTh4e5yjAFlXVzlqhf+6EWZrtaSpkfIkd9zPWfbi3Pfoz3x/Hv8/Hdw7uoEmwfWeDHe4/xI8Ie5bvpRUaVllupIwFH6pFnCpFnCpFnCpFnCpFnCpFnCpFnCpFnCpFvDlVAv4+yy8sjOxfgCuB/pOqh0+9CFpwISzvtN7/HD+ay2wubxrB/NYLlHxJeNMrZOkEx8aYVOgaZ6naMMfnV8IzXPil+cgcyj1OimmkTjafbRkoJLidkskGOkzHzKuaswzURSQaSGTNVx4YBkaP2kOjFwbybEGuwZa6PU2KXgjlfgXghx6aoXDSDWfwFbVdLibnY2ksJIOtgCag5wztdhQrGHak18D3wtRAOWzCO4w4H5ct2UxEZ8wRXoYsz4bcytl1ofvN9kI8Ps1dG8j2Vsuds0KcBwyfaP5jTkGTCWQFXCsW4EntLc2EVEuru4gMI6l99CfLc4Bi2HhBhbwddTa19hMaxQiIRMyxxPO3aiBZ1/rfyup1CyrCiprI5A1VURk5iR67mFovqnpppxNCSa+UOIkLZlUemGheJbqcO97RxD1NBhOHbwPJ5aDVtUyKujJCRV0lI9jswE12ImrOWj4rGeTGfxWy7EtwV42Qdev2ANwjzkyUW4XWvgYOG6e6yDhpbcou1sjaSo5hz+o6nsg+vtOeYg4omcdMtTo44jmpocrjCuhFNLcmtJrZsPQ5A7UiNn5bcwWe5TsXUqxwRsb2bq9IIgxu4mMXkppV57f4TiB5ieCT+XimGxA05zuXAk90GO/WUmEKiUyZkaFR6bXkUYT95s/hIbZ+aW18urb/X3bRgLWqOF7QcsAdO6/h5R2hJxfFml3Bv7birVNYtk2Bi+LE2xLTMI0Fd/TAhuRuI1ZdwKjs7kF5usBw7o6i+SFrH+3hay7BvGX8XFcKpang//A2V8VELOlwJYMq62LDhHPkpKjoaBYLgrGPyUkc/srkVBKUMiGr7xNxOEz/iCKB8gXHo6nik4O0yays7HI4rjSkqVvOVgRwAp1XdhDy1FIWw8fsVHiBOC0waMbsCKgp+uvTvIepk/bYT+8/XEE2+Hy3V10f6eYdg0RRV1uIF5uIJ7rBqLJV7/sy4eOjncbPOyWkDu+rkN6lxsJ+99IuBw8vxw8vxw8P/bgOQf9KOSn2dTWEmopTp78HFTqJTS+W8iAPUAekeUog5RCHsx5Kp/PIRxHQ79sh7yXlKsN0/r5+OS91yeOwuWWx363PH6+XPCIX/AYGKhNIy93O37+Gq91tDmA1TagTGz3+JSsnseN/5ZP6Na/IyMrHlzB8TWFUDNw8tgGM8DTNK/ImDAOMAbSBYp1zklumtrT93Ap/vcWjUuW+48aU0eOr9qME8aWvYLdV2hC/wjklCl3bmb5jTRtDbsU+Re5hH2ZkV5mpJcZ6RlnpF/FntEz2SUZ0PrSSzR+VWUZcUhtKueofukcW48Rn1AQkmyE7JTZaR5cQBF4TnikamPiXbTT9atYwBztU86nMSHpNoy6tC8lrL74ElbxznhwHavY1O+Fdpewyr2txcVL31uszfI42GGchYi/9M3n2iBNEQa0CF4kHTML7jouTrbHWZOavN+6OAeb8G5rYxMpPm9nY40kAti5j2RkufeRDp/kx+rohM/sRW0SrqATrJ9zIJKvck6kbs4xKANxwVsLx1ptV56DsZdiZ1NijD+6jNWMid7WnVgvpleKZaRaTKTbxeOXvwpLE6K8kWmvKjGJmUXrw0ysDhOhtAPa7mlMqQwzpS7M9IaxT02YYEWYw1q1u+c/uRZMtIDETvmIKZq73uuvttISUXvQ99V0SMUoWjoiRuq4xjms4eBkj1DolK5I5NXpZEcqgkSohj14bGjZ4baXMzv0UvvSU+MlVoPkjI7sVk2Z4skh2VO6sstuoi+HBI91piXhEzOtlstB7WY2RBkt3BIAimZX7agYLdniOKgtzyYNSlFQfNSqTtNtsr7lmXdVfGRoqwpQE0eGcfPfbXl2g3RuUWwTf+uHiMWy+QcVfPhrjN3QY0n4tQUI2nenJ3O6r7JPoE8cZ4LU10xpsZJ0Q2oeId5237OU5sMbrOyTyu3vatGkI7tDcPwR6okUj/N9nOQeDWCE5VlaQ1yZcJOY9ZXBx3jzancm7Y9HkWjUWTlo5F2WDV78ssHgKuqBMGOFdR2eBFUVSRS7s62UUK1hU+qhaIfZRIOEsNhZfXIvyzGX5ZjLcsxlOeayHHNZjrksx1yWYy7LMedYjolWlgzXlYxS2Kem5GAu1q/keNggCf8O55+W/sRzLHgEPO8o4x+WJtLesy8eyibSAfuMho30eE4+mQ6/FPm8lIDTFLSJKUS7OZbGjchJK5RYoREGdqKUAteJimrdWNw66JwJXmea5mhMGEncRxvGx+V0PhKTBowBj+M81uGxK2jWB24Oy836KP3YFYmdnQsmjbwjlpJadgcXjZqFn/NnuRdKaaqrfjMJRfFQHG/FlWvaK0M/rsCIlf3qGCDy2lYTviKPlGHZuCuiQW4Yp9FzjRJovg2y9FdmnsiyZWhA/PbtMjEHAlSQDOMadh8cO5BMjeONY9GKtMn897H2EHndsLo2FTHRadeSqvWvQpQ/0OyTWC6vyE9SmjtjN1VRXJHmr/b3Q9fiHyEb72MEen0tNmUBGvKr1hLXlHOhbytuIIS8Iv/852+/sKKA/Bur/nzmM80+N0PGeok5eDsP3Yio5YZOHe/ldlwRMzD1eeEgoTapPQslC4cvvXsBd+0Uu64SGRmQVykhw1DwhvzX/D9SMG+4TDRojPs4vRHtDra6n5Xj7l0NPLax+yecIyrGZ6t7msAe8DZ0wjd2+w58et6t26wGwfuymRT8T3E/G/PaxJSmlpYkoTmmEOi15TGQ4YTbucnRAF45DiQT3Jab3x6M04ogpShYtvUi0QyTZu/cJdjoAivttSicE6lmz2rYSFpophaqUiXwHHIvtD812kHvLidYLFxR8MvtFd/2LPNHJiE7sD+VIlsTNVjodxSwzrOvxLdjgE8KLFwLSMYDjY6SG0vIinvhOXw+ETxKHoXPgeYF42HksTb3oxXQQNOlBtl0TcMkE+aZD4mLCEvKio4npvwl/uPwL61uZSG2m90FtWMCYyswSWwsaaUO7267LHsHHDpMaxTfdMTxkFAWLKPKy6RvqYN5OBTC+FL0vuOzUpdhDortvqeScK70Y8uxPV9jERvbkNeqhOyYC3OpODZIIb+1pCp+PloV34dYmQ/i8UlI1ThDQs+8WraupnfGsb4TX/LwZy8TLW0KNndXO8hrLSu4IktaKHP/u+KfuHjk4X5TcTtSRBupPxzuw3IHx2fiU8z2O9dvTzfBbqpmdy/7+tH65bFGSHmG/n05NQWnhkgnmnR2bR6ahZ16CvcudPd6bO7ZOOZJmVu2je+8U2Z/qaZ0vjMFy/a1wCG+wTlSkFHjkJPSMZf4LdKsT6HEZx+UBq4fRFHtzIT9lp42XLViSS3XjV3101U4sH2LYRK+jYxp8LkEyTC92zGOz88pFgR+r4miiPks6i5fFwi6yofhJHlhfHsd+yphZBCa4fOCOC/SouMdL6rSQuId7qygSh2KflcLIUZIszQwaFk786nej7M+sX4LzQrKNidrpllBv4jGevP7daSl1pY66oWvHxiu1pCHsU5hlxEXtv0c0TfsQRloo9U8ff9AuxkBftk0w+Obi43ID0b43oggKGJ+7p528/v1PNSx/EPqc6h+yEqvFVgZM0B0YQCZvb3xguE5/sVpEFF0CHbPadd+wHZ6NPRFyPJj3WmsKU6g6KFp99Zv3d76DfAcr2DN5988xfyyx+64maad/0F+Fq4Nmo/v1ZBta8166QR0ohBgBdoyPseHAkdw5rPgcR2lSzW8ajneX8YXvTwD3QSq7v3ZdpXQGQMPXpHb+oc70EFmY+uYT8Ur3ofTscL+uy83cW8q/JzKaPYJUDw24pDw0XLcxGrJmVNeUhT9e35dngW9h+7ORlqarRWXVVFsHdqoNR07d2Htr0pomiy0dGQmCS6n2w28tfr/D+o/uifYt9I+DGoE3OSQG8jJ6zWVuVkKVXjQyOWBznZe+GNmBbuKDiQFXwDfA6KrYX3kE796Rf5AVf9AXf/A4P2HF9ir+AH6GXEmpTbOIrQsCwaK6HZvqScm9OPwL44rhgOWQaLuYqWR5zNFvbOMwnPHrKjwPsBh6fhbrkFyWpC3N027t0bwo8Hn+guLFEo5YeTHd3fhftBAsjIZYGCCUQiaL+5pQXkG8gi8XwXNyQ9WjmujoVnNMf3cKTaQ4YQzvpI4GT9cl7e1hBB7B4DztmPahIP5h09Ob/Dxh/2R+v7OVM1T7ztfcAg4FYBlVaTL7p3EZOl9zAieDCbC0mUujUmad/vJa8DDKvVgeGc16KeAjpBLcHqy/Ubbb76xY7wmkTpoynHiJLXN/pocteFbQtiITzH3sDjTCDpybRZ+aj938v1OBvO83N04uUP2ebjZOXcCsd5ian8t1e/YqVGvu7T6jPKpHV7hrKqU4oHhW+4gD4Vyu0itpDbF6rLwE5D1Hs3Ccxh1Dw63tRR7pNXg51tONyyjOEW1Q4ndoFBeInYb5J6ZdcajVvV/w41dw6F+V7a1DW6vUZ4Ti+IlctTgv+P2kRTAPMeVqh8YYd3670lSACfOa4wJnvj7LHymPZh9nfuBpX3O+Yxp17yjMTR/yNjneZcCT914PzAmfAygCzIoQTZ50Jlo3+4fPFR0jY+KGKOZ92XsYs8syjFyG2cSzTQP35zozNPpGpBvr3iyzcbOIx36UoNf3a/lMYLLS/iXl/DTvoTv2CQ9X2aPpaRISI5JyYdWsZlzMBG5PE1+eZr88jT55WnyEz5N/kFB/kze//458Op3n4q/ekRaLvUbQoyLHNTs/wcAamMW3Q=="
 }
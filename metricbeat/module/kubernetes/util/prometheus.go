@@ -28,3 +28,18 @@ func GetLabel(m *dto.Metric, label string) string {
 	}
 	return ""
 }
+
+// GetValue returns the value of a counter, gauge or untyped metric, or 0 for
+// other types
+func GetValue(m *dto.Metric) float64 {
+	switch {
+	case m.GetCounter() != nil:
+		return m.GetCounter().GetValue()
+	case m.GetGauge() != nil:
+		return m.GetGauge().GetValue()
+	case m.GetUntyped() != nil:
+		return m.GetUntyped().GetValue()
+	default:
+		return 0
+	}
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetLogstash returns asset data.
 // This is the base64 encoded gzipped contents of module/logstash.
 func AssetLogstash() string {
-	return "eJyslM2OmzAQgO88xYhzwwNw6KmtmqpV95TLarWyYALeGA/yDKzy9isCZMEx+d055OCJP3/jGbOCHe5TMFSwKC4jANFiMIX477AURwA5cuZ0LZpsCt8jAIAxDRXljcEIwKFBxZhCoSIARhFtC07hOWY28TeIS5E6fokAthpNzumBswKrKpwZdCH7uiM5auphJeAwJ01plnI8LoZoi8Q+vP3z0sbwD58KlMQyS4wSymjFXqZWUvZbku6nI3j/qHThVC8qrvGzZwrp4jexwAl0NG3RsSZ7oyyja3WGSXj3Q7rHydoE2KP1W1sFjf0eX3Hen80/WNsteYlQdy/f26fJDvfv5PJA/oJPF6HSp4fXOgRebhocG1c7ypA5CRPON+5K+af+CFj/CL7LhEUJP/o6Xw8UqFCczjh56LFii1b8K7t7nn4eaOBXuSQxFdHL42TIFve1Y20zqrQthjIho8YKumTRghr/0/UVGv8bKegWja02gg6XB/1+l18D+sTlIwAA//9sYbU7"
+	return "eNrVmEuP2zYQgO/9FYTPjtCzDwEKuEUdJGnSLvZSFAJNjSRmKZLhw7vOr+9QD3stU7YcP3ZXBwMmpZlvOA8O+Y48wHpGhCqso7b8hRDHnYAZmXxshyY4loFlhmvHlZyR9zhASDdNKpV5AThmQAC1+GlB8Z8F57gs7Iz8O7FWTKZkUjqnJ//hXM5BZHZWy3lHJK1ghyA8bq2DJKO8bkciDLuSnkuTKoPNYEzaoMTm6X2/a1r39JU/ByiVdTsTHQQVnNrejKaubD5Jwk+Q0Huj4oWhDagzvj97wJDw/IkyyZ7QjnQFxuKHJ8JaMCvOIIl/fRbuJrLuI7I76m+rKkrc9/EIfR/uP5GFzFVvIubd4+u2JcG8elQmi8wf4QnP/YDwTrnm2aDimNO2jtNGMbA2iUs47LiR8F8aFWQxj+Zlgt519tzsTGsppAJnOLPJWckKK5DOXiqefq+lkb6VY2KKD4eTULL4OXcsJFMVluLWTMKUlw5MMkihvLsCxl/eFeoUjJwLnIfsCix/tKIHWbZ5pkFwedZeMgdHuUBlTcSqnABl5UY0MV7KsC5KElfCtvzVuRKL6yUKHBnZe0l+qDYdWbgvHfBintw4jcKihbXp1ix5DXn12VdLMLU/G1QDDPgKHb1cH6S9dqbtgVn87aBQpfbHV/QmabgH2m5O2yVslJ9Am/lm/0q5TCsuBLdX4L5TjgpslCsgVoe1bbmfVTcud4CnYaDhAaZkZoct+O7BQ6q9LdOXMaZdei7rOHmkPPTxxCkSmLb24UB4rcY9Yl5nGlYwRbOLVYi/G3HnFgfrWR109qoR3mrJveiW4UDK4X7hzZWB8mZTisLsxOKl3PU1CDvXWUH7dVruOxTR0bVBXUGlzJooQ3Tox62DbNhpTWKkdStxi1rZVZiWDBO3pj5WWCz/AaGeLNfu9ADLlamoww5k4OMRdvyD+jdB0GMPLVDG7cOwDRV9Sl+HHZ/oE698RexZ9jCqKeNunQTDvDRAszTaRl0ihDpkuQmlRuORiJqSXwkP7wpe8YMpsLFG0wLS7t+riTaEskOuGjbKOy74j3ofTjQbTm3LKNbTNMdy6g4Yg4oZRCvEmHOLoSzMd1ZUY4IQ3eotxAt8FgJOgMMmK71osZ+HuGoEd7mQ9zoiJcUaOyeou9PHEiThjnBLQNKl2A+zYzvD6ytv2f4avO369hMGZUZpDVcsanv7YqsRT8mMYtwPgD9SS7AXEwf28yfNzU3JDbYb4fiYG1UNYGNvzus3Q36HQqCV4GwdubgQvtg59Z58bXFX4rtFiYcAQiViUFTJ1tvri1pBP6vt27nNaPiH7jIid/DnKwtCp0TwBwiOeAiNJQhqHWcWqGFlHCXS8p6PEiSEElzndn3UmxKGLmLT9swd4Jq7gjjVpoG/pFMW835ANX8a5iWEZLN4FH2x+6ca5I3cPkVZb373BNgzuvFUL3rl0cbZ3m3OW729ucjlzXPr6Ko4xawjLfEI835bgcG2fZyvwrZUWxix6H8jRO9s"
 }
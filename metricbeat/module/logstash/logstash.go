@@ -46,6 +46,10 @@ func NewModule(base mb.BaseModule) (mb.Module, error) {
 // ModuleName is the name of this module.
 const ModuleName = "logstash"
 
+// PipelineStatsPath is the path of the node stats API returning the detailed
+// stats of the pipelines, including their plugins and queues.
+const PipelineStatsPath = "/_node/stats/pipelines"
+
 // PipelineGraphAPIsAvailableVersion is the version of Logstash since when its APIs
 // can return pipeline graphs
 var PipelineGraphAPIsAvailableVersion = common.MustNewVersion("7.3.0")
@@ -80,6 +84,39 @@ type PipelineState struct {
 	Workers        int             `json:"workers"`
 }
 
+// PipelineStats represents the response of the pipelines node stats API.
+type PipelineStats struct {
+	ID        string                            `json:"id"`
+	Host      string                            `json:"host"`
+	Version   string                            `json:"version"`
+	Pipelines map[string]map[string]interface{} `json:"pipelines"`
+}
+
+// ParsePipelineStats parses the response of the pipelines node stats API.
+func ParsePipelineStats(content []byte) (*PipelineStats, error) {
+	var stats PipelineStats
+	if err := json.Unmarshal(content, &stats); err != nil {
+		return nil, errors.Wrap(err, "failure parsing Logstash Node Pipelines Stats API response")
+	}
+
+	if stats.ID == "" {
+		return nil, elastic.MakeErrorForMissingField("id", elastic.Logstash)
+	}
+	return &stats, nil
+}
+
+// ServiceFields returns the service fields describing the Logstash node.
+func (s *PipelineStats) ServiceFields() common.MapStr {
+	return common.MapStr{
+		"service": common.MapStr{
+			"name":     ModuleName,
+			"id":       s.ID,
+			"hostname": s.Host,
+			"version":  s.Version,
+		},
+	}
+}
+
 // NewMetricSet creates a metricset that can be used to build other metricsets
 // within the Logstash module.
 func NewMetricSet(base mb.BaseMetricSet) (*MetricSet, error) {
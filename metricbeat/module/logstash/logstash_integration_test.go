@@ -32,11 +32,15 @@ import (
 	"github.com/elastic/beats/v7/metricbeat/module/logstash"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node"
 	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/node_stats"
+	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/pipeline"
+	_ "github.com/elastic/beats/v7/metricbeat/module/logstash/plugin"
 )
 
 var metricSets = []string{
 	"node",
 	"node_stats",
+	"pipeline",
+	"plugin",
 }
 
 func TestFetch(t *testing.T) {
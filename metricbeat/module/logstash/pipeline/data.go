@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package pipeline
+
+import (
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	s "github.com/elastic/beats/v7/libbeat/common/schema"
+	c "github.com/elastic/beats/v7/libbeat/common/schema/mapstriface"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/logstash"
+)
+
+var (
+	schema = s.Schema{
+		"events": c.Dict("events", s.Schema{
+			"in":                            c.Int("in"),
+			"out":                           c.Int("out"),
+			"filtered":                      c.Int("filtered"),
+			"duration_in_millis":            c.Int("duration_in_millis"),
+			"queue_push_duration_in_millis": c.Int("queue_push_duration_in_millis"),
+		}),
+		"reloads": c.Dict("reloads", s.Schema{
+			"successes": c.Int("successes"),
+			"failures":  c.Int("failures"),
+		}, c.DictOptional),
+		"queue": c.Dict("queue", s.Schema{
+			"type":                    c.Str("type"),
+			"events_count":            c.Int("events_count", s.Optional),
+			"queue_size_in_bytes":     c.Int("queue_size_in_bytes", s.Optional),
+			"max_queue_size_in_bytes": c.Int("max_queue_size_in_bytes", s.Optional),
+			"capacity": c.Dict("capacity", s.Schema{
+				"max_unread_events":      c.Int("max_unread_events", s.Optional),
+				"page_capacity_in_bytes": c.Int("page_capacity_in_bytes", s.Optional),
+			}, c.DictOptional),
+		}, c.DictOptional),
+		"dead_letter_queue": c.Dict("dead_letter_queue", s.Schema{
+			"queue_size_in_bytes":     c.Int("queue_size_in_bytes"),
+			"max_queue_size_in_bytes": c.Int("max_queue_size_in_bytes", s.Optional),
+			"dropped_events":          c.Int("dropped_events", s.Optional),
+			"expired_events":          c.Int("expired_events", s.Optional),
+		}, c.DictOptional),
+	}
+)
+
+func eventsMapping(r mb.ReporterV2, content []byte) error {
+	stats, err := logstash.ParsePipelineStats(content)
+	if err != nil {
+		return err
+	}
+
+	var errs multierror.Errors
+	for id, pipeline := range stats.Pipelines {
+		fields, err := schema.Apply(pipeline)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "failure applying pipeline schema for pipeline %s", id))
+			continue
+		}
+		fields.Put("id", id)
+		addQueueUtilization(fields)
+
+		event := mb.Event{
+			RootFields:      stats.ServiceFields(),
+			MetricSetFields: fields,
+		}
+		r.Event(event)
+	}
+
+	return errs.Err()
+}
+
+// addQueueUtilization adds the fraction of the persistent queue in use when
+// its maximum size is known.
+func addQueueUtilization(fields common.MapStr) {
+	size, err := fields.GetValue("queue.queue_size_in_bytes")
+	if err != nil {
+		return
+	}
+	max, err := fields.GetValue("queue.max_queue_size_in_bytes")
+	if err != nil {
+		return
+	}
+
+	sizeBytes, _ := size.(int64)
+	maxBytes, _ := max.(int64)
+	if maxBytes <= 0 {
+		return
+	}
+	fields.Put("queue.utilization.pct", common.Round(float64(sizeBytes)/float64(maxBytes), common.DefaultDecimalPlacesCount))
+}
@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+// +build !integration
+
+package pipeline
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestEventMapping(t *testing.T) {
+	input, err := ioutil.ReadFile("./_meta/test/node_stats_pipelines.750.json")
+	require.NoError(t, err)
+
+	reporter := &mbtest.CapturingReporterV2{}
+	err = eventsMapping(reporter, input)
+	require.NoError(t, err)
+	require.Empty(t, reporter.GetErrors())
+
+	events := map[string]common.MapStr{}
+	for _, e := range reporter.GetEvents() {
+		id, err := e.MetricSetFields.GetValue("id")
+		require.NoError(t, err)
+		events[id.(string)] = e.MetricSetFields
+
+		serviceID, _ := e.RootFields.GetValue("service.id")
+		assert.Equal(t, "7565df20-c3aa-4261-81d5-3b0ab8d15c16", serviceID)
+	}
+	require.Len(t, events, 2)
+
+	main := events["main"]
+	for field, expected := range map[string]interface{}{
+		"events.in":                             int64(11520),
+		"events.duration_in_millis":             int64(68424),
+		"queue.type":                            "persisted",
+		"queue.events_count":                    int64(20),
+		"queue.utilization.pct":                 0.25,
+		"dead_letter_queue.queue_size_in_bytes": int64(1024),
+	} {
+		v, err := main.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, expected, v, field)
+		}
+	}
+
+	metrics := events["metrics"]
+	queueType, _ := metrics.GetValue("queue.type")
+	assert.Equal(t, "memory", queueType)
+	_, err = metrics.GetValue("queue.utilization")
+	assert.Error(t, err)
+	_, err = metrics.GetValue("dead_letter_queue")
+	assert.Error(t, err)
+}
@@ -0,0 +1,116 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+package plugin
+
+import (
+	"github.com/joeshaw/multierror"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	s "github.com/elastic/beats/v7/libbeat/common/schema"
+	c "github.com/elastic/beats/v7/libbeat/common/schema/mapstriface"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/metricbeat/module/logstash"
+)
+
+var (
+	schema = s.Schema{
+		"id":   c.Str("id"),
+		"name": c.Str("name"),
+		"events": c.Dict("events", s.Schema{
+			"in":                            c.Int("in", s.Optional),
+			"out":                           c.Int("out", s.Optional),
+			"duration_in_millis":            c.Int("duration_in_millis", s.Optional),
+			"queue_push_duration_in_millis": c.Int("queue_push_duration_in_millis", s.Optional),
+		}, c.DictOptional),
+	}
+
+	// pluginTypes maps the sections of the pipeline plugins stats to the
+	// type of the plugins they contain.
+	pluginTypes = map[string]string{
+		"inputs":  "input",
+		"codecs":  "codec",
+		"filters": "filter",
+		"outputs": "output",
+	}
+)
+
+func eventsMapping(r mb.ReporterV2, content []byte) error {
+	stats, err := logstash.ParsePipelineStats(content)
+	if err != nil {
+		return err
+	}
+
+	var errs multierror.Errors
+	for pipelineID, pipeline := range stats.Pipelines {
+		plugins, ok := pipeline["plugins"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for section, pluginType := range pluginTypes {
+			list, ok := plugins[section].([]interface{})
+			if !ok {
+				continue
+			}
+
+			for _, p := range list {
+				plugin, ok := p.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				fields, err := schema.Apply(plugin)
+				if err != nil {
+					errs = append(errs, errors.Wrapf(err, "failure applying plugin schema for pipeline %s", pipelineID))
+					continue
+				}
+				fields.Put("type", pluginType)
+				fields.Put("pipeline.id", pipelineID)
+				addAverageDuration(fields)
+
+				event := mb.Event{
+					RootFields:      stats.ServiceFields(),
+					MetricSetFields: fields,
+				}
+				r.Event(event)
+			}
+		}
+	}
+
+	return errs.Err()
+}
+
+// addAverageDuration adds the average time the plugin spent processing each
+// event, it is only known for plugins that emitted events.
+func addAverageDuration(fields common.MapStr) {
+	duration, err := fields.GetValue("events.duration_in_millis")
+	if err != nil {
+		return
+	}
+	out, err := fields.GetValue("events.out")
+	if err != nil {
+		return
+	}
+
+	durationMillis, _ := duration.(int64)
+	count, _ := out.(int64)
+	if count <= 0 {
+		return
+	}
+	fields.Put("events.avg_duration_in_millis", common.Round(float64(durationMillis)/float64(count), common.DefaultDecimalPlacesCount))
+}
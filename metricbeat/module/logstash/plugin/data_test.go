@@ -0,0 +1,75 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+// +build !integration
+
+package plugin
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	mbtest "github.com/elastic/beats/v7/metricbeat/mb/testing"
+)
+
+func TestEventMapping(t *testing.T) {
+	input, err := ioutil.ReadFile("./_meta/test/node_stats_pipelines.750.json")
+	require.NoError(t, err)
+
+	reporter := &mbtest.CapturingReporterV2{}
+	err = eventsMapping(reporter, input)
+	require.NoError(t, err)
+	require.Empty(t, reporter.GetErrors())
+
+	events := map[string]common.MapStr{}
+	for _, e := range reporter.GetEvents() {
+		id, err := e.MetricSetFields.GetValue("id")
+		require.NoError(t, err)
+		events[id.(string)] = e.MetricSetFields
+	}
+	require.Len(t, events, 6)
+
+	assert.Equal(t, common.MapStr{
+		"id":   "grok_access",
+		"name": "grok",
+		"type": "filter",
+		"pipeline": common.MapStr{
+			"id": "main",
+		},
+		"events": common.MapStr{
+			"in":                     int64(11500),
+			"out":                    int64(11500),
+			"duration_in_millis":     int64(40250),
+			"avg_duration_in_millis": 3.5,
+		},
+	}, events["grok_access"])
+
+	assert.Equal(t, common.MapStr{
+		"id":   "plain_ab12",
+		"name": "plain",
+		"type": "codec",
+		"pipeline": common.MapStr{
+			"id": "main",
+		},
+	}, events["plain_ab12"])
+
+	pipelineID, _ := events["stdout_output"].GetValue("pipeline.id")
+	assert.Equal(t, "metrics", pipelineID)
+}
@@ -21,7 +21,9 @@ Package mysql is Metricbeat module for MySQL server.
 package mysql
 
 import (
+	"context"
 	"database/sql"
+	"strings"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
 
@@ -36,6 +38,19 @@ func init() {
 	}
 }
 
+const (
+	// FeatureMariaDB is the feature of MariaDB servers.
+	FeatureMariaDB = "mariadb"
+	// FeatureGalera is the feature of the nodes of Galera clusters.
+	FeatureGalera = "galera"
+)
+
+// Module is the mysql module. It probes the version of the servers and
+// whether they are nodes of a Galera cluster when it starts.
+type Module struct {
+	mb.BaseModule
+}
+
 func NewModule(base mb.BaseModule) (mb.Module, error) {
 	// Validate that at least one host has been specified.
 	config := struct {
@@ -45,7 +60,47 @@ func NewModule(base mb.BaseModule) (mb.Module, error) {
 		return nil, err
 	}
 
-	return &base, nil
+	return &Module{BaseModule: base}, nil
+}
+
+// ProbeCapabilities implements mb.CapabilitiesProber. It disables the
+// galera_status metricset for servers that are not nodes of a Galera cluster.
+func (m *Module) ProbeCapabilities(ctx context.Context, host mb.HostData) (mb.Capabilities, error) {
+	db, err := NewDB(host.URI)
+	if err != nil {
+		return mb.Capabilities{}, err
+	}
+	defer db.Close()
+
+	var version string
+	if err := db.QueryRowContext(ctx, "SELECT VERSION()").Scan(&version); err != nil {
+		return mb.Capabilities{}, errors.Wrap(err, "failed to query the version")
+	}
+
+	rows, err := db.QueryContext(ctx, "SHOW /*!50002 GLOBAL */ STATUS LIKE 'wsrep_ready'")
+	if err != nil {
+		return mb.Capabilities{}, errors.Wrap(err, "failed to query the wsrep status")
+	}
+	defer rows.Close()
+	galera := rows.Next()
+	if err := rows.Err(); err != nil {
+		return mb.Capabilities{}, errors.Wrap(err, "failed to query the wsrep status")
+	}
+
+	return newCapabilities(version, galera), nil
+}
+
+func newCapabilities(version string, galera bool) mb.Capabilities {
+	c := mb.Capabilities{Version: version}
+	if strings.Contains(strings.ToLower(version), "mariadb") {
+		c.Features = append(c.Features, FeatureMariaDB)
+	}
+	if galera {
+		c.Features = append(c.Features, FeatureGalera)
+	} else {
+		c.Disable("galera_status", "the server is not a node of a Galera cluster")
+	}
+	return c
 }
 
 // ParseDSN creates a DSN (data source name) string by parsing the host.
@@ -68,3 +68,14 @@ func TestParseDSN(t *testing.T) {
 		}
 	}
 }
+
+func TestNewCapabilities(t *testing.T) {
+	c := newCapabilities("5.7.30-log", false)
+	assert.Equal(t, "5.7.30-log", c.Version)
+	assert.Empty(t, c.Features)
+	assert.Contains(t, c.Disabled, "galera_status")
+
+	c = newCapabilities("10.4.13-MariaDB-1:10.4.13+maria~focal", true)
+	assert.Equal(t, []string{FeatureMariaDB, FeatureGalera}, c.Features)
+	assert.Empty(t, c.Disabled)
+}
@@ -79,10 +79,13 @@ type MetricSet struct {
 	prometheus     p.Prometheus
 	includeMetrics []*regexp.Regexp
 	excludeMetrics []*regexp.Regexp
-	namespace      string
-	promEventsGen  PromEventsGenerator
-	once           sync.Once
-	host           string
+	// histograms matching these patterns are converted to the given quantiles
+	histogramMetrics   []*regexp.Regexp
+	histogramQuantiles []float64
+	namespace          string
+	promEventsGen      PromEventsGenerator
+	once               sync.Once
+	host               string
 }
 
 // MetricSetBuilder returns a builder function for a new Prometheus metricset using
@@ -119,6 +122,13 @@ func MetricSetBuilder(namespace string, genFactory PromEventsGeneratorFactory) f
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to compile include patterns")
 		}
+		ms.histogramMetrics, err = compilePatternList(config.HistogramPercentiles.Metrics)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to compile histogram percentiles patterns")
+		}
+		for _, p := range config.HistogramPercentiles.Percentiles {
+			ms.histogramQuantiles = append(ms.histogramQuantiles, p/100)
+		}
 
 		return ms, nil
 	}
@@ -145,6 +155,9 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 		if m.skipFamily(family) {
 			continue
 		}
+		if family != nil && matchMetricFamily(family.GetName(), m.histogramMetrics) {
+			family = histogramToSummary(family, m.histogramQuantiles)
+		}
 		promEvents := m.promEventsGen.GeneratePromEvents(family)
 
 		for _, promEvent := range promEvents {
@@ -187,7 +200,7 @@ func (m *MetricSet) Fetch(reporter mb.ReporterV2) error {
 // Close stops the metricset
 func (m *MetricSet) Close() error {
 	m.promEventsGen.Stop()
-	return nil
+	return m.prometheus.Close()
 }
 
 func (m *MetricSet) upMetricFamily(value float64) *dto.MetricFamily {
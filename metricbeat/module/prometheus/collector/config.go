@@ -17,8 +17,11 @@
 
 package collector
 
+import "fmt"
+
 type metricsetConfig struct {
-	MetricsFilters MetricFilters `config:"metrics_filters" yaml:"metrics_filters,omitempty"`
+	MetricsFilters       MetricFilters        `config:"metrics_filters" yaml:"metrics_filters,omitempty"`
+	HistogramPercentiles HistogramPercentiles `config:"histogram_percentiles" yaml:"histogram_percentiles,omitempty"`
 }
 
 type MetricFilters struct {
@@ -26,6 +29,13 @@ type MetricFilters struct {
 	ExcludeMetrics *[]string `config:"exclude" yaml:"exclude,omitempty"`
 }
 
+// HistogramPercentiles selects the histograms to convert to percentiles at
+// collection time
+type HistogramPercentiles struct {
+	Metrics     *[]string `config:"metrics" yaml:"metrics,omitempty"`
+	Percentiles []float64 `config:"percentiles" yaml:"percentiles,omitempty"`
+}
+
 var defaultConfig = metricsetConfig{
 	MetricsFilters: MetricFilters{
 		IncludeMetrics: nil,
@@ -33,6 +43,14 @@ var defaultConfig = metricsetConfig{
 }
 
 func (c *metricsetConfig) Validate() error {
-	// validate configuration here
+	histograms := c.HistogramPercentiles
+	if histograms.Metrics != nil && len(*histograms.Metrics) > 0 && len(histograms.Percentiles) == 0 {
+		return fmt.Errorf("histogram_percentiles.percentiles cannot be empty when histogram_percentiles.metrics is set")
+	}
+	for _, p := range histograms.Percentiles {
+		if p <= 0 || p > 100 {
+			return fmt.Errorf("invalid percentile %v in histogram_percentiles.percentiles, it must be between 0 and 100", p)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,120 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package collector
+
+import (
+	"math"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// histogramToSummary converts a histogram family into a summary family with
+// the given quantiles, estimated from the buckets of each histogram. The sum
+// and count of the histograms are kept, families of other types are returned
+// as they are.
+func histogramToSummary(family *dto.MetricFamily, quantiles []float64) *dto.MetricFamily {
+	if family.GetType() != dto.MetricType_HISTOGRAM {
+		return family
+	}
+
+	summaryType := dto.MetricType_SUMMARY
+	summary := &dto.MetricFamily{
+		Name: family.Name,
+		Help: family.Help,
+		Type: &summaryType,
+	}
+	for _, metric := range family.Metric {
+		histogram := metric.GetHistogram()
+		if histogram == nil {
+			continue
+		}
+
+		s := &dto.Summary{
+			SampleCount: histogram.SampleCount,
+			SampleSum:   histogram.SampleSum,
+		}
+		for _, q := range quantiles {
+			q := q
+			value := bucketQuantile(q, histogram)
+			s.Quantile = append(s.Quantile, &dto.Quantile{
+				Quantile: &q,
+				Value:    &value,
+			})
+		}
+		summary.Metric = append(summary.Metric, &dto.Metric{
+			Label:       metric.Label,
+			Summary:     s,
+			TimestampMs: metric.TimestampMs,
+		})
+	}
+	return summary
+}
+
+// bucketQuantile estimates a quantile from the cumulative buckets of a
+// histogram, interpolating linearly inside the bucket where the quantile falls,
+// as done by the `histogram_quantile` function of Prometheus. It returns NaN
+// when there are no observations.
+func bucketQuantile(q float64, histogram *dto.Histogram) float64 {
+	buckets := append([]*dto.Bucket(nil), histogram.GetBucket()...)
+	sort.Slice(buckets, func(i, j int) bool {
+		return buckets[i].GetUpperBound() < buckets[j].GetUpperBound()
+	})
+	if len(buckets) == 0 || !math.IsInf(buckets[len(buckets)-1].GetUpperBound(), 1) {
+		// The +Inf bucket is implicit in some exposition formats, it holds
+		// all the observations.
+		buckets = append(buckets, &dto.Bucket{
+			UpperBound:      proto.Float64(math.Inf(1)),
+			CumulativeCount: histogram.SampleCount,
+		})
+	}
+
+	total := float64(buckets[len(buckets)-1].GetCumulativeCount())
+	if total == 0 {
+		return math.NaN()
+	}
+
+	rank := q * total
+	i := sort.Search(len(buckets), func(i int) bool {
+		return float64(buckets[i].GetCumulativeCount()) >= rank
+	})
+	if i >= len(buckets)-1 {
+		// The quantile falls in the +Inf bucket, use the highest finite bound.
+		if len(buckets) < 2 {
+			return math.NaN()
+		}
+		return buckets[len(buckets)-2].GetUpperBound()
+	}
+
+	start, prevCount := 0.0, 0.0
+	end := buckets[i].GetUpperBound()
+	if i > 0 {
+		start = buckets[i-1].GetUpperBound()
+		prevCount = float64(buckets[i-1].GetCumulativeCount())
+	} else if end <= 0 {
+		// The first bucket has no lower bound if its upper bound is not positive.
+		return end
+	}
+
+	count := float64(buckets[i].GetCumulativeCount()) - prevCount
+	if count == 0 {
+		return end
+	}
+	return start + (end-start)*(rank-prevCount)/count
+}
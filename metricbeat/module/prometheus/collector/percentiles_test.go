@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// +build !integration
+
+package collector
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestBucketQuantile(t *testing.T) {
+	histogram := &dto.Histogram{
+		SampleCount: proto.Uint64(100),
+		SampleSum:   proto.Float64(45),
+		Bucket: []*dto.Bucket{
+			{UpperBound: proto.Float64(0.5), CumulativeCount: proto.Uint64(60)},
+			{UpperBound: proto.Float64(0.1), CumulativeCount: proto.Uint64(10)},
+			{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(90)},
+			{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(100)},
+		},
+	}
+
+	tests := map[float64]float64{
+		0.1:  0.1,
+		0.5:  0.42,
+		0.9:  1,
+		0.99: 1,
+	}
+	for q, expected := range tests {
+		assert.InDelta(t, expected, bucketQuantile(q, histogram), 1e-9, "quantile %v", q)
+	}
+
+	// Implicit +Inf bucket
+	histogram.Bucket = histogram.Bucket[:3]
+	assert.InDelta(t, 0.42, bucketQuantile(0.5, histogram), 1e-9)
+
+	// No observations
+	assert.True(t, math.IsNaN(bucketQuantile(0.5, &dto.Histogram{SampleCount: proto.Uint64(0)})))
+}
+
+func TestHistogramToSummaryEvents(t *testing.T) {
+	family := &dto.MetricFamily{
+		Name: proto.String("http_request_duration_seconds"),
+		Help: proto.String("foo"),
+		Type: dto.MetricType_HISTOGRAM.Enum(),
+		Metric: []*dto.Metric{
+			{
+				Label: []*dto.LabelPair{
+					{
+						Name:  proto.String("handler"),
+						Value: proto.String("query"),
+					},
+				},
+				Histogram: &dto.Histogram{
+					SampleCount: proto.Uint64(10),
+					SampleSum:   proto.Float64(4),
+					Bucket: []*dto.Bucket{
+						{UpperBound: proto.Float64(0.5), CumulativeCount: proto.Uint64(5)},
+						{UpperBound: proto.Float64(1), CumulativeCount: proto.Uint64(10)},
+						{UpperBound: proto.Float64(math.Inf(1)), CumulativeCount: proto.Uint64(10)},
+					},
+				},
+			},
+		},
+	}
+
+	p := promEventGenerator{}
+	events := p.GeneratePromEvents(histogramToSummary(family, []float64{0.5, 0.9}))
+	assert.Equal(t, []PromEvent{
+		{
+			Data: common.MapStr{
+				"metrics": common.MapStr{
+					"http_request_duration_seconds_sum":   float64(4),
+					"http_request_duration_seconds_count": uint64(10),
+				},
+			},
+			Labels: common.MapStr{"handler": "query"},
+		},
+		{
+			Data: common.MapStr{
+				"metrics": common.MapStr{
+					"http_request_duration_seconds": float64(0.5),
+				},
+			},
+			Labels: common.MapStr{"handler": "query", "quantile": "0.5"},
+		},
+		{
+			Data: common.MapStr{
+				"metrics": common.MapStr{
+					"http_request_duration_seconds": float64(0.9),
+				},
+			},
+			Labels: common.MapStr{"handler": "query", "quantile": "0.9"},
+		},
+	}, events)
+}
@@ -32,5 +32,5 @@ func init() {
 // AssetSystem returns asset data.
 // This is the base64 encoded gzipped contents of module/system.
 func AssetSystem() string {
-	return "eJzsff+PGzey5+/5KwgfFhm/m5E93mxe3vxwgON5uRvAWQ9sB+8Bh4NMdZck7rDJDsmWrPz1BxbZ39nqbqmlkYMMFtlkRiI/VSwW6xuLN+QJdndE77SB5DtCDDMc7siLT/iLF98REoOOFEsNk+KO/K/vCCHE/ZFoQ02mSQJGsUhfE86egLx7/I1QEZMEEql2JNN0BdfErKkhVAGJJOcQGYjJUsmEmDUQmYKihomVRzH7jhC9lsrMIymWbHVHjMrgO0IUcKAa7siKfkfIkgGP9R0CuiGCJlAhw/6YXWo/q2SW+t8ESLE/X9zXvpBICkOZ0ITLiHI/Wk7fzH++Om917kgqKH4Zmn0PggqKGztOBYrlp0dAllIRSjQTKw44H5FLQkmSccPwexUO5j91puU/TSKqhLC49uucFC7FqvGHPdTYHwv9nUUlsmQBqkRV++T/II+gIhCGrkAHAWUa1CyNTBCWjiiHeL7kkjY/sJQqoeaOpG78ceA/ryH/Il0hoy05hiVAdArCECYQGNEpjaCDthoFhkVPehrWWnA0kZkwRwLz8nKJzH0CJYCPoWJCBvdyeAQ6wSK4PA5LQbjc3qSKScXMjqRKRqA16CHUnI3Th6JkMb9AniOqAcDPJ8gDAMktZeYCeSmIBUaupCAx008vh9FxTh0xDp/6/fKYrEFtWGRNM2vSramIuf2PNVXx1lpzTBhQKktN735Uv5+P9ZOh1nJpvqV1sXgPo/C51+YA5AYov7yVYYIwsZE8E4aqnVMBix36ORumTEY5fmO7Zhzwt+tdalmipWpNtqW6xi9p1qDyI1CqWesLbzeUcbrgQKTgO3t4/ibY10GMPKdevFwGFb5cmh3lykVp1vImLVXWY9bHeWfWzZtyoZxvli8Ujk5SBdpbX7gCUpuZ+7AUN8LuH87+gKabSCo7Q5Mt45ys6Qasg0q/siRLyIbyDDfNl9vXr/9G/s1N9wXHbg1WzlMbl3IFNN4RQ5+sfDDtR2XCSEKjCMXO6ZZNe9AAFgvlT+2akg+iHSLQ161hdzIjERVu0aosL4I3KwXUgLK/EI5v5BepCHylScrhmrAl+XtrWCdS9uvUkB9f/81Cu7Zy5YTLhz1mUZrNcm5+cdKzAHL7U+fi/Llc2D+Xk/jtul9/Fm/nG7Ja/7LLAxT+Zd1OY90aaS6UkdYWBE0c2XiiPsQcUHAePvyX1UJdRsk/S8tokH1iLamLZMHYMPXFEjL2oL9MQo467S+TpOFH/oXiP+Dcv0xKJj/8vykyD7UALpPIb9UMuDRuDrECrvNAiIY4Z3IZs0HnOkB7w2L43IrufSuZ6UvO6X4bWdALTCZedBLuuVMhh5+Iz4380EPur9xDlSdWTpn8rsmKMekHO0Ql/2D/kzx8KMrIBtbg5T/jcxT2n8H1fILdVqpm4sDHj++Ijunt+OVG8uyUfcIGilE+d4fnCHgDIXyv/Qx5uRv5vGaaJHRHhDRkAVY4Nix2xzjlvGR6a0wfo+8hSAGNZ5jwmHDzoKVUsTDsJFZk7ApZkdFZZCV8mXG+68G3VczAyQHiLAciRA4udmZ4Ri03BUNfOgA8DoMw6rDJB0HeM5F9dSku1pyKNOxADZGRyo+EyZ6UMy9pglCts8RyBj9FNPsD7dB/3L4ZtILPzyCLw4CYhkf5YAPZ1Bq1n20oVvbcOaHYJ4xbnyCSItb+ePNqBXfsoIV9Nohuz/Yai6cGGMYYS3sOPrz60A/Qem8zXG0Fv2egzSwBtQI9T0HNNURB7CEPswd8M1WP29xPqQnOiVly4ihxGdstKCC/Z5BBTIzEzRDDhvX6Np4sJyLnpQvnPDVhtfU660KV6JnWLfQVOg9YoPOuzLSU4Ip4AvacNhOQ8XN53ha2bwtz03YPH2m9BFHrX0xLCN2Aoiuo+jRLqRpSFlwRI60Fah0WiMfs/zOuihOxUy6LI+l869LYNBMtTL7j6WY1tzbKaUhB6+eKCcfel3aZLOqBGmAYJajDT0wHzkE4iJVZn4SIc27zaQXJhS9g3mllHS9EbgZHiBWmqrn1Eol6ePVh2vVYZHo3HTWP4ch9nClrJG7XLFrXSeg+FK8WVMRbFps1yQzj7A9qp0UmlJ96OSP37uOamky5j8goyqzj4mrmypJHTSIuNS59vYoxZwkIo2S6OyaYVIat/HXI9pjjA0Q0H3S+YGbS0F+B1g5sl6wNt4Tx/KmgEq/HeW25SQ3bQC49qZS8cNl/eP0fP7ZWeck41G6+koOihuUwrdrl8k9TlDAXRJ8ppoABQszqVPhtpHX5M5EqtmEcrJ+Buan8xJsFobtNOh8Z4BwVxKyW1N6RL69i2Lyyf739EkRk5z0BFDtGEwp8NT+EQWDAfZ5K1hHpOxgLDmw1LY7d4k0YDUrrCcMGdnwiZAzaSovdo/ibduS8AknBs0r7fqm26OZTc63CLwVwCNOQ72fimlvjCu/2cyzTcN7AsZ1wJLznP90aoPfVKRSiqO0Bc9Q55kXKjVQ5yiqHWJ4Jo6uVghUtUmGUc6dyGpdbyq8efXvn0GTIP+vqx6MhS5k1PePa9jliW38OqL0OeXNTBZy4fVIfXtk24aBxfUqqSSwj3YoGBLhO9mvgvazoQ9/CGfIeiGciasDGHmgCtJvl2QDiTu0BGFLH50Po1N4VAk15ppGnL9suD5c0PkZ9WBfPjpH7sEdu+Be3L8YqYfsnJlbzJY2MVHfWtRuniN9X4BfuJafakISJzEB4D7/4xyUh/YfH2qFwXtxeFNrbANwwbixBfC6ZCMgCiVlRkzCstLBNznMtRVhgpqDo2aSrQ6qOpAk/FKbotJeGW9rZdQU7yrxzQ7RCFL7f2AThibO5HXiuOdz9/aPO5278Zo/YQbDO6NU6/6ys7EOLyq954Qu5iisXHI0laCy8YiLiWVx8OJLCVXksdrk5GdFoDZpQ0ba/FtlyCUqTKw2Fr+pZQyOTUT5rmCEX744NWlhH22H2ehvJWxyt7AgIMdaNWs71WfF7reXgjiBnsEg9QRV+VmTwwRAFXhlqF9lnVohAREAWYLbgb757kcaqhmqsxq9QsCmC/Wl+ksSQgoh1rnk/fHJxskQqIDEYyri+JimqQRKtIXoqfOSKDH/pEAny/D6UZ3d4yz8YzINQHmUcHfkFtctS4UW9TMxph7y/wK+QlAkODAG8SpWMXiWQMLGU121e2B+pqhPi16rg0D0plUqhRNiyProFbjVUsaBt38v+fBDkw6f/JgwJpURnSVMB5jLEBI0wdZCL0AdB/ouJWG71tf8+/N7e2H4VZSEW/utDxaJDvZEhKo70qjky0Ets51Zau7SvQHhLm5qtW+elCpbs6x158X+RrP/XNK/qoRQreThKabZYS4VpwyLtUj5lvtDiqPVPzaU5FCztj3w8s99eEjNUlJ5LraPhMw7vc2nEMik7Cq7MzCxtXRYfgLmGKcqNMBwKIaRW5WamHwETpwPARP/8CmhM11hvdjQM5H0xIKkPOAABHhGjY377IOCIZF3NqX9rWjsbtgmLFD5dwRydvsOs1fzIxmqVQiOP1LDpSkdUzJ8s7AMFK+dm8HZKC7WX+4gK4TwZN3UfwJgpiA7UAMcAdPPyZllOFR86A2cDZmcrgimt0okW7wxQfsbVLaMrDq2CiFOWDF1pRHu+pe5G27vs7gNzWC5ZxEBEuzPqIzd3jpaUGCr6aEbeEi63oKo6iomYRXhpuxQea1lro7LVCi9CGlmM21RiTRa45XweFri5z86CoB5fZysICevZDXALxEvyc9vew/Zf+GAt08UVgnzhRSolv3Rb/NdKsIgJQjmXES5RSc4UnmkPAUfZNvXS0ZpcdVbokol8i2lEp4w0HSxEClxF8vMSkqMgi8y4kEtAnkZSpjOV8uzEh2sfYXIDKpJJwkZvjRiWNOMmVLQxmIYj9ve9m94Vti6lGgfeHlyzqr/ZBB46MFrIKksf8mEr9HZoedJwREK8IH3MbMEagqiiJSjnCxo9TTL1u9yxrrAGa/KTTOMVdp1yZv9liZ1ktzStwiuu/4PZSlVFND7L58eopPn8b6qNDGrv4eR/x+YTy0Yly/l6GIBZj0z8YkK1CX5IQwOZmbPWIDZvZWsQXU0KK+Ge50SoIALWfx/GhcWiJ5j0LkLVMcKxBzLsdEhUgWQgY5iYgVJSnYYtbmjfbsUhYmI1YK3OhUmDiPsRMTGLlbTK+iSImIhkgiXwfu3KS1J+2gEcOyVAmZmV3A+wmphnmlC+pbv2Yfna+lr3VG2twS9i8vOne7KAiGYafPbKmm4KUqlMGb7pbl3TOI/mOksSOqD6pDgsFmDosPPqV38iucs7zv9dcbmgvFDtmJpjZjfw/GHp7N+CyyUX/4KWR9OzYA+PLmYOKtwFzkRTzvb5Xc90WTzldL/d908358zAxHO+Zwb2T8yiZNJVfPdrgNLCAHWtp46yuvwYFavL/8aaXDSmhl5XHyS8rr702HgmkUxrdVHOaFNjpNSsC7pnga8mbOVuUBZPSLZnxAaMIwy9IVU3nmc4dOPO0guVCcHE6kW4rjXteHyxn/z2N4dQnx4x4YEzrg6fsf3VITNGScyZmHiNlxnnxHreVMQ3dngXqjLSrroyLpDgcF/7EjQ8FgI1PVStsgSLhTSkVFF/tgWr8dlKSAVzupAbuCNvXv/wU1jjaVAHbCXXLfywfRRtD11WezoysfIpi3p56NDZQWyGq1n3y/mREgBiw5QUduXIhipGF9zH9oJS4B7QsSo01KmKVpoDkl8UwM+f7q9d2ZJTsh8+kf8Oq4z6W0Vkupj5u8ffbnQKEVuyqBosT8s+h2PD4Z3dZsmorHd3LjnQ+tFUNfK+NrRNsK5nMBqtJ0JbvEFkwbpsg2YiAic9Xl908boJ9PJS+Y3um95eL9YCKS2K3bM0xtPywVQcBc0SxqnyhVHBaf9mZykYWZ0gZjrldFd6CkamucrO22+2Oy2GmdvROfqb4jBsauGH+shV96zy8lbrvkFZ72+5yAxRVHQFPrEw8nW7O0WTxXtaPZMz64VwC+gmYCcTp8TraoP3Lu8eflrtEerqUqKL20bvGHQW0zZ/wStnInbEtVNDx4XU1uUPMrxOx+UDx55Hfedd33n1TMmRUgLytsTex6qye011tcDXVTc3Ks/fYWqIvFtTtQJyZQIXKYqRqTNXcm+OCroCZWchLsGEpc4YcPcuTI7kZfECn4+6uktMTPdLqtL62TLMlskfQbPYbq1PYMgn9gfMGtoiwHcZRVnKXFo6ofYf7jNXH9/++rJ3RaJMKTuhN3qJBpcDu+640d/k1uWdQaNZ1L3b1lQ913bDueMQMZnubJsR9ngOuCHzC+NQfEYqbwvmERV3PFtJQXa7SCPTFadhGWhQ7u482FPa+xNTK0eZgjjq+Gt0CanzQOP4g888zhJmZlouR9d6DBUQuTRulrwiqAd6YT0Fh6x5hZWxIyrIAki0tmZV3LToqCFU7PD87WPFmrac2qlYYYc+FSsqY1tWYKv8BRBF8/dPlJSmwxEObbyDt2Qe0bcbCPHosjWlmwnbomIDODxXqX5yF3QSwPbvrRH9t4reIwrKXEbLmLLnrhtIr1mKJVCtAYUUN5YdfmRkoIbaBMi/WnAB1cJYv70VdyM9EbQBDCZemh7u0cCwkiSxAYujRhOqtYwYhsO2zKzdcWrZHPZhHtD7w+Z74ntDaD7qw70LyvjW0/noOBrSnV8GC45KF3uStqRW/2HWp2OSHT2/HuTlqNknzv9aZwvnT32vXSsb1zlrFMtwtnMwrR27IuNKeLo5FqVZyQuiozXEGQeNPhXFLvLOTqX6qaj88vsoOOZb951cP0thlOTca7atLGK3xVRKX5N3v3xCBfLxc3hQ+3dtqIgdmPwNA74jS8pUOZTXM6mSVl8wKSgPlFUjd7BRgLf+c/cxv3WaL2NxRXILbLU2M/LxcwVGcFwFlHtftAFKg9GVd7WDnnbQHiXlO0b1BUAm+3vaeadNSlZsA8Lankzuq54cVq0VVGhkwH4lTQl8uM/jTk3p2QugQ10cBCG8CezP4yFqo3O0kDrZS2S01DO/YMFCSTK6QG0PqTgProV/Wi1hkZJ5a38sMZRbomCVcarsqdg5lGPJ9zrXE0aiLCvQMlMRaKLXMuMx2iVQVJKO4MnvmTT09Cz53HD1OxnjNjLl4YvBCClXk7S6R1Um8v0pBfi9Sa6oJjEsmTP7urlcFY6uDgoh7qGrdmrevRVYi7cC5aMbGCDx4SewCq/YSIinqvA6B611H82NxhpbZ5W8QD5Z7LVjNyfTzDPFmd95seYbYoWerdZVa3Qve5W54P1a7Mtu/nbsVwzDjN2oysxUJtDVugRmYBhfihVog9YHE5nMtN9znQMz0XBR6pt4TTfQxbWBbHINdxyMU7OprHv3qgbLZTeUa1Q6tQ1jN0VdxXQrN7u1kRXAabr/ckabdLNW0hgO8dmZYGVFd63qwrUZ8djIFRLJ9HXnuPm1iK1LYFvdnhffmTXsPIO+rmmG/RjxAbPlXr1UUXdWqmsr5OIBTBE8C4eq/ybHxcmP0CJinrd9dx3Zr5ggggpZa2Xvd1qxHj0GRmidhvlMNNoTBR7kN3kvKO+uHKjdyn/+sqaLn2e2pn0m+jxWY/WN4oqg19pnWRVQ9Z975H3UHnelSNPQWtBSBV8Ax1KQRMZdty3C+HzO+nwIr1xq+uUYqCmocISF7C+Qyn9qhVLHS1ZBZUt7FmRLQYBGa/xoQ8L2HN9M94vY3iQ0Gac9/eVUHxZ2NbB/KdATKdDxijKBZIYZtM7cMhmyQ/syiyMIr7Z69Mm9xa4z/FW+ujSa4IR+vRyi11BEBau9/6am3OW7LpHqMvTiDpl67zpLbV4XbP327uOTRmt46Us02vFqTPRULPdMDz0fLPeWlPHs9PGUeq7Xey6NmhOX9rtqrOlLsm0VEJc/CrCJ0nCC9fbSdMMa8p5+eXFITVFgN0FsiORaoPs91DnelHurYNal6pVWpY49jNvMqjFljyFxLLMuXhXlkSQvcE2m4WLvj5NMrYD09pJUUHOz4YJ2jnjVWnVUViOV0tOl2iu+GPZkZsvT5dstTRb0mi+do47nzMUrE7ls8GefguiOEh6iOJ4u03RprtuUtosde26i9CJVRU1H2EPm87vHsu9xq7J1DKGXqhqqOqFJcUBH9ATHDtOeyKZvQU94ZjX51FIYfVw62NIouHWZSqO5kN3JqvH2hQtYus7gcypkuCXLYAZMKCtvhRS7RGa6tEBdB1spiO9kzoFqc6MgAmH47gZ329X7j791M4gzbWpXbpN0qcmVXieQvAyV2Q9nnvXSz8y8XxiHmwWNnsri9JI57z/+VpB7AFXI6zPT82gPCJx46jVaM1BURWsWUT53rJpflmqsho0LTyyH7a2novFCRU843deduZ2EXXp7mdwqPbLBfOscss7Pw/iWv7Hw7WjS4lWIqrqo7bxuB7e5Iw/i1DOozW5OhRVqkEcHSEeCTfsui+JP/u1wR+2Ng0j8/+Gjnt2qeFqdg83ase/j2YtkrI6gxe2KundqFFutQEFsP7EvAIbQR8rDv6SafwN0I9AewsmLX+2nXrj/1GRtRUiUd1d8MMC9u8J3eIfFyH3ur3u2Bpti4OWamFVvdwyUKD3vDLucoPAMe2Laf2L1may81sTcpby8Q9MBdHT1+jw1ITKrOGnHkrLvTu8gUs5xLPrUm90higqdUsy7FE3IX14TIbvjvtMarkrruZ35Yrj2z0YXTbkktGBkkF/jSme2NL0YWj8VaY8DVy8TsGGRwee7LoWoXyvh2IgKIY27q+AfZhhEaU7lgj+xkA4fUS7zM5dRtW/vX1UyU1fJHFAk46oJL0Vim2/NO8WDumYJSrlon3s00j/Tv0Chiu3m65yc7EnWjGITk+epuiwZ8PDqQ97ZVAos87fcdhVylvzDCcc6bCiv1vvaY2zoITmLdu0GqvkN7UADVWY43JFHb19+GthhdQ9T/BC1Ht+Vi9Ggi/YjwYeGT/7gb19nuMY6lrARLtMtvJUbJ46wiZBUXj+o92sZhIXFrYKk44HYQUeh0BwgPQVL8oHHoTFTNlGugHHjjsLyh0wWbPoVcsOOQhIDnZ4lMT6oF0ZBHsz3mmxA7UgmOHsC7k0dZtytdOuWUoVvfTBBtEz8XTrKiWYm8yqVGZLQnXdiw6Rl4knIbdO5PJ66krDKtZG1e4AOWwrzWHzvbTajGGys3lfWJfOI2ipa0Zp1NFrtNr5/8vcS+nhFk6KfnzvqurYkNa3beUfMmzfpvnFLMQABhw2ED4+DO4vatXDj1gGEObAT0dzClmE5PQjFO1+IaAcnbvBrwhyWj28f7glViu7cvco4EzEVJtx7Pmb6KU+fTbSNqu8TuZitm2TP/Kc84HGGyiLhXQamjXWb92FCH3p6luCwcT9LlpTxyY6yyvxu3P75cX/pMc3Rj+/aSxKKTXsU3SIKp2/DrdvRvZhWciphFRy8KjRryWMMw5Pb129+uLHuTw5hHzy7P09gkHh83sD2EF0oWeGNMDtvD9pCP4Fq6K7JHl2ough5nxc3mz7DoYUVHpVjqk1o5ZCQNJ4f1Wn+M17/pjGpHUz75jx6uvwsHDFltjieSp0tbsYROcdGt8E5A31OWxNipsTQJM0nxG653hbDPmwz3ykph4LBcXf2UBHn/tW1s1Ht/4wmWdru0lZ0K/8K0TyS8VF8+vTwv9/9n/f3xI5TtibzCL/XrvFi+1GIismY3/QPouhtmeZ3XNFtrNWtK7xy/c3GojTzpX/B25XDO9iVXbXr9w07Z/YJkP0FlsPnrxVFtjLozcmxDG6GGZejZq1UnWFh3fCFab2Y04ljUNg3f/gml7PO2wWDYr/nrrVwEciOzGIFVfi1rtPhyh9Z6kHW/aTZYGjBafueI+x6k+6wWX3+qTJn276QFtgxru/nd49+FF0aOU69HxdXdA9adDlm3Q9j+I0z6/p+14MYQRBLmrBWr7ihCOznjpmcy4jyGQs35Wz9ungk5/Y/3sxez97MbolU5M3r17d3r+9//unu7c//eX/30z/+/uPd3e040/a9xUEeHgmNY+V7jbKimR8V5OFx84Od7OFx82PxoSG0pVI1N0SniBf0vXlzCHw7VQ8mBYk0cAEM/4hAJua4p+4sLPcEDOf5Wuowqp73Qv/9x5s3t7c3t7f/fvP3H2diO/N/mUWy9dp4D+bHzx+JgkiqOHjoq3xNZuQBX9OTC0OxS9uGUaJgA0q3j+eHR8KlfOpMmDXYAIbH85Rnei5HPblUvp96KPn4Js9yCZFPlKY3LoQWS7SEr+Dz+/uXuYnveWEXzVWYSgEkke1rSpwugNfe8LrGAexo//MWXc8XSylnC6pmK8mpWM2kWs1eWP6+qP6ilfQungOyY8RgQCVM5G++2OFJJBPwXYepIJAsII4hJpFMd0VgkJpWmyH8wtqY9O7VqzRbcBbpbLlkXxHHYFme40uYhzoobeH8Tzuc/9AiJ9O1lyrWBCXQixvxFzV6EHc/f9Z3xo1/OG0vAP+wzIEgAoGIw1BM/dbZL5V3zkht6L044Ouhz/hZ3zjDcppj+IHtg0aLRPhb4yfuDCv1TL3MOJ+PEIW6Ddydnv+EfydD3z8dkZ2XS9emP7efWZmT9wGCoyzodkvSg/u5v0U5FsJZ1M1F6I1J7HXLfafQPoc4XP1hgSEPu9FVe/trA4EigQmxFFOg8dP5XuyU6+IejD10bXo6OnUzZIKnQ36tXwyvupJ5wOe6bLddhmaKZqS+DhfLU11ALXXPwP0BM/JOKgU6xcZrRub9pjRgXvuV1Ziv9E6/EmBesXTzwysTpfMEkhn50NH2v7vML9z89+hO7P2rSwYGgKRK13R/nXf3Sg9Ei4jdXveL5KeF2Ip8vrTd/N1LQZcOmZqAXJ/0832YXjkBPgttn55pwgNtLQKm161k1wkAlnmwyrSjuBlxqWG+pZ2tQ06CtoHQ6oh5iWQeTAjVcRuWXAbsAsgQ1Hon5jr8oNVZQec4hmJWEDUfrX0WzBbHEMxLJnBNmqGgs4MugIxB3Yz/PBvqN0NQc6rNnEahDMxZQec4hmC2uuYsJ0i/ymNiFUJcOGnxpObrb/d/EvPVEvKM5msWX6L5un91yUDz9dzGXxfqPf9S7I608YjF6CjBFzfEl3o3A3+dQaxyUXGf8rGEI1NtvjH7LAlXMwRSA/n2yb/a+DMTaWbm+YcSxjkLlw8MKOj88CmnFV92KIdql0tlGpTu5f0BxVLv5WoF8U3x9jlozaRoBpD38bgjnHZwmWt5CcuDCc6qofV41BHzvhXV1AiXK2Y1V3OKPfe9jqT5/udM+0pG98raAA4EkrBHorBfz2euSkPHAoRqRY5Zg0L4hpam1NMTQSQLKTm04gO9SOzXCBMxi5xmonlmaC9HjilxC69I3vm1Ufi2B0Mkp5aKymo4BR0HZinL3mncOqwOrqleA77rSR6H6QS3RvORKdfeI/RtLS3oc9Jly9QGoPJf/n8AAAD//y766bE="
+	return "eNrtXW2P3DaS/n6/QpjDIp69mR6PN8lm58MCjr3ZM2CvDdvBLnA4tNkSu5s7alERpWl3fv1VFal36rXVPZpcBskk0y2RTxXJYr2xeO3c88Odow4q5rv/cJxYxD6/cy4+0QcX8InHlRuJMBYyuHP+Ch84jv7SUTGLE+XseBwJV105vrjnzqsPPzss8ODTnYwOTqLYhl858ZbFDou440rf527MPWcdyR18zh0Z8ojFItgYFAvoQ21lFC9dGazF5s6Jo4TDhxH3OVOAbsPgr7XgvqfuCNC1E7AdL5CBP/EhxGcjmYTmEwsp+PNFv/YFwAUxE4FyfOky37SW0rcwzxf7LfbtyohnH9p6b0FQQHGN7RSgID8NAmctI4c5Cljlc+rPkWv4YJf4saD3ChxMf8pMS3+qRBQJEV7p45QUXwabyhct1OAPQn+FqIJkt+JRjqr05H86H3jkciB3w5UVUKLg5dCNrbAUjBT3lmtfsuoDwK4di++cULc/DPxnmJlhBgwZjeTEYgdMDuFDRwQEDP5iLm+grURBLNx7NQ1rERzbyQRgHAfMzJc5MveeRwH3h1AxIYM7OTwAXSDgkdlxWAbAlf11GAkZifjghJF0uVJc9aHmbJwei1J4/gx5Tqh6AD/fRO4BSO6ZiGcpIRCY8wymiCfU/WU/Os4pI4bhi36ZH5NhG3kQLqpmqNJt4ZePf2xZ5O1RmxNBzKMoCWPVg7qzsX4y1Equ46c0Loh3HIWPPTYjkMec+bMUSyJ4kD5Qz8D8IRGwOpCd8yCiOAGzAt/YbwWo8PjpFvACS5SMap3tmSrxS8LzUboFymhRe+HlAxM+W0HLMvAPuHn+HIivvRh5Trk4XwZltlyYHGXKwfs1axKpQotZHWedoZk35UBp2ywdKGodOMiV0b5oBKSKF/phGVwHuH588Sv3au3lK0M5e+H7sL4fOBqo7KvYJTvngfkJLZovt8+f/8H5o+7uC7Vdayzvp9Qu8yPOPJgw7B7nh1CmVRAc0mGuS9NOy5aHeqMWLAjlN22aOu+DuotAXdWaPcjEcVmgB63I8sx5swHWg3jGDwLNN+cnGTn8K9uFPr9yxNr5U61ZPaXwdWjlexh2gIYOIa4nl3F7LGDJLFJuftGzZ8Wd2x8aB+e3ZcL+tozEp2t+/Vasnd+0NfH/QC//XbudRruNZTxTRqIuCBujJpt21Dcgn2jivHn/T5RCTUrJP3LNqJd+gprU0/afmz1+toQM3ejnSchRu/1Mx6b3lj/buTV4358nJZNv/k+KzLEawGxn5ZNUA+bGzT5awFXqCFGw3Rsm5z4bMq4ttFc0hs81795TiUzPOab7NKKgMwwmzjoI93TDVE81iPN77KHIE5ynQh4VfsAmCvEH/BN2kyyNrGcO3vgYBf62juc9P+xlVA0cGP8xTAeP3Q4fbiIPu+yabDwSzF/qzXMAvJ4QvlGmhzTdDb4RytmxA+zgMTqzYXI8CE9v48z3c6bX2jQ++g6CMBCyoIDHhIuHNKWChoGd4JTBEcIpoxIXZ/g68f1DB749bFb85ACpl5EIiYOrQ9w/opaqgraXRoCnZghGGTbGbN6KIPmqQ1xC1Vor64EK1rOMTEsU7Al9YWZa4DClkh1yhp5ylPiV9NDvbl/0GsHHZxDiiHkwDY/SxnqyqdZqN9toWuG+c8JpvxM+2gQg5GF56u3NiBVasb0G9tEg6jXbqSyeGqAdoydxH3xz874bIFpvCxrtiP+ScBUvdjzacLUEyb6EVq3YbRZmB/hqqJ6WuekSE/ChT4qSO5oSHbHdc1DF4JEEvoslLQaPPwiX9yNLT5Hz0kV9npqw0niddaBy9AJETRV9gc4RA3TekZmWEhoRQ0DLbjMBGT/m+22m+9YwL3ptaZ0EMbQvpiWEPYDGuOFFmwbPhJRnmXVE4C/QQNFg4d6Q9X/GUdFT7JTDokk637hUFs1EA5OuePawWaKOchpSSPt5BhYzsfcShwlR95QA/SghGX5iOqgPx+fBJt6ehIhzLvNpJ5J2X/Blo5Z1/CTSPWhCcDIV1a1LIgr0rGnHY5Wow3TUfLB77r0kQiVxvxXutkxC86b4bMUCby88mIdJLHzxK8NuiQn5U5cL57V+XLE4ifQj0nUTNFx0zlye8ghWry8VDX05izFlCSCPZHg4xpmUu63Mcch6m8MdRCxtdLkS8aSuvwwtNoxDVoebw3j8UFCO1+C8Qm7CqD/wdPaEUvqZyf7t8798XxvltfB56eTrOK9h3kwtdzn/aooU5ozoM/kUyEFIUZ0CvzFbOHCSIIzEA1CHdgbFptIdb2GFrhfpcqCDc5ATs5hSe+d8uYEub/Db2y/2yCb0ewIo2EYVCv8af2sHQQ73ZQiGczwtFmoYJS21XeONHQ3N1hO6DbB9J5DwJs4WXKP0Sd1zXoAU8Ued7e2zGtEtp+ZagV/Q/BimEd/P6ncs8K6dYyAqzus4xg4Hwnv83a0Cui1PIZuKCjeYCeooLHRLha2ssImlkTC22UR8w7JQGPomSeRUDrfkrx59emdsMOQfZfFj0MCIJVXLuLR8jljWny1iT7V1ZTHi2ma9fWTrhINajeOTU+140lU1b4CF6x0SuJUVXeh7WQ+OYSJJwMoasK3ZRwNIK7UDoE0cnw+hFnvPCGjoJ4p4elk3eUCqeceIDzTxsI3Uhj1ywV/cXgwVwvgVqDzLNcMw1B2adsME8dsC/My89JmKwfwOkpjb1/DFd3NC+p3B2iBwLm5nhfbWAteOm1IQH2tOWOYCbM9ZTkK/1MI6Od/NgZxsBKag6HYWJN1ORRM9dPEIh4Zr0llXBTtKvdNN1FwUpt7YBO6Js5kdtK9p3N31o85nbvyMW2wvWGe0arV9lmf2kUZlxjyzhXTGlXaOehLmJCZeicD1Ey97GKaNzvKApWTUSZe5WzyiHNT1r1WyXvNIOc8Uz2xVwxpY5wnzFxU1ZPbmWK+B1bSN09frSF5Sa3lFQECBeaPIuS4tvlVbXjQplafWSA1BBX4W5uCbGGSOEYZKe/YFTiIegP664vGem5PvZkpTVkPRV2NGyFoUAX+qTwLikGP6ipG87z9pP9kOT/t7HISkr65AZ0Yx6MBMd+8zG7kwh78supn+SDaUYbd9yQO7MQ7CfDfxyZBfMRyWAi/KaWJaOqT1Bd7xXR7gIBfADabw3kA3IljLK8cKCJhb6JBeK4Ij8yQXKpkQEety6wgcJVQ2oAtrb4D//ad/OYIIZQ5Ir6oATOeQCGBAMXSQTiF49Z8i8OQeJoB+n/+SWI04Bx3iX8oSru+0aBBvvURct5jrayXWYyu1VdqVILxnYW+ZByt8Lb6CcvU/RNb/XrS6UnDmUSu52oKailAxuqEo5JPHCxFHqX5qOpttztJuz8cj2+05MYuZi3VSfIbhfSyJmAdlB8GVSbwIa4fFe2AuYXJTJYyaIgghitwk7kYggtMBEEF3/5gqxbaUb3Y0DOJ91qBTbrAHAtoivCkhUIvOthhTf2pSO+m3CLMQPry2JKNvnLaabtmUrZJJ5IESNtwAr4LlPcIeObFSblpPp9RQm3kPnQbaktFddwH0RMTd+PwAdb/+oQUfGQNnA4a9Zc6UWupEjXd4Su2Mo5t7VzRaYJ7PxK7vSBPa8w11M9rOYdcPLPl6LVwB9tHhjPJI952idXIMBXm0cF7iwVs815fLKNCnhUuHtvPJg5q1iqNks6GDkKDHpe1WhViVBXo4H4cFuu+zs8Aqx7fJhtsm69kVcARiZvJj69791p99Y83DxQWCTOIFZpTNXRd/V3AWAWzmo5cXhygnZwrLtIOAo3SbcupoaV41ZuhOZVtMM3VyT9PoSYR+MMxIflxCUhTOKom1y8UynwZSppIIHT2PS5h8gEkmdzsxeGl4fM0SP7YlbZxjfb/W3evEVox6DQKPG9eiaG/22TBqyApDb7Nhu6V81RBR1ic6mNmkO7ciKkgJmMor5t5P0vWr1LAusIZy8neJoiPsKvSxZgJfUyVZgFiElx3/5/FeRvdHRflMG4Uwn/mkWMigdB9O+j0Vn1hXMlnOV8OAx9vnIwKqVfB9ChqgN+ecOYjVU9kY4eg6kxE8KkJQQbnoPg+j3WLuPY9PkwBr2u7JsNMhiTIkPRkDaHgUyeg0bNFNm3IrGhEs5R5jdS5MMF5eNyJgkhdJFNYnQSQC2OApBd6MXX5IynTbg2OnBAjtb2Q7wGJgHoNY/p4d6pvlc7S1XrNojwp/4Dk/fnoNW43LQF820StU3SIeyijO3TfNpWsq+9FSJbsd65F9km0WKx6zfvvVO7Mj6cM72v7d+HKFCbRGtFNoTsSHvrewhYs/WodLrv7N3YG5AG8+aJ85j+xV4GJ3yt4+v+roLvGm7O7n193dLX08+Tttn2/xcG9rx8LdTTqKr95ZKM0UUF166iity7RR0LrMJ6hyMY/F7Kp4IeFV8abHyjWJE2tdzBesKjFCFm8zuheWV3dio09QZldIWkwJLMA47aGllGfUdOXM0kWUBAEIiAt7XmvYcPliN/n1N/tQHx7R4cgeN+N73Izq0d1hebqJxxhLEGHNqR2sgGtsXruqQOzDqEexdiRo3FcmBY22BUtOD4s2yY6ShRQPWcTM3mbNxhebACyvJVuB4X/nvHj+7Q9tJC8b72o5gvpXmmiHiDYH6tMZX7izRR9HJ88pQNKmGrDMxgCQMzELXK7S5ugNaBPGdnely+Vlu76oF2RKxdbCkL3IYHyhlJcAXVhe40mpaITQ0XXVx0kcdz92AaAegTnGOnZRTqTt2zsPHvpvSPrD5ZFrBboUkQxwjoMOGAkcDtW8XvRVQ7jZ2Gp6sUIZReeniHPQEq90gpfejt5/cv5lH+vyrU4TRhdgS7xWIXfFWrjFsEKYV4QcGjhorMs7LD+gOepuKZJZXMmtBXutUY4FqfcnQpvd1oRgdVxGCUyopNljJGsTr7tvg3jspIdKnVJj2WRjQZRmxwKS0CO94k1cMKmU2MGyiEwKmbXbP2AvGSOLHXhChT475DZVLMN0c0sLlTqW84825jbU2H5SHOYPJUdNueWiIVvY72onM/KTEchFsF8jFjS5iCmF9Hm9jkdNWW0uin1uuWAvlm2fE6fEq7OoW4e3hZ8oPWz1b3J0Xt08GIIOMe3Tu85SJlLtYOy6SSupHZMZkNGkI6dD96Ou/a5rv3qkMFI+A9ICzsYaLbJ7y1QxFVrngVdy9F9REM15tQVtnDvPYsuRk6xlptWV1O5lAciYCHtxdCiOksIpNGGMvRTJZXZXofFP6+NeQnXP1Ai+fKxYPDL5I1fCw6X1icO/MNiLirSw8B3LFoVCB/B3DH/pZ559fPnusnNE3CSKsEOj9IJxpKOFVw21D6rcmt8eNJhFzasNpuljLTfq27MRk6jGAiPeVGeJfkK/fPoMrB6tC6a+J70940whdmufLDqc8zMellLu+nQI7tLGnphaOErYlI7a/ir1VMo8UNR+7z3PB00xXmCt/hPtyNi07iXNneqAnmlP1iZLVmGhbbyqdIUHmlCt8qoaHd5YGhxo/+1iBV64cCJWYNOnYkWhbWQFeUmAHxFLb4qJpIwbDGHbwhu9JNPYh3YK7bDuWlbEU/dEBWSpVB7tq0zd66NMIEOwUH79ylj9VlalJeK5/6emTOG+qxtSWxFSspjlLuHgGtlhWiYGKl7qgPhXci6QWBhqt4vmqWT3nwyYTW9ek4KBM0mSM01To7BwuXQFOQ73It7q7RTZbLdh3pD1R2UKg29gnaStQvuJyq8nSFun1oju9Nic/VjiqiW8Xc6UibenYxK2nh6kMvOoWlHPfKySlbanvlG66I+uMTaIZdTbOZhW910NTHZq5hg0nfMCVKMt9xJ00KFNxajevtZTYT5lOXJmHTUc16R3UvkssbCh7xvJtpeZlzvrKlJXzqufPpEA+fjZ3ih+j15iT4NJb3uANbtmIsqbMnIGhAPKC6AcBuVgbVGXVDDaf2o+pudz02HMDpPuudhs4wXgK8CwthtxsBq0LVoBpTA4nt9AbrW0Wdwm+XllAIjJ5kR7WpOUORuY6wHqnkK25Zn2y2uzCrQ+67U2A0G8GL9TdfZ0JNZZxcUoCH9teMhxPowRG42t2cRJK5HuWi3MgCXTZBG2kEr90FiYS+h2wo1kegkCJWPKPZC/SXwW4a7Y2JRmCchPIyeAUziXwYyTSYQBHbWVCYhA1Et4lnM7gCe/JGCEn54lnyumfiNj9EIGShqbysQkK67RKAnS9SkDbtam8wxUFw9MC632NXO5ODkuB3CPTLVT8+5lQFmL6ALR3g1ykBj3E3o/84VEeIoCr7HRUp3WVGkssXVRiAuknXlGOjZzMkwMU7T6naa1vsDcuC1I+KI22sreKJ7xes3WZTN/G9YruWGGLlTgRYRlWsHUmgMzyI0v0dcek/YBarxMlFlzjQ3jVYElE6W8iLcM5m8D13qySZcm0jBOzab8hIARNZRYDKtFkdApLRhcFGUR0yzccGkTK2BJh6r3DNGkx9tIxjGG4s/NBJwrqmlUV7ogi8GGvl+GyYZXje2mB0j2OoCNsj1NU4TJczAM+rqFT9Ako6ve1q1yqSDucFaXRkj7A0DHpb3wciTHg5NvoZnHPC2Qr2vXY9X/gAWyVPTfrLRsPDoUDNs49bOZYMUeeUjEWEFpHWpLltvv2vTctGkTiT6P1li8zbkw0UuFxijzqWA/d8z3QWtcpyJNQ2tGSxF8BpxSQXbS44PwmZj1+RA+06HpyyFQMVBj9bB0JEhZE6WOn1kZlTXpmZENopUzEKL4aGWGtWzfWCqra4q1BqEHSk9zjNe4hXW28O8C9EQCdLighCFeUAStMbbca4V2RRYHy9RS8avVodH9ld9PNZjgHfs6H6Ixm8B4BYtVEqemXMe75kh17nrRm0y5yh9Sm2ZQo93evH1i/aNLk6JR91dToKeguUNfA7i3Bh09Ob0/pRzrNZZLJedEh/2eVcb0EhOLGtuNOJWb6k+w2s9NNmAAWFc/TJNDSoKC6i5S6ShdLN6socb2plxbGbPmKldqmTq4GdeZVWJKiyJxLLNmL4pST5KZcFWm0WC3+0mmFkDAtRmJoOpiowFtbPFZbdRJWA0USvdz1VdMMuzJ1Jb7+estVRZ0qi+NrQ7nzFPQa8r8aRMQzV7CMYLjfp6qy/0JdRdse4lHmmdv2uAmg6elswrRtczWIYQ+BZOmSrFFRnQ5g0dJT2LTU5AThllVPtUERheXRmsaGbfmKTSqA9kcrBquX2iHpa6hvmSBDGYzV14CmMMOw5iZBqpr/crAMTXf8ah9fI1lVILYP1zTanv29uPPzQzyhYpLh5N34RqvutgCmy+vhgqjEvPQSj8z8zAz/BorYeXJ6TlzgA8ZuSOoIl6fmZ4PuEFQx1OP0VbwiEXuVrjMX2pWLeclGotu48wSS2Eb7SkrUVGQE1r2XbUF+Y9nl9rPk1u5Rdabb80htxI/x/EtvY3i6UjS7P6MorgorbxmA7e6Ikdx6hHEZjOn7ALVyqMRs2NH5Q3nRfEnc8u6pvZaQ3TMf+j602ZRPK3MobL2VCHz7EkyKCNYdrqibJ3GkdhsOB7/YlQdsrFVgj5wPvxbRssnQDcB7SDcuXiHT13oP/GMSohHtLKzK8YZoG+o8Q90hiWWbeavvuCHimLQ4Rq8VXnwjFLLRrfLCRLPqHoo/qbsM1m410roQ3lpLasRdDRVRT01IdBvLiCOJaXtTO9stkUTesMVErFAhYziLlm5dtjxAhm3CL8pFVdg2BJ7ng3X/lGpN4o1szNGWvk1LHUGyxzPZnPMwh4jRy8J+INwY7robGaqs1aMWYCZnXRWwVxh0YvSlMqVfy/kcekyP/rSLVY4/j1LZuosGTU2m3BeXuRqoWOSNWseRdrbp6/XBDUB08JWNKk8XHxctURdmoI1g9gk5HmyLnMGvLl5n9aAlQGl+SO3dYYckj+ecMrD5vnRepN7TAU9pC/cw6KpCOfCUmpWxFj/8YPRLz/1rEXbwhTTRKkaeuFgNJa/NuVHrFcyn/xq5F7XHxeKZGewCS5oVVW8hRMnmrCJkBTuiSjXa+mFRXg+nxwINjoIhfI5D0/BkrThYWjiKctNF8Dodgdh+VXuVmL6EdLNDkLigRk3OQ6Prh60o3DexN8o54Fj8C3wxT0el9anW2J9Kh3NUhbRrSgg+ZTcmbN0eG+nANtUi1R4escOxohtKKMZ3AdyH0xOXU5Y4djIVl/VR8WXfQ/P45POBlKQ4x0v8CAwwCCqi+iIlbSjwWK38v7Jb5bo4hXWSjUqmt7qFo11jhM1Xb9pOfNrPRQ9EPgwOv60VXhxLHS7ZQB2DhwCd4mwZTBhNVyTiIiNO7rxK7zoGbF8fAnaM4siWDt0rtJLAo/Bs3bhINR9Gj6baBkVb3LSPlvdSUv/p9zgqYfCINFZBqFiNJvbMJENPT1LqFmvmyUYBp9sKyv0r9vt7p/WlxpSRv74qr3o8yZ9iO0JhZa39iL3ZF5MO3MKbhVqvDhpthIEK9nlt89ffHuN5k8KoQ0ers8TKCQGn1GwDUTtSo7oRBj224E2k088qsiuya6nKJoIaZ0X3Zs6w6ZFGR6FbapOaGGTkMxbHlWT/zMd/walqLQxtfV5dHfpXjigy2R1PJXQyPUwIpdU6Nbap6XOaa1DipTEbBemHVK1XKOLUR22hamUlEIh57jee9AnYeyrK62j4j8g/ZOwXqUtq1b+lbtLV3pH8enTm7+/+u+3WDzL43lpMoMQj01h4cX69RmlSwz1Sf9xJdPMisuqjdWqddlHrrvYGJ7c16l/wXHldfOq2uXzhh1nAzsSLPv3X0qKrEXQa14ITINbUMTlqF4LWWeUWNd/YNruuhvh9k2vCErnWePpgl6+33PnWmgPZENksfNes9PhSq+j6kDWfPnbOM9l2m3XxY1Nt/eN69XEnwp91vULicCOMX0xIVW3onIlR4v34/yK+kKLJsOs+WIMs3AWTe83XYhhL1LLdsI/jESAzx3TOVbc8RfCXpSz9nF2ndDtX14sni9eLG7R6/Hi+fPbu+evf/zh7uWPf3t998N3f/r+7u52mGr7FnHgfWHM8yJTa1Rkxfzg95sPD99iZ/Df77OH+tCG9fj66t8ZfS9ejIGPXXVgimDHifkMGP6RgEzMcUPdWVhuCOjPc3RNjrlZ9c/fX7+4vb2+vf3z9Z++XwT7hfkGNIHavewdmD98/ohBXujMuulH6ZigBxXVVLnClEl45EFgObMHHiluu2TPl/K+MWBWYQOPfW+JOZtLGYy7aXYs+XQnz3rNXRMoDa+1C82TpAk/45/fvr5MVXzDCxw0nWGK9etAW6vR77MV90u3nV1RA9jaf92S6XmxlnKxYtFiI32wFhYy2iwukL8XxQ8WNrw68wna8HjMo50I0jtfsHksB8pN1WFYMFgM2PNgtFwZHjLHIItrZYbohW0ch3c3N2Gy8kE7T9Zr8ZVw9J7LS7ozdDqX4t+wOfPQKiVTl5fKxoRmoJlujjmo0YG4+aK46a+YawVgLpY5/TV/7SimvhXup8KNcE6p6VYcYOGO5ATaxgml0xzDDyofNHhK2N8a3vHYq9fw+r3lgKlQ1oGbw/Of6HtHTR+dx4tyMQc01Z9FHpM3DoKjNOh6SdLR9dxf0jwOAq1Rq6E+iVaz3FQKHVesGoERD5vRFWv7A08tSQITYsm6IOWn8WbdKcdFX607dmw6Kjo1M2SCq0PelQ+GF03J1OFzlZfbzl0zWTFSk4dL6anaoRbqa+DwLppXMgK1OKTCa7FM603hRSDQ+A1KzBt46QastRsRPnx7A8OCR3nwEhp72f/mND978d+jK7F3j25fB5CMwi1rz/NuHukBiVgv9Vo3g2S6xfwzN0yHtpm/7bWLG2TI1ASk8qSb7/3kygnwIbQ2OVNzmCvUCITacu/0APM4WKHbQdx0fan4cs9EfE60FYQoI5Y5kqU1IFQR62I3D9gZkD6o1SFYKh48OugUR1/MYKo/zAEz4uiDeS0CGpPbxwadARmC+sVcUL/ogxpDkEvm3j826BRHH8woa86yg3SLPLxO2oI4M9K8SdXXn1//RtRXJOQR1VcYlhmqr+2j21d9Pbfy14S65X+y1RFWLrEY7CX4opv4Uq5mYI4z4KWXeqrop4wv4chQmynMvtipvqGBdPmkr1a+FkGYxMv0oZ3wQQ+0pg/0SOh8/ymllW52yJuqp0uhH0idIlnqrdxsuHed3X3OlcJC9hUHchuPG9xpo9Nc80NYBow9w4ezeLp+XwbF0IgvNwIlV7WLlvNeR9L8+keMNVAmo75lrQcHLEHYI1Hg62nPxdnQMAC2XJFjxiCbfH1TU8rhCSuSlZSwJIKhSPA1WJSecLVkYmlkqJUjx6S42UckrfxaSXxrweDKqWdFYTS0gPYsveRp78zj0VQ51Rj9wYssP/STCXqMlgNDrp1b6MtSWNDEpPOSqRVA+f/8H2QOQO8="
 }
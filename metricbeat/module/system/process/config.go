@@ -24,14 +24,16 @@ import (
 
 // Config stores the system/process config options
 type Config struct {
-	Procs           []string                 `config:"processes"`
-	Cgroups         *bool                    `config:"process.cgroups.enabled"`
-	EnvWhitelist    []string                 `config:"process.env.whitelist"`
-	CacheCmdLine    bool                     `config:"process.cmdline.cache.enabled"`
-	IncludeTop      process.IncludeTopConfig `config:"process.include_top_n"`
-	IncludeCPUTicks bool                     `config:"process.include_cpu_ticks"`
-	IncludePerCPU   bool                     `config:"process.include_per_cpu"`
-	CPUTicks        *bool                    `config:"cpu_ticks"` // Deprecated
+	Procs              []string                 `config:"processes"`
+	Cgroups            *bool                    `config:"process.cgroups.enabled"`
+	EnvWhitelist       []string                 `config:"process.env.whitelist"`
+	CacheCmdLine       bool                     `config:"process.cmdline.cache.enabled"`
+	NormalizeCmdLine   bool                     `config:"process.cmdline.normalize"`
+	IncludeTop         process.IncludeTopConfig `config:"process.include_top_n"`
+	IncludeCPUTicks    bool                     `config:"process.include_cpu_ticks"`
+	IncludePerCPU      bool                     `config:"process.include_per_cpu"`
+	IncludeContainerID bool                     `config:"process.include_container_id"`
+	CPUTicks           *bool                    `config:"cpu_ticks"` // Deprecated
 }
 
 // Validate checks for depricated config options
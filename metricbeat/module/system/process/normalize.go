@@ -0,0 +1,84 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package process
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/elastic/gosigar/cgroup"
+)
+
+// containerIDRegexp matches the container IDs found in the cgroup paths of
+// processes running in Docker, containerd or CRI-O containers.
+var containerIDRegexp = regexp.MustCompile(`[0-9a-f]{64}`)
+
+// normalizeCmdline returns a representation of the command line of a process
+// that is stable between instances of the same program: the base name of the
+// executable, the flags without their values and the base names of the other
+// arguments, ignoring the ones containing digits, as these are usually ids,
+// ports, or temporary paths.
+func normalizeCmdline(name string, args []string) string {
+	if len(args) == 0 {
+		return name
+	}
+
+	normalized := []string{filepath.Base(args[0])}
+	for _, arg := range args[1:] {
+		if strings.HasPrefix(arg, "-") {
+			if i := strings.Index(arg, "="); i > 0 {
+				arg = arg[:i]
+			}
+			normalized = append(normalized, arg)
+			continue
+		}
+
+		arg = filepath.Base(arg)
+		if arg == "" || arg == "." || arg == "/" || strings.IndexFunc(arg, unicode.IsDigit) >= 0 {
+			continue
+		}
+		normalized = append(normalized, arg)
+	}
+	return strings.Join(normalized, " ")
+}
+
+// getContainerID returns the ID of the container the process runs in,
+// obtained from its cgroups, or an empty string if it doesn't run in a
+// container.
+func getContainerID(hostfs string, pid int) (string, error) {
+	paths, err := cgroup.ProcessCgroupPaths(hostfs, pid)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range paths {
+		if id := containerIDFromCgroupPath(path); id != "" {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+func containerIDFromCgroupPath(path string) string {
+	ids := containerIDRegexp.FindAllString(path, -1)
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[len(ids)-1]
+}
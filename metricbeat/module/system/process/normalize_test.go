@@ -0,0 +1,50 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package process
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeCmdline(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected string
+	}{
+		{"kthreadd", nil, "kthreadd"},
+		{"nginx", []string{"nginx: worker process"}, "nginx: worker process"},
+		{"java", []string{"/usr/bin/java", "-Xmx4g", "-Dport=8080", "-jar", "/opt/app/app.jar"}, "java -Xmx4g -Dport -jar app.jar"},
+		{"python3", []string{"/usr/bin/python3", "/srv/worker.py", "--id", "42", "--tmp=/tmp/job-1234"}, "python3 worker.py --id --tmp"},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, normalizeCmdline(test.name, test.args))
+	}
+}
+
+func TestContainerIDFromCgroupPath(t *testing.T) {
+	id := "b29faf21b7eff959f64b4192c34d5d67a707fe8561e9eaa608cb27693fba4242"
+
+	assert.Equal(t, id, containerIDFromCgroupPath("/docker/"+id))
+	assert.Equal(t, id, containerIDFromCgroupPath("/kubepods/burstable/pod1a2b/"+id))
+	assert.Equal(t, id, containerIDFromCgroupPath("/system.slice/docker-"+id+".scope"))
+	assert.Equal(t, "", containerIDFromCgroupPath("/user.slice/user-1000.slice/session-2.scope"))
+}
@@ -46,9 +46,13 @@ func init() {
 // MetricSet that fetches process metrics.
 type MetricSet struct {
 	mb.BaseMetricSet
-	stats  *process.Stats
-	cgroup *cgroup.Reader
-	perCPU bool
+	stats     *process.Stats
+	cgroup    *cgroup.Reader
+	perCPU    bool
+	normalize bool
+
+	// hostfs is set on Linux if container IDs are included
+	hostfs string
 }
 
 // New creates and returns a new MetricSet.
@@ -67,7 +71,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 			CacheCmdLine: config.CacheCmdLine,
 			IncludeTop:   config.IncludeTop,
 		},
-		perCPU: config.IncludePerCPU,
+		perCPU:    config.IncludePerCPU,
+		normalize: config.NormalizeCmdLine,
 	}
 	err := m.stats.Init()
 	if err != nil {
@@ -80,6 +85,13 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 			return nil, fmt.Errorf("unexpected module type")
 		}
 
+		if config.IncludeContainerID {
+			m.hostfs = systemModule.HostFS
+			if m.hostfs == "" {
+				m.hostfs = "/"
+			}
+		}
+
 		if config.Cgroups == nil || *config.Cgroups {
 			debugf("process cgroup data collection is enabled, using hostfs='%v'", systemModule.HostFS)
 			m.cgroup, err = cgroup.NewReader(systemModule.HostFS, true)
@@ -144,10 +156,29 @@ func (m *MetricSet) Fetch(r mb.ReporterV2) error {
 			rootFields.Put("process.executable", exe)
 		}
 
-		if args := getAndRemove(proc, "args"); args != nil {
+		args := getAndRemove(proc, "args")
+		if args != nil {
 			rootFields.Put("process.args", args)
 		}
 
+		if m.normalize {
+			name, _ := rootFields.GetValue("process.name")
+			argv, _ := args.([]string)
+			proc["cmdline_normalized"] = normalizeCmdline(fmt.Sprint(name), argv)
+		}
+
+		if m.hostfs != "" {
+			pid, _ := rootFields.GetValue("process.pid")
+			if pid, ok := pid.(int); ok {
+				id, err := getContainerID(m.hostfs, pid)
+				if err != nil {
+					debugf("error getting container id for pid=%d, %v", pid, err)
+				} else if id != "" {
+					rootFields.Put("container.id", id)
+				}
+			}
+		}
+
 		e := mb.Event{
 			RootFields:      rootFields,
 			MetricSetFields: proc,
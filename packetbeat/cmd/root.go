@@ -27,6 +27,7 @@ import (
 
 	cmd "github.com/elastic/beats/v7/libbeat/cmd"
 	"github.com/elastic/beats/v7/libbeat/cmd/instance"
+	"github.com/elastic/beats/v7/libbeat/preflight"
 	"github.com/elastic/beats/v7/packetbeat/beater"
 )
 
@@ -48,6 +49,9 @@ func init() {
 		RunFlags:      runFlags,
 		Name:          Name,
 		HasDashboards: true,
+		Preflight: []preflight.Check{
+			preflight.CapabilitiesCheck("net_raw", "net_admin"),
+		},
 	}
 	RootCmd = cmd.GenRootCmdWithSettings(beater.New, settings)
 	RootCmd.AddCommand(genDevicesCommand())
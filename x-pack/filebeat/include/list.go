@@ -29,6 +29,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/googlecloud"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/ibmmq"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/iptables"
+	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/microsoft"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/misp"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/mssql"
 	_ "github.com/elastic/beats/v7/x-pack/filebeat/module/netflow"
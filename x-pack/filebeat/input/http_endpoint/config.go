@@ -7,6 +7,7 @@ package http_endpoint
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 )
@@ -23,8 +24,18 @@ type config struct {
 	ListenPort    string                  `config:"listen_port"`
 	URL           string                  `config:"url"`
 	Prefix        string                  `config:"prefix"`
+	Mode          string                  `config:"mode"`
 }
 
+const (
+	// modeGeneric publishes each request body as an event under prefix.
+	modeGeneric = "generic"
+
+	// modeKubernetesAudit receives the batches of audit events sent by the
+	// webhook backend of the Kubernetes API server.
+	modeKubernetesAudit = "kubernetes_audit"
+)
+
 func defaultConfig() config {
 	return config{
 		BasicAuth:     false,
@@ -36,6 +47,7 @@ func defaultConfig() config {
 		ListenPort:    "8000",
 		URL:           "/",
 		Prefix:        "json",
+		Mode:          modeGeneric,
 	}
 }
 
@@ -44,5 +56,11 @@ func (c *config) Validate() error {
 		return errors.New("response_body must be valid JSON")
 	}
 
+	switch c.Mode {
+	case modeGeneric, modeKubernetesAudit:
+	default:
+		return fmt.Errorf("invalid mode '%s', it must be one of '%s' or '%s'", c.Mode, modeGeneric, modeKubernetesAudit)
+	}
+
 	return nil
 }
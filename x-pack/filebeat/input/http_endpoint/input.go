@@ -145,9 +145,33 @@ func (in *HttpEndpoint) sendEvent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// If middleware validation successed, an event is sent for each audit event
+// of the batch
+func (in *HttpEndpoint) sendAuditEvents(w http.ResponseWriter, r *http.Request) bool {
+	events, err := auditEvents(*in.eventObject)
+	if err != nil {
+		in.sendResponse(w, http.StatusBadRequest, in.createErrorMessage(err.Error()))
+		return false
+	}
+
+	for _, event := range events {
+		if !in.outlet.OnEvent(event) {
+			in.sendResponse(w, http.StatusInternalServerError, in.createErrorMessage("Unable to send event"))
+			return false
+		}
+	}
+	return true
+}
+
 // Triggers if middleware validation returns successful
 func (in *HttpEndpoint) apiResponse(w http.ResponseWriter, r *http.Request) {
-	in.sendEvent(w, r)
+	if in.config.Mode == modeKubernetesAudit {
+		if !in.sendAuditEvents(w, r) {
+			return
+		}
+	} else {
+		in.sendEvent(w, r)
+	}
 	w.Header().Add("Content-Type", "application/json")
 	in.sendResponse(w, uint(in.config.ResponseCode), in.config.ResponseBody)
 }
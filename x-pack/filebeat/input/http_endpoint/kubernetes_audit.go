@@ -0,0 +1,118 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const (
+	auditEventList  = "EventList"
+	auditAPIVersion = "audit.k8s.io/"
+)
+
+// auditEvents maps the audit events of an EventList sent by the Kubernetes API
+// server webhook backend into events. The original audit event is kept under
+// kubernetes.audit, and its main fields are copied into ECS fields.
+func auditEvents(body map[string]interface{}) ([]beat.Event, error) {
+	kind, _ := body["kind"].(string)
+	apiVersion, _ := body["apiVersion"].(string)
+	if kind != auditEventList || !strings.HasPrefix(apiVersion, auditAPIVersion) {
+		return nil, errors.New("Body must be an audit.k8s.io EventList")
+	}
+
+	items, _ := body["items"].([]interface{})
+	events := make([]beat.Event, 0, len(items))
+	for _, item := range items {
+		audit, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, errors.New("Audit events must be JSON objects")
+		}
+		events = append(events, auditEvent(audit))
+	}
+	return events, nil
+}
+
+func auditEvent(audit map[string]interface{}) beat.Event {
+	fields := common.MapStr{
+		"kubernetes": common.MapStr{
+			"audit": audit,
+		},
+		"event": common.MapStr{
+			"kind": "event",
+		},
+	}
+
+	received, hasReceived := auditTimestamp(audit, "requestReceivedTimestamp")
+	stage, hasStage := auditTimestamp(audit, "stageTimestamp")
+	if hasReceived {
+		fields.Put("event.start", received)
+	}
+	if hasStage {
+		fields.Put("event.end", stage)
+	}
+
+	if id, ok := audit["auditID"].(string); ok {
+		fields.Put("event.id", id)
+	}
+	if verb, ok := audit["verb"].(string); ok {
+		fields.Put("event.action", verb)
+	}
+	if uri, ok := audit["requestURI"].(string); ok {
+		fields.Put("url.original", uri)
+	}
+	if userAgent, ok := audit["userAgent"].(string); ok {
+		fields.Put("user_agent.original", userAgent)
+	}
+	if user, ok := audit["user"].(map[string]interface{}); ok {
+		if name, ok := user["username"].(string); ok {
+			fields.Put("user.name", name)
+		}
+	}
+	if ips, ok := audit["sourceIPs"].([]interface{}); ok && len(ips) > 0 {
+		if ip, ok := ips[0].(string); ok {
+			fields.Put("source.ip", ip)
+		}
+	}
+	if status, ok := audit["responseStatus"].(map[string]interface{}); ok {
+		if code, ok := status["code"].(float64); ok {
+			fields.Put("http.response.status_code", int(code))
+			if code < 400 {
+				fields.Put("event.outcome", "success")
+			} else {
+				fields.Put("event.outcome", "failure")
+			}
+		}
+	}
+
+	timestamp := time.Now().UTC()
+	if hasStage {
+		timestamp = stage
+	} else if hasReceived {
+		timestamp = received
+	}
+
+	return beat.Event{
+		Timestamp: timestamp,
+		Fields:    fields,
+	}
+}
+
+func auditTimestamp(audit map[string]interface{}, key string) (time.Time, bool) {
+	value, ok := audit[key].(string)
+	if !ok {
+		return time.Time{}, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts.UTC(), true
+}
@@ -0,0 +1,90 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package http_endpoint
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+const auditEventListBody = `{
+  "kind": "EventList",
+  "apiVersion": "audit.k8s.io/v1",
+  "items": [
+    {
+      "level": "Metadata",
+      "auditID": "8c7a8b52-6c7f-4a0b-9d3e-1c1b2e5c2d11",
+      "stage": "ResponseComplete",
+      "requestURI": "/api/v1/namespaces/default/pods",
+      "verb": "list",
+      "user": {"username": "system:admin", "groups": ["system:masters"]},
+      "sourceIPs": ["10.0.0.1"],
+      "userAgent": "kubectl/v1.18.0",
+      "responseStatus": {"metadata": {}, "code": 200},
+      "requestReceivedTimestamp": "2020-06-01T10:00:00.000000Z",
+      "stageTimestamp": "2020-06-01T10:00:00.500000Z"
+    },
+    {
+      "level": "Metadata",
+      "auditID": "0d1e2f3a-4b5c-6d7e-8f9a-0b1c2d3e4f5a",
+      "stage": "ResponseComplete",
+      "verb": "delete",
+      "responseStatus": {"metadata": {}, "code": 403}
+    }
+  ]
+}`
+
+func TestAuditEvents(t *testing.T) {
+	var body map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(auditEventListBody), &body))
+
+	events, err := auditEvents(body)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, time.Date(2020, 6, 1, 10, 0, 0, 500000000, time.UTC), events[0].Timestamp)
+	for field, expected := range map[string]interface{}{
+		"event.kind":                "event",
+		"event.id":                  "8c7a8b52-6c7f-4a0b-9d3e-1c1b2e5c2d11",
+		"event.action":              "list",
+		"event.outcome":             "success",
+		"event.start":               time.Date(2020, 6, 1, 10, 0, 0, 0, time.UTC),
+		"url.original":              "/api/v1/namespaces/default/pods",
+		"user.name":                 "system:admin",
+		"user_agent.original":       "kubectl/v1.18.0",
+		"source.ip":                 "10.0.0.1",
+		"http.response.status_code": 200,
+		"kubernetes.audit.stage":    "ResponseComplete",
+	} {
+		value, err := events[0].Fields.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, expected, value, field)
+		}
+	}
+
+	outcome, err := events[1].Fields.GetValue("event.outcome")
+	assert.NoError(t, err)
+	assert.Equal(t, "failure", outcome)
+	assert.False(t, events[1].Timestamp.IsZero())
+	_, err = events[1].Fields.GetValue("user.name")
+	assert.Equal(t, common.ErrKeyNotFound, err)
+}
+
+func TestAuditEventsInvalidBody(t *testing.T) {
+	for name, body := range map[string]map[string]interface{}{
+		"not an event list": {"kind": "Event", "apiVersion": "audit.k8s.io/v1"},
+		"other api":         {"kind": "EventList", "apiVersion": "v1"},
+		"invalid items":     {"kind": "EventList", "apiVersion": "audit.k8s.io/v1", "items": []interface{}{"foo"}},
+	} {
+		_, err := auditEvents(body)
+		assert.Error(t, err, name)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/elastic/beats/v7/filebeat/harvester"
 	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+	"github.com/elastic/beats/v7/x-pack/libbeat/reader/parquet"
 )
 
 type config struct {
@@ -19,6 +20,14 @@ type config struct {
 	AwsConfig                 awscommon.ConfigAWS `config:",inline"`
 	ExpandEventListFromField  string              `config:"expand_event_list_from_field"`
 	APITimeout                time.Duration       `config:"api_timeout"`
+	BucketRoles               []bucketRole        `config:"bucket_roles"`
+	Parquet                   parquet.Config      `config:"parquet"`
+}
+
+// bucketRole is an IAM role assumed to get the objects of a bucket
+type bucketRole struct {
+	Bucket                     string `config:"bucket" validate:"required"`
+	awscommon.AssumeRoleConfig `config:",inline"`
 }
 
 func defaultConfig() config {
@@ -28,6 +37,7 @@ func defaultConfig() config {
 		},
 		VisibilityTimeout: 300 * time.Second,
 		APITimeout:        120 * time.Second,
+		Parquet:           parquet.DefaultConfig(),
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"strings"
@@ -34,6 +35,7 @@ import (
 	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+	"github.com/elastic/beats/v7/x-pack/libbeat/reader/parquet"
 )
 
 const inputName = "s3"
@@ -70,6 +72,7 @@ type s3Input struct {
 	context    *channelContext
 	workerWg   sync.WaitGroup // Waits on s3 worker goroutine.
 	stopOnce   sync.Once
+	bucketS3   map[string]s3iface.ClientAPI // S3 clients of buckets with a role of their own.
 }
 
 type s3Info struct {
@@ -100,6 +103,12 @@ type sqsMessage struct {
 		EventName   string         `json:"eventName"`
 		S3          s3BucketObject `json:"s3"`
 	} `json:"Records"`
+
+	// S3 event notifications delivered through Amazon EventBridge
+	Source     string         `json:"source"`
+	DetailType string         `json:"detail-type"`
+	Region     string         `json:"region"`
+	Detail     s3BucketObject `json:"detail"`
 }
 
 type s3Context struct {
@@ -187,6 +196,12 @@ func (p *s3Input) Run() {
 		svcSQS := sqs.New(awscommon.EnrichAWSConfigWithEndpoint(p.config.AwsConfig.Endpoint, "sqs", regionName, awsConfig))
 		svcS3 := s3.New(awscommon.EnrichAWSConfigWithEndpoint(p.config.AwsConfig.Endpoint, "s3", regionName, awsConfig))
 
+		p.bucketS3 = make(map[string]s3iface.ClientAPI, len(p.config.BucketRoles))
+		for _, role := range p.config.BucketRoles {
+			roleConfig := awscommon.AssumeRoleChain(awsConfig, []awscommon.AssumeRoleConfig{role.AssumeRoleConfig})
+			p.bucketS3[role.Bucket] = s3.New(awscommon.EnrichAWSConfigWithEndpoint(p.config.AwsConfig.Endpoint, "s3", regionName, roleConfig))
+		}
+
 		p.workerWg.Add(1)
 		go p.run(svcSQS, svcS3, visibilityTimeout)
 		p.workerWg.Done()
@@ -359,6 +374,10 @@ func handleSQSMessage(m sqs.Message) ([]s3Info, error) {
 		return nil, errors.Wrap(err, "json unmarshal sqs message body failed")
 	}
 
+	if msg.Source == "aws.s3" {
+		return handleEventBridgeMessage(msg)
+	}
+
 	var s3Infos []s3Info
 	for _, record := range msg.Records {
 		if record.EventSource == "aws:s3" && strings.HasPrefix(record.EventName, "ObjectCreated:") {
@@ -381,6 +400,31 @@ func handleSQSMessage(m sqs.Message) ([]s3Info, error) {
 	return s3Infos, nil
 }
 
+func handleEventBridgeMessage(msg sqsMessage) ([]s3Info, error) {
+	if msg.DetailType != "Object Created" {
+		return nil, errors.New("this SQS queue should be dedicated to s3 Object Created event notifications")
+	}
+
+	// Unescape substrings from s3 log name. For example, convert "%3D" back to "="
+	filename, err := url.QueryUnescape(msg.Detail.object.Key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "url.QueryUnescape failed for '%s'", msg.Detail.object.Key)
+	}
+
+	// EventBridge events don't include the bucket ARN
+	arn := msg.Detail.bucket.Arn
+	if arn == "" {
+		arn = "arn:aws:s3:::" + msg.Detail.bucket.Name
+	}
+
+	return []s3Info{{
+		region: msg.Region,
+		name:   msg.Detail.bucket.Name,
+		key:    filename,
+		arn:    arn,
+	}}, nil
+}
+
 func (p *s3Input) handleS3Objects(svc s3iface.ClientAPI, s3Infos []s3Info, errC chan error) error {
 	s3Ctx := &s3Context{
 		refs: 1,
@@ -390,7 +434,7 @@ func (p *s3Input) handleS3Objects(svc s3iface.ClientAPI, s3Infos []s3Info, errC
 
 	for _, info := range s3Infos {
 		p.logger.Debugf("Processing file from s3 bucket \"%s\" with name \"%s\"", info.name, info.key)
-		err := p.createEventsFromS3Info(svc, info, s3Ctx)
+		err := p.createEventsFromS3Info(p.bucketClient(info.name, svc), info, s3Ctx)
 		if err != nil {
 			err = errors.Wrapf(err, "createEventsFromS3Info failed processing file from s3 bucket \"%s\" with name \"%s\"", info.name, info.key)
 			p.logger.Error(err)
@@ -400,6 +444,15 @@ func (p *s3Input) handleS3Objects(svc s3iface.ClientAPI, s3Infos []s3Info, errC
 	return nil
 }
 
+// bucketClient returns the S3 client to get the objects of a bucket, buckets
+// configured in bucket_roles use a client assuming their role.
+func (p *s3Input) bucketClient(bucket string, svc s3iface.ClientAPI) s3iface.ClientAPI {
+	if bucketSvc, found := p.bucketS3[bucket]; found {
+		return bucketSvc
+	}
+	return svc
+}
+
 func (p *s3Input) createEventsFromS3Info(svc s3iface.ClientAPI, info s3Info, s3Ctx *s3Context) error {
 	objectHash := s3ObjectHash(info)
 
@@ -455,6 +508,26 @@ func (p *s3Input) createEventsFromS3Info(svc s3iface.ClientAPI, info s3Info, s3C
 		gzipReader.Close()
 	}
 
+	// Decode parquet files when parquet decoding is enabled
+	if p.config.Parquet.Enabled {
+		isS3ObjParquet, err := isStreamParquet(reader)
+		if err != nil {
+			err = errors.Wrap(err, "could not determine if S3 object is a parquet file")
+			p.logger.Error(err)
+			return err
+		}
+
+		if isS3ObjParquet {
+			err := p.decodeParquet(reader, objectHash, info, s3Ctx)
+			if err != nil {
+				err = errors.Wrapf(err, "decodeParquet failed for '%s' from S3 bucket '%s'", info.key, info.name)
+				p.logger.Error(err)
+				return err
+			}
+			return nil
+		}
+	}
+
 	// Check if expand_event_list_from_field is given with document content-type = "application/json"
 	if resp.ContentType != nil && *resp.ContentType == "application/json" && p.config.ExpandEventListFromField == "" {
 		err := errors.New("expand_event_list_from_field parameter is missing in config for application/json content-type file")
@@ -564,6 +637,31 @@ func (p *s3Input) decodeJSONWithKey(decoder *json.Decoder, objectHash string, s3
 	}
 }
 
+// decodeParquet creates an event per row of a parquet file. Parquet files
+// can only be decoded from their footer, so they are read in memory.
+func (p *s3Input) decodeParquet(reader io.Reader, objectHash string, s3Info s3Info, s3Ctx *s3Context) error {
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return errors.Wrap(err, "reading parquet file failed")
+	}
+
+	return p.config.Parquet.Decode(data, time.Now(), func(row int64, fields common.MapStr, ts time.Time) error {
+		// The offset of the events of parquet files is their row
+		event := createEvent("", int(row), s3Info, objectHash, s3Ctx)
+		event.Fields.Delete("message")
+		event.Fields.DeepUpdate(fields)
+		if !ts.IsZero() {
+			event.Timestamp = ts
+		}
+
+		err := p.forwardEvent(event)
+		if err != nil {
+			return errors.Wrap(err, "forwardEvent failed")
+		}
+		return nil
+	})
+}
+
 func (p *s3Input) convertJSONToEvent(jsonFields interface{}, offset int, objectHash string, s3Info s3Info, s3Ctx *s3Context) error {
 	vJSON, err := json.Marshal(jsonFields)
 	log := string(vJSON)
@@ -694,3 +792,13 @@ func isStreamGzipped(r *bufio.Reader) (bool, error) {
 		return false, nil
 	}
 }
+
+// isStreamParquet determines whether the given stream of bytes (encapsulated in a buffered reader)
+// is a parquet file or not, without consuming it.
+func isStreamParquet(r *bufio.Reader) (bool, error) {
+	buf, err := r.Peek(4)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	return parquet.IsParquet(buf), nil
+}
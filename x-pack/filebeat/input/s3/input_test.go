@@ -14,6 +14,7 @@ import (
 	"io/ioutil"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/x-pack/libbeat/reader/parquet"
 )
 
 // MockS3Client struct is used for unit tests.
@@ -116,6 +118,18 @@ func TestHandleMessage(t *testing.T) {
 				},
 			},
 		},
+		{
+			"sqs message with s3 Object Created event from EventBridge",
+			sqs.Message{
+				Body: awssdk.String("{\"version\":\"0\",\"id\":\"17793124-05d4-b198-2fde-7ededc63b103\",\"detail-type\":\"Object Created\",\"source\":\"aws.s3\",\"account\":\"123456789012\",\"time\":\"2021-11-12T00:00:00Z\",\"region\":\"ca-central-1\",\"resources\":[\"arn:aws:s3:::test-s3-ks-2\"],\"detail\":{\"version\":\"0\",\"bucket\":{\"name\":\"test-s3-ks-2\"},\"object\":{\"key\":\"year%3D2020/month%3D05/test1.txt\",\"size\":5}}}"),
+			},
+			[]s3Info{
+				{
+					name: "test-s3-ks-2",
+					key:  "year=2020/month=05/test1.txt",
+				},
+			},
+		},
 	}
 
 	for _, c := range casesPositive {
@@ -149,6 +163,13 @@ func TestHandleMessage(t *testing.T) {
 			},
 			[]s3Info{},
 		},
+		{
+			"sqs message with s3 Object Deleted event from EventBridge",
+			sqs.Message{
+				Body: awssdk.String("{\"version\":\"0\",\"detail-type\":\"Object Deleted\",\"source\":\"aws.s3\",\"region\":\"ca-central-1\",\"detail\":{\"bucket\":{\"name\":\"test-s3-ks-2\"},\"object\":{\"key\":\"test1.txt\"}}}"),
+			},
+			[]s3Info{},
+		},
 	}
 
 	for _, c := range casesNegative {
@@ -373,3 +394,59 @@ May 28 03:03:29 Shaunaks-MacBook-Pro-Work VTDecoderXPCService[57953]: DEPRECATED
 		})
 	}
 }
+
+type mockOutlet struct {
+	events []beat.Event
+}
+
+func (o *mockOutlet) Close() error              { return nil }
+func (o *mockOutlet) Done() <-chan struct{}     { return nil }
+func (o *mockOutlet) OnEvent(e beat.Event) bool { o.events = append(o.events, e); return true }
+
+func TestDecodeParquet(t *testing.T) {
+	data, err := ioutil.ReadFile("../../../libbeat/reader/parquet/testdata/flow_logs.parquet")
+	require.NoError(t, err)
+
+	r := bufio.NewReader(bytes.NewReader(data))
+	isParquet, err := isStreamParquet(r)
+	require.NoError(t, err)
+	require.True(t, isParquet)
+
+	outlet := &mockOutlet{}
+	p := &s3Input{outlet: outlet, config: defaultConfig()}
+	p.config.Parquet.Enabled = true
+	p.config.Parquet.TimeColumn = "start"
+	p.config.Parquet.Columns = []parquet.ColumnConfig{{Column: "srcaddr", Field: "source.ip"}}
+
+	s3Ctx := &s3Context{}
+	err = p.decodeParquet(r, s3ObjectHash(info), info, s3Ctx)
+	require.NoError(t, err)
+	require.Len(t, outlet.events, 5)
+	assert.Equal(t, 5, s3Ctx.refs)
+
+	event := outlet.events[3]
+	assert.Equal(t, time.Date(2020, 9, 13, 13, 26, 40, 0, time.UTC), event.Timestamp)
+
+	sourceIP, err := event.Fields.GetValue("source.ip")
+	assert.NoError(t, err)
+	assert.Equal(t, "10.0.0.4", sourceIP)
+
+	packets, err := event.Fields.GetValue("parquet.packets")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), packets)
+
+	offset, err := event.Fields.GetValue("log.offset")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), offset)
+
+	bucket, err := event.Fields.GetValue("aws.s3.bucket.name")
+	assert.NoError(t, err)
+	assert.Equal(t, info.name, bucket)
+
+	_, err = event.Fields.GetValue("message")
+	assert.Error(t, err)
+
+	isParquet, err = isStreamParquet(bufio.NewReader(bytes.NewReader([]byte(s3LogString1))))
+	require.NoError(t, err)
+	assert.False(t, isParquet)
+}
@@ -0,0 +1,277 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+var processor = require("processor");
+
+// The description column of the audit log is written in the language of the
+// server, so events are categorized using the numeric event ID only.
+var eventIDs = {
+    // DHCPv4
+    0: {action: "log-started", type: ["start"]},
+    1: {action: "log-stopped", type: ["end"]},
+    2: {action: "log-paused", type: ["info"]},
+    10: {action: "lease-assigned", type: ["allowed", "connection"], outcome: "success"},
+    11: {action: "lease-renewed", type: ["allowed", "connection"], outcome: "success"},
+    12: {action: "lease-released", type: ["end", "connection"], outcome: "success"},
+    13: {action: "address-in-use", type: ["info"], outcome: "failure"},
+    14: {action: "scope-exhausted", type: ["denied"], outcome: "failure"},
+    15: {action: "lease-denied", type: ["denied"], outcome: "failure"},
+    16: {action: "lease-deleted", type: ["deletion"]},
+    17: {action: "lease-expired", type: ["end"]},
+    18: {action: "lease-expired", type: ["end"]},
+    20: {action: "bootp-lease-assigned", type: ["allowed", "connection"], outcome: "success"},
+    21: {action: "bootp-lease-assigned", type: ["allowed", "connection"], outcome: "success"},
+    22: {action: "bootp-scope-exhausted", type: ["denied"], outcome: "failure"},
+    23: {action: "bootp-address-deleted", type: ["deletion"]},
+    24: {action: "cleanup-started", type: ["start"]},
+    25: {action: "cleanup-statistics", type: ["info"]},
+    30: {action: "dns-update-requested", type: ["change"]},
+    31: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+    32: {action: "dns-update-succeeded", type: ["change"], outcome: "success"},
+    33: {action: "packet-dropped", type: ["denied"], outcome: "failure"},
+    34: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+    35: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+    36: {action: "packet-dropped", type: ["denied"], outcome: "failure"},
+
+    // DHCPv6
+    11000: {action: "solicit", type: ["protocol"]},
+    11001: {action: "advertise", type: ["protocol"]},
+    11002: {action: "request", type: ["protocol"]},
+    11003: {action: "confirm", type: ["protocol"]},
+    11004: {action: "renew", type: ["protocol"]},
+    11005: {action: "rebind", type: ["protocol"]},
+    11006: {action: "decline", type: ["protocol"]},
+    11007: {action: "release", type: ["end", "protocol"]},
+    11008: {action: "information-request", type: ["protocol"]},
+    11009: {action: "scope-exhausted", type: ["denied"], outcome: "failure"},
+    11010: {action: "log-started", type: ["start"]},
+    11011: {action: "log-stopped", type: ["end"]},
+    11012: {action: "log-paused", type: ["info"]},
+    11013: {action: "log-file", type: ["info"]},
+    11014: {action: "bad-address", type: ["error"], outcome: "failure"},
+    11015: {action: "address-in-use", type: ["info"], outcome: "failure"},
+    11016: {action: "client-deleted", type: ["deletion"]},
+    11017: {action: "dns-record-not-deleted", type: ["info"]},
+    11018: {action: "lease-expired", type: ["end"]},
+    11019: {action: "lease-expired", type: ["end"]},
+    11020: {action: "cleanup-started", type: ["start"]},
+    11021: {action: "cleanup-finished", type: ["end"]},
+    11022: {action: "dns-update-requested", type: ["change"]},
+    11023: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+    11024: {action: "dns-update-succeeded", type: ["change"], outcome: "success"},
+    11028: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+    11029: {action: "dns-update-failed", type: ["change"], outcome: "failure"},
+};
+
+var quarantineResults = {
+    "0": "no_quarantine",
+    "1": "quarantine",
+    "2": "drop_packet",
+    "3": "probation",
+    "6": "no_quarantine_information",
+};
+
+var v4Columns = [
+    null, null, null,
+    "message",
+    "source.ip",
+    "source.domain",
+    "source.mac",
+    "user.name",
+    "microsoft.dhcp.transaction_id",
+    "microsoft.dhcp.quarantine_result",
+    "microsoft.dhcp.probation_time",
+    "microsoft.dhcp.correlation_id",
+    "microsoft.dhcp.dhcid",
+    "microsoft.dhcp.vendor_class.hex",
+    "microsoft.dhcp.vendor_class.ascii",
+    "microsoft.dhcp.user_class.hex",
+    "microsoft.dhcp.user_class.ascii",
+    "microsoft.dhcp.relay_agent_information",
+    "microsoft.dhcp.dns_error_code",
+];
+
+var v6Columns = [
+    null, null, null,
+    "message",
+    "source.ip",
+    "source.domain",
+    "microsoft.dhcp.error_code",
+    "microsoft.dhcp.duid.length",
+    "microsoft.dhcp.duid.hex",
+    "user.name",
+    "microsoft.dhcp.dhcid",
+    "microsoft.dhcp.subnet_prefix",
+];
+
+// parseDate returns the date as YYYY-MM-DD. The order of the date components
+// depends on the short date format of the server locale. In auto mode it is
+// detected from the values themselves, falling back to month first when the
+// date is ambiguous.
+var parseDate = function(value, order) {
+    var m = value.match(/^(\d{1,4})([\/.\-])(\d{1,2})[\/.\-](\d{1,4})$/);
+    if (!m) {
+        return null;
+    }
+    var a = m[1], b = m[3], c = m[4];
+    if (order === "auto") {
+        if (a.length === 4) {
+            order = "ymd";
+        } else if (m[2] === "." || parseInt(a, 10) > 12) {
+            order = "dmy";
+        } else {
+            order = "mdy";
+        }
+    }
+
+    var year, month, day;
+    switch (order) {
+        case "ymd":
+            year = a; month = b; day = c;
+            break;
+        case "dmy":
+            year = c; month = b; day = a;
+            break;
+        case "mdy":
+            year = c; month = a; day = b;
+            break;
+        default:
+            return null;
+    }
+    if (year.length === 2) {
+        year = "20" + year;
+    }
+    if (year.length !== 4 || parseInt(month, 10) > 12 || parseInt(day, 10) > 31) {
+        return null;
+    }
+    return year + "-" + pad(month) + "-" + pad(day);
+};
+
+var parseTime = function(value) {
+    var m = value.match(/^(\d{1,2})[:.](\d{2})[:.](\d{2})$/);
+    if (!m) {
+        return null;
+    }
+    return pad(m[1]) + ":" + m[2] + ":" + m[3];
+};
+
+var pad = function(value) {
+    return value.length === 1 ? "0" + value : value;
+};
+
+var formatMAC = function(value) {
+    if (!/^[0-9A-Fa-f]{12}$/.test(value)) {
+        return value;
+    }
+    return value.toUpperCase().match(/../g).join("-");
+};
+
+function DHCPProcessor(dateOrder, timezone) {
+    var builder = new processor.Chain();
+
+    // Skip the header that precedes the records in every audit log file.
+    builder.Add(function(evt) {
+        var msg = evt.Get("message");
+        if (!/^\d+,[^,]*,[^,]*,/.test(msg)) {
+            evt.Cancel();
+            return;
+        }
+        evt.Put("log.original", msg);
+    });
+
+    builder.Add(function(evt) {
+        var columns = evt.Get("log.original").split(",");
+        var id = parseInt(columns[0], 10);
+        var names = id >= 11000 ? v6Columns : v4Columns;
+
+        evt.Delete("message");
+        evt.Put("event.code", columns[0]);
+        for (var i = 0; i < names.length && i < columns.length; i++) {
+            var value = columns[i].trim();
+            if (names[i] === null || value === "") {
+                continue;
+            }
+            evt.Put(names[i], value);
+        }
+
+        var date = parseDate(columns[1].trim(), dateOrder);
+        var time = parseTime(columns[2].trim());
+        if (date === null || time === null) {
+            evt.Put("error.message", "failed to parse date and time of the record");
+        } else {
+            evt.Put("_tmp.timestamp", date + " " + time);
+        }
+
+        var info = eventIDs[id];
+        if (info === undefined && id >= 50 && id < 11000) {
+            info = {action: "rogue-server-detection", type: ["info"]};
+        }
+        evt.Put("event.kind", "event");
+        evt.Put("event.category", ["network"]);
+        if (info !== undefined) {
+            evt.Put("event.action", info.action);
+            evt.Put("event.type", info.type);
+            if (info.outcome) {
+                evt.Put("event.outcome", info.outcome);
+            }
+        }
+    });
+
+    builder.Add(new processor.Timestamp({
+        field: "_tmp.timestamp",
+        target_field: "@timestamp",
+        timezone: timezone,
+        layouts: ["2006-01-02 15:04:05"],
+        ignore_missing: true,
+        ignore_failure: true,
+    }));
+
+    builder.Add(new processor.Convert({
+        fields: [
+            {from: "source.ip", type: "ip"},
+            {from: "microsoft.dhcp.duid.length", type: "long"},
+        ],
+        ignore_missing: true,
+        fail_on_error: false,
+    }));
+
+    builder.Add(function(evt) {
+        evt.Delete("_tmp");
+
+        var mac = evt.Get("source.mac");
+        if (mac) {
+            evt.Put("source.mac", formatMAC(mac));
+        }
+
+        var result = quarantineResults[evt.Get("microsoft.dhcp.quarantine_result")];
+        if (result) {
+            evt.Put("microsoft.dhcp.quarantine_result", result);
+        }
+
+        var ip = evt.Get("source.ip");
+        if (ip) {
+            evt.Put("related.ip", [ip]);
+        }
+        var user = evt.Get("user.name");
+        if (user) {
+            evt.Put("related.user", [user]);
+        }
+    });
+
+    var chain = builder.Build();
+    return {
+        process: chain.Run,
+    };
+}
+
+var dhcp = new DHCPProcessor("auto", "Local");
+
+// Register params from configuration.
+function register(params) {
+    dhcp = new DHCPProcessor(params.date_order || "auto", params.timezone || "Local");
+}
+
+function process(evt) {
+    return dhcp.process(evt);
+}
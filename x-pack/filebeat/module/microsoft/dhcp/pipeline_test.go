@@ -0,0 +1,231 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package dhcp_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/script/javascript"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/validator"
+
+	// Register JS "require" modules.
+	_ "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module"
+	// Register required processors.
+	_ "github.com/elastic/beats/v7/libbeat/cmd/instance"
+	_ "github.com/elastic/beats/v7/libbeat/processors/timestamp"
+)
+
+type testCase struct {
+	message   string
+	timestamp string
+	validator validator.Validator
+}
+
+var testCases = []testCase{
+	{
+		"10,01/15/20,13:31:09,Assign,10.0.0.100,host.example.com,000C29A1B2C3,,1234567890,0,,,,0x4D53465420352E30,MSFT 5.0,,,,0",
+		"2020-01-15T13:31:09Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.action":                      "lease-assigned",
+			"event.category":                    []string{"network"},
+			"event.code":                        "10",
+			"event.kind":                        "event",
+			"event.outcome":                     "success",
+			"event.type":                        []string{"allowed", "connection"},
+			"log.original":                      isdef.IsNonEmptyString,
+			"message":                           "Assign",
+			"microsoft.dhcp.dns_error_code":     "0",
+			"microsoft.dhcp.quarantine_result":  "no_quarantine",
+			"microsoft.dhcp.transaction_id":     "1234567890",
+			"microsoft.dhcp.vendor_class.ascii": "MSFT 5.0",
+			"microsoft.dhcp.vendor_class.hex":   "0x4D53465420352E30",
+			"related.ip":                        []string{"10.0.0.100"},
+			"source.domain":                     "host.example.com",
+			"source.ip":                         "10.0.0.100",
+			"source.mac":                        "00-0C-29-A1-B2-C3",
+		}),
+	},
+
+	{
+		"11,15.01.20,08:02:11,Erneuern,10.0.0.101,pc.example.com,001122AABBCC,CORP\\alice,2345,0,,,,,,,,,0",
+		"2020-01-15T08:02:11Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.action":  "lease-renewed",
+			"event.code":    "11",
+			"event.outcome": "success",
+			"message":       "Erneuern",
+			"related.user":  []string{"CORP\\alice"},
+			"source.ip":     "10.0.0.101",
+			"source.mac":    "00-11-22-AA-BB-CC",
+			"user.name":     "CORP\\alice",
+		}),
+	},
+
+	{
+		"15,2020-01-15,21:45:00,NACK,10.0.0.102,,AABBCCDDEEFF,,0,6,,,,,,,,,0",
+		"2020-01-15T21:45:00Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.action":                     "lease-denied",
+			"event.outcome":                    "failure",
+			"event.type":                       []string{"denied"},
+			"microsoft.dhcp.quarantine_result": "no_quarantine_information",
+			"source.ip":                        "10.0.0.102",
+		}),
+	},
+
+	{
+		"11000,01/15/20,13:31:09,DHCPV6 Solicit,fe80::1,host6.example.com,,14,0001000124D3AB9D000C29A1B2C3,,,",
+		"2020-01-15T13:31:09Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.action":               "solicit",
+			"event.code":                 "11000",
+			"event.type":                 []string{"protocol"},
+			"message":                    "DHCPV6 Solicit",
+			"microsoft.dhcp.duid.hex":    "0001000124D3AB9D000C29A1B2C3",
+			"microsoft.dhcp.duid.length": int64(14),
+			"source.domain":              "host6.example.com",
+			"source.ip":                  "fe80::1",
+		}),
+	},
+
+	{
+		"00,01/15/20,00:00:05,Started,,,,,0,6,,,,,,,,,0",
+		"2020-01-15T00:00:05Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.action": "log-started",
+			"event.code":   "00",
+			"event.type":   []string{"start"},
+		}),
+	},
+}
+
+func TestDHCPPipeline(t *testing.T) {
+	logp.TestingSetup()
+
+	p := newPipeline(t, "auto")
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			e := runPipeline(t, p, tc.message)
+			if e == nil {
+				t.Fatal("event was dropped")
+			}
+
+			if ts := e.Timestamp.UTC().Format(time.RFC3339); ts != tc.timestamp {
+				t.Errorf("expected timestamp %v, got %v", tc.timestamp, ts)
+			}
+
+			if results := tc.validator(e.Fields); !results.Valid {
+				for _, err := range results.Errors() {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestDHCPPipelineHeader(t *testing.T) {
+	logp.TestingSetup()
+
+	p := newPipeline(t, "auto")
+
+	for _, msg := range []string{
+		"\t\tMicrosoft DHCP Service Activity Log",
+		"Event ID  Meaning",
+		"00\tThe log was started.",
+		"ID,Date,Time,Description,IP Address,Host Name,MAC Address,User Name, TransactionID, QResult,Probationtime, CorrelationID,Dhcid,VendorClass(Hex),VendorClass(ASCII),UserClass(Hex),UserClass(ASCII),RelayAgentInformation,DnsRegError.",
+		"",
+	} {
+		if e := runPipeline(t, p, msg); e != nil {
+			t.Errorf("expected header line %q to be dropped", msg)
+		}
+	}
+}
+
+func TestDHCPPipelineDateOrder(t *testing.T) {
+	logp.TestingSetup()
+
+	msg := "10,05/01/20,13:31:09,Assign,10.0.0.100,host.example.com,000C29A1B2C3,,1234567890,0,,,,,,,,,0"
+	for order, expected := range map[string]string{
+		"auto": "2020-05-01T13:31:09Z",
+		"mdy":  "2020-05-01T13:31:09Z",
+		"dmy":  "2020-01-05T13:31:09Z",
+	} {
+		e := runPipeline(t, newPipeline(t, order), msg)
+		if e == nil {
+			t.Fatal("event was dropped")
+		}
+		if ts := e.Timestamp.UTC().Format(time.RFC3339); ts != expected {
+			t.Errorf("date_order %v: expected timestamp %v, got %v", order, expected, ts)
+		}
+	}
+}
+
+func newPipeline(t testing.TB, dateOrder string) processors.Processor {
+	p, err := javascript.NewFromConfig(
+		javascript.Config{
+			File: "config/pipeline.js",
+			Params: map[string]interface{}{
+				"date_order": dateOrder,
+				"timezone":   "UTC",
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func runPipeline(t testing.TB, p processors.Processor, message string) *beat.Event {
+	e := &beat.Event{
+		Fields: common.MapStr{
+			"message": message,
+		},
+	}
+
+	out, err := p.Run(e)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if out != nil && testing.Verbose() {
+		data, err := json.MarshalIndent(out.Fields, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(string(data))
+	}
+	return out
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	p := newPipeline(b, "auto")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		e := beat.Event{
+			Fields: common.MapStr{
+				"message": testCases[i%len(testCases)].message,
+			},
+		}
+
+		_, err := p.Run(&e)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+var processor = require("processor");
+
+// <date> <time> [<AM/PM>] <thread id> <context> <details>
+var lineRegex = /^(\d{1,4}[\/.\-]\d{1,2}[\/.\-]\d{1,4})\s+(\d{1,2}[:.]\d{2}[:.]\d{2})(?:\s+([^\s\d]\S*))?\s+([0-9A-Fa-f]+)\s+([A-Z]+)\s+(.*)$/;
+
+// <packet id> <protocol> <direction> <remote ip> <xid> <R> <opcode>
+// [<flags hex> <flags chars> <response code>] <question type> <question name>
+var packetRegex = /^([0-9A-Fa-f]+)\s+(UDP|TCP)\s+(Rcv|Snd)\s+(\S+)\s+([0-9A-Fa-f]{4})\s([ R])\s([QNU?])\s\[([0-9A-Fa-f]{4})([ ATDR]*?)\s*([A-Z]+)\]\s+(\S+)\s+(\S+)\s*$/;
+
+var opCodes = {
+    "Q": "QUERY",
+    "N": "NOTIFY",
+    "U": "UPDATE",
+    "?": "UNKNOWN",
+};
+
+var headerFlags = {
+    "A": "AA",
+    "T": "TC",
+    "D": "RD",
+    "R": "RA",
+};
+
+// parseDate returns the date as YYYY-MM-DD. The order of the date components
+// depends on the short date format of the server locale. In auto mode it is
+// detected from the values themselves, falling back to month first when the
+// date is ambiguous.
+var parseDate = function(value, order) {
+    var m = value.match(/^(\d{1,4})([\/.\-])(\d{1,2})[\/.\-](\d{1,4})$/);
+    if (!m) {
+        return null;
+    }
+    var a = m[1], b = m[3], c = m[4];
+    if (order === "auto") {
+        if (a.length === 4) {
+            order = "ymd";
+        } else if (m[2] === "." || parseInt(a, 10) > 12) {
+            order = "dmy";
+        } else {
+            order = "mdy";
+        }
+    }
+
+    var year, month, day;
+    switch (order) {
+        case "ymd":
+            year = a; month = b; day = c;
+            break;
+        case "dmy":
+            year = c; month = b; day = a;
+            break;
+        case "mdy":
+            year = c; month = a; day = b;
+            break;
+        default:
+            return null;
+    }
+    if (year.length === 2) {
+        year = "20" + year;
+    }
+    if (year.length !== 4 || parseInt(month, 10) > 12 || parseInt(day, 10) > 31) {
+        return null;
+    }
+    return year + "-" + pad(month) + "-" + pad(day);
+};
+
+// parseTime returns the time in 24-hour format. The AM/PM designator is only
+// present when the server locale uses a 12-hour clock.
+var parseTime = function(value, designator) {
+    var m = value.match(/^(\d{1,2})[:.](\d{2})[:.](\d{2})$/);
+    if (!m) {
+        return null;
+    }
+    var hour = parseInt(m[1], 10);
+    if (designator) {
+        designator = designator.replace(/\./g, "").toUpperCase();
+        if (designator === "PM" && hour < 12) {
+            hour += 12;
+        } else if (designator === "AM" && hour === 12) {
+            hour = 0;
+        }
+    }
+    return pad(String(hour)) + ":" + m[2] + ":" + m[3];
+};
+
+var pad = function(value) {
+    return value.length === 1 ? "0" + value : value;
+};
+
+// questionName converts a name in wire format, e.g. (3)www(7)example(3)com(0),
+// to dotted notation.
+var questionName = function(value) {
+    var name = value.replace(/\(\d+\)/g, ".").replace(/^\.+|\.+$/g, "");
+    return name === "" ? "." : name;
+};
+
+function DNSProcessor(dateOrder, timezone) {
+    var builder = new processor.Chain();
+
+    // Skip the header and the continuation lines of detailed packet logging.
+    builder.Add(function(evt) {
+        var msg = evt.Get("message");
+        var m = msg.match(lineRegex);
+        if (!m) {
+            evt.Cancel();
+            return;
+        }
+        evt.Put("log.original", msg);
+
+        var date = parseDate(m[1], dateOrder);
+        var time = parseTime(m[2], m[3]);
+        if (date === null || time === null) {
+            evt.Put("error.message", "failed to parse date and time of the record");
+        } else {
+            evt.Put("_tmp.timestamp", date + " " + time);
+        }
+
+        evt.Put("microsoft.dns.thread_id", m[4]);
+        evt.Put("microsoft.dns.context", m[5]);
+        evt.Put("message", m[6].trim());
+        evt.Put("event.kind", "event");
+        evt.Put("event.category", ["network"]);
+    });
+
+    builder.Add(function(evt) {
+        if (evt.Get("microsoft.dns.context") !== "PACKET") {
+            return;
+        }
+        var m = evt.Get("message").match(packetRegex);
+        if (!m) {
+            return;
+        }
+
+        var remote = m[4];
+        var response = m[6] === "R";
+        evt.Put("microsoft.dns.packet_id", m[1]);
+        evt.Put("network.transport", m[2].toLowerCase());
+        evt.Put("network.protocol", "dns");
+        evt.Put("network.type", remote.indexOf(":") !== -1 ? "ipv6" : "ipv4");
+        if (m[3] === "Rcv") {
+            evt.Put("network.direction", "inbound");
+            evt.Put("source.ip", remote);
+        } else {
+            evt.Put("network.direction", "outbound");
+            evt.Put("destination.ip", remote);
+        }
+        evt.Put("related.ip", [remote]);
+
+        evt.Put("dns.id", m[5]);
+        evt.Put("dns.type", response ? "answer" : "query");
+        evt.Put("dns.op_code", opCodes[m[7]]);
+        evt.Put("dns.response_code", m[10]);
+        evt.Put("dns.question.type", m[11]);
+        evt.Put("dns.question.name", questionName(m[12]));
+
+        var flags = [];
+        var chars = m[9].replace(/\s/g, "");
+        for (var i = 0; i < chars.length; i++) {
+            flags.push(headerFlags[chars.charAt(i)]);
+        }
+        if (flags.length > 0) {
+            evt.Put("dns.header_flags", flags);
+        }
+
+        evt.Put("event.action", response ? "dns-response" : "dns-query");
+        evt.Put("event.type", ["protocol"]);
+        if (response) {
+            evt.Put("event.outcome", m[10] === "NOERROR" ? "success" : "failure");
+        }
+    });
+
+    builder.Add(new processor.Timestamp({
+        field: "_tmp.timestamp",
+        target_field: "@timestamp",
+        timezone: timezone,
+        layouts: ["2006-01-02 15:04:05"],
+        ignore_missing: true,
+        ignore_failure: true,
+    }));
+
+    builder.Add(new processor.Convert({
+        fields: [
+            {from: "source.ip", type: "ip"},
+            {from: "destination.ip", type: "ip"},
+        ],
+        ignore_missing: true,
+        fail_on_error: false,
+    }));
+
+    builder.Add(function(evt) {
+        evt.Delete("_tmp");
+    });
+
+    var chain = builder.Build();
+    return {
+        process: chain.Run,
+    };
+}
+
+var dns = new DNSProcessor("auto", "Local");
+
+// Register params from configuration.
+function register(params) {
+    dns = new DNSProcessor(params.date_order || "auto", params.timezone || "Local");
+}
+
+function process(evt) {
+    return dns.process(evt);
+}
@@ -0,0 +1,235 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package dns_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/processors"
+	"github.com/elastic/beats/v7/libbeat/processors/script/javascript"
+	"github.com/elastic/go-lookslike"
+	"github.com/elastic/go-lookslike/isdef"
+	"github.com/elastic/go-lookslike/validator"
+
+	// Register JS "require" modules.
+	_ "github.com/elastic/beats/v7/libbeat/processors/script/javascript/module"
+	// Register required processors.
+	_ "github.com/elastic/beats/v7/libbeat/cmd/instance"
+	_ "github.com/elastic/beats/v7/libbeat/processors/timestamp"
+)
+
+type testCase struct {
+	message   string
+	timestamp string
+	validator validator.Validator
+}
+
+var testCases = []testCase{
+	{
+		"6/5/2013 10:00:32 AM 0E70 PACKET  00000000033397A0 UDP Rcv 10.0.0.10       4c22   Q [0001   D   NOERROR] A      (3)www(7)example(3)com(0)",
+		"2013-06-05T10:00:32Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"dns.header_flags":        []string{"RD"},
+			"dns.id":                  "4c22",
+			"dns.op_code":             "QUERY",
+			"dns.question.name":       "www.example.com",
+			"dns.question.type":       "A",
+			"dns.response_code":       "NOERROR",
+			"dns.type":                "query",
+			"event.action":            "dns-query",
+			"event.category":          []string{"network"},
+			"event.kind":              "event",
+			"event.type":              []string{"protocol"},
+			"log.original":            isdef.IsNonEmptyString,
+			"microsoft.dns.context":   "PACKET",
+			"microsoft.dns.packet_id": "00000000033397A0",
+			"microsoft.dns.thread_id": "0E70",
+			"network.direction":       "inbound",
+			"network.protocol":        "dns",
+			"network.transport":       "udp",
+			"network.type":            "ipv4",
+			"related.ip":              []string{"10.0.0.10"},
+			"source.ip":               "10.0.0.10",
+		}),
+	},
+
+	{
+		"6/5/2013 12:05:01 PM 0E70 PACKET  00000000033397A0 UDP Snd 10.0.0.10       4c22 R Q [8081   DR  NOERROR] A      (3)www(7)example(3)com(0)",
+		"2013-06-05T12:05:01Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"destination.ip":    "10.0.0.10",
+			"dns.header_flags":  []string{"RD", "RA"},
+			"dns.type":          "answer",
+			"event.action":      "dns-response",
+			"event.outcome":     "success",
+			"network.direction": "outbound",
+		}),
+	},
+
+	{
+		"15.01.2020 23:10:45 1A2C PACKET  000001F3B2C4D5E0 TCP Snd fe80::1         a1b2 R Q [8385 A DR NXDOMAIN] AAAA   (7)missing(7)example(3)com(0)",
+		"2020-01-15T23:10:45Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"destination.ip":    "fe80::1",
+			"dns.header_flags":  []string{"AA", "RD", "RA"},
+			"dns.question.name": "missing.example.com",
+			"dns.question.type": "AAAA",
+			"dns.response_code": "NXDOMAIN",
+			"event.outcome":     "failure",
+			"network.transport": "tcp",
+			"network.type":      "ipv6",
+		}),
+	},
+
+	{
+		"1/15/2020 12:00:01 AM 0F14 PACKET  000000D2F3A2B1C0 UDP Rcv 192.168.1.20    0005 R N [0084 A     NOERROR] SOA    (7)example(3)com(0)",
+		"2020-01-15T00:00:01Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"dns.header_flags":  []string{"AA"},
+			"dns.op_code":       "NOTIFY",
+			"dns.question.name": "example.com",
+			"dns.question.type": "SOA",
+		}),
+	},
+
+	{
+		"2020-01-15 08:00:00 0C10 EVENT   The DNS server has finished the background loading of zones.",
+		"2020-01-15T08:00:00Z",
+		lookslike.MustCompile(map[string]interface{}{
+			"event.kind":              "event",
+			"message":                 "The DNS server has finished the background loading of zones.",
+			"microsoft.dns.context":   "EVENT",
+			"microsoft.dns.thread_id": "0C10",
+		}),
+	},
+}
+
+func TestDNSPipeline(t *testing.T) {
+	logp.TestingSetup()
+
+	p := newPipeline(t, "auto")
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			e := runPipeline(t, p, tc.message)
+			if e == nil {
+				t.Fatal("event was dropped")
+			}
+
+			if ts := e.Timestamp.UTC().Format(time.RFC3339); ts != tc.timestamp {
+				t.Errorf("expected timestamp %v, got %v", tc.timestamp, ts)
+			}
+
+			if results := tc.validator(e.Fields); !results.Valid {
+				for _, err := range results.Errors() {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestDNSPipelineHeader(t *testing.T) {
+	logp.TestingSetup()
+
+	p := newPipeline(t, "auto")
+
+	for _, msg := range []string{
+		"DNS Server log file creation at 1/15/2020 8:00:00 AM",
+		"Log file wrap at 1/15/2020 8:00:00 AM",
+		"Message logging key (for packets - other items use a subset of these fields):",
+		"\tField #  Information         Values",
+		"UDP question info at 00000000033397A0",
+		"  Socket = 508",
+		"",
+	} {
+		if e := runPipeline(t, p, msg); e != nil {
+			t.Errorf("expected header line %q to be dropped", msg)
+		}
+	}
+}
+
+func TestDNSPipelineDateOrder(t *testing.T) {
+	logp.TestingSetup()
+
+	msg := "05/01/2020 13:31:09 0E70 PACKET  00000000033397A0 UDP Rcv 10.0.0.10       4c22   Q [0001   D   NOERROR] A      (3)www(7)example(3)com(0)"
+	for order, expected := range map[string]string{
+		"auto": "2020-05-01T13:31:09Z",
+		"mdy":  "2020-05-01T13:31:09Z",
+		"dmy":  "2020-01-05T13:31:09Z",
+	} {
+		e := runPipeline(t, newPipeline(t, order), msg)
+		if e == nil {
+			t.Fatal("event was dropped")
+		}
+		if ts := e.Timestamp.UTC().Format(time.RFC3339); ts != expected {
+			t.Errorf("date_order %v: expected timestamp %v, got %v", order, expected, ts)
+		}
+	}
+}
+
+func newPipeline(t testing.TB, dateOrder string) processors.Processor {
+	p, err := javascript.NewFromConfig(
+		javascript.Config{
+			File: "config/pipeline.js",
+			Params: map[string]interface{}{
+				"date_order": dateOrder,
+				"timezone":   "UTC",
+			},
+		},
+		nil,
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func runPipeline(t testing.TB, p processors.Processor, message string) *beat.Event {
+	e := &beat.Event{
+		Fields: common.MapStr{
+			"message": message,
+		},
+	}
+
+	out, err := p.Run(e)
+	if err != nil {
+		t.Fatalf("%+v", err)
+	}
+
+	if out != nil && testing.Verbose() {
+		data, err := json.MarshalIndent(out.Fields, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Log(string(data))
+	}
+	return out
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	p := newPipeline(b, "auto")
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		e := beat.Event{
+			Fields: common.MapStr{
+				"message": testCases[i%len(testCases)].message,
+			},
+		}
+
+		_, err := p.Run(&e)
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
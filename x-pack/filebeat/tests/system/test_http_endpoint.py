@@ -198,3 +198,59 @@ class Test(BaseTest):
 
         assert r.status_code == 405
         assert r.text == '{"message": "Only POST requests supported"}'
+
+    def test_http_endpoint_kubernetes_audit(self):
+        """
+        Test http_endpoint input with a batch of Kubernetes audit events.
+        """
+        options = """
+  mode: kubernetes_audit
+"""
+        self.get_config(options)
+        filebeat = self.start_beat()
+        self.wait_until(lambda: self.log_contains("Starting HTTP server on {}:{}".format(self.host, self.port)))
+
+        payload = {
+            "kind": "EventList",
+            "apiVersion": "audit.k8s.io/v1",
+            "items": [
+                {"auditID": "1", "verb": "get", "user": {"username": "admin"},
+                 "stageTimestamp": "2020-06-01T10:00:00.000000Z"},
+                {"auditID": "2", "verb": "delete", "responseStatus": {"code": 403},
+                 "stageTimestamp": "2020-06-01T10:00:01.000000Z"},
+            ],
+        }
+        headers = {"Content-Type": "application/json", "Accept": "application/json"}
+        r = requests.post(self.url, headers=headers, data=json.dumps(payload))
+
+        self.wait_until(lambda: self.output_count(lambda x: x >= 2))
+        filebeat.check_kill_and_wait()
+
+        output = self.read_output()
+
+        assert r.status_code == 200
+        assert output[0]["event.id"] == "1"
+        assert output[0]["user.name"] == "admin"
+        assert output[0]["kubernetes.audit.verb"] == "get"
+        assert output[1]["event.action"] == "delete"
+        assert output[1]["event.outcome"] == "failure"
+
+    def test_http_endpoint_kubernetes_audit_wrong_body(self):
+        """
+        Test http_endpoint input in kubernetes_audit mode with a body that is not an EventList.
+        """
+        options = """
+  mode: kubernetes_audit
+"""
+        self.get_config(options)
+        filebeat = self.start_beat()
+        self.wait_until(lambda: self.log_contains("Starting HTTP server on {}:{}".format(self.host, self.port)))
+
+        payload = {self.prefix: "somerandommessage"}
+        headers = {"Content-Type": "application/json", "Accept": "application/json"}
+        r = requests.post(self.url, headers=headers, data=json.dumps(payload))
+
+        filebeat.check_kill_and_wait()
+
+        assert r.status_code == 400
+        assert r.text == '{"message": "Body must be an audit.k8s.io EventList"}'
@@ -17,6 +17,7 @@ func XPackConfigFileParams() devtools.ConfigFileParams {
 		"ExcludeFileOutput":          true,
 		"ExcludeKafka":               true,
 		"ExcludeRedis":               true,
+		"ExcludeSyslog":              true,
 		"UseDockerMetadataProcessor": false,
 	}
 	return p
@@ -0,0 +1,75 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+)
+
+const (
+	sourceAPI      = "api"
+	sourceMetadata = "metadata"
+
+	// metadataEnvVar is set by the ECS agent in the containers of the tasks
+	// with the URL of the task metadata endpoint v4.
+	metadataEnvVar = "ECS_CONTAINER_METADATA_URI_V4"
+)
+
+// Config for the aws_ecs autodiscover provider.
+type Config struct {
+	Templates template.MapperSettings `config:"templates"`
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+
+	// Period defines how often to poll the ECS API or the metadata endpoint.
+	Period time.Duration `config:"period" validate:"nonzero,required"`
+
+	// Source is `api` to list the tasks of the clusters with the ECS API, or
+	// `metadata` to read the task of the Beat from the task metadata endpoint.
+	Source string `config:"source"`
+
+	// ECS API specific fields
+	Clusters  []string            `config:"clusters"`
+	Region    string              `config:"region"`
+	AWSConfig awscommon.ConfigAWS `config:",inline"`
+
+	// Task metadata endpoint specific fields
+	MetadataURL string `config:"metadata_url"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Prefix:      "co.elastic",
+		Period:      time.Minute,
+		Source:      sourceAPI,
+		Clusters:    []string{"default"},
+		MetadataURL: os.Getenv(metadataEnvVar),
+	}
+}
+
+// Validate ensures correctness of config
+func (c *Config) Validate() error {
+	switch c.Source {
+	case sourceAPI:
+		if len(c.Clusters) == 0 {
+			return fmt.Errorf("at least one cluster is required with the api source")
+		}
+	case sourceMetadata:
+		if c.MetadataURL == "" {
+			return fmt.Errorf("metadata_url is required with the metadata source when %s is not set", metadataEnvVar)
+		}
+	default:
+		return fmt.Errorf("invalid source %q, must be %s or %s", c.Source, sourceAPI, sourceMetadata)
+	}
+	return nil
+}
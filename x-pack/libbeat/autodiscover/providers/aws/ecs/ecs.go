@@ -0,0 +1,123 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"strings"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+)
+
+// ecsTask is the task of a discovered container.
+type ecsTask struct {
+	ARN              string
+	ClusterARN       string
+	DefinitionARN    string
+	Family           string
+	Revision         int64
+	LaunchType       string
+	AvailabilityZone string
+}
+
+// ecsContainer is a running container of an ECS task.
+type ecsContainer struct {
+	Task ecsTask
+
+	// ID is the ID of the container in the Docker daemon, only known when it
+	// is read from the task metadata endpoint.
+	ID     string
+	ARN    string
+	Name   string
+	Image  string
+	Labels map[string]string
+
+	// Host is the address the container can be reached at, and Ports the
+	// ports it can be reached at in this address.
+	Host  string
+	Ports []int64
+}
+
+// key identifies the container, containers of a task have unique names.
+func (c *ecsContainer) key() string {
+	return c.Task.ARN + "/" + c.Name
+}
+
+// toMap converts this container into the form consumed as metadata in the autodiscovery process.
+func (c *ecsContainer) toMap() common.MapStr {
+	m := common.MapStr{
+		"cluster": common.MapStr{
+			"arn":  c.Task.ClusterARN,
+			"name": resourceName(c.Task.ClusterARN),
+		},
+		"task": common.MapStr{
+			"arn":    c.Task.ARN,
+			"family": c.Task.Family,
+		},
+		"container": common.MapStr{
+			"name": c.Name,
+		},
+	}
+	if c.Task.Revision > 0 {
+		m.Put("task.revision", c.Task.Revision)
+	}
+	if c.Task.LaunchType != "" {
+		m.Put("task.launch_type", c.Task.LaunchType)
+	}
+	if c.Task.DefinitionARN != "" {
+		m.Put("task.definition_arn", c.Task.DefinitionARN)
+	}
+	if c.ARN != "" {
+		m.Put("container.arn", c.ARN)
+	}
+	return m
+}
+
+// toContainerMap returns the container fields of the metadata.
+func (c *ecsContainer) toContainerMap() common.MapStr {
+	m := common.MapStr{
+		"name": c.Name,
+		"image": common.MapStr{
+			"name": c.Image,
+		},
+	}
+	if c.ID != "" {
+		m["id"] = c.ID
+	}
+	if len(c.Labels) > 0 {
+		labels := common.MapStr{}
+		for k, v := range c.Labels {
+			safemapstr.Put(labels, k, v)
+		}
+		m["labels"] = labels
+	}
+	return m
+}
+
+func (c *ecsContainer) toCloudMap() common.MapStr {
+	m := common.MapStr{
+		"provider": "aws",
+		"region":   arnRegion(c.Task.ARN),
+	}
+	if c.Task.AvailabilityZone != "" {
+		m["availability_zone"] = c.Task.AvailabilityZone
+	}
+	return m
+}
+
+// arnRegion returns the region of an ARN, like arn:aws:ecs:us-east-1:012345678910:task/...
+func arnRegion(arn string) string {
+	parts := strings.SplitN(arn, ":", 6)
+	if len(parts) < 6 {
+		return ""
+	}
+	return parts[3]
+}
+
+// resourceName returns the name of a resource from its ARN, like
+// arn:aws:ecs:us-east-1:012345678910:cluster/name.
+func resourceName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
@@ -0,0 +1,350 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/ecsiface"
+	"github.com/pkg/errors"
+
+	awsauto "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/aws"
+)
+
+const (
+	statusRunning = "RUNNING"
+
+	// describeBatchSize is the maximum number of resources that can be
+	// described with a single request to the ECS API.
+	describeBatchSize = 100
+)
+
+// fetcher is an interface that can fetch the list of running containers without pagination being necessary.
+type fetcher interface {
+	fetch(ctx context.Context) ([]*ecsContainer, error)
+}
+
+// apiFetcher fetches the running containers of the tasks of some clusters
+// from the ECS API.
+type apiFetcher struct {
+	client    ecsiface.ClientAPI
+	ec2Client ec2iface.ClientAPI
+	clusters  []string
+
+	// Task definitions are immutable, and the addresses of container
+	// instances don't change, they are kept while they are in use.
+	definitions map[string]*ecs.TaskDefinition
+	hosts       map[string]string
+}
+
+func newAPIFetcher(client ecsiface.ClientAPI, ec2Client ec2iface.ClientAPI, clusters []string) *apiFetcher {
+	return &apiFetcher{
+		client:      client,
+		ec2Client:   ec2Client,
+		clusters:    clusters,
+		definitions: map[string]*ecs.TaskDefinition{},
+		hosts:       map[string]string{},
+	}
+}
+
+func (f *apiFetcher) fetch(ctx context.Context) ([]*ecsContainer, error) {
+	var tasks []ecs.Task
+	for _, cluster := range f.clusters {
+		clusterTasks, err := f.fetchTasks(ctx, cluster)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to fetch tasks of cluster %s", cluster)
+		}
+		tasks = append(tasks, clusterTasks...)
+	}
+
+	definitions := map[string]*ecs.TaskDefinition{}
+	hosts := map[string]string{}
+	var containers []*ecsContainer
+	for _, task := range tasks {
+		if awsauto.SafeString(task.LastStatus) != statusRunning {
+			continue
+		}
+
+		definitionARN := awsauto.SafeString(task.TaskDefinitionArn)
+		definition, err := f.taskDefinition(ctx, definitionARN)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to describe task definition %s", definitionARN)
+		}
+		definitions[definitionARN] = definition
+
+		var host string
+		if definition.NetworkMode != ecs.NetworkModeAwsvpc {
+			instanceARN := awsauto.SafeString(task.ContainerInstanceArn)
+			host, err = f.containerInstanceHost(ctx, awsauto.SafeString(task.ClusterArn), instanceARN)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to get the address of container instance %s", instanceARN)
+			}
+			hosts[instanceARN] = host
+		}
+
+		for _, c := range task.Containers {
+			if awsauto.SafeString(c.LastStatus) != statusRunning {
+				continue
+			}
+			containers = append(containers, apiContainer(task, definition, c, host))
+		}
+	}
+
+	f.definitions = definitions
+	f.hosts = hosts
+	return containers, nil
+}
+
+// fetchTasks lists and describes the tasks of a cluster.
+func (f *apiFetcher) fetchTasks(ctx context.Context, cluster string) ([]ecs.Task, error) {
+	req := f.client.ListTasksRequest(&ecs.ListTasksInput{
+		Cluster:       &cluster,
+		DesiredStatus: ecs.DesiredStatusRunning,
+	})
+	paginator := ecs.NewListTasksPaginator(req)
+
+	var arns []string
+	for paginator.Next(ctx) {
+		arns = append(arns, paginator.CurrentPage().TaskArns...)
+	}
+	if err := paginator.Err(); err != nil {
+		return nil, err
+	}
+
+	var tasks []ecs.Task
+	for len(arns) > 0 {
+		batch := arns
+		if len(batch) > describeBatchSize {
+			batch = batch[:describeBatchSize]
+		}
+		arns = arns[len(batch):]
+
+		resp, err := f.client.DescribeTasksRequest(&ecs.DescribeTasksInput{
+			Cluster: &cluster,
+			Tasks:   batch,
+		}).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, resp.Tasks...)
+	}
+	return tasks, nil
+}
+
+func (f *apiFetcher) taskDefinition(ctx context.Context, arn string) (*ecs.TaskDefinition, error) {
+	if definition, ok := f.definitions[arn]; ok {
+		return definition, nil
+	}
+
+	resp, err := f.client.DescribeTaskDefinitionRequest(&ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: &arn,
+	}).Send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if resp.TaskDefinition == nil {
+		return nil, fmt.Errorf("task definition not found")
+	}
+	f.definitions[arn] = resp.TaskDefinition
+	return resp.TaskDefinition, nil
+}
+
+// containerInstanceHost returns the private address of the EC2 instance of a
+// container instance.
+func (f *apiFetcher) containerInstanceHost(ctx context.Context, cluster, arn string) (string, error) {
+	if host, ok := f.hosts[arn]; ok {
+		return host, nil
+	}
+
+	resp, err := f.client.DescribeContainerInstancesRequest(&ecs.DescribeContainerInstancesInput{
+		Cluster:            &cluster,
+		ContainerInstances: []string{arn},
+	}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	if len(resp.ContainerInstances) == 0 {
+		return "", fmt.Errorf("container instance not found")
+	}
+
+	instanceID := awsauto.SafeString(resp.ContainerInstances[0].Ec2InstanceId)
+	instances, err := f.ec2Client.DescribeInstancesRequest(&ec2.DescribeInstancesInput{
+		InstanceIds: []string{instanceID},
+	}).Send(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, reservation := range instances.Reservations {
+		for _, instance := range reservation.Instances {
+			host := awsauto.SafeString(instance.PrivateIpAddress)
+			f.hosts[arn] = host
+			return host, nil
+		}
+	}
+	return "", fmt.Errorf("EC2 instance %s not found", instanceID)
+}
+
+func apiContainer(task ecs.Task, definition *ecs.TaskDefinition, c ecs.Container, host string) *ecsContainer {
+	container := &ecsContainer{
+		Task: ecsTask{
+			ARN:           awsauto.SafeString(task.TaskArn),
+			ClusterARN:    awsauto.SafeString(task.ClusterArn),
+			DefinitionARN: awsauto.SafeString(definition.TaskDefinitionArn),
+			Family:        awsauto.SafeString(definition.Family),
+			LaunchType:    string(task.LaunchType),
+		},
+		ARN:  awsauto.SafeString(c.ContainerArn),
+		Name: awsauto.SafeString(c.Name),
+		Host: host,
+	}
+	if definition.Revision != nil {
+		container.Task.Revision = *definition.Revision
+	}
+
+	for _, d := range definition.ContainerDefinitions {
+		if awsauto.SafeString(d.Name) != container.Name {
+			continue
+		}
+		container.Image = awsauto.SafeString(d.Image)
+		container.Labels = d.DockerLabels
+
+		// Tasks with their own network interface can be reached in the
+		// ports of the containers, as in Fargate.
+		if definition.NetworkMode == ecs.NetworkModeAwsvpc {
+			for _, mapping := range d.PortMappings {
+				if mapping.ContainerPort != nil {
+					container.Ports = append(container.Ports, *mapping.ContainerPort)
+				}
+			}
+		}
+	}
+
+	if definition.NetworkMode == ecs.NetworkModeAwsvpc {
+		for _, iface := range c.NetworkInterfaces {
+			if iface.PrivateIpv4Address != nil {
+				container.Host = *iface.PrivateIpv4Address
+				break
+			}
+		}
+	} else {
+		for _, binding := range c.NetworkBindings {
+			if binding.HostPort != nil {
+				container.Ports = append(container.Ports, *binding.HostPort)
+			}
+		}
+	}
+	return container
+}
+
+// taskMetadata is the response of the task endpoint of the task metadata
+// endpoint v4.
+type taskMetadata struct {
+	Cluster          string
+	TaskARN          string
+	Family           string
+	Revision         string
+	KnownStatus      string
+	LaunchType       string
+	AvailabilityZone string
+	Containers       []struct {
+		DockerID     string `json:"DockerId"`
+		Name         string
+		Image        string
+		Labels       map[string]string
+		KnownStatus  string
+		Type         string
+		ContainerARN string
+		Networks     []struct {
+			IPv4Addresses []string
+		}
+		Ports []struct {
+			ContainerPort int64
+		}
+	}
+}
+
+// metadataFetcher fetches the running containers of the task of the Beat from
+// the task metadata endpoint.
+type metadataFetcher struct {
+	url    string
+	client *http.Client
+}
+
+func newMetadataFetcher(url string) *metadataFetcher {
+	return &metadataFetcher{
+		url:    strings.TrimRight(url, "/"),
+		client: &http.Client{},
+	}
+}
+
+func (f *metadataFetcher) fetch(ctx context.Context) ([]*ecsContainer, error) {
+	req, err := http.NewRequest("GET", f.url+"/task", nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := f.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to request the task metadata endpoint")
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("task metadata endpoint returned status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var task taskMetadata
+	if err := json.NewDecoder(resp.Body).Decode(&task); err != nil {
+		return nil, errors.Wrap(err, "invalid response of the task metadata endpoint")
+	}
+
+	revision, _ := strconv.ParseInt(task.Revision, 10, 64)
+	var containers []*ecsContainer
+	for _, c := range task.Containers {
+		// Containers managed by the ECS agent, like the pause containers of
+		// the tasks with their own network interface, are not discovered.
+		if c.KnownStatus != statusRunning || (c.Type != "" && c.Type != "NORMAL") {
+			continue
+		}
+
+		container := &ecsContainer{
+			Task: ecsTask{
+				ARN:              task.TaskARN,
+				ClusterARN:       task.Cluster,
+				Family:           task.Family,
+				Revision:         revision,
+				LaunchType:       task.LaunchType,
+				AvailabilityZone: task.AvailabilityZone,
+			},
+			ID:     c.DockerID,
+			ARN:    c.ContainerARN,
+			Name:   c.Name,
+			Image:  c.Image,
+			Labels: c.Labels,
+		}
+		// The Beat runs in the same task, so containers can be reached in
+		// their own addresses and ports.
+		for _, network := range c.Networks {
+			if len(network.IPv4Addresses) > 0 {
+				container.Host = network.IPv4Addresses[0]
+				break
+			}
+		}
+		for _, port := range c.Ports {
+			container.Ports = append(container.Ports, port.ContainerPort)
+		}
+		containers = append(containers, container)
+	}
+	return containers, nil
+}
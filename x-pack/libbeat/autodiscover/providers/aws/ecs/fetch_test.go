@@ -0,0 +1,122 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAPIFetcher(t *testing.T) {
+	client := &mockECSClient{}
+	f := newAPIFetcher(client, &mockEC2Client{}, []string{"default"})
+
+	containers, err := f.fetch(context.Background())
+	require.NoError(t, err)
+	require.Len(t, containers, 2)
+	sort.Slice(containers, func(i, j int) bool { return containers[i].Name < containers[j].Name })
+
+	nginx := containers[0]
+	assert.Equal(t, fargateTaskARN+"/nginx", nginx.key())
+	assert.Equal(t, "nginx:1.19", nginx.Image)
+	assert.Equal(t, "10.0.1.12", nginx.Host)
+	assert.Equal(t, []int64{80, 8080}, nginx.Ports)
+	assert.Equal(t, map[string]string{"co.elastic.metrics/module": "nginx"}, nginx.Labels)
+	assert.Equal(t, ecsTask{
+		ARN:           fargateTaskARN,
+		ClusterARN:    clusterARN,
+		DefinitionARN: fargateDefinition,
+		Family:        "web",
+		Revision:      2,
+		LaunchType:    "FARGATE",
+	}, nginx.Task)
+
+	// Containers of tasks in bridge mode are reached in the address of the
+	// container instance, with the host ports.
+	redis := containers[1]
+	assert.Equal(t, "10.0.2.5", redis.Host)
+	assert.Equal(t, []int64{32768}, redis.Ports)
+	assert.Equal(t, "EC2", redis.Task.LaunchType)
+
+	// Task definitions are only described once.
+	_, err = f.fetch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, client.describedDefinitions)
+}
+
+const testTaskMetadata = `{
+  "Cluster": "arn:aws:ecs:us-west-2:012345678910:cluster/default",
+  "TaskARN": "arn:aws:ecs:us-west-2:012345678910:task/default/158d1c8083dd49d6b527399fd6414f5c",
+  "Family": "curltest",
+  "Revision": "26",
+  "DesiredStatus": "RUNNING",
+  "KnownStatus": "RUNNING",
+  "LaunchType": "FARGATE",
+  "AvailabilityZone": "us-west-2d",
+  "Containers": [
+    {
+      "DockerId": "ea32192c8553fbff06c9340478a2ff089b2bb5646fb718b4ee206641c9086d66",
+      "Name": "~internal~ecs~pause",
+      "Image": "amazon/amazon-ecs-pause:0.1.0",
+      "KnownStatus": "RESOURCES_PROVISIONED",
+      "Type": "CNI_PAUSE"
+    },
+    {
+      "DockerId": "ee08638adaaf009d78c248913f629e38299471d45fe7dc944d1039077e3424ca",
+      "Name": "redis",
+      "Image": "redis:6",
+      "Labels": {
+        "co.elastic.metrics/module": "redis",
+        "com.amazonaws.ecs.container-name": "redis"
+      },
+      "KnownStatus": "RUNNING",
+      "Type": "NORMAL",
+      "ContainerARN": "arn:aws:ecs:us-west-2:012345678910:container/05966557-f16c-49cb-9352-24b3a0dcd0e1",
+      "Networks": [{"NetworkMode": "awsvpc", "IPv4Addresses": ["10.0.2.106"]}],
+      "Ports": [{"ContainerPort": 6379, "Protocol": "tcp"}]
+    },
+    {
+      "DockerId": "aa08638adaaf009d78c248913f629e38299471d45fe7dc944d1039077e3424ca",
+      "Name": "migrations",
+      "Image": "migrate",
+      "KnownStatus": "STOPPED",
+      "Type": "NORMAL"
+    }
+  ]
+}`
+
+func TestMetadataFetcher(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/task" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(testTaskMetadata))
+	}))
+	defer server.Close()
+
+	containers, err := newMetadataFetcher(server.URL + "/v4/").fetch(context.Background())
+	require.NoError(t, err)
+	require.Len(t, containers, 1)
+
+	redis := containers[0]
+	assert.Equal(t, "ee08638adaaf009d78c248913f629e38299471d45fe7dc944d1039077e3424ca", redis.ID)
+	assert.Equal(t, "redis", redis.Name)
+	assert.Equal(t, "10.0.2.106", redis.Host)
+	assert.Equal(t, []int64{6379}, redis.Ports)
+	assert.Equal(t, "redis", redis.Labels["co.elastic.metrics/module"])
+	assert.Equal(t, int64(26), redis.Task.Revision)
+	assert.Equal(t, "us-west-2d", redis.Task.AvailabilityZone)
+	assert.Equal(t, "us-west-2", redis.toCloudMap()["region"])
+
+	_, err = newMetadataFetcher(server.URL + "/other").fetch(context.Background())
+	assert.Error(t, err)
+}
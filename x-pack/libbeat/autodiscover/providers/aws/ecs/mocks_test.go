@@ -0,0 +1,215 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/ec2iface"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/ecsiface"
+)
+
+// mockFetcher is a fetcher that returns a customizable list of results, useful for testing.
+type mockFetcher struct {
+	containers []*ecsContainer
+	err        error
+	lock       sync.Mutex
+}
+
+func newMockFetcher(containers []*ecsContainer, err error) *mockFetcher {
+	return &mockFetcher{containers: containers, err: err}
+}
+
+func (f *mockFetcher) fetch(ctx context.Context) ([]*ecsContainer, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	result := make([]*ecsContainer, len(f.containers))
+	copy(result, f.containers)
+
+	return result, f.err
+}
+
+func (f *mockFetcher) setContainers(containers []*ecsContainer) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.containers = containers
+}
+
+func fakeContainer() *ecsContainer {
+	return &ecsContainer{
+		Task: ecsTask{
+			ARN:           "arn:aws:ecs:us-east-1:012345678910:task/default/a1b2c3",
+			ClusterARN:    "arn:aws:ecs:us-east-1:012345678910:cluster/default",
+			DefinitionARN: "arn:aws:ecs:us-east-1:012345678910:task-definition/redis:3",
+			Family:        "redis",
+			Revision:      3,
+			LaunchType:    "FARGATE",
+		},
+		ARN:   "arn:aws:ecs:us-east-1:012345678910:container/d4e5f6",
+		Name:  "redis",
+		Image: "redis:6",
+		Labels: map[string]string{
+			"co.elastic.metrics/module": "redis",
+			"co.elastic.metrics/hosts":  "${data.host}:${data.port}",
+		},
+		Host:  "10.0.1.12",
+		Ports: []int64{6379},
+	}
+}
+
+// mockECSClient serves the tasks of a cluster with a task in a Fargate task
+// definition and a task in a bridge task definition.
+type mockECSClient struct {
+	ecsiface.ClientAPI
+	describedDefinitions int
+}
+
+var (
+	fargateTaskARN      = "arn:aws:ecs:us-east-1:012345678910:task/default/fargate"
+	bridgeTaskARN       = "arn:aws:ecs:us-east-1:012345678910:task/default/bridge"
+	stoppedTaskARN      = "arn:aws:ecs:us-east-1:012345678910:task/default/stopped"
+	fargateDefinition   = "arn:aws:ecs:us-east-1:012345678910:task-definition/web:2"
+	bridgeDefinition    = "arn:aws:ecs:us-east-1:012345678910:task-definition/redis:7"
+	containerInstance   = "arn:aws:ecs:us-east-1:012345678910:container-instance/default/ci1"
+	clusterARN          = "arn:aws:ecs:us-east-1:012345678910:cluster/default"
+	containerInstanceID = "i-0123456789abcdef0"
+)
+
+func newRequest(data interface{}) *awssdk.Request {
+	httpReq, _ := http.NewRequest("", "", nil)
+	return &awssdk.Request{Data: data, HTTPRequest: httpReq, Operation: &awssdk.Operation{}}
+}
+
+func (m *mockECSClient) ListTasksRequest(input *ecs.ListTasksInput) ecs.ListTasksRequest {
+	return ecs.ListTasksRequest{
+		Input: input,
+		Copy:  m.ListTasksRequest,
+		Request: newRequest(&ecs.ListTasksOutput{
+			TaskArns: []string{fargateTaskARN, bridgeTaskARN, stoppedTaskARN},
+		}),
+	}
+}
+
+func (m *mockECSClient) DescribeTasksRequest(input *ecs.DescribeTasksInput) ecs.DescribeTasksRequest {
+	running := statusRunning
+	stopped := "STOPPED"
+	name := func(s string) *string { return &s }
+	port := func(p int64) *int64 { return &p }
+	return ecs.DescribeTasksRequest{
+		Request: newRequest(&ecs.DescribeTasksOutput{
+			Tasks: []ecs.Task{
+				{
+					TaskArn:           &fargateTaskARN,
+					ClusterArn:        &clusterARN,
+					TaskDefinitionArn: &fargateDefinition,
+					LaunchType:        ecs.LaunchTypeFargate,
+					LastStatus:        &running,
+					Containers: []ecs.Container{
+						{
+							Name:              name("nginx"),
+							LastStatus:        &running,
+							NetworkInterfaces: []ecs.NetworkInterface{{PrivateIpv4Address: name("10.0.1.12")}},
+						},
+						{Name: name("init"), LastStatus: &stopped},
+					},
+				},
+				{
+					TaskArn:              &bridgeTaskARN,
+					ClusterArn:           &clusterARN,
+					TaskDefinitionArn:    &bridgeDefinition,
+					ContainerInstanceArn: &containerInstance,
+					LaunchType:           ecs.LaunchTypeEc2,
+					LastStatus:           &running,
+					Containers: []ecs.Container{
+						{
+							Name:            name("redis"),
+							LastStatus:      &running,
+							NetworkBindings: []ecs.NetworkBinding{{ContainerPort: port(6379), HostPort: port(32768)}},
+						},
+					},
+				},
+				{TaskArn: &stoppedTaskARN, LastStatus: &stopped},
+			},
+		}),
+	}
+}
+
+func (m *mockECSClient) DescribeTaskDefinitionRequest(input *ecs.DescribeTaskDefinitionInput) ecs.DescribeTaskDefinitionRequest {
+	m.describedDefinitions++
+	name := func(s string) *string { return &s }
+	port := func(p int64) *int64 { return &p }
+	revision := func(r int64) *int64 { return &r }
+
+	definition := &ecs.TaskDefinition{
+		TaskDefinitionArn: input.TaskDefinition,
+		Family:            name("web"),
+		Revision:          revision(2),
+		NetworkMode:       ecs.NetworkModeAwsvpc,
+		ContainerDefinitions: []ecs.ContainerDefinition{
+			{
+				Name:         name("nginx"),
+				Image:        name("nginx:1.19"),
+				DockerLabels: map[string]string{"co.elastic.metrics/module": "nginx"},
+				PortMappings: []ecs.PortMapping{{ContainerPort: port(80)}, {ContainerPort: port(8080)}},
+			},
+			{Name: name("init"), Image: name("busybox")},
+		},
+	}
+	if *input.TaskDefinition == bridgeDefinition {
+		definition = &ecs.TaskDefinition{
+			TaskDefinitionArn: input.TaskDefinition,
+			Family:            name("redis"),
+			Revision:          revision(7),
+			NetworkMode:       ecs.NetworkModeBridge,
+			ContainerDefinitions: []ecs.ContainerDefinition{
+				{Name: name("redis"), Image: name("redis:6")},
+			},
+		}
+	}
+	return ecs.DescribeTaskDefinitionRequest{
+		Request: newRequest(&ecs.DescribeTaskDefinitionOutput{TaskDefinition: definition}),
+	}
+}
+
+func (m *mockECSClient) DescribeContainerInstancesRequest(input *ecs.DescribeContainerInstancesInput) ecs.DescribeContainerInstancesRequest {
+	return ecs.DescribeContainerInstancesRequest{
+		Request: newRequest(&ecs.DescribeContainerInstancesOutput{
+			ContainerInstances: []ecs.ContainerInstance{{
+				ContainerInstanceArn: &containerInstance,
+				Ec2InstanceId:        &containerInstanceID,
+			}},
+		}),
+	}
+}
+
+type mockEC2Client struct {
+	ec2iface.ClientAPI
+}
+
+func (m *mockEC2Client) DescribeInstancesRequest(input *ec2.DescribeInstancesInput) ec2.DescribeInstancesRequest {
+	ip := "10.0.2.5"
+	return ec2.DescribeInstancesRequest{
+		Request: newRequest(&ec2.DescribeInstancesOutput{
+			Reservations: []ec2.Reservation{{
+				Instances: []ec2.Instance{{InstanceId: &input.InstanceIds[0], PrivateIpAddress: &ip}},
+			}},
+		}),
+	}
+}
+
+func (f *mockFetcher) setError(err error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.containers = []*ecsContainer{}
+	f.err = err
+}
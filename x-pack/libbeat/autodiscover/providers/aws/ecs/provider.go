@@ -0,0 +1,203 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("aws_ecs", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for the containers of ECS tasks.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates *template.Mapper
+	watcher   *watcher
+	uuid      uuid.UUID
+}
+
+// AutodiscoverBuilder is the main builder for this provider.
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("aws_ecs autodiscover is experimental")
+
+	config := defaultConfig()
+	err := c.Unpack(&config)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Source == sourceMetadata {
+		return internalBuilder(uuid, bus, config, newMetadataFetcher(config.MetadataURL), keystore)
+	}
+
+	awsCfg, err := awscommon.GetAWSCredentials(config.AWSConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "error loading AWS config for aws_ecs autodiscover provider")
+	}
+	if config.Region != "" {
+		awsCfg.Region = config.Region
+	}
+	if awsCfg.Region == "" {
+		return nil, fmt.Errorf("region is required for aws_ecs autodiscover provider")
+	}
+
+	client := ecs.New(awscommon.EnrichAWSConfigWithEndpoint(
+		config.AWSConfig.Endpoint, "ecs", awsCfg.Region, awsCfg))
+	ec2Client := ec2.New(awscommon.EnrichAWSConfigWithEndpoint(
+		config.AWSConfig.Endpoint, "ec2", awsCfg.Region, awsCfg))
+
+	return internalBuilder(uuid, bus, config, newAPIFetcher(client, ec2Client, config.Clusters), keystore)
+}
+
+// internalBuilder is mainly intended for testing via mocks and stubs.
+// it can be configured to use a fetcher that doesn't actually hit the AWS API.
+func internalBuilder(uuid uuid.UUID, bus bus.Bus, config *Config, fetcher fetcher, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		builders:  builders,
+		appenders: appenders,
+		templates: &mapper,
+		uuid:      uuid,
+	}
+
+	p.watcher = newWatcher(
+		fetcher,
+		config.Period,
+		p.onWatcherStart,
+		p.onWatcherStop,
+	)
+
+	return p, nil
+}
+
+// Start the autodiscover process.
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process.
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+// onWatcherStart emits an event for each port of the container, or a single
+// one if the container has no ports.
+func (p *Provider) onWatcherStart(key string, container *ecsContainer) {
+	e := bus.Event{
+		"start":    true,
+		"provider": p.uuid,
+		"id":       key,
+		"host":     container.Host,
+		"aws": common.MapStr{
+			"ecs": container.toMap(),
+		},
+		"container": container.toContainerMap(),
+		"cloud":     container.toCloudMap(),
+		"meta": common.MapStr{
+			"aws": common.MapStr{
+				"ecs": container.toMap(),
+			},
+			"container": container.toContainerMap(),
+			"cloud":     container.toCloudMap(),
+		},
+	}
+
+	if len(container.Ports) == 0 {
+		p.publish(e)
+		return
+	}
+	for _, port := range container.Ports {
+		portEvent := bus.Event{}
+		for k, v := range e {
+			portEvent[k] = v
+		}
+		portEvent["port"] = port
+		p.publish(portEvent)
+	}
+}
+
+func (p *Provider) onWatcherStop(key string) {
+	e := bus.Event{
+		"stop":     true,
+		"id":       key,
+		"provider": p.uuid,
+	}
+	p.bus.Publish(e)
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "port", "container"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	if labels, err := common.MapStr(event).GetValue("container.labels"); err == nil {
+		e["hints"] = builder.GenerateHints(labels.(common.MapStr), "", p.config.Prefix)
+	}
+	return e
+}
+
+func (p *Provider) String() string {
+	return "aws_ecs"
+}
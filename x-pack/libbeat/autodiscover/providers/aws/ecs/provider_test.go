@@ -0,0 +1,187 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/aws/test"
+)
+
+func Test_internalBuilder(t *testing.T) {
+	container := fakeContainer()
+	fetcher := newMockFetcher([]*ecsContainer{container}, nil)
+	log := logp.NewLogger("ecs")
+	pBus := bus.New(log, "test")
+
+	cfg := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"period": "1ns",
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{
+				"equals": map[string]interface{}{"aws.ecs.task.family": "redis"},
+			},
+			"config": []map[string]interface{}{{"type": "log"}},
+		}},
+	}).Unpack(cfg)
+	require.NoError(t, err)
+
+	uuid, _ := uuid.NewV4()
+	provider, err := internalBuilder(uuid, pBus, cfg, fetcher, nil)
+	require.NoError(t, err)
+
+	startListener := pBus.Subscribe("start")
+	stopListener := pBus.Subscribe("stop")
+	listenerDone := make(chan struct{})
+	defer close(listenerDone)
+
+	var events test.TestEventAccumulator
+	go func() {
+		for {
+			select {
+			case e := <-startListener.Events():
+				events.Add(e)
+			case e := <-stopListener.Events():
+				events.Add(e)
+			case <-listenerDone:
+				return
+			}
+		}
+	}()
+
+	// Let run twice to ensure that duplicates don't create two start events
+	// Since we're turning a list of assets into a list of changes the second once() call should be a noop
+	provider.watcher.once()
+	provider.watcher.once()
+	events.WaitForNumEvents(t, 1, time.Second)
+
+	assert.Equal(t, 1, events.Len())
+
+	expectedMeta := common.MapStr{
+		"aws": common.MapStr{
+			"ecs": common.MapStr{
+				"cluster": common.MapStr{
+					"arn":  "arn:aws:ecs:us-east-1:012345678910:cluster/default",
+					"name": "default",
+				},
+				"task": common.MapStr{
+					"arn":            "arn:aws:ecs:us-east-1:012345678910:task/default/a1b2c3",
+					"family":         "redis",
+					"revision":       int64(3),
+					"launch_type":    "FARGATE",
+					"definition_arn": "arn:aws:ecs:us-east-1:012345678910:task-definition/redis:3",
+				},
+				"container": common.MapStr{
+					"name": "redis",
+					"arn":  "arn:aws:ecs:us-east-1:012345678910:container/d4e5f6",
+				},
+			},
+		},
+		"container": common.MapStr{
+			"name":  "redis",
+			"image": common.MapStr{"name": "redis:6"},
+			"labels": common.MapStr{
+				"co": common.MapStr{"elastic": common.MapStr{"metrics/module": "redis", "metrics/hosts": "${data.host}:${data.port}"}},
+			},
+		},
+		"cloud": common.MapStr{
+			"provider": "aws",
+			"region":   "us-east-1",
+		},
+	}
+
+	expectedStartEvent := bus.Event{
+		"id":        container.key(),
+		"provider":  uuid,
+		"start":     true,
+		"host":      "10.0.1.12",
+		"port":      int64(6379),
+		"aws":       expectedMeta["aws"],
+		"container": expectedMeta["container"],
+		"cloud":     expectedMeta["cloud"],
+		"meta":      expectedMeta,
+	}
+	startEvent := events.Get()[0]
+	assert.NotEmpty(t, startEvent["config"])
+	delete(startEvent, "config")
+	require.Equal(t, expectedStartEvent, startEvent)
+
+	fetcher.setContainers([]*ecsContainer{})
+
+	// Let run twice to ensure that duplicates don't cause an issue
+	provider.watcher.once()
+	provider.watcher.once()
+	events.WaitForNumEvents(t, 2, time.Second)
+
+	require.Equal(t, 2, events.Len())
+
+	expectedStopEvent := bus.Event{
+		"stop":     true,
+		"id":       container.key(),
+		"provider": uuid,
+	}
+
+	require.Equal(t, expectedStopEvent, events.Get()[1])
+
+	// Test that in an error situation nothing changes.
+	preErrorEventCount := events.Len()
+	fetcher.setError(assert.AnError)
+	provider.watcher.once()
+	time.Sleep(time.Millisecond)
+	require.Equal(t, preErrorEventCount, events.Len())
+}
+
+func TestGenerateHints(t *testing.T) {
+	cfg := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"templates": []map[string]interface{}{{
+			"condition": map[string]interface{}{
+				"equals": map[string]interface{}{"aws.ecs.task.family": "web"},
+			},
+			"config": []map[string]interface{}{{"type": "log"}},
+		}},
+	}).Unpack(cfg)
+	require.NoError(t, err)
+
+	p, err := internalBuilder(uuid.Nil, bus.New(logp.L(), "test"), cfg, newMockFetcher(nil, nil), nil)
+	require.NoError(t, err)
+
+	container := fakeContainer()
+	event := bus.Event{
+		"host":      container.Host,
+		"port":      container.Ports[0],
+		"container": container.toContainerMap(),
+	}
+	hints := p.generateHints(event)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "redis",
+			"hosts":  "${data.host}:${data.port}",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.1.12", hints["host"])
+	assert.Equal(t, int64(6379), hints["port"])
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.Source = sourceMetadata
+	cfg.MetadataURL = ""
+	assert.Error(t, cfg.Validate())
+
+	cfg.MetadataURL = "http://169.254.170.2/v4/abc"
+	assert.NoError(t, cfg.Validate())
+
+	cfg.Source = "docker"
+	assert.Error(t, cfg.Validate())
+}
@@ -0,0 +1,111 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package ecs
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+type watcher struct {
+	// gen tracks changes we increment the 'generation' of each entry in the map.
+	gen        uint64
+	fetcher    fetcher
+	onStart    func(key string, container *ecsContainer)
+	onStop     func(key string)
+	done       chan struct{}
+	ticker     *time.Ticker
+	period     time.Duration
+	containers map[string]uint64
+	logger     *logp.Logger
+}
+
+func newWatcher(
+	fetcher fetcher,
+	period time.Duration,
+	onStart func(key string, container *ecsContainer),
+	onStop func(key string)) *watcher {
+	return &watcher{
+		fetcher:    fetcher,
+		onStart:    onStart,
+		onStop:     onStop,
+		done:       make(chan struct{}),
+		ticker:     time.NewTicker(period),
+		period:     period,
+		containers: map[string]uint64{},
+		logger:     logp.NewLogger("autodiscover-ecs-watcher"),
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	close(w.done)
+}
+
+func (w *watcher) forever() {
+	// Discover the containers on start, without waiting for a period.
+	if err := w.once(); err != nil {
+		w.logger.Error(errors.Wrap(err, "error while fetching ECS containers"))
+	}
+
+	for {
+		select {
+		case <-w.done:
+			w.ticker.Stop()
+			return
+		case <-w.ticker.C:
+			err := w.once()
+			if err != nil {
+				w.logger.Error(errors.Wrap(err, "error while fetching ECS containers"))
+			}
+		}
+	}
+}
+
+// once executes the watch loop a single time.
+// This is mostly useful for testing.
+func (w *watcher) once() error {
+	ctx, cancelCtx := context.WithTimeout(context.Background(), w.period)
+	defer cancelCtx() // Always cancel to avoid leak
+
+	fetchedContainers, err := w.fetcher.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	w.logger.Debugf("fetched %d ECS containers for autodiscover", len(fetchedContainers))
+
+	oldGen := w.gen
+	w.gen++
+
+	// Increment the generation of all containers returned by the fetcher
+	for _, container := range fetchedContainers {
+		key := container.key()
+		if _, exists := w.containers[key]; !exists {
+			if w.onStart != nil {
+				w.onStart(key, container)
+			}
+		}
+		w.containers[key] = w.gen
+	}
+
+	// Containers not seen in the last fetch get deleted
+	for key, entryGen := range w.containers {
+		if entryGen == oldGen {
+			if w.onStop != nil {
+				w.onStop(key)
+			}
+			delete(w.containers, key)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,192 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package vmss
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-09-01/network"
+	"github.com/Azure/go-autorest/autorest/azure/auth"
+	"github.com/Azure/go-autorest/autorest/to"
+)
+
+// instance is a virtual machine of a scale set.
+type instance struct {
+	ID             string
+	VMID           string
+	InstanceID     string
+	Name           string
+	ComputerName   string
+	Size           string
+	PrivateIP      string
+	Zones          []string
+	Tags           map[string]string
+	ScaleSet       string
+	ResourceGroup  string
+	SubscriptionID string
+	Location       string
+}
+
+// client lists the resources of the Azure API used by the provider.
+type client interface {
+	// scaleSets lists the scale sets of a resource group, or of the whole
+	// subscription if the resource group is empty.
+	scaleSets(ctx context.Context, resourceGroup string) ([]compute.VirtualMachineScaleSet, error)
+	// virtualMachines lists the virtual machines of a scale set.
+	virtualMachines(ctx context.Context, resourceGroup, scaleSet string) ([]compute.VirtualMachineScaleSetVM, error)
+	// networkInterfaces lists the network interfaces of the virtual machines
+	// of a scale set.
+	networkInterfaces(ctx context.Context, resourceGroup, scaleSet string) ([]network.Interface, error)
+}
+
+// apiClient is a client of the Azure Resource Manager API.
+type apiClient struct {
+	scaleSetsClient  compute.VirtualMachineScaleSetsClient
+	vmsClient        compute.VirtualMachineScaleSetVMsClient
+	interfacesClient network.InterfacesClient
+}
+
+func newAPIClient(config *Config) (*apiClient, error) {
+	clientConfig := auth.NewClientCredentialsConfig(config.ClientID, config.ClientSecret, config.TenantID)
+	authorizer, err := clientConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+
+	c := &apiClient{
+		scaleSetsClient:  compute.NewVirtualMachineScaleSetsClient(config.SubscriptionID),
+		vmsClient:        compute.NewVirtualMachineScaleSetVMsClient(config.SubscriptionID),
+		interfacesClient: network.NewInterfacesClient(config.SubscriptionID),
+	}
+	c.scaleSetsClient.Authorizer = authorizer
+	c.vmsClient.Authorizer = authorizer
+	c.interfacesClient.Authorizer = authorizer
+	return c, nil
+}
+
+func (c *apiClient) scaleSets(ctx context.Context, resourceGroup string) ([]compute.VirtualMachineScaleSet, error) {
+	var result []compute.VirtualMachineScaleSet
+	if resourceGroup == "" {
+		iter, err := c.scaleSetsClient.ListAllComplete(ctx)
+		for err == nil && iter.NotDone() {
+			result = append(result, iter.Value())
+			err = iter.NextWithContext(ctx)
+		}
+		return result, err
+	}
+
+	iter, err := c.scaleSetsClient.ListComplete(ctx, resourceGroup)
+	for err == nil && iter.NotDone() {
+		result = append(result, iter.Value())
+		err = iter.NextWithContext(ctx)
+	}
+	return result, err
+}
+
+func (c *apiClient) virtualMachines(ctx context.Context, resourceGroup, scaleSet string) ([]compute.VirtualMachineScaleSetVM, error) {
+	var result []compute.VirtualMachineScaleSetVM
+	iter, err := c.vmsClient.ListComplete(ctx, resourceGroup, scaleSet, "", "", "")
+	for err == nil && iter.NotDone() {
+		result = append(result, iter.Value())
+		err = iter.NextWithContext(ctx)
+	}
+	return result, err
+}
+
+func (c *apiClient) networkInterfaces(ctx context.Context, resourceGroup, scaleSet string) ([]network.Interface, error) {
+	var result []network.Interface
+	iter, err := c.interfacesClient.ListVirtualMachineScaleSetNetworkInterfacesComplete(ctx, resourceGroup, scaleSet)
+	for err == nil && iter.NotDone() {
+		result = append(result, iter.Value())
+		err = iter.NextWithContext(ctx)
+	}
+	return result, err
+}
+
+// newInstance builds an instance from a virtual machine of a scale set. Tags
+// of the virtual machine override the ones of the scale set.
+func newInstance(subscriptionID string, set compute.VirtualMachineScaleSet, vm compute.VirtualMachineScaleSetVM, privateIP string) *instance {
+	i := &instance{
+		ID:             to.String(vm.ID),
+		InstanceID:     to.String(vm.InstanceID),
+		Name:           to.String(vm.Name),
+		PrivateIP:      privateIP,
+		Tags:           map[string]string{},
+		ScaleSet:       to.String(set.Name),
+		ResourceGroup:  resourceGroupFromID(to.String(set.ID)),
+		SubscriptionID: subscriptionID,
+		Location:       to.String(vm.Location),
+	}
+	if vm.Zones != nil {
+		i.Zones = *vm.Zones
+	}
+	if vm.Sku != nil {
+		i.Size = to.String(vm.Sku.Name)
+	}
+	if props := vm.VirtualMachineScaleSetVMProperties; props != nil {
+		i.VMID = to.String(props.VMID)
+		if props.OsProfile != nil {
+			i.ComputerName = to.String(props.OsProfile.ComputerName)
+		}
+		if props.HardwareProfile != nil && props.HardwareProfile.VMSize != "" {
+			i.Size = string(props.HardwareProfile.VMSize)
+		}
+	}
+	for k, v := range set.Tags {
+		i.Tags[k] = to.String(v)
+	}
+	for k, v := range vm.Tags {
+		i.Tags[k] = to.String(v)
+	}
+	return i
+}
+
+// isDeleting returns true if the virtual machine is being deleted.
+func isDeleting(vm compute.VirtualMachineScaleSetVM) bool {
+	props := vm.VirtualMachineScaleSetVMProperties
+	return props != nil && strings.EqualFold(to.String(props.ProvisioningState), "Deleting")
+}
+
+// privateIPs maps the IDs of the virtual machines of a set of network
+// interfaces to their primary private IP. IDs are lower cased, as the API
+// doesn't keep the case of resource IDs consistently.
+func privateIPs(interfaces []network.Interface) map[string]string {
+	ips := map[string]string{}
+	for _, nic := range interfaces {
+		props := nic.InterfacePropertiesFormat
+		if props == nil || props.VirtualMachine == nil || props.IPConfigurations == nil {
+			continue
+		}
+		vmID := strings.ToLower(to.String(props.VirtualMachine.ID))
+		if _, found := ips[vmID]; found && !to.Bool(props.Primary) {
+			continue
+		}
+		for _, ipConfig := range *props.IPConfigurations {
+			ipProps := ipConfig.InterfaceIPConfigurationPropertiesFormat
+			if ipProps == nil || to.String(ipProps.PrivateIPAddress) == "" {
+				continue
+			}
+			ips[vmID] = to.String(ipProps.PrivateIPAddress)
+			if to.Bool(ipProps.Primary) {
+				break
+			}
+		}
+	}
+	return ips
+}
+
+// resourceGroupFromID returns the resource group of a resource ID, as in
+// /subscriptions/<id>/resourceGroups/<group>/providers/...
+func resourceGroupFromID(id string) string {
+	parts := strings.Split(id, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if strings.EqualFold(parts[i], "resourceGroups") {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
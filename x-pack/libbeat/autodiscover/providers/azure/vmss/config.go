@@ -0,0 +1,42 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package vmss
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Config for the azure_vmss autodiscover provider.
+type Config struct {
+	ClientID       string `config:"client_id" validate:"required"`
+	ClientSecret   string `config:"client_secret" validate:"required"`
+	TenantID       string `config:"tenant_id" validate:"required"`
+	SubscriptionID string `config:"subscription_id" validate:"required"`
+
+	// ResourceGroups limits the discovered scale sets to the ones in these
+	// resource groups, all the resource groups of the subscription are used
+	// by default.
+	ResourceGroups []string `config:"resource_groups"`
+	// ScaleSets limits the discovered scale sets to the ones with these names.
+	ScaleSets []string `config:"scale_sets"`
+
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Period: time.Minute,
+		Prefix: "co.elastic",
+	}
+}
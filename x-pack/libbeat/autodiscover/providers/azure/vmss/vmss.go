@@ -0,0 +1,243 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package vmss
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("azure_vmss", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for virtual machines of Azure
+// virtual machine scale sets.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("azure_vmss autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up azure_vmss autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	client, err := newAPIClient(config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	p, err := internalBuilder(bus, uuid, config, client, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client client, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover.azure_vmss"),
+	}
+	p.watcher = newWatcher(client, config.SubscriptionID, config.ResourceGroups, config.ScaleSets, config.Period, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return "azure_vmss"
+}
+
+func (p *Provider) onStart(i *instance) {
+	p.publish(p.instanceEvent(i, "start"), i)
+}
+
+func (p *Provider) onStop(i *instance) {
+	p.publish(p.instanceEvent(i, "stop"), i)
+}
+
+func (p *Provider) instanceEvent(i *instance, flag string) bus.Event {
+	meta := instanceMetadata(i)
+	cloud := cloudMetadata(i)
+	return bus.Event{
+		"provider": p.uuid,
+		"id":       i.ID,
+		flag:       true,
+		"host":     i.PrivateIP,
+		"azure":    meta,
+		"cloud":    cloud,
+		"meta": common.MapStr{
+			"azure": meta,
+			"cloud": cloud,
+		},
+	}
+}
+
+func instanceMetadata(i *instance) common.MapStr {
+	meta := common.MapStr{
+		"subscription_id": i.SubscriptionID,
+		"resource_group":  i.ResourceGroup,
+		"vmss": common.MapStr{
+			"name": i.ScaleSet,
+		},
+		"vm": common.MapStr{
+			"name":        i.Name,
+			"instance_id": i.InstanceID,
+			"private_ip":  i.PrivateIP,
+		},
+	}
+	if i.VMID != "" {
+		meta.Put("vm.id", i.VMID)
+	}
+	if i.ComputerName != "" {
+		meta.Put("vm.computer_name", i.ComputerName)
+	}
+	if i.Size != "" {
+		meta.Put("vm.size", i.Size)
+	}
+	if len(i.Tags) > 0 {
+		// Tag names can contain dots, they are replaced to avoid conflicts
+		// between tags like `co.elastic.metrics.module`.
+		tags := common.MapStr{}
+		for k, v := range i.Tags {
+			tags[common.DeDot(k)] = v
+		}
+		meta.Put("vm.tags", tags)
+	}
+	return meta
+}
+
+func cloudMetadata(i *instance) common.MapStr {
+	cloud := common.MapStr{
+		"provider": "azure",
+		"account": common.MapStr{
+			"id": i.SubscriptionID,
+		},
+		"instance": common.MapStr{
+			"name": i.Name,
+		},
+	}
+	if i.VMID != "" {
+		cloud.Put("instance.id", i.VMID)
+	}
+	if i.Size != "" {
+		cloud.Put("machine.type", i.Size)
+	}
+	if i.Location != "" {
+		cloud.Put("region", i.Location)
+	}
+	if len(i.Zones) > 0 {
+		cloud.Put("availability_zone", i.Zones[0])
+	}
+	return cloud
+}
+
+func (p *Provider) publish(event bus.Event, i *instance) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event, i)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event, i *instance) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "azure", "cloud"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+	e["hints"] = builder.GenerateHints(hintAnnotations(i.Tags, p.config.Prefix), "", p.config.Prefix)
+	return e
+}
+
+// hintAnnotations maps the tags of a virtual machine into the namespace of
+// hints. Tag names cannot contain slashes, so hints are written with a dot
+// between the hint type and the hint, like `co.elastic.metrics.module`.
+func hintAnnotations(tags map[string]string, prefix string) common.MapStr {
+	annotations := common.MapStr{}
+	for key, value := range tags {
+		if !strings.HasPrefix(key, prefix+".") {
+			continue
+		}
+		hint := strings.SplitN(strings.TrimPrefix(key, prefix+"."), ".", 2)
+		if len(hint) != 2 || hint[0] == "" || hint[1] == "" {
+			continue
+		}
+		safemapstr.Put(annotations, prefix+"."+hint[0]+"/"+hint[1], value)
+	}
+	return annotations
+}
@@ -0,0 +1,390 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package vmss
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2019-07-01/compute"
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2019-09-01/network"
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const testSubscription = "00000000-0000-0000-0000-000000000001"
+
+func scaleSetID(group, name string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachineScaleSets/%s", testSubscription, group, name)
+}
+
+func testScaleSet(group, name string, tags map[string]string) compute.VirtualMachineScaleSet {
+	set := compute.VirtualMachineScaleSet{
+		ID:   to.StringPtr(scaleSetID(group, name)),
+		Name: to.StringPtr(name),
+		Tags: map[string]*string{},
+	}
+	for k, v := range tags {
+		set.Tags[k] = to.StringPtr(v)
+	}
+	return set
+}
+
+func testVM(set compute.VirtualMachineScaleSet, instanceID string) compute.VirtualMachineScaleSetVM {
+	return compute.VirtualMachineScaleSetVM{
+		ID:         to.StringPtr(to.String(set.ID) + "/virtualMachines/" + instanceID),
+		Name:       to.StringPtr(to.String(set.Name) + "_" + instanceID),
+		InstanceID: to.StringPtr(instanceID),
+		Location:   to.StringPtr("westeurope"),
+		Zones:      &[]string{"1"},
+		Sku:        &compute.Sku{Name: to.StringPtr("Standard_D2s_v3")},
+		VirtualMachineScaleSetVMProperties: &compute.VirtualMachineScaleSetVMProperties{
+			VMID:              to.StringPtr("vm-" + to.String(set.Name) + "-" + instanceID),
+			OsProfile:         &compute.OSProfile{ComputerName: to.StringPtr(to.String(set.Name) + "00000" + instanceID)},
+			ProvisioningState: to.StringPtr("Succeeded"),
+		},
+	}
+}
+
+func testInterface(vm compute.VirtualMachineScaleSetVM, ips ...string) network.Interface {
+	var ipConfigs []network.InterfaceIPConfiguration
+	for n, ip := range ips {
+		ipConfigs = append(ipConfigs, network.InterfaceIPConfiguration{
+			InterfaceIPConfigurationPropertiesFormat: &network.InterfaceIPConfigurationPropertiesFormat{
+				PrivateIPAddress: to.StringPtr(ip),
+				Primary:          to.BoolPtr(n == len(ips)-1),
+			},
+		})
+	}
+	return network.Interface{
+		InterfacePropertiesFormat: &network.InterfacePropertiesFormat{
+			// Resource IDs of network interfaces don't keep the case.
+			VirtualMachine:   &network.SubResource{ID: to.StringPtr(strings.ToLower(to.String(vm.ID)))},
+			IPConfigurations: &ipConfigs,
+			Primary:          to.BoolPtr(true),
+		},
+	}
+}
+
+// mockClient is a client that returns the resources it has been set.
+type mockClient struct {
+	mu         sync.Mutex
+	sets       []compute.VirtualMachineScaleSet
+	vms        map[string][]compute.VirtualMachineScaleSetVM
+	interfaces map[string][]network.Interface
+	err        error
+}
+
+func newMockClient() *mockClient {
+	return &mockClient{
+		vms:        map[string][]compute.VirtualMachineScaleSetVM{},
+		interfaces: map[string][]network.Interface{},
+	}
+}
+
+// addVM adds a virtual machine with a private IP to a scale set.
+func (c *mockClient) addVM(set compute.VirtualMachineScaleSet, vm compute.VirtualMachineScaleSetVM, ip string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	found := false
+	for _, s := range c.sets {
+		found = found || to.String(s.ID) == to.String(set.ID)
+	}
+	if !found {
+		c.sets = append(c.sets, set)
+	}
+	c.vms[to.String(set.ID)] = append(c.vms[to.String(set.ID)], vm)
+	if ip != "" {
+		c.interfaces[to.String(set.ID)] = append(c.interfaces[to.String(set.ID)], testInterface(vm, ip))
+	}
+}
+
+func (c *mockClient) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sets = nil
+	c.vms = map[string][]compute.VirtualMachineScaleSetVM{}
+	c.interfaces = map[string][]network.Interface{}
+}
+
+func (c *mockClient) scaleSets(_ context.Context, resourceGroup string) ([]compute.VirtualMachineScaleSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	var sets []compute.VirtualMachineScaleSet
+	for _, set := range c.sets {
+		if resourceGroup == "" || resourceGroupFromID(to.String(set.ID)) == resourceGroup {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
+}
+
+func (c *mockClient) virtualMachines(_ context.Context, resourceGroup, scaleSet string) ([]compute.VirtualMachineScaleSetVM, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.vms[scaleSetID(resourceGroup, scaleSet)], nil
+}
+
+func (c *mockClient) networkInterfaces(_ context.Context, resourceGroup, scaleSet string) ([]network.Interface, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.interfaces[scaleSetID(resourceGroup, scaleSet)], nil
+}
+
+func TestResourceGroupFromID(t *testing.T) {
+	assert.Equal(t, "group-1", resourceGroupFromID(scaleSetID("group-1", "web")))
+	assert.Equal(t, "group-1", resourceGroupFromID("/subscriptions/1/resourcegroups/group-1/providers/x"))
+	assert.Equal(t, "", resourceGroupFromID("/subscriptions/1"))
+}
+
+func TestPrivateIPs(t *testing.T) {
+	set := testScaleSet("group-1", "web", nil)
+	vm0, vm1 := testVM(set, "0"), testVM(set, "1")
+
+	secondary := testInterface(vm1, "10.0.1.9")
+	secondary.Primary = to.BoolPtr(false)
+
+	ips := privateIPs([]network.Interface{
+		testInterface(vm0, "10.0.0.9", "10.0.0.4"),
+		secondary,
+		testInterface(vm1, "10.0.0.5"),
+		{},
+	})
+	assert.Equal(t, map[string]string{
+		strings.ToLower(to.String(vm0.ID)): "10.0.0.4",
+		strings.ToLower(to.String(vm1.ID)): "10.0.0.5",
+	}, ips)
+}
+
+func TestNewInstance(t *testing.T) {
+	set := testScaleSet("group-1", "web", map[string]string{"env": "prod", "team": "web"})
+	vm := testVM(set, "3")
+	vm.Tags = map[string]*string{"team": to.StringPtr("frontend")}
+
+	i := newInstance(testSubscription, set, vm, "10.0.0.7")
+	assert.Equal(t, &instance{
+		ID:             scaleSetID("group-1", "web") + "/virtualMachines/3",
+		VMID:           "vm-web-3",
+		InstanceID:     "3",
+		Name:           "web_3",
+		ComputerName:   "web000003",
+		Size:           "Standard_D2s_v3",
+		PrivateIP:      "10.0.0.7",
+		Zones:          []string{"1"},
+		Tags:           map[string]string{"env": "prod", "team": "frontend"},
+		ScaleSet:       "web",
+		ResourceGroup:  "group-1",
+		SubscriptionID: testSubscription,
+		Location:       "westeurope",
+	}, i)
+}
+
+func TestWatcher(t *testing.T) {
+	web := testScaleSet("group-1", "web", nil)
+	api := testScaleSet("group-2", "api", nil)
+	other := testScaleSet("group-2", "other", nil)
+
+	client := newMockClient()
+	client.addVM(web, testVM(web, "0"), "10.0.0.4")
+	client.addVM(web, testVM(web, "1"), "")
+	client.addVM(api, testVM(api, "0"), "10.0.1.4")
+	client.addVM(other, testVM(other, "0"), "10.0.2.4")
+
+	deleting := testVM(api, "1")
+	deleting.ProvisioningState = to.StringPtr("Deleting")
+	client.addVM(api, deleting, "10.0.1.5")
+
+	var started, stopped []string
+	w := newWatcher(client, testSubscription, nil, []string{"web", "api"}, time.Second,
+		func(i *instance) { started = append(started, i.Name) },
+		func(i *instance) { stopped = append(stopped, i.Name) },
+	)
+
+	// Virtual machines without IP or being deleted are not discovered.
+	require.NoError(t, w.once())
+	sort.Strings(started)
+	assert.Equal(t, []string{"api_0", "web_0"}, started)
+	assert.Empty(t, stopped)
+
+	// Discovered virtual machines are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 2)
+
+	// Updated virtual machines are stopped and started again.
+	client.reset()
+	updated := testVM(web, "0")
+	updated.Tags = map[string]*string{"co.elastic.metrics.module": to.StringPtr("nginx")}
+	client.addVM(web, updated, "10.0.0.4")
+	client.addVM(web, testVM(web, "1"), "10.0.0.5")
+	started = nil
+	require.NoError(t, w.once())
+	sort.Strings(started)
+	assert.Equal(t, []string{"web_0", "web_1"}, started)
+	sort.Strings(stopped)
+	assert.Equal(t, []string{"api_0", "web_0"}, stopped)
+
+	// Errors don't stop the discovered virtual machines.
+	client.err = fmt.Errorf("unavailable")
+	stopped = nil
+	assert.Error(t, w.once())
+	assert.Empty(t, stopped)
+	assert.Len(t, w.instances, 2)
+}
+
+func TestWatcherResourceGroups(t *testing.T) {
+	web := testScaleSet("group-1", "web", nil)
+	api := testScaleSet("group-2", "api", nil)
+
+	client := newMockClient()
+	client.addVM(web, testVM(web, "0"), "10.0.0.4")
+	client.addVM(api, testVM(api, "0"), "10.0.1.4")
+
+	var started []string
+	w := newWatcher(client, testSubscription, []string{"group-2"}, nil, time.Second,
+		func(i *instance) { started = append(started, i.Name) }, nil,
+	)
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{"api_0"}, started)
+}
+
+func TestInstanceEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	set := testScaleSet("group-1", "web", map[string]string{"co.elastic.metrics.module": "nginx"})
+	i := newInstance(testSubscription, set, testVM(set, "3"), "10.0.0.7")
+	event := p.instanceEvent(i, "start")
+	assert.Equal(t, i.ID, event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "10.0.0.7", event["host"])
+	assert.Equal(t, common.MapStr{
+		"subscription_id": testSubscription,
+		"resource_group":  "group-1",
+		"vmss": common.MapStr{
+			"name": "web",
+		},
+		"vm": common.MapStr{
+			"id":            "vm-web-3",
+			"name":          "web_3",
+			"instance_id":   "3",
+			"computer_name": "web000003",
+			"size":          "Standard_D2s_v3",
+			"private_ip":    "10.0.0.7",
+			"tags": common.MapStr{
+				"co_elastic_metrics_module": "nginx",
+			},
+		},
+	}, event["azure"])
+	assert.Equal(t, common.MapStr{
+		"provider":          "azure",
+		"account":           common.MapStr{"id": testSubscription},
+		"instance":          common.MapStr{"id": "vm-web-3", "name": "web_3"},
+		"machine":           common.MapStr{"type": "Standard_D2s_v3"},
+		"region":            "westeurope",
+		"availability_zone": "1",
+	}, event["cloud"])
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	set := testScaleSet("group-1", "web", map[string]string{
+		"co.elastic.metrics.module":        "nginx",
+		"co.elastic.metrics.hosts":         "${data.host}:8080",
+		"co.elastic.logs.json.message_key": "msg",
+		"env":                              "prod",
+	})
+	i := newInstance(testSubscription, set, testVM(set, "0"), "10.0.0.4")
+	hints := p.generateHints(p.instanceEvent(i, "start"), i)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "nginx",
+			"hosts":  "${data.host}:8080",
+		},
+		"logs": common.MapStr{
+			"json": common.MapStr{
+				"message_key": "msg",
+			},
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.0.4", hints["host"])
+	assert.Contains(t, hints, "azure")
+	assert.Contains(t, hints, "cloud")
+}
+
+func TestHintAnnotations(t *testing.T) {
+	annotations := hintAnnotations(map[string]string{
+		"co.elastic.metrics.period": "30s",
+		"co.elastic.invalid":        "x",
+		"co.elastic.":               "x",
+		"other.metrics.module":      "redis",
+	}, "co.elastic")
+	assert.Equal(t, common.MapStr{
+		"co": common.MapStr{"elastic": common.MapStr{
+			"metrics/period": "30s",
+		}},
+	}, annotations)
+}
+
+func TestPublish(t *testing.T) {
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+	p := newTestProvider(t, b)
+
+	set := testScaleSet("group-1", "web", nil)
+	i := newInstance(testSubscription, set, testVM(set, "0"), "10.0.0.4")
+	p.onStart(i)
+
+	event := providertest.Events(t, listener, 1)[0]
+	assert.Equal(t, true, event["start"])
+	assert.Len(t, event["config"], 1)
+}
+
+func TestConfigValidation(t *testing.T) {
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"client_id": "client",
+	}).Unpack(config)
+	assert.Error(t, err)
+
+	config = defaultConfig()
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"client_id":       "client",
+		"client_secret":   "secret",
+		"tenant_id":       "tenant",
+		"subscription_id": testSubscription,
+		"period":          "0s",
+	}).Unpack(config)
+	assert.Error(t, err)
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := defaultConfig()
+	providertest.Unpack(t, config, common.MapStr{
+		"client_id":       "client",
+		"client_secret":   "secret",
+		"tenant_id":       "tenant",
+		"subscription_id": testSubscription,
+	}, "azure.vmss.name", "web")
+
+	p, err := internalBuilder(providertest.Bus(b), uuid.Nil, config, newMockClient(), nil)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,179 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package vmss
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest/to"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// watcher polls the virtual machines of the scale sets of a subscription,
+// and notifies when they are added or removed. Virtual machines whose
+// metadata changes are notified as stopped and started again.
+type watcher struct {
+	client         client
+	subscriptionID string
+	resourceGroups []string
+	scaleSets      map[string]bool
+	period         time.Duration
+	onStart        func(i *instance)
+	onStop         func(i *instance)
+
+	instances map[string]*instance
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client client,
+	subscriptionID string,
+	resourceGroups, scaleSets []string,
+	period time.Duration,
+	onStart, onStop func(i *instance),
+) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watcher{
+		client:         client,
+		subscriptionID: subscriptionID,
+		resourceGroups: resourceGroups,
+		period:         period,
+		onStart:        onStart,
+		onStop:         onStop,
+		instances:      map[string]*instance{},
+		ctx:            ctx,
+		cancel:         cancel,
+		done:           make(chan struct{}),
+		logger:         logp.NewLogger("autodiscover.azure_vmss"),
+	}
+	if len(scaleSets) > 0 {
+		w.scaleSets = map[string]bool{}
+		for _, name := range scaleSets {
+			w.scaleSets[name] = true
+		}
+	}
+	return w
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *watcher) forever() {
+	defer close(w.done)
+	for {
+		err := w.once()
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error(errors.Wrap(err, "error while fetching Azure scale sets"))
+		}
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(w.period):
+		}
+	}
+}
+
+// once fetches the virtual machines and notifies the changes since the
+// previous fetch. This is mostly useful for testing.
+func (w *watcher) once() error {
+	ctx, cancel := context.WithTimeout(w.ctx, w.period)
+	defer cancel()
+
+	instances, err := w.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	w.logger.Debugf("fetched %d scale set instances from Azure for autodiscover", len(instances))
+
+	current := map[string]*instance{}
+	for _, i := range instances {
+		current[strings.ToLower(i.ID)] = i
+	}
+
+	for id, old := range w.instances {
+		if i, found := current[id]; !found || !reflect.DeepEqual(old, i) {
+			delete(w.instances, id)
+			if w.onStop != nil {
+				w.onStop(old)
+			}
+		}
+	}
+	for id, i := range current {
+		if _, known := w.instances[id]; known {
+			continue
+		}
+		w.instances[id] = i
+		if w.onStart != nil {
+			w.onStart(i)
+		}
+	}
+	return nil
+}
+
+// fetch lists the virtual machines of the watched scale sets. Virtual
+// machines are only listed once they have a private IP, and until they start
+// to be deleted.
+func (w *watcher) fetch(ctx context.Context) ([]*instance, error) {
+	groups := w.resourceGroups
+	if len(groups) == 0 {
+		groups = []string{""}
+	}
+
+	var instances []*instance
+	for _, group := range groups {
+		sets, err := w.client.scaleSets(ctx, group)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list scale sets of resource group '%s'", group)
+		}
+
+		for _, set := range sets {
+			name := to.String(set.Name)
+			if w.scaleSets != nil && !w.scaleSets[name] {
+				continue
+			}
+			setGroup := resourceGroupFromID(to.String(set.ID))
+
+			vms, err := w.client.virtualMachines(ctx, setGroup, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list virtual machines of scale set '%s'", name)
+			}
+			if len(vms) == 0 {
+				continue
+			}
+			interfaces, err := w.client.networkInterfaces(ctx, setGroup, name)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to list network interfaces of scale set '%s'", name)
+			}
+			ips := privateIPs(interfaces)
+
+			for _, vm := range vms {
+				ip := ips[strings.ToLower(to.String(vm.ID))]
+				if ip == "" || isDeleting(vm) {
+					continue
+				}
+				instances = append(instances, newInstance(w.subscriptionID, set, vm, ip))
+			}
+		}
+	}
+	return instances, nil
+}
@@ -0,0 +1,253 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cloudfoundry
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/cloudfoundry-community/go-cfclient"
+	"github.com/pkg/errors"
+)
+
+const stateStarted = "STARTED"
+
+// app is an application of Cloud Foundry, with its space and organization.
+type app struct {
+	GUID        string
+	Name        string
+	State       string
+	SpaceGUID   string
+	SpaceName   string
+	OrgGUID     string
+	OrgName     string
+	Labels      map[string]string
+	Annotations map[string]string
+	Routes      []string
+}
+
+// usageEvent is an app usage event, recorded by the Cloud Controller when the
+// processes of an application start or stop.
+type usageEvent struct {
+	GUID    string
+	AppGUID string
+	State   string
+}
+
+// client is the subset of the Cloud Controller API used by the watcher.
+type client interface {
+	// apps returns the started applications.
+	apps() ([]*app, error)
+	// app returns an application, or nil if it doesn't exist.
+	app(guid string) (*app, error)
+	// lastEvent returns the GUID of the last app usage event, or an empty
+	// string if there are none.
+	lastEvent() (string, error)
+	// eventsAfter returns the app usage events recorded after the given one.
+	eventsAfter(guid string) ([]usageEvent, error)
+}
+
+// apiClient requests the Cloud Controller API with the authenticated cfclient.
+// The v3 API is used for applications, as only it has their labels and
+// annotations. App usage events are only in the v2 API.
+type apiClient struct {
+	cf *cfclient.Client
+}
+
+type v3App struct {
+	GUID          string `json:"guid"`
+	Name          string `json:"name"`
+	State         string `json:"state"`
+	Relationships struct {
+		Space v3Relationship `json:"space"`
+	} `json:"relationships"`
+	Metadata struct {
+		Labels      map[string]string `json:"labels"`
+		Annotations map[string]string `json:"annotations"`
+	} `json:"metadata"`
+}
+
+type v3Relationship struct {
+	Data struct {
+		GUID string `json:"guid"`
+	} `json:"data"`
+}
+
+type v3Space struct {
+	GUID          string `json:"guid"`
+	Name          string `json:"name"`
+	Relationships struct {
+		Organization v3Relationship `json:"organization"`
+	} `json:"relationships"`
+}
+
+type v3Org struct {
+	GUID string `json:"guid"`
+	Name string `json:"name"`
+}
+
+type v3Included struct {
+	Spaces        []v3Space `json:"spaces"`
+	Organizations []v3Org   `json:"organizations"`
+}
+
+type v3Pagination struct {
+	Next *struct {
+		Href string `json:"href"`
+	} `json:"next"`
+}
+
+type v3Route struct {
+	URL string `json:"url"`
+}
+
+type v2UsageEvents struct {
+	NextURL   string `json:"next_url"`
+	Resources []struct {
+		Metadata struct {
+			GUID string `json:"guid"`
+		} `json:"metadata"`
+		Entity struct {
+			AppGUID string `json:"app_guid"`
+			State   string `json:"state"`
+		} `json:"entity"`
+	} `json:"resources"`
+}
+
+func (c *apiClient) apps() ([]*app, error) {
+	var apps []*app
+	path := "/v3/apps?states=" + stateStarted + "&include=space.organization&per_page=500"
+	for path != "" {
+		var page struct {
+			Pagination v3Pagination `json:"pagination"`
+			Resources  []v3App      `json:"resources"`
+			Included   v3Included   `json:"included"`
+		}
+		if err := c.get(path, &page); err != nil {
+			return nil, errors.Wrap(err, "error listing applications")
+		}
+		for _, resource := range page.Resources {
+			a := newApp(resource, page.Included)
+			if err := c.routes(a); err != nil {
+				return nil, err
+			}
+			apps = append(apps, a)
+		}
+
+		path = ""
+		if page.Pagination.Next != nil {
+			path = c.relative(page.Pagination.Next.Href)
+		}
+	}
+	return apps, nil
+}
+
+func (c *apiClient) app(guid string) (*app, error) {
+	var resource struct {
+		v3App
+		Included v3Included `json:"included"`
+	}
+	err := c.get("/v3/apps/"+url.PathEscape(guid)+"?include=space.organization", &resource)
+	if cfclient.IsResourceNotFoundError(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting application %s", guid)
+	}
+
+	a := newApp(resource.v3App, resource.Included)
+	if err := c.routes(a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// routes sets the URLs of the routes mapped to the application.
+func (c *apiClient) routes(a *app) error {
+	var page struct {
+		Resources []v3Route `json:"resources"`
+	}
+	if err := c.get("/v3/apps/"+url.PathEscape(a.GUID)+"/routes?per_page=500", &page); err != nil {
+		return errors.Wrapf(err, "error getting routes of application %s", a.GUID)
+	}
+	for _, route := range page.Resources {
+		a.Routes = append(a.Routes, route.URL)
+	}
+	return nil
+}
+
+func (c *apiClient) lastEvent() (string, error) {
+	var page v2UsageEvents
+	if err := c.get("/v2/app_usage_events?order-direction=desc&results-per-page=1", &page); err != nil {
+		return "", errors.Wrap(err, "error getting last app usage event")
+	}
+	if len(page.Resources) == 0 {
+		return "", nil
+	}
+	return page.Resources[0].Metadata.GUID, nil
+}
+
+func (c *apiClient) eventsAfter(guid string) ([]usageEvent, error) {
+	var events []usageEvent
+	query := url.Values{}
+	query.Set("after_guid", guid)
+	query.Set("results-per-page", "100")
+	path := "/v2/app_usage_events?" + query.Encode()
+	for path != "" {
+		var page v2UsageEvents
+		if err := c.get(path, &page); err != nil {
+			return nil, errors.Wrap(err, "error listing app usage events")
+		}
+		for _, resource := range page.Resources {
+			events = append(events, usageEvent{
+				GUID:    resource.Metadata.GUID,
+				AppGUID: resource.Entity.AppGUID,
+				State:   resource.Entity.State,
+			})
+		}
+		path = page.NextURL
+	}
+	return events, nil
+}
+
+func (c *apiClient) get(path string, out interface{}) error {
+	resp, err := c.cf.DoRequest(c.cf.NewRequest("GET", path))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// relative returns the path of the links of the v3 API, that are absolute
+// URLs.
+func (c *apiClient) relative(href string) string {
+	return strings.TrimPrefix(href, c.cf.Config.ApiAddress)
+}
+
+func newApp(resource v3App, included v3Included) *app {
+	a := &app{
+		GUID:        resource.GUID,
+		Name:        resource.Name,
+		State:       resource.State,
+		SpaceGUID:   resource.Relationships.Space.Data.GUID,
+		Labels:      resource.Metadata.Labels,
+		Annotations: resource.Metadata.Annotations,
+	}
+	for _, space := range included.Spaces {
+		if space.GUID != a.SpaceGUID {
+			continue
+		}
+		a.SpaceName = space.Name
+		a.OrgGUID = space.Relationships.Organization.Data.GUID
+	}
+	for _, org := range included.Organizations {
+		if org.GUID == a.OrgGUID {
+			a.OrgName = org.Name
+		}
+	}
+	return a
+}
@@ -0,0 +1,204 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cloudfoundry
+
+import (
+	"fmt"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/cloudfoundry"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("cloudfoundry", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for Cloud Foundry applications.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("cloudfoundry autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up cloudfoundry autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	log := logp.NewLogger("autodiscover.cloudfoundry")
+	hub := cloudfoundry.NewHub(&config.Config, "autodiscover", log)
+	cf, err := hub.ClientWithoutCache()
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	p, err := internalBuilder(bus, uuid, config, &apiClient{cf: cf}, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client client, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+	}
+	p.watcher = newWatcher(client, config.Period, config.RetryInterval, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return "cloudfoundry"
+}
+
+func (p *Provider) onStart(a *app) {
+	p.publish(p.appEvent(a, "start"))
+}
+
+func (p *Provider) onStop(a *app) {
+	p.publish(p.appEvent(a, "stop"))
+}
+
+func (p *Provider) appEvent(a *app, flag string) bus.Event {
+	labels := common.MapStr{}
+	for k, v := range a.Labels {
+		safemapstr.Put(labels, k, v)
+	}
+
+	meta := common.MapStr{
+		"app": common.MapStr{
+			"id":   a.GUID,
+			"name": a.Name,
+		},
+		"space": common.MapStr{
+			"id":   a.SpaceGUID,
+			"name": a.SpaceName,
+		},
+		"org": common.MapStr{
+			"id":   a.OrgGUID,
+			"name": a.OrgName,
+		},
+	}
+	if len(labels) > 0 {
+		meta.Put("app.labels", labels)
+	}
+
+	// Annotations and routes are available for templates and hints, but
+	// not added to the collected events.
+	data := meta.Clone()
+	if len(a.Annotations) > 0 {
+		annotations := common.MapStr{}
+		for k, v := range a.Annotations {
+			safemapstr.Put(annotations, k, v)
+		}
+		data.Put("app.annotations", annotations)
+	}
+	if len(a.Routes) > 0 {
+		data.Put("app.routes", a.Routes)
+	}
+
+	event := bus.Event{
+		"provider":     p.uuid,
+		"id":           a.GUID,
+		flag:           true,
+		"cloudfoundry": data,
+		"meta": common.MapStr{
+			"cloudfoundry": meta,
+		},
+	}
+	if len(a.Routes) > 0 {
+		event["host"] = a.Routes[0]
+	}
+	return event
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "cloudfoundry"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	if annotations, err := common.MapStr(event).GetValue("cloudfoundry.app.annotations"); err == nil {
+		e["hints"] = builder.GenerateHints(annotations.(common.MapStr), "", p.config.Prefix)
+	}
+	return e
+}
@@ -0,0 +1,352 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cloudfoundry
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cloudfoundry-community/go-cfclient"
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	testAppGUID   = "6e8a1c8b-3b4a-4c0e-9a55-64b6a55fdb1f"
+	testOtherGUID = "b7f0a3d2-90c1-4b8e-8d2f-3a4f0b1c2d3e"
+	testSpaceGUID = "0c1e3f5a-7b9d-4e2f-8a6c-1d3e5f7a9b0c"
+	testOrgGUID   = "9a8b7c6d-5e4f-4a3b-2c1d-0e9f8a7b6c5d"
+)
+
+// fakeCC serves the endpoints of the Cloud Controller API used by the
+// provider.
+type fakeCC struct {
+	mu     sync.Mutex
+	url    string
+	apps   map[string]*v3App
+	events []usageEvent
+}
+
+func newFakeCC() *fakeCC {
+	apps := map[string]*v3App{}
+	for _, guid := range []string{testAppGUID, testOtherGUID} {
+		a := &v3App{GUID: guid, Name: "app-" + guid[:4], State: stateStarted}
+		a.Relationships.Space.Data.GUID = testSpaceGUID
+		apps[guid] = a
+	}
+	apps[testAppGUID].Name = "shop"
+	apps[testAppGUID].Metadata.Labels = map[string]string{"team": "web"}
+	apps[testAppGUID].Metadata.Annotations = map[string]string{
+		"co.elastic.metrics/module":       "prometheus",
+		"co.elastic.metrics/hosts":        "https://${data.host}",
+		"co.elastic.metrics/metrics_path": "/actuator/prometheus",
+	}
+	return &fakeCC{
+		apps:   apps,
+		events: []usageEvent{{GUID: "event-0", AppGUID: testAppGUID, State: stateStarted}},
+	}
+}
+
+// setState changes the state of an application and records its usage event.
+func (f *fakeCC) setState(guid, state string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.apps[guid].State = state
+	f.events = append(f.events, usageEvent{
+		GUID:    "event-" + string(rune('0'+len(f.events))),
+		AppGUID: guid,
+		State:   state,
+	})
+}
+
+func (f *fakeCC) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	query := r.URL.Query()
+	var body interface{}
+	switch path := r.URL.Path; {
+	case path == "/v3/apps":
+		// Each page has one application.
+		var started []*v3App
+		for _, guid := range []string{testAppGUID, testOtherGUID} {
+			if f.apps[guid].State == query.Get("states") {
+				started = append(started, f.apps[guid])
+			}
+		}
+		page := map[string]interface{}{
+			"pagination": map[string]interface{}{"next": nil},
+			"resources":  started,
+			"included":   f.included(),
+		}
+		if len(started) > 1 {
+			if query.Get("page") == "2" {
+				page["resources"] = started[1:]
+			} else {
+				page["resources"] = started[:1]
+				page["pagination"] = map[string]interface{}{
+					"next": map[string]string{"href": f.url + "/v3/apps?states=STARTED&include=space.organization&page=2"},
+				}
+			}
+		}
+		body = page
+	case strings.HasSuffix(path, "/routes"):
+		guid := strings.TrimSuffix(strings.TrimPrefix(path, "/v3/apps/"), "/routes")
+		body = map[string]interface{}{"resources": []v3Route{{URL: f.apps[guid].Name + ".example.com"}}}
+	case strings.HasPrefix(path, "/v3/apps/"):
+		a, found := f.apps[strings.TrimPrefix(path, "/v3/apps/")]
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			body = map[string]interface{}{"errors": []map[string]interface{}{{"code": 10010, "title": "CF-ResourceNotFound", "detail": "App not found"}}}
+			break
+		}
+		body = struct {
+			*v3App
+			Included v3Included `json:"included"`
+		}{a, f.included()}
+	case path == "/v2/app_usage_events":
+		events := f.events
+		if query.Get("order-direction") == "desc" {
+			events = events[len(events)-1:]
+		}
+		if after := query.Get("after_guid"); after != "" {
+			events = nil
+			for i, event := range f.events {
+				if event.GUID == after {
+					events = f.events[i+1:]
+				}
+			}
+			if events == nil {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]interface{}{"code": 10005, "error_code": "CF-BadQueryParameter"})
+				return
+			}
+		}
+		var resources []interface{}
+		for _, event := range events {
+			resources = append(resources, map[string]interface{}{
+				"metadata": map[string]string{"guid": event.GUID},
+				"entity":   map[string]string{"app_guid": event.AppGUID, "state": event.State},
+			})
+		}
+		body = map[string]interface{}{"resources": resources}
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func (f *fakeCC) included() v3Included {
+	space := v3Space{GUID: testSpaceGUID, Name: "production"}
+	space.Relationships.Organization.Data.GUID = testOrgGUID
+	return v3Included{
+		Spaces:        []v3Space{space},
+		Organizations: []v3Org{{GUID: testOrgGUID, Name: "acme"}},
+	}
+}
+
+func newTestServer(t *testing.T) (*fakeCC, *apiClient, func()) {
+	fake := newFakeCC()
+	server := httptest.NewServer(fake)
+	fake.url = server.URL
+	cf := &cfclient.Client{Config: cfclient.Config{ApiAddress: server.URL, HttpClient: server.Client()}}
+	return fake, &apiClient{cf: cf}, server.Close
+}
+
+func TestAPIClient(t *testing.T) {
+	_, client, closer := newTestServer(t)
+	defer closer()
+
+	apps, err := client.apps()
+	require.NoError(t, err)
+	require.Len(t, apps, 2)
+	assert.Equal(t, &app{
+		GUID:        testAppGUID,
+		Name:        "shop",
+		State:       stateStarted,
+		SpaceGUID:   testSpaceGUID,
+		SpaceName:   "production",
+		OrgGUID:     testOrgGUID,
+		OrgName:     "acme",
+		Labels:      map[string]string{"team": "web"},
+		Annotations: apps[0].Annotations,
+		Routes:      []string{"shop.example.com"},
+	}, apps[0])
+	assert.Equal(t, testOtherGUID, apps[1].GUID)
+
+	a, err := client.app(testAppGUID)
+	require.NoError(t, err)
+	assert.Equal(t, apps[0], a)
+
+	a, err = client.app("unknown")
+	assert.NoError(t, err)
+	assert.Nil(t, a)
+
+	last, err := client.lastEvent()
+	require.NoError(t, err)
+	assert.Equal(t, "event-0", last)
+
+	events, err := client.eventsAfter("event-0")
+	require.NoError(t, err)
+	assert.Empty(t, events)
+}
+
+func TestWatcher(t *testing.T) {
+	fake, client, closer := newTestServer(t)
+	defer closer()
+
+	var started, stopped []string
+	w := newWatcher(client, time.Second, time.Second,
+		func(a *app) { started = append(started, a.GUID) },
+		func(a *app) { stopped = append(stopped, a.GUID) },
+	)
+
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{testAppGUID, testOtherGUID}, started)
+	assert.Empty(t, stopped)
+
+	// Started applications are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 2)
+
+	fake.setState(testOtherGUID, "STOPPED")
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{testOtherGUID}, stopped)
+	assert.Len(t, started, 2)
+	assert.NotContains(t, w.apps, testOtherGUID)
+
+	// Changed applications are stopped and started again.
+	fake.mu.Lock()
+	fake.apps[testAppGUID].Metadata.Labels = map[string]string{"team": "shop"}
+	fake.mu.Unlock()
+	fake.setState(testAppGUID, stateStarted)
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{testOtherGUID, testAppGUID}, stopped)
+	assert.Equal(t, []string{testAppGUID, testOtherGUID, testAppGUID}, started)
+
+	// Everything is listed again when the last event is not found.
+	fake.setState(testOtherGUID, stateStarted)
+	w.lastEvent = "purged"
+	assert.Error(t, w.once())
+	assert.False(t, w.synced)
+	require.NoError(t, w.once())
+	assert.True(t, w.synced)
+	assert.Equal(t, []string{testAppGUID, testOtherGUID, testAppGUID, testOtherGUID}, started)
+}
+
+func TestAppEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+	_, client, closer := newTestServer(t)
+	defer closer()
+
+	a, err := client.app(testAppGUID)
+	require.NoError(t, err)
+
+	event := p.appEvent(a, "start")
+	assert.Equal(t, testAppGUID, event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "shop.example.com", event["host"])
+
+	meta := common.MapStr{
+		"app":   common.MapStr{"id": testAppGUID, "name": "shop", "labels": common.MapStr{"team": "web"}},
+		"space": common.MapStr{"id": testSpaceGUID, "name": "production"},
+		"org":   common.MapStr{"id": testOrgGUID, "name": "acme"},
+	}
+	assert.Equal(t, common.MapStr{"cloudfoundry": meta}, event["meta"])
+
+	data := meta.Clone()
+	data.Put("app.routes", []string{"shop.example.com"})
+	data.Put("app.annotations", common.MapStr{"co": common.MapStr{"elastic": common.MapStr{
+		"metrics/module":       "prometheus",
+		"metrics/hosts":        "https://${data.host}",
+		"metrics/metrics_path": "/actuator/prometheus",
+	}}})
+	assert.Equal(t, data, event["cloudfoundry"])
+
+	hints := p.generateHints(event)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module":       "prometheus",
+			"hosts":        "https://${data.host}",
+			"metrics_path": "/actuator/prometheus",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "shop.example.com", hints["host"])
+}
+
+func TestProvider(t *testing.T) {
+	fake, client, closer := newTestServer(t)
+	defer closer()
+
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	p := newTestProvider(t, b)
+	p.watcher.client = client
+	require.NoError(t, p.watcher.once())
+	fake.setState(testAppGUID, "STOPPED")
+	require.NoError(t, p.watcher.once())
+
+	events := providertest.Events(t, listener, 3)
+
+	assert.Equal(t, testAppGUID, events[0]["id"])
+	assert.Equal(t, true, events[0]["start"])
+	assert.NotEmpty(t, events[0]["config"])
+	assert.Equal(t, testOtherGUID, events[1]["id"])
+	assert.Empty(t, events[1]["config"])
+	assert.Equal(t, testAppGUID, events[2]["id"])
+	assert.Equal(t, true, events[2]["stop"])
+	assert.NotEmpty(t, events[2]["config"])
+}
+
+func TestConfig(t *testing.T) {
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"client_id":     "id",
+		"client_secret": "secret",
+		"hints.enabled": true,
+	}).Unpack(&config)
+	assert.Error(t, err, "api_address is required")
+
+	config = defaultConfig()
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"api_address":   "https://api.example.com",
+		"client_id":     "id",
+		"client_secret": "secret",
+		"period":        "1m",
+	}).Unpack(&config)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, config.Period)
+	assert.Equal(t, 30*time.Second, config.RetryInterval)
+	assert.Equal(t, "co.elastic", config.Prefix)
+	assert.Equal(t, "id", config.ClientID)
+	assert.NotEmpty(t, config.ShardID)
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := defaultConfig()
+	providertest.Unpack(t, config, common.MapStr{
+		"api_address":   "https://api.example.com",
+		"client_id":     "id",
+		"client_secret": "secret",
+	}, "cloudfoundry.app.name", "shop")
+
+	p, err := internalBuilder(providertest.Bus(b), uuid.Nil, config, &apiClient{}, nil)
+	require.NoError(t, err)
+	return p
+}
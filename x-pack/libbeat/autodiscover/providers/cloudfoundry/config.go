@@ -0,0 +1,46 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cloudfoundry
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/x-pack/libbeat/common/cloudfoundry"
+)
+
+// Config for the cloudfoundry autodiscover provider.
+type Config struct {
+	cloudfoundry.Config `config:",inline"`
+
+	// Period is the time between the requests of the app usage events used
+	// to follow the lifecycle of the applications.
+	Period        time.Duration `config:"period" validate:"positive"`
+	RetryInterval time.Duration `config:"retry_interval" validate:"positive"`
+
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Period:        10 * time.Second,
+		RetryInterval: 30 * time.Second,
+		Prefix:        "co.elastic",
+	}
+}
+
+// Validate ensures correctness of config
+func (c *Config) Validate() error {
+	if c.APIAddress == "" {
+		return fmt.Errorf("api_address is required")
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package cloudfoundry
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// watcher follows the lifecycle of the applications with the app usage events
+// of the Cloud Controller, and notifies when applications start or stop.
+//
+// All the started applications are listed on the first run, and after any
+// error, as events could have been missed. Then the applications with new
+// usage events are requested again to know if they are still started, or if
+// they changed.
+type watcher struct {
+	client        client
+	period        time.Duration
+	retryInterval time.Duration
+	onStart       func(a *app)
+	onStop        func(a *app)
+
+	synced    bool
+	lastEvent string
+	apps      map[string]*app
+
+	done   chan struct{}
+	closed chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client client,
+	period, retryInterval time.Duration,
+	onStart, onStop func(a *app),
+) *watcher {
+	return &watcher{
+		client:        client,
+		period:        period,
+		retryInterval: retryInterval,
+		onStart:       onStart,
+		onStop:        onStop,
+		apps:          map[string]*app{},
+		done:          make(chan struct{}),
+		closed:        make(chan struct{}),
+		logger:        logp.NewLogger("autodiscover.cloudfoundry"),
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	close(w.done)
+	<-w.closed
+}
+
+func (w *watcher) forever() {
+	defer close(w.closed)
+	for {
+		wait := w.period
+		if err := w.once(); err != nil {
+			w.logger.Error(errors.Wrap(err, "error while watching Cloud Foundry applications"))
+			wait = w.retryInterval
+		}
+
+		select {
+		case <-w.done:
+			return
+		case <-time.After(wait):
+		}
+	}
+}
+
+// once updates the applications and notifies the changes.
+// This is mostly useful for testing.
+func (w *watcher) once() error {
+	var err error
+	if w.synced {
+		err = w.follow()
+	} else {
+		err = w.sync()
+	}
+	w.synced = err == nil
+	return err
+}
+
+// sync lists all the started applications.
+func (w *watcher) sync() error {
+	// The last event is requested before listing the applications, so
+	// changes made while listing them are not missed.
+	last, err := w.client.lastEvent()
+	if err != nil {
+		return err
+	}
+	apps, err := w.client.apps()
+	if err != nil {
+		return err
+	}
+	w.logger.Debugf("fetched %d applications from Cloud Foundry for autodiscover", len(apps))
+
+	started := map[string]bool{}
+	for _, a := range apps {
+		started[a.GUID] = true
+		w.update(a.GUID, a)
+	}
+	for guid := range w.apps {
+		if !started[guid] {
+			w.update(guid, nil)
+		}
+	}
+	w.lastEvent = last
+	return nil
+}
+
+// follow requests the applications with usage events since the last one.
+func (w *watcher) follow() error {
+	if w.lastEvent == "" {
+		// There were no events on the last sync, so there is nothing to
+		// follow yet.
+		return w.sync()
+	}
+
+	events, err := w.client.eventsAfter(w.lastEvent)
+	if err != nil {
+		return err
+	}
+
+	changed := map[string]bool{}
+	for _, event := range events {
+		w.lastEvent = event.GUID
+		if event.AppGUID == "" || changed[event.AppGUID] {
+			continue
+		}
+		changed[event.AppGUID] = true
+
+		a, err := w.client.app(event.AppGUID)
+		if err != nil {
+			return err
+		}
+		w.update(event.AppGUID, a)
+	}
+	return nil
+}
+
+// update notifies the changes of an application, a nil or not started app is
+// stopped.
+func (w *watcher) update(guid string, a *app) {
+	if a != nil && a.State != stateStarted {
+		a = nil
+	}
+
+	known, found := w.apps[guid]
+	if found && a != nil && reflect.DeepEqual(known, a) {
+		return
+	}
+	if found {
+		delete(w.apps, guid)
+		w.onStop(known)
+	}
+	if a != nil {
+		w.apps[guid] = a
+		w.onStart(a)
+	}
+}
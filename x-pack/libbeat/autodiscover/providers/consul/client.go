@@ -0,0 +1,219 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// instance is an instance of a service registered in the catalog.
+type instance struct {
+	Node       string
+	NodeID     string
+	Address    string
+	Datacenter string
+	NodeMeta   map[string]string
+
+	ServiceID      string
+	ServiceName    string
+	ServiceAddress string
+	ServicePort    int
+	ServiceTags    []string
+	ServiceMeta    map[string]string
+}
+
+// id identifies the instance in the catalog, service IDs are only unique in
+// a node.
+func (i *instance) id() string {
+	return i.Node + "/" + i.ServiceID
+}
+
+// host returns the address of the service, or of its node if the service
+// doesn't have its own.
+func (i *instance) host() string {
+	if i.ServiceAddress != "" {
+		return i.ServiceAddress
+	}
+	return i.Address
+}
+
+// catalogNode is the response of the node endpoint of the catalog.
+type catalogNode struct {
+	Node struct {
+		ID         string
+		Node       string
+		Address    string
+		Datacenter string
+		Meta       map[string]string
+	}
+	Services map[string]nodeService
+}
+
+// nodeService is a service registered in a node.
+type nodeService struct {
+	ID      string
+	Service string
+	Tags    []string
+	Address string
+	Port    int
+	Meta    map[string]string
+}
+
+func (n *catalogNode) instances() []instance {
+	var instances []instance
+	for _, service := range n.Services {
+		instances = append(instances, instance{
+			Node:           n.Node.Node,
+			NodeID:         n.Node.ID,
+			Address:        n.Node.Address,
+			Datacenter:     n.Node.Datacenter,
+			NodeMeta:       n.Node.Meta,
+			ServiceID:      service.ID,
+			ServiceName:    service.Service,
+			ServiceAddress: service.Address,
+			ServicePort:    service.Port,
+			ServiceTags:    service.Tags,
+			ServiceMeta:    service.Meta,
+		})
+	}
+	return instances
+}
+
+// apiClient is a client of the Consul HTTP API.
+type apiClient struct {
+	address    string
+	datacenter string
+	token      string
+	http       *http.Client
+}
+
+func newAPIClient(config *Config) (*apiClient, error) {
+	if _, err := url.Parse(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid address %s: %v", config.Address, err)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.ToConfig()
+	}
+
+	return &apiClient{
+		address:    strings.TrimRight(config.Address, "/"),
+		datacenter: config.Datacenter,
+		token:      config.Token,
+		http:       &http.Client{Transport: transport},
+	}, nil
+}
+
+// nodeName returns the name of the node of the agent.
+func (c *apiClient) nodeName(ctx context.Context) (string, error) {
+	var self struct {
+		Config struct {
+			NodeName string
+		}
+	}
+	if _, err := c.get(ctx, "/v1/agent/self", nil, &self); err != nil {
+		return "", err
+	}
+	return self.Config.NodeName, nil
+}
+
+// services lists the names of the services of the catalog. When index is not
+// zero the request is a blocking query, that returns when the catalog changes
+// after that index or when the wait time is over.
+func (c *apiClient) services(ctx context.Context, index uint64, wait time.Duration) ([]string, uint64, error) {
+	var services map[string][]string
+	index, err := c.get(ctx, "/v1/catalog/services", blockingQuery(index, wait), &services)
+	names := make([]string, 0, len(services))
+	for name := range services {
+		names = append(names, name)
+	}
+	return names, index, err
+}
+
+// service lists the instances of a service.
+func (c *apiClient) service(ctx context.Context, name string) ([]instance, error) {
+	var instances []instance
+	_, err := c.get(ctx, "/v1/catalog/service/"+url.PathEscape(name), nil, &instances)
+	return instances, err
+}
+
+// node lists the instances of the services of a node, as a blocking query
+// if index is not zero.
+func (c *apiClient) node(ctx context.Context, name string, index uint64, wait time.Duration) ([]instance, uint64, error) {
+	var n *catalogNode
+	index, err := c.get(ctx, "/v1/catalog/node/"+url.PathEscape(name), blockingQuery(index, wait), &n)
+	if err != nil {
+		return nil, 0, err
+	}
+	if n == nil {
+		return nil, 0, fmt.Errorf("node %s not found", name)
+	}
+	return n.instances(), index, nil
+}
+
+func blockingQuery(index uint64, wait time.Duration) url.Values {
+	query := url.Values{}
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%dms", wait.Milliseconds()))
+	}
+	return query
+}
+
+// get requests an endpoint of the API and decodes the response in out. It
+// returns the index of the response for blocking queries.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, out interface{}) (uint64, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if c.datacenter != "" {
+		query.Set("dc", c.datacenter)
+	}
+
+	u := c.address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	if c.token != "" {
+		req.Header.Set("X-Consul-Token", c.token)
+	}
+
+	resp, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return 0, fmt.Errorf("request to %s failed with status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("invalid response of %s: %v", path, err)
+	}
+
+	index, _ := strconv.ParseUint(resp.Header.Get("X-Consul-Index"), 10, 64)
+	return index, nil
+}
@@ -0,0 +1,250 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package consul
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("consul", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for services in the Consul catalog.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("consul autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up consul autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	client, err := newAPIClient(config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	node := config.Node
+	if config.Scope == "node" && node == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		node, err = client.nodeName(ctx)
+		if err != nil {
+			return nil, errWrap(errors.Wrap(err, "failed to get the node of the local agent"))
+		}
+	}
+
+	p, err := internalBuilder(bus, uuid, config, client, node, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client *apiClient, node string, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover.consul"),
+	}
+	p.watcher = newWatcher(client, node, config.Services, config.WaitTime, config.RetryInterval, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return "consul"
+}
+
+func (p *Provider) onStart(i *instance) {
+	p.publish(p.instanceEvent(i, "start"))
+}
+
+func (p *Provider) onStop(i *instance) {
+	p.publish(p.instanceEvent(i, "stop"))
+}
+
+func (p *Provider) instanceEvent(i *instance, flag string) bus.Event {
+	meta := instanceMetadata(i)
+	event := bus.Event{
+		"provider": p.uuid,
+		"id":       i.id(),
+		flag:       true,
+		"host":     i.host(),
+		"consul":   meta,
+		"meta": common.MapStr{
+			"consul": meta,
+		},
+	}
+	if i.ServicePort != 0 {
+		event["port"] = i.ServicePort
+	}
+	return event
+}
+
+func instanceMetadata(i *instance) common.MapStr {
+	meta := common.MapStr{
+		"service": common.MapStr{
+			"id":   i.ServiceID,
+			"name": i.ServiceName,
+		},
+		"node": common.MapStr{
+			"name":    i.Node,
+			"address": i.Address,
+		},
+	}
+	if i.NodeID != "" {
+		meta.Put("node.id", i.NodeID)
+	}
+	if i.Datacenter != "" {
+		meta.Put("datacenter", i.Datacenter)
+	}
+	if len(i.ServiceTags) > 0 {
+		meta.Put("service.tags", i.ServiceTags)
+	}
+	if len(i.ServiceMeta) > 0 {
+		// Meta keys cannot contain dots, they are kept as they are.
+		serviceMeta := common.MapStr{}
+		for k, v := range i.ServiceMeta {
+			serviceMeta[k] = v
+		}
+		meta.Put("service.meta", serviceMeta)
+	}
+	return meta
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "port", "consul"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	var tags []string
+	if value, err := common.MapStr(event).GetValue("consul.service.tags"); err == nil {
+		tags, _ = value.([]string)
+	}
+	meta := map[string]string{}
+	if value, err := common.MapStr(event).GetValue("consul.service.meta"); err == nil {
+		for k, v := range value.(common.MapStr) {
+			meta[k], _ = v.(string)
+		}
+	}
+	e["hints"] = builder.GenerateHints(hintAnnotations(tags, meta, p.config.Prefix), "", p.config.Prefix)
+	return e
+}
+
+// hintAnnotations maps the tags and the meta of a service into the namespace
+// of hints. Tags are `key=value` pairs with keys like `co.elastic.metrics/module`.
+// Meta keys cannot contain dots or slashes, so they are written with
+// underscores instead, like `co_elastic_metrics_module`, and the first
+// underscore after the prefix separates the hint type from the hint. Meta
+// takes precedence over tags.
+func hintAnnotations(tags []string, meta map[string]string, prefix string) common.MapStr {
+	annotations := common.MapStr{}
+	for _, tag := range tags {
+		parts := strings.SplitN(tag, "=", 2)
+		if len(parts) != 2 || !strings.HasPrefix(parts[0], prefix+".") {
+			continue
+		}
+		safemapstr.Put(annotations, parts[0], parts[1])
+	}
+
+	metaPrefix := strings.Replace(prefix, ".", "_", -1) + "_"
+	for key, value := range meta {
+		if !strings.HasPrefix(key, metaPrefix) {
+			continue
+		}
+		hint := strings.SplitN(strings.TrimPrefix(key, metaPrefix), "_", 2)
+		if len(hint) != 2 || hint[0] == "" || hint[1] == "" {
+			continue
+		}
+		safemapstr.Put(annotations, prefix+"."+hint[0]+"/"+hint[1], value)
+	}
+	return annotations
+}
@@ -0,0 +1,263 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package consul
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+)
+
+var testInstance = instance{
+	Node:           "consul-1",
+	NodeID:         "0e5a5b1c-3a0c-4c7a-8f3e-6c1d2b5a9e01",
+	Address:        "10.0.0.5",
+	Datacenter:     "dc1",
+	ServiceID:      "redis-1",
+	ServiceName:    "redis",
+	ServiceAddress: "10.0.0.12",
+	ServicePort:    6379,
+	ServiceTags:    []string{"primary", "co.elastic.metrics/module=redis", "co.elastic.metrics/period=10s"},
+	ServiceMeta: map[string]string{
+		"version":                   "6.2",
+		"co_elastic_metrics_period": "30s",
+		"co_elastic_logs_enabled":   "false",
+	},
+}
+
+// fakeConsul serves the endpoints of the Consul API used by the provider.
+type fakeConsul struct {
+	mu        sync.Mutex
+	index     uint64
+	instances []instance
+}
+
+func (f *fakeConsul) setInstances(instances ...instance) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances = instances
+	f.index++
+}
+
+func (f *fakeConsul) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("X-Consul-Index", strconv.FormatUint(f.index+1, 10))
+	var body interface{}
+	switch r.URL.Path {
+	case "/v1/agent/self":
+		body = map[string]interface{}{"Config": map[string]string{"NodeName": "consul-1"}}
+	case "/v1/catalog/services":
+		services := map[string][]string{"consul": nil}
+		for _, i := range f.instances {
+			services[i.ServiceName] = i.ServiceTags
+		}
+		body = services
+	case "/v1/catalog/service/consul":
+		body = []instance{{Node: "consul-1", Address: "10.0.0.5", ServiceID: "consul", ServiceName: "consul", ServicePort: 8300}}
+	case "/v1/catalog/service/redis", "/v1/catalog/service/web":
+		var instances []instance
+		for _, i := range f.instances {
+			if "/v1/catalog/service/"+i.ServiceName == r.URL.Path {
+				instances = append(instances, i)
+			}
+		}
+		body = instances
+	case "/v1/catalog/node/consul-1":
+		n := catalogNode{}
+		n.Node.ID = testInstance.NodeID
+		n.Node.Node = "consul-1"
+		n.Node.Address = "10.0.0.5"
+		n.Node.Datacenter = "dc1"
+		n.Services = map[string]nodeService{}
+		for _, i := range f.instances {
+			if i.Node != "consul-1" {
+				continue
+			}
+			n.Services[i.ServiceID] = nodeService{
+				ID:      i.ServiceID,
+				Service: i.ServiceName,
+				Tags:    i.ServiceTags,
+				Address: i.ServiceAddress,
+				Port:    i.ServicePort,
+				Meta:    i.ServiceMeta,
+			}
+		}
+		body = n
+	case "/v1/catalog/node/unknown":
+		body = nil
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *apiClient {
+	client, err := newAPIClient(&Config{Address: server.URL})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNodeName(t *testing.T) {
+	server := httptest.NewServer(&fakeConsul{})
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	name, err := client.nodeName(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "consul-1", name)
+
+	_, _, err = client.node(context.Background(), "unknown", 0, time.Second)
+	assert.Error(t, err)
+}
+
+func TestWatcher(t *testing.T) {
+	other := testInstance
+	other.Node = "consul-2"
+	other.ServiceID = "redis-2"
+
+	web := testInstance
+	web.ServiceID = "web-1"
+	web.ServiceName = "web"
+
+	for _, node := range []string{"consul-1", ""} {
+		t.Run("node="+node, func(t *testing.T) {
+			fake := &fakeConsul{}
+			fake.setInstances(testInstance, other, web)
+			server := httptest.NewServer(fake)
+			defer server.Close()
+
+			var started, stopped []string
+			w := newWatcher(newTestClient(t, server), node, []string{"redis"}, time.Second, time.Second,
+				func(i *instance) { started = append(started, i.id()) },
+				func(i *instance) { stopped = append(stopped, i.id()) },
+			)
+
+			expected := []string{"consul-1/redis-1"}
+			if node == "" {
+				expected = append(expected, "consul-2/redis-2")
+			}
+
+			require.NoError(t, w.once())
+			sort.Strings(started)
+			assert.Equal(t, expected, started)
+			assert.Empty(t, stopped)
+
+			// Registered instances are only notified once.
+			require.NoError(t, w.once())
+			assert.Len(t, started, len(expected))
+
+			// Updated instances are stopped and started again.
+			updated := testInstance
+			updated.ServicePort = 6380
+			fake.setInstances(updated, other, web)
+			started = nil
+			require.NoError(t, w.once())
+			assert.Equal(t, []string{"consul-1/redis-1"}, started)
+			assert.Equal(t, []string{"consul-1/redis-1"}, stopped)
+
+			fake.setInstances(web)
+			stopped = nil
+			require.NoError(t, w.once())
+			sort.Strings(stopped)
+			assert.Equal(t, expected, stopped)
+			assert.Empty(t, w.instances)
+		})
+	}
+}
+
+func TestInstanceEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	i := testInstance
+	event := p.instanceEvent(&i, "start")
+	assert.Equal(t, "consul-1/redis-1", event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "10.0.0.12", event["host"])
+	assert.Equal(t, 6379, event["port"])
+	assert.Equal(t, common.MapStr{
+		"service": common.MapStr{
+			"id":   "redis-1",
+			"name": "redis",
+			"tags": []string{"primary", "co.elastic.metrics/module=redis", "co.elastic.metrics/period=10s"},
+			"meta": common.MapStr{
+				"version":                   "6.2",
+				"co_elastic_metrics_period": "30s",
+				"co_elastic_logs_enabled":   "false",
+			},
+		},
+		"node": common.MapStr{
+			"id":      testInstance.NodeID,
+			"name":    "consul-1",
+			"address": "10.0.0.5",
+		},
+		"datacenter": "dc1",
+	}, event["consul"])
+
+	// Services without address use the address of the node.
+	i.ServiceAddress = ""
+	i.ServicePort = 0
+	event = p.instanceEvent(&i, "stop")
+	assert.Equal(t, "10.0.0.5", event["host"])
+	assert.NotContains(t, event, "port")
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	i := testInstance
+	hints := p.generateHints(p.instanceEvent(&i, "start"))
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "redis",
+			"period": "30s",
+		},
+		"logs": common.MapStr{
+			"enabled": "false",
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.0.12", hints["host"])
+	assert.Equal(t, 6379, hints["port"])
+	assert.Contains(t, hints, "consul")
+}
+
+func TestHintAnnotations(t *testing.T) {
+	annotations := hintAnnotations(
+		[]string{"co.elastic.metrics/hosts=${data.host}:9121", "co.elastic.invalid", "other.metrics/module=redis", "web"},
+		map[string]string{"co_elastic_metrics_metrics_path": "/stats", "co_elastic_": "x", "other": "y"},
+		"co.elastic",
+	)
+	assert.Equal(t, common.MapStr{
+		"co": common.MapStr{"elastic": common.MapStr{
+			"metrics/hosts":        "${data.host}:9121",
+			"metrics/metrics_path": "/stats",
+		}},
+	}, annotations)
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := defaultConfig()
+	providertest.Unpack(t, config, nil, "consul.service.name", "web")
+
+	p, err := internalBuilder(providertest.Bus(b), uuid.Nil, config, &apiClient{}, "", nil)
+	require.NoError(t, err)
+	return p
+}
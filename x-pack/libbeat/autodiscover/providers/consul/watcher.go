@@ -0,0 +1,169 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package consul
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// watcher follows the service catalog of Consul with blocking queries, and
+// notifies when service instances are registered or deregistered. Instances
+// updated in the catalog are notified as stopped and started again.
+type watcher struct {
+	client        *apiClient
+	node          string
+	services      map[string]bool
+	waitTime      time.Duration
+	retryInterval time.Duration
+	onStart       func(i *instance)
+	onStop        func(i *instance)
+
+	index     uint64
+	instances map[string]*instance
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client *apiClient,
+	node string,
+	services []string,
+	waitTime, retryInterval time.Duration,
+	onStart, onStop func(i *instance),
+) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	w := &watcher{
+		client:        client,
+		node:          node,
+		waitTime:      waitTime,
+		retryInterval: retryInterval,
+		onStart:       onStart,
+		onStop:        onStop,
+		instances:     map[string]*instance{},
+		ctx:           ctx,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		logger:        logp.NewLogger("autodiscover.consul"),
+	}
+	if len(services) > 0 {
+		w.services = map[string]bool{}
+		for _, name := range services {
+			w.services[name] = true
+		}
+	}
+	return w
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *watcher) forever() {
+	defer close(w.done)
+	for {
+		err := w.once()
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error(errors.Wrap(err, "error while watching the Consul catalog"))
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(w.retryInterval):
+			}
+		}
+	}
+}
+
+// once waits for changes in the catalog and notifies them.
+// This is mostly useful for testing.
+func (w *watcher) once() error {
+	// Allow some margin over the wait time, as Consul adds some jitter to it.
+	ctx, cancel := context.WithTimeout(w.ctx, w.waitTime+w.waitTime/16+10*time.Second)
+	defer cancel()
+
+	var instances []instance
+	var index uint64
+	var err error
+	if w.node != "" {
+		instances, index, err = w.client.node(ctx, w.node, w.index, w.waitTime)
+	} else {
+		instances, index, err = w.catalog(ctx)
+	}
+	if err != nil {
+		return err
+	}
+	// Indexes going backwards must be reset, as described in the Consul
+	// documentation for blocking queries.
+	if index < w.index {
+		index = 0
+	}
+	w.index = index
+	w.logger.Debugf("fetched %d service instances from Consul for autodiscover", len(instances))
+
+	current := map[string]*instance{}
+	for i := range instances {
+		if w.services != nil && !w.services[instances[i].ServiceName] {
+			continue
+		}
+		current[instances[i].id()] = &instances[i]
+	}
+
+	for id, old := range w.instances {
+		if i, found := current[id]; !found || !reflect.DeepEqual(old, i) {
+			delete(w.instances, id)
+			if w.onStop != nil {
+				w.onStop(old)
+			}
+		}
+	}
+	for id, i := range current {
+		if _, known := w.instances[id]; known {
+			continue
+		}
+		w.instances[id] = i
+		if w.onStart != nil {
+			w.onStart(i)
+		}
+	}
+	return nil
+}
+
+// catalog lists the instances of all the services in the catalog, waiting for
+// changes in the list of services.
+func (w *watcher) catalog(ctx context.Context) ([]instance, uint64, error) {
+	names, index, err := w.client.services(ctx, w.index, w.waitTime)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var instances []instance
+	for _, name := range names {
+		if w.services != nil && !w.services[name] {
+			continue
+		}
+		list, err := w.client.service(ctx, name)
+		if err != nil {
+			return nil, 0, errors.Wrapf(err, "failed to get service %s", name)
+		}
+		instances = append(instances, list...)
+	}
+	return instances, index, nil
+}
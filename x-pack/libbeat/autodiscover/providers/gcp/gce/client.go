@@ -0,0 +1,143 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gce
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"strings"
+
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	"github.com/elastic/beats/v7/libbeat/common/useragent"
+)
+
+// instance is a Compute Engine instance.
+type instance struct {
+	ID          string
+	Name        string
+	Hostname    string
+	MachineType string
+	Project     string
+	Zone        string
+	PrivateIP   string
+	PublicIP    string
+	Labels      map[string]string
+	NetworkTags []string
+	// Hints are the metadata items of the instance in the namespace of
+	// hints, other metadata is not kept as it can contain secrets.
+	Hints map[string]string
+}
+
+// client lists the instances of the Compute Engine API.
+type client interface {
+	// instances lists the instances of a zone of a project, or of all its
+	// zones if zone is empty. Only the instances matching the filter are
+	// listed if it is not empty.
+	instances(ctx context.Context, project, zone, filter string) ([]*compute.Instance, error)
+}
+
+// apiClient is a client of the Compute Engine API.
+type apiClient struct {
+	service *compute.Service
+}
+
+func newAPIClient(config *Config) (*apiClient, error) {
+	opts := []option.ClientOption{
+		option.WithUserAgent(useragent.UserAgent("Beats")),
+		option.WithScopes(compute.ComputeReadonlyScope),
+	}
+	if config.CredentialsFilePath != "" {
+		opts = append(opts, option.WithCredentialsFile(config.CredentialsFilePath))
+	} else if config.CredentialsJSON != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(config.CredentialsJSON)))
+	}
+
+	service, err := compute.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &apiClient{service: service}, nil
+}
+
+func (c *apiClient) instances(ctx context.Context, project, zone, filter string) ([]*compute.Instance, error) {
+	var result []*compute.Instance
+	if zone == "" {
+		call := c.service.Instances.AggregatedList(project)
+		if filter != "" {
+			call = call.Filter(filter)
+		}
+		err := call.Pages(ctx, func(list *compute.InstanceAggregatedList) error {
+			for _, scoped := range list.Items {
+				result = append(result, scoped.Instances...)
+			}
+			return nil
+		})
+		return result, err
+	}
+
+	call := c.service.Instances.List(project, zone)
+	if filter != "" {
+		call = call.Filter(filter)
+	}
+	err := call.Pages(ctx, func(list *compute.InstanceList) error {
+		result = append(result, list.Items...)
+		return nil
+	})
+	return result, err
+}
+
+// newInstance builds an instance from the instance of the API. The private
+// and public IPs are the ones of its first network interface.
+func newInstance(project string, i *compute.Instance, hintsPrefix string) *instance {
+	result := &instance{
+		ID:          strconv.FormatUint(i.Id, 10),
+		Name:        i.Name,
+		Hostname:    i.Hostname,
+		MachineType: path.Base(i.MachineType),
+		Project:     project,
+		Zone:        path.Base(i.Zone),
+		Labels:      i.Labels,
+		Hints:       map[string]string{},
+	}
+	if len(i.NetworkInterfaces) > 0 && i.NetworkInterfaces[0] != nil {
+		nic := i.NetworkInterfaces[0]
+		result.PrivateIP = nic.NetworkIP
+		for _, access := range nic.AccessConfigs {
+			if access != nil && access.NatIP != "" {
+				result.PublicIP = access.NatIP
+				break
+			}
+		}
+	}
+	if i.Tags != nil {
+		result.NetworkTags = i.Tags.Items
+	}
+	if i.Metadata != nil {
+		for _, item := range i.Metadata.Items {
+			if item == nil || item.Value == nil || !strings.HasPrefix(item.Key, hintsPrefix) {
+				continue
+			}
+			result.Hints[item.Key] = *item.Value
+		}
+	}
+	return result
+}
+
+// isRunning returns true if the instance is running.
+func isRunning(i *compute.Instance) bool {
+	return i.Status == "RUNNING"
+}
+
+// regionFromZone returns the region of a zone, as us-central1 for
+// us-central1-a.
+func regionFromZone(zone string) string {
+	if i := strings.LastIndex(zone, "-"); i > 0 {
+		return zone[:i]
+	}
+	return zone
+}
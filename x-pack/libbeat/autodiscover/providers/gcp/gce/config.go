@@ -0,0 +1,45 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gce
+
+import (
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Config for the gcp_gce autodiscover provider.
+type Config struct {
+	// Projects are the IDs of the projects whose instances are discovered.
+	Projects []string `config:"projects" validate:"required"`
+	// Zones limits the discovered instances to the ones in these zones, all
+	// the zones are used by default.
+	Zones []string `config:"zones"`
+	// Filter is an expression of the Compute Engine API to select the
+	// discovered instances, like `labels.env = prod`.
+	Filter string `config:"filter"`
+
+	// CredentialsFilePath is a JSON file with the credentials of a service
+	// account, application default credentials are used if it is not set.
+	CredentialsFilePath string `config:"credentials_file_path"`
+	// CredentialsJSON are the credentials of a service account.
+	CredentialsJSON string `config:"credentials_json"`
+
+	Period time.Duration `config:"period" validate:"positive,nonzero"`
+
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Period: time.Minute,
+		Prefix: "co.elastic",
+	}
+}
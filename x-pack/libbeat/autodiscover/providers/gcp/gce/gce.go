@@ -0,0 +1,254 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gce
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("gcp_gce", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for Google Compute Engine
+// instances.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("gcp_gce autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up gcp_gce autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	client, err := newAPIClient(config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	p, err := internalBuilder(bus, uuid, config, client, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client client, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover.gcp_gce"),
+	}
+	p.watcher = newWatcher(client, config.Projects, config.Zones, config.Filter, hintsKeyPrefix(config.Prefix),
+		config.Period, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+}
+
+func (p *Provider) String() string {
+	return "gcp_gce"
+}
+
+func (p *Provider) onStart(i *instance) {
+	p.publish(p.instanceEvent(i, "start"), i)
+}
+
+func (p *Provider) onStop(i *instance) {
+	p.publish(p.instanceEvent(i, "stop"), i)
+}
+
+func (p *Provider) instanceEvent(i *instance, flag string) bus.Event {
+	meta := instanceMetadata(i)
+	cloud := cloudMetadata(i)
+	return bus.Event{
+		"provider": p.uuid,
+		"id":       i.ID,
+		flag:       true,
+		"host":     i.PrivateIP,
+		"gcp":      meta,
+		"cloud":    cloud,
+		"meta": common.MapStr{
+			"gcp":   meta,
+			"cloud": cloud,
+		},
+	}
+}
+
+func instanceMetadata(i *instance) common.MapStr {
+	meta := common.MapStr{
+		"project": common.MapStr{
+			"id": i.Project,
+		},
+		"zone": i.Zone,
+		"gce": common.MapStr{
+			"instance": common.MapStr{
+				"id":           i.ID,
+				"name":         i.Name,
+				"machine_type": i.MachineType,
+				"private_ip":   i.PrivateIP,
+			},
+		},
+	}
+	if i.Hostname != "" {
+		meta.Put("gce.instance.hostname", i.Hostname)
+	}
+	if i.PublicIP != "" {
+		meta.Put("gce.instance.public_ip", i.PublicIP)
+	}
+	if len(i.Labels) > 0 {
+		labels := common.MapStr{}
+		for k, v := range i.Labels {
+			labels[k] = v
+		}
+		meta.Put("gce.instance.labels", labels)
+	}
+	if len(i.NetworkTags) > 0 {
+		meta.Put("gce.instance.network_tags", i.NetworkTags)
+	}
+	return meta
+}
+
+func cloudMetadata(i *instance) common.MapStr {
+	return common.MapStr{
+		"provider": "gcp",
+		"project": common.MapStr{
+			"id": i.Project,
+		},
+		"instance": common.MapStr{
+			"id":   i.ID,
+			"name": i.Name,
+		},
+		"machine": common.MapStr{
+			"type": i.MachineType,
+		},
+		"availability_zone": i.Zone,
+		"region":            regionFromZone(i.Zone),
+	}
+}
+
+func (p *Provider) publish(event bus.Event, i *instance) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event, i)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event, i *instance) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "gcp", "cloud"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+	// Hints in metadata override the ones in labels, as values of labels are
+	// more restricted.
+	items := map[string]string{}
+	for k, v := range i.Labels {
+		items[k] = v
+	}
+	for k, v := range i.Hints {
+		items[k] = v
+	}
+	e["hints"] = builder.GenerateHints(hintAnnotations(items, p.config.Prefix), "", p.config.Prefix)
+	return e
+}
+
+// hintsKeyPrefix returns the prefix of the labels and metadata keys with
+// hints. These keys can only contain letters, numbers, dashes and
+// underscores, so dots in the prefix are replaced by dashes.
+func hintsKeyPrefix(prefix string) string {
+	return strings.Replace(prefix, ".", "-", -1) + "-"
+}
+
+// hintAnnotations maps labels or metadata items of an instance into the
+// namespace of hints. Their keys are written with dashes instead of dots and
+// slashes, like `co-elastic-metrics-module` for `co.elastic.metrics/module`.
+func hintAnnotations(items map[string]string, prefix string) common.MapStr {
+	keyPrefix := hintsKeyPrefix(prefix)
+	annotations := common.MapStr{}
+	for key, value := range items {
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		hint := strings.SplitN(strings.TrimPrefix(key, keyPrefix), "-", 2)
+		if len(hint) != 2 || hint[0] == "" || hint[1] == "" {
+			continue
+		}
+		safemapstr.Put(annotations, prefix+"."+hint[0]+"/"+strings.Replace(hint[1], "-", ".", -1), value)
+	}
+	return annotations
+}
@@ -0,0 +1,294 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gce
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const testProject = "elastic-observability"
+
+func testInstance(id uint64, name, zone, ip string) *compute.Instance {
+	return &compute.Instance{
+		Id:          id,
+		Name:        name,
+		Hostname:    name + ".c." + testProject + ".internal",
+		MachineType: "https://www.googleapis.com/compute/v1/projects/" + testProject + "/zones/" + zone + "/machineTypes/n1-standard-1",
+		Zone:        "https://www.googleapis.com/compute/v1/projects/" + testProject + "/zones/" + zone,
+		Status:      "RUNNING",
+		NetworkInterfaces: []*compute.NetworkInterface{{
+			NetworkIP: ip,
+		}},
+	}
+}
+
+func metadataValue(s string) *string {
+	return &s
+}
+
+// mockClient is a client that returns the instances it has been set.
+type mockClient struct {
+	mu    sync.Mutex
+	zones map[string][]*compute.Instance
+	err   error
+}
+
+func newMockClient() *mockClient {
+	return &mockClient{zones: map[string][]*compute.Instance{}}
+}
+
+func (c *mockClient) add(project, zone string, i *compute.Instance) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zones[project+"/"+zone] = append(c.zones[project+"/"+zone], i)
+}
+
+func (c *mockClient) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.zones = map[string][]*compute.Instance{}
+}
+
+func (c *mockClient) instances(_ context.Context, project, zone, filter string) ([]*compute.Instance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return nil, c.err
+	}
+	if zone != "" {
+		return c.zones[project+"/"+zone], nil
+	}
+	var result []*compute.Instance
+	for key, instances := range c.zones {
+		if strings.HasPrefix(key, project+"/") {
+			result = append(result, instances...)
+		}
+	}
+	return result, nil
+}
+
+func TestRegionFromZone(t *testing.T) {
+	assert.Equal(t, "us-central1", regionFromZone("us-central1-a"))
+	assert.Equal(t, "europe-west4", regionFromZone("europe-west4-c"))
+	assert.Equal(t, "local", regionFromZone("local"))
+}
+
+func TestNewInstance(t *testing.T) {
+	i := testInstance(1234, "web-1", "us-central1-a", "10.0.0.4")
+	i.NetworkInterfaces[0].AccessConfigs = []*compute.AccessConfig{{NatIP: "35.1.2.3"}}
+	i.Labels = map[string]string{"env": "prod"}
+	i.Tags = &compute.Tags{Items: []string{"http-server"}}
+	i.Metadata = &compute.Metadata{Items: []*compute.MetadataItems{
+		{Key: "co-elastic-metrics-hosts", Value: metadataValue("${data.host}:8080")},
+		{Key: "startup-script", Value: metadataValue("echo secret")},
+	}}
+
+	assert.Equal(t, &instance{
+		ID:          "1234",
+		Name:        "web-1",
+		Hostname:    "web-1.c." + testProject + ".internal",
+		MachineType: "n1-standard-1",
+		Project:     testProject,
+		Zone:        "us-central1-a",
+		PrivateIP:   "10.0.0.4",
+		PublicIP:    "35.1.2.3",
+		Labels:      map[string]string{"env": "prod"},
+		NetworkTags: []string{"http-server"},
+		Hints:       map[string]string{"co-elastic-metrics-hosts": "${data.host}:8080"},
+	}, newInstance(testProject, i, "co-elastic-"))
+}
+
+func TestWatcher(t *testing.T) {
+	client := newMockClient()
+	client.add(testProject, "us-central1-a", testInstance(1, "web-1", "us-central1-a", "10.0.0.4"))
+	client.add(testProject, "us-central1-b", testInstance(2, "web-2", "us-central1-b", "10.0.0.5"))
+	client.add(testProject, "us-central1-b", testInstance(3, "no-ip", "us-central1-b", ""))
+	stopped := testInstance(4, "stopped", "us-central1-b", "10.0.0.6")
+	stopped.Status = "TERMINATED"
+	client.add(testProject, "us-central1-b", stopped)
+	client.add("other", "us-central1-a", testInstance(5, "other", "us-central1-a", "10.1.0.4"))
+
+	var started, stoppedNames []string
+	w := newWatcher(client, []string{testProject}, nil, "", "co-elastic-", time.Second,
+		func(i *instance) { started = append(started, i.Name) },
+		func(i *instance) { stoppedNames = append(stoppedNames, i.Name) },
+	)
+
+	// Instances without IP or not running are not discovered.
+	require.NoError(t, w.once())
+	sort.Strings(started)
+	assert.Equal(t, []string{"web-1", "web-2"}, started)
+	assert.Empty(t, stoppedNames)
+
+	// Discovered instances are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 2)
+
+	// Updated instances are stopped and started again.
+	client.reset()
+	updated := testInstance(1, "web-1", "us-central1-a", "10.0.0.4")
+	updated.Labels = map[string]string{"co-elastic-metrics-module": "nginx"}
+	client.add(testProject, "us-central1-a", updated)
+	started = nil
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{"web-1"}, started)
+	sort.Strings(stoppedNames)
+	assert.Equal(t, []string{"web-1", "web-2"}, stoppedNames)
+
+	// Errors don't stop the discovered instances.
+	client.err = fmt.Errorf("unavailable")
+	stoppedNames = nil
+	assert.Error(t, w.once())
+	assert.Empty(t, stoppedNames)
+	assert.Len(t, w.instances, 1)
+}
+
+func TestWatcherZones(t *testing.T) {
+	client := newMockClient()
+	client.add(testProject, "us-central1-a", testInstance(1, "web-1", "us-central1-a", "10.0.0.4"))
+	client.add(testProject, "us-central1-b", testInstance(2, "web-2", "us-central1-b", "10.0.0.5"))
+
+	var started []string
+	w := newWatcher(client, []string{testProject}, []string{"us-central1-b"}, "", "co-elastic-", time.Second,
+		func(i *instance) { started = append(started, i.Name) }, nil,
+	)
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{"web-2"}, started)
+}
+
+func TestInstanceEvent(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	gi := testInstance(1234, "web-1", "us-central1-a", "10.0.0.4")
+	gi.Labels = map[string]string{"env": "prod"}
+	i := newInstance(testProject, gi, "co-elastic-")
+	event := p.instanceEvent(i, "start")
+	assert.Equal(t, "1234", event["id"])
+	assert.Equal(t, true, event["start"])
+	assert.Equal(t, "10.0.0.4", event["host"])
+	assert.Equal(t, common.MapStr{
+		"project": common.MapStr{"id": testProject},
+		"zone":    "us-central1-a",
+		"gce": common.MapStr{
+			"instance": common.MapStr{
+				"id":           "1234",
+				"name":         "web-1",
+				"hostname":     "web-1.c." + testProject + ".internal",
+				"machine_type": "n1-standard-1",
+				"private_ip":   "10.0.0.4",
+				"labels":       common.MapStr{"env": "prod"},
+			},
+		},
+	}, event["gcp"])
+	assert.Equal(t, common.MapStr{
+		"provider":          "gcp",
+		"project":           common.MapStr{"id": testProject},
+		"instance":          common.MapStr{"id": "1234", "name": "web-1"},
+		"machine":           common.MapStr{"type": "n1-standard-1"},
+		"availability_zone": "us-central1-a",
+		"region":            "us-central1",
+	}, event["cloud"])
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	gi := testInstance(1, "web-1", "us-central1-a", "10.0.0.4")
+	gi.Labels = map[string]string{
+		"co-elastic-metrics-module": "nginx",
+		"co-elastic-metrics-period": "10s",
+		"env":                       "prod",
+	}
+	gi.Metadata = &compute.Metadata{Items: []*compute.MetadataItems{
+		{Key: "co-elastic-metrics-hosts", Value: metadataValue("${data.host}:8080")},
+		{Key: "co-elastic-metrics-period", Value: metadataValue("30s")},
+		{Key: "co-elastic-logs-json-message_key", Value: metadataValue("msg")},
+	}}
+	i := newInstance(testProject, gi, "co-elastic-")
+	hints := p.generateHints(p.instanceEvent(i, "start"), i)
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "nginx",
+			"hosts":  "${data.host}:8080",
+			"period": "30s",
+		},
+		"logs": common.MapStr{
+			"json": common.MapStr{
+				"message_key": "msg",
+			},
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.0.4", hints["host"])
+	assert.Contains(t, hints, "gcp")
+	assert.Contains(t, hints, "cloud")
+}
+
+func TestHintAnnotations(t *testing.T) {
+	annotations := hintAnnotations(map[string]string{
+		"co-elastic-metrics-period": "30s",
+		"co-elastic-invalid":        "x",
+		"co-elastic-":               "x",
+		"other-metrics-module":      "redis",
+	}, "co.elastic")
+	assert.Equal(t, common.MapStr{
+		"co": common.MapStr{"elastic": common.MapStr{
+			"metrics/period": "30s",
+		}},
+	}, annotations)
+}
+
+func TestPublish(t *testing.T) {
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+	p := newTestProvider(t, b)
+
+	i := newInstance(testProject, testInstance(1, "web-1", "us-central1-a", "10.0.0.4"), "co-elastic-")
+	p.onStart(i)
+
+	event := providertest.Events(t, listener, 1)[0]
+	assert.Equal(t, true, event["start"])
+	assert.Len(t, event["config"], 1)
+}
+
+func TestConfigValidation(t *testing.T) {
+	config := defaultConfig()
+	err := common.MustNewConfigFrom(map[string]interface{}{
+		"zones": []string{"us-central1-a"},
+	}).Unpack(config)
+	assert.Error(t, err)
+
+	config = defaultConfig()
+	err = common.MustNewConfigFrom(map[string]interface{}{
+		"projects": []string{testProject},
+		"period":   "0s",
+	}).Unpack(config)
+	assert.Error(t, err)
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := defaultConfig()
+	providertest.Unpack(t, config, common.MapStr{"projects": []string{testProject}}, "gcp.zone", "us-central1-a")
+
+	p, err := internalBuilder(providertest.Bus(b), uuid.Nil, config, newMockClient(), nil)
+	require.NoError(t, err)
+	return p
+}
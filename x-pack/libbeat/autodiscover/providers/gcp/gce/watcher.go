@@ -0,0 +1,159 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package gce
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// watcher polls the instances of a set of projects, and notifies when they
+// are added or removed. Instances whose metadata changes are notified as
+// stopped and started again.
+type watcher struct {
+	client      client
+	projects    []string
+	zones       []string
+	filter      string
+	hintsPrefix string
+	period      time.Duration
+	onStart     func(i *instance)
+	onStop      func(i *instance)
+
+	instances map[string]*instance
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client client,
+	projects, zones []string,
+	filter, hintsPrefix string,
+	period time.Duration,
+	onStart, onStop func(i *instance),
+) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watcher{
+		client:      client,
+		projects:    projects,
+		zones:       zones,
+		filter:      filter,
+		hintsPrefix: hintsPrefix,
+		period:      period,
+		onStart:     onStart,
+		onStop:      onStop,
+		instances:   map[string]*instance{},
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		logger:      logp.NewLogger("autodiscover.gcp_gce"),
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *watcher) forever() {
+	defer close(w.done)
+	for {
+		err := w.once()
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error(errors.Wrap(err, "error while fetching GCE instances"))
+		}
+		select {
+		case <-w.ctx.Done():
+			return
+		case <-time.After(w.period):
+		}
+	}
+}
+
+// once fetches the instances and notifies the changes since the previous
+// fetch. This is mostly useful for testing.
+func (w *watcher) once() error {
+	ctx, cancel := context.WithTimeout(w.ctx, w.period)
+	defer cancel()
+
+	instances, err := w.fetch(ctx)
+	if err != nil {
+		return err
+	}
+	w.logger.Debugf("fetched %d instances from GCE for autodiscover", len(instances))
+
+	current := map[string]*instance{}
+	for _, i := range instances {
+		current[i.ID] = i
+	}
+
+	for id, old := range w.instances {
+		if i, found := current[id]; !found || !reflect.DeepEqual(old, i) {
+			delete(w.instances, id)
+			if w.onStop != nil {
+				w.onStop(old)
+			}
+		}
+	}
+	for id, i := range current {
+		if _, known := w.instances[id]; known {
+			continue
+		}
+		w.instances[id] = i
+		if w.onStart != nil {
+			w.onStart(i)
+		}
+	}
+	return nil
+}
+
+// fetch lists the instances of the watched projects and zones. Instances are
+// only listed while they are running and have a private IP.
+func (w *watcher) fetch(ctx context.Context) ([]*instance, error) {
+	zones := w.zones
+	if len(zones) == 0 {
+		zones = []string{""}
+	}
+
+	var instances []*instance
+	for _, project := range w.projects {
+		for _, zone := range zones {
+			list, err := w.client.instances(ctx, project, zone, w.filter)
+			if err != nil {
+				if zone == "" {
+					return nil, errors.Wrapf(err, "failed to list instances of project '%s'", project)
+				}
+				return nil, errors.Wrapf(err, "failed to list instances of project '%s' in zone '%s'", project, zone)
+			}
+
+			for _, i := range list {
+				if i == nil || !isRunning(i) {
+					continue
+				}
+				instance := newInstance(project, i, w.hintsPrefix)
+				if instance.PrivateIP == "" {
+					continue
+				}
+				instances = append(instances, instance)
+			}
+		}
+	}
+	return instances, nil
+}
@@ -0,0 +1,249 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// allocationStub is an allocation as listed by the allocations endpoint.
+type allocationStub struct {
+	ID            string
+	NodeID        string
+	ClientStatus  string
+	DesiredStatus string
+	ModifyIndex   uint64
+}
+
+type allocation struct {
+	ID                 string
+	Name               string
+	Namespace          string
+	NodeID             string
+	NodeName           string
+	JobID              string
+	TaskGroup          string
+	ClientStatus       string
+	Job                *job
+	AllocatedResources *allocatedResources
+}
+
+type job struct {
+	ID         string
+	Name       string
+	Type       string
+	Region     string
+	Meta       map[string]string
+	TaskGroups []taskGroup
+}
+
+type taskGroup struct {
+	Name  string
+	Meta  map[string]string
+	Tasks []task
+}
+
+type task struct {
+	Name   string
+	Driver string
+	Meta   map[string]string
+}
+
+type allocatedResources struct {
+	Tasks  map[string]taskResources
+	Shared sharedResources
+}
+
+type taskResources struct {
+	Networks []network
+}
+
+type sharedResources struct {
+	Networks []network
+	Ports    []portMapping
+}
+
+type network struct {
+	IP            string
+	ReservedPorts []port
+	DynamicPorts  []port
+}
+
+type port struct {
+	Label string
+	Value int
+	To    int
+}
+
+type portMapping struct {
+	Label  string
+	Value  int
+	To     int
+	HostIP string
+}
+
+type node struct {
+	ID         string
+	Name       string
+	Datacenter string
+	HTTPAddr   string
+	Attributes map[string]string
+}
+
+// address returns the IP address of the node.
+func (n *node) address() string {
+	if ip := n.Attributes["unique.network.ip-address"]; ip != "" {
+		return ip
+	}
+	if i := strings.LastIndex(n.HTTPAddr, ":"); i > 0 {
+		return strings.Trim(n.HTTPAddr[:i], "[]")
+	}
+	return n.HTTPAddr
+}
+
+// apiClient is a client of the Nomad HTTP API.
+type apiClient struct {
+	address   string
+	region    string
+	namespace string
+	secretID  string
+	http      *http.Client
+}
+
+func newAPIClient(config *Config) (*apiClient, error) {
+	if _, err := url.Parse(config.Address); err != nil {
+		return nil, fmt.Errorf("invalid address %s: %v", config.Address, err)
+	}
+
+	tlsConfig, err := tlscommon.LoadTLSConfig(config.TLS)
+	if err != nil {
+		return nil, err
+	}
+
+	transport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if tlsConfig != nil {
+		transport.TLSClientConfig = tlsConfig.ToConfig()
+	}
+
+	return &apiClient{
+		address:   strings.TrimRight(config.Address, "/"),
+		region:    config.Region,
+		namespace: config.Namespace,
+		secretID:  config.SecretID,
+		http:      &http.Client{Transport: transport},
+	}, nil
+}
+
+// allocations lists the allocations of the cluster. When index is not zero the
+// request is a blocking query, that returns when the allocations change after
+// that index or when the wait time is over.
+func (c *apiClient) allocations(ctx context.Context, index uint64, wait time.Duration) ([]allocationStub, uint64, error) {
+	query := url.Values{}
+	if index > 0 {
+		query.Set("index", strconv.FormatUint(index, 10))
+		query.Set("wait", fmt.Sprintf("%dms", wait.Milliseconds()))
+	}
+
+	var allocs []allocationStub
+	index, err := c.get(ctx, "/v1/allocations", query, &allocs)
+	return allocs, index, err
+}
+
+func (c *apiClient) allocation(ctx context.Context, id string) (*allocation, error) {
+	var alloc allocation
+	_, err := c.get(ctx, "/v1/allocation/"+url.PathEscape(id), nil, &alloc)
+	return &alloc, err
+}
+
+func (c *apiClient) node(ctx context.Context, id string) (*node, error) {
+	var n node
+	_, err := c.get(ctx, "/v1/node/"+url.PathEscape(id), nil, &n)
+	return &n, err
+}
+
+// nodeID returns the ID of the node with the given name or ID, or of the node
+// of the agent if empty.
+func (c *apiClient) nodeID(ctx context.Context, name string) (string, error) {
+	if name == "" {
+		var self struct {
+			Stats struct {
+				Client struct {
+					NodeID string `json:"node_id"`
+				} `json:"client"`
+			} `json:"stats"`
+		}
+		if _, err := c.get(ctx, "/v1/agent/self", nil, &self); err != nil {
+			return "", err
+		}
+		if self.Stats.Client.NodeID == "" {
+			return "", fmt.Errorf("agent at %s is not a Nomad client, set the node to discover", c.address)
+		}
+		return self.Stats.Client.NodeID, nil
+	}
+
+	var nodes []node
+	if _, err := c.get(ctx, "/v1/nodes", nil, &nodes); err != nil {
+		return "", err
+	}
+	for _, n := range nodes {
+		if n.ID == name || n.Name == name {
+			return n.ID, nil
+		}
+	}
+	return "", fmt.Errorf("node %s not found", name)
+}
+
+// get requests an endpoint of the API and decodes the response in out. It
+// returns the index of the response for blocking queries.
+func (c *apiClient) get(ctx context.Context, path string, query url.Values, out interface{}) (uint64, error) {
+	if query == nil {
+		query = url.Values{}
+	}
+	if c.region != "" {
+		query.Set("region", c.region)
+	}
+	if c.namespace != "" {
+		query.Set("namespace", c.namespace)
+	}
+
+	u := c.address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest("GET", u, nil)
+	if err != nil {
+		return 0, err
+	}
+	if c.secretID != "" {
+		req.Header.Set("X-Nomad-Token", c.secretID)
+	}
+
+	resp, err := c.http.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return 0, fmt.Errorf("request to %s failed with status %d: %s", path, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return 0, fmt.Errorf("invalid response of %s: %v", path, err)
+	}
+
+	index, _ := strconv.ParseUint(resp.Header.Get("X-Nomad-Index"), 10, 64)
+	return index, nil
+}
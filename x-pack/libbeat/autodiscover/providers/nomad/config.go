@@ -0,0 +1,62 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package nomad
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// Config for the nomad autodiscover provider.
+type Config struct {
+	Address   string            `config:"address"`
+	Region    string            `config:"region"`
+	Namespace string            `config:"namespace"`
+	SecretID  string            `config:"secret_id"`
+	TLS       *tlscommon.Config `config:"ssl"`
+
+	// Scope is `node` to discover the allocations of the local Nomad client,
+	// or `cluster` to discover the allocations of all the clients.
+	Scope string `config:"scope"`
+	// Node is the name or ID of the node discovered with the `node` scope,
+	// the node of the agent at Address by default.
+	Node string `config:"node"`
+
+	WaitTime       time.Duration `config:"wait_time" validate:"positive"`
+	RetryInterval  time.Duration `config:"retry_interval" validate:"positive"`
+	CleanupTimeout time.Duration `config:"cleanup_timeout" validate:"positive"`
+
+	Prefix    string                  `config:"prefix"`
+	Hints     *common.Config          `config:"hints"`
+	Builders  []*common.Config        `config:"builders"`
+	Appenders []*common.Config        `config:"appenders"`
+	Templates template.MapperSettings `config:"templates"`
+}
+
+func defaultConfig() *Config {
+	return &Config{
+		Address:        "http://127.0.0.1:4646",
+		Scope:          "node",
+		WaitTime:       5 * time.Minute,
+		RetryInterval:  10 * time.Second,
+		CleanupTimeout: 60 * time.Second,
+		Prefix:         "co.elastic",
+	}
+}
+
+// Validate ensures correctness of config
+func (c *Config) Validate() error {
+	if c.Scope != "node" && c.Scope != "cluster" {
+		return fmt.Errorf("invalid scope %q, must be node or cluster", c.Scope)
+	}
+	if c.Scope == "cluster" && c.Node != "" {
+		return fmt.Errorf("node cannot be set with the cluster scope")
+	}
+	return nil
+}
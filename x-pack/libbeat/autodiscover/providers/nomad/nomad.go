@@ -0,0 +1,344 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package nomad
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/builder"
+	"github.com/elastic/beats/v7/libbeat/autodiscover/template"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/common/cfgwarn"
+	"github.com/elastic/beats/v7/libbeat/common/safemapstr"
+	"github.com/elastic/beats/v7/libbeat/keystore"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func init() {
+	autodiscover.Registry.AddProvider("nomad", AutodiscoverBuilder)
+}
+
+// Provider implements autodiscover provider for Nomad allocations.
+type Provider struct {
+	config    *Config
+	bus       bus.Bus
+	uuid      uuid.UUID
+	builders  autodiscover.Builders
+	appenders autodiscover.Appenders
+	templates template.Mapper
+	watcher   *watcher
+	logger    *logp.Logger
+
+	mu       sync.Mutex
+	stoppers map[string]*time.Timer
+}
+
+// AutodiscoverBuilder builds and returns an autodiscover provider
+func AutodiscoverBuilder(bus bus.Bus, uuid uuid.UUID, c *common.Config, keystore keystore.Keystore) (autodiscover.Provider, error) {
+	cfgwarn.Experimental("nomad autodiscover is experimental")
+
+	errWrap := func(err error) error {
+		return errors.Wrap(err, "error setting up nomad autodiscover provider")
+	}
+
+	config := defaultConfig()
+	if err := c.Unpack(&config); err != nil {
+		return nil, errWrap(err)
+	}
+
+	client, err := newAPIClient(config)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+
+	var nodeID string
+	if config.Scope == "node" {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		nodeID, err = client.nodeID(ctx, config.Node)
+		if err != nil {
+			return nil, errWrap(err)
+		}
+	}
+
+	p, err := internalBuilder(bus, uuid, config, client, nodeID, keystore)
+	if err != nil {
+		return nil, errWrap(err)
+	}
+	return p, nil
+}
+
+func internalBuilder(bus bus.Bus, uuid uuid.UUID, config *Config, client *apiClient, nodeID string, keystore keystore.Keystore) (*Provider, error) {
+	mapper, err := template.NewConfigMapper(config.Templates, keystore, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(mapper.ConditionMaps) == 0 && !config.Hints.Enabled() {
+		return nil, fmt.Errorf("no configs or hints defined for autodiscover provider")
+	}
+
+	builders, err := autodiscover.NewBuilders(config.Builders, config.Hints, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	appenders, err := autodiscover.NewAppenders(config.Appenders)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Provider{
+		config:    config,
+		bus:       bus,
+		uuid:      uuid,
+		builders:  builders,
+		appenders: appenders,
+		templates: mapper,
+		logger:    logp.NewLogger("autodiscover.nomad"),
+		stoppers:  map[string]*time.Timer{},
+	}
+	p.watcher = newWatcher(client, nodeID, config.WaitTime, config.RetryInterval, p.onStart, p.onStop)
+	return p, nil
+}
+
+// Start the autodiscover process
+func (p *Provider) Start() {
+	p.watcher.start()
+}
+
+// Stop the autodiscover process
+func (p *Provider) Stop() {
+	p.watcher.stop()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, stopper := range p.stoppers {
+		stopper.Stop()
+	}
+}
+
+func (p *Provider) String() string {
+	return "nomad"
+}
+
+func (p *Provider) onStart(alloc *allocation, n *node) {
+	for _, event := range p.allocationEvents(alloc, n, "start") {
+		p.publish(event)
+	}
+}
+
+// onStop emits the stop events of the allocation after the cleanup timeout,
+// so inputs have some time to collect the last logs of the tasks.
+func (p *Provider) onStop(alloc *allocation) {
+	events := p.allocationEvents(alloc, nil, "stop")
+	if p.config.CleanupTimeout <= 0 {
+		for _, event := range events {
+			p.publish(event)
+		}
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.stoppers[alloc.ID] = time.AfterFunc(p.config.CleanupTimeout, func() {
+		p.mu.Lock()
+		delete(p.stoppers, alloc.ID)
+		p.mu.Unlock()
+
+		for _, event := range events {
+			p.publish(event)
+		}
+	})
+}
+
+// allocationEvents returns the events of the tasks of an allocation, one per
+// port of the task, or a single one if the task has no ports.
+func (p *Provider) allocationEvents(alloc *allocation, n *node, flag string) []bus.Event {
+	group := alloc.taskGroup()
+	if group == nil {
+		p.logger.Debugf("Allocation %s has no task group %s", alloc.ID, alloc.TaskGroup)
+		return nil
+	}
+
+	var events []bus.Event
+	for _, t := range group.Tasks {
+		host, ports := alloc.taskNetwork(t.Name)
+		if host == "" && n != nil {
+			host = n.address()
+		}
+
+		meta := p.taskMetadata(alloc, group, t, n)
+		event := bus.Event{
+			"provider": p.uuid,
+			"id":       alloc.ID + "." + t.Name,
+			flag:       true,
+			"host":     host,
+			"nomad":    meta,
+			"meta": common.MapStr{
+				"nomad": meta,
+			},
+		}
+
+		if len(ports) == 0 {
+			events = append(events, event)
+			continue
+		}
+		event["ports"] = ports
+		for _, number := range ports {
+			portEvent := bus.Event{}
+			for k, v := range event {
+				portEvent[k] = v
+			}
+			portEvent["port"] = number
+			events = append(events, portEvent)
+		}
+	}
+	return events
+}
+
+func (p *Provider) taskMetadata(alloc *allocation, group *taskGroup, t task, n *node) common.MapStr {
+	meta := common.MapStr{
+		"allocation": common.MapStr{
+			"id":     alloc.ID,
+			"name":   alloc.Name,
+			"status": alloc.ClientStatus,
+		},
+		"job": common.MapStr{
+			"name": alloc.JobID,
+		},
+		"task": common.MapStr{
+			"name":       t.Name,
+			"group_name": group.Name,
+			"meta":       taskMeta(alloc.Job, group, t),
+		},
+		"namespace": alloc.Namespace,
+	}
+	if alloc.Job != nil {
+		meta.Put("job.type", alloc.Job.Type)
+		if alloc.Job.Region != "" {
+			meta.Put("region", alloc.Job.Region)
+		}
+	}
+	if n != nil {
+		meta.Put("node.id", n.ID)
+		if n.Name != "" {
+			meta.Put("node.name", n.Name)
+		}
+		if n.Datacenter != "" {
+			meta.Put("datacenter", n.Datacenter)
+		}
+	} else {
+		meta.Put("node.id", alloc.NodeID)
+		if alloc.NodeName != "" {
+			meta.Put("node.name", alloc.NodeName)
+		}
+	}
+	return meta
+}
+
+// taskMeta merges the meta of the job, of the group and of the task, with the
+// most specific ones taking precedence, like Nomad does.
+func taskMeta(j *job, group *taskGroup, t task) common.MapStr {
+	meta := common.MapStr{}
+	var sources []map[string]string
+	if j != nil {
+		sources = append(sources, j.Meta)
+	}
+	sources = append(sources, group.Meta, t.Meta)
+	for _, source := range sources {
+		for k, v := range source {
+			safemapstr.Put(meta, k, v)
+		}
+	}
+	return meta
+}
+
+func (p *Provider) publish(event bus.Event) {
+	// Try to match a config
+	if config := p.templates.GetConfig(event); config != nil {
+		event["config"] = config
+	} else {
+		// If there isn't a default template then attempt to use builders
+		if config := p.builders.GetConfig(p.generateHints(event)); config != nil {
+			event["config"] = config
+		}
+	}
+
+	// Call all appenders to append any extra configuration
+	p.appenders.Append(event)
+
+	p.bus.Publish(event)
+}
+
+func (p *Provider) generateHints(event bus.Event) bus.Event {
+	// Try to build a config with enabled builders. Send a provider agnostic payload.
+	// Builders are Beat specific.
+	e := bus.Event{}
+	for _, key := range []string{"host", "port", "ports", "nomad"} {
+		if value, ok := event[key]; ok {
+			e[key] = value
+		}
+	}
+
+	if meta, err := common.MapStr(event).GetValue("nomad.task.meta"); err == nil {
+		e["hints"] = builder.GenerateHints(meta.(common.MapStr), "", p.config.Prefix)
+	}
+	return e
+}
+
+func (a *allocation) taskGroup() *taskGroup {
+	if a.Job == nil {
+		return nil
+	}
+	for i := range a.Job.TaskGroups {
+		if a.Job.TaskGroups[i].Name == a.TaskGroup {
+			return &a.Job.TaskGroups[i]
+		}
+	}
+	return nil
+}
+
+// taskNetwork returns the address and the named ports of a task, from the
+// networks of the task, or from the networks shared by the group.
+func (a *allocation) taskNetwork(name string) (string, common.MapStr) {
+	if a.AllocatedResources == nil {
+		return "", nil
+	}
+
+	var host string
+	ports := common.MapStr{}
+	var networks []network
+	networks = append(networks, a.AllocatedResources.Tasks[name].Networks...)
+	networks = append(networks, a.AllocatedResources.Shared.Networks...)
+	for _, network := range networks {
+		if host == "" {
+			host = network.IP
+		}
+		for _, list := range [][]port{network.ReservedPorts, network.DynamicPorts} {
+			for _, p := range list {
+				ports[p.Label] = p.Value
+			}
+		}
+	}
+	for _, p := range a.AllocatedResources.Shared.Ports {
+		if host == "" {
+			host = p.HostIP
+		}
+		ports[p.Label] = p.Value
+	}
+
+	if len(ports) == 0 {
+		ports = nil
+	}
+	return host, ports
+}
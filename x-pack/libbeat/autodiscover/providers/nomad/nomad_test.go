@@ -0,0 +1,295 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/autodiscover/providers/providertest"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/bus"
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	testNodeID  = "f3b2d7c1-2b4e-4a71-9c43-1d0b6f1e8a10"
+	testAllocID = "5456bd7a-9fc0-c0dd-6131-cbee77f57577"
+)
+
+var testAllocation = allocation{
+	ID:           testAllocID,
+	Name:         "web.frontend[0]",
+	Namespace:    "default",
+	NodeID:       testNodeID,
+	NodeName:     "nomad-client-1",
+	JobID:        "web",
+	TaskGroup:    "frontend",
+	ClientStatus: statusRunning,
+	Job: &job{
+		ID:     "web",
+		Name:   "web",
+		Type:   "service",
+		Region: "global",
+		Meta:   map[string]string{"team": "shop", "co.elastic.metrics/period": "30s"},
+		TaskGroups: []taskGroup{{
+			Name: "frontend",
+			Meta: map[string]string{"co.elastic.metrics/module": "nginx"},
+			Tasks: []task{
+				{
+					Name:   "nginx",
+					Driver: "docker",
+					Meta: map[string]string{
+						"co.elastic.metrics/hosts":          "${data.host}:${data.ports.status}",
+						"co.elastic.metrics/period":         "10s",
+						"co.elastic.logs/multiline.pattern": "^\\[",
+					},
+				},
+				{Name: "sidecar", Driver: "exec"},
+			},
+		}},
+	},
+	AllocatedResources: &allocatedResources{
+		Tasks: map[string]taskResources{
+			"nginx": {Networks: []network{{
+				IP:           "10.0.0.12",
+				DynamicPorts: []port{{Label: "http", Value: 23412}, {Label: "status", Value: 23413}},
+			}}},
+		},
+	},
+}
+
+var testNode = node{
+	ID:         testNodeID,
+	Name:       "nomad-client-1",
+	Datacenter: "dc1",
+	HTTPAddr:   "10.0.0.5:4646",
+	Attributes: map[string]string{"unique.network.ip-address": "10.0.0.5"},
+}
+
+// fakeNomad serves the endpoints of the Nomad API used by the provider.
+type fakeNomad struct {
+	mu     sync.Mutex
+	index  uint64
+	status string
+}
+
+func (f *fakeNomad) setStatus(status string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.status = status
+	f.index++
+}
+
+func (f *fakeNomad) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	w.Header().Set("X-Nomad-Index", "1")
+	var body interface{}
+	switch r.URL.Path {
+	case "/v1/agent/self":
+		body = map[string]interface{}{"stats": map[string]interface{}{"client": map[string]string{"node_id": testNodeID}}}
+	case "/v1/nodes":
+		body = []node{testNode}
+	case "/v1/node/" + testNodeID:
+		body = testNode
+	case "/v1/allocations":
+		w.Header().Set("X-Nomad-Index", strconv.FormatUint(f.index+1, 10))
+		body = []allocationStub{
+			{ID: testAllocID, NodeID: testNodeID, ClientStatus: f.status},
+			{ID: "other", NodeID: "other-node", ClientStatus: statusRunning},
+		}
+	case "/v1/allocation/" + testAllocID:
+		alloc := testAllocation
+		alloc.ClientStatus = f.status
+		body = alloc
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	json.NewEncoder(w).Encode(body)
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *apiClient {
+	client, err := newAPIClient(&Config{Address: server.URL})
+	require.NoError(t, err)
+	return client
+}
+
+func TestNodeID(t *testing.T) {
+	server := httptest.NewServer(&fakeNomad{})
+	defer server.Close()
+	client := newTestClient(t, server)
+
+	for _, name := range []string{"", "nomad-client-1", testNodeID} {
+		id, err := client.nodeID(context.Background(), name)
+		require.NoError(t, err)
+		assert.Equal(t, testNodeID, id)
+	}
+
+	_, err := client.nodeID(context.Background(), "unknown")
+	assert.Error(t, err)
+}
+
+func TestWatcher(t *testing.T) {
+	fake := &fakeNomad{status: statusRunning}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	var started, stopped []string
+	var startedNode *node
+	w := newWatcher(newTestClient(t, server), testNodeID, time.Second, time.Second,
+		func(alloc *allocation, n *node) {
+			started = append(started, alloc.ID)
+			startedNode = n
+		},
+		func(alloc *allocation) { stopped = append(stopped, alloc.ID) },
+	)
+
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{testAllocID}, started)
+	assert.Empty(t, stopped)
+	require.NotNil(t, startedNode)
+	assert.Equal(t, "dc1", startedNode.Datacenter)
+
+	// Running allocations are only notified once.
+	require.NoError(t, w.once())
+	assert.Len(t, started, 1)
+
+	fake.setStatus("complete")
+	require.NoError(t, w.once())
+	assert.Equal(t, []string{testAllocID}, stopped)
+	assert.Empty(t, w.allocations)
+}
+
+func TestAllocationEvents(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	alloc := testAllocation
+	events := p.allocationEvents(&alloc, &testNode, "start")
+	require.Len(t, events, 3)
+
+	var nginx []bus.Event
+	var sidecar bus.Event
+	for _, event := range events {
+		switch event["id"] {
+		case testAllocID + ".nginx":
+			nginx = append(nginx, event)
+		case testAllocID + ".sidecar":
+			sidecar = event
+		}
+	}
+
+	require.Len(t, nginx, 2)
+	sort.Slice(nginx, func(i, j int) bool { return nginx[i]["port"].(int) < nginx[j]["port"].(int) })
+	assert.Equal(t, 23412, nginx[0]["port"])
+	assert.Equal(t, 23413, nginx[1]["port"])
+	assert.Equal(t, "10.0.0.12", nginx[0]["host"])
+	assert.Equal(t, common.MapStr{"http": 23412, "status": 23413}, nginx[0]["ports"])
+	assert.Equal(t, true, nginx[0]["start"])
+
+	meta := nginx[0]["nomad"].(common.MapStr)
+	assert.Equal(t, common.MapStr{
+		"allocation": common.MapStr{"id": testAllocID, "name": "web.frontend[0]", "status": statusRunning},
+		"job":        common.MapStr{"name": "web", "type": "service"},
+		"task": common.MapStr{
+			"name":       "nginx",
+			"group_name": "frontend",
+			"meta": common.MapStr{
+				"team": "shop",
+				"co": common.MapStr{"elastic": common.MapStr{
+					"metrics/module": "nginx",
+					"metrics/period": "10s",
+					"metrics/hosts":  "${data.host}:${data.ports.status}",
+					"logs/multiline": common.MapStr{"pattern": "^\\["},
+				}},
+			},
+		},
+		"namespace":  "default",
+		"region":     "global",
+		"datacenter": "dc1",
+		"node":       common.MapStr{"id": testNodeID, "name": "nomad-client-1"},
+	}, meta)
+
+	// Tasks without network use the address of the node.
+	require.NotNil(t, sidecar)
+	assert.Equal(t, "10.0.0.5", sidecar["host"])
+	assert.NotContains(t, sidecar, "port")
+}
+
+func TestGenerateHints(t *testing.T) {
+	p := newTestProvider(t, nil)
+
+	alloc := testAllocation
+	events := p.allocationEvents(&alloc, &testNode, "start")
+	require.NotEmpty(t, events)
+
+	hints := p.generateHints(events[0])
+	assert.Equal(t, common.MapStr{
+		"metrics": common.MapStr{
+			"module": "nginx",
+			"period": "10s",
+			"hosts":  "${data.host}:${data.ports.status}",
+		},
+		"logs": common.MapStr{
+			"multiline": common.MapStr{"pattern": "^\\["},
+		},
+	}, hints["hints"])
+	assert.Equal(t, "10.0.0.12", hints["host"])
+	assert.Contains(t, hints, "ports")
+	assert.Contains(t, hints, "nomad")
+}
+
+func TestProviderStop(t *testing.T) {
+	fake := &fakeNomad{status: statusRunning}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	b := bus.New(logp.L(), "test")
+	listener := b.Subscribe()
+	defer listener.Stop()
+
+	p := newTestProvider(t, b)
+	p.config.CleanupTimeout = 10 * time.Millisecond
+	p.watcher.client = newTestClient(t, server)
+	p.watcher.nodeID = testNodeID
+
+	require.NoError(t, p.watcher.once())
+	fake.setStatus("complete")
+	require.NoError(t, p.watcher.once())
+
+	var start, stop int
+	for _, event := range providertest.Events(t, listener, 6) {
+		if _, ok := event["start"]; ok {
+			start++
+		}
+		if _, ok := event["stop"]; ok {
+			stop++
+		}
+	}
+	assert.Equal(t, 3, start)
+	assert.Equal(t, 3, stop)
+}
+
+func newTestProvider(t *testing.T, b bus.Bus) *Provider {
+	config := defaultConfig()
+	providertest.Unpack(t, config, nil, "nomad.task.name", "nginx")
+
+	p, err := internalBuilder(providertest.Bus(b), uuid.Nil, config, &apiClient{}, "", nil)
+	require.NoError(t, err)
+	return p
+}
@@ -0,0 +1,157 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package nomad
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const statusRunning = "running"
+
+// watcher follows the allocations of Nomad with blocking queries, and notifies
+// when allocations start or stop running.
+type watcher struct {
+	client        *apiClient
+	nodeID        string
+	waitTime      time.Duration
+	retryInterval time.Duration
+	onStart       func(alloc *allocation, n *node)
+	onStop        func(alloc *allocation)
+
+	index       uint64
+	allocations map[string]*allocation
+	nodes       map[string]*node
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+	logger *logp.Logger
+}
+
+func newWatcher(
+	client *apiClient,
+	nodeID string,
+	waitTime, retryInterval time.Duration,
+	onStart func(alloc *allocation, n *node),
+	onStop func(alloc *allocation),
+) *watcher {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &watcher{
+		client:        client,
+		nodeID:        nodeID,
+		waitTime:      waitTime,
+		retryInterval: retryInterval,
+		onStart:       onStart,
+		onStop:        onStop,
+		allocations:   map[string]*allocation{},
+		nodes:         map[string]*node{},
+		ctx:           ctx,
+		cancel:        cancel,
+		done:          make(chan struct{}),
+		logger:        logp.NewLogger("autodiscover.nomad"),
+	}
+}
+
+func (w *watcher) start() {
+	go w.forever()
+}
+
+func (w *watcher) stop() {
+	w.cancel()
+	<-w.done
+}
+
+func (w *watcher) forever() {
+	defer close(w.done)
+	for {
+		err := w.once()
+		if w.ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			w.logger.Error(errors.Wrap(err, "error while watching Nomad allocations"))
+			select {
+			case <-w.ctx.Done():
+				return
+			case <-time.After(w.retryInterval):
+			}
+		}
+	}
+}
+
+// once waits for changes in the allocations and notifies them.
+// This is mostly useful for testing.
+func (w *watcher) once() error {
+	// Allow some margin over the wait time, as Nomad adds some jitter to it.
+	ctx, cancel := context.WithTimeout(w.ctx, w.waitTime+w.waitTime/16+10*time.Second)
+	defer cancel()
+
+	stubs, index, err := w.client.allocations(ctx, w.index, w.waitTime)
+	if err != nil {
+		return err
+	}
+	// Indexes going backwards must be reset, as described in the Nomad
+	// documentation for blocking queries.
+	if index < w.index {
+		index = 0
+	}
+	w.index = index
+	w.logger.Debugf("fetched %d allocations from Nomad for autodiscover", len(stubs))
+
+	running := map[string]bool{}
+	for _, stub := range stubs {
+		if w.nodeID != "" && stub.NodeID != w.nodeID {
+			continue
+		}
+		if stub.ClientStatus != statusRunning {
+			continue
+		}
+		running[stub.ID] = true
+		if _, known := w.allocations[stub.ID]; known {
+			continue
+		}
+
+		alloc, err := w.client.allocation(ctx, stub.ID)
+		if err != nil {
+			w.logger.Errorf("Failed to get allocation %s: %v", stub.ID, err)
+			delete(running, stub.ID)
+			continue
+		}
+		w.allocations[stub.ID] = alloc
+		if w.onStart != nil {
+			w.onStart(alloc, w.node(ctx, alloc.NodeID))
+		}
+	}
+
+	for id, alloc := range w.allocations {
+		if !running[id] {
+			delete(w.allocations, id)
+			if w.onStop != nil {
+				w.onStop(alloc)
+			}
+		}
+	}
+	return nil
+}
+
+// node returns the node of the given ID, nodes are cached as their metadata
+// doesn't change while allocations run on them.
+func (w *watcher) node(ctx context.Context, id string) *node {
+	if n, ok := w.nodes[id]; ok {
+		return n
+	}
+	n, err := w.client.node(ctx, id)
+	if err != nil {
+		w.logger.Errorf("Failed to get node %s: %v", id, err)
+		return &node{ID: id}
+	}
+	w.nodes[id] = n
+	return n
+}
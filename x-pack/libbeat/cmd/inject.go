@@ -18,7 +18,13 @@ import (
 
 	// register autodiscover providers
 	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/aws/ec2"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/aws/ecs"
 	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/aws/elb"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/azure/vmss"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/cloudfoundry"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/consul"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/gcp/gce"
+	_ "github.com/elastic/beats/v7/x-pack/libbeat/autodiscover/providers/nomad"
 )
 
 // AddXPack extends the given root folder with XPack features
@@ -24,6 +24,15 @@ type ConfigAWS struct {
 	SharedCredentialFile string `config:"shared_credential_file"`
 	Endpoint             string `config:"endpoint"`
 	RoleArn              string `config:"role_arn"`
+	ExternalID           string `config:"external_id"`
+
+	AssumeRoleChain []AssumeRoleConfig `config:"assume_role_chain"`
+}
+
+// AssumeRoleConfig is an IAM role to assume
+type AssumeRoleConfig struct {
+	RoleArn    string `config:"role_arn" validate:"required"`
+	ExternalID string `config:"external_id"`
 }
 
 // GetAWSCredentials function gets aws credentials from the config.
@@ -31,7 +40,36 @@ type ConfigAWS struct {
 // If role_arn is given, assume the IAM role instead.
 // If none of the above is given, then load from aws config file. If credential_profile_name is not
 // given, then load default profile from the aws config file.
+// If assume_role_chain is given, its roles are assumed in order starting with these credentials.
 func GetAWSCredentials(config ConfigAWS) (awssdk.Config, error) {
+	awsConfig, err := getBaseAWSCredentials(config)
+	if err != nil {
+		return awsConfig, err
+	}
+	return AssumeRoleChain(awsConfig, config.AssumeRoleChain), nil
+}
+
+// AssumeRoleChain returns a copy of the AWS config using the credentials of the
+// last role in the chain. Each role is assumed with the credentials of the
+// previous one, which allows to hop through several accounts.
+func AssumeRoleChain(awsConfig awssdk.Config, chain []AssumeRoleConfig) awssdk.Config {
+	for _, role := range chain {
+		awsConfig = assumeRole(awsConfig, role.RoleArn, role.ExternalID)
+	}
+	return awsConfig
+}
+
+func assumeRole(awsConfig awssdk.Config, roleArn, externalID string) awssdk.Config {
+	awsConfig = awsConfig.Copy()
+	stsCredProvider := stscreds.NewAssumeRoleProvider(sts.New(awsConfig), roleArn)
+	if externalID != "" {
+		stsCredProvider.ExternalID = awssdk.String(externalID)
+	}
+	awsConfig.Credentials = stsCredProvider
+	return awsConfig
+}
+
+func getBaseAWSCredentials(config ConfigAWS) (awssdk.Config, error) {
 	logger := logp.NewLogger("get_aws_credentials")
 
 	// Check if accessKeyID or secretAccessKey or sessionToken is given from configuration
@@ -60,10 +98,7 @@ func GetAWSCredentials(config ConfigAWS) (awssdk.Config, error) {
 		if err != nil {
 			return awsConfig, errors.Wrap(err, "external.LoadDefaultAWSConfig failed when using role_arn")
 		}
-		stsSvc := sts.New(awsConfig)
-		stsCredProvider := stscreds.NewAssumeRoleProvider(stsSvc, config.RoleArn)
-		awsConfig.Credentials = stsCredProvider
-		return awsConfig, nil
+		return assumeRole(awsConfig, config.RoleArn, config.ExternalID), nil
 	}
 
 	// If accessKeyID, secretAccessKey or sessionToken is not given, iam_role is not given, then load from default config
@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestGetAWSCredentials(t *testing.T) {
@@ -65,3 +68,32 @@ func TestEnrichAWSConfigWithEndpoint(t *testing.T) {
 		})
 	}
 }
+
+func TestAssumeRoleChain(t *testing.T) {
+	inputConfig := ConfigAWS{
+		AccessKeyID:     "123",
+		SecretAccessKey: "abc",
+		AssumeRoleChain: []AssumeRoleConfig{
+			{RoleArn: "arn:aws:iam::111111111111:role/hub"},
+			{RoleArn: "arn:aws:iam::222222222222:role/logs", ExternalID: "beats"},
+		},
+	}
+	awsConfig, err := GetAWSCredentials(inputConfig)
+	assert.NoError(t, err)
+
+	last, ok := awsConfig.Credentials.(*stscreds.AssumeRoleProvider)
+	require.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::222222222222:role/logs", last.RoleARN)
+	assert.Equal(t, awssdk.String("beats"), last.ExternalID)
+
+	// The last role is assumed with the credentials of the previous one
+	first, ok := last.Client.(*sts.Client).Credentials.(*stscreds.AssumeRoleProvider)
+	require.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::111111111111:role/hub", first.RoleARN)
+	assert.Nil(t, first.ExternalID)
+
+	// And the first one with the static credentials
+	static, err := first.Client.(*sts.Client).Credentials.Retrieve()
+	assert.NoError(t, err)
+	assert.Equal(t, "123", static.AccessKeyID)
+}
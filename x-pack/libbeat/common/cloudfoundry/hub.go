@@ -40,6 +40,16 @@ func NewHub(cfg *Config, userAgent string, log *logp.Logger) *Hub {
 
 // Client returns the cloudfoundry client.
 func (h *Hub) Client() (Client, error) {
+	cf, err := h.ClientWithoutCache()
+	if err != nil {
+		return nil, err
+	}
+	return newClientCacheWrap(cf, h.cfg.CacheDuration, h.log), nil
+}
+
+// ClientWithoutCache returns the cloudfoundry client, without the cache of
+// applications, to make requests to any endpoint of the API.
+func (h *Hub) ClientWithoutCache() (*cfclient.Client, error) {
 	httpClient, insecure, err := h.httpClient()
 	if err != nil {
 		return nil, err
@@ -67,7 +77,7 @@ func (h *Hub) Client() (Client, error) {
 	if h.cfg.UaaAddress != "" {
 		cf.Endpoint.AuthEndpoint = h.cfg.UaaAddress
 	}
-	return newClientCacheWrap(cf, h.cfg.CacheDuration, h.log), nil
+	return cf, nil
 }
 
 // RlpListener returns a listener client that calls the passed callback when the provided events are streamed through
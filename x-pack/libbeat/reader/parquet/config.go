@@ -0,0 +1,50 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"errors"
+	"time"
+)
+
+// Config is the configuration of the decoding of parquet files into events.
+type Config struct {
+	Enabled bool `config:"enabled"`
+
+	// TargetField is the field under which the columns without mapping are
+	// stored, they are stored at the root of the event if it is empty.
+	TargetField string `config:"target_field"`
+
+	// Columns maps columns to event fields.
+	Columns []ColumnConfig `config:"columns"`
+
+	// TimeColumn is the column used as timestamp of the events.
+	TimeColumn string `config:"time_column"`
+
+	// MaxAge skips the rows older than this, row groups only containing older
+	// rows are not decoded.
+	MaxAge time.Duration `config:"max_age" validate:"min=0"`
+}
+
+// ColumnConfig maps a column to an event field.
+type ColumnConfig struct {
+	Column string `config:"column" validate:"required"`
+	Field  string `config:"field" validate:"required"`
+}
+
+// DefaultConfig returns the default configuration of the parquet decoding.
+func DefaultConfig() Config {
+	return Config{
+		TargetField: "parquet",
+	}
+}
+
+// Validate checks the configuration.
+func (c *Config) Validate() error {
+	if c.MaxAge > 0 && c.TimeColumn == "" {
+		return errors.New("max_age requires time_column to be set")
+	}
+	return nil
+}
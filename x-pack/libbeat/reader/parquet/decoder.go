@@ -0,0 +1,95 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// Decode decodes the rows of the parquet file in data into event fields, it
+// calls fn with the index of each row, its fields and its time if a time
+// column is configured. Repeated columns are not supported and are ignored.
+func (c *Config) Decode(data []byte, now time.Time, fn func(row int64, fields common.MapStr, ts time.Time) error) error {
+	f, err := Open(data)
+	if err != nil {
+		return err
+	}
+
+	fields := map[string]string{}
+	for _, m := range c.Columns {
+		fields[m.Column] = m.Field
+	}
+
+	var columns []*Column
+	timeIndex := -1
+	for _, column := range f.Columns() {
+		if column.Repeated() {
+			continue
+		}
+		if column.Name == c.TimeColumn {
+			timeIndex = len(columns)
+		}
+		if _, found := fields[column.Name]; !found {
+			fields[column.Name] = column.Name
+			if c.TargetField != "" {
+				fields[column.Name] = c.TargetField + "." + column.Name
+			}
+		}
+		columns = append(columns, column)
+	}
+	if c.TimeColumn != "" && timeIndex < 0 {
+		return fmt.Errorf("time column %s not found", c.TimeColumn)
+	}
+
+	var cutoff time.Time
+	if c.MaxAge > 0 {
+		cutoff = now.Add(-c.MaxAge)
+	}
+
+	var row int64
+	for rg := 0; rg < f.NumRowGroups(); rg++ {
+		numRows := f.rowGroups[rg].int(3, 0)
+
+		// Skip the row groups whose rows are all too old
+		if !cutoff.IsZero() {
+			if _, max, ok := f.Stats(rg, columns[timeIndex]); ok {
+				if t, ok := max.(time.Time); ok && t.Before(cutoff) {
+					row += numRows
+					continue
+				}
+			}
+		}
+
+		values, err := f.ReadRowGroup(rg, columns)
+		if err != nil {
+			return fmt.Errorf("failed to read row group %d: %v", rg, err)
+		}
+
+		for i := 0; i < int(numRows); i++ {
+			var ts time.Time
+			if timeIndex >= 0 {
+				ts, _ = values[timeIndex][i].(time.Time)
+				if !cutoff.IsZero() && !ts.IsZero() && ts.Before(cutoff) {
+					continue
+				}
+			}
+
+			event := common.MapStr{}
+			for j, column := range columns {
+				if v := values[j][i]; v != nil {
+					event.Put(fields[column.Name], v)
+				}
+			}
+			if err := fn(row+int64(i), event, ts); err != nil {
+				return err
+			}
+		}
+		row += numRows
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestDecode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/flow_logs.parquet")
+	require.NoError(t, err)
+	start := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+
+	type row struct {
+		index  int64
+		fields common.MapStr
+		ts     time.Time
+	}
+	decode := func(config Config, now time.Time) []row {
+		var rows []row
+		err := config.Decode(data, now, func(index int64, fields common.MapStr, ts time.Time) error {
+			rows = append(rows, row{index, fields, ts})
+			return nil
+		})
+		require.NoError(t, err)
+		return rows
+	}
+
+	t.Run("column mapping", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Columns = []ColumnConfig{
+			{Column: "srcaddr", Field: "source.ip"},
+			{Column: "packets", Field: "network.packets"},
+		}
+		config.TimeColumn = "start"
+
+		rows := decode(config, time.Now())
+		require.Len(t, rows, 5)
+		assert.Equal(t, row{
+			index: 0,
+			fields: common.MapStr{
+				"source":  common.MapStr{"ip": "10.0.0.1"},
+				"network": common.MapStr{"packets": int64(10)},
+				"parquet": common.MapStr{
+					"version":       int32(2),
+					"start":         start,
+					"action":        "ACCEPT",
+					"log_status_ok": true,
+				},
+			},
+			ts: start,
+		}, rows[0])
+		assert.Equal(t, row{
+			index: 1,
+			fields: common.MapStr{
+				"network": common.MapStr{"packets": int64(20)},
+				"parquet": common.MapStr{
+					"version":       int32(2),
+					"start":         start.Add(time.Second),
+					"action":        "REJECT",
+					"log_status_ok": false,
+				},
+			},
+			ts: start.Add(time.Second),
+		}, rows[1])
+		assert.Equal(t, int64(4), rows[4].index)
+	})
+
+	t.Run("root target", func(t *testing.T) {
+		config := DefaultConfig()
+		config.TargetField = ""
+
+		rows := decode(config, time.Now())
+		require.Len(t, rows, 5)
+		assert.Equal(t, "10.0.0.4", rows[3].fields["srcaddr"])
+		assert.True(t, rows[3].ts.IsZero())
+	})
+
+	t.Run("max age", func(t *testing.T) {
+		config := DefaultConfig()
+		config.TimeColumn = "start"
+
+		// The first row group only has older rows
+		config.MaxAge = time.Hour
+		rows := decode(config, start.Add(time.Hour+time.Minute))
+		require.Len(t, rows, 2)
+		assert.Equal(t, int64(3), rows[0].index)
+		assert.Equal(t, int64(4), rows[1].index)
+
+		// Older rows in the row group are skipped
+		rows = decode(config, start.Add(time.Hour+time.Second/2))
+		require.Len(t, rows, 4)
+		assert.Equal(t, int64(1), rows[0].index)
+	})
+
+	t.Run("unknown time column", func(t *testing.T) {
+		config := DefaultConfig()
+		config.TimeColumn = "end"
+		err := config.Decode(data, time.Now(), func(int64, common.MapStr, time.Time) error { return nil })
+		assert.Error(t, err)
+	})
+}
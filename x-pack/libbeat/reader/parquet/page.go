@@ -0,0 +1,411 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/bits"
+	"sync"
+
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+)
+
+// Page types.
+const (
+	pageData       = 0
+	pageIndex      = 1
+	pageDictionary = 2
+	pageDataV2     = 3
+)
+
+// Encodings.
+const (
+	encodingPlain           = 0
+	encodingPlainDictionary = 2
+	encodingRLE             = 3
+	encodingBitPacked       = 4
+	encodingRLEDictionary   = 8
+)
+
+// Compression codecs.
+const (
+	codecUncompressed = 0
+	codecSnappy       = 1
+	codecGzip         = 2
+	codecZstd         = 6
+)
+
+var (
+	zstdOnce    sync.Once
+	zstdDecoder *zstd.Decoder
+	zstdErr     error
+)
+
+// readChunk decodes the pages of a column chunk, it returns a value per row
+// with nil for null values.
+func (f *File) readChunk(meta tstruct, column *Column, numRows int) ([]interface{}, error) {
+	codec := meta.int(4, codecUncompressed)
+	numValues := meta.int(5, 0)
+	offset := meta.int(9, 0)
+	if dictOffset := meta.int(11, 0); dictOffset > 0 && dictOffset < offset {
+		offset = dictOffset
+	}
+	end := offset + meta.int(7, 0)
+	if offset < 0 || end > int64(len(f.data)) || end < offset {
+		return nil, fmt.Errorf("invalid column chunk bounds %d-%d", offset, end)
+	}
+
+	var dict []interface{}
+	values := make([]interface{}, 0, numRows)
+	for pos, read := offset, int64(0); read < numValues; {
+		d := thriftDecoder{buf: f.data[pos:end]}
+		header, err := d.readStruct(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read page header: %v", err)
+		}
+		pos += int64(d.pos)
+
+		size := header.int(3, 0)
+		if size < 0 || pos+size > end {
+			return nil, fmt.Errorf("invalid page size %d", size)
+		}
+		body := f.data[pos : pos+size]
+		pos += size
+		uncompressedSize := int(header.int(2, 0))
+
+		switch header.int(1, -1) {
+		case pageDictionary:
+			page, err := decompress(codec, body, uncompressedSize)
+			if err != nil {
+				return nil, err
+			}
+			dict, err = decodePlain(page, column, int(header.sub(7).int(1, 0)))
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode dictionary page: %v", err)
+			}
+
+		case pageData:
+			page, err := decompress(codec, body, uncompressedSize)
+			if err != nil {
+				return nil, err
+			}
+			h := header.sub(5)
+			n := int(h.int(1, 0))
+			defs, page, err := readLevels(page, column.maxDef, n, h.int(3, encodingRLE))
+			if err != nil {
+				return nil, err
+			}
+			values, err = appendPageValues(values, page, column, h.int(2, encodingPlain), defs, n, dict)
+			if err != nil {
+				return nil, err
+			}
+			read += int64(n)
+
+		case pageDataV2:
+			h := header.sub(8)
+			n := int(h.int(1, 0))
+			defLen, repLen := int(h.int(5, 0)), int(h.int(6, 0))
+			if defLen < 0 || repLen < 0 || defLen+repLen > len(body) {
+				return nil, fmt.Errorf("invalid levels size in data page")
+			}
+			var defs []uint32
+			if column.maxDef > 0 {
+				defs, err = decodeHybrid(body[repLen:repLen+defLen], bits.Len(uint(column.maxDef)), n)
+				if err != nil {
+					return nil, fmt.Errorf("failed to decode definition levels: %v", err)
+				}
+			}
+			page := body[repLen+defLen:]
+			if h.bool(7, true) {
+				page, err = decompress(codec, page, uncompressedSize-defLen-repLen)
+				if err != nil {
+					return nil, err
+				}
+			}
+			values, err = appendPageValues(values, page, column, h.int(4, encodingPlain), defs, n, dict)
+			if err != nil {
+				return nil, err
+			}
+			read += int64(n)
+
+		case pageIndex:
+		default:
+			return nil, fmt.Errorf("unknown page type %d", header.int(1, -1))
+		}
+	}
+
+	if len(values) != numRows {
+		return nil, fmt.Errorf("column has %d values, expected %d", len(values), numRows)
+	}
+	return values, nil
+}
+
+func decompress(codec int64, data []byte, size int) ([]byte, error) {
+	switch codec {
+	case codecUncompressed:
+		return data, nil
+	case codecSnappy:
+		return snappy.Decode(nil, data)
+	case codecGzip:
+		r, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer r.Close()
+		return ioutil.ReadAll(r)
+	case codecZstd:
+		zstdOnce.Do(func() {
+			zstdDecoder, zstdErr = zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+		})
+		if zstdErr != nil {
+			return nil, zstdErr
+		}
+		if size < 0 {
+			size = 0
+		}
+		return zstdDecoder.DecodeAll(data, make([]byte, 0, size))
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %d", codec)
+	}
+}
+
+// readLevels reads the definition levels at the beginning of a data page v1,
+// it returns the levels and the rest of the page.
+func readLevels(page []byte, maxDef int, n int, encoding int64) ([]uint32, []byte, error) {
+	if maxDef == 0 {
+		return nil, page, nil
+	}
+	if encoding != encodingRLE {
+		return nil, nil, fmt.Errorf("unsupported definition levels encoding %d", encoding)
+	}
+	if len(page) < 4 {
+		return nil, nil, errShortBuffer
+	}
+	size := int(binary.LittleEndian.Uint32(page))
+	if size < 0 || size > len(page)-4 {
+		return nil, nil, errShortBuffer
+	}
+	defs, err := decodeHybrid(page[4:4+size], bits.Len(uint(maxDef)), n)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode definition levels: %v", err)
+	}
+	return defs, page[4+size:], nil
+}
+
+// appendPageValues decodes the values of a data page and appends them to
+// values, with nil for the rows whose definition level is below the maximum.
+func appendPageValues(values []interface{}, page []byte, column *Column, encoding int64, defs []uint32, n int, dict []interface{}) ([]interface{}, error) {
+	count := n
+	if defs != nil {
+		count = 0
+		for _, d := range defs {
+			if int(d) == column.maxDef {
+				count++
+			}
+		}
+	}
+
+	decoded, err := decodeValues(page, column, encoding, count, dict)
+	if err != nil {
+		return nil, err
+	}
+
+	if defs == nil {
+		return append(values, decoded...), nil
+	}
+	for _, d := range defs {
+		if int(d) == column.maxDef {
+			values = append(values, decoded[0])
+			decoded = decoded[1:]
+		} else {
+			values = append(values, nil)
+		}
+	}
+	return values, nil
+}
+
+func decodeValues(data []byte, column *Column, encoding int64, n int, dict []interface{}) ([]interface{}, error) {
+	switch encoding {
+	case encodingPlain:
+		return decodePlain(data, column, n)
+
+	case encodingPlainDictionary, encodingRLEDictionary:
+		if dict == nil {
+			return nil, fmt.Errorf("dictionary encoded page without dictionary")
+		}
+		if n == 0 {
+			return nil, nil
+		}
+		if len(data) == 0 {
+			return nil, errShortBuffer
+		}
+		indexes, err := decodeHybrid(data[1:], int(data[0]), n)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, n)
+		for i, idx := range indexes {
+			if int(idx) >= len(dict) {
+				return nil, fmt.Errorf("invalid dictionary index %d", idx)
+			}
+			values[i] = dict[idx]
+		}
+		return values, nil
+
+	case encodingRLE:
+		if column.physicalType != typeBoolean {
+			return nil, fmt.Errorf("unsupported RLE encoding for non boolean values")
+		}
+		if len(data) < 4 {
+			return nil, errShortBuffer
+		}
+		size := int(binary.LittleEndian.Uint32(data))
+		if size < 0 || size > len(data)-4 {
+			return nil, errShortBuffer
+		}
+		decoded, err := decodeHybrid(data[4:4+size], 1, n)
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, n)
+		for i, v := range decoded {
+			values[i] = v == 1
+		}
+		return values, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported encoding %d", encoding)
+	}
+}
+
+// decodePlain decodes n values with the PLAIN encoding.
+func decodePlain(data []byte, column *Column, n int) ([]interface{}, error) {
+	values := make([]interface{}, n)
+
+	width := 0
+	switch column.physicalType {
+	case typeInt32, typeFloat:
+		width = 4
+	case typeInt64, typeDouble:
+		width = 8
+	case typeInt96:
+		width = 12
+	case typeFixedLenByteArray:
+		width = column.typeLength
+		if width <= 0 {
+			return nil, fmt.Errorf("invalid fixed length %d", width)
+		}
+	}
+	if width > 0 && len(data) < width*n {
+		return nil, errShortBuffer
+	}
+
+	switch column.physicalType {
+	case typeBoolean:
+		if len(data)*8 < n {
+			return nil, errShortBuffer
+		}
+		for i := range values {
+			values[i] = data[i/8]>>(uint(i)%8)&1 == 1
+		}
+	case typeInt32:
+		for i := range values {
+			values[i] = int32(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+	case typeInt64:
+		for i := range values {
+			values[i] = int64(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+	case typeFloat:
+		for i := range values {
+			values[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+	case typeDouble:
+		for i := range values {
+			values[i] = math.Float64frombits(binary.LittleEndian.Uint64(data[i*8:]))
+		}
+	case typeInt96, typeFixedLenByteArray:
+		for i := range values {
+			values[i] = data[i*width : (i+1)*width]
+		}
+	case typeByteArray:
+		pos := 0
+		for i := range values {
+			if len(data)-pos < 4 {
+				return nil, errShortBuffer
+			}
+			size := int(binary.LittleEndian.Uint32(data[pos:]))
+			pos += 4
+			if size < 0 || size > len(data)-pos {
+				return nil, errShortBuffer
+			}
+			values[i] = data[pos : pos+size]
+			pos += size
+		}
+	default:
+		return nil, fmt.Errorf("unsupported physical type %d", column.physicalType)
+	}
+	return values, nil
+}
+
+// decodeHybrid decodes n values with the RLE/bit-packing hybrid encoding.
+func decodeHybrid(data []byte, bitWidth int, n int) ([]uint32, error) {
+	if bitWidth < 0 || bitWidth > 32 {
+		return nil, fmt.Errorf("invalid bit width %d", bitWidth)
+	}
+	byteWidth := (bitWidth + 7) / 8
+
+	values := make([]uint32, 0, n)
+	for pos := 0; len(values) < n; {
+		header, k := binary.Uvarint(data[pos:])
+		if k <= 0 {
+			return nil, errShortBuffer
+		}
+		pos += k
+
+		if header&1 == 0 {
+			// RLE run
+			if len(data)-pos < byteWidth {
+				return nil, errShortBuffer
+			}
+			var v uint32
+			for i := 0; i < byteWidth; i++ {
+				v |= uint32(data[pos+i]) << (8 * uint(i))
+			}
+			pos += byteWidth
+			for count := header >> 1; count > 0 && len(values) < n; count-- {
+				values = append(values, v)
+			}
+			continue
+		}
+
+		// Bit-packed groups of 8 values
+		groups := header >> 1
+		if groups > uint64(len(data)) {
+			return nil, errShortBuffer
+		}
+		size := int(groups) * bitWidth
+		if len(data)-pos < size {
+			return nil, errShortBuffer
+		}
+		packed := data[pos : pos+size]
+		for i := 0; i < int(groups)*8 && len(values) < n; i++ {
+			var v uint32
+			for b := 0; b < bitWidth; b++ {
+				bit := i*bitWidth + b
+				v |= uint32(packed[bit/8]>>(uint(bit)%8)&1) << uint(b)
+			}
+			values = append(values, v)
+		}
+		pos += size
+	}
+	return values, nil
+}
@@ -0,0 +1,272 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package parquet decodes Apache Parquet files into rows. It supports the
+// flat and nested, but not repeated, columns produced by the usual data lake
+// exports, with the PLAIN, dictionary and RLE encodings and the snappy, gzip
+// and zstd compression codecs.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	magic = []byte("PAR1")
+
+	errShortBuffer = errors.New("unexpected end of parquet data")
+)
+
+// Physical types.
+const (
+	typeBoolean           = 0
+	typeInt32             = 1
+	typeInt64             = 2
+	typeInt96             = 3
+	typeFloat             = 4
+	typeDouble            = 5
+	typeByteArray         = 6
+	typeFixedLenByteArray = 7
+)
+
+// Repetition types.
+const (
+	repetitionRequired = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+)
+
+// IsParquet returns true if data starts like a parquet file.
+func IsParquet(data []byte) bool {
+	return bytes.HasPrefix(data, magic)
+}
+
+// Column is a leaf column of the schema of a parquet file.
+type Column struct {
+	// Name is the path of the column in the schema, joined with dots.
+	Name string
+
+	physicalType int64
+	typeLength   int
+	converted    int64
+	logical      tstruct
+	scale        int64
+	maxDef       int
+	repeated     bool
+}
+
+// File is a parquet file loaded in memory.
+type File struct {
+	data      []byte
+	columns   []*Column
+	rowGroups []tstruct
+	numRows   int64
+}
+
+// Open reads the metadata of the parquet file in data.
+func Open(data []byte) (*File, error) {
+	if len(data) < 12 || !IsParquet(data) || !bytes.HasSuffix(data, magic) {
+		return nil, errors.New("not a parquet file")
+	}
+
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	if size <= 0 || size > len(data)-12 {
+		return nil, fmt.Errorf("invalid parquet metadata size %d", size)
+	}
+	d := thriftDecoder{buf: data[len(data)-8-size : len(data)-8]}
+	meta, err := d.readStruct(0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read parquet metadata: %v", err)
+	}
+
+	f := &File{
+		data:      data,
+		rowGroups: meta.structs(4),
+		numRows:   meta.int(3, 0),
+	}
+
+	schema := meta.structs(2)
+	if len(schema) == 0 {
+		return nil, errors.New("parquet file without schema")
+	}
+	rest, err := f.readSchema(schema[1:], int(schema[0].int(5, 0)), nil, 0, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) != 0 {
+		return nil, errors.New("invalid parquet schema")
+	}
+	return f, nil
+}
+
+// readSchema walks the schema elements, stored in depth-first order, to
+// collect the leaf columns.
+func (f *File) readSchema(elements []tstruct, children int, path []string, maxDef int, repeated bool) ([]tstruct, error) {
+	for i := 0; i < children; i++ {
+		if len(elements) == 0 {
+			return nil, errors.New("invalid parquet schema")
+		}
+		e := elements[0]
+		elements = elements[1:]
+
+		name := append(path[:len(path):len(path)], e.string(4))
+		def := maxDef
+		rep := repeated
+		switch e.int(3, repetitionRequired) {
+		case repetitionOptional:
+			def++
+		case repetitionRepeated:
+			def++
+			rep = true
+		}
+
+		if n := int(e.int(5, 0)); n > 0 {
+			var err error
+			elements, err = f.readSchema(elements, n, name, def, rep)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		f.columns = append(f.columns, &Column{
+			Name:         strings.Join(name, "."),
+			physicalType: e.int(1, -1),
+			typeLength:   int(e.int(2, 0)),
+			converted:    e.int(6, -1),
+			logical:      e.sub(10),
+			scale:        e.int(7, 0),
+			maxDef:       def,
+			repeated:     rep,
+		})
+	}
+	return elements, nil
+}
+
+// Columns returns the leaf columns of the file.
+func (f *File) Columns() []*Column {
+	return f.columns
+}
+
+// Column returns the column with the given name, or nil if there is none.
+func (f *File) Column(name string) *Column {
+	for _, c := range f.columns {
+		if c.Name == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// NumRows returns the number of rows in the file.
+func (f *File) NumRows() int64 {
+	return f.numRows
+}
+
+// NumRowGroups returns the number of row groups in the file.
+func (f *File) NumRowGroups() int {
+	return len(f.rowGroups)
+}
+
+// Repeated returns true for the columns of repeated fields, which cannot be
+// decoded.
+func (c *Column) Repeated() bool {
+	return c.repeated
+}
+
+// Stats returns the minimum and maximum values of a column in a row group,
+// as stored in the column statistics. It returns false if the statistics are
+// not available or cannot be used to compare values.
+func (f *File) Stats(rowGroup int, column *Column) (min, max interface{}, ok bool) {
+	meta := f.chunkMetadata(rowGroup, column)
+	if meta == nil {
+		return nil, nil, false
+	}
+	// INT96 statistics use an undefined sort order
+	if column.physicalType == typeInt96 {
+		return nil, nil, false
+	}
+
+	stats := meta.sub(12)
+	minRaw, maxRaw := stats.bytes(6), stats.bytes(5)
+	if minRaw == nil || maxRaw == nil {
+		// Deprecated min and max, only valid for signed numbers
+		if column.physicalType != typeInt32 && column.physicalType != typeInt64 {
+			return nil, nil, false
+		}
+		minRaw, maxRaw = stats.bytes(2), stats.bytes(1)
+	}
+	if minRaw == nil || maxRaw == nil {
+		return nil, nil, false
+	}
+
+	// Binary statistics are stored without length prefix
+	if column.physicalType == typeByteArray {
+		return column.convert(minRaw), column.convert(maxRaw), true
+	}
+	minValues, err := decodePlain(minRaw, column, 1)
+	if err != nil {
+		return nil, nil, false
+	}
+	maxValues, err := decodePlain(maxRaw, column, 1)
+	if err != nil {
+		return nil, nil, false
+	}
+	return column.convert(minValues[0]), column.convert(maxValues[0]), true
+}
+
+func (f *File) chunkMetadata(rowGroup int, column *Column) tstruct {
+	if rowGroup < 0 || rowGroup >= len(f.rowGroups) {
+		return nil
+	}
+	for _, chunk := range f.rowGroups[rowGroup].structs(1) {
+		meta := chunk.sub(3)
+		var path []string
+		for _, p := range meta.list(3) {
+			b, _ := p.([]byte)
+			path = append(path, string(b))
+		}
+		if strings.Join(path, ".") == column.Name {
+			return meta
+		}
+	}
+	return nil
+}
+
+// ReadRowGroup decodes the given columns of a row group. It returns the
+// values of each column, in the same order as the columns, with nil for null
+// values.
+func (f *File) ReadRowGroup(rowGroup int, columns []*Column) ([][]interface{}, error) {
+	if rowGroup < 0 || rowGroup >= len(f.rowGroups) {
+		return nil, fmt.Errorf("invalid row group %d", rowGroup)
+	}
+	numRows := int(f.rowGroups[rowGroup].int(3, 0))
+
+	values := make([][]interface{}, len(columns))
+	for i, column := range columns {
+		if column.repeated {
+			return nil, fmt.Errorf("repeated column %s is not supported", column.Name)
+		}
+		meta := f.chunkMetadata(rowGroup, column)
+		if meta == nil {
+			return nil, fmt.Errorf("column %s not found in row group %d", column.Name, rowGroup)
+		}
+
+		raw, err := f.readChunk(meta, column, numRows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read column %s: %v", column.Name, err)
+		}
+		for j, v := range raw {
+			if v != nil {
+				raw[j] = column.convert(v)
+			}
+		}
+		values[i] = raw
+	}
+	return values, nil
+}
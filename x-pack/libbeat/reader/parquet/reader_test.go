@@ -0,0 +1,120 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func openTestFile(t *testing.T, name string) *File {
+	t.Helper()
+	data, err := ioutil.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	require.True(t, IsParquet(data))
+
+	f, err := Open(data)
+	require.NoError(t, err)
+	return f
+}
+
+func columnNames(f *File) []string {
+	var names []string
+	for _, c := range f.Columns() {
+		names = append(names, c.Name)
+	}
+	return names
+}
+
+func TestReadPlain(t *testing.T) {
+	f := openTestFile(t, "flow_logs.parquet")
+
+	assert.Equal(t, []string{"version", "srcaddr", "packets", "start", "action", "log_status_ok"}, columnNames(f))
+	assert.Equal(t, int64(5), f.NumRows())
+	require.Equal(t, 2, f.NumRowGroups())
+
+	values, err := f.ReadRowGroup(0, f.Columns())
+	require.NoError(t, err)
+
+	start := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	assert.Equal(t, [][]interface{}{
+		{int32(2), int32(2), int32(2)},
+		{"10.0.0.1", nil, "10.0.0.3"},
+		{int64(10), int64(20), int64(30)},
+		{start, start.Add(time.Second), start.Add(2 * time.Second)},
+		{"ACCEPT", "REJECT", nil},
+		{true, false, true},
+	}, values)
+
+	values, err = f.ReadRowGroup(1, []*Column{f.Column("packets")})
+	require.NoError(t, err)
+	assert.Equal(t, [][]interface{}{{int64(40), int64(50)}}, values)
+}
+
+func TestReadDictionaryCompressedNested(t *testing.T) {
+	f := openTestFile(t, "nested_dict.parquet")
+
+	assert.Equal(t, []string{"user.name", "user.port", "event_time", "amount", "tags.list.element"}, columnNames(f))
+	assert.True(t, f.Column("tags.list.element").Repeated())
+
+	columns := f.Columns()[:4]
+	values, err := f.ReadRowGroup(0, columns)
+	require.NoError(t, err)
+
+	eventTime := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	assert.Equal(t, [][]interface{}{
+		{"alice", "bob", "alice", nil, "bob", "alice"},
+		{int32(443), nil, int32(80), int32(22), nil, int32(8080)},
+		{
+			eventTime, eventTime.Add(time.Second), eventTime.Add(2 * time.Second),
+			eventTime.Add(3 * time.Second), eventTime.Add(4 * time.Second), eventTime.Add(5 * time.Second),
+		},
+		{12.34, -0.5, 0.0, 999.99, 0.01, 0.07},
+	}, values)
+
+	_, err = f.ReadRowGroup(0, []*Column{f.Column("tags.list.element")})
+	assert.Error(t, err)
+}
+
+func TestStats(t *testing.T) {
+	f := openTestFile(t, "flow_logs.parquet")
+
+	start := time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)
+	min, max, ok := f.Stats(1, f.Column("start"))
+	require.True(t, ok)
+	assert.Equal(t, start.Add(time.Hour), min)
+	assert.Equal(t, start.Add(time.Hour+time.Second), max)
+
+	_, _, ok = f.Stats(0, f.Column("packets"))
+	assert.False(t, ok)
+}
+
+func TestOpenInvalid(t *testing.T) {
+	for name, data := range map[string][]byte{
+		"empty":     {},
+		"not magic": []byte("this is not a parquet file"),
+		"truncated": append([]byte("PAR1\x00\x00\x00\x00"), []byte("\xff\x00\x00\x00PAR1")...),
+	} {
+		_, err := Open(data)
+		assert.Error(t, err, name)
+	}
+
+	// Corrupted metadata
+	data, err := ioutil.ReadFile("testdata/flow_logs.parquet")
+	require.NoError(t, err)
+	for i := len(data) - 200; i < len(data)-8; i++ {
+		corrupted := append([]byte{}, data...)
+		corrupted[i] ^= 0xff
+		if f, err := Open(corrupted); err == nil {
+			for rg := 0; rg < f.NumRowGroups(); rg++ {
+				f.ReadRowGroup(rg, f.Columns())
+			}
+		}
+	}
+}
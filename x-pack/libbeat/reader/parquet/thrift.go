@@ -0,0 +1,258 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// Types of the thrift compact protocol.
+const (
+	thriftStop      = 0
+	thriftTrue      = 1
+	thriftFalse     = 2
+	thriftByte      = 3
+	thriftI16       = 4
+	thriftI32       = 5
+	thriftI64       = 6
+	thriftDouble    = 7
+	thriftBinary    = 8
+	thriftList      = 9
+	thriftSet       = 10
+	thriftMap       = 11
+	thriftStruct    = 12
+	thriftMaxDepth  = 64
+	thriftMaxLength = 1 << 28
+)
+
+// tstruct is a decoded thrift struct, its values are indexed by field id.
+// Integers are decoded as int64, binaries as []byte, lists as []interface{}
+// and structs as tstruct. Maps are skipped as parquet metadata only uses them
+// in fields ignored by this package.
+type tstruct map[int16]interface{}
+
+func (s tstruct) int(id int16, def int64) int64 {
+	if v, ok := s[id].(int64); ok {
+		return v
+	}
+	return def
+}
+
+func (s tstruct) has(id int16) bool {
+	_, ok := s[id]
+	return ok
+}
+
+func (s tstruct) bool(id int16, def bool) bool {
+	if v, ok := s[id].(bool); ok {
+		return v
+	}
+	return def
+}
+
+func (s tstruct) bytes(id int16) []byte {
+	v, _ := s[id].([]byte)
+	return v
+}
+
+func (s tstruct) string(id int16) string {
+	return string(s.bytes(id))
+}
+
+func (s tstruct) list(id int16) []interface{} {
+	v, _ := s[id].([]interface{})
+	return v
+}
+
+func (s tstruct) structs(id int16) []tstruct {
+	var structs []tstruct
+	for _, v := range s.list(id) {
+		if st, ok := v.(tstruct); ok {
+			structs = append(structs, st)
+		}
+	}
+	return structs
+}
+
+func (s tstruct) sub(id int16) tstruct {
+	v, _ := s[id].(tstruct)
+	return v
+}
+
+// thriftDecoder decodes structs serialized with the thrift compact protocol,
+// used by parquet for its metadata.
+type thriftDecoder struct {
+	buf []byte
+	pos int
+}
+
+func (d *thriftDecoder) readStruct(depth int) (tstruct, error) {
+	if depth > thriftMaxDepth {
+		return nil, fmt.Errorf("thrift struct nested too deep")
+	}
+
+	s := tstruct{}
+	var lastID int16
+	for {
+		header, err := d.readByte()
+		if err != nil {
+			return nil, err
+		}
+		typ := header & 0x0f
+		if typ == thriftStop {
+			return s, nil
+		}
+
+		id := lastID + int16(header>>4)
+		if header>>4 == 0 {
+			v, err := d.readVarint()
+			if err != nil {
+				return nil, err
+			}
+			id = int16(v)
+		}
+		lastID = id
+
+		var value interface{}
+		switch typ {
+		case thriftTrue:
+			value = true
+		case thriftFalse:
+			value = false
+		default:
+			value, err = d.readValue(typ, depth)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if value != nil {
+			s[id] = value
+		}
+	}
+}
+
+func (d *thriftDecoder) readValue(typ byte, depth int) (interface{}, error) {
+	switch typ {
+	case thriftTrue, thriftFalse:
+		// Booleans in lists are encoded as a byte
+		b, err := d.readByte()
+		return b == thriftTrue, err
+	case thriftByte:
+		b, err := d.readByte()
+		return int64(int8(b)), err
+	case thriftI16, thriftI32, thriftI64:
+		return d.readVarint()
+	case thriftDouble:
+		if len(d.buf)-d.pos < 8 {
+			return nil, errShortBuffer
+		}
+		v := math.Float64frombits(binary.LittleEndian.Uint64(d.buf[d.pos:]))
+		d.pos += 8
+		return v, nil
+	case thriftBinary:
+		return d.readBinary()
+	case thriftList, thriftSet:
+		return d.readList(depth)
+	case thriftMap:
+		return nil, d.skipMap(depth)
+	case thriftStruct:
+		return d.readStruct(depth + 1)
+	default:
+		return nil, fmt.Errorf("unknown thrift type %d", typ)
+	}
+}
+
+func (d *thriftDecoder) readList(depth int) ([]interface{}, error) {
+	header, err := d.readByte()
+	if err != nil {
+		return nil, err
+	}
+	size := int64(header >> 4)
+	if size == 15 {
+		size, err = d.readUvarint()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if size > thriftMaxLength || size > int64(len(d.buf)-d.pos) {
+		return nil, fmt.Errorf("invalid thrift list size %d", size)
+	}
+
+	list := make([]interface{}, 0, size)
+	for i := int64(0); i < size; i++ {
+		v, err := d.readValue(header&0x0f, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, v)
+	}
+	return list, nil
+}
+
+func (d *thriftDecoder) skipMap(depth int) error {
+	size, err := d.readUvarint()
+	if err != nil || size == 0 {
+		return err
+	}
+	if size > thriftMaxLength || size > int64(len(d.buf)-d.pos) {
+		return fmt.Errorf("invalid thrift map size %d", size)
+	}
+	types, err := d.readByte()
+	if err != nil {
+		return err
+	}
+	for i := int64(0); i < 2*size; i++ {
+		typ := types >> 4
+		if i%2 == 1 {
+			typ = types & 0x0f
+		}
+		if _, err := d.readValue(typ, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *thriftDecoder) readBinary() ([]byte, error) {
+	n, err := d.readUvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > int64(len(d.buf)-d.pos) {
+		return nil, errShortBuffer
+	}
+	b := d.buf[d.pos : d.pos+int(n)]
+	d.pos += int(n)
+	return b, nil
+}
+
+func (d *thriftDecoder) readByte() (byte, error) {
+	if d.pos >= len(d.buf) {
+		return 0, errShortBuffer
+	}
+	b := d.buf[d.pos]
+	d.pos++
+	return b, nil
+}
+
+func (d *thriftDecoder) readUvarint() (int64, error) {
+	v, n := binary.Uvarint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errShortBuffer
+	}
+	d.pos += n
+	return int64(v), nil
+}
+
+func (d *thriftDecoder) readVarint() (int64, error) {
+	v, n := binary.Varint(d.buf[d.pos:])
+	if n <= 0 {
+		return 0, errShortBuffer
+	}
+	d.pos += n
+	return v, nil
+}
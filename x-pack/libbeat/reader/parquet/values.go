@@ -0,0 +1,114 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package parquet
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// Converted types.
+const (
+	convertedDecimal         = 5
+	convertedDate            = 6
+	convertedTimestampMillis = 9
+	convertedTimestampMicros = 10
+)
+
+// Logical types, as field ids of the LogicalType union.
+const (
+	logicalDecimal   = 5
+	logicalDate      = 6
+	logicalTimestamp = 8
+	logicalUUID      = 14
+)
+
+// julianUnixEpoch is the julian day of the unix epoch, used by INT96 timestamps.
+const julianUnixEpoch = 2440588
+
+// convert converts a value decoded from the physical type of the column to
+// the type given by its logical type: timestamps and dates to time.Time,
+// decimals to float64 and binaries to strings.
+func (c *Column) convert(v interface{}) interface{} {
+	switch value := v.(type) {
+	case int32:
+		if c.isDate() {
+			return time.Unix(int64(value)*24*60*60, 0).UTC()
+		}
+		if scale, ok := c.decimalScale(); ok {
+			return float64(value) / math.Pow10(scale)
+		}
+	case int64:
+		if unit, ok := c.timestampUnit(); ok {
+			perSecond := int64(time.Second / unit)
+			return time.Unix(value/perSecond, value%perSecond*int64(unit)).UTC()
+		}
+		if scale, ok := c.decimalScale(); ok {
+			return float64(value) / math.Pow10(scale)
+		}
+	case []byte:
+		if c.physicalType == typeInt96 {
+			nanos := int64(binary.LittleEndian.Uint64(value[:8]))
+			days := int64(binary.LittleEndian.Uint32(value[8:]))
+			return time.Unix((days-julianUnixEpoch)*24*60*60, nanos).UTC()
+		}
+		if scale, ok := c.decimalScale(); ok {
+			// Big-endian two's complement unscaled value
+			i := new(big.Int).SetBytes(value)
+			if len(value) > 0 && value[0]&0x80 != 0 {
+				i.Sub(i, new(big.Int).Lsh(big.NewInt(1), uint(len(value)*8)))
+			}
+			f, _ := new(big.Float).Quo(new(big.Float).SetInt(i), big.NewFloat(math.Pow10(scale))).Float64()
+			return f
+		}
+		if c.physicalType == typeFixedLenByteArray {
+			if c.logical.has(logicalUUID) && len(value) == 16 {
+				return fmt.Sprintf("%x-%x-%x-%x-%x", value[0:4], value[4:6], value[6:8], value[8:10], value[10:])
+			}
+			return hex.EncodeToString(value)
+		}
+		return string(value)
+	}
+	return v
+}
+
+func (c *Column) isDate() bool {
+	return c.converted == convertedDate || c.logical.has(logicalDate)
+}
+
+func (c *Column) decimalScale() (int, bool) {
+	if d := c.logical.sub(logicalDecimal); d != nil {
+		return int(d.int(1, 0)), true
+	}
+	if c.converted == convertedDecimal {
+		return int(c.scale), true
+	}
+	return 0, false
+}
+
+func (c *Column) timestampUnit() (time.Duration, bool) {
+	if ts := c.logical.sub(logicalTimestamp); ts != nil {
+		unit := ts.sub(2)
+		switch {
+		case unit.has(1):
+			return time.Millisecond, true
+		case unit.has(2):
+			return time.Microsecond, true
+		case unit.has(3):
+			return time.Nanosecond, true
+		}
+	}
+	switch c.converted {
+	case convertedTimestampMillis:
+		return time.Millisecond, true
+	case convertedTimestampMicros:
+		return time.Microsecond, true
+	}
+	return 0, false
+}
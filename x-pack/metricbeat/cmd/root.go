@@ -41,5 +41,6 @@ func init() {
 	RootCmd = cmd.GenRootCmdWithSettings(beater.DefaultCreator(), settings)
 	RootCmd.AddCommand(cmd.GenModulesCmd(Name, "", mbcmd.BuildModulesManager))
 	RootCmd.TestCmd.AddCommand(test.GenTestModulesCmd(Name, "", beater.DefaultTestModulesCreator()))
+	RootCmd.TestCmd.AddCommand(test.GenTestHintsCmd(Name, ""))
 	xpackcmd.AddXPack(RootCmd, Name)
 }
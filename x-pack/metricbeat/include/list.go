@@ -12,6 +12,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/appsearch"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/appsearch/stats"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws/billing"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws/cloudwatch"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws/ec2"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws/rds"
@@ -23,6 +24,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/azure/compute_vm_scaleset"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/azure/monitor"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/azure/storage"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/cilium"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/cloudfoundry"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/cloudfoundry/container"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/cloudfoundry/counter"
@@ -32,6 +34,7 @@ import (
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/coredns/stats"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/googlecloud"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/googlecloud/stackdriver"
+	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/gpu"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/ibmmq"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/iis"
 	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/iis/application_pool"
@@ -0,0 +1,517 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/budgetsiface"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/cloudwatchiface"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/costexploreriface"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	awscommon "github.com/elastic/beats/v7/x-pack/libbeat/common/aws"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/aws"
+)
+
+const (
+	metricsetName = "billing"
+	namespace     = "AWS/Billing"
+
+	// Billing metrics, Cost Explorer and Budgets are only available in us-east-1.
+	regionName = "us-east-1"
+
+	// Cost Explorer dates are days, with an exclusive end.
+	dateLayout = "2006-01-02"
+)
+
+var (
+	// costMetrics are the Cost Explorer metrics measured in a currency.
+	costMetrics = []string{"AmortizedCost", "BlendedCost", "UnblendedCost"}
+
+	// usageMetrics are the Cost Explorer metrics measured in usage units.
+	usageMetrics = []string{"NormalizedUsageAmount", "UsageQuantity"}
+)
+
+// init registers the MetricSet with the central registry as soon as the program
+// starts. The New function will be called later to instantiate an instance of
+// the MetricSet for each host defined in the module's configuration. After the
+// MetricSet has been created then Fetch will begin to be called periodically.
+func init() {
+	mb.Registry.MustAddMetricSet(aws.ModuleName, metricsetName, New,
+		mb.DefaultMetricSet(),
+	)
+}
+
+// MetricSet holds any configuration or state information. It must implement
+// the mb.MetricSet interface. And this is best achieved by embedding
+// mb.BaseMetricSet because it implements all of the required mb.MetricSet
+// interface methods except for Fetch.
+type MetricSet struct {
+	*aws.MetricSet
+	CostExplorerConfig CostExplorerConfig
+	BudgetsConfig      BudgetsConfig
+	CurrencyConfig     CurrencyConfig
+}
+
+// Config holds the configuration specific for billing metricset.
+type Config struct {
+	CostExplorerConfig CostExplorerConfig `config:"cost_explorer_config"`
+	BudgetsConfig      BudgetsConfig      `config:"budgets"`
+	CurrencyConfig     CurrencyConfig     `config:"currency"`
+}
+
+// CostExplorerConfig holds the configuration of the costs grouped by Cost
+// Explorer, one event is created for each value of each key.
+type CostExplorerConfig struct {
+	GroupByDimensionKeys []string `config:"group_by_dimension_keys"`
+	GroupByTagKeys       []string `config:"group_by_tag_keys"`
+}
+
+// BudgetsConfig holds the configuration of the budgets collection.
+type BudgetsConfig struct {
+	Enabled bool `config:"enabled"`
+}
+
+// CurrencyConfig holds the exchange rates used to normalize all the amounts
+// into the target currency.
+type CurrencyConfig struct {
+	Target        string             `config:"target"`
+	ExchangeRates map[string]float64 `config:"exchange_rates"`
+}
+
+// Validate checks the group by keys are supported by Cost Explorer.
+func (c CostExplorerConfig) Validate() error {
+	for _, key := range c.GroupByDimensionKeys {
+		if !supportedDimensionKey(key) {
+			return fmt.Errorf("unsupported group_by_dimension_keys '%s'", key)
+		}
+	}
+	return nil
+}
+
+// Validate checks exchange rates are only set together with a target currency.
+func (c CurrencyConfig) Validate() error {
+	if c.Target == "" && len(c.ExchangeRates) > 0 {
+		return errors.New("currency.target is required when currency.exchange_rates are set")
+	}
+	for currency, rate := range c.ExchangeRates {
+		if rate <= 0 {
+			return fmt.Errorf("exchange rate for currency '%s' must be positive", currency)
+		}
+	}
+	return nil
+}
+
+// New creates a new instance of the MetricSet. New is responsible for unpacking
+// any MetricSet specific configuration options if there are any.
+func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
+	var config Config
+	if err := base.Module().UnpackConfig(&config); err != nil {
+		return nil, err
+	}
+
+	metricSet, err := aws.NewMetricSet(base)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating aws metricset")
+	}
+
+	// Each Cost Explorer request is charged, so costs are only queried when
+	// period covers at least a day.
+	if len(config.CostExplorerConfig.GroupByDimensionKeys)+len(config.CostExplorerConfig.GroupByTagKeys) > 0 &&
+		metricSet.Period < 24*time.Hour {
+		base.Logger().Warn("cost_explorer_config is ignored, period needs to be set to 24h or longer to query Cost Explorer")
+	}
+
+	return &MetricSet{
+		MetricSet:          metricSet,
+		CostExplorerConfig: config.CostExplorerConfig,
+		BudgetsConfig:      config.BudgetsConfig,
+		CurrencyConfig:     config.CurrencyConfig,
+	}, nil
+}
+
+// Fetch methods implements the data gathering and data conversion to the right
+// format. It publishes the event which is then forwarded to the output. In case
+// of an error set the Error field of mb.Event or simply call report.Error().
+func (m *MetricSet) Fetch(report mb.ReporterV2) error {
+	awsConfig := m.MetricSet.AwsConfig.Copy()
+	awsConfig.Region = regionName
+
+	svcCloudwatch := cloudwatch.New(awscommon.EnrichAWSConfigWithEndpoint(
+		m.Endpoint, "monitoring", regionName, awsConfig))
+	events, err := m.getCloudWatchBillingMetrics(svcCloudwatch)
+	if err != nil {
+		err = errors.Wrap(err, "failed to get billing metrics from Cloudwatch")
+		m.Logger().Error(err)
+		report.Error(err)
+	}
+
+	if m.Period >= 24*time.Hour {
+		svcCostExplorer := costexplorer.New(awscommon.EnrichAWSConfigWithEndpoint(
+			m.Endpoint, "ce", regionName, awsConfig))
+		costEvents, err := m.getCostGroupBy(svcCostExplorer, time.Now())
+		if err != nil {
+			err = errors.Wrap(err, "failed to get costs from Cost Explorer")
+			m.Logger().Error(err)
+			report.Error(err)
+		}
+		events = append(events, costEvents...)
+	}
+
+	if m.BudgetsConfig.Enabled {
+		svcBudgets := budgets.New(awscommon.EnrichAWSConfigWithEndpoint(
+			m.Endpoint, "budgets", "", awsConfig))
+		budgetEvents, err := m.getBudgets(svcBudgets)
+		if err != nil {
+			err = errors.Wrap(err, "failed to get budgets")
+			m.Logger().Error(err)
+			report.Error(err)
+		}
+		events = append(events, budgetEvents...)
+	}
+
+	for _, event := range events {
+		if reported := report.Event(event); !reported {
+			m.Logger().Debug("Fetch interrupted, failed to emit event")
+			return nil
+		}
+	}
+	return nil
+}
+
+// getCloudWatchBillingMetrics creates an event with the maximum estimated
+// charges for each combination of dimensions reported to Cloudwatch.
+func (m *MetricSet) getCloudWatchBillingMetrics(svcCloudwatch cloudwatchiface.ClientAPI) ([]mb.Event, error) {
+	listMetricsOutputs, err := aws.GetListMetricsOutput(namespace, regionName, svcCloudwatch)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(listMetricsOutputs) == 0 {
+		return nil, nil
+	}
+
+	statistic := "Maximum"
+	periodInSec := int64(m.Period.Seconds())
+	metrics := map[string]cloudwatch.Metric{}
+	var metricDataQueries []cloudwatch.MetricDataQuery
+	for i := range listMetricsOutputs {
+		metric := listMetricsOutputs[i]
+		id := "billing" + strconv.Itoa(i)
+		metrics[id] = metric
+		metricDataQueries = append(metricDataQueries, cloudwatch.MetricDataQuery{
+			Id: awssdk.String(id),
+			MetricStat: &cloudwatch.MetricStat{
+				Period: &periodInSec,
+				Stat:   &statistic,
+				Metric: &metric,
+			},
+		})
+	}
+
+	startTime, endTime := aws.GetStartTimeEndTime(m.Period)
+	metricDataOutputs, err := aws.GetMetricDataResults(metricDataQueries, svcCloudwatch, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var events []mb.Event
+	for _, output := range metricDataOutputs {
+		metric, found := metrics[*output.Id]
+		if !found || len(output.Values) == 0 {
+			continue
+		}
+
+		event := aws.InitEvent(regionName, m.AccountName, m.AccountID)
+		event.MetricSetFields.Put("metrics."+common.DeDot(*metric.MetricName)+".max", output.Values[0])
+		event.RootFields.Put("aws.cloudwatch.namespace", namespace)
+		for _, dim := range metric.Dimensions {
+			event.RootFields.Put("aws.dimensions."+*dim.Name, *dim.Value)
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// getCostGroupBy creates an event with the costs of each value of the
+// configured group by keys, since the start of the last period.
+func (m *MetricSet) getCostGroupBy(svcCostExplorer costexploreriface.ClientAPI, now time.Time) ([]mb.Event, error) {
+	var groupDefinitions []costexplorer.GroupDefinition
+	for _, key := range m.CostExplorerConfig.GroupByDimensionKeys {
+		groupDefinitions = append(groupDefinitions, costexplorer.GroupDefinition{
+			Key:  awssdk.String(key),
+			Type: costexplorer.GroupDefinitionTypeDimension,
+		})
+	}
+	for _, key := range m.CostExplorerConfig.GroupByTagKeys {
+		groupDefinitions = append(groupDefinitions, costexplorer.GroupDefinition{
+			Key:  awssdk.String(key),
+			Type: costexplorer.GroupDefinitionTypeTag,
+		})
+	}
+
+	timePeriod := &costexplorer.DateInterval{
+		Start: awssdk.String(now.Add(-m.Period).UTC().Format(dateLayout)),
+		End:   awssdk.String(now.UTC().Format(dateLayout)),
+	}
+
+	var events []mb.Event
+	for _, groupDefinition := range groupDefinitions {
+		var nextPageToken *string
+		for {
+			input := &costexplorer.GetCostAndUsageInput{
+				Granularity:   costexplorer.GranularityDaily,
+				GroupBy:       []costexplorer.GroupDefinition{groupDefinition},
+				Metrics:       append(append([]string{}, costMetrics...), usageMetrics...),
+				NextPageToken: nextPageToken,
+				TimePeriod:    timePeriod,
+			}
+			req := svcCostExplorer.GetCostAndUsageRequest(input)
+			output, err := req.Send(context.TODO())
+			if err != nil {
+				return events, errors.Wrapf(err, "GetCostAndUsageRequest failed for %s '%s'",
+					strings.ToLower(string(groupDefinition.Type)), *groupDefinition.Key)
+			}
+
+			for _, result := range output.ResultsByTime {
+				for _, group := range result.Groups {
+					events = append(events, m.createCostGroupByEvent(groupDefinition, result, group))
+				}
+			}
+
+			if output.NextPageToken == nil || *output.NextPageToken == "" {
+				break
+			}
+			nextPageToken = output.NextPageToken
+		}
+	}
+	return events, nil
+}
+
+func (m *MetricSet) createCostGroupByEvent(groupDefinition costexplorer.GroupDefinition, result costexplorer.ResultByTime, group costexplorer.Group) mb.Event {
+	event := aws.InitEvent(regionName, m.AccountName, m.AccountID)
+
+	key := *groupDefinition.Key
+	value := strings.Join(group.Keys, ",")
+	if groupDefinition.Type == costexplorer.GroupDefinitionTypeTag {
+		// Tag group keys are formatted as "key$value", with an empty value for
+		// the costs of resources without the tag.
+		value = strings.TrimPrefix(value, key+"$")
+	}
+	event.MetricSetFields.Put("group_definition.key", key)
+	event.MetricSetFields.Put("group_definition.type", string(groupDefinition.Type))
+	event.MetricSetFields.Put("group_by."+common.DeDot(key), value)
+
+	if result.TimePeriod != nil {
+		event.MetricSetFields.Put("start_date", *result.TimePeriod.Start)
+		event.MetricSetFields.Put("end_date", *result.TimePeriod.End)
+	}
+	if result.Estimated != nil {
+		event.MetricSetFields.Put("estimated", *result.Estimated)
+	}
+
+	for _, metricName := range costMetrics {
+		if metricValue, found := group.Metrics[metricName]; found {
+			m.putAmount(event.MetricSetFields, metricName, metricValue.Amount, metricValue.Unit)
+		}
+	}
+	for _, metricName := range usageMetrics {
+		if metricValue, found := group.Metrics[metricName]; found {
+			putUsage(event.MetricSetFields, metricName, metricValue.Amount, metricValue.Unit)
+		}
+	}
+	return event
+}
+
+// getBudgets creates an event for each budget with its spend, utilization and
+// the state of its alerts.
+func (m *MetricSet) getBudgets(svcBudgets budgetsiface.ClientAPI) ([]mb.Event, error) {
+	if m.AccountID == "" {
+		return nil, errors.New("account ID is required to describe budgets")
+	}
+
+	var events []mb.Event
+	var nextToken *string
+	for {
+		req := svcBudgets.DescribeBudgetsRequest(&budgets.DescribeBudgetsInput{
+			AccountId: awssdk.String(m.AccountID),
+			NextToken: nextToken,
+		})
+		output, err := req.Send(context.TODO())
+		if err != nil {
+			return events, errors.Wrap(err, "DescribeBudgetsRequest failed")
+		}
+
+		for _, budget := range output.Budgets {
+			notifications, err := m.getBudgetNotifications(svcBudgets, *budget.BudgetName)
+			if err != nil {
+				m.Logger().Warn(err)
+			}
+			events = append(events, m.createBudgetEvent(budget, notifications))
+		}
+
+		if output.NextToken == nil || *output.NextToken == "" {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	return events, nil
+}
+
+func (m *MetricSet) getBudgetNotifications(svcBudgets budgetsiface.ClientAPI, budgetName string) ([]budgets.Notification, error) {
+	var notifications []budgets.Notification
+	var nextToken *string
+	for {
+		req := svcBudgets.DescribeNotificationsForBudgetRequest(&budgets.DescribeNotificationsForBudgetInput{
+			AccountId:  awssdk.String(m.AccountID),
+			BudgetName: awssdk.String(budgetName),
+			NextToken:  nextToken,
+		})
+		output, err := req.Send(context.TODO())
+		if err != nil {
+			return notifications, errors.Wrapf(err, "DescribeNotificationsForBudgetRequest failed for budget '%s'", budgetName)
+		}
+		notifications = append(notifications, output.Notifications...)
+
+		if output.NextToken == nil || *output.NextToken == "" {
+			return notifications, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
+func (m *MetricSet) createBudgetEvent(budget budgets.Budget, notifications []budgets.Notification) mb.Event {
+	event := aws.InitEvent(regionName, m.AccountName, m.AccountID)
+
+	event.MetricSetFields.Put("budget.name", *budget.BudgetName)
+	event.MetricSetFields.Put("budget.type", string(budget.BudgetType))
+	event.MetricSetFields.Put("budget.time_unit", string(budget.TimeUnit))
+
+	var limit, actual, forecasted float64
+	var hasLimit, hasActual, hasForecasted bool
+	if budget.BudgetLimit != nil {
+		limit, hasLimit = m.putSpend(event.MetricSetFields, "budget.limit", budget.BudgetLimit)
+	}
+	if budget.CalculatedSpend != nil {
+		if budget.CalculatedSpend.ActualSpend != nil {
+			actual, hasActual = m.putSpend(event.MetricSetFields, "budget.actual_spend", budget.CalculatedSpend.ActualSpend)
+		}
+		if budget.CalculatedSpend.ForecastedSpend != nil {
+			forecasted, hasForecasted = m.putSpend(event.MetricSetFields, "budget.forecasted_spend", budget.CalculatedSpend.ForecastedSpend)
+		}
+	}
+
+	if hasLimit && limit > 0 {
+		if hasActual {
+			event.MetricSetFields.Put("budget.utilization.pct", actual/limit)
+		}
+		if hasForecasted {
+			event.MetricSetFields.Put("budget.forecasted_utilization.pct", forecasted/limit)
+		}
+	}
+
+	inAlarm := 0
+	for _, notification := range notifications {
+		if notification.NotificationState == budgets.NotificationStateAlarm {
+			inAlarm++
+		}
+	}
+	alertState := string(budgets.NotificationStateOk)
+	if inAlarm > 0 {
+		alertState = string(budgets.NotificationStateAlarm)
+	}
+	event.MetricSetFields.Put("budget.alerts.total", len(notifications))
+	event.MetricSetFields.Put("budget.alerts.in_alarm", inAlarm)
+	event.MetricSetFields.Put("budget.alerts.state", alertState)
+	return event
+}
+
+// putSpend adds the amount of a budget spend to the fields, it returns the
+// amount if it could be parsed.
+func (m *MetricSet) putSpend(fields common.MapStr, name string, spend *budgets.Spend) (float64, bool) {
+	return m.putAmount(fields, name, spend.Amount, spend.Unit)
+}
+
+// putAmount adds an amount in a currency to the fields, with its value in
+// the target currency if an exchange rate is configured for it.
+func (m *MetricSet) putAmount(fields common.MapStr, name string, amount *string, unit *string) (float64, bool) {
+	value, ok := parseAmount(amount)
+	if !ok {
+		return 0, false
+	}
+	fields.Put(name+".amount", value)
+	if unit == nil {
+		return value, true
+	}
+	fields.Put(name+".unit", *unit)
+
+	if normalized, ok := m.normalize(value, *unit); ok {
+		fields.Put(name+".normalized.amount", normalized)
+		fields.Put(name+".normalized.unit", m.CurrencyConfig.Target)
+	}
+	return value, true
+}
+
+// normalize converts an amount into the target currency.
+func (m *MetricSet) normalize(value float64, unit string) (float64, bool) {
+	target := m.CurrencyConfig.Target
+	if target == "" {
+		return 0, false
+	}
+	if strings.EqualFold(unit, target) {
+		return value, true
+	}
+	for currency, rate := range m.CurrencyConfig.ExchangeRates {
+		if strings.EqualFold(unit, currency) {
+			return value * rate, true
+		}
+	}
+	return 0, false
+}
+
+func putUsage(fields common.MapStr, name string, amount *string, unit *string) {
+	value, ok := parseAmount(amount)
+	if !ok {
+		return
+	}
+	fields.Put(name+".amount", value)
+	if unit != nil {
+		fields.Put(name+".unit", *unit)
+	}
+}
+
+func parseAmount(amount *string) (float64, bool) {
+	if amount == nil {
+		return 0, false
+	}
+	value, err := strconv.ParseFloat(*amount, 64)
+	if err != nil {
+		return 0, false
+	}
+	return value, true
+}
+
+// supportedDimensionKey returns true if the key can be used to group costs
+// in Cost Explorer.
+func supportedDimensionKey(key string) bool {
+	switch key {
+	case "AZ", "INSTANCE_TYPE", "LEGAL_ENTITY_NAME", "LINKED_ACCOUNT",
+		"OPERATION", "PLATFORM", "PURCHASE_TYPE", "SERVICE", "TENANCY",
+		"RECORD_TYPE", "USAGE_TYPE":
+		return true
+	}
+	return false
+}
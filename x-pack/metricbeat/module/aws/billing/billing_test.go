@@ -2,20 +2,275 @@
 // or more contributor license agreements. Licensed under the Elastic License;
 // you may not use this file except in compliance with the Elastic License.
 
+// +build !integration
+
 package billing
 
 import (
-	"os"
+	"net/http"
+	"testing"
+	"time"
 
-	"github.com/elastic/beats/v7/metricbeat/mb"
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/budgets"
+	"github.com/aws/aws-sdk-go-v2/service/budgets/budgetsiface"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer"
+	"github.com/aws/aws-sdk-go-v2/service/costexplorer/costexploreriface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
-	// Register input module and metricset
-	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws"
-	_ "github.com/elastic/beats/v7/x-pack/metricbeat/module/aws/cloudwatch"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/metricbeat/mb"
+	"github.com/elastic/beats/v7/x-pack/metricbeat/module/aws"
 )
 
-func init() {
-	// To be moved to some kind of helper
-	os.Setenv("BEAT_STRICT_PERMS", "false")
-	mb.Registry.SetSecondarySource(mb.NewLightModulesSource("../../../module"))
+// MockCostExplorerClient struct is used for unit tests.
+type MockCostExplorerClient struct {
+	costexploreriface.ClientAPI
+	inputs []*costexplorer.GetCostAndUsageInput
+}
+
+func (m *MockCostExplorerClient) GetCostAndUsageRequest(input *costexplorer.GetCostAndUsageInput) costexplorer.GetCostAndUsageRequest {
+	m.inputs = append(m.inputs, input)
+
+	groupKey := "AWS Lambda"
+	if input.GroupBy[0].Type == costexplorer.GroupDefinitionTypeTag {
+		groupKey = *input.GroupBy[0].Key + "$team-a"
+	}
+	httpReq, _ := http.NewRequest("", "", nil)
+	return costexplorer.GetCostAndUsageRequest{
+		Request: &awssdk.Request{
+			Data: &costexplorer.GetCostAndUsageOutput{
+				ResultsByTime: []costexplorer.ResultByTime{
+					{
+						Estimated: awssdk.Bool(true),
+						TimePeriod: &costexplorer.DateInterval{
+							Start: awssdk.String("2020-06-01"),
+							End:   awssdk.String("2020-06-02"),
+						},
+						Groups: []costexplorer.Group{
+							{
+								Keys: []string{groupKey},
+								Metrics: map[string]costexplorer.MetricValue{
+									"UnblendedCost": {Amount: awssdk.String("1.5"), Unit: awssdk.String("USD")},
+									"UsageQuantity": {Amount: awssdk.String("42"), Unit: awssdk.String("N/A")},
+								},
+							},
+						},
+					},
+				},
+			},
+			HTTPRequest: httpReq,
+		},
+	}
+}
+
+// MockBudgetsClient struct is used for unit tests.
+type MockBudgetsClient struct {
+	budgetsiface.ClientAPI
+}
+
+func (m *MockBudgetsClient) DescribeBudgetsRequest(input *budgets.DescribeBudgetsInput) budgets.DescribeBudgetsRequest {
+	httpReq, _ := http.NewRequest("", "", nil)
+	return budgets.DescribeBudgetsRequest{
+		Request: &awssdk.Request{
+			Data: &budgets.DescribeBudgetsOutput{
+				Budgets: []budgets.Budget{
+					{
+						BudgetName:  awssdk.String("monthly"),
+						BudgetType:  budgets.BudgetTypeCost,
+						TimeUnit:    budgets.TimeUnitMonthly,
+						BudgetLimit: &budgets.Spend{Amount: awssdk.String("200"), Unit: awssdk.String("USD")},
+						CalculatedSpend: &budgets.CalculatedSpend{
+							ActualSpend:     &budgets.Spend{Amount: awssdk.String("150"), Unit: awssdk.String("USD")},
+							ForecastedSpend: &budgets.Spend{Amount: awssdk.String("250"), Unit: awssdk.String("USD")},
+						},
+					},
+				},
+			},
+			HTTPRequest: httpReq,
+		},
+	}
+}
+
+func (m *MockBudgetsClient) DescribeNotificationsForBudgetRequest(input *budgets.DescribeNotificationsForBudgetInput) budgets.DescribeNotificationsForBudgetRequest {
+	httpReq, _ := http.NewRequest("", "", nil)
+	return budgets.DescribeNotificationsForBudgetRequest{
+		Request: &awssdk.Request{
+			Data: &budgets.DescribeNotificationsForBudgetOutput{
+				Notifications: []budgets.Notification{
+					{
+						NotificationType:  budgets.NotificationTypeActual,
+						NotificationState: budgets.NotificationStateOk,
+						Threshold:         awssdk.Float64(80),
+					},
+					{
+						NotificationType:  budgets.NotificationTypeForecasted,
+						NotificationState: budgets.NotificationStateAlarm,
+						Threshold:         awssdk.Float64(100),
+					},
+				},
+			},
+			HTTPRequest: httpReq,
+		},
+	}
+}
+
+func newTestMetricSet(period time.Duration) *MetricSet {
+	return &MetricSet{
+		MetricSet: &aws.MetricSet{
+			BaseMetricSet: mb.BaseMetricSet{},
+			Period:        period,
+			AccountName:   "test",
+			AccountID:     "123456789012",
+		},
+		CurrencyConfig: CurrencyConfig{
+			Target:        "EUR",
+			ExchangeRates: map[string]float64{"USD": 0.5},
+		},
+	}
+}
+
+func TestGetCostGroupBy(t *testing.T) {
+	m := newTestMetricSet(24 * time.Hour)
+	m.CostExplorerConfig = CostExplorerConfig{
+		GroupByDimensionKeys: []string{"SERVICE"},
+		GroupByTagKeys:       []string{"team"},
+	}
+
+	mockSvc := &MockCostExplorerClient{}
+	now := time.Date(2020, 6, 2, 10, 0, 0, 0, time.UTC)
+	events, err := m.getCostGroupBy(mockSvc, now)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	require.Len(t, mockSvc.inputs, 2)
+	assert.Equal(t, "2020-06-01", *mockSvc.inputs[0].TimePeriod.Start)
+	assert.Equal(t, "2020-06-02", *mockSvc.inputs[0].TimePeriod.End)
+
+	expected := common.MapStr{
+		"group_definition": common.MapStr{
+			"key":  "SERVICE",
+			"type": "DIMENSION",
+		},
+		"group_by": common.MapStr{
+			"SERVICE": "AWS Lambda",
+		},
+		"start_date": "2020-06-01",
+		"end_date":   "2020-06-02",
+		"estimated":  true,
+		"UnblendedCost": common.MapStr{
+			"amount": 1.5,
+			"unit":   "USD",
+			"normalized": common.MapStr{
+				"amount": 0.75,
+				"unit":   "EUR",
+			},
+		},
+		"UsageQuantity": common.MapStr{
+			"amount": 42.0,
+			"unit":   "N/A",
+		},
+	}
+	assert.Equal(t, expected, events[0].MetricSetFields)
+
+	tag, err := events[1].MetricSetFields.GetValue("group_by.team")
+	assert.NoError(t, err)
+	assert.Equal(t, "team-a", tag)
+}
+
+func TestGetBudgets(t *testing.T) {
+	m := newTestMetricSet(12 * time.Hour)
+
+	events, err := m.getBudgets(&MockBudgetsClient{})
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	fields := events[0].MetricSetFields
+	for field, expected := range map[string]interface{}{
+		"budget.name":                             "monthly",
+		"budget.type":                             "COST",
+		"budget.time_unit":                        "MONTHLY",
+		"budget.limit.amount":                     200.0,
+		"budget.limit.normalized.amount":          100.0,
+		"budget.actual_spend.amount":              150.0,
+		"budget.forecasted_spend.amount":          250.0,
+		"budget.utilization.pct":                  0.75,
+		"budget.forecasted_utilization.pct":       1.25,
+		"budget.alerts.total":                     2,
+		"budget.alerts.in_alarm":                  1,
+		"budget.alerts.state":                     "ALARM",
+		"budget.forecasted_spend.normalized.unit": "EUR",
+	} {
+		value, err := fields.GetValue(field)
+		if assert.NoError(t, err, field) {
+			assert.Equal(t, expected, value, field)
+		}
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	m := newTestMetricSet(24 * time.Hour)
+
+	value, ok := m.normalize(10, "EUR")
+	assert.True(t, ok)
+	assert.Equal(t, 10.0, value)
+
+	value, ok = m.normalize(10, "usd")
+	assert.True(t, ok)
+	assert.Equal(t, 5.0, value)
+
+	_, ok = m.normalize(10, "GBP")
+	assert.False(t, ok)
+
+	m.CurrencyConfig = CurrencyConfig{}
+	_, ok = m.normalize(10, "USD")
+	assert.False(t, ok)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cases := map[string]struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		"empty": {
+			config: map[string]interface{}{},
+			valid:  true,
+		},
+		"group by service and tag": {
+			config: map[string]interface{}{
+				"cost_explorer_config.group_by_dimension_keys": []string{"SERVICE"},
+				"cost_explorer_config.group_by_tag_keys":       []string{"team"},
+			},
+			valid: true,
+		},
+		"unsupported dimension": {
+			config: map[string]interface{}{
+				"cost_explorer_config.group_by_dimension_keys": []string{"COLOR"},
+			},
+		},
+		"exchange rates without target": {
+			config: map[string]interface{}{
+				"currency.exchange_rates.USD": 0.9,
+			},
+		},
+		"negative exchange rate": {
+			config: map[string]interface{}{
+				"currency.target":             "EUR",
+				"currency.exchange_rates.USD": -1,
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			var config Config
+			err := common.MustNewConfigFrom(c.config).Unpack(&config)
+			if c.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
 }
@@ -19,5 +19,5 @@ func init() {
 // AssetAws returns asset data.
 // This is the base64 encoded gzipped contents of module/aws.
 func AssetAws() string {
-	return "eJzsfd9zIzfu53v+Cta+xE7Z2slMsnWVh6uyLc/G9/V4HMuzkzct1Q1JXLPJHpItW6n9468Ikv1LrR8tdcueq5uHrY0lkR+AIACCIHBOnmD5G6HP+gdCDDMcfiN/u/g6+tsPhMSgI8VSw6T4jfzvHwgh5N/0Wf+bJDLOOJBIcg6R0eTi64gkUjAjFRMzkoBRLNJkqmSCn11xmcXP1ETzwQ+EKOBANfxGZvQHQqYMeKx/w9HPiaAJBDT2n1mm9otKZqn/SwOo6iDlgQyd6cFP+Z/DeHLyH4hM6c/uD2P36RMsn6WKmz8eJzRNmZj57/7tp7+VvteIzf17pDM7MFlQngFJKVOeP/RZEwVaZioCPVihQH8YTLLoCczA/vcKJatYN2C4owkQOSWUjD4QP+rKhDFLQGgmxRth3CcUpjKsFcg//jTwIjf4afDTjy1RxzKbcOgDtCZmTg1RYDIlIHbrXewFcnF/Q75loJarJE0Y50zMVkgp74QtGP7tx/g3iaQwlAkLBwhowxJqICbRnKoZaDKViixlpnCr0iiSmTCEidquDf/y3TsBQ0t/r2/BMjV+dSqfraNo3Vjl8a4DDVeOhEFCX1a+HCbgssLHRs59oi8syZI1zPF8QcasLlWUs+mg1SqGqS1YUpalZ1BAdKRoGuQp169fUaae5yyaFwM0aGUNwpDJksRsOgVl/8PSoVNa0T91Nb3LMufjNC70qnbYwhL773EOxbBEpxCxKYOYPM9BuL1T4j+hKWtQaEtBExlPDlqdMMiR1sb+cIhTDi/f2uYbZVEEWk8z/gDfMtDmlhoQ0XJAF037bI2S3YHp4Z+VAboARWdAuJvLWjGd4yDKAdHEyJxtxG7chP4lRfGnkVFAkzorPJAMV8KuaiFmhiVAUlBMxoP2DNmklQ5lSOI11ltkyGfBmYAbEcPLPagIhKEzuFdypkDrXsUkzaezDIlkknKwv3H6ghIBz2TG5YRyoiGSIqZqSZgFSpgmE7AE0zi2hEpCiaETDuvpvFdywaxPAvFXxQxc0ZRGzCy/CGb6pVNkyQSUpTEtMJBnC4JEHgXJLAw0YJ4Sgv+3mf6dqHwAGr82kQpo3DmNV1LoLDk2gUGpFYQ2ERd5bEQuQK3fjmeN02hpXTsSUUGMotETmctnkmTR3M6GTl+Zt2auZDabp5mx2yHTsGGTr2eZzpKDnLH1DNNZ8p1y6cj6YVWyGnXD98e03mXre+LTA6ScRdRSdkwfDDhNdaB8AuYZrG0VJEtjPDoxAwmhaQoUHQgmkGO5z6HR57A6u3EmKYAoR5jT6GeEith52KsjUyHNHFT+Cz+Z1/9b7HcD/47hsv0/w79HRYWmkaX7SoopZ5HpTQAvvPAp+A9EgUvnHBZQ8nbjDKzjZgpclNvNi9B0zutIiihT9ujbOFU+nHTM0DQBnE63Y0VPukoayt8qGy5ckGSdy2gYZ3/hfjuKoqqeBrY5kRmig9ievy29tB7w2U5s1WC9GWobbVprckdLbSC5VkqqPu1wy6OrU2wzEKCoaeIisar198fHe/Lru3dEG2oya9BjOOCAeyVFzNy+uppD9PSRMm5F3SHvkTmFPzfFKQk1BpLUcSsFNZUqsfs6oHNLv2HD3oOImZiVLOEVSsExSEBr5IyeX0aqABEbEJagVVPWOOokM+7nc7oAIqQhSzBkYlVcabADPQUaP86VNIbD9QJEb4v80CT9SBy8RID+IazXZI1DdnREDuT3LeatOVDymDlLmGmOZklBaH7PRk609b+prrBEOBacrucB6ve3KQdVHd+nIHiz94m+2F1x+N3Ldod5c3wEuWJPVxPA85I1aFSst2dudKadtJBYgkalQdOUL53aOY8hQafZcklbNjUzaZNmLdj0aEe5tS7aG2ZYIRGO1OajbC1mKqdlTpOPUq0yzxSsjmjqr00czjVuJ42DE+AB7yCuSE+mN6jwpvX46qzjMRek0Rd72yviIPe6JG96IV5fl3yiL6VTBsrvunNVnwGMw85Tczabg24+a66MVZP9LXLehnFrz2ivw7m6GDYzrfyTDXt0T64FbsGk7Du1vySHiT7i/fj15eiwdIWuL8b/JXmW4Ma8XFptdvihPwS9NPsLBQdoNHf7Q6b2vMukKJ9ifRQaXcTUWJd3gZC0PSbSaB6uNe+YUfJ8Qq2CY0IbKiI4I89zuz6mFFFQkCqwLNeVPzcEwbcdmB1rcOv1yhu3Db5L5li5+Zx2wRmrcAxGCWt+YM4XjaHfFYj2i4YlGyx2aR37w1pbxAPB/pFBBrcgZmbeEd4aV61xr8tdHsR6psygBErrUviEBJSsA0h6zE+8RXpFR7RVDdXN3z+X1yEF5U0KObn5fD86JTFwtgAFDnq+lvbDipWbuvO1j+FdX4785huQL3afPTMzL+cZuAFGo2G+R6Xgy21sKd9I9yKiNMHUyA0Lr8mJkCqhzoYbSd7/+o//qTlGp8V14mYp6IY3l5nS5pJyq8c64EaB6Z8Yc+XkPlOp1ICQTmbp+9MzUggo+ZwaliA3fh8OyYk2P5+6C6krycPfop9Pq8Q4emOwW39q+Ymbik4kRvqapDRSEFun88RKmgVhvaBSZKjyuTY/IwScWEFCmShdtE0sw1bSo5tFDi9jMDhoF2xTKGh/deh2nLZy4pwfyvmKPncHl47UiwXgQl1HpmplN3VJ1k3Mj0HQRowuD01Iv35qlWLnJGeThBlTvvvPffTo/WE+evT+mD761fvDfPQozQbI6UEa1Y+OjngdUQ7xeMolrX9hh9ziqiahnMsI7+Cvr96j3GUGyqEBqoD4O1NuD1Uk0xDuR4OzOFhLiFNC40zTWXOKdEOMY5f86FwGr+6/5Jou31hlbGiI7bey0sF3G96JMx69IAaKLyPKwB2jRYF5TrU9s6oMYqKZ/Qsz5Jlqwmkm0HFHnU6VqSfLlInRmUp5psdHIMpPVaUIL6fwUqpQeYJkAiNHpbOGUxH2Z1f3X65wBG+9/dshpslfoOSulOqxe8pQjxt0RCrS0kiw3StCGpJSFpNYPgtL8up6O2/AqRUzz6wCjTL0FmmcX2M6EppJFmCepXoaMDFIqTXazSf6Qyn1YxMFEbCFFTqBNstPT5gwoKY0Al3fdLvCHqegxhqiXuGXfHhU1Nal6ogSmZmjrECPuL/3JWBiMFka2Jn/zrn+jTT9qBVtOEAfewMH7nVZHPTSonRNhZWv11yVPvbLKyxLV2TETD8xObAe+NGW5dJvD+qdaos/N/jaSAVFNHJBGcc4vpH7UNPxopSQl9aiHyLwMHTkNSlnCPVITy+rErCXFqYPMlC2ZNqVUb/Kj/P1IMbeUtUmMLCGuI4XaD2RXeyiA+h1MtnHaq4Gb/aTxS6I6201V4g8fO/tQ69LYx1Ec4iexi4VtCNSHyCVymh7CsV0yQpSexJPqcbECGnm1Q9Daq3F5J8cANGYNFz9zMdZOdWGJExkZncix268I9PaByFhnlcgpXnFdiUmNxmRVPZ/spXnA44G65bNoP5GpX04SypfhWG7vco/ZQmdwYA174m96xDcDMMtF47vEoSM9GGoNviKqOnArkGH9RJuRMwiTKgOkhCDcanipVAt0wSE1UVr4mU50FSxBTUwiIUe1+rPdMBQPzoZ3o1w4sDeFc9+R5SsnrHhJbH+5xbQbu4XvxAaxwq0JlRrGTGMD+MN2F5YswlnUV8MxcFX+LmjVHpoHXIxMM7juLbKhUXk5j7/5MQy+JRMZOYM6D4sxS00iGTczM29FRGOW+fhmcsa//kf5xNmSCY0mwmM3uIkOyHtft0bkZKT1D3uIP8lKhPC/T89z4xhYnaOEdn/EgMqYQJl+r/WY8FyR+H/Qny6hSIzt/6t87esqu7LFPh50N0KZqHhcowfVuUF+DELvFzfNtd2ebUEtksaPYGIr6QQgM8GO3rsVV3KKB++zFYhTamACV8S0IZOONNz62z6F4vooEgaE397o3I/U8GMaYOZKEE2N+TT/v74eH8lYxh7isfv//yzYyrxxdn7P/8kCnQqhQb35iw8VMMEzwNBf+gH9IdeQf/SD+hfegX9az+gf+0F9PXtZZ9cjjizOgysakDQuop6ZY/uCLlHHmtQC1CdQPbvsrp5JFlPJvQ5g0W+C8IttGVC171aRVdpQfmG17sp41wuQHUHfTXHNLxZy7V6/kx9AhHNtMug1ZmaAfmWgbvMtup+g4wA5Wa+/F0Gph/6RqTK9Lkbvthg5V2HTj5W6NhROkaWsnLCaRdg17L5BAWcW7QC1GldWk4er8qf5nfywStUMgupqXSFD+tp/CJ6XpJMdLso3ZVGKVYDc7l8HY8zwkTI/jpzbiFmwtqvrDos6ACa4p27Y3+DqieZMIyvBGyUcUEHDbnn4w3IHGgMaoOFyKtmXtxeXkSGLaDw9NxCdsOioohmxenzeVPEimVZTilCcYxzxkWHk+Cqr5ezt/qR/T5VMzA7kh9ShW+vvnSVItxEdRVk7X3Uye3Vl9PyK7OLNH+ET27tLy+3ynaZpjt4Pt56CnheWciyx3681bxX0h4aoLNHN+tI9hfSYbrdFy2val189dCDanWoI55ZS+S+ueNrs07rw9N5A9rsCsd+vB3dwUwaRvPjeh+u6ePtqEIkE8ywsvfsDwUocTGL8TSfqwNCiQatsQxnCJtWCfYFiyhOhG765kPD+CN7gXj84E3fuA+ap3aK89y60pWIRRGt2AL2AWKmIDK9wFR+8E4AflF8fMsSZsbXWGUC4iNijmTGY/GjqT6UKh8cvjzchmuqfF0wYduKlnN/7IGC272j7KCC/K//2fH4+eHPP3uhtRRScURbrO4MilRLxWYYf12jDHY/8PcHf82xv0v8v/aJf00MoFP87971iP/dux6Bv+8T+PsegX/oE/iHHoH/0ifwX7oEfnO/+EfNwe7Dn2pwrVedBHxZbQFthttjhM4OX4Rf8jThdhHEhmNaHyx99QPaWxObX5CgzfLz4MOVfSzQtguwxlBplZQ5VkZytQqY0Q1FbUpDv24Mu1iUVvzPOFwvKM9ccl3X4DK+XVxmbAGuVJwLzymrNn1xB08MFWQusw1bvIfo0l4xpU1R0lpS/6EBiWKYIwYj7tykbzQQ8ZHL5y7DcBuCEFMunzU5qV4AnK7q+G06uwZ8/Hh13z94a6V6I+B2dAQCbke9EfBleIQV+DLsbgW+B923grn/WFqd+1Zm5lTEek6fgpvuS/r6C15RYCmKxIdjuDWlLloWLvg2OpyFKurL1VwjPhs9TidKIaKzU+HlMi24uXtzndfv6a5peiOO8hlhIuIZXg0/Xt3//eZ++41iFXpvC9IAvyz6m8ry43p8Fzu7TJHf306aNlB3dT92umv8ABq6DDCvJh1oMOTkYfR4Wn2H7d4w5RcAckfY17eXr4J537wfi9kJ06uz2rHXsdqx/dWyZ4K6K2qjSKFZjHkMPonjFRNJNqHLk0xWT0ScJpOYHnQackMc8SR0ixM2noJesVPkjVj425nuXUFrWrXz8qaZKK5V8G3LC0SZcZk5waSVmiO6j91trYjL/+nb9eqMG/cqLx96y6WkT5TumkhWMHB/bEOg8S0YA6ozlB+lIlQvRTRXUkis2RKAnrknHLV1cvJZ6VaBCUxUEFjkhiMGGp9zhOrTAyeZM54bTPwQtGEC5x66aoTLj5TxTHWSDNIbpTnonWjMVFd9ZPBZTl7H0CepUdO0k3QKIs79LmyN6YnY3iWiz71QSzSlWJjVN6fYcDdgrOGX6qKTWplWLtx6+o4SvlF4vk9DpQfXrUs7YfFlxvJ3lgoiqeJwWtjC2qv8wH6da6zOuZxLQJF7WSSOFoIArvfFBttuvRi1wFB6H6g/+oKiX0fkAWYNu9EhLMC73q6VM0Sg1X8rluJHX6YrgC+CJNEGR6ZUnLOR2m69mlYrRKSo1GHdm55o51Lpu1CEq0cWoDApyP4HZ9TvEVfkTE63sZXEbMHiwpGvl2hdQ3ZR468tA8reTLcXE7v4Mh2uZP4U4PUpshIcU1VdIdermfO1S8i0L73YcJ1BzYwaeKbLw64z8mHWOPGo26+KzvOu3V70RLC4o2XB3cUj8WNYZ5y6WiDOWjT32X9FTx3jNzfio5JJyZ/qWChqNcL8vi3zKb9uLvlHGyobF6BHyNbXwRvCf0w4gf/X/dUWzJ8z8yj75nNeLstXQV4B7y/9d2c1wu6R0/5pxEa0rZhdXONfOHe839v8wunHh1prKNkF7nURYu47AaEczW6NGA+U91KZCx7yMHsxJHVhwFRR12jHW3NCgyOeSrXBiQ5FoGXWszB4rwx7sGIVUyhl0/gkcfdsP5QDirn/ywZ77sKBQyXTPtCHaGOs8O1/g8bbCq1vG7JShfVgK1IB3ot22xlzK+XmcfdsS1ZqrnZhTcrQe+V45xal+YlJH688S9fzXlvUs/m2ausAWsWHtXVS8THbOj0MD2zrdFDJ+FBP0teF/2HDqu1SUL5tqfX/Xxr+2KXhY2rohGoYl7ZWL+SEiWoPqVY7JObIJnmRuAFVohHUXhWDfBeeh9AM944mcHLxcHeKIuB6jMV6O6iIU93Mq71gXZU1TLl8VejDQEVMEkikWhapP4ghfHF4ua2gaQk9i0EYNmUrdYm6IIHaZVXnOktTziAuFr+YdeAaRxZ/IMyRngn2LQMLwMl7/g07bCsSXX2/7sgb+XoTDmcwT6XiU0znlK6v0znGq51xDKmZN2Lbs5VHsdVkZjCwZE3MzWdNThTQ+O+VNqb6tNyai+LdoHNgmH5qxh4qUH7jY/eYaExnIMz4P3LSj8bwWSOjP27JyL1eurATEjthuQzI1pKNUwVAJxzGbvcctRh5EZAtCqAqKmKZBK57UGuRj7WRis6OVxh6HWyPg+h0bU06n5E/zjTEYzz7uSeOYxZ3KSMh8b80A7kZhj4j2rUZsRgG7sE24D3kvdRmpmD0x20zeMmt9z72DfIRtubSjDmdDZJJh/A5nc3wTt63bnQPOl1b/vAZuplS4123AZWgkv96cYsKJj9KtaLPaoExk1vrA++pf0IHyJLJZ/rJXQWubaS3DikyAznfoopxkPnYXxcfIvZ4M0zJg0X/4NemZHzsOlk5m7NQ6Nf5EmX7VF6bT8vRH7dn5BNVjA4vXc+XYr0q06zxPPQzTZ1//EqKwAJwe98lGfu2TxWK0aS7U4015xihyvWH9a4KZd5MZVlncDnTY5+wtrqah2xAFMwSKfYoUFIlduJWOwtN6/G3lrPoLffWtwwU21189kLn5yiuuraBioHGXEZP/cLKZwkZB7lbug2fK2KOZu21dp83vpX8/otMSVXRS1iMCSfbRIir+s9aVMHvriUL4zx0BahJbp5Km2kDykM9s8ZAYtUnasiv587Pywu+bSbT1cN/FTrd3sRtWiMzj7sdTia6h1xGlL+ykxiks6rsDSSpVFQtQ/N/a/Wsct0mpVzOmMBK8ZnqWVX5QwbOWFxgbdMHRWfVQSSThDXH2TrT9m6ONlq+BDAGDmtKrHdnjnCOXO+3QRfzfqENh7elZ7ktgCU9A2NCgzL6jGRpTA34RoCOk62QuoGOAXafBfYvYzuFl+udUCq91OkYG3PkV03Oplgf3fp3rqms1cDh1gNbMkbzSjMSq529ZUW33dpXr60LxbUHC8YeVZesYCKSiT0vnjy4wU8Lnig6nbKowU8v54Uju6JMG5mAKhyi8GPLuhAvHY7yP6MXYlV86TKDYuu4/Oy8M1fCynTJFpmZmUS2PPrRvx++WNeoj81cz3qmT7511qqTshWjBg5rLpc6Uzlujn1UjlOo/aJzc+yDDj3DfsFN6q3dcIm3YeS+UGxLj6bLqIuHgFtoxelB5ZswzpmvNruZjDaeRV80YLAuhikWDJSCcCpmmV2rk+Hw9jT3S9pS1sI16Yuyjd5LS3paOjD9khS2dEsaWmntDijoSqkH/C01el9rUFX6Ldegpd7vi4aqaWhJQzvr8AYFqeVxszfNWzmR7rgIeD3rY+wMA9CvFE8pBahlFGUpc0G/CRNULTGEEtzXhNpzyepdg4uwqY1XCiVy65de3V54NcTbSxMSOyGZMg7tou4l+PVrg97hH3RdUPqxHrjstl5jXCFToTxvePYrZthPWoQTb5GpEU7EW13bMjUTLqOnzppxNpNTIaMeyS8evDkk268eSgkj8WTsD/rjPtJj9kx4CZFi3+4kopw7HecPoMUtgP/mdkKVXHlieABdw0tiB9SEsycgXx9uHq8fiFTk4fpieP1w1iVwEDMmoOPWgdc0mlcud1UmPO/dfGeOsvolbukCFx/Sm6iZAIp0jr1JGZdut7vcJ/Wra1XcWgcJCm3wCt5jQXJnMCKZpNSwCePMLDfcb29cK0/qjMsJ5eN4khsWiMf5LWkrm7qF9Juy8vonTkuGXhnU38Q23pcWAIvE+VSxxBra4nlt862Nb12M2qX6/R25Y9WWC4BNQR2ZL4XAKIiltWLuuBrgqDJHnJtRY8hBpJc9Dsyw6Yry8DR6J9I5nbn3ljkcMQtH2k3ysKND6an2gw96pNMnjxxGX+UWeR/qxgl96Y7CcqpXlaRyQ8Q6eKeLrUpfvR4P7kItor8fqUx0TCoTb4HUCY2e8C3vOJpTMYOxq9KgB5ECt13VulP2oRmf+dTETe0LRGiCU4f6s1O2AJ/v6TpjYy7ENsu0lixsU9+pxxqZrFq/bR1ZlWSO3Ql4ZiKWzwM3T6fnnOkUFFjhKUudL7hVUOHmz3uPenrrn+9KBV8X+ztUmsLbSWo2wbReuE4o56FlxiaSp1i4wdVIdnUNw0RrkvZcXoRPHKLRU5aOFRjr30sx9pURuzT7jw2VINy8eY5GfoMZ+rfrLE2lckxKJRPmnIlzdCIV4OYgU6AmU4DeYvWCtBDaH3WYKCdwoyBUWKMFTfVcmlfjReTLtmJXK84DeQGX0zO04ciCyfYsBixI3ooBEY3mMJ4zM0ZXdDDJ7O7rkPbqU6zVokG+xot/B+Wmd6h2A+yKcY01dLl924F+QAgazCbc/syYpbhPW+QTtz915cqm8kIL09H92avcLXFN8nOsx0aOvceRujOm/sbHe2ZFtwyhzkoAW7iOD8NR+Tyc028kkWYOigjsx+G1x1ZDl6Uho23sMgbH7knja+kHu/3dO86lzFx8ySUyli3CjvEMv7I+p5TD1PREnIKEMjzwlx5xYBgTs/MakhAToDpzXTjr+Xm53v4wjinjy7A+P9SxtnlaWx+s9s4WP8sXo89Xt6MPhz26nWTRE5iBZn+9Vgomnt1zeXVOrYtPeGyNuJ23NJbTsZz8ByLT/d4qPUtzMzRgc7uI83yp8VnjGunzNuFQufPDlCQutLx4y3IWDKJ74d3jYmE3pdz8uoIuEj0gF7sdffBrd0YUzKiKOfiHqMt0jR3Osc869RhqmP95/VjDbYUryB4TTTRswZtmPeK9/9I53g1XsJ1AHl7fXj9ed416vi6DohPMv19fDHeS522yIHWfwvB5VJeGvVBuyOY4FGeBZHR9e331SD7jouPbb6voOpYKR8lYR1SIIz++qefTBSPrsbi7k53ZcQj1Ckym3gr5Acwx6Oesz91WPV3auXy9BYSOFG/2nmL5LLik8eusjFuWAgNutt1M9vMcFFQbybrUZ7xznsh4zXv0LH1tcgOC0DMX3a5SvzKL/ay95gRXGvyXl3olow7F7ZeXl2obWVefwhUG3WXd3I6jRYlYYHi0fkekIj9vJOzXPgn79eWl2l/2GISFfLMpU9qMrXC0uI05POssBXUeZA5DP3lEJPRuLkQSSy+Xq581scBIF22pbEos3YM5RRPIFe9mfqAjH043R2UJcJpql3GzhjW4VriRC3aE3ps0fKJDlfhNezc/D4rDantpcczaXqO75tper1j59j7D6pcj9lcXZeGtGISqFgloTWegSZr5Apvr68qNPo1GrkHFAzVdAVG+Lk+p9cXo0yjgIrHrlsDqj1DLuO5Q0X2efvK03OekdFuwb5VXdge4N95+D9yNiJEpi3ZAeycNplthgotvCtEf5IK9fBmYGnZLMwXu0mlih55grXYR471TW9I+4tvdvuhCBVDC7l8KGxmIbIuWcWM58znrum5pFXKtm+4ysBp/SqaIgqSSs2gn0V9Hw/mNWFDO4gtjFJtkXbVu64SqSg/hMM6PhOZQMYLPHAHk3NV9e6HWbp9Vfpv/gvyf0ec7V3g9kkpBZFwqY0LNxlL6W7l4J71u+W746FpECFliZ0v6HyBWbAHiUQ75t16pRah4/ZZI72w0tNnZS+08Sk9G/1RguWfxo/Uk96Rj9Gn0SQozf5RDamCUgjBfRsNOQEdzqmau2YFjd7UeJeaOWi82r2bok9EjykHEFJ/Kmrl//ONq1pWsdNMVwLcDXb5vR3X5/jiwnKuvSub5MaazVlfYHTyvSVMlX1iCRcaL/j0OFhFSnLtwc5w7Vv6Ot0EkCyfWL24MnC67S75as4nKgIpMAj83pjGtFqpSQFEWWZJAzKgBviYkktMipBkvmGar3mk3R+2qTnAGjEw5m83XxDRyZEdBVWefUQwWlBeHvx3lwYpSv0iDvLZCFs6r/ULLY6uTpVWQPK8W5Ks7eF+BuNcvWyDr1QLOXa95HAdjtIGHkKRmGYpf9FMqtMaei/ubwD7sbMXcDnfcJTQQsCYxDUShbo9+ob9yet6Nx+6jbp/FjP4YeZ1ZGbfy7ot10m6oOtTeLYf8MN9d26Gj9O2pMWe9rxh63fTX4yZXvDtjyptUHKk1RVtg3bOr0sJhP1R5m5RLTqOnueR9tZnI+6UUp8UlSewmte4VmYTpiZIrNZo3wL6TD/j9I4IOlgLBE1oHnF+D6UMT33CEvRWdlgng6eLNarYrynkfLXr8U1KI0cZXC+JZy+vyyjDsSKMIAazFGBoA9IETj7051nyZ8heYdZA+XzN8zZ1PpkwUKilmCQjtujZrLSOGpg0vzgrhWRXVRSoOEtRFKvYW03/d3719G/yYCQF8ZLq7dyg1BABicPgBvtazH7DIskWfkXeEiRgfnmoy/Pz1Ds+hP5f++OXe/eryn/f+J+VPr0ePF5e3N6Pfr4f4y3eE6aL8GOXcp10jmA0BOkf+kBq6xbjuTn/N/yj36bES4TmyA6JtVrUtpJV2SGU4/zcAAP//E71G2g=="
+	return "eJzsfVtz4zby77s/BWpf4kl5tMnMZOtUHk6Vb0l81uNxLM0m54mByJaEHRBgANC2UvnwpxoXXiRSEiVS9pz6187DxpKA36/RaDQajcZb8gWWPxL6pE8IMcxw+JH84/y38T9OCElAx4plhknxI/nfJ4QQ8gd90n+QVCY5BxJLziE2mpz/NiapFMxIxcScpGAUizWZKZnazy65zJMnauLF6IQQBRyohh/JnJ4QMmPAE/2jbf0tETSFgAb/Z5YZflHJPPN/aQBVb6TakKFzPfq2+HNoT07/C7Gp/Nn9IXKffoHlk1RJ88dRSrOMibn/7j++/Ufle43Y3L8JnaOkySPlOZCMMuXlQ580UaBlrmLQozUG+v1omsdfwIzwvytNtmHdgOGOpkDkjFAyfk98q2sdJiwFoZkUr0RwH60yVWGtQf7m25FXudG3o2+/6Yg6kfmUQ/Onh4HWxCyoIQpMrgQkbrzLuUDO72/Inzmo5fqwTxnnTMwrja7PhC0Y/vBt/EFiKQxlAuEAAW1YSg0kJF5QNQdNZlKRpcyVnao0jmUuDGGigvSs1jC2EktttJuXkJDpklxKbcj1c8alAkWoSGxn2lBjVc62P82TOZjKAFZswRQMrfx9dUJXZePHuvZZm3za2qq2dx0kcukEMkrp89qXQwdcinnDh7Vx+EifWZqnLaL2UrZiHp00AbIkogRmTDDUrt6YfoFlK7H1ydvIbbIAa8dKJaAKKopwRnQeLwjVZHz98J+by2siFaHEOPM3aoWG0u0DG7aDCme24ry6+Xh9N775dIcIJ+c/bxqL6bJmDDeYljXz0ox9VxuzZY7jP2TtVpWdaEOamaVVxPI7crbWarEkkSdmFjI3tklD581i0oYqEyXUwMnuA1gjNsYWSOLtRYHtjDAR81yzR2juGURyUL/XImnqFZ439xqm9krTrtuplByo2NTtbwswC6gOAuqnNoxzMgX0okrrMV2iaW4Gcp5KZdhfkKAB7s1Q0BSt09rHhLQsmY0UC2hWpr7NUWufuWDtPTYP5LYuscn2DoVUKeX47dFwfHEhdWMsZmyeK0iIwVXGkDhXCkS83AlgH8KZdIARIFxwEMlrVC4P7HiqVevwuIrVwPUrV6vPYvpaFauAdjzVWunyuMrVyPcrV6+7AsJnTedw3iSnF1azEiLJEaNvdVhFa+m0YZ0MvVr5/ZpTYZhZvi4JWmjkT49tWMHV+9ogL7fF7U1QKzGfvbCHqA/OZwdv4F1YZQfm+iv3hJefxhPcbn0en/98vQEGSyHqY9xuQczNwrJnKZqzR0AjMl02ovv46W7yy+3/bcfFWcpMT2u6lQwkfi5s67MXJRbM1Adm5+77XnQu6t0fstysATz2olMFQ2OTUx7pDERPknJrF8EWSzHZxc/4QSQZKCaTHUH1rUh+AC2+HSH0rUyNIjp08F6JQs2kgphqA8kASlU2TowkUzhIy9aQDqRpzaA74BpI/bYIs4+xfyU6mRvG2V8U2Y+yuB2GjimHJJpxSZu+NEM25kdUrBiE2Yb2ATssdMFOVStiK+5OS1pFrq+ASolmTzqUgzJ6ZKSh/KBji7s8nYJCEbsmyamQhs1YbOWj31SVJcSPtzmUHhwTEeVUpf3ie1pIDcQsFOiF5AlZUE2mAILAcwyQwKY10bYwsudTh86j89vzh4+EOc0s0SBOsXQ9hcH2tnQN6Bn59G8iMSD8xHQl5BzgxsVR3Mm2bcWG04I/ymZWDgX9kZo7r3wCjETHimaQrJzh/4a/JU8LFi/KBhpO/rVdOJYkYbMZWF8FeeiMxtB8/Dff9fCvaOdk9xHb4QClaBYnYcxmDBLytADhzmcr8ic0Y+vjkywFTWUyPWh0QiNHGhv84ZXt8uritR3JjvM4Bq1nOX+AP3PQ5pYa63nTx/mBy/X6+AcdoI+gcJvPXV84Z3WBgygHRKN9DmLDrex5Sv+SovzT2Cig6aooPJDcjoRZVNXM7ky3eVetAtl0Vn2oQFJ/jv0aBfJJcCbgRiTwfO9WXTqHeyXnCrQeVE38Io+aImcklmnGAX/j7AUlAp7InMsp5URDLEVC1ZIwBEqY9md7NMGAq5H2ZHzKoZ3nvZKPDNNxIPlNMQOXNKMxM0v0jIflKYrlNisxkCcEQWKPwkbCXAaJZ4IaQFv478TyAWjy0iQV0KR3jpdS6Dw9NsFg1EqiTeRij43IR1Dt0/HspKEXoiWmD5GYCmIUjb+QhXwiKWaBhMSfqmzNQsl8vshyg9Mh15DsIzKdH+ZLtgtM5+lXKqUj24d1zWq0DV+f0AbXra9JTg+Qcb8DPKYPBpxmOjCfgnnCbR0VJM8wVychzEBKaJYBtQ6Ej7IUPoe2ThiuS409SQFEOWJu1TrzGYvUNLRMhd2ZFb/wnXn7v2X9bpDfMVy2/2/kN1FUaBoj70spZpzFZjAFPPfKpwCT87yU3nJ4hIq3m+SAHq8pcVGOk9dC04WsYyl8ALWxq6I56SSv8ZAORae7iWIgW4WxpNcqhnOXodzmMlYiescwVPXdQNXKNnkQLt5YnkD6bOsuZOsL1qth27imdaY7XmoD6bVSUg25DnfcujrDNgcBqjlcSNC0/jKZ3JMfvvuOYFAxR28xgQM2uJdSJDb7m/LLBcRffqKMoyfskA8onNKfm9kuCTUGk4Zt5CMDhXF7Epfo3JZww4S9B5EwMa+shJc4gY9CAW2JX/R8BA3TbBGxAYGE1peyxlanNgGaGrKgj0CENGQJxsVwK40d6CnQZLJQ0hgO148gBhvkhybtt7JxcXOLudWSNTbZ0xY50B9azTtLoOIx23yD5miWxPBPSJwnpyGtpCoS4SJBb9plYO3769SDuo0fUhH8sveRPuPW//AbOdsd5tJkNK3bViq4C52C3VfigkZF+3qG/yYLpl36DEkkaGs0aJbxJWqdFG8TSO2mA6WkUUzNQgK9i5gm2MoteqqvWGClRjiqjX2s0McOKpImP0m1LjxTijqmmT82ccHrxj4sYu8EeMA7qKvlk1eP53YZDzufjzsgjb7Y6x4RB3nQIXnVA1HKs6X5wW3JR/pc2WVY/W3bV20S4aE7jcP2Uws2X8DajQL3b62tFd3fouddBNe6R3sZya2qYbPQqj9p7MQ1s6fUgrRgqk+2nRBvIPwHTPURz8evL8aNR+M7pyv4Rk+aBnyfg/H/SJ6ndmJeLHHTdfimPwS9NPvLbuqBxgs3P2SG+1082azsYn0U2rqImSFSkEcLSeM2kcaLcKx5x4ySb6cUnSUmtKEihjM8IlWYI1NGFBRkCjBdRNf+3BAE37ZhdqKxU29Q2bhp8FUKB/XmU9aHZNDg2IyzympXVxptQ79rEEMu/k7jOBzWlUE8EOyvOeTg7hr0hHdFqrhRWNU77yxp8kSZsUEmm3bqExIgOYzSpNjxlukVPXGrL1Q3//xUHYcMlF9SyOnNp/vxG5IAZ+7ahk+ic2OJH9ZWORt9ECGGd30x9pNvRD6jEcJr7NU8A9fAeHxVzFEp+IasVyeWcGyIM2kQFfVZzhsGXpPTMhEYje27H/717xXH6E15nLhZC/qRzUWutLmgHI18D9IoMf1sY66c3Ocqw1xPhHQ6z969OSOlgpJPmWGplcYvV1fkVJvv37gDvUvMw3R/i79/Uyfj+CaAUx9Dmla2hE5DqYN1LY0VJOh0nqKmIQjcyVYiQ7XPtfneQrAdK0gpE5WDtikKbK0Ez6pY/UxEvUB9m+CAbQoF7W8O3YzTPmcdgxucrzgBYePSk3lBUnYCHZvV2mzqk9ZNwo9BaCNG9CMEEdKPn1pn7JzkfJpi4LriNAQuEL872easbvTR43fH9NEv3x3mo8dZ7rLnG64AbE3/3yCH5o0Z5VzGNofh+vKd1bvcQDU0gAcU/syU46YKA47hfDQ4i6NWIs4IRfZO8cmOMY4dOJQ6eHn/ubCExcSqYrPRX/xW9XLFNrxTt3gMghiowl1wFbid81SUmDEjn8axyvEaBhMxnkKTJ6oJp7mwuxpr06mqTZhVMjpXGc91dARSvqs6I3s4ZQ+lSpMnSC5saLSy13AmAgVxef/50rbgV29fSYhp8hcouStTHblaYskwVC2XRsI4VzAWllGWkEQ+CbTy6+PtvAFnVswixyU/zq23SJPiGNNRaKYswDxJ9WXExCijWDdPD8LUt00UxMAeUemEXYl994QJA2qGlyhWJ92usKMMVKQhHhR+xYfHzStBl6onJjI3RxmBAXF/7UPAxGiK8ZVd8YfLcU0/6sTNNjDE3LANDzostofqoPTNAvXrJUdliPnyAsPSF42E6S9MjtADP9qwXPjpQb1TjfgDSqKNVFBsgOkjZdzG8Y3ch03Pg1JBXhmLYUjYzdCRx6SaITQgn0FGJWCvDMwQNHDwRzLTPWG/LLbzq0GMvbWqS2CghVzPA9ROso9ZdABf1BkYZDTXgzfyxcgNNpprJA+fe/vwdWmsoxiTTyOXCtoT1QfIpLu/X9ZALZDiTjyjGkPAU2kW9Q9Dai1i8lcOgGibNFz/zMdZOdWGpEzkZneSkWvvyFyHIBL6eQEqxd/3IhN+PYqlglHcUKTFLQzols1BdaNRdwFtVEgqX5u7Cn0LNJbSOYxY0m8dgpurcMpl28fYnz0zdmGoLvjKqGljlYsDcN6IBPO4odSEBIzVuGqolmkCAm1RsgVoptgjNTBKhI4a6t0dKFDfOrm6G9uOg3jXPPsdUbKsWROz/aHd3D9+IDRJ8OY6oVrLmNn4sD2V2wtrPuUsHkqgtvE1eRad7wStRykGwXkc12hcWExu7guRnqKA35CpzMvqOl1FaqfQCK90NAPf1xAVDyRUZXhGMBpNvv/X2ynDZEjN5hjA9p3shLT/cW9ESk6xZBFO97+JyoU94vyb6EVuMCPhrY3I/k0MqJQJq9N/o8diy92H/wvJmy2MsKQOTVwkABeEfkegXAp8PzZME5aF0ckqLOCHVXkBfswCL9e3F4cdjvlGG2W+SrutrWp7FxigFMmlFALstcGeLnvVhzIumq+KFU8KygImfIlV/OmUM43nO+HGIo4IlzQh/vSmfMxEwZxpYzNRgm5uyKfF62CXMoHIM47e/f57zyyxC/Lu99/x/aBMCo1l5hIoLqrZBM8DQb8fBvT7QUF/GAb0h0FB/zAM6B8GAX19ezGklGPOMBALaBqsTus66rU5uiPkAWWsQWHKah+Q/b2sfi5J1uEWOYNlvotUNWuZ0rZbq+h+qEfK24GPM8Y5Jqf2B301A6YgUFr14pr6FGKKuRIWdq7mgMXb3GH2LOcbcP8ClJvF8hcZhL4pTb+70Beu+XKCVWeddfJtJZMdtWOMzKoJp32AbRXzqTUiHNEKUG9WteV0cln9tDiTD16hknlITaVrcmjn+FkMPCS56HdQ+iuNUo4GRuZCHQ98BcmHAPWZi+miltuvrFkWlx2Ff/bD6MRf4RdIk1wYxusevU9ywd9oKDwfv4AsgCag9Gj7W2rntxfnsWGPUHp6bm71I6LycaRyUMtaEwTVsqqnWDXjEZzg3OKiw06wLjoaQubrH+H3qVp9wK6dfkgVvr38rAdkXQdZTwMmp7eXn99Ub5mdZ8UlfHKLv7zYqttVTnfwdLzxxEJ6qwNZ9diPN5r3SmLVQ+jt0k0bZX8gHbrbfdACZFp+9dCNar2pI+5ZK3Rf3fa12aYN4em8Amt2adue3I7vYC4No8V2vT/WJd/J7bhG0r59WfWe/abA+hgJS+x9+sIcYD40aLy94BbvdcK+YBG1HVk3vZ34L5PJffQTe4YkevB7p2gIzjPs4m2xulJPvTKpimjFFrAPkDAFsRkEpvKN9wLws+LRLeajRte++PURMccy54n4xtQvSlU3Dp8fbsMxVTEuNmEbVcu5P7ih4OgJ4MklFeR//XvH7ef7338fhGslpOKEjFjdHtSylorNbfy1xRjsCP/DkPBbtv194v9hSPwtMYBe8X/33YD4v/tuQODvhgT+bkDg74cE/n5A4B+GBP6hT+A394//WnGwh/CnGlzrNZCuRi0C2gx3wAgdNl+GX4o04emyi0gbtmlDiPTFN2ivTW0+2LOizfrz4MOVQwxQCbs6JFtCpXUqC2rTCe2VJ7xms17UptL0y8awy0HpJP8cy6pRntvtjO4bXM63q8ucPWI13sCE4CFBKO7gyVBBFjLfMMUHiC6VLDrElDZFSQNWn2xxaECibOaIwYg71+krDUT8xOVTn2G4DUGIGZdPmpzWDwDerNv4bTZ7BXg0ubwfHjyuUoMRuB0fgcDteDACn6+OMAKfr/obga/B9q1hHj6Wtip9DHQtqEj0gn4Jbrov6esPeEWJpch/oX4orCvjomXhgK+d3R08Ffo0CBd0NVvUZ6PH6VQpRHR2Krxc5RJNbseD8Zncjo/F6ZU4yniMGfMcq0FjUPufN/fbTxTr0AcbkAb4VdXfAHBix+OrmNlVRn5+u9ViA7vL+8idA2AoHEw0HCssdWbI6cN48qZ+D9vO6sIuGbkjbIyZvQTmffN+Jpf3kVOmFxe10wq0oEHsL5Y9E8xdkVuCla1YYvMYfBLHCyaSbEKXizV8ARen6TShJ9s2Apt2Q66JI+6Ebm2HjbugF3wp8kY8+tOZ/l1BXFq1ez5plovyWAUPgOAZ4txAUl3SysMw/zGisna28p9WUxTonNv33GnZ9JZDSZ8o3TdJVgpwf2xXQJNbMAZUbyix0DfVSxEvlBQy1xWgZ8TUKLhxcvpZe63CJjBRQeCxWDgSoMlbbqH69EB82mFGGd+wxF9hroawfV+5aoRLfI4jV/CqmRagd+KYq77ekZmUr7V7zE6zGmYS1ilKCr8LJ1Egsf2ViCHnQhllLCy78d1ucLNvDOYoS3XeS61MnAFOc/2LEim1N2eKeRoqPbjXzrRTFl9mrLhnqSCWKgm7hS2ivSw27NeFxepdyoUGhJzDipArimChblDYzwKdL/UIyUCof/IFRX8bkweYN8xGh7AE79529RTcFihw9d9KJCYf2DJdAXwZJNn05n6lOGcj2369mk4jhLVSqnVY9+YT71wqfRdGdvTIIyjsBfOWKWfUzxFX5EzOtomVJAxLhBaO/GqJ1hbaZY2/rgKoejN9+P3dfJkeR7K4CvDyjNDGJFTVR8gWwMNKCm1DyLQvvTg6WWUsqJlTA090ebLNg93kwJfNtDjxdi92Wb48j7Ef+7inLe6IIrg7nxDfBh5JoMYV7w/p1+ap29zZG/GTkmnFn+pZKVZqhPl5W5VTcdxc8Y9Gu4AeW7G+DN4Q/mPCbUf+c3+5BfOn3Ezk0HIuymX5Kshr4I3sKGoLe0BJ+6sRG9F2EnZ5jH/u3PH+gjYl9jI2XDr99qJWC5Nd4F6XIeZhIddzQjojthvKe6nMOQ95mD1DdQvJCiCXKmqTjMNqjjnGVjMJlq9pRxyKQMt8YGXwXplRVGhbxTTEeZCLTxJ31/a9ZrOE+7+0o7934cArJbMh0IdoY6Ls3f8Gi7cV2tBrSIDY3ypSAz6IddsZcyfj5nEPvJYE7L2uJlXog0q89xWl+YpJHxu81VOAwt81wVqsZvO18BqdrIJWiT7Z5iRucoZVcsxnnR6uxi9YMj7Uk/R14U82jFpzyZJ6Qfmupdb/pzT8sUvDJ9RQfAkrqkytQeiEjmpzeHUbW0M2LYrEjagS/VUM8q/wPITHcO9oCqfnD3dvbJk1+46WSvR2UDGnWvcH67JqYarlq8I7DHhYk0Iq1bJM/bEYwhevLgrN2I6eJSAMVk9QA1CgOKzqrc7xuiQk5eCXvY7cw5HlHzA2gixywf7MAYfW6XvxDWy2E0X0JvMeR2js6004nGF5qhSfYrpg2oKO6S+RPdqJEsjMYqULh23PpzzKqSZzgxKwqSw3nzQ5xdpR/6w9Y6rfVJ/movZs0LLCSqLN2H3hRv0nj2x0WEV0DsJE/5VT3cjjUIvhs0bGv96Sse2QnGOHBDuslgHZWrJxpgCw2l/kZs/RKizXz5rKAqiKigRTGZzUPahW5BEWAaZzX0j5BWF7HERnrTXpfEZ+hFmIkd37ofMmRcSSnZHvgC4k/ld6IDdX4Z0R7d6+Rwwjdz8dzwgkuZfazBWMf71tBi85eu+RfyDfwtZcmojT+Sid9gif0/kclTc83YhK7HstPsO/pVLbc3isUWeLCP52fmsNTLGV6sQPrUDE5Nb6wHvan/ACZDmB0Za4o8DWh/TakFphWMl3qGIcdD7xx8V7cCjUHgMvhBJ82wyLyyKc6uKD44R6tmC+DIn3JSpfqY3Nx+X419sz8pEqRq8u3Jsv5XjVumnxPPQTzdzTSS9kCBCAm/u4uCfh2acaY7uku10NLuc2QlXYD/SuSmPezLJqM7ic68gnrK2PZivhHUhZxaxQmS6rHRPsuNPMskvr8aeW7bbr3PozB8VA9yjNdXS+j/KoaxsozHzhMv4yLKyil5BxULil2/C5Cvx2WXup2ecX31p+/3mupKrZJUz5cscJm4i4qv9MZsfiUY7BlHEOSeOqUKTS5hozoBzUM3xoWOL+lVBDfnjr6owXBd8207QT5GV42q7dNF2hWcTdDqeJjm2EBwD8hZ3EoJ2ls4jGHs+cpMLn1/3j/7jqoXHdpqVczpmIZj6NbVc2e9kEv8mwPZYHWNvsgY89ZrkZxTJNmRnW2rs+qkrUAWACWElkWICuj8Lud0GX8GGhXV3dlglzXYClAwNjQoPC9//yLMGK9c4pdJLshNQ1dAyw+wywvxnbK7zC7vjGK/25hzmKoya3pqCPrqjQ7lFZ3AuGUw/7JGO8KNbP4Bn4ldW67bi+emtdGq49RBB5VH2KgolYpriXPH1wjb8pyscbRWczFjf46dW8cCuuONdGpqBKhyj8GFUyxEuvxsWfrReCJr5ymIFf9Ru39th5g1TCyPQpFpmbuUR6pxPf+tcjF3SN+pRFmMzlam33toZ+8U9nrTspWzFq4BCbIVCWJsf1sY/JcQZ1WHSuj33QWc9wWHDT1afd7BBvw8h9odiOHk2fURcPwU6hNacHpydJGefMV5vdTKOLZzEUB7QbJIGZLRiIkQUq5jmO1enV1e2bwi/pyix9eWYbvZeOfDo6MMNSClO6I4dOVrsHBn7OH2zUA/6OFn2oMagb/Y5j0NHuD8WhvjR05NBtdXiFitRxuznUINR3pDsOAi6TIcbObAD6heIplQC1jOM8wzcOpksyZQKjKRhCCe5rSjGKtH7W4M5dvN+5nW7FQbWHXv0eeDXE2ysdEuyQzBiHblH3CvzVY4PB4R90XFD5sR7hmcMj9Ih23R0MmQrVfn1sHjcomLktwo632OmETdF217bKZorxdUgGpVOjsRrJLy+8OSRb4VcTRpJp5Df6UZmX0l/+yJ4JLx5SeO4kppw7G+c35n7kym9uJ6okh/54XV0QbFATzr4A+e3hZnL9gLfaHq7Pr64fzvoEDmLOBPT8dOA1pnxV4gD4XJyXvevvzDFbPcQt57mNB4CJmwlQyzPyS0pIMMDT7T7nyerRte+mqkHhGbxS9rYgueVl08yoYVPGMbGs/Xx741h5qnMup5RHybRYWCCJilPSTmvqFuo3VeP1s+2WXHljsHontvG8tARYJs5niqW40JbXa5tPbfzTxda61L+/o3TQ2ro8mRmoI8ulVBgFicRDb2tFSYCjqhJxbsaKQA6iHuRul2zMsOmLebgavRN1TufuvmUBR8zDlnaTPuzoUHrWvvHRgDx98shh/IIB3JtdlNLnUTpEqledUigb1QTe2WI06V40Vxdr4f3SjB1AlYmeqTLxGqhOafzF3uWN4gUVc8BjC4kJ0bECN11V2y57P96lgS66Jq5r4rsmtutQf3aGD4y4A3L3MrbNhSh5dqSFZ9f9eqyxySnfhVbYTXQk8MREIp9w55BT3iPwhM1mgFfMoaZ1ro5UhYXrv3h71PNd/XxXFrwt9neoNoW7k9Rsgol5ajqlnIcnMzZRRm2jvkayq2sYOmpm6/MifOIQjb/kWaTA4NZCishXRuxz2S+vUpVWBBnnWZFBVJxghvfbMUtfKiekTDJh3jLxFjnhfX2cHGQG1OQK7PM13qyUBscr7Tc6dFQQ3KgINdFoQTO9kObFZBH7sq04G7H0gqcXcDk7Q8U6bbwUiEXVCDMdBRDTeAHRgpnIRr5G0xxnX4/c61exihyIYofsa7z4e1Cue4dqN8CuUFikwbwY6AcLAcscbsDt94x5hjo9akvN3A9uUymp2q2yIh3d772qryU2w1WJjoyMvMeRuT0m3rrYMyu6Ewt0rkqAHVxHPAWv7IcL/kYSaRagiLDvcXjr4SdPswDcLAoZbZHLGIysVXsx+4DT35UkXMrczh6fyFhdEdaMw0Zf0m0hIw4zMxA5BSll9t37yiUOG8ZE1Ovb2TOSAtU5bvwC8dHJKnr9Pkoo48swPgddrV1tbOWere2oGIwhb92O3x926Xaa45XuEd7oeKkjA9y7F/qKMnPDSAO2RtzOW4rkLJLT/0Jsdsa9A7bVK92+hwZsbnXlvBhqe62xRfv8mnCo3vlmKhrn//Kq9cxj1O6G94CDZV9TCr35gi7Sxhtc0Hn83o8dZi7PqUo4+IuoywxGm7HPe/UYVjD/fD1ZwY3KFXSPiSYOW/Bm+YB47z/3jnfDEWwvkK+ub68n132jXrRlUPSC+Zfr86sVxEbugRL9seFQ3n8aT/pAuSGb41CcJZLx9e315YR8soNu736joetZKxyTSMdUiCNfvik52waKRdZjsbvh3cVxCHsFJlevhX4Acwz++CDArnQ7Uaovb9ajxL58vQUL3TLe7D0l8kngIxIvMzL21xUMOOA7GY8z3NcpqD8k61Kf8ZIOmcqk5T56nr003YAgvJlrfbfKe2WI/ay75bT1zfXow/PzrqS6q9uH52d/78A/UYmVI3JtX6rcadzcjKNliVhgdmv9HR62f7+R2A9DEvvh+bn+vuwxiIV8sxlT2kSoHKO0s0bun3WWgXrrqbkD9yIiEt5uDsx96eXiSsp02SgCI3FWrrzubEv32JyiKRSGd7M87MYg7G6OKhLgNMOQcrto7FjZ9agsBucP1m0RD/uJDlXi14U0OlllrWvVivbYCIpj1vYa341fW+Xb+9xWvxxj4KKfYuqhqkUKGnPzNMlyX2Bz1Ipi/HE8dg9UPFDTFxDl6/JUnr4YfxwHXCRxryWwaphhFdedNXSfZh89Fy8tSHou2LcuK5wB9lgwzIG7MTEyY/EOaO8kZq75ytb+UYjhIJfi5csg1DBbmhkgN51PsekpThUQiT136koN37kYjpc1ABXs/qYw3qZxJLuiZdygZD7lZlDIK6/pLoOo7U8xzRYDvpnkLGbQWeIlh7c34pFylpwbo9g07+vptl5Y1d4QDu18Q2gB1UbwmSNA3uLaR+CZ4rp9Vvtt8Qvyf8af7vCoGC9cKgWxcTmYKV4ZTw6Q4p30tuWrkaN7IkLIijg78n+AROER+kRe8T8HZWuh2uO3VHpno+GZna7DZw3BRHoaw7Ow5Z7xcY4p7Mlj/HH8UQqzmMgramCcgTCfx1e9gI4XmB5iHztwmlGvR4kulfViQ6GrkIweUw6YOog+k1n4yz+uZt3445ikoO04jk5Wueg/D3T5/jyqy/fr+LCIv69K5jUCa+51Ofw93KunWabkM0vRmfKn8igzB4sIKd66cHMSlDYknjWoZODkv6lHCXC67C/5qmUSVQGVmQS+b5vGtF6oCq/P4JiyNIWEUQN8uYWLkCbC10bWvdO9+WywCciACTLjbL4wW5AdBdWq+Ixi8Eh5ufnbUR/AQDIs0qCvnZCF/eqw0IrY6hRrvHJeVAvy1R28r4C5bK2Z1gVkvV7AuWe4NEnCYrRBhlhSZxmKX+hBEK2I5/z+JogPZ3vC3GMJTrqEBgLNcFFskf9GdPQD/bXd824yduLv91rM+Next5m1dkOX/mkHX3b9oCW53tTeTw75Zr66Z4eO8m7PinBGm0H1v0mtvHFTGN6dMfkXE27EQA8l7C2s8imHgZAFX3IPVMUzKRecxl8WksPQ76WUu8UlSXGSYjk7Mg3dEyXXajRvgH0nH+z3jwg6rBQWPKGrgAPQ1WcKuls828Lehk7LFOy25NVatkvK+RBP9PirpJDYNb5yjS8DhSuvy3mzYUcaxxZAK8bwAMAQOHG6lliLYSpuYK6C9Pma4Wvo3duyMaVJSlgKAt8y1IRqLWPck/gz+FJ51lX1MRMn2wZ0k6I+ZmJvNf3P/d3rX4MnuRDAx6a/c4fKgwBAjG1+ZG/r4QcsJv+5v9Nn5DvCRIIRQdDk6tNvd3Yf+n3lj5/v3a8ufr73P6l+ej2enF/c3ox/ub6yv/wOg5NF+TG8wuDSrrHPutCb6OON2S2L6+78V/wPX/rKSgM1wktkB0TbVtWukNaeQ6rC+X8DABz/c6Y="
 }
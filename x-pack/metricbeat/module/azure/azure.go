@@ -23,6 +23,8 @@ type Config struct {
 	Resources           []ResourceConfig `config:"resources"`
 	RefreshListInterval time.Duration    `config:"refresh_list_interval"`
 	DefaultResourceType string           `config:"default_resource_type"`
+	// BatchAPI enables the retrieval of the metric values of several resources per request with the metrics batch API
+	BatchAPI bool `config:"batch_api"`
 }
 
 // ResourceConfig contains resource and metric list specific configuration.
@@ -33,6 +35,31 @@ type ResourceConfig struct {
 	Type        string         `config:"resource_type"`
 	Query       string         `config:"resource_query"`
 	ServiceType []string       `config:"service_type"`
+	// Tags and GraphQuery select the resources with Azure Resource Graph queries
+	Tags       map[string]string `config:"resource_tags"`
+	GraphQuery string            `config:"resource_graph_query"`
+}
+
+// Validate checks that the options identifying the resources can be combined.
+func (r *ResourceConfig) Validate() error {
+	if r.GraphQuery != "" && (len(r.Id) > 0 || len(r.Group) > 0 || r.Type != "" || r.Query != "" || len(r.Tags) > 0) {
+		return errors.New("resource_graph_query cannot be combined with other options identifying resources")
+	}
+	if len(r.Tags) > 0 && (len(r.Id) > 0 || r.Query != "") {
+		return errors.New("resource_tags cannot be combined with resource_id or resource_query")
+	}
+	return nil
+}
+
+// usesResourceGraph returns true if the resources are retrieved with Azure Resource Graph queries.
+func (r *ResourceConfig) usesResourceGraph() bool {
+	return r.GraphQuery != "" || len(r.Tags) > 0
+}
+
+func defaultConfig() Config {
+	return Config{
+		BatchAPI: true,
+	}
 }
 
 // MetricConfig contains metric specific configuration.
@@ -60,7 +87,7 @@ func init() {
 // newModule adds validation that hosts is non-empty, a requirement to use the
 // azure module.
 func newModule(base mb.BaseModule) (mb.Module, error) {
-	var config Config
+	config := defaultConfig()
 	if err := base.UnpackConfig(&config); err != nil {
 		return nil, errors.Wrap(err, "error unpack raw module config using UnpackConfig")
 	}
@@ -80,7 +107,7 @@ type MetricSet struct {
 // NewMetricSet will instantiate a new azure metricset
 func NewMetricSet(base mb.BaseMetricSet) (*MetricSet, error) {
 	metricsetName := base.Name()
-	var config Config
+	config := defaultConfig()
 	err := base.Module().UnpackConfig(&config)
 	if err != nil {
 		return nil, errors.Wrap(err, "error unpack raw module config using UnpackConfig")
@@ -96,14 +123,18 @@ func NewMetricSet(base mb.BaseMetricSet) (*MetricSet, error) {
 	default:
 		// validate config resource options entered, no resource queries allowed for the compute_vm and compute_vm_scaleset metricsets
 		for _, resource := range config.Resources {
-			if resource.Query != "" {
+			if resource.Query != "" || resource.GraphQuery != "" {
 				return nil, errors.Errorf("error initializing the monitor client: module azure - %s metricset. No queries allowed, please select one of the allowed options", metricsetName)
 			}
 		}
-		// check for lightweight resources if no groups or ids have been entered, if not a new resource is created to check the entire subscription
+		// check for lightweight resources if no groups, ids or tags have been entered, if not a new resource is created to check the entire subscription
 		var resources []ResourceConfig
 		for _, resource := range config.Resources {
-			if hasConfigOptions(resource.Group) || hasConfigOptions(resource.Id) {
+			if hasConfigOptions(resource.Group) || hasConfigOptions(resource.Id) || len(resource.Tags) > 0 {
+				// resources selected by tags are filtered by the resource type of the lightweight metricset
+				if len(resource.Tags) > 0 && resource.Type == "" {
+					resource.Type = config.DefaultResourceType
+				}
 				resources = append(resources, resource)
 			}
 		}
@@ -140,6 +171,12 @@ func (m *MetricSet) Fetch(report mb.ReporterV2) error {
 		return nil
 	}
 	// retrieve metrics
+	if m.Client.Config.BatchAPI {
+		// the metric values of all the resources are retrieved together, in as few batch requests as possible
+		results := m.Client.GetMetricValues(m.Client.Resources.Metrics, report)
+		return errors.Wrap(EventsMapping(results, m.BaseMetricSet.Name(), report), "error running EventsMapping")
+	}
+
 	groupedMetrics := groupMetricsByResource(m.Client.Resources.Metrics)
 
 	for _, metrics := range groupedMetrics {
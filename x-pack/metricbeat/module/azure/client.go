@@ -57,18 +57,18 @@ func (client *Client) InitResources(fn mapResourceMetrics, report mb.ReporterV2)
 	var metrics []Metric
 	for _, resource := range client.Config.Resources {
 		// retrieve azure resources information
-		resourceList, err := client.AzureMonitorService.GetResourceDefinitions(resource.Id, resource.Group, resource.Type, resource.Query)
+		resourceList, err := client.getResources(resource)
 		if err != nil {
 			err = errors.Wrap(err, "failed to retrieve resources")
 			return err
 		}
-		if len(resourceList.Values()) == 0 {
-			err = errors.Errorf("failed to retrieve resources: No resources returned using the configuration options resource ID %s, resource group %s, resource type %s, resource query %s",
-				resource.Id, resource.Group, resource.Type, resource.Query)
+		if len(resourceList) == 0 {
+			err = errors.Errorf("failed to retrieve resources: No resources returned using the configuration options resource ID %s, resource group %s, resource type %s, resource query %s, resource tags %v, resource graph query %s",
+				resource.Id, resource.Group, resource.Type, resource.Query, resource.Tags, resource.GraphQuery)
 			client.Log.Error(err)
 			continue
 		}
-		resourceMetrics, err := fn(client, resourceList.Values(), resource)
+		resourceMetrics, err := fn(client, resourceList, resource)
 		if err != nil {
 			return err
 		}
@@ -83,47 +83,118 @@ func (client *Client) InitResources(fn mapResourceMetrics, report mb.ReporterV2)
 	return nil
 }
 
+// getResources retrieves the azure resources matching the resource configuration, with Azure Resource Graph queries when tags or graph queries are configured
+func (client *Client) getResources(resource ResourceConfig) ([]resources.GenericResource, error) {
+	if resource.usesResourceGraph() {
+		return client.AzureMonitorService.GetResourceDefinitionsByGraphQuery(graphQuery(resource))
+	}
+	resourceList, err := client.AzureMonitorService.GetResourceDefinitions(resource.Id, resource.Group, resource.Type, resource.Query)
+	if err != nil {
+		return nil, err
+	}
+	return resourceList.Values(), nil
+}
+
 // GetMetricValues returns the specified metric data points for the specified resource ID/namespace.
+// If the batch API is enabled, the metric values of the resources located in the same region are retrieved together.
 func (client *Client) GetMetricValues(metrics []Metric, report mb.ReporterV2) []Metric {
+	if !client.Config.BatchAPI {
+		return client.getMetricValues(metrics, report)
+	}
 	var resultedMetrics []Metric
-	// loop over the set of metrics
+	var singleMetrics []Metric
+	batches := make(map[string][]Metric)
+	var keys []string
 	for _, metric := range metrics {
-		// select period to collect metrics, will double the interval value in order to retrieve any missing values
-		//if timegrain is larger than intervalx2 then interval will be assigned the timegrain value
-		interval := client.Config.Period
-		if t := convertTimegrainToDuration(metric.TimeGrain); t > interval*2 {
-			interval = t
+		// the batch API uses regional endpoints, resources without a region are requested one by one
+		location := strings.ToLower(strings.Replace(metric.Resource.Location, " ", "", -1))
+		if location == "" || location == "global" {
+			singleMetrics = append(singleMetrics, metric)
+			continue
 		}
-		endTime := time.Now().UTC()
-		startTime := endTime.Add(interval * (-2))
-		timespan := fmt.Sprintf("%s/%s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
-
-		// build the 'filter' parameter which will contain any dimensions configured
-		var filter string
-		if len(metric.Dimensions) > 0 {
-			var filterList []string
-			for _, dim := range metric.Dimensions {
-				filterList = append(filterList, dim.Name+" eq '"+dim.Value+"'")
-			}
-			filter = strings.Join(filterList, " AND ")
+		key := strings.Join([]string{location, metric.Namespace, strings.Join(metric.Names, ","), metric.Aggregations, metric.TimeGrain, metricFilter(metric)}, "|")
+		if _, ok := batches[key]; !ok {
+			keys = append(keys, key)
 		}
+		batches[key] = append(batches[key], metric)
+	}
+	for _, key := range keys {
+		resultedMetrics = append(resultedMetrics, client.getMetricValuesBatch(batches[key], report)...)
+	}
+	return append(resultedMetrics, client.getMetricValues(singleMetrics, report)...)
+}
+
+// getMetricValues retrieves the metric values one resource at a time.
+func (client *Client) getMetricValues(metrics []Metric, report mb.ReporterV2) []Metric {
+	var resultedMetrics []Metric
+	// loop over the set of metrics
+	for _, metric := range metrics {
+		interval, startTime, endTime := client.metricTimespan(metric)
+		timespan := fmt.Sprintf("%s/%s", startTime.Format(time.RFC3339), endTime.Format(time.RFC3339))
 		resp, timegrain, err := client.AzureMonitorService.GetMetricValues(metric.Resource.SubId, metric.Namespace, metric.TimeGrain, timespan, metric.Names,
-			metric.Aggregations, filter)
+			metric.Aggregations, metricFilter(metric))
 		if err != nil {
 			err = errors.Wrapf(err, "error while listing metric values by resource ID %s and namespace  %s", metric.Resource.SubId, metric.Namespace)
 			client.Log.Error(err)
 			report.Error(err)
 		} else {
-			for i, currentMetric := range client.Resources.Metrics {
-				if matchMetrics(currentMetric, metric) {
-					current := mapMetricValues(resp, currentMetric.Values, endTime.Truncate(time.Minute).Add(interval*(-1)), endTime.Truncate(time.Minute))
-					client.Resources.Metrics[i].Values = current
-					if client.Resources.Metrics[i].TimeGrain == "" {
-						client.Resources.Metrics[i].TimeGrain = timegrain
-					}
-					resultedMetrics = append(resultedMetrics, client.Resources.Metrics[i])
-				}
+			resultedMetrics = append(resultedMetrics, client.updateMetricValues(metric, resp, timegrain, interval, endTime)...)
+		}
+	}
+	return resultedMetrics
+}
+
+// getMetricValuesBatch retrieves the metric values of metrics sharing the same region, namespace, names, aggregations, timegrain and filter.
+func (client *Client) getMetricValuesBatch(metrics []Metric, report mb.ReporterV2) []Metric {
+	var resultedMetrics []Metric
+	metric := metrics[0]
+	interval, startTime, endTime := client.metricTimespan(metric)
+	var resourceIds []string
+	for _, met := range metrics {
+		resourceIds = append(resourceIds, met.Resource.SubId)
+	}
+	resp, timegrain, err := client.AzureMonitorService.GetMetricValuesBatch(resourceIds, metric.Resource.Location, metric.Namespace, metric.TimeGrain, startTime, endTime,
+		metric.Names, metric.Aggregations, metricFilter(metric))
+	if err != nil {
+		err = errors.Wrapf(err, "error while listing metric values by batch for %d resources in region %s and namespace %s", len(resourceIds), metric.Resource.Location, metric.Namespace)
+		client.Log.Error(err)
+		report.Error(err)
+		return nil
+	}
+	// resource IDs are case insensitive and could be returned with a different case
+	values := make(map[string][]insights.Metric, len(resp))
+	for id, value := range resp {
+		values[strings.ToLower(id)] = value
+	}
+	for _, met := range metrics {
+		resultedMetrics = append(resultedMetrics, client.updateMetricValues(met, values[strings.ToLower(met.Resource.SubId)], timegrain, interval, endTime)...)
+	}
+	return resultedMetrics
+}
+
+// metricTimespan returns the period to collect metrics, will double the interval value in order to retrieve any missing values.
+// If timegrain is larger than intervalx2 then interval will be assigned the timegrain value.
+func (client *Client) metricTimespan(metric Metric) (interval time.Duration, startTime time.Time, endTime time.Time) {
+	interval = client.Config.Period
+	if t := convertTimegrainToDuration(metric.TimeGrain); t > interval*2 {
+		interval = t
+	}
+	endTime = time.Now().UTC()
+	startTime = endTime.Add(interval * (-2))
+	return interval, startTime, endTime
+}
+
+// updateMetricValues maps the metric values returned to the matching client metrics.
+func (client *Client) updateMetricValues(metric Metric, resp []insights.Metric, timegrain string, interval time.Duration, endTime time.Time) []Metric {
+	var resultedMetrics []Metric
+	for i, currentMetric := range client.Resources.Metrics {
+		if matchMetrics(currentMetric, metric) {
+			current := mapMetricValues(resp, currentMetric.Values, endTime.Truncate(time.Minute).Add(interval*(-1)), endTime.Truncate(time.Minute))
+			client.Resources.Metrics[i].Values = current
+			if client.Resources.Metrics[i].TimeGrain == "" {
+				client.Resources.Metrics[i].TimeGrain = timegrain
 			}
+			resultedMetrics = append(resultedMetrics, client.Resources.Metrics[i])
 		}
 	}
 	return resultedMetrics
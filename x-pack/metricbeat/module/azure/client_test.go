@@ -7,9 +7,11 @@ package azure
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -60,6 +62,34 @@ func TestInitResources(t *testing.T) {
 		assert.Equal(t, len(client.Resources.Metrics), 0)
 		m.AssertExpectations(t)
 	})
+	t.Run("retrieve resources with resource graph queries when tags are configured", func(t *testing.T) {
+		client := NewMockClient()
+		client.Config = Config{
+			Resources: []ResourceConfig{
+				{
+					Type: "Microsoft.Compute/virtualMachines",
+					Tags: map[string]string{"env": "production"},
+				},
+			},
+		}
+		id, name, location, resourceType := "/subscriptions/sub/resourceGroups/group/providers/Microsoft.Compute/virtualMachines/vm", "vm", "westeurope", "Microsoft.Compute/virtualMachines"
+		m := &MockService{}
+		m.On("GetResourceDefinitionsByGraphQuery", "Resources | where type =~ 'Microsoft.Compute/virtualMachines' | where tags['env'] =~ 'production'").
+			Return([]resources.GenericResource{{ID: &id, Name: &name, Location: &location, Type: &resourceType}}, nil)
+		client.AzureMonitorService = m
+		mr := MockReporterV2{}
+		err := client.InitResources(func(client *Client, resources []resources.GenericResource, resourceConfig ResourceConfig) ([]Metric, error) {
+			var metrics []Metric
+			for _, resource := range resources {
+				metrics = append(metrics, client.CreateMetric(*resource.ID, resource, "", "namespace", []string{"Percentage CPU"}, "Average", nil, ""))
+			}
+			return metrics, nil
+		}, &mr)
+		assert.NoError(t, err)
+		assert.Len(t, client.Resources.Metrics, 1)
+		assert.Equal(t, id, client.Resources.Metrics[0].Resource.Id)
+		m.AssertExpectations(t)
+	})
 }
 
 func TestGetMetricValues(t *testing.T) {
@@ -111,3 +141,57 @@ func TestGetMetricValues(t *testing.T) {
 		m.AssertExpectations(t)
 	})
 }
+
+func TestGetMetricValuesBatch(t *testing.T) {
+	newMetric := func(id string, location string) Metric {
+		return Metric{
+			Resource:     Resource{Id: id, SubId: id, Location: location},
+			Namespace:    "namespace",
+			Names:        []string{"TotalRequests", "Capacity"},
+			Aggregations: "Average",
+		}
+	}
+	value := 1.0
+	timestamp := date.Time{Time: time.Now().UTC().Add(-5 * time.Minute)}
+	name := "TotalRequests"
+	metricValues := []insights.Metric{
+		{
+			Name: &insights.LocalizableString{Value: &name},
+			Timeseries: &[]insights.TimeSeriesElement{
+				{Data: &[]insights.MetricValue{{TimeStamp: &timestamp, Average: &value}}},
+			},
+		},
+	}
+
+	t.Run("group the resources by region and fall back to single requests for global resources", func(t *testing.T) {
+		client := NewMockClient()
+		client.Config = Config{Period: time.Minute, BatchAPI: true}
+		client.Resources = ResourceConfiguration{
+			Metrics: []Metric{
+				newMetric("vm1", "westeurope"),
+				newMetric("vm2", "West Europe"),
+				newMetric("vm3", "eastus"),
+				newMetric("frontdoor", "global"),
+			},
+		}
+		m := &MockService{}
+		m.On("GetMetricValuesBatch", []string{"vm1", "vm2"}, "westeurope", "namespace").Once().
+			Return(map[string][]insights.Metric{"VM1": metricValues, "vm2": metricValues}, "PT1M", nil)
+		m.On("GetMetricValuesBatch", []string{"vm3"}, "eastus", "namespace").Once().
+			Return(map[string][]insights.Metric{}, "", errors.New("invalid parameters"))
+		m.On("GetMetricValues", "frontdoor", "namespace").Once().
+			Return(metricValues, "PT1M", nil)
+		client.AzureMonitorService = m
+		mr := MockReporterV2{}
+		mr.On("Error", mock.Anything).Once().Return(true)
+		metrics := client.GetMetricValues(client.Resources.Metrics, &mr)
+		assert.Len(t, metrics, 3)
+		for _, metric := range metrics {
+			assert.Len(t, metric.Values, 1)
+			assert.Equal(t, "PT1M", metric.TimeGrain)
+		}
+		assert.Len(t, client.Resources.Metrics[2].Values, 0)
+		m.AssertExpectations(t)
+		mr.AssertExpectations(t)
+	})
+}
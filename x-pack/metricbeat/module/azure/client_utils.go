@@ -7,6 +7,7 @@ package azure
 import (
 	"fmt"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
@@ -198,3 +199,53 @@ func ContainsDimension(dimension string, dimensions []insights.LocalizableString
 	}
 	return false
 }
+
+// metricFilter builds the 'filter' parameter which will contain any dimensions configured
+func metricFilter(metric Metric) string {
+	if len(metric.Dimensions) == 0 {
+		return ""
+	}
+	var filterList []string
+	for _, dim := range metric.Dimensions {
+		filterList = append(filterList, dim.Name+" eq '"+dim.Value+"'")
+	}
+	return strings.Join(filterList, " AND ")
+}
+
+// graphQuery builds the Azure Resource Graph query retrieving the resources configured
+func graphQuery(resource ResourceConfig) string {
+	if resource.GraphQuery != "" {
+		return resource.GraphQuery
+	}
+	query := []string{"Resources"}
+	if resource.Type != "" {
+		query = append(query, fmt.Sprintf("where type =~ %s", kqlString(resource.Type)))
+	}
+	if len(resource.Group) > 0 {
+		var groups []string
+		for _, group := range resource.Group {
+			groups = append(groups, kqlString(group))
+		}
+		query = append(query, fmt.Sprintf("where resourceGroup in~ (%s)", strings.Join(groups, ", ")))
+	}
+	// sort the tags so the query is the same for each refresh
+	var tags []string
+	for tag := range resource.Tags {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		if value := resource.Tags[tag]; value == "*" {
+			query = append(query, fmt.Sprintf("where isnotempty(tags[%s])", kqlString(tag)))
+		} else {
+			query = append(query, fmt.Sprintf("where tags[%s] =~ %s", kqlString(tag), kqlString(value)))
+		}
+	}
+	return strings.Join(query, " | ")
+}
+
+// kqlString quotes a string literal of a Kusto query
+func kqlString(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	return "'" + strings.Replace(value, "'", `\'`, -1) + "'"
+}
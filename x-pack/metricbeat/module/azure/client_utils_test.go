@@ -168,3 +168,22 @@ func TestContainsDimension(t *testing.T) {
 	result = ContainsDimension(dimension, dimensionList)
 	assert.False(t, result)
 }
+
+func TestMetricFilter(t *testing.T) {
+	assert.Equal(t, "", metricFilter(Metric{}))
+	metric := Metric{Dimensions: []Dimension{{Name: "VMName", Value: "*"}, {Name: "SlotID", Value: "1"}}}
+	assert.Equal(t, "VMName eq '*' AND SlotID eq '1'", metricFilter(metric))
+}
+
+func TestGraphQuery(t *testing.T) {
+	resource := ResourceConfig{GraphQuery: "Resources | where type =~ 'microsoft.compute/virtualmachines'"}
+	assert.Equal(t, resource.GraphQuery, graphQuery(resource))
+
+	resource = ResourceConfig{
+		Type:  "Microsoft.Compute/virtualMachines",
+		Group: []string{"group1", "group2"},
+		Tags:  map[string]string{"env": "production", "team": "*", "owner": "o'brien"},
+	}
+	assert.Equal(t, "Resources | where type =~ 'Microsoft.Compute/virtualMachines' | where resourceGroup in~ ('group1', 'group2') | "+
+		`where tags['env'] =~ 'production' | where tags['owner'] =~ 'o\'brien' | where isnotempty(tags['team'])`, graphQuery(resource))
+}
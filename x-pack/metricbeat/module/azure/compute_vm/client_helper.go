@@ -51,19 +51,27 @@ func mapResourceSize(resource resources.GenericResource, client *azure.Client) s
 	if resource.Sku != nil && resource.Sku.Name != nil {
 		return *resource.Sku.Name
 	}
-	if resource.Sku == nil && resource.Properties == nil {
+	// resources retrieved with resource graph queries contain the resource properties
+	if resource.Properties != nil {
+		return vmSize(resource.Properties)
+	}
+	if resource.Sku == nil {
 		expandedResource, err := client.AzureMonitorService.GetResourceDefinitionById(*resource.ID)
 		if err != nil {
 			client.Log.Error(err, "could not retrieve the resource details by resource ID %s", *resource.ID)
 			return ""
 		}
-		if expandedResource.Properties != nil {
-			if properties, ok := expandedResource.Properties.(map[string]interface{}); ok {
-				if hardware, ok := properties["hardwareProfile"]; ok {
-					if vmSize, ok := hardware.(map[string]interface{})["vmSize"]; ok {
-						return vmSize.(string)
-					}
-				}
+		return vmSize(expandedResource.Properties)
+	}
+	return ""
+}
+
+// vmSize returns the size in the hardware profile of the vm properties
+func vmSize(resourceProperties interface{}) string {
+	if properties, ok := resourceProperties.(map[string]interface{}); ok {
+		if hardware, ok := properties["hardwareProfile"].(map[string]interface{}); ok {
+			if vmSize, ok := hardware["vmSize"].(string); ok {
+				return vmSize
 			}
 		}
 	}
@@ -50,8 +50,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		}
 	}
 	for index := range ms.Client.Config.Resources {
-		// add the default vm scaleset type if groups are defined
-		if len(ms.Client.Config.Resources[index].Group) > 0 {
+		// add the default vm scaleset type if groups or tags are defined
+		if len(ms.Client.Config.Resources[index].Group) > 0 || len(ms.Client.Config.Resources[index].Tags) > 0 {
 			ms.Client.Config.Resources[index].Type = defaultVMScalesetNamespace
 		}
 		// add the default metrics for each resource option
@@ -5,6 +5,8 @@
 package azure
 
 import (
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
 	"github.com/stretchr/testify/mock"
@@ -30,6 +32,12 @@ func (client *MockService) GetResourceDefinitions(id []string, group []string, r
 	return args.Get(0).(resources.ListResultPage), args.Error(1)
 }
 
+// GetResourceDefinitionsByGraphQuery is a mock function for the azure service
+func (client *MockService) GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResource, error) {
+	args := client.Called(query)
+	return args.Get(0).([]resources.GenericResource), args.Error(1)
+}
+
 // GetMetricDefinitions is a mock function for the azure service
 func (client *MockService) GetMetricDefinitions(resourceId string, namespace string) (insights.MetricDefinitionCollection, error) {
 	args := client.Called(resourceId, namespace)
@@ -48,6 +56,12 @@ func (client *MockService) GetMetricValues(resourceId string, namespace string,
 	return args.Get(0).([]insights.Metric), args.String(1), args.Error(2)
 }
 
+// GetMetricValuesBatch is a mock function for the azure service
+func (client *MockService) GetMetricValuesBatch(resourceIds []string, region string, namespace string, timegrain string, startTime time.Time, endTime time.Time, metricNames []string, aggregations string, filter string) (map[string][]insights.Metric, string, error) {
+	args := client.Called(resourceIds, region, namespace)
+	return args.Get(0).(map[string][]insights.Metric), args.String(1), args.Error(2)
+}
+
 // MockReporterV2 mock implementation for testing purposes
 type MockReporterV2 struct {
 	mock.Mock
@@ -6,13 +6,21 @@ package azure
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strings"
+	"time"
+
+	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/logp"
 
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
+	"github.com/Azure/azure-sdk-for-go/services/resourcegraph/mgmt/2019-04-01/resourcegraph"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 )
 
@@ -22,11 +30,23 @@ type MonitorService struct {
 	metricDefinitionClient *insights.MetricDefinitionsClient
 	metricNamespaceClient  *insights.MetricNamespacesClient
 	resourceClient         *resources.Client
-	context                context.Context
-	log                    *logp.Logger
+	resourceGraphClient    *resourcegraph.BaseClient
+	batchClient            *autorest.Client
+	// batchBaseURI is the format of the regional endpoints of the metrics batch API
+	batchBaseURI   string
+	subscriptionId string
+	context        context.Context
+	log            *logp.Logger
 }
 
-const metricNameLimit = 20
+const (
+	metricNameLimit = 20
+	// batchResourceLimit is the maximum number of resources of a metrics batch API request
+	batchResourceLimit = 50
+	batchAPIVersion    = "2023-10-01"
+	batchResource      = "https://metrics.monitor.azure.com"
+	batchBaseURI       = "https://%s.metrics.monitor.azure.com"
+)
 
 // NewService instantiates the Azure monitoring service
 func NewService(clientId string, clientSecret string, tenantId string, subscriptionId string) (*MonitorService, error) {
@@ -39,15 +59,32 @@ func NewService(clientId string, clientSecret string, tenantId string, subscript
 	metricsDefinitionClient := insights.NewMetricDefinitionsClient(subscriptionId)
 	resourceClient := resources.NewClient(subscriptionId)
 	metricNamespaceClient := insights.NewMetricNamespacesClient(subscriptionId)
+	resourceGraphClient := resourcegraph.New()
 	metricsClient.Authorizer = authorizer
 	metricsDefinitionClient.Authorizer = authorizer
 	resourceClient.Authorizer = authorizer
 	metricNamespaceClient.Authorizer = authorizer
+	resourceGraphClient.Authorizer = authorizer
+
+	// the metrics batch API requires tokens for its own resource
+	batchConfig := auth.NewClientCredentialsConfig(clientId, clientSecret, tenantId)
+	batchConfig.Resource = batchResource
+	batchAuthorizer, err := batchConfig.Authorizer()
+	if err != nil {
+		return nil, err
+	}
+	batchClient := autorest.NewClientWithUserAgent(resourceGraphClient.UserAgent)
+	batchClient.Authorizer = batchAuthorizer
+
 	service := &MonitorService{
 		metricDefinitionClient: &metricsDefinitionClient,
 		metricsClient:          &metricsClient,
 		metricNamespaceClient:  &metricNamespaceClient,
 		resourceClient:         &resourceClient,
+		resourceGraphClient:    &resourceGraphClient,
+		batchClient:            &batchClient,
+		batchBaseURI:           batchBaseURI,
+		subscriptionId:         subscriptionId,
 		context:                context.Background(),
 		log:                    logp.NewLogger("azure monitor service"),
 	}
@@ -79,6 +116,43 @@ func (service MonitorService) GetResourceDefinitions(id []string, group []string
 	return service.resourceClient.List(service.context, resourceQuery, "", nil)
 }
 
+// GetResourceDefinitionsByGraphQuery will retrieve the azure resources returned by an Azure Resource Graph query
+func (service MonitorService) GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResource, error) {
+	request := resourcegraph.QueryRequest{
+		Subscriptions: &[]string{service.subscriptionId},
+		Query:         &query,
+		Options: &resourcegraph.QueryRequestOptions{
+			ResultFormat: resourcegraph.ResultFormatObjectArray,
+		},
+	}
+	var resourceList []resources.GenericResource
+	for {
+		resp, err := service.resourceGraphClient.Resources(service.context, request)
+		if err != nil {
+			return nil, err
+		}
+		// the rows are decoded again to the resource model of the resource manager
+		data, err := json.Marshal(resp.Data)
+		if err != nil {
+			return nil, err
+		}
+		var rows []graphResource
+		if err := json.Unmarshal(data, &rows); err != nil {
+			return nil, errors.Wrap(err, "unexpected format of the resource graph query results")
+		}
+		for _, row := range rows {
+			if row.ID == nil {
+				return nil, errors.New("the resource graph query results must contain the id of the resources")
+			}
+			resourceList = append(resourceList, row.genericResource())
+		}
+		if resp.SkipToken == nil || *resp.SkipToken == "" {
+			return resourceList, nil
+		}
+		request.Options.SkipToken = resp.SkipToken
+	}
+}
+
 // GetResourceDefinitionById will retrieve the azure resource based on the resource Id
 func (service MonitorService) GetResourceDefinitionById(id string) (resources.GenericResource, error) {
 	return service.resourceClient.GetByID(service.context, id)
@@ -124,3 +198,130 @@ func (service *MonitorService) GetMetricValues(resourceId string, namespace stri
 	}
 	return metrics, interval, nil
 }
+
+// GetMetricValuesBatch will return the metric values of several resources of the same region with the metrics batch API,
+// the values are returned by resource ID
+func (service *MonitorService) GetMetricValuesBatch(resourceIds []string, region string, namespace string, timegrain string, startTime time.Time, endTime time.Time, metricNames []string, aggregations string, filter string) (map[string][]insights.Metric, string, error) {
+	metrics := make(map[string][]insights.Metric)
+	var interval string
+	// check for the limits of requested resources (50) and metrics (20)
+	for i := 0; i < len(resourceIds); i += batchResourceLimit {
+		end := i + batchResourceLimit
+		if end > len(resourceIds) {
+			end = len(resourceIds)
+		}
+		for j := 0; j < len(metricNames); j += metricNameLimit {
+			last := j + metricNameLimit
+			if last > len(metricNames) {
+				last = len(metricNames)
+			}
+			resp, err := service.getMetricValuesBatch(resourceIds[i:end], region, namespace, timegrain, startTime, endTime, metricNames[j:last], aggregations, filter)
+			if err != nil {
+				return metrics, "", err
+			}
+			for _, value := range resp.Values {
+				if value.Interval != nil {
+					interval = *value.Interval
+				}
+				metrics[value.ResourceID] = append(metrics[value.ResourceID], value.Value...)
+			}
+		}
+	}
+	return metrics, interval, nil
+}
+
+// batchResponse is the response of the metrics batch API
+type batchResponse struct {
+	Values []struct {
+		ResourceID string            `json:"resourceid"`
+		Interval   *string           `json:"interval"`
+		Value      []insights.Metric `json:"value"`
+	} `json:"values"`
+}
+
+func (service *MonitorService) getMetricValuesBatch(resourceIds []string, region string, namespace string, timegrain string, startTime time.Time, endTime time.Time, metricNames []string, aggregations string, filter string) (batchResponse, error) {
+	pathParameters := map[string]interface{}{
+		"subscriptionId": autorest.Encode("path", service.subscriptionId),
+	}
+	queryParameters := map[string]interface{}{
+		"api-version":     batchAPIVersion,
+		"metricnamespace": autorest.Encode("query", namespace),
+		"metricnames":     autorest.Encode("query", strings.Join(metricNames, ",")),
+		"starttime":       autorest.Encode("query", startTime.Format(time.RFC3339)),
+		"endtime":         autorest.Encode("query", endTime.Format(time.RFC3339)),
+	}
+	if timegrain != "" {
+		queryParameters["interval"] = autorest.Encode("query", timegrain)
+	}
+	if aggregations != "" {
+		queryParameters["aggregation"] = autorest.Encode("query", aggregations)
+	}
+	if filter != "" {
+		queryParameters["filter"] = autorest.Encode("query", filter)
+	}
+	body := map[string]interface{}{
+		"resourceids": resourceIds,
+	}
+
+	var result batchResponse
+	req, err := autorest.Prepare((&http.Request{}).WithContext(service.context),
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPost(),
+		autorest.WithBaseURL(fmt.Sprintf(service.batchBaseURI, strings.ToLower(strings.Replace(region, " ", "", -1)))),
+		autorest.WithPathParameters("/subscriptions/{subscriptionId}/metrics:getBatch", pathParameters),
+		autorest.WithQueryParameters(queryParameters),
+		autorest.WithJSON(body),
+		service.batchClient.WithAuthorization())
+	if err != nil {
+		return result, errors.Wrap(err, "error preparing the metrics batch request")
+	}
+	resp, err := autorest.SendWithSender(service.batchClient, req,
+		autorest.DoRetryForStatusCodes(service.batchClient.RetryAttempts, service.batchClient.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return result, errors.Wrap(err, "error sending the metrics batch request")
+	}
+	err = autorest.Respond(resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result),
+		autorest.ByClosing())
+	return result, err
+}
+
+// graphResource contains the columns of the resource graph query results used by the metricsets
+type graphResource struct {
+	ID       *string            `json:"id"`
+	Name     *string            `json:"name"`
+	Type     *string            `json:"type"`
+	Location *string            `json:"location"`
+	Tags     map[string]*string `json:"tags"`
+	Sku      *struct {
+		Name *string `json:"name"`
+	} `json:"sku"`
+	Properties interface{} `json:"properties"`
+}
+
+func (r graphResource) genericResource() resources.GenericResource {
+	resource := resources.GenericResource{
+		ID:         r.ID,
+		Name:       r.Name,
+		Type:       r.Type,
+		Location:   r.Location,
+		Tags:       r.Tags,
+		Properties: r.Properties,
+	}
+	if r.Name == nil {
+		name := getResourceNameFromId(*r.ID)
+		resource.Name = &name
+	}
+	if r.Type == nil {
+		resourceType := getResourceTypeFromId(*r.ID)
+		resource.Type = &resourceType
+	}
+	if r.Location == nil {
+		resource.Location = new(string)
+	}
+	if r.Sku != nil && r.Sku.Name != nil {
+		resource.Sku = &resources.Sku{Name: r.Sku.Name}
+	}
+	return resource
+}
@@ -5,6 +5,8 @@
 package azure
 
 import (
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/monitor/mgmt/2019-06-01/insights"
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2019-03-01/resources"
 )
@@ -13,7 +15,9 @@ import (
 type Service interface {
 	GetResourceDefinitionById(id string) (resources.GenericResource, error)
 	GetResourceDefinitions(id []string, group []string, rType string, query string) (resources.ListResultPage, error)
+	GetResourceDefinitionsByGraphQuery(query string) ([]resources.GenericResource, error)
 	GetMetricDefinitions(resourceId string, namespace string) (insights.MetricDefinitionCollection, error)
 	GetMetricNamespaces(resourceId string) (insights.MetricNamespaceCollection, error)
 	GetMetricValues(resourceId string, namespace string, timegrain string, timespan string, metricNames []string, aggregations string, filter string) ([]insights.Metric, string, error)
+	GetMetricValuesBatch(resourceIds []string, region string, namespace string, timegrain string, startTime time.Time, endTime time.Time, metricNames []string, aggregations string, filter string) (map[string][]insights.Metric, string, error)
 }
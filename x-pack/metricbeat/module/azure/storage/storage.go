@@ -50,8 +50,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		}
 	}
 	for index := range ms.Client.Config.Resources {
-		// if any resource groups were configured the resource type should be added
-		if len(ms.Client.Config.Resources[index].Group) > 0 {
+		// if any resource groups or tags were configured the resource type should be added
+		if len(ms.Client.Config.Resources[index].Group) > 0 || len(ms.Client.Config.Resources[index].Tags) > 0 {
 			ms.Client.Config.Resources[index].Type = defaultStorageAccountNamespace
 		}
 		// one metric configuration will be added containing all metrics names
@@ -0,0 +1,224 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package query
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	mssql "github.com/denisenkom/go-mssqldb"
+	"github.com/go-sql-driver/mysql"
+	"github.com/godror/godror"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
+)
+
+// sqlDriver describes how the metricset uses a database driver.
+type sqlDriver struct {
+	// name of the driver registered in database/sql
+	name string
+
+	// validate checks the DSN with the parser of the driver
+	validate func(dsn string) error
+
+	// address extracts the address of the server from the DSN, to report
+	// it without credentials
+	address func(dsn string) string
+}
+
+// drivers is the matrix of drivers supported by the metricset, by the name
+// used in the configuration.
+var drivers = map[string]sqlDriver{
+	"mysql": {
+		name: "mysql",
+		validate: func(dsn string) error {
+			_, err := mysql.ParseDSN(dsn)
+			return err
+		},
+		address: func(dsn string) string {
+			if config, err := mysql.ParseDSN(dsn); err == nil {
+				return config.Addr
+			}
+			return ""
+		},
+	},
+	"postgres": {
+		name: "postgres",
+		validate: func(dsn string) error {
+			_, err := pq.NewConnector(dsn)
+			return err
+		},
+		address: postgresAddress,
+	},
+	"oracle": {
+		name: "godror",
+		validate: func(dsn string) error {
+			_, err := godror.ParseConnString(dsn)
+			return err
+		},
+		address: func(dsn string) string {
+			if params, err := godror.ParseConnString(dsn); err == nil {
+				return strings.SplitN(params.SID, "/", 2)[0]
+			}
+			return ""
+		},
+	},
+	"mssql": {
+		name:     "mssql",
+		validate: validateMSSQL,
+	},
+	"sqlserver": {
+		name:     "sqlserver",
+		validate: validateMSSQL,
+	},
+}
+
+func validateMSSQL(dsn string) error {
+	_, err := mssql.NewConnector(dsn)
+	return err
+}
+
+// getDriver returns the driver with the name used in the configuration.
+func getDriver(name string) (sqlDriver, error) {
+	driver, found := drivers[name]
+	if !found {
+		var names []string
+		for name := range drivers {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return sqlDriver{}, fmt.Errorf("unsupported driver %q, supported drivers are %s", name, strings.Join(names, ", "))
+	}
+	return driver, nil
+}
+
+// postgresAddress returns the host and port of a PostgreSQL DSN in the
+// key=value format. URLs are handled as any other URL.
+func postgresAddress(dsn string) string {
+	host, port := "", ""
+	for _, field := range strings.Fields(dsn) {
+		kv := strings.SplitN(field, "=", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		switch kv[0] {
+		case "host":
+			host = strings.Trim(kv[1], "'")
+		case "port":
+			port = strings.Trim(kv[1], "'")
+		}
+	}
+	if host == "" {
+		return ""
+	}
+	if port == "" {
+		return host
+	}
+	return host + ":" + port
+}
+
+// credentialsConfig contains the driver specific settings to authenticate
+// with certificates or wallets instead of passwords in the DSN.
+type credentialsConfig struct {
+	Oracle struct {
+		Wallet string `config:"wallet"`
+	} `config:"oracle"`
+	TLS *tlscommon.Config `config:"ssl"`
+}
+
+// connectionString returns the DSN to open the connection with, once the
+// credentials settings of the driver are applied to it.
+func (c *credentialsConfig) connectionString(driver, dsn string) (string, error) {
+	if c.Oracle.Wallet != "" {
+		if driver != "oracle" {
+			return "", errors.New("oracle.wallet can only be used with the oracle driver")
+		}
+		if err := setOracleWallet(c.Oracle.Wallet); err != nil {
+			return "", err
+		}
+	}
+
+	if c.TLS.IsEnabled() {
+		if driver != "postgres" {
+			return "", errors.New("ssl settings can only be used with the postgres driver")
+		}
+		return postgresSSLConnectionString(dsn, c.TLS)
+	}
+	return dsn, nil
+}
+
+// setOracleWallet points the Oracle client to the directory of the wallet,
+// that contains the sqlnet.ora and tnsnames.ora files. The client reads it
+// from the TNS_ADMIN environment variable, so all the connections of the
+// process share the same wallet.
+func setOracleWallet(wallet string) error {
+	if info, err := os.Stat(wallet); err != nil || !info.IsDir() {
+		return fmt.Errorf("oracle.wallet %s is not a directory", wallet)
+	}
+	if current := os.Getenv("TNS_ADMIN"); current != "" && current != wallet {
+		return fmt.Errorf("oracle.wallet %s conflicts with the wallet in use %s, only one wallet can be used", wallet, current)
+	}
+	return os.Setenv("TNS_ADMIN", wallet)
+}
+
+// postgresSSLConnectionString adds the client certificate, key and certificate
+// authority of the ssl settings to a PostgreSQL DSN.
+func postgresSSLConnectionString(dsn string, config *tlscommon.Config) (string, error) {
+	if config.Certificate.Passphrase != "" {
+		return "", errors.New("ssl.key_passphrase is not supported by the postgres driver")
+	}
+	if len(config.CAs) > 1 {
+		return "", errors.New("ssl.certificate_authorities supports only one certificate authority with the postgres driver")
+	}
+
+	if strings.HasPrefix(dsn, "postgres://") || strings.HasPrefix(dsn, "postgresql://") {
+		var err error
+		if dsn, err = pq.ParseURL(dsn); err != nil {
+			return "", err
+		}
+	}
+
+	sslmode := "verify-full"
+	if config.VerificationMode == tlscommon.VerifyNone {
+		sslmode = "require"
+	}
+	params := []string{dsn, postgresParam("sslmode", sslmode)}
+	if config.Certificate.Certificate != "" {
+		params = append(params, postgresParam("sslcert", config.Certificate.Certificate))
+	}
+	if config.Certificate.Key != "" {
+		params = append(params, postgresParam("sslkey", config.Certificate.Key))
+	}
+	if len(config.CAs) == 1 {
+		params = append(params, postgresParam("sslrootcert", config.CAs[0]))
+	}
+	return strings.Join(params, " "), nil
+}
+
+func postgresParam(key, value string) string {
+	value = strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(value)
+	return key + "='" + value + "'"
+}
+
+// sanitize returns the address of the server of the DSN without credentials.
+func sanitize(driver sqlDriver, host string) string {
+	// Host is a standard URL
+	if url, err := url.Parse(host); err == nil && len(url.Host) > 0 {
+		return url.Host
+	}
+
+	if driver.address != nil {
+		if address := driver.address(host); address != "" {
+			return address
+		}
+	}
+
+	return "(redacted)"
+}
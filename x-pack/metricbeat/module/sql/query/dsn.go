@@ -5,18 +5,31 @@
 package query
 
 import (
-	"net/url"
-
-	"github.com/go-sql-driver/mysql"
+	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/metricbeat/mb"
 )
 
-// ParseDSN tries to parse the host
+// ParseDSN validates the host with the DSN parser of the driver
 func ParseDSN(mod mb.Module, host string) (mb.HostData, error) {
 	// TODO: Add support for `username` and `password` as module options
 
-	sanitized := sanitize(host)
+	config := struct {
+		Driver string `config:"driver"`
+	}{}
+	if err := mod.UnpackConfig(&config); err != nil {
+		return mb.HostData{}, err
+	}
+
+	driver, err := getDriver(config.Driver)
+	if err != nil {
+		return mb.HostData{}, err
+	}
+	if err := driver.validate(host); err != nil {
+		return mb.HostData{}, errors.Wrapf(err, "invalid DSN for the %s driver", config.Driver)
+	}
+
+	sanitized := sanitize(driver, host)
 
 	return mb.HostData{
 		URI:          host,
@@ -24,19 +37,3 @@ func ParseDSN(mod mb.Module, host string) (mb.HostData, error) {
 		Host:         sanitized,
 	}, nil
 }
-
-func sanitize(host string) string {
-	// Host is a standard URL
-	if url, err := url.Parse(host); err == nil && len(url.Host) > 0 {
-		return url.Host
-	}
-
-	// Host is a MySQL DSN
-	if config, err := mysql.ParseDSN(host); err == nil {
-		return config.Addr
-	}
-
-	// TODO: Add support for PostgreSQL connection strings and other formats
-
-	return "(redacted)"
-}
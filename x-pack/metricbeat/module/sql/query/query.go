@@ -45,6 +45,9 @@ type MetricSet struct {
 	Query          string
 	ResponseFormat string
 
+	driverName string
+	dsn        string
+
 	db *sqlx.DB
 }
 
@@ -57,6 +60,8 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		Driver         string `config:"driver" validate:"nonzero,required"`
 		Query          string `config:"sql_query" validate:"nonzero,required"`
 		ResponseFormat string `config:"sql_response_format"`
+
+		credentialsConfig `config:",inline"`
 	}{ResponseFormat: tableResponseFormat}
 
 	if err := base.Module().UnpackConfig(&config); err != nil {
@@ -67,11 +72,23 @@ func New(base mb.BaseMetricSet) (mb.MetricSet, error) {
 		return nil, fmt.Errorf("invalid sql_response_format value: %s", config.ResponseFormat)
 	}
 
+	driver, err := getDriver(config.Driver)
+	if err != nil {
+		return nil, err
+	}
+
+	dsn, err := config.connectionString(config.Driver, base.HostData().URI)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MetricSet{
 		BaseMetricSet:  base,
 		Driver:         config.Driver,
 		Query:          config.Query,
 		ResponseFormat: config.ResponseFormat,
+		driverName:     driver.name,
+		dsn:            dsn,
 	}, nil
 }
 
@@ -102,7 +119,7 @@ func (m *MetricSet) Fetch(ctx context.Context, report mb.ReporterV2) error {
 // DB gets a client ready to query the database
 func (m *MetricSet) DB() (*sqlx.DB, error) {
 	if m.db == nil {
-		db, err := sqlx.Open(switchDriverName(m.Driver), m.HostData().URI)
+		db, err := sqlx.Open(m.driverName, m.dsn)
 		if err != nil {
 			return nil, errors.Wrap(err, "opening connection")
 		}
@@ -251,13 +268,3 @@ func (m *MetricSet) Close() error {
 	}
 	return errors.Wrap(m.db.Close(), "closing connection")
 }
-
-// switchDriverName switches between driver name and a pretty name for a driver. For example, 'oracle' driver is called
-// 'godror' so this detail implementation must be hidden to the user, that should only choose and see 'oracle' as driver
-func switchDriverName(d string) string {
-	if d == "oracle" {
-		return "godror"
-	}
-
-	return d
-}